@@ -0,0 +1,256 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspect
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const tsspFileSuffix = ".tssp"
+
+var ErrCorruptFile = errors.New("corrupt tssp files found")
+
+type DumpConfig struct {
+	File string
+	// dump the column data of the series, 0 means only dump the meta data
+	Sid uint64
+	// max rows of the column data to dump, 0 means all rows
+	Rows int
+}
+
+type VerifyConfig struct {
+	// a shard directory or a single TSSP file
+	Path string
+}
+
+type SalvageConfig struct {
+	File string
+	// the readable chunks are written to OutDir/<measurement>/<file name>
+	OutDir string
+}
+
+// Dump prints the trailer, meta index, chunk metas, pre-aggregation of a TSSP file,
+// and the column data of the series if it is set
+func Dump(w io.Writer, conf *DumpConfig) error {
+	f, err := immutable.OpenTSSPFileReadonly(conf.File)
+	if err != nil {
+		return err
+	}
+	defer closeFile(f)
+
+	dumpTrailer(w, f)
+
+	ctx := immutable.NewReadContext(true)
+	defer ctx.Release()
+
+	lastMetaIdx := -1
+	return immutable.WalkChunkMetas(f, func(metaIdx int, mi *immutable.MetaIndex, cm *immutable.ChunkMeta) error {
+		if metaIdx != lastMetaIdx {
+			minT, maxT := mi.MinMaxTime()
+			fmt.Fprintf(w, "\nMeta index %d: id=%d time=[%d, %d] offset=%d size=%d count=%d\n",
+				metaIdx, mi.GetID(), minT, maxT, mi.GetOffset(), mi.GetSize(), mi.GetCount())
+			lastMetaIdx = metaIdx
+		}
+		if conf.Sid != 0 && cm.GetSid() != conf.Sid {
+			return nil
+		}
+
+		dumpChunkMeta(w, cm)
+		if conf.Sid == 0 {
+			return nil
+		}
+		rec, err := immutable.ReadChunk(f, cm, ctx)
+		if err != nil {
+			fmt.Fprintf(w, "    read data failed: %v\n", err)
+			return nil
+		}
+		dumpRecord(w, rec, conf.Rows)
+		return nil
+	})
+}
+
+func dumpTrailer(w io.Writer, f immutable.TSSPFile) {
+	tr := f.FileStat()
+	minID, maxID := tr.MinMaxID()
+	minT, maxT := tr.MinMaxTime()
+	fmt.Fprintf(w, "File: %s\n", f.Path())
+	fmt.Fprintf(w, "Size: %d, Version: %d, Order: %v\n", f.FileSize(), f.Version(), f.IsOrder())
+	fmt.Fprintf(w, "Trailer:\n")
+	fmt.Fprintf(w, "  measurement: %s\n", tr.Name())
+	fmt.Fprintf(w, "  series: %d, id=[%d, %d]\n", tr.IdCount(), minID, maxID)
+	fmt.Fprintf(w, "  time: [%d, %d]\n", minT, maxT)
+	fmt.Fprintf(w, "  data: offset=%d size=%d\n", tr.DataOffset(), tr.DataSize())
+	fmt.Fprintf(w, "  chunk meta size: %d, meta index size: %d, meta index count: %d\n", tr.IndexSize(), tr.MetaIndexSize(), tr.MetaIndexItemNum())
+	fmt.Fprintf(w, "  bloom filter size: %d, id time size: %d\n", tr.BloomSize(), tr.IdTimeSize())
+	fmt.Fprintf(w, "  chunk meta compress: %d, time store: %d\n", tr.ChunkMetaCompressFlag, tr.TimeStoreFlag)
+}
+
+func dumpChunkMeta(w io.Writer, cm *immutable.ChunkMeta) {
+	minT, maxT := cm.MinMaxTime()
+	off, size := cm.DataOffsetSize()
+	fmt.Fprintf(w, "  Chunk sid=%d time=[%d, %d] offset=%d size=%d segments=%d\n", cm.GetSid(), minT, maxT, off, size, cm.SegmentCount())
+	for _, col := range cm.GetColMeta() {
+		fmt.Fprintf(w, "    column %s(%s)", col.Name(), influx.FieldTypeName[int(col.Type())])
+		if agg, err := col.DecodePreAgg(); err != nil {
+			fmt.Fprintf(w, " pre-agg: %v", err)
+		} else {
+			fmt.Fprintf(w, " count=%d min=%v(%d) max=%v(%d) sum=%v", agg.Count, agg.Min, agg.MinTime, agg.Max, agg.MaxTime, agg.Sum)
+		}
+		fmt.Fprintln(w)
+		for j := 0; j < cm.SegmentCount(); j++ {
+			segOff, segSize := col.GetSegment(j)
+			fmt.Fprintf(w, "      segment %d: offset=%d size=%d\n", j, segOff, segSize)
+		}
+	}
+}
+
+func dumpRecord(w io.Writer, rec *record.Record, limit int) {
+	rows := rec.RowNums()
+	if limit > 0 && rows > limit {
+		rows = limit
+	}
+	fmt.Fprintf(w, "    Data (%d of %d rows):\n", rows, rec.RowNums())
+
+	names := make([]string, rec.ColNums())
+	for i := range rec.Schema {
+		names[i] = rec.Schema[i].Name
+	}
+	fmt.Fprintf(w, "      %s\n", strings.Join(names, "\t"))
+
+	times := rec.Times()
+	values := make([]string, rec.ColNums())
+	for row := 0; row < rows; row++ {
+		for i := range rec.Schema[:len(rec.Schema)-1] {
+			values[i] = columnValue(&rec.Schema[i], rec.Column(i), row)
+		}
+		values[len(values)-1] = fmt.Sprintf("%d", times[row])
+		fmt.Fprintf(w, "      %s\n", strings.Join(values, "\t"))
+	}
+}
+
+func columnValue(ref *record.Field, col *record.ColVal, row int) string {
+	if col.IsNil(row) {
+		return "null"
+	}
+	switch ref.Type {
+	case influx.Field_Type_Int:
+		v, _ := col.IntegerValue(row)
+		return fmt.Sprintf("%d", v)
+	case influx.Field_Type_Float:
+		v, _ := col.FloatValue(row)
+		return fmt.Sprintf("%v", v)
+	case influx.Field_Type_Boolean:
+		v, _ := col.BooleanValue(row)
+		return fmt.Sprintf("%v", v)
+	case influx.Field_Type_String:
+		v, _ := col.StringValueUnsafe(row)
+		return fmt.Sprintf("%q", v)
+	default:
+		return "?"
+	}
+}
+
+// Verify checks every TSSP file under the path, returns ErrCorruptFile if any file is corrupt
+func Verify(w io.Writer, conf *VerifyConfig) error {
+	files, err := tsspFiles(conf.Path)
+	if err != nil {
+		return err
+	}
+
+	corrupt := 0
+	for _, name := range files {
+		f, err := immutable.OpenTSSPFileReadonly(name)
+		if err != nil {
+			corrupt++
+			fmt.Fprintf(w, "%s: open failed: %v\n", name, err)
+			continue
+		}
+
+		res := immutable.VerifyTSSPFile(f)
+		closeFile(f)
+		if len(res.Corruptions) == 0 {
+			fmt.Fprintf(w, "%s: ok, %d chunks, %d rows\n", name, res.Chunks, res.Rows)
+			continue
+		}
+		corrupt++
+		fmt.Fprintf(w, "%s: corrupt, %d chunks, %d rows\n", name, res.Chunks, res.Rows)
+		for _, c := range res.Corruptions {
+			fmt.Fprintf(w, "  %s\n", c.String())
+		}
+	}
+
+	fmt.Fprintf(w, "%d files verified, %d corrupt\n", len(files), corrupt)
+	if corrupt > 0 {
+		return ErrCorruptFile
+	}
+	return nil
+}
+
+func tsspFiles(path string) ([]string, error) {
+	var files []string
+	err := filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.HasSuffix(p, tsspFileSuffix) {
+			files = append(files, p)
+		}
+		return nil
+	})
+	sort.Strings(files)
+	return files, err
+}
+
+// Salvage copies the readable chunks of a TSSP file to a new file
+func Salvage(w io.Writer, conf *SalvageConfig) error {
+	if conf.OutDir == "" {
+		return errors.New("the output directory is required")
+	}
+	f, err := immutable.OpenTSSPFileReadonly(conf.File)
+	if err != nil {
+		return err
+	}
+	defer closeFile(f)
+
+	nf, res, err := immutable.SalvageTSSPFile(f, conf.OutDir, new(string))
+	if err != nil {
+		return err
+	}
+	for _, c := range res.Skipped {
+		fmt.Fprintf(w, "skipped %s\n", c.String())
+	}
+	if nf == nil {
+		return fmt.Errorf("no readable chunk in %s", conf.File)
+	}
+	defer closeFile(nf)
+	fmt.Fprintf(w, "%d chunks, %d rows salvaged to %s, %d skipped\n", res.Chunks, res.Rows, nf.Path(), len(res.Skipped))
+	return nil
+}
+
+func closeFile(f immutable.TSSPFile) {
+	if err := f.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "close %s failed: %v\n", f.Path(), err)
+	}
+}
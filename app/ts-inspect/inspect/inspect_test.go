@@ -0,0 +1,96 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspect
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, dir string) string {
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_String, Name: "host"},
+		record.Field{Type: influx.Field_Type_Int, Name: "time"},
+	}
+	lockPath := ""
+	fileName := immutable.NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := immutable.NewMsBuilder(dir, "cpu", &lockPath, immutable.NewTsStoreConfig(), 2, fileName, 0, nil, 2, config.TSSTORE, nil, 0)
+	for sid := uint64(1); sid <= 2; sid++ {
+		rec := record.NewRecordBuilder(schema)
+		rec.ColVals[0].AppendString("server_a")
+		rec.ColVals[0].AppendStringNull()
+		rec.ColVals[1].AppendIntegers(1, 2)
+		require.NoError(t, msb.WriteData(sid, rec))
+	}
+	f, err := msb.NewTSSPFile(true)
+	require.NoError(t, err)
+	require.NoError(t, immutable.RenameTmpFiles([]immutable.TSSPFile{f}))
+	path := f.Path()
+	require.NoError(t, f.Close())
+	return path
+}
+
+func TestDump(t *testing.T) {
+	path := writeFile(t, t.TempDir())
+
+	w := &bytes.Buffer{}
+	require.NoError(t, Dump(w, &DumpConfig{File: path}))
+	require.Contains(t, w.String(), "measurement: cpu")
+	require.Contains(t, w.String(), "series: 2, id=[1, 2]")
+	require.Contains(t, w.String(), "Chunk sid=2 time=[1, 2]")
+	require.NotContains(t, w.String(), "server_a")
+
+	w.Reset()
+	require.NoError(t, Dump(w, &DumpConfig{File: path, Sid: 2, Rows: 1}))
+	require.NotContains(t, w.String(), "Chunk sid=1")
+	require.Contains(t, w.String(), "Data (1 of 2 rows)")
+	require.Contains(t, w.String(), "\"server_a\"\t1")
+}
+
+func TestVerifyAndSalvage(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir)
+
+	w := &bytes.Buffer{}
+	require.NoError(t, Verify(w, &VerifyConfig{Path: dir}))
+	require.Contains(t, w.String(), "1 files verified, 0 corrupt")
+
+	// corrupt the last column of the last chunk
+	buf, err := os.ReadFile(path)
+	require.NoError(t, err)
+	f, err := immutable.OpenTSSPFileReadonly(path)
+	require.NoError(t, err)
+	pos := f.FileStat().DataOffset() + f.FileStat().DataSize() - 1
+	require.NoError(t, f.Close())
+	buf[pos] ^= 0xff
+	require.NoError(t, os.WriteFile(path, buf, 0600))
+
+	w.Reset()
+	require.ErrorIs(t, Verify(w, &VerifyConfig{Path: dir}), ErrCorruptFile)
+	require.Contains(t, w.String(), "series 2: column time crc mismatch")
+
+	w.Reset()
+	require.NoError(t, Salvage(w, &SalvageConfig{File: path, OutDir: t.TempDir()}))
+	require.Contains(t, w.String(), "1 chunks, 2 rows salvaged")
+
+	require.Error(t, Salvage(w, &SalvageConfig{File: path}))
+}
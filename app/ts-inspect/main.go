@@ -0,0 +1,118 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/influxdata/influxdb/cmd"
+	"github.com/openGemini/openGemini/app"
+	"github.com/openGemini/openGemini/app/ts-inspect/inspect"
+)
+
+const TsInspect = "ts-inspect"
+
+const inspectUsage = `Inspect and repair the TSSP files offline, the store must not be running on the files.
+
+Usage: ts-inspect [command] [arguments]
+
+The commands are:
+	dump            dump the trailer, meta index, chunk metas and column data of a TSSP file
+	verify          verify the crc and ordering of the TSSP files under a shard directory
+	salvage         copy the readable chunks of a corrupt TSSP file to a new file
+	version         display the openGemini version
+
+Use "ts-inspect [command] -help" for more information about a command.
+`
+
+const dumpUsage = `Usage: ts-inspect dump [flags]
+
+    -file <path>
+            The TSSP file to dump.
+    -sid <series id>
+            Dump the column data of the series.
+    -rows <n>
+            Max rows of the column data to dump, 0 means all rows.
+`
+
+const verifyUsage = `Usage: ts-inspect verify [flags]
+
+    -path <path>
+            The shard directory or TSSP file to verify.
+`
+
+const salvageUsage = `Usage: ts-inspect salvage [flags]
+
+    -file <path>
+            The corrupt TSSP file.
+    -out <path>
+            The output directory, the new file is written to <out>/<measurement>/<file name>.
+`
+
+func main() {
+	if err := doRun(os.Args[1:]...); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func doRun(args ...string) error {
+	name, args := cmd.ParseCommandName(args)
+	switch name {
+	case "dump":
+		var conf inspect.DumpConfig
+		fs := newFlagSet(dumpUsage)
+		fs.StringVar(&conf.File, "file", "", "")
+		fs.Uint64Var(&conf.Sid, "sid", 0, "")
+		fs.IntVar(&conf.Rows, "rows", 0, "")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		return inspect.Dump(os.Stdout, &conf)
+	case "verify":
+		var conf inspect.VerifyConfig
+		fs := newFlagSet(verifyUsage)
+		fs.StringVar(&conf.Path, "path", "", "")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		return inspect.Verify(os.Stdout, &conf)
+	case "salvage":
+		var conf inspect.SalvageConfig
+		fs := newFlagSet(salvageUsage)
+		fs.StringVar(&conf.File, "file", "", "")
+		fs.StringVar(&conf.OutDir, "out", "", "")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		return inspect.Salvage(os.Stdout, &conf)
+	case "version":
+		fmt.Println(app.FullVersion(TsInspect))
+	default:
+		return fmt.Errorf(inspectUsage)
+	}
+
+	return nil
+}
+
+func newFlagSet(usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Println(usage)
+	}
+	return fs
+}
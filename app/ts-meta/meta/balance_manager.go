@@ -27,7 +27,10 @@ import (
 
 var balanceInterval = 10 * time.Second
 
-const SerialBalanceAlgoName string = "v1.0"
+const (
+	SerialBalanceAlgoName string = "v1.0"
+	LoadBalanceAlgoName   string = config.LoadBalanceAlgoVer
+)
 
 type BalanceManager struct {
 	wg      sync.WaitGroup
@@ -41,6 +44,10 @@ func NewBalanceManager(algo string) *BalanceManager {
 		bm.algoFn = bm.balanceIfNeeded
 		return bm
 	}
+	if algo == LoadBalanceAlgoName {
+		bm.algoFn = bm.balanceByLoad
+		return bm
+	}
 	bm.algoFn = bm.balanceIfNeededEx
 	return bm
 }
@@ -90,6 +97,43 @@ func (b *BalanceManager) balanceIfNeededEx() {
 	}
 }
 
+// balanceByLoad moves the db pts by the plans of the load balancer, a plan is made every load-balance interval
+func (b *BalanceManager) balanceByLoad() {
+	logger.GetLogger().Info("[balancer] 2.0 algo start")
+	defer b.wg.Done()
+	lb := globalService.loadBalancer
+	for {
+		if atomic.LoadInt32(&b.stopped) == 1 || config.GetHaPolicy() != config.SharedStorage {
+			return
+		}
+		if globalService.store.isBalancerEnabled() {
+			b.executeBalancePlan(lb)
+		}
+
+		for waited := time.Duration(0); waited < time.Duration(lb.conf.Interval) && atomic.LoadInt32(&b.stopped) == 0; waited += balanceInterval {
+			time.Sleep(balanceInterval)
+		}
+	}
+}
+
+func (b *BalanceManager) executeBalancePlan(lb *LoadBalancer) {
+	plan, err := lb.Plan()
+	if err != nil {
+		logger.GetLogger().Error("[balancer] plan the load balance failed", zap.Error(err))
+		return
+	}
+	logger.GetLogger().Info("[balancer] load balance plan", zap.Float64("imbalance", plan.Imbalance),
+		zap.Float64("plannedImbalance", plan.PlannedImbalance), zap.Any("moves", plan.Moves), zap.String("reason", plan.Reason))
+	for _, mv := range plan.Moves {
+		if atomic.LoadInt32(&b.stopped) == 1 {
+			return
+		}
+		if err = globalService.store.loadBalanceMovePt(mv); err != nil {
+			logger.GetLogger().Error("[balancer] load balance move failed", zap.Any("move", mv), zap.Error(err))
+		}
+	}
+}
+
 // Stop balance goroutine
 func (b *BalanceManager) Stop() {
 	if !atomic.CompareAndSwapInt32(&b.stopped, 0, 1) {
@@ -99,6 +143,9 @@ func (b *BalanceManager) Stop() {
 }
 
 func (b *BalanceManager) assignDbPt(dbPt *meta.DbPtInfo, target uint64, aliveConnId uint64, userCommand bool) error {
+	if err := globalService.store.checkRGPlacement(dbPt.Db, dbPt.Pti.PtId, target); err != nil {
+		return err
+	}
 	me := NewAssignEvent(dbPt, target, aliveConnId, userCommand)
 	return globalService.msm.executeEvent(me)
 }
@@ -247,6 +247,11 @@ func (s *Store) addMovePtTasks(db string, tasks *taskDatas, moveEvents []*MoveEv
 			logger.GetLogger().Info("no need exec mvpt task", zap.String("db", db), zap.String("info", task.String()))
 			continue
 		}
+		if err := s.data.CheckRGPlacement(db, task.ptId, task.destDn); err != nil {
+			logger.GetLogger().Info("skip mvpt task breaking the replica group placement", zap.String("db", db),
+				zap.String("info", task.String()), zap.Error(err))
+			continue
+		}
 		shardDurations := s.data.GetShardDurationsByDbPt(db, task.ptId)
 		pt := s.data.GetPtInfo(db, task.ptId)
 		dn := s.data.DataNode(task.destDn)
@@ -579,7 +584,7 @@ func (s *Store) balanceByPtNum(db string, from, to uint64, nodePtsMap map[uint64
 	isMoveOld := s.canMigrateOldPt(db, from, to, nodePtsMap)
 	for i := 0; i <= len(nodePtsMap[from])-1; i++ {
 		pt := nodePtsMap[from][i]
-		if pt.Status != meta.Online {
+		if pt.Status != meta.Online || s.data.CheckRGPlacement(db, pt.PtId, to) != nil {
 			continue
 		}
 		isOldPt := s.isOldPt(db, pt)
@@ -657,6 +662,11 @@ func (s *Store) balanceByOldAndNew(db string, aliveNodes []uint64, nodePtsMap ma
 			if srcDn == nil || dstDn == nil {
 				return moveEvents
 			}
+			if err := s.checkSwapPlacement(db, fromPt.PtId, from, toPt.PtId, to); err != nil {
+				logger.GetLogger().Info("skip swapping the pts breaking the replica group placement", zap.String("db", db),
+					zap.Uint32("fromPt", fromPt.PtId), zap.Uint32("toPt", toPt.PtId), zap.Error(err))
+				return moveEvents
+			}
 			shardDurations := s.data.GetShardDurationsByDbPt(db, fromPt.PtId)
 			moveEvents = append(moveEvents, NewMoveEvent(
 				&meta.DbPtInfo{Db: db, Pti: &fromPt, Shards: shardDurations, DBBriefInfo: dbBriefInfo}, from, to, dstDn.AliveConnID, false))
@@ -669,6 +679,19 @@ func (s *Store) balanceByOldAndNew(db string, aliveNodes []uint64, nodePtsMap ma
 	return moveEvents
 }
 
+// checkSwapPlacement checks the placement of the replica groups after ptA on nodeA and ptB on nodeB are swapped.
+// The pts of the same replica group keep on different nodes, so only the other members of their groups are checked
+func (s *Store) checkSwapPlacement(db string, ptA uint32, nodeA uint64, ptB uint32, nodeB uint64) error {
+	ptView := s.data.PtView[db]
+	if int(ptA) < len(ptView) && int(ptB) < len(ptView) && ptView[ptA].RGID == ptView[ptB].RGID {
+		return nil
+	}
+	if err := s.data.CheckRGPlacement(db, ptA, nodeB); err != nil {
+		return err
+	}
+	return s.data.CheckRGPlacement(db, ptB, nodeA)
+}
+
 func (s *Store) refreshShards(e MigrateEvent) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
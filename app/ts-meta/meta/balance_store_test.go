@@ -506,3 +506,47 @@ func TestMasterPtBalanceDBPts6(t *testing.T) {
 	eventDbs, _, _, _ := store.selectUpdateRGEvents()
 	assert.Equal(t, 0, len(eventDbs))
 }
+
+// {0*, 1} and {2*, 3} are the replica groups, pt 1 is on node 2
+func TestBalanceReplicaGroupPlacement(t *testing.T) {
+	store := &Store{
+		data: &meta.Data{
+			Databases: map[string]*meta.DatabaseInfo{"db0": {Name: "db0", ReplicaN: 2}},
+			ReplicaGroups: map[string][]meta.ReplicaGroup{"db0": {
+				{ID: 0, MasterPtID: 0, Peers: []meta.Peer{{ID: 1}}},
+				{ID: 1, MasterPtID: 2, Peers: []meta.Peer{{ID: 3}}},
+			}},
+		},
+	}
+	n1, _ := store.data.CreateDataNode("127.0.0.1:8401", "127.0.0.1:8402", "", "")
+	n2, _ := store.data.CreateDataNode("127.0.0.2:8401", "127.0.0.2:8402", "", "")
+	n3, _ := store.data.CreateDataNode("127.0.0.3:8401", "127.0.0.3:8402", "", "")
+	store.data.PtView = map[string]meta.DBPtInfos{
+		"db0": []meta.PtInfo{{PtId: 0, Owner: meta.PtOwner{NodeID: n1}, Status: meta.Online, RGID: 0},
+			{PtId: 1, Owner: meta.PtOwner{NodeID: n2}, Status: meta.Online, RGID: 0},
+			{PtId: 2, Owner: meta.PtOwner{NodeID: n1}, Status: meta.Online, RGID: 1},
+			{PtId: 3, Owner: meta.PtOwner{NodeID: n3}, Status: meta.Online, RGID: 1},
+		}}
+
+	assert.Error(t, store.checkRGPlacement("db0", 0, n2))
+	assert.NoError(t, store.checkRGPlacement("db0", 0, n3))
+
+	nodePtsMap := map[uint64]meta.DBPtInfos{n1: {store.data.PtView["db0"][0], store.data.PtView["db0"][2]}}
+	events := store.balanceByPtNum("db0", n1, n2, nodePtsMap, nil, nil)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, uint32(2), events[0].pt.Pti.PtId)
+
+	nodePtsMap = map[uint64]meta.DBPtInfos{n1: {store.data.PtView["db0"][0]}}
+	events = store.balanceByPtNum("db0", n1, n2, nodePtsMap, nil, nil)
+	assert.Equal(t, 0, len(events))
+
+	tasks := taskDatas{{ptId: 0, srcDn: n1, destDn: n2}, {ptId: 2, srcDn: n1, destDn: n2}}
+	events = store.addMovePtTasks("db0", &tasks, nil, nil)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, uint32(2), events[0].pt.Pti.PtId)
+
+	// swapping pt 0 and pt 3 moves pt 3 onto node 1 with pt 2 of its replica group
+	assert.Error(t, store.checkSwapPlacement("db0", 0, n1, 3, n3))
+	assert.NoError(t, store.checkSwapPlacement("db0", 1, n2, 3, n3))
+	assert.NoError(t, store.checkSwapPlacement("db0", 0, n1, 1, n2))
+}
@@ -0,0 +1,705 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	mproto "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+// actions of the decommission of a node
+const (
+	DecommissionStart  = "start"
+	DecommissionPause  = "pause"
+	DecommissionResume = "resume"
+	DecommissionCancel = "cancel"
+)
+
+// states of the decommission of a node and of its db pts
+const (
+	DecommissionRunning   = "running"
+	DecommissionPaused    = "paused"
+	DecommissionCancelled = "cancelled"
+	DecommissionDone      = "done"
+	DecommissionFailed    = "failed"
+
+	DecommissionPtPending = "pending"
+	DecommissionPtCopying = "copying"
+	DecommissionPtMoving  = "moving"
+	DecommissionPtMoved   = "moved"
+)
+
+var (
+	errDecommissionCancelled = errors.New("decommission cancelled")
+	errDecommissionStopped   = errors.New("decommission stopped by the leader change")
+)
+
+// DecommissionPtStatus is the progress of the move of a db pt of the decommissioned node
+type DecommissionPtStatus struct {
+	Db          string `json:"db"`
+	Pt          uint32 `json:"pt"`
+	Target      uint64 `json:"target,omitempty"`
+	State       string `json:"state"`
+	TotalBytes  int64  `json:"totalBytes"`
+	CopiedBytes int64  `json:"copiedBytes"`
+	Error       string `json:"error,omitempty"`
+}
+
+type DecommissionStatus struct {
+	NodeID    uint64                  `json:"nodeId"`
+	State     string                  `json:"state"`
+	StartTime time.Time               `json:"startTime"`
+	EndTime   time.Time               `json:"endTime"`
+	Pts       []*DecommissionPtStatus `json:"pts"`
+	Error     string                  `json:"error,omitempty"`
+}
+
+type decommissionTask struct {
+	nodeID uint64
+	notify chan struct{} // wakes the task up when it is paused, resumed or cancelled
+
+	mu        sync.Mutex
+	paused    bool
+	cancelled bool
+	status    DecommissionStatus
+
+	persistMu sync.Mutex // keeps the persisted states of the task in order
+}
+
+// restoreDecommissionTask rebuilds the task from the state persisted by the previous leader
+func restoreDecommissionTask(info *meta.DecommissionInfo) *decommissionTask {
+	task := &decommissionTask{
+		nodeID:    info.NodeID,
+		notify:    make(chan struct{}, 1),
+		paused:    info.Paused,
+		cancelled: info.Cancelled,
+		status: DecommissionStatus{NodeID: info.NodeID, State: info.State, StartTime: time.Unix(0, info.StartTime),
+			Pts: make([]*DecommissionPtStatus, len(info.Pts)), Error: info.Error},
+	}
+	if info.EndTime != 0 {
+		task.status.EndTime = time.Unix(0, info.EndTime)
+	}
+	for i, pt := range info.Pts {
+		task.status.Pts[i] = &DecommissionPtStatus{Db: pt.Db, Pt: pt.Pt, Target: pt.Target, State: pt.State, Error: pt.Error}
+	}
+	return task
+}
+
+// info returns the state of the task to persist, the copied bytes are not persisted
+func (t *decommissionTask) info() *meta.DecommissionInfo {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	info := &meta.DecommissionInfo{
+		NodeID:    t.nodeID,
+		State:     t.status.State,
+		Paused:    t.paused,
+		Cancelled: t.cancelled,
+		StartTime: t.status.StartTime.UnixNano(),
+		Error:     t.status.Error,
+		Pts:       make([]meta.DecommissionPtInfo, len(t.status.Pts)),
+	}
+	if !t.status.EndTime.IsZero() {
+		info.EndTime = t.status.EndTime.UnixNano()
+	}
+	for i, pt := range t.status.Pts {
+		info.Pts[i] = meta.DecommissionPtInfo{Db: pt.Db, Pt: pt.Pt, Target: pt.Target, State: pt.State, Error: pt.Error}
+	}
+	return info
+}
+
+func (t *decommissionTask) wakeUp() {
+	select {
+	case t.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (t *decommissionTask) control() (paused, cancelled bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.paused, t.cancelled
+}
+
+func (t *decommissionTask) finished() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.status.State == DecommissionCancelled || t.status.State == DecommissionDone || t.status.State == DecommissionFailed
+}
+
+func (t *decommissionTask) update(fn func(s *DecommissionStatus)) {
+	t.mu.Lock()
+	fn(&t.status)
+	t.mu.Unlock()
+}
+
+func (t *decommissionTask) state() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.status.State
+}
+
+func (t *decommissionTask) getStatus() DecommissionStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	status := t.status
+	status.Pts = make([]*DecommissionPtStatus, len(t.status.Pts))
+	for i, pt := range t.status.Pts {
+		ptStatus := *pt
+		status.Pts[i] = &ptStatus
+	}
+	return status
+}
+
+// DecommissionManager moves the db pts of the decommissioned nodes of the local-storage clusters to the other nodes.
+// The files of each db pt are copied to the target node in the background first, then the db pt is moved by a
+// decommission move event which copies the rest of the files once the db pt is offloaded. It runs on the leader only,
+// the state of the decommissions is persisted in the meta data and the new leader resumes the unfinished ones.
+type DecommissionManager struct {
+	conf  config.DecommissionConfig
+	store *Store
+
+	// movePt moves the db pt to dst once most of its files are copied, it is replaced by the tests
+	movePt func(db string, pt uint32, src, dst uint64) error
+
+	wg      sync.WaitGroup
+	stopped int32
+	closing chan struct{}
+
+	mu    sync.Mutex
+	tasks map[uint64]*decommissionTask
+}
+
+func NewDecommissionManager(conf config.DecommissionConfig, store *Store) *DecommissionManager {
+	return &DecommissionManager{
+		conf:    conf,
+		store:   store,
+		movePt:  store.decommissionMovePt,
+		stopped: 1,
+		tasks:   make(map[uint64]*decommissionTask),
+	}
+}
+
+func (m *DecommissionManager) Start() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !atomic.CompareAndSwapInt32(&m.stopped, 1, 0) {
+		return
+	}
+	m.closing = make(chan struct{})
+
+	// resume the decommissions of the previous leader
+	m.tasks = make(map[uint64]*decommissionTask)
+	for nodeID, info := range m.store.getDecommissions() {
+		task := restoreDecommissionTask(info)
+		m.tasks[nodeID] = task
+		if task.finished() {
+			continue
+		}
+		logger.GetLogger().Info("[decommission] resume", zap.Uint64("node", nodeID), zap.String("state", info.State))
+		m.wg.Add(1)
+		go m.run(task, m.closing)
+	}
+}
+
+// Stop the running decommissions, the decommissioned nodes stay segregating until they are started again
+func (m *DecommissionManager) Stop() {
+	m.mu.Lock()
+	if !atomic.CompareAndSwapInt32(&m.stopped, 0, 1) {
+		m.mu.Unlock()
+		return
+	}
+	close(m.closing)
+	m.mu.Unlock()
+	m.wg.Wait()
+}
+
+// Control starts, pauses, resumes or cancels the decommission of the node
+func (m *DecommissionManager) Control(nodeID uint64, action string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if atomic.LoadInt32(&m.stopped) == 1 {
+		return errno.NewError(errno.MetaIsNotLeader)
+	}
+
+	task, ok := m.tasks[nodeID]
+	running := ok && !task.finished()
+	if action == DecommissionStart {
+		if running {
+			return fmt.Errorf("decommission of node %d is already running", nodeID)
+		}
+		return m.start(nodeID)
+	}
+	if !running {
+		return fmt.Errorf("no running decommission of node %d", nodeID)
+	}
+
+	task.mu.Lock()
+	switch action {
+	case DecommissionPause:
+		task.paused = true
+	case DecommissionResume:
+		task.paused = false
+	case DecommissionCancel:
+		task.cancelled = true
+	default:
+		task.mu.Unlock()
+		return fmt.Errorf("unknown decommission action %q", action)
+	}
+	task.mu.Unlock()
+	if err := m.persist(task); err != nil {
+		return err
+	}
+	task.wakeUp()
+	return nil
+}
+
+func (m *DecommissionManager) start(nodeID uint64) error {
+	if config.GetHaPolicy() != config.WriteAvailableFirst {
+		return errors.New("decommission with data moving is only supported by ha-policy write-available-first")
+	}
+	if err := m.store.checkDecommissionNode(nodeID); err != nil {
+		return err
+	}
+	if _, err := m.store.setNodeToSegregating([]uint64{nodeID}); err != nil {
+		return err
+	}
+
+	task := &decommissionTask{
+		nodeID: nodeID,
+		notify: make(chan struct{}, 1),
+		status: DecommissionStatus{NodeID: nodeID, State: DecommissionRunning, StartTime: time.Now()},
+	}
+	for _, pt := range m.store.decommissionPts(nodeID) {
+		task.status.Pts = append(task.status.Pts, &DecommissionPtStatus{Db: pt.Db, Pt: pt.Pti.PtId, State: DecommissionPtPending})
+	}
+	if err := m.persist(task); err != nil {
+		if err1 := m.store.SetSegregateNodeStatus([]uint64{meta.Normal}, []uint64{nodeID}); err1 != nil {
+			logger.GetLogger().Error("[decommission] restore the segregate status failed", zap.Uint64("node", nodeID), zap.Error(err1))
+		}
+		return err
+	}
+	m.tasks[nodeID] = task
+
+	m.wg.Add(1)
+	go m.run(task, m.closing)
+	return nil
+}
+
+// persist saves the state of the task in the meta data by raft
+func (m *DecommissionManager) persist(task *decommissionTask) error {
+	task.persistMu.Lock()
+	defer task.persistMu.Unlock()
+	err := m.store.updateDecommission(task.info())
+	if err != nil {
+		logger.GetLogger().Error("[decommission] persist the state failed", zap.Uint64("node", task.nodeID), zap.Error(err))
+	}
+	return err
+}
+
+// update updates the status of the task and persists it, a failed persistence is logged only,
+// the state is persisted again by the next update
+func (m *DecommissionManager) update(task *decommissionTask, fn func(s *DecommissionStatus)) {
+	task.update(fn)
+	_ = m.persist(task)
+}
+
+func (m *DecommissionManager) Status() []DecommissionStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res := make([]DecommissionStatus, 0, len(m.tasks))
+	for _, task := range m.tasks {
+		res = append(res, task.getStatus())
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].NodeID < res[j].NodeID
+	})
+	return res
+}
+
+func (m *DecommissionManager) run(task *decommissionTask, closing chan struct{}) {
+	defer m.wg.Done()
+	log := logger.GetLogger()
+	log.Info("[decommission] start", zap.Uint64("node", task.nodeID))
+
+	err := m.decommission(task, closing)
+	state := DecommissionDone
+	switch {
+	case err == nil:
+		err = m.store.SetSegregateNodeStatus([]uint64{meta.Segregated}, []uint64{task.nodeID})
+		if err != nil {
+			state = DecommissionFailed
+		}
+	case err == errDecommissionCancelled:
+		state = DecommissionCancelled
+	default:
+		state = DecommissionFailed
+	}
+	if state != DecommissionDone && err != errDecommissionStopped {
+		// the node serves the db pts which are not moved
+		if err1 := m.store.SetSegregateNodeStatus([]uint64{meta.Normal}, []uint64{task.nodeID}); err1 != nil {
+			log.Error("[decommission] restore the segregate status failed", zap.Uint64("node", task.nodeID), zap.Error(err1))
+		}
+	}
+
+	task.update(func(s *DecommissionStatus) {
+		s.State = state
+		s.EndTime = time.Now()
+		if err != nil && err != errDecommissionCancelled {
+			s.Error = err.Error()
+		}
+	})
+	if err != errDecommissionStopped {
+		// the new leader resumes the stopped decommission
+		_ = m.persist(task)
+	}
+	log.Info("[decommission] finished", zap.Uint64("node", task.nodeID), zap.String("state", state), zap.Error(err))
+}
+
+func (m *DecommissionManager) decommission(task *decommissionTask, closing chan struct{}) error {
+	for i := range task.getStatus().Pts {
+		var pt DecommissionPtStatus
+		task.update(func(s *DecommissionStatus) {
+			pt = *s.Pts[i]
+		})
+		if pt.State == DecommissionPtMoved {
+			continue
+		}
+		if pt.State == DecommissionPtMoving {
+			// the move event of the previous leader is resumed by the migrate state machine
+			if err := m.waitPtOnline(pt.Db, pt.Pt, closing); err != nil {
+				return err
+			}
+		}
+		if m.store.ptOwner(pt.Db, pt.Pt) != task.nodeID {
+			// the db pt is moved by another event
+			m.update(task, func(s *DecommissionStatus) {
+				s.Pts[i].State = DecommissionPtMoved
+			})
+			continue
+		}
+
+		target, err := m.store.selectDecommissionTarget(pt.Target)
+		if err != nil {
+			return m.failPt(task, i, err)
+		}
+		m.update(task, func(s *DecommissionStatus) {
+			s.Pts[i].Target = target
+			s.Pts[i].State = DecommissionPtCopying
+		})
+
+		if err = m.copyPt(task, i, pt.Db, pt.Pt, target, closing); err != nil {
+			if err == errDecommissionCancelled || err == errDecommissionStopped {
+				return err
+			}
+			return m.failPt(task, i, err)
+		}
+
+		m.update(task, func(s *DecommissionStatus) {
+			s.Pts[i].State = DecommissionPtMoving
+		})
+		if err = m.movePt(pt.Db, pt.Pt, task.nodeID, target); err != nil {
+			return m.failPt(task, i, err)
+		}
+		m.update(task, func(s *DecommissionStatus) {
+			s.Pts[i].State = DecommissionPtMoved
+		})
+	}
+	return nil
+}
+
+// waitPtOnline waits for the running move event of the db pt to finish
+func (m *DecommissionManager) waitPtOnline(db string, pt uint32, closing chan struct{}) error {
+	for !m.store.ptOnline(db, pt) {
+		select {
+		case <-closing:
+			return errDecommissionStopped
+		case <-time.After(time.Duration(m.conf.CheckInterval)):
+		}
+	}
+	return nil
+}
+
+func (m *DecommissionManager) failPt(task *decommissionTask, i int, err error) error {
+	task.update(func(s *DecommissionStatus) {
+		s.Pts[i].State = DecommissionFailed
+		s.Pts[i].Error = err.Error()
+	})
+	return err
+}
+
+// copyPt copies the files of the db pt to the target in the background and waits for the copy.
+// A paused copy is stopped and the copied files are kept, it resumes from them
+func (m *DecommissionManager) copyPt(task *decommissionTask, i int, db string, pt uint32, target uint64, closing chan struct{}) error {
+	req := &netstorage.PtCopyRequest{Db: db, Pt: pt, SourceNode: task.nodeID, Throughput: int64(m.conf.Throughput)}
+	started := false
+	for {
+		paused, cancelled := task.control()
+		if cancelled {
+			if err := m.store.NetStore.CancelPtCopy(target, db, pt, true); err != nil {
+				logger.GetLogger().Error("[decommission] cancel the copy of db pt failed", zap.String("db", db),
+					zap.Uint32("pt", pt), zap.Uint64("target", target), zap.Error(err))
+			}
+			return errDecommissionCancelled
+		}
+		if paused && started {
+			if err := m.store.NetStore.CancelPtCopy(target, db, pt, false); err != nil {
+				return err
+			}
+			started = false
+		}
+		state := DecommissionRunning
+		if paused {
+			state = DecommissionPaused
+		}
+		if task.state() != state {
+			m.update(task, func(s *DecommissionStatus) {
+				s.State = state
+			})
+		}
+		if !paused && !started {
+			if err := m.store.NetStore.StartPtCopy(target, req); err != nil {
+				return err
+			}
+			started = true
+		}
+
+		select {
+		case <-closing:
+			if started {
+				_ = m.store.NetStore.CancelPtCopy(target, db, pt, false)
+			}
+			return errDecommissionStopped
+		case <-task.notify:
+			continue
+		case <-time.After(time.Duration(m.conf.CheckInterval)):
+		}
+		if !started {
+			continue
+		}
+
+		status, err := m.store.NetStore.GetPtCopyStatus(target, db, pt)
+		if err != nil {
+			return err
+		}
+		task.update(func(s *DecommissionStatus) {
+			s.Pts[i].TotalBytes = status.TotalBytes
+			s.Pts[i].CopiedBytes = status.CopiedBytes
+		})
+		switch status.State {
+		case netstorage.PtCopyDone:
+			return nil
+		case netstorage.PtCopyFailed:
+			return fmt.Errorf("copy of db pt %s$%d to node %d failed: %s", db, pt, target, status.Error)
+		case netstorage.PtCopyCancelled:
+			// the copy was stopped on the target, start it again
+			started = false
+		}
+	}
+}
+
+// checkDecommissionNode checks the node is a data node which serves the db pts
+func (s *Store) checkDecommissionNode(nodeID uint64) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	node := s.data.DataNode(nodeID)
+	if node == nil {
+		return errno.NewError(errno.DataNodeNotFound)
+	}
+	if node.SegregateStatus == meta.Segregated {
+		return fmt.Errorf("node %d is already segregated", nodeID)
+	}
+	return nil
+}
+
+// decommissionPts returns the db pts of the node sorted by db and pt
+func (s *Store) decommissionPts(nodeID uint64) []*meta.DbPtInfo {
+	s.mu.RLock()
+	pts := s.data.GetPtInfosByNodeId(nodeID)
+	s.mu.RUnlock()
+	sort.Slice(pts, func(i, j int) bool {
+		if pts[i].Db != pts[j].Db {
+			return pts[i].Db < pts[j].Db
+		}
+		return pts[i].Pti.PtId < pts[j].Pti.PtId
+	})
+	return pts
+}
+
+func (s *Store) ptOwner(db string, pt uint32) uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if int(pt) >= len(s.data.PtView[db]) {
+		return 0
+	}
+	return s.data.PtView[db][pt].Owner.NodeID
+}
+
+func (s *Store) ptOnline(db string, pt uint32) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if int(pt) >= len(s.data.PtView[db]) {
+		return false
+	}
+	return s.data.PtView[db][pt].Status == meta.Online
+}
+
+// selectDecommissionTarget selects the normal alive node with the fewest db pts,
+// the nodes being decommissioned are segregating and never selected.
+// The previous target of the db pt is kept while it can be selected, the files copied to it are reused
+func (s *Store) selectDecommissionTarget(prev uint64) (uint64, error) {
+	nodePtNum := *s.getDbPtNumPerAliveNode()
+	if _, ok := nodePtNum[prev]; ok {
+		return prev, nil
+	}
+	var target uint64
+	found := false
+	for id, num := range nodePtNum {
+		if !found || num < nodePtNum[target] || (num == nodePtNum[target] && id < target) {
+			target, found = id, true
+		}
+	}
+	if !found {
+		return 0, errors.New("no alive node to take over the db pts of the decommissioned node")
+	}
+	return target, nil
+}
+
+// decommissionMovePt moves the db pt from src to dst by a decommission move event, the event moves
+// the db pt back to src if the final copy of its files fails
+func (s *Store) decommissionMovePt(db string, pt uint32, src, dst uint64) error {
+	event, err := s.genDecommissionMoveEvent(db, pt, src, dst)
+	if err != nil {
+		return err
+	}
+	if err = s.data.CheckDataNodeAlive(dst); err != nil {
+		return err
+	}
+	if err = globalService.msm.executeEvent(event); err != nil {
+		return err
+	}
+	if owner := s.ptOwner(db, pt); owner != dst {
+		return fmt.Errorf("db pt %s$%d is not moved to node %d, its owner is node %d", db, pt, dst, owner)
+	}
+	return nil
+}
+
+func (s *Store) genDecommissionMoveEvent(db string, pt uint32, src, dst uint64) (MigrateEvent, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if int(pt) >= len(s.data.PtView[db]) {
+		return nil, errno.NewError(errno.PtNotFound)
+	}
+	pti := &s.data.PtView[db][pt]
+	if pti.Owner.NodeID != src {
+		return nil, fmt.Errorf("db pt %s$%d is not on node %d", db, pt, src)
+	}
+	if pti.Status == meta.Offline {
+		return nil, errno.NewError(errno.PtIsAlreadyMigrating)
+	}
+	dn := s.data.DataNode(dst)
+	if dn == nil {
+		return nil, errno.NewError(errno.DataNodeNotFound)
+	}
+	if err := s.data.CheckRGPlacement(db, pt, dst); err != nil {
+		return nil, err
+	}
+	ptiClone := *pti
+	return NewDecommissionMoveEvent(&meta.DbPtInfo{Db: db, Pti: &ptiClone, Shards: s.data.GetShardDurationsByDbPt(db, pt),
+		DBBriefInfo: s.data.GetDBBriefInfo(db)}, src, dst, dn.AliveConnID, true), nil
+}
+
+// finalCopyPt copies the rest of the files of the offloaded db pt from src to dst, the files are verified
+// by their checksums and moved to the db pt directories of dst
+func (s *Store) finalCopyPt(db string, pt uint32, src, dst uint64) error {
+	conf := s.config.Decommission
+	req := &netstorage.PtCopyRequest{Db: db, Pt: pt, SourceNode: src, Throughput: int64(conf.Throughput), Final: true}
+	if err := s.NetStore.StartPtCopy(dst, req); err != nil {
+		return err
+	}
+	for {
+		if globalService.msm.isStopped() {
+			_ = s.NetStore.CancelPtCopy(dst, db, pt, false)
+			return errno.NewError(errno.StateMachineIsNotRunning)
+		}
+		time.Sleep(time.Duration(conf.CheckInterval))
+
+		status, err := s.NetStore.GetPtCopyStatus(dst, db, pt)
+		if err != nil {
+			return err
+		}
+		if !status.Finished() {
+			continue
+		}
+		if status.State != netstorage.PtCopyDone {
+			return fmt.Errorf("final copy of db pt %s$%d is %s: %s", db, pt, status.State, status.Error)
+		}
+		return nil
+	}
+}
+
+// updateDecommission persists the state of the decommission of the node by raft
+func (s *Store) updateDecommission(info *meta.DecommissionInfo) error {
+	val := &mproto.UpdateDecommissionCommand{
+		Info: info.Marshal(),
+	}
+	t := mproto.Command_UpdateDecommissionCommand
+	cmd := &mproto.Command{Type: &t}
+
+	if err := proto.SetExtension(cmd, mproto.E_UpdateDecommissionCommand_Command, val); err != nil {
+		panic(err)
+	}
+
+	return s.ApplyCmd(cmd)
+}
+
+func (s *Store) getDecommissions() map[uint64]*meta.DecommissionInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.CloneDecommissions()
+}
+
+func (s *Store) decommissionNode(nodeID uint64, action string) error {
+	if !s.IsLeader() {
+		return errno.NewError(errno.MetaIsNotLeader)
+	}
+	if globalService == nil || globalService.decommissionManager == nil {
+		return errors.New("decommission manager is not started")
+	}
+	return globalService.decommissionManager.Control(nodeID, action)
+}
+
+func (s *Store) decommissionStatus() ([]DecommissionStatus, error) {
+	if !s.IsLeader() {
+		return nil, errno.NewError(errno.MetaIsNotLeader)
+	}
+	if globalService == nil || globalService.decommissionManager == nil {
+		return nil, errors.New("decommission manager is not started")
+	}
+	return globalService.decommissionManager.Status(), nil
+}
@@ -0,0 +1,291 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func newDecommissionTestStore() *Store {
+	dataNodes := make([]meta.DataNode, 0, 3)
+	for id := uint64(1); id <= 3; id++ {
+		dataNodes = append(dataNodes, meta.DataNode{
+			NodeInfo:    meta.NodeInfo{ID: id, Status: serf.StatusAlive},
+			ConnID:      id,
+			AliveConnID: id,
+		})
+	}
+	// the mock raft does not apply the commands, node 1 is segregating as if it was applied
+	dataNodes[0].SegregateStatus = meta.Segregating
+	return &Store{
+		raft: &MockRaftForSG{isLeader: true},
+		data: &meta.Data{
+			Databases: map[string]*meta.DatabaseInfo{"db0": {Name: "db0"}},
+			DataNodes: dataNodes,
+			PtView: map[string]meta.DBPtInfos{
+				"db0": {
+					{PtId: 0, Owner: meta.PtOwner{NodeID: 1}, Status: meta.Online},
+					{PtId: 1, Owner: meta.PtOwner{NodeID: 2}, Status: meta.Online},
+					{PtId: 2, Owner: meta.PtOwner{NodeID: 1}, Status: meta.Online},
+					{PtId: 3, Owner: meta.PtOwner{NodeID: 3}, Status: meta.Online},
+				},
+			},
+		},
+	}
+}
+
+func newTestDecommissionManager(s *Store) (*DecommissionManager, *[]string) {
+	config.SetHaPolicy(config.WAFPolicy)
+	conf := config.NewDecommissionConfig()
+	conf.CheckInterval = toml.Duration(10 * time.Millisecond)
+	m := NewDecommissionManager(conf, s)
+	var mu sync.Mutex
+	moves := &[]string{}
+	m.movePt = func(db string, pt uint32, src, dst uint64) error {
+		s.mu.Lock()
+		s.data.PtView[db][pt].Owner.NodeID = dst
+		s.mu.Unlock()
+		mu.Lock()
+		*moves = append(*moves, (&meta.DbPtInfo{Db: db, Pti: &meta.PtInfo{PtId: pt}}).String())
+		mu.Unlock()
+		return nil
+	}
+	return m, moves
+}
+
+func waitDecommission(t *testing.T, m *DecommissionManager, state string) DecommissionStatus {
+	require.Eventually(t, func() bool {
+		status := m.Status()
+		return len(status) == 1 && status[0].State == state
+	}, 10*time.Second, 5*time.Millisecond)
+	return m.Status()[0]
+}
+
+func TestDecommissionManager(t *testing.T) {
+	s := newDecommissionTestStore()
+	netStore := NewMockNetStorage()
+	var copies []*netstorage.PtCopyRequest
+	netStore.StartPtCopyFn = func(nodeID uint64, req *netstorage.PtCopyRequest) error {
+		copies = append(copies, req)
+		return nil
+	}
+	netStore.GetPtCopyStatusFn = func(nodeID uint64, db string, pt uint32) (*netstorage.PtCopyStatus, error) {
+		return &netstorage.PtCopyStatus{Db: db, Pt: pt, State: netstorage.PtCopyDone, TotalBytes: 10, CopiedBytes: 10}, nil
+	}
+	s.NetStore = netStore
+	m, moves := newTestDecommissionManager(s)
+
+	// not started on the followers
+	require.True(t, errno.Equal(m.Control(1, DecommissionStart), errno.MetaIsNotLeader))
+
+	m.Start()
+	defer m.Stop()
+	require.EqualError(t, m.Control(1, DecommissionPause), "no running decommission of node 1")
+	require.True(t, errno.Equal(m.Control(4, DecommissionStart), errno.DataNodeNotFound))
+	require.NoError(t, m.Control(1, DecommissionStart))
+
+	status := waitDecommission(t, m, DecommissionDone)
+	require.Empty(t, status.Error)
+	require.Equal(t, 2, len(status.Pts))
+	// the db pts are moved to the nodes with the fewest db pts
+	require.Equal(t, DecommissionPtStatus{Db: "db0", Pt: 0, Target: 2, State: DecommissionPtMoved, TotalBytes: 10, CopiedBytes: 10}, *status.Pts[0])
+	require.Equal(t, DecommissionPtStatus{Db: "db0", Pt: 2, Target: 3, State: DecommissionPtMoved, TotalBytes: 10, CopiedBytes: 10}, *status.Pts[1])
+	require.Equal(t, []string{"db0$0", "db0$2"}, *moves)
+	require.Equal(t, 2, len(copies))
+	require.Equal(t, uint64(1), copies[0].SourceNode)
+	require.False(t, copies[0].Final)
+	require.Equal(t, int64(config.DefaultDecommissionThroughput), copies[0].Throughput)
+}
+
+func TestDecommissionManager_PauseResumeCancel(t *testing.T) {
+	s := newDecommissionTestStore()
+	netStore := NewMockNetStorage()
+	var mu sync.Mutex
+	starts := 0
+	var cancels []bool
+	netStore.StartPtCopyFn = func(nodeID uint64, req *netstorage.PtCopyRequest) error {
+		mu.Lock()
+		starts++
+		mu.Unlock()
+		return nil
+	}
+	netStore.GetPtCopyStatusFn = func(nodeID uint64, db string, pt uint32) (*netstorage.PtCopyStatus, error) {
+		return &netstorage.PtCopyStatus{Db: db, Pt: pt, State: netstorage.PtCopyRunning, TotalBytes: 10, CopiedBytes: 5}, nil
+	}
+	netStore.CancelPtCopyFn = func(nodeID uint64, db string, pt uint32, clean bool) error {
+		mu.Lock()
+		cancels = append(cancels, clean)
+		mu.Unlock()
+		return nil
+	}
+	s.NetStore = netStore
+	m, moves := newTestDecommissionManager(s)
+	m.Start()
+	defer m.Stop()
+
+	require.NoError(t, m.Control(1, DecommissionStart))
+	require.EqualError(t, m.Control(1, DecommissionStart), "decommission of node 1 is already running")
+	require.Eventually(t, func() bool {
+		pts := m.Status()[0].Pts
+		return pts[0].State == DecommissionPtCopying && pts[0].CopiedBytes == 5
+	}, 10*time.Second, 5*time.Millisecond)
+
+	// the paused copy is stopped and the copied files are kept
+	require.NoError(t, m.Control(1, DecommissionPause))
+	waitDecommission(t, m, DecommissionPaused)
+	mu.Lock()
+	require.Equal(t, []bool{false}, cancels)
+	mu.Unlock()
+
+	require.NoError(t, m.Control(1, DecommissionResume))
+	waitDecommission(t, m, DecommissionRunning)
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return starts == 2
+	}, 10*time.Second, 5*time.Millisecond)
+
+	// the cancelled copy removes the copied files
+	require.NoError(t, m.Control(1, DecommissionCancel))
+	status := waitDecommission(t, m, DecommissionCancelled)
+	require.Empty(t, status.Error)
+	mu.Lock()
+	require.Equal(t, []bool{false, true}, cancels)
+	mu.Unlock()
+	require.Empty(t, *moves)
+	require.Equal(t, uint64(1), s.ptOwner("db0", 0))
+}
+
+func TestDecommissionManager_MoveFailed(t *testing.T) {
+	s := newDecommissionTestStore()
+	s.NetStore = NewMockNetStorage()
+	m, _ := newTestDecommissionManager(s)
+	m.movePt = func(db string, pt uint32, src, dst uint64) error {
+		return errors.New("final copy failed")
+	}
+	m.Start()
+	defer m.Stop()
+
+	require.NoError(t, m.Control(1, DecommissionStart))
+	status := waitDecommission(t, m, DecommissionFailed)
+	require.Equal(t, "final copy failed", status.Error)
+	require.Equal(t, DecommissionFailed, status.Pts[0].State)
+	require.Equal(t, DecommissionPtPending, status.Pts[1].State)
+
+	// a failed decommission can be started again
+	m.movePt = func(db string, pt uint32, src, dst uint64) error { return nil }
+	require.NoError(t, m.Control(1, DecommissionStart))
+}
+
+func TestDecommissionManager_HaPolicy(t *testing.T) {
+	m, _ := newTestDecommissionManager(newDecommissionTestStore())
+	config.SetHaPolicy(config.SSPolicy)
+	defer config.SetHaPolicy(config.WAFPolicy)
+	m.Start()
+	defer m.Stop()
+	require.EqualError(t, m.Control(1, DecommissionStart), "decommission with data moving is only supported by ha-policy write-available-first")
+}
+
+// mockRaftForDecommission applies the decommission commands to the store
+type mockRaftForDecommission struct {
+	MockRaftForSG
+	store *Store
+}
+
+func (m *mockRaftForDecommission) Apply(b []byte) error {
+	cmd := proto2.Command{}
+	if err := proto.Unmarshal(b, &cmd); err != nil {
+		return err
+	}
+	if cmd.GetType() != proto2.Command_UpdateDecommissionCommand {
+		return nil
+	}
+	m.store.mu.Lock()
+	defer m.store.mu.Unlock()
+	(*storeFSM)(m.store).executeCmd(cmd)
+	return nil
+}
+
+func TestDecommissionManager_ResumeOnNewLeader(t *testing.T) {
+	s := newDecommissionTestStore()
+	s.raft = &mockRaftForDecommission{MockRaftForSG: MockRaftForSG{isLeader: true}, store: s}
+	netStore := NewMockNetStorage()
+	var mu sync.Mutex
+	var targets []uint64
+	copyState := netstorage.PtCopyRunning
+	netStore.StartPtCopyFn = func(nodeID uint64, req *netstorage.PtCopyRequest) error {
+		mu.Lock()
+		targets = append(targets, nodeID)
+		mu.Unlock()
+		return nil
+	}
+	netStore.GetPtCopyStatusFn = func(nodeID uint64, db string, pt uint32) (*netstorage.PtCopyStatus, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return &netstorage.PtCopyStatus{Db: db, Pt: pt, State: copyState}, nil
+	}
+	s.NetStore = netStore
+	m, _ := newTestDecommissionManager(s)
+	m.Start()
+
+	require.NoError(t, m.Control(1, DecommissionStart))
+	require.Eventually(t, func() bool {
+		info := s.getDecommissions()[1]
+		return info != nil && info.Pts[0].State == DecommissionPtCopying
+	}, 10*time.Second, 5*time.Millisecond)
+	require.NoError(t, m.Control(1, DecommissionPause))
+	waitDecommission(t, m, DecommissionPaused)
+
+	// the leader changes, the stopped decommission is not finished in the meta data
+	m.Stop()
+	info := s.getDecommissions()[1]
+	require.Equal(t, DecommissionPaused, info.State)
+	require.True(t, info.Paused)
+	require.Equal(t, meta.DecommissionPtInfo{Db: "db0", Pt: 0, Target: 2, State: DecommissionPtCopying}, info.Pts[0])
+	require.Equal(t, meta.DecommissionPtInfo{Db: "db0", Pt: 2, State: DecommissionPtPending}, info.Pts[1])
+
+	// the new leader resumes the paused decommission
+	m, moves := newTestDecommissionManager(s)
+	m.Start()
+	defer m.Stop()
+	status := waitDecommission(t, m, DecommissionPaused)
+	require.Equal(t, uint64(2), status.Pts[0].Target)
+
+	mu.Lock()
+	copyState = netstorage.PtCopyDone
+	mu.Unlock()
+	require.NoError(t, m.Control(1, DecommissionResume))
+	status = waitDecommission(t, m, DecommissionDone)
+	require.Equal(t, []string{"db0$0", "db0$2"}, *moves)
+	mu.Lock()
+	// the copy of db0$0 resumes on its previous target
+	require.Equal(t, []uint64{2, 2, 3}, targets)
+	mu.Unlock()
+	require.Equal(t, DecommissionDone, s.getDecommissions()[1].State)
+	require.Equal(t, status.EndTime.UnixNano(), s.getDecommissions()[1].EndTime)
+}
@@ -54,6 +54,11 @@ type IStore interface {
 	leadershipTransfer() error
 	SpecialCtlData(cmd string) error
 	ModifyRepDBMasterPt(db string, rgId uint32, newMasterPtId uint32) error
+	triggerRepair(opt RepairOptions) error
+	repairStatus() (*RepairStatus, error)
+	decommissionNode(nodeID uint64, action string) error
+	decommissionStatus() ([]DecommissionStatus, error)
+	loadBalancePlan() (*BalancePlan, error)
 }
 
 var httpScheme = map[bool]string{
@@ -116,6 +121,12 @@ func (h *httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			h.WrapHandler(h.serveAnalysisHeartInfo).ServeHTTP(w, r)
 		case "/debug/vars":
 			h.WrapHandler(h.serveExpvar).ServeHTTP(w, r)
+		case "/repair":
+			h.WrapHandler(h.serveRepairStatus).ServeHTTP(w, r)
+		case "/decommission":
+			h.WrapHandler(h.serveDecommissionStatus).ServeHTTP(w, r)
+		case "/balance/plan":
+			h.WrapHandler(h.serveBalancePlan).ServeHTTP(w, r)
 		}
 		h.logger.Info("serve get")
 	case "POST":
@@ -141,6 +152,12 @@ func (h *httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			h.WrapHandler(h.specialCtlData).ServeHTTP(w, r)
 		case "/modifyRepDBMasterPt":
 			h.WrapHandler(h.modifyRepDBMasterPt).ServeHTTP(w, r)
+		case "/repair":
+			h.logger.Info("serveRepair")
+			h.WrapHandler(h.serveRepair).ServeHTTP(w, r)
+		case "/decommission":
+			h.logger.Info("serveDecommission")
+			h.WrapHandler(h.serveDecommission).ServeHTTP(w, r)
 		}
 		h.logger.Info("serve post")
 	default:
@@ -549,3 +566,148 @@ func (h *httpHandler) modifyRepDBMasterPt(w http.ResponseWriter, r *http.Request
 	h.handleResponse(w, err)
 	h.logger.Info("modifyRepDBMasterPt", zap.String("db", db), zap.Uint64("rgId", rgId), zap.Uint64("newMasterPtId", newMasterPtId), zap.Error(err))
 }
+
+// curl -i -XPOST 'http://127.0.0.1:8091/repair?db=db0&rp=autogen&start=2024-01-01T00:00:00Z&end=2024-01-02T00:00:00Z&source=0&dryrun=true'
+// all the parameters are optional, the master pts are the sources of the repair if source is not set
+func (h *httpHandler) serveRepair(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	opt := RepairOptions{Db: q.Get("db"), Rp: q.Get("rp")}
+	for _, p := range []struct {
+		name string
+		dst  *int64
+	}{{"start", &opt.StartTime}, {"end", &opt.EndTime}} {
+		if v := q.Get(p.name); v != "" {
+			t, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("error parsing %s: %v", p.name, err), http.StatusBadRequest)
+				return
+			}
+			*p.dst = t.UnixNano()
+		}
+	}
+	if v := q.Get("source"); v != "" {
+		pt, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			http.Error(w, "error parsing source", http.StatusBadRequest)
+			return
+		}
+		sourcePt := uint32(pt)
+		opt.SourcePt = &sourcePt
+	}
+	if v := q.Get("dryrun"); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			http.Error(w, "error parsing dryrun", http.StatusBadRequest)
+			return
+		}
+		opt.DryRun = dryRun
+	}
+
+	err := h.store.triggerRepair(opt)
+	if errno.Equal(err, errno.MetaIsNotLeader) {
+		h.redirectToLeader(w, r)
+		return
+	}
+	h.handleResponse(w, err)
+	h.logger.Info("repair", zap.Any("options", opt), zap.Error(err))
+}
+
+// curl -i -XGET 'http://127.0.0.1:8091/repair'
+func (h *httpHandler) serveRepairStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := h.store.repairStatus()
+	if errno.Equal(err, errno.MetaIsNotLeader) {
+		h.redirectToLeader(w, r)
+		return
+	}
+	if err != nil {
+		h.httpErr(err, w, http.StatusInternalServerError)
+		return
+	}
+
+	b, err := json.Marshal(status)
+	if err != nil {
+		h.httpErr(err, w, http.StatusInternalServerError)
+		return
+	}
+	w.Header().Add("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+// curl -i -XPOST 'http://127.0.0.1:8091/decommission?node=4&action=start'
+// action is one of start, pause, resume and cancel
+func (h *httpHandler) serveDecommission(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	nodeID, err := strconv.ParseUint(q.Get("node"), 10, 64)
+	if err != nil {
+		http.Error(w, "error parsing node", http.StatusBadRequest)
+		return
+	}
+	action := q.Get("action")
+	switch action {
+	case DecommissionStart, DecommissionPause, DecommissionResume, DecommissionCancel:
+	default:
+		http.Error(w, fmt.Sprintf("invalid action %q", action), http.StatusBadRequest)
+		return
+	}
+
+	err = h.store.decommissionNode(nodeID, action)
+	if errno.Equal(err, errno.MetaIsNotLeader) {
+		h.redirectToLeader(w, r)
+		return
+	}
+	h.handleResponse(w, err)
+	h.logger.Info("decommission", zap.Uint64("node", nodeID), zap.String("action", action), zap.Error(err))
+}
+
+// curl -i -XGET 'http://127.0.0.1:8091/decommission'
+func (h *httpHandler) serveDecommissionStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := h.store.decommissionStatus()
+	if errno.Equal(err, errno.MetaIsNotLeader) {
+		h.redirectToLeader(w, r)
+		return
+	}
+	if err != nil {
+		h.httpErr(err, w, http.StatusInternalServerError)
+		return
+	}
+
+	b, err := json.Marshal(status)
+	if err != nil {
+		h.httpErr(err, w, http.StatusInternalServerError)
+		return
+	}
+	w.Header().Add("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+// curl -i -XGET 'http://127.0.0.1:8091/balance/plan'
+// the moves of the db pts planned by the load balancer, the plan is not executed
+func (h *httpHandler) serveBalancePlan(w http.ResponseWriter, r *http.Request) {
+	plan, err := h.store.loadBalancePlan()
+	if errno.Equal(err, errno.MetaIsNotLeader) {
+		h.redirectToLeader(w, r)
+		return
+	}
+	if err != nil {
+		h.httpErr(err, w, http.StatusInternalServerError)
+		return
+	}
+
+	b, err := json.Marshal(plan)
+	if err != nil {
+		h.httpErr(err, w, http.StatusInternalServerError)
+		return
+	}
+	w.Header().Add("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+func (h *httpHandler) redirectToLeader(w http.ResponseWriter, r *http.Request) {
+	l := h.store.leaderHTTP()
+	if l == "" {
+		h.httpErr(errors.New("no leader"), w, http.StatusServiceUnavailable)
+		return
+	}
+	url := fmt.Sprintf("%s://%s%s?%s", httpScheme[h.config.HTTPSEnabled], l, r.URL.Path, r.URL.RawQuery)
+	http.Redirect(w, r, url, http.StatusTemporaryRedirect)
+}
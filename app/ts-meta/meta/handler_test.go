@@ -19,6 +19,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -205,11 +206,77 @@ func (s *MockIStore) ModifyRepDBMasterPt(db string, rgId uint32, newMasterPtId u
 	return nil
 }
 
+func (s *MockIStore) triggerRepair(opt RepairOptions) error {
+	return nil
+}
+
+func (s *MockIStore) repairStatus() (*RepairStatus, error) {
+	return &RepairStatus{}, nil
+}
+
+func (s *MockIStore) decommissionNode(nodeID uint64, action string) error {
+	return nil
+}
+
+func (s *MockIStore) decommissionStatus() ([]DecommissionStatus, error) {
+	return nil, nil
+}
+
+func (s *MockIStore) loadBalancePlan() (*BalancePlan, error) {
+	return &BalancePlan{Moves: []*BalanceMove{{Db: "db0", Pt: 1, From: 1, To: 2}}}, nil
+}
+
 func TestServeExpandGroups(t *testing.T) {
 	handler := newHttpHandler(&config.Meta{}, &MockIStore{})
 	handler.serveExpandGroups(&MockResponseWriter{}, nil)
 }
 
+func TestServeRepair(t *testing.T) {
+	handler := newHttpHandler(&config.Meta{}, &MockIStore{})
+	for url, code := range map[string]int{
+		"/repair?db=db0&start=2024-01-01T00:00:00Z&end=2024-01-02T00:00:00Z&source=1&dryrun=true": http.StatusOK,
+		"/repair?start=yesterday": http.StatusBadRequest,
+		"/repair?source=-1":       http.StatusBadRequest,
+		"/repair?dryrun=maybe":    http.StatusBadRequest,
+	} {
+		w := httptest.NewRecorder()
+		handler.serveRepair(w, httptest.NewRequest(http.MethodPost, url, nil))
+		assert.Equal(t, code, w.Code, url)
+	}
+
+	w := httptest.NewRecorder()
+	handler.serveRepairStatus(w, httptest.NewRequest(http.MethodGet, "/repair", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"running":false`)
+}
+
+func TestServeDecommission(t *testing.T) {
+	handler := newHttpHandler(&config.Meta{}, &MockIStore{})
+	for url, code := range map[string]int{
+		"/decommission?node=4&action=start":  http.StatusOK,
+		"/decommission?node=4&action=cancel": http.StatusOK,
+		"/decommission?action=start":         http.StatusBadRequest,
+		"/decommission?node=4&action=drop":   http.StatusBadRequest,
+	} {
+		w := httptest.NewRecorder()
+		handler.serveDecommission(w, httptest.NewRequest(http.MethodPost, url, nil))
+		assert.Equal(t, code, w.Code, url)
+	}
+
+	w := httptest.NewRecorder()
+	handler.serveDecommissionStatus(w, httptest.NewRequest(http.MethodGet, "/decommission", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "null", w.Body.String())
+}
+
+func TestServeBalancePlan(t *testing.T) {
+	handler := newHttpHandler(&config.Meta{}, &MockIStore{})
+	w := httptest.NewRecorder()
+	handler.serveBalancePlan(w, httptest.NewRequest(http.MethodGet, "/balance/plan", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"moves":[{"db":"db0","pt":1,"from":1,"to":2,"size":0,"load":0}]`)
+}
+
 func TestGetDBBriefInfo_FromStore(t *testing.T) {
 	dir := t.TempDir()
 	mms, err := NewMockMetaService(dir, testIp)
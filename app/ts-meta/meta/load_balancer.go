@@ -0,0 +1,405 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// PtLoadStat is the load of a db pt measured by the load balancer. IngestRate is the rows written per second,
+// QueryRate is the seconds of queries per second. Load is the weighted share of the db pt of the cluster load
+type PtLoadStat struct {
+	Db         string  `json:"db"`
+	Pt         uint32  `json:"pt"`
+	NodeID     uint64  `json:"nodeId"`
+	Size       int64   `json:"size"`
+	IngestRate float64 `json:"ingestRate"`
+	QueryRate  float64 `json:"queryRate"`
+	Load       float64 `json:"load"`
+}
+
+type NodeLoadStat struct {
+	NodeID     uint64  `json:"nodeId"`
+	Pts        int     `json:"pts"`
+	Size       int64   `json:"size"`
+	IngestRate float64 `json:"ingestRate"`
+	QueryRate  float64 `json:"queryRate"`
+	Load       float64 `json:"load"`
+}
+
+func (n *NodeLoadStat) add(pt *PtLoadStat, sign int) {
+	n.Pts += sign
+	n.Size += int64(sign) * pt.Size
+	n.IngestRate += float64(sign) * pt.IngestRate
+	n.QueryRate += float64(sign) * pt.QueryRate
+	n.Load += float64(sign) * pt.Load
+}
+
+type BalanceMove struct {
+	Db   string  `json:"db"`
+	Pt   uint32  `json:"pt"`
+	From uint64  `json:"from"`
+	To   uint64  `json:"to"`
+	Size int64   `json:"size"`
+	Load float64 `json:"load"`
+}
+
+// BalancePlan is the moves of the db pts planned by the load balancer. Nodes are the loads before the moves,
+// the imbalance of the loads is (max node load - min node load) / mean node load
+type BalancePlan struct {
+	Time             time.Time       `json:"time"`
+	Nodes            []*NodeLoadStat `json:"nodes"`
+	Pts              []*PtLoadStat   `json:"pts"`
+	Moves            []*BalanceMove  `json:"moves"`
+	Imbalance        float64         `json:"imbalance"`
+	PlannedImbalance float64         `json:"plannedImbalance"`
+	Reason           string          `json:"reason,omitempty"`
+}
+
+// ptLoadSample is the loads reported by a node, the rates of the db pts are measured between two samples
+type ptLoadSample struct {
+	time  time.Time
+	loads map[string]*netstorage.PtLoad
+}
+
+// LoadBalancer plans the moves of the db pts by their sizes, ingest rates and query times
+type LoadBalancer struct {
+	conf  config.LoadBalanceConfig
+	store *Store
+
+	mu      sync.Mutex
+	samples map[uint64]*ptLoadSample
+}
+
+func NewLoadBalancer(conf config.LoadBalanceConfig, store *Store) *LoadBalancer {
+	return &LoadBalancer{
+		conf:    conf,
+		store:   store,
+		samples: make(map[uint64]*ptLoadSample),
+	}
+}
+
+// Plan measures the loads of the db pts and plans the moves which reduce the imbalance of the node loads.
+// The loads are measured since the last plan, Plan waits for sample-interval if there is no older sample
+func (b *LoadBalancer) Plan() (*BalancePlan, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	nodes := b.store.loadBalanceNodes()
+	if len(nodes) == 0 {
+		return nil, errors.New("no alive node to balance")
+	}
+	pts, err := b.measure(nodes)
+	if err != nil {
+		return nil, err
+	}
+	return planLoadBalance(b.conf, nodes, pts), nil
+}
+
+func (b *LoadBalancer) measure(nodes []uint64) ([]*PtLoadStat, error) {
+	interval := time.Duration(b.conf.SampleInterval)
+	for waited := false; ; waited = true {
+		now := time.Now()
+		samples := make(map[uint64]*ptLoadSample, len(nodes))
+		ready := true
+		for _, id := range nodes {
+			loads, err := b.store.NetStore.GetPtLoads(id)
+			if err != nil {
+				return nil, fmt.Errorf("get the loads of node %d failed: %v", id, err)
+			}
+			sample := &ptLoadSample{time: now, loads: make(map[string]*netstorage.PtLoad, len(loads))}
+			for _, load := range loads {
+				sample.loads[ptLoadKey(load.Db, load.Pt)] = load
+			}
+			samples[id] = sample
+			if prev := b.samples[id]; prev == nil || now.Sub(prev.time) < interval {
+				ready = false
+			}
+		}
+
+		if !ready && !waited {
+			for id, sample := range samples {
+				if b.samples[id] == nil {
+					b.samples[id] = sample
+				}
+			}
+			time.Sleep(interval)
+			continue
+		}
+
+		var pts []*PtLoadStat
+		for _, id := range nodes {
+			pts = append(pts, b.store.ownedPtLoads(id, b.samples[id], samples[id])...)
+			b.samples[id] = samples[id]
+		}
+		return pts, nil
+	}
+}
+
+func ptLoadKey(db string, pt uint32) string {
+	return fmt.Sprintf("%s$%d", db, pt)
+}
+
+// ptLoadRate returns the increase of the counter per second, the counter restarts from zero
+// when the db pt is loaded again, it is not in the previous sample if the db pt is moved to the node
+func ptLoadRate(cur, prev int64, hasPrev bool, seconds float64) float64 {
+	delta := cur
+	if hasPrev && cur >= prev {
+		delta = cur - prev
+	}
+	if seconds <= 0 {
+		return 0
+	}
+	return float64(delta) / seconds
+}
+
+// planLoadBalance moves the db pt which best evens the most and the least loaded nodes out,
+// until the imbalance is below the threshold or the move budget is used up
+func planLoadBalance(conf config.LoadBalanceConfig, nodes []uint64, pts []*PtLoadStat) *BalancePlan {
+	plan := &BalancePlan{Time: time.Now(), Pts: pts, Moves: []*BalanceMove{}}
+	weighPtLoads(conf, pts)
+
+	nodeStats := make(map[uint64]*NodeLoadStat, len(nodes))
+	for _, id := range nodes {
+		nodeStats[id] = &NodeLoadStat{NodeID: id}
+	}
+	for _, pt := range pts {
+		nodeStats[pt.NodeID].add(pt, 1)
+	}
+	for _, id := range nodes {
+		n := *nodeStats[id]
+		plan.Nodes = append(plan.Nodes, &n)
+	}
+	plan.Imbalance = loadImbalance(nodes, nodeStats)
+	plan.PlannedImbalance = plan.Imbalance
+	if plan.Imbalance <= conf.ImbalanceThreshold {
+		plan.Reason = fmt.Sprintf("imbalance %.3f is not above the threshold %.3f", plan.Imbalance, conf.ImbalanceThreshold)
+		return plan
+	}
+
+	sorted := make([]*PtLoadStat, len(pts))
+	copy(sorted, pts)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Load != sorted[j].Load {
+			return sorted[i].Load > sorted[j].Load
+		}
+		if sorted[i].Db != sorted[j].Db {
+			return sorted[i].Db < sorted[j].Db
+		}
+		return sorted[i].Pt < sorted[j].Pt
+	})
+	moved := make(map[*PtLoadStat]bool)
+	var movedSize int64
+	for len(plan.Moves) < conf.MaxMoves && plan.PlannedImbalance > conf.ImbalanceThreshold {
+		high, low := nodeStats[nodes[0]], nodeStats[nodes[0]]
+		for _, id := range nodes {
+			if nodeStats[id].Load > high.Load {
+				high = nodeStats[id]
+			}
+			if nodeStats[id].Load < low.Load {
+				low = nodeStats[id]
+			}
+		}
+		gap := high.Load - low.Load
+		var best *PtLoadStat
+		bestGap := gap
+		for _, pt := range sorted {
+			if pt.NodeID != high.NodeID || moved[pt] || pt.Load <= 0 {
+				continue
+			}
+			if conf.MaxMoveSize > 0 && movedSize+pt.Size > int64(conf.MaxMoveSize) {
+				continue
+			}
+			if g := math.Abs(gap - 2*pt.Load); g < bestGap {
+				best, bestGap = pt, g
+			}
+		}
+		if best == nil {
+			break
+		}
+
+		plan.Moves = append(plan.Moves, &BalanceMove{Db: best.Db, Pt: best.Pt, From: high.NodeID, To: low.NodeID, Size: best.Size, Load: best.Load})
+		high.add(best, -1)
+		low.add(best, 1)
+		moved[best] = true
+		movedSize += best.Size
+		plan.PlannedImbalance = loadImbalance(nodes, nodeStats)
+	}
+	if len(plan.Moves) == 0 {
+		plan.Reason = "no db pt move reduces the imbalance within the move budget"
+	}
+	return plan
+}
+
+// weighPtLoads sets the load of the db pts to the weighted sum of their shares of the size, the ingest rate
+// and the query time of the cluster, the dimensions without any load are ignored
+func weighPtLoads(conf config.LoadBalanceConfig, pts []*PtLoadStat) {
+	var size, ingest, query float64
+	for _, pt := range pts {
+		size += float64(pt.Size)
+		ingest += pt.IngestRate
+		query += pt.QueryRate
+	}
+	dims := []struct {
+		weight, total float64
+		value         func(pt *PtLoadStat) float64
+	}{
+		{conf.SizeWeight, size, func(pt *PtLoadStat) float64 { return float64(pt.Size) }},
+		{conf.IngestWeight, ingest, func(pt *PtLoadStat) float64 { return pt.IngestRate }},
+		{conf.QueryWeight, query, func(pt *PtLoadStat) float64 { return pt.QueryRate }},
+	}
+	var weights float64
+	for _, d := range dims {
+		if d.total > 0 {
+			weights += d.weight
+		}
+	}
+	if weights == 0 {
+		return
+	}
+	for _, pt := range pts {
+		pt.Load = 0
+		for _, d := range dims {
+			if d.total > 0 {
+				pt.Load += d.weight * d.value(pt) / d.total
+			}
+		}
+		pt.Load /= weights
+	}
+}
+
+func loadImbalance(nodes []uint64, nodeStats map[uint64]*NodeLoadStat) float64 {
+	minLoad, maxLoad, sum := math.MaxFloat64, 0.0, 0.0
+	for _, id := range nodes {
+		load := nodeStats[id].Load
+		minLoad = math.Min(minLoad, load)
+		maxLoad = math.Max(maxLoad, load)
+		sum += load
+	}
+	if sum <= 0 {
+		return 0
+	}
+	return (maxLoad - minLoad) / (sum / float64(len(nodes)))
+}
+
+// loadBalanceNodes returns the normal alive nodes sorted by id
+func (s *Store) loadBalanceNodes() []uint64 {
+	var nodes []uint64
+	for id := range *s.getDbPtNumPerAliveNode() {
+		nodes = append(nodes, id)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i] < nodes[j]
+	})
+	return nodes
+}
+
+// ownedPtLoads returns the loads of the online db pts owned by the node, the db pts which cannot be moved are skipped
+func (s *Store) ownedPtLoads(nodeID uint64, prev, cur *ptLoadSample) []*PtLoadStat {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	seconds := cur.time.Sub(prev.time).Seconds()
+	var pts []*PtLoadStat
+	for key, load := range cur.loads {
+		if s.data.CheckCanMoveDb(load.Db) != nil || int(load.Pt) >= len(s.data.PtView[load.Db]) {
+			continue
+		}
+		pti := s.data.PtView[load.Db][load.Pt]
+		if pti.Owner.NodeID != nodeID || pti.Status != meta.Online {
+			continue
+		}
+		prevLoad, ok := prev.loads[key]
+		if !ok {
+			prevLoad = &netstorage.PtLoad{}
+		}
+		pts = append(pts, &PtLoadStat{
+			Db:         load.Db,
+			Pt:         load.Pt,
+			NodeID:     nodeID,
+			Size:       load.Size,
+			IngestRate: ptLoadRate(load.Rows, prevLoad.Rows, ok, seconds),
+			QueryRate:  ptLoadRate(load.QueryTimeNs, prevLoad.QueryTimeNs, ok, seconds) / float64(time.Second),
+		})
+	}
+	sort.Slice(pts, func(i, j int) bool {
+		if pts[i].Db != pts[j].Db {
+			return pts[i].Db < pts[j].Db
+		}
+		return pts[i].Pt < pts[j].Pt
+	})
+	return pts
+}
+
+func (s *Store) isBalancerEnabled() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.BalancerEnabled
+}
+
+// loadBalanceMovePt moves the db pt by the balance plan, the db pt is skipped if it is changed after the plan
+func (s *Store) loadBalanceMovePt(mv *BalanceMove) error {
+	event, err := s.genLoadBalanceMoveEvent(mv)
+	if err != nil {
+		return err
+	}
+	return globalService.msm.executeEvent(event)
+}
+
+func (s *Store) genLoadBalanceMoveEvent(mv *BalanceMove) (MigrateEvent, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if int(mv.Pt) >= len(s.data.PtView[mv.Db]) {
+		return nil, errno.NewError(errno.PtNotFound)
+	}
+	pti := s.data.PtView[mv.Db][mv.Pt]
+	if pti.Owner.NodeID != mv.From || pti.Status != meta.Online {
+		return nil, fmt.Errorf("db pt %s$%d is changed after the balance plan", mv.Db, mv.Pt)
+	}
+	dn := s.data.DataNode(mv.To)
+	if dn == nil {
+		return nil, errno.NewError(errno.DataNodeNotFound)
+	}
+	if dn.Status != serf.StatusAlive || dn.SegregateStatus != meta.Normal {
+		return nil, fmt.Errorf("node %d is not available for the balance", mv.To)
+	}
+	if err := s.data.CheckRGPlacement(mv.Db, mv.Pt, mv.To); err != nil {
+		return nil, err
+	}
+	return NewMoveEvent(&meta.DbPtInfo{Db: mv.Db, Pti: &pti, Shards: s.data.GetShardDurationsByDbPt(mv.Db, mv.Pt),
+		DBBriefInfo: s.data.GetDBBriefInfo(mv.Db)}, mv.From, mv.To, dn.AliveConnID, false), nil
+}
+
+func (s *Store) loadBalancePlan() (*BalancePlan, error) {
+	if !s.IsLeader() {
+		return nil, errno.NewError(errno.MetaIsNotLeader)
+	}
+	if globalService == nil || globalService.loadBalancer == nil {
+		return nil, errors.New("load balancer is not started")
+	}
+	return globalService.loadBalancer.Plan()
+}
@@ -0,0 +1,152 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+func newLoadBalanceTestPts() []*PtLoadStat {
+	return []*PtLoadStat{
+		{Db: "db0", Pt: 0, NodeID: 1, Size: 16},
+		{Db: "db0", Pt: 1, NodeID: 1, Size: 16},
+		{Db: "db0", Pt: 2, NodeID: 1, Size: 8},
+		{Db: "db0", Pt: 3, NodeID: 1, Size: 8},
+		{Db: "db0", Pt: 4, NodeID: 2, Size: 8},
+		{Db: "db0", Pt: 5, NodeID: 3, Size: 8},
+	}
+}
+
+func TestPlanLoadBalance(t *testing.T) {
+	conf := config.NewLoadBalanceConfig()
+	nodes := []uint64{1, 2, 3}
+
+	plan := planLoadBalance(conf, nodes, newLoadBalanceTestPts())
+	require.Empty(t, plan.Reason)
+	require.Equal(t, []*BalanceMove{
+		{Db: "db0", Pt: 0, From: 1, To: 2, Size: 16, Load: 0.25},
+		{Db: "db0", Pt: 1, From: 1, To: 3, Size: 16, Load: 0.25},
+	}, plan.Moves)
+	require.InDelta(t, 1.875, plan.Imbalance, 1e-9)
+	require.InDelta(t, 0.375, plan.PlannedImbalance, 1e-9)
+	// the node loads are the loads before the moves
+	require.Equal(t, &NodeLoadStat{NodeID: 1, Pts: 4, Size: 48, Load: 0.75}, plan.Nodes[0])
+
+	// the moves are limited by the budget
+	conf.MaxMoves = 1
+	plan = planLoadBalance(conf, nodes, newLoadBalanceTestPts())
+	require.Equal(t, 1, len(plan.Moves))
+	conf.MaxMoves = 3
+	conf.MaxMoveSize = 20
+	plan = planLoadBalance(conf, nodes, newLoadBalanceTestPts())
+	require.Equal(t, 1, len(plan.Moves))
+	conf.MaxMoveSize = 4
+	plan = planLoadBalance(conf, nodes, newLoadBalanceTestPts())
+	require.Empty(t, plan.Moves)
+	require.Equal(t, "no db pt move reduces the imbalance within the move budget", plan.Reason)
+
+	conf = config.NewLoadBalanceConfig()
+	conf.ImbalanceThreshold = 2
+	plan = planLoadBalance(conf, nodes, newLoadBalanceTestPts())
+	require.Empty(t, plan.Moves)
+	require.Equal(t, "imbalance 1.875 is not above the threshold 2.000", plan.Reason)
+}
+
+func TestWeighPtLoads(t *testing.T) {
+	conf := config.NewLoadBalanceConfig()
+	pts := []*PtLoadStat{{Size: 3}, {Size: 1, IngestRate: 10}}
+	// the query time is ignored without any query
+	weighPtLoads(conf, pts)
+	require.InDelta(t, 0.375, pts[0].Load, 1e-9)
+	require.InDelta(t, 0.625, pts[1].Load, 1e-9)
+
+	conf.IngestWeight = 0
+	weighPtLoads(conf, pts)
+	require.InDelta(t, 0.75, pts[0].Load, 1e-9)
+
+	require.Equal(t, 2.0, ptLoadRate(30, 10, true, 10))
+	// the counter restarts after the db pt is loaded again
+	require.Equal(t, 0.5, ptLoadRate(5, 10, true, 10))
+	require.Equal(t, 1.0, ptLoadRate(10, 0, false, 10))
+}
+
+func TestLoadBalancer_Plan(t *testing.T) {
+	s := &Store{
+		raft: &MockRaftForSG{isLeader: true},
+		data: &meta.Data{
+			Databases: map[string]*meta.DatabaseInfo{"db0": {Name: "db0"}},
+			DataNodes: []meta.DataNode{
+				{NodeInfo: meta.NodeInfo{ID: 1, Status: serf.StatusAlive}, ConnID: 1, AliveConnID: 1},
+				{NodeInfo: meta.NodeInfo{ID: 2, Status: serf.StatusAlive}, ConnID: 2, AliveConnID: 2},
+			},
+			PtView: map[string]meta.DBPtInfos{
+				"db0": {
+					{PtId: 0, Owner: meta.PtOwner{NodeID: 1}, Status: meta.Online},
+					{PtId: 1, Owner: meta.PtOwner{NodeID: 1}, Status: meta.Online},
+					{PtId: 2, Owner: meta.PtOwner{NodeID: 2}, Status: meta.Online},
+					{PtId: 3, Owner: meta.PtOwner{NodeID: 2}, Status: meta.Offline},
+				},
+			},
+		},
+	}
+	netStore := NewMockNetStorage()
+	calls := map[uint64]int64{}
+	netStore.GetPtLoadsFn = func(nodeID uint64) ([]*netstorage.PtLoad, error) {
+		calls[nodeID]++
+		if nodeID == 1 {
+			return []*netstorage.PtLoad{
+				{Db: "db0", Pt: 0, Size: 10, Rows: 100 * calls[nodeID], QueryTimeNs: int64(time.Second) * calls[nodeID]},
+				{Db: "db0", Pt: 1, Size: 10},
+			}, nil
+		}
+		// the offline db pt is not moved
+		return []*netstorage.PtLoad{{Db: "db0", Pt: 2, Size: 10}, {Db: "db0", Pt: 3, Size: 100}}, nil
+	}
+	s.NetStore = netStore
+	conf := config.NewLoadBalanceConfig()
+	conf.SampleInterval = toml.Duration(10 * time.Millisecond)
+	b := NewLoadBalancer(conf, s)
+
+	// the loads are sampled twice to measure the rates
+	plan, err := b.Plan()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), calls[1])
+	require.Equal(t, 3, len(plan.Pts))
+	require.Greater(t, plan.Pts[0].IngestRate, 0.0)
+	require.Greater(t, plan.Pts[0].QueryRate, 0.0)
+	require.Zero(t, plan.Pts[1].IngestRate)
+	// the hot db pt is not moved, it would make the other node the hot one
+	require.Equal(t, []*BalanceMove{{Db: "db0", Pt: 1, From: 1, To: 2, Size: 10, Load: plan.Pts[1].Load}}, plan.Moves)
+
+	// the next plan measures the loads since the last plan
+	time.Sleep(time.Duration(conf.SampleInterval))
+	_, err = b.Plan()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), calls[1])
+
+	event, err := s.genLoadBalanceMoveEvent(plan.Moves[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), event.(*MoveEvent).dst)
+	_, err = s.genLoadBalanceMoveEvent(&BalanceMove{Db: "db0", Pt: 2, From: 1, To: 2})
+	require.EqualError(t, err, "db pt db0$2 is changed after the balance plan")
+}
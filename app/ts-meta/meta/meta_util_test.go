@@ -568,6 +568,12 @@ type MockNetStorage struct {
 	DeleteRetentionPolicyFn func(node *meta2.DataNode, db string, rp string, ptId uint32) error
 	DeleteMeasurementFn     func(node *meta2.DataNode, db string, rp, name string, shardIds []uint64) error
 	MigratePtFn             func(nodeID uint64, data transport.Codec, cb transport.Callback) error
+	GetRepairDigestsFn      func(nodeID uint64, req *netstorage.RepairDigestRequest) ([]*netstorage.RepairDigest, error)
+	RepairSeriesFn          func(nodeID uint64, req *netstorage.RepairSeriesRequest) (*netstorage.RepairSeriesResult, error)
+	StartPtCopyFn           func(nodeID uint64, req *netstorage.PtCopyRequest) error
+	GetPtCopyStatusFn       func(nodeID uint64, db string, pt uint32) (*netstorage.PtCopyStatus, error)
+	CancelPtCopyFn          func(nodeID uint64, db string, pt uint32, clean bool) error
+	GetPtLoadsFn            func(nodeID uint64) ([]*netstorage.PtLoad, error)
 }
 
 func (s *MockNetStorage) GetShardSplitPoints(node *meta2.DataNode, database string, pt uint32,
@@ -602,6 +608,52 @@ func (s *MockNetStorage) TransferLeadership(database string, nodeId uint64, oldM
 	return nil
 }
 
+func (s *MockNetStorage) GetRepairDigests(nodeID uint64, req *netstorage.RepairDigestRequest) ([]*netstorage.RepairDigest, error) {
+	if s.GetRepairDigestsFn == nil {
+		return nil, nil
+	}
+	return s.GetRepairDigestsFn(nodeID, req)
+}
+
+func (s *MockNetStorage) RepairSeries(nodeID uint64, req *netstorage.RepairSeriesRequest) (*netstorage.RepairSeriesResult, error) {
+	if s.RepairSeriesFn == nil {
+		return &netstorage.RepairSeriesResult{}, nil
+	}
+	return s.RepairSeriesFn(nodeID, req)
+}
+
+func (s *MockNetStorage) StartPtCopy(nodeID uint64, req *netstorage.PtCopyRequest) error {
+	if s.StartPtCopyFn == nil {
+		return nil
+	}
+	return s.StartPtCopyFn(nodeID, req)
+}
+
+func (s *MockNetStorage) GetPtCopyStatus(nodeID uint64, db string, pt uint32) (*netstorage.PtCopyStatus, error) {
+	if s.GetPtCopyStatusFn == nil {
+		return &netstorage.PtCopyStatus{Db: db, Pt: pt, State: netstorage.PtCopyDone}, nil
+	}
+	return s.GetPtCopyStatusFn(nodeID, db, pt)
+}
+
+func (s *MockNetStorage) CancelPtCopy(nodeID uint64, db string, pt uint32, clean bool) error {
+	if s.CancelPtCopyFn == nil {
+		return nil
+	}
+	return s.CancelPtCopyFn(nodeID, db, pt, clean)
+}
+
+func (s *MockNetStorage) GetPtLoads(nodeID uint64) ([]*netstorage.PtLoad, error) {
+	if s.GetPtLoadsFn == nil {
+		return nil, nil
+	}
+	return s.GetPtLoadsFn(nodeID)
+}
+
+func (s *MockNetStorage) GetMeasurementStats(nodeID uint64, req *netstorage.MeasurementStatsRequest) (*netstorage.MeasurementStats, error) {
+	return nil, nil
+}
+
 func NewMockNetStorage() *MockNetStorage {
 	netStore := &MockNetStorage{}
 	netStore.DeleteDatabaseFn = func(node *meta2.DataNode, database string, ptId uint32) error {
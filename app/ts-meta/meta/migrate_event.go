@@ -72,6 +72,7 @@ const (
 	AssignType EventType = iota
 	OffloadType
 	MoveType
+	DecommissionMoveType
 )
 
 func (t EventType) String() string {
@@ -82,6 +83,8 @@ func (t EventType) String() string {
 		return "offload_event"
 	case MoveType:
 		return "move_event"
+	case DecommissionMoveType:
+		return "decommission_move_event"
 	}
 	return "unknown event type"
 }
@@ -123,6 +123,8 @@ func (m *MigrateStateMachine) createEventFromInfo(e *meta.MigrateEventInfo) Migr
 		me = NewAssignEvent(e.GetPtInfo(), e.GetDst(), e.GetAliveConnId(), false)
 	case MoveType:
 		me = NewMoveEvent(e.GetPtInfo(), e.GetSrc(), e.GetDst(), e.GetAliveConnId(), false)
+	case DecommissionMoveType:
+		me = NewDecommissionMoveEvent(e.GetPtInfo(), e.GetSrc(), e.GetDst(), e.GetAliveConnId(), false)
 	default:
 
 	}
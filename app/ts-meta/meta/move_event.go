@@ -34,6 +34,10 @@ type MoveEvent struct {
 	curState      meta.MoveState
 	preState      meta.MoveState
 	rollbackState meta.MoveState
+
+	// decommission moves the db pt from a node being decommissioned, the files of the db pt are copied
+	// to the dst node before the move, the rest of them are copied after the db pt is offloaded
+	decommission bool
 }
 
 func NewMoveEvent(pt *meta.DbPtInfo, src, dst uint64, aliveConnId uint64, isUserCommand bool) *MoveEvent {
@@ -57,6 +61,13 @@ func NewMoveEvent(pt *meta.DbPtInfo, src, dst uint64, aliveConnId uint64, isUser
 	return e
 }
 
+func NewDecommissionMoveEvent(pt *meta.DbPtInfo, src, dst uint64, aliveConnId uint64, isUserCommand bool) *MoveEvent {
+	e := NewMoveEvent(pt, src, dst, aliveConnId, isUserCommand)
+	e.eventType = DecommissionMoveType
+	e.decommission = true
+	return e
+}
+
 func (e *MoveEvent) marshalEvent() *mproto.MigrateEventInfo {
 	return &mproto.MigrateEventInfo{
 		EventId:       proto.String(e.eventId),
@@ -257,6 +268,11 @@ func moveRollbackPreOffloadHander(e *MoveEvent) (NextAction, error) {
 }
 
 func movePreAssignHandler(e *MoveEvent) (NextAction, error) {
+	if e.decommission {
+		// the files of the db pt are not all on dst yet, there is nothing to preload
+		e.stateTransition(nil)
+		return ActionContinue, nil
+	}
 	// shard's downSample attr may be updated, need refresh
 	globalService.store.refreshShards(e)
 	return globalService.msm.sendMigrateCommand(e)
@@ -280,11 +296,30 @@ func moveOffloadedHandler(e *MoveEvent) (NextAction, error) {
 		return ActionContinue, err
 	}
 	e.pt.Pti.Status = meta.Offline
+	if e.decommission && e.dst != e.src {
+		err = globalService.store.finalCopyPt(e.pt.Db, e.pt.Pti.PtId, e.src, e.dst)
+		if errno.Equal(err, errno.StateMachineIsNotRunning) {
+			return ActionContinue, err
+		}
+		if err != nil {
+			// assign the db pt back to src, the decommission of src fails
+			logger.GetLogger().Error("final copy of db pt failed, move it back", zap.String("event", e.String()), zap.Error(err))
+			e.dst = e.src
+		}
+	}
 	e.stateTransition(nil)
 	return ActionContinue, nil
 }
 
 func moveAssignHandler(e *MoveEvent) (NextAction, error) {
+	if e.decommission {
+		// dst may be changed back to src by a failed final copy
+		aliveConnId, err := globalService.store.getDataNodeAliveConnId(e.dst)
+		if err != nil {
+			return ActionContinue, err
+		}
+		e.aliveConnId = aliveConnId
+	}
 	err := globalService.store.updatePtInfo(e.pt.Db, e.pt.Pti, e.dst, e.pt.Pti.Status)
 	if errno.Equal(err, errno.PtChanged) {
 		globalService.store.refreshDbPt(e.pt)
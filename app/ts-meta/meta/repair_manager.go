@@ -0,0 +1,453 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+const (
+	RepairTriggerScheduled = "scheduled"
+	RepairTriggerManual    = "manual"
+
+	maxRepairStatusErrors = 100
+)
+
+var errRepairRunning = errors.New("a round of repair is already running")
+
+// RepairOptions limits a round of repair to the databases, the retention policy and the time range.
+// The master pt of each replica group is the source of the repair unless SourcePt is set
+type RepairOptions struct {
+	Db        string  `json:"db,omitempty"`
+	Rp        string  `json:"rp,omitempty"`
+	StartTime int64   `json:"startTime"`
+	EndTime   int64   `json:"endTime"`
+	SourcePt  *uint32 `json:"sourcePt,omitempty"`
+	DryRun    bool    `json:"dryRun"`
+}
+
+// RepairStatus is the progress of the running round of repair, or the result of the last round
+type RepairStatus struct {
+	Running         bool          `json:"running"`
+	Trigger         string        `json:"trigger,omitempty"`
+	Options         RepairOptions `json:"options"`
+	StartTime       time.Time     `json:"startTime"`
+	EndTime         time.Time     `json:"endTime"`
+	Tasks           int           `json:"tasks"`
+	CheckedWindows  int           `json:"checkedWindows"`
+	DivergedWindows int           `json:"divergedWindows"`
+	RepairedSeries  int           `json:"repairedSeries"`
+	RepairedRows    int64         `json:"repairedRows"`
+	Errors          []string      `json:"errors,omitempty"`
+}
+
+type repairReplica struct {
+	pt     uint32
+	nodeID uint64
+}
+
+// repairTask compares the replicas of a replica group within the time range of a shard group,
+// the rows missing or different on the replicas are re-written from the source
+type repairTask struct {
+	db       string
+	rp       string
+	tr       util.TimeRange
+	source   repairReplica
+	replicas []repairReplica
+}
+
+// RepairManager runs the anti-entropy repair between the replicas of the replicated databases.
+// The replicas are compared by the digests of the time windows, only the series of the diverged windows
+// are compared one by one and repaired. It runs on the leader only.
+type RepairManager struct {
+	conf  config.RepairConfig
+	store *Store
+
+	wg      sync.WaitGroup
+	stopped int32
+	closing chan struct{}
+
+	mu      sync.Mutex
+	running bool
+	status  RepairStatus
+}
+
+func NewRepairManager(conf config.RepairConfig, store *Store) *RepairManager {
+	return &RepairManager{
+		conf:    conf,
+		store:   store,
+		stopped: 1,
+	}
+}
+
+// Start the scheduled rounds of repair if run-interval is set
+func (m *RepairManager) Start() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !atomic.CompareAndSwapInt32(&m.stopped, 1, 0) {
+		return
+	}
+	m.closing = make(chan struct{})
+	if m.conf.RunInterval > 0 {
+		m.wg.Add(1)
+		go m.schedule(m.closing)
+	}
+}
+
+// Stop the scheduled rounds and wait for the running round to abort
+func (m *RepairManager) Stop() {
+	m.mu.Lock()
+	if !atomic.CompareAndSwapInt32(&m.stopped, 0, 1) {
+		m.mu.Unlock()
+		return
+	}
+	close(m.closing)
+	m.mu.Unlock()
+	m.wg.Wait()
+}
+
+func (m *RepairManager) schedule(closing chan struct{}) {
+	defer m.wg.Done()
+	ticker := time.NewTicker(time.Duration(m.conf.RunInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-closing:
+			return
+		case <-ticker.C:
+			now := time.Now()
+			opt := RepairOptions{
+				StartTime: now.Add(-time.Duration(m.conf.Lookback)).UnixNano(),
+				EndTime:   now.Add(-time.Duration(m.conf.MinAge)).UnixNano(),
+			}
+			if err := m.trigger(RepairTriggerScheduled, opt); err != nil {
+				logger.GetLogger().Info("[repair] skip the scheduled round", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Trigger a round of repair, the round runs in the background and its progress is reported by Status
+func (m *RepairManager) Trigger(opt RepairOptions) error {
+	if opt.EndTime == 0 {
+		opt.EndTime = time.Now().Add(-time.Duration(m.conf.MinAge)).UnixNano()
+	}
+	if opt.StartTime == 0 {
+		opt.StartTime = opt.EndTime - int64(m.conf.Lookback)
+	}
+	if opt.StartTime > opt.EndTime {
+		return fmt.Errorf("invalid time range of repair: [%d, %d]", opt.StartTime, opt.EndTime)
+	}
+	return m.trigger(RepairTriggerManual, opt)
+}
+
+func (m *RepairManager) trigger(trigger string, opt RepairOptions) error {
+	if config.GetHaPolicy() != config.Replication {
+		return errors.New("ha-policy is not replication")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if atomic.LoadInt32(&m.stopped) == 1 {
+		return errno.NewError(errno.MetaIsNotLeader)
+	}
+	if m.running {
+		return errRepairRunning
+	}
+	m.running = true
+	m.status = RepairStatus{Running: true, Trigger: trigger, Options: opt, StartTime: time.Now()}
+
+	m.wg.Add(1)
+	go m.run(opt)
+	return nil
+}
+
+func (m *RepairManager) Status() RepairStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	status := m.status
+	status.Errors = append([]string(nil), m.status.Errors...)
+	return status
+}
+
+func (m *RepairManager) run(opt RepairOptions) {
+	defer m.wg.Done()
+	tasks := m.store.selectRepairTasks(opt)
+	m.update(func(s *RepairStatus) { s.Tasks = len(tasks) })
+	logger.GetLogger().Info("[repair] round start", zap.Any("options", opt), zap.Int("tasks", len(tasks)))
+
+	for _, task := range tasks {
+		if atomic.LoadInt32(&m.stopped) == 1 {
+			m.addError(errors.New("repair aborted, the node is not the leader any more"))
+			break
+		}
+		for _, replica := range task.replicas {
+			m.repairReplica(task, replica, opt.DryRun)
+		}
+	}
+
+	m.mu.Lock()
+	m.running = false
+	m.status.Running = false
+	m.status.EndTime = time.Now()
+	status := m.status
+	m.mu.Unlock()
+	logger.GetLogger().Info("[repair] round end", zap.Int("checkedWindows", status.CheckedWindows), zap.Int("divergedWindows", status.DivergedWindows),
+		zap.Int("repairedSeries", status.RepairedSeries), zap.Int64("repairedRows", status.RepairedRows), zap.Int("errors", len(status.Errors)))
+}
+
+// repairReplica compares the window digests of the replica with the source, and repairs the series of the diverged windows.
+// The rows only the replica has are kept, the repair never deletes the rows of a replica
+func (m *RepairManager) repairReplica(task *repairTask, replica repairReplica, dryRun bool) {
+	window := int64(m.conf.Window)
+	req := &netstorage.RepairDigestRequest{Db: task.db, Rp: task.rp, TimeRange: task.tr, Window: window}
+	req.Pt = task.source.pt
+	srcDigests, err := m.store.NetStore.GetRepairDigests(task.source.nodeID, req)
+	if err != nil {
+		m.addError(fmt.Errorf("get digests of db %s pt %d: %v", task.db, task.source.pt, err))
+		return
+	}
+	req.Pt = replica.pt
+	dstDigests, err := m.store.NetStore.GetRepairDigests(replica.nodeID, req)
+	if err != nil {
+		m.addError(fmt.Errorf("get digests of db %s pt %d: %v", task.db, replica.pt, err))
+		return
+	}
+
+	diverged := diffRepairDigests(srcDigests, dstDigests, func(d *netstorage.RepairDigest) string {
+		return fmt.Sprintf("%s/%d", d.Mst, d.Window)
+	})
+	m.update(func(s *RepairStatus) {
+		s.CheckedWindows += len(srcDigests)
+		s.DivergedWindows += len(diverged)
+	})
+
+	for _, d := range diverged {
+		if dryRun {
+			logger.GetLogger().Info("[repair] replica diverged", zap.String("db", task.db), zap.String("rp", task.rp), zap.String("mst", d.Mst),
+				zap.Int64("window", d.Window), zap.Uint32("sourcePt", task.source.pt), zap.Uint32("pt", replica.pt))
+			continue
+		}
+		tr := util.TimeRange{Min: max(d.Window, task.tr.Min), Max: min(d.Window+window-1, task.tr.Max)}
+		if err = m.repairWindow(task, replica, d.Mst, tr); err != nil {
+			m.addError(fmt.Errorf("repair db %s mst %s pt %d window %d: %v", task.db, d.Mst, replica.pt, d.Window, err))
+		}
+	}
+}
+
+func (m *RepairManager) repairWindow(task *repairTask, replica repairReplica, mst string, tr util.TimeRange) error {
+	req := &netstorage.RepairDigestRequest{Db: task.db, Rp: task.rp, Mst: mst, TimeRange: tr, Window: int64(m.conf.Window), PerSeries: true}
+	req.Pt = task.source.pt
+	srcDigests, err := m.store.NetStore.GetRepairDigests(task.source.nodeID, req)
+	if err != nil {
+		return err
+	}
+	req.Pt = replica.pt
+	dstDigests, err := m.store.NetStore.GetRepairDigests(replica.nodeID, req)
+	if err != nil {
+		return err
+	}
+
+	diverged := diffRepairDigests(srcDigests, dstDigests, func(d *netstorage.RepairDigest) string {
+		return d.Key
+	})
+	for len(diverged) > 0 {
+		n := min(m.conf.BatchSeries, len(diverged))
+		keys, err := repairSeriesKeys(diverged[:n])
+		if err != nil {
+			return err
+		}
+		diverged = diverged[n:]
+
+		result, err := m.store.NetStore.RepairSeries(replica.nodeID, &netstorage.RepairSeriesRequest{
+			Db:         task.db,
+			Rp:         task.rp,
+			Pt:         replica.pt,
+			Mst:        mst,
+			TimeRange:  tr,
+			SourceNode: task.source.nodeID,
+			SourcePt:   task.source.pt,
+			Keys:       keys,
+		})
+		if err != nil {
+			return err
+		}
+		m.update(func(s *RepairStatus) {
+			s.RepairedSeries += result.Series
+			s.RepairedRows += result.Rows
+		})
+	}
+	return nil
+}
+
+// diffRepairDigests returns the source digests missing in the replica or different from the replica
+func diffRepairDigests(src, dst []*netstorage.RepairDigest, key func(*netstorage.RepairDigest) string) []*netstorage.RepairDigest {
+	dstMap := make(map[string]*netstorage.RepairDigest, len(dst))
+	for _, d := range dst {
+		dstMap[key(d)] = d
+	}
+	var diverged []*netstorage.RepairDigest
+	for _, s := range src {
+		if d, ok := dstMap[key(s)]; !ok || !s.Equal(d) {
+			diverged = append(diverged, s)
+		}
+	}
+	return diverged
+}
+
+func repairSeriesKeys(digests []*netstorage.RepairDigest) ([][]byte, error) {
+	keys := make([][]byte, 0, len(digests))
+	for _, d := range digests {
+		key, err := hex.DecodeString(d.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid series key %q: %v", d.Key, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (m *RepairManager) update(fn func(s *RepairStatus)) {
+	m.mu.Lock()
+	fn(&m.status)
+	m.mu.Unlock()
+}
+
+func (m *RepairManager) addError(err error) {
+	logger.GetLogger().Error("[repair] repair failed", zap.Error(err))
+	m.update(func(s *RepairStatus) {
+		if len(s.Errors) < maxRepairStatusErrors {
+			s.Errors = append(s.Errors, err.Error())
+		}
+	})
+}
+
+// selectRepairTasks returns a task for each replica group of the replicated databases in each shard group
+// overlapping the time range of the options, the offline pts are skipped
+func (s *Store) selectRepairTasks(opt RepairOptions) []*repairTask {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	minTime, maxTime := time.Unix(0, opt.StartTime), time.Unix(0, opt.EndTime)
+	dbs := make([]string, 0, len(s.data.Databases))
+	for name := range s.data.Databases {
+		dbs = append(dbs, name)
+	}
+	sort.Strings(dbs)
+
+	var tasks []*repairTask
+	for _, db := range dbs {
+		dbi := s.data.Databases[db]
+		if dbi.MarkDeleted || dbi.ReplicaN <= 1 || (opt.Db != "" && db != opt.Db) {
+			continue
+		}
+		ptView, rgs := s.data.DBPtView(db), s.data.DBRepGroups(db)
+
+		rps := make([]string, 0, len(dbi.RetentionPolicies))
+		for name := range dbi.RetentionPolicies {
+			rps = append(rps, name)
+		}
+		sort.Strings(rps)
+		for _, rp := range rps {
+			rpi := dbi.RetentionPolicies[rp]
+			if rpi.MarkDeleted || (opt.Rp != "" && rp != opt.Rp) {
+				continue
+			}
+			for i := range rpi.ShardGroups {
+				sg := &rpi.ShardGroups[i]
+				if sg.Deleted() || !sg.Overlaps(minTime, maxTime) {
+					continue
+				}
+				tr := util.TimeRange{Min: max(sg.StartTime.UnixNano(), opt.StartTime), Max: min(sg.EndTime.UnixNano()-1, opt.EndTime)}
+				for j := range rgs {
+					if task := newRepairTask(db, rp, tr, &rgs[j], ptView, opt.SourcePt); task != nil {
+						tasks = append(tasks, task)
+					}
+				}
+			}
+		}
+	}
+	return tasks
+}
+
+func newRepairTask(db, rp string, tr util.TimeRange, rg *meta.ReplicaGroup, ptView meta.DBPtInfos, sourcePt *uint32) *repairTask {
+	members := make([]uint32, 0, len(rg.Peers)+1)
+	members = append(members, rg.MasterPtID)
+	for _, peer := range rg.Peers {
+		if peer.ID != rg.MasterPtID {
+			members = append(members, peer.ID)
+		}
+	}
+
+	source := rg.MasterPtID
+	if sourcePt != nil {
+		for _, pt := range members {
+			if pt == *sourcePt {
+				source = pt
+			}
+		}
+	}
+	online := func(pt uint32) bool {
+		return int(pt) < len(ptView) && ptView[pt].Status == meta.Online
+	}
+	if !online(source) {
+		return nil
+	}
+
+	task := &repairTask{db: db, rp: rp, tr: tr, source: repairReplica{pt: source, nodeID: ptView[source].Owner.NodeID}}
+	for _, pt := range members {
+		if pt != source && online(pt) {
+			task.replicas = append(task.replicas, repairReplica{pt: pt, nodeID: ptView[pt].Owner.NodeID})
+		}
+	}
+	if len(task.replicas) == 0 {
+		return nil
+	}
+	return task
+}
+
+func (s *Store) triggerRepair(opt RepairOptions) error {
+	if !s.IsLeader() {
+		return errno.NewError(errno.MetaIsNotLeader)
+	}
+	if globalService == nil || globalService.repairManager == nil {
+		return errors.New("repair manager is not started")
+	}
+	return globalService.repairManager.Trigger(opt)
+}
+
+func (s *Store) repairStatus() (*RepairStatus, error) {
+	if !s.IsLeader() {
+		return nil, errno.NewError(errno.MetaIsNotLeader)
+	}
+	if globalService == nil || globalService.repairManager == nil {
+		return nil, errors.New("repair manager is not started")
+	}
+	status := globalService.repairManager.Status()
+	return &status, nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+func newRepairTestStore() *Store {
+	return &Store{
+		data: &meta.Data{
+			Databases: map[string]*meta.DatabaseInfo{
+				"db0": {Name: "db0", ReplicaN: 2, RetentionPolicies: map[string]*meta.RetentionPolicyInfo{
+					"rp0": {Name: "rp0", ShardGroups: []meta.ShardGroupInfo{
+						{ID: 1, StartTime: time.Unix(0, 0), EndTime: time.Unix(0, 100)},
+						{ID: 2, StartTime: time.Unix(0, 100), EndTime: time.Unix(0, 200)},
+					}},
+				}},
+				"db1": {Name: "db1", ReplicaN: 1, RetentionPolicies: map[string]*meta.RetentionPolicyInfo{
+					"rp0": {Name: "rp0", ShardGroups: []meta.ShardGroupInfo{{ID: 3, StartTime: time.Unix(0, 0), EndTime: time.Unix(0, 100)}}},
+				}},
+			},
+			PtView: map[string]meta.DBPtInfos{
+				"db0": {
+					{PtId: 0, Owner: meta.PtOwner{NodeID: 1}, Status: meta.Online, RGID: 0},
+					{PtId: 1, Owner: meta.PtOwner{NodeID: 2}, Status: meta.Online, RGID: 0},
+					{PtId: 2, Owner: meta.PtOwner{NodeID: 3}, Status: meta.Offline, RGID: 0},
+				},
+			},
+			ReplicaGroups: map[string][]meta.ReplicaGroup{
+				"db0": {{ID: 0, MasterPtID: 0, Peers: []meta.Peer{{ID: 1}, {ID: 2}}}},
+			},
+		},
+	}
+}
+
+func TestStore_SelectRepairTasks(t *testing.T) {
+	s := newRepairTestStore()
+
+	tasks := s.selectRepairTasks(RepairOptions{StartTime: 50, EndTime: 150})
+	require.Equal(t, 2, len(tasks))
+	require.Equal(t, "db0", tasks[0].db)
+	require.Equal(t, int64(50), tasks[0].tr.Min)
+	require.Equal(t, int64(99), tasks[0].tr.Max)
+	require.Equal(t, int64(100), tasks[1].tr.Min)
+	require.Equal(t, int64(150), tasks[1].tr.Max)
+	// the offline pt is skipped
+	require.Equal(t, repairReplica{pt: 0, nodeID: 1}, tasks[0].source)
+	require.Equal(t, []repairReplica{{pt: 1, nodeID: 2}}, tasks[0].replicas)
+
+	source := uint32(1)
+	tasks = s.selectRepairTasks(RepairOptions{StartTime: 0, EndTime: 50, SourcePt: &source})
+	require.Equal(t, 1, len(tasks))
+	require.Equal(t, repairReplica{pt: 1, nodeID: 2}, tasks[0].source)
+	require.Equal(t, []repairReplica{{pt: 0, nodeID: 1}}, tasks[0].replicas)
+
+	require.Empty(t, s.selectRepairTasks(RepairOptions{Db: "db1", StartTime: 0, EndTime: 50}))
+	require.Empty(t, s.selectRepairTasks(RepairOptions{Rp: "rp1", StartTime: 0, EndTime: 50}))
+}
+
+func TestRepairManager(t *testing.T) {
+	config.SetHaPolicy(config.RepPolicy)
+	defer config.SetHaPolicy(config.WAFPolicy)
+
+	key0, key1 := hex.EncodeToString([]byte("k0")), hex.EncodeToString([]byte("k1"))
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var repaired []*netstorage.RepairSeriesRequest
+
+	netStore := NewMockNetStorage()
+	netStore.GetRepairDigestsFn = func(nodeID uint64, req *netstorage.RepairDigestRequest) ([]*netstorage.RepairDigest, error) {
+		<-release
+		if req.PerSeries {
+			if nodeID == 1 {
+				return []*netstorage.RepairDigest{{Mst: "cpu", Key: key0, Rows: 1, Hash: 1}, {Mst: "cpu", Key: key1, Rows: 1, Hash: 2}}, nil
+			}
+			return []*netstorage.RepairDigest{{Mst: "cpu", Key: key0, Rows: 1, Hash: 3}}, nil
+		}
+		if nodeID == 1 {
+			return []*netstorage.RepairDigest{{Mst: "cpu", Window: 0, Rows: 2, Hash: 1}, {Mst: "cpu", Window: 10, Rows: 2, Hash: 2}}, nil
+		}
+		return []*netstorage.RepairDigest{{Mst: "cpu", Window: 0, Rows: 2, Hash: 1}}, nil
+	}
+	netStore.RepairSeriesFn = func(nodeID uint64, req *netstorage.RepairSeriesRequest) (*netstorage.RepairSeriesResult, error) {
+		mu.Lock()
+		repaired = append(repaired, req)
+		mu.Unlock()
+		return &netstorage.RepairSeriesResult{Series: len(req.Keys), Rows: int64(len(req.Keys))}, nil
+	}
+
+	s := newRepairTestStore()
+	s.NetStore = netStore
+	conf := config.NewRepairConfig()
+	conf.Window = toml.Duration(10)
+	conf.BatchSeries = 1
+	m := NewRepairManager(conf, s)
+
+	// not started on the followers
+	require.True(t, errno.Equal(m.Trigger(RepairOptions{StartTime: 0, EndTime: 50}), errno.MetaIsNotLeader))
+
+	m.Start()
+	defer m.Stop()
+	require.NoError(t, m.Trigger(RepairOptions{StartTime: 0, EndTime: 50}))
+	require.Equal(t, errRepairRunning, m.Trigger(RepairOptions{StartTime: 0, EndTime: 50}))
+	require.True(t, m.Status().Running)
+	close(release)
+
+	require.Eventually(t, func() bool { return !m.Status().Running }, 10*time.Second, 10*time.Millisecond)
+	status := m.Status()
+	require.Equal(t, RepairTriggerManual, status.Trigger)
+	require.Equal(t, 1, status.Tasks)
+	require.Equal(t, 2, status.CheckedWindows)
+	require.Equal(t, 1, status.DivergedWindows)
+	require.Equal(t, 2, status.RepairedSeries)
+	require.Empty(t, status.Errors)
+
+	// one request for each batch of series, the series are read from the source
+	require.Equal(t, 2, len(repaired))
+	for _, req := range repaired {
+		require.Equal(t, uint32(1), req.Pt)
+		require.Equal(t, uint64(1), req.SourceNode)
+		require.Equal(t, uint32(0), req.SourcePt)
+		require.Equal(t, "cpu", req.Mst)
+		require.Equal(t, int64(10), req.TimeRange.Min)
+		require.Equal(t, int64(19), req.TimeRange.Max)
+	}
+
+	// nothing is repaired in the dry run
+	repaired = nil
+	require.NoError(t, m.Trigger(RepairOptions{StartTime: 0, EndTime: 50, DryRun: true}))
+	require.Eventually(t, func() bool { return !m.Status().Running }, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, m.Status().DivergedWindows)
+	require.Empty(t, repaired)
+
+	require.Error(t, m.Trigger(RepairOptions{StartTime: 50, EndTime: 10}))
+}
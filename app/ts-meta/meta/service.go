@@ -59,6 +59,9 @@ type Service struct {
 	msm                    *MigrateStateMachine
 	balanceManager         *BalanceManager
 	masterPtBalanceManager *MasterPtBalanceManager
+	repairManager          *RepairManager
+	decommissionManager    *DecommissionManager
+	loadBalancer           *LoadBalancer
 
 	httpServer *httpServer
 	metaServer *MetaServer
@@ -108,6 +111,9 @@ func (s *Service) startMetaServer() error {
 	s.clusterManager = NewClusterManager(s.store)
 	s.balanceManager = NewBalanceManager(s.config.BalanceAlgo)
 	s.masterPtBalanceManager = NewMasterPtBalanceManager()
+	s.repairManager = NewRepairManager(s.config.Repair, s.store)
+	s.decommissionManager = NewDecommissionManager(s.config.Decommission, s.store)
+	s.loadBalancer = NewLoadBalancer(s.config.LoadBalance, s.store)
 	s.msm = NewMigrateStateMachine()
 	s.store.cm = s.clusterManager
 	return nil
@@ -213,9 +219,16 @@ func (s *Service) Close() error {
 	if s.masterPtBalanceManager != nil {
 		s.masterPtBalanceManager.Stop()
 	}
+	if s.repairManager != nil {
+		s.repairManager.Stop()
+	}
 	if s.msm != nil {
 		s.msm.Stop()
 	}
+	// the decommission waits for the move events which are aborted by the stop of msm
+	if s.decommissionManager != nil {
+		s.decommissionManager.Stop()
+	}
 	err := s.store.close()
 
 	if s.metaServer != nil {
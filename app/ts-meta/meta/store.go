@@ -406,6 +406,12 @@ type Store struct {
 		MigratePt(nodeID uint64, data transport.Codec, cb transport.Callback) error
 		SendSegregateNodeCmds(nodeIDs []uint64, address []string) (int, error)
 		TransferLeadership(database string, nodeId uint64, oldMasterPtId, newMasterPtId uint32) error
+		GetRepairDigests(nodeID uint64, req *netstorage.RepairDigestRequest) ([]*netstorage.RepairDigest, error)
+		RepairSeries(nodeID uint64, req *netstorage.RepairSeriesRequest) (*netstorage.RepairSeriesResult, error)
+		StartPtCopy(nodeID uint64, req *netstorage.PtCopyRequest) error
+		GetPtCopyStatus(nodeID uint64, db string, pt uint32) (*netstorage.PtCopyStatus, error)
+		CancelPtCopy(nodeID uint64, db string, pt uint32, clean bool) error
+		GetPtLoads(nodeID uint64) ([]*netstorage.PtLoad, error)
 	}
 
 	statMu       sync.RWMutex
@@ -485,6 +491,8 @@ func (s *Store) checkLeaderChanged() {
 					globalService.balanceManager.Start()
 					globalService.clusterManager.Start()
 					globalService.masterPtBalanceManager.Start()
+					globalService.repairManager.Start()
+					globalService.decommissionManager.Start()
 				}
 
 				s.deleteWg.Add(3)
@@ -501,7 +509,9 @@ func (s *Store) checkLeaderChanged() {
 				globalService.clusterManager.Stop()
 				globalService.balanceManager.Stop()
 				globalService.masterPtBalanceManager.Stop()
+				globalService.repairManager.Stop()
 				globalService.msm.Stop()
+				globalService.decommissionManager.Stop()
 			}
 			s.deleteWg.Wait()
 		case <-s.closing:
@@ -1805,6 +1815,9 @@ func (s *Store) UpdateLoad(b []byte) error {
 }
 
 func (s *Store) createDataNode(writeHost, queryHost, role, az string) ([]byte, error) {
+	if err := meta.CheckDataNodeAz(writeHost, az); err != nil {
+		return nil, err
+	}
 	val := &mproto.CreateDataNodeCommand{
 		HTTPAddr: proto.String(writeHost),
 		TCPAddr:  proto.String(queryHost),
@@ -2399,6 +2412,13 @@ func (s *Store) getPtStatus(db string, ptId uint32) (meta.PtStatus, error) {
 	return status, nil
 }
 
+// checkRGPlacement checks whether the db pt can be placed on the node without breaking the placement of its replica group
+func (s *Store) checkRGPlacement(db string, ptId uint32, nodeId uint64) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.CheckRGPlacement(db, ptId, nodeId)
+}
+
 func (s *Store) getReplicationGroup(db string) []meta.ReplicaGroup {
 	s.mu.RLock()
 	repGroups := s.data.ReplicaGroups[db]
@@ -97,6 +97,9 @@ func (s *Store) genMoveEvent(db string, pt uint32, to uint64) (MigrateEvent, err
 	if dn == nil {
 		return nil, errno.NewError(errno.DataNodeNotFound)
 	}
+	if err := s.data.CheckRGPlacement(db, pt, to); err != nil {
+		return nil, err
+	}
 	moveEvent := NewMoveEvent(&meta.DbPtInfo{Db: db, Pti: &ptiClone, Shards: shardDurations, DBBriefInfo: dbInfo},
 		pti.Owner.NodeID, to, dn.AliveConnID, true)
 	return moveEvent, nil
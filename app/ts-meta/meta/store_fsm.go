@@ -138,6 +138,7 @@ var applyFunc = map[proto2.Command_Type]func(fsm *storeFSM, cmd *proto2.Command)
 	proto2.Command_ReShardingCommand:                applyReSharding,
 	proto2.Command_UpdateSchemaCommand:              applyUpdateSchema,
 	proto2.Command_AlterShardKeyCmd:                 applyAlterShardKey,
+	proto2.Command_AlterMeasurementTTLCmd:           applyAlterMeasurementTTL,
 	proto2.Command_PruneGroupsCommand:               applyPruneGroups,
 	proto2.Command_MarkMeasurementDeleteCommand:     applyMarkMeasurementDelete,
 	proto2.Command_DropMeasurementCommand:           applyDropMeasurement,
@@ -172,6 +173,7 @@ var applyFunc = map[proto2.Command_Type]func(fsm *storeFSM, cmd *proto2.Command)
 	proto2.Command_UpdateNodeTmpIndexCommand:        applyUpdateNodeTmpIndexCommand,
 	proto2.Command_InsertFilesCommand:               applyInsertFilesCommand,
 	proto2.Command_UpdateMetaNodeStatusCommand:      applyUpdateMetaNodeStatus,
+	proto2.Command_UpdateDecommissionCommand:        applyUpdateDecommission,
 }
 
 func applyCreateDatabase(fsm *storeFSM, cmd *proto2.Command) interface{} {
@@ -286,6 +288,10 @@ func applyAlterShardKey(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyAlterShardKeyCommand(cmd)
 }
 
+func applyAlterMeasurementTTL(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return meta2.ApplyAlterMeasurementTTL(fsm.data, cmd)
+}
+
 func applyPruneGroups(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyPruneGroupsCommand(cmd)
 }
@@ -358,6 +364,10 @@ func applyMarkBalancer(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyMarkBalancerCommand(cmd)
 }
 
+func applyUpdateDecommission(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyUpdateDecommissionCommand(cmd)
+}
+
 func applyCreateStream(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyCreateStream(cmd)
 }
@@ -831,6 +841,14 @@ func (fsm *storeFSM) applyMarkBalancerCommand(cmd *proto2.Command) interface{} {
 	return nil
 }
 
+func (fsm *storeFSM) applyUpdateDecommissionCommand(cmd *proto2.Command) interface{} {
+	ext, _ := proto.GetExtension(cmd, proto2.E_UpdateDecommissionCommand_Command)
+	v := ext.(*proto2.UpdateDecommissionCommand)
+
+	fsm.data.UpdateDecommission(v.GetInfo())
+	return nil
+}
+
 func (fsm *storeFSM) applyRegisterQueryIDOffsetCommand(cmd *proto2.Command) interface{} {
 	return meta2.ApplyRegisterQueryIDOffset(fsm.data, cmd)
 }
@@ -928,3 +928,32 @@ func Test_DeleteDbForLogkeeper(t *testing.T) {
 		t.Errorf("deleteDatabase failed, err:%+v", err)
 	}
 }
+
+func Test_ReplicaPlacementOfAz(t *testing.T) {
+	meta2.DataLogger = logger.GetLogger().With(zap.String("service", "data"))
+	defer meta2.SetRepDisPolicy(uint8(meta2.NodeHard))
+	data := &meta2.Data{
+		Databases:    map[string]*meta2.DatabaseInfo{"db0": {Name: "db0", ReplicaN: 3}},
+		PtNumPerNode: 1,
+		PtView:       map[string]meta2.DBPtInfos{"db0": {}},
+	}
+	for i, az := range []string{"az1", "az1", "az2", "az2", "az3", "az3"} {
+		data.DataNodes = append(data.DataNodes, meta2.DataNode{NodeInfo: meta2.NodeInfo{ID: uint64(i + 1), Status: serf.StatusAlive}, Az: az})
+		data.PtView["db0"] = append(data.PtView["db0"], meta2.PtInfo{PtId: uint32(i), Owner: meta2.PtOwner{NodeID: uint64(i + 1)}, Status: meta2.Online})
+	}
+	require.NoError(t, meta2.CreateDBRGFns[meta2.NodeHard](data, "db0", 3))
+	s := &Store{data: data, raft: &MockRaftForSG{isLeader: true}}
+
+	// pt 2 of the same replica group is on node 3
+	_, err := s.genMoveEvent("db0", 0, 3)
+	require.True(t, errno.Equal(err, errno.ReplicaOnSameNode))
+	event, err := s.genMoveEvent("db0", 0, 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), event.getDst())
+
+	meta2.SetRepDisPolicy(uint8(meta2.AzHard))
+	_, err = s.genMoveEvent("db0", 0, 4)
+	require.True(t, errno.Equal(err, errno.ReplicaInSameAz))
+	_, err = s.createDataNode("127.0.0.1:8400", "127.0.0.1:8401", "", "")
+	require.True(t, errno.Equal(err, errno.AvailabilityZoneRequired))
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	logger2 "github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
 	"github.com/openGemini/openGemini/lib/obs"
 	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
@@ -190,6 +191,12 @@ type MockStore interface {
 	MigratePt(uint64, transport.Codec, transport.Callback) error
 	SendSegregateNodeCmds(nodeIDs []uint64, address []string) (int, error)
 	TransferLeadership(database string, nodeId uint64, oldMasterPtId, newMasterPtId uint32) error
+	GetRepairDigests(nodeID uint64, req *netstorage.RepairDigestRequest) ([]*netstorage.RepairDigest, error)
+	RepairSeries(nodeID uint64, req *netstorage.RepairSeriesRequest) (*netstorage.RepairSeriesResult, error)
+	StartPtCopy(nodeID uint64, req *netstorage.PtCopyRequest) error
+	GetPtCopyStatus(nodeID uint64, db string, pt uint32) (*netstorage.PtCopyStatus, error)
+	CancelPtCopy(nodeID uint64, db string, pt uint32, clean bool) error
+	GetPtLoads(nodeID uint64) ([]*netstorage.PtLoad, error)
 }
 
 type MockNetStorage struct {
@@ -239,6 +246,34 @@ func (s *MockNetStorage) TransferLeadership(database string, nodeId uint64, oldM
 	return nil
 }
 
+func (s *MockNetStorage) GetRepairDigests(nodeID uint64, req *netstorage.RepairDigestRequest) ([]*netstorage.RepairDigest, error) {
+	return nil, nil
+}
+
+func (s *MockNetStorage) RepairSeries(nodeID uint64, req *netstorage.RepairSeriesRequest) (*netstorage.RepairSeriesResult, error) {
+	return &netstorage.RepairSeriesResult{}, nil
+}
+
+func (s *MockNetStorage) StartPtCopy(nodeID uint64, req *netstorage.PtCopyRequest) error {
+	return nil
+}
+
+func (s *MockNetStorage) GetPtCopyStatus(nodeID uint64, db string, pt uint32) (*netstorage.PtCopyStatus, error) {
+	return &netstorage.PtCopyStatus{Db: db, Pt: pt, State: netstorage.PtCopyDone}, nil
+}
+
+func (s *MockNetStorage) CancelPtCopy(nodeID uint64, db string, pt uint32, clean bool) error {
+	return nil
+}
+
+func (s *MockNetStorage) GetPtLoads(nodeID uint64) ([]*netstorage.PtLoad, error) {
+	return nil, nil
+}
+
+func (s *MockNetStorage) GetMeasurementStats(nodeID uint64, req *netstorage.MeasurementStatsRequest) (*netstorage.MeasurementStats, error) {
+	return nil, nil
+}
+
 func NewMockNetStorage() MockStore {
 	return &MockNetStorage{}
 }
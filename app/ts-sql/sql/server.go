@@ -38,6 +38,7 @@ import (
 	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/sysconfig"
 	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/auth"
@@ -50,6 +51,7 @@ import (
 	"github.com/openGemini/openGemini/services/arrowflight"
 	"github.com/openGemini/openGemini/services/castor"
 	"github.com/openGemini/openGemini/services/continuousquery"
+	"github.com/openGemini/openGemini/services/queryhistory"
 	"github.com/openGemini/openGemini/services/runtimecfg"
 	"github.com/openGemini/openGemini/services/sherlock"
 	"github.com/openGemini/openGemini/services/writer"
@@ -72,6 +74,7 @@ type Server struct {
 	QueryExecutor     *query.Executor
 	PointsWriter      *coordinator.PointsWriter
 	SubscriberManager *coordinator.SubscriberManager
+	Replication       *coordinator.ClusterReplicationManager
 	httpService       *httpd.Service
 
 	arrowFlightService *arrowflight.Service
@@ -95,6 +98,8 @@ type Server struct {
 
 	writerService *writer.Service
 
+	queryHistoryService *queryhistory.Service
+
 	ctx          context.Context
 	ctxCancel    context.CancelFunc
 	serfInstance *serf.Serf
@@ -155,10 +160,18 @@ func NewServer(conf config.Config, info app.ServerInfo, logger *Logger.Logger) (
 	}
 	config.SetSubscriptionEnable(s.config.Subscriber.Enabled)
 
+	if s.config.ClusterReplication.Enabled {
+		s.Replication, err = coordinator.NewClusterReplicationManager(s.config.ClusterReplication, s.httpService.Handler.Logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	syscontrol.SysCtrl.MetaClient = s.MetaClient
 	syscontrol.SysCtrl.NetStore = store
 	// set query schema limit
 	syscontrol.SetQuerySchemaLimit(c.SelectSpec.QuerySchemaLimit)
+	syscontrol.SetQueryMemoryLimit(int64(c.SelectSpec.QueryMemoryLimit), c.SelectSpec.SpillDir)
 	syscontrol.SetParallelQueryInBatch(c.HTTP.ParallelQueryInBatch)
 
 	s.initQueryExecutor(c)
@@ -209,6 +222,11 @@ func NewServer(conf config.Config, info app.ServerInfo, logger *Logger.Logger) (
 		}
 		s.writerService.WithAuthorizer(a)
 	}
+	if c.QueryHistory.Enabled {
+		s.queryHistoryService = queryhistory.NewService(c.QueryHistory, &c.Logging, c.HTTP.BindAddress)
+		s.queryHistoryService.PointsWriter = s.PointsWriter
+		s.httpService.Handler.QueryHistory = s.queryHistoryService
+	}
 	return s, nil
 }
 
@@ -259,23 +277,32 @@ func (s *Server) initQueryExecutor(c *config.TSSql) {
 	metaExecutor.MetaClient = s.MetaClient
 	metaExecutor.SetTimeOut(time.Duration(c.Coordinator.MetaExecutorWriteTimeout))
 
+	shardMapper := &coordinator.ClusterShardMapper{
+		Timeout:    time.Duration(c.Coordinator.ShardMapperTimeout),
+		MetaClient: s.MetaClient,
+		NetStore:   s.TSDBStore,
+		Logger:     s.Logger.With(zap.String("shardMapper", "cluster")),
+	}
+	if c.Coordinator.FollowerRead {
+		shardMapper.FollowerReader = coordinator.NewFollowerReader(time.Duration(c.Coordinator.FollowerReadMaxStaleness), s.TSDBStore)
+	}
+
 	s.QueryExecutor = query.NewExecutor(cpu.GetCpuNum())
 	s.QueryExecutor.StatementExecutor = &coordinator2.StatementExecutor{
-		MetaClient:  s.MetaClient,
-		TaskManager: s.QueryExecutor.TaskManager,
-		NetStorage:  s.TSDBStore,
-		ShardMapper: &coordinator.ClusterShardMapper{
-			Timeout:    time.Duration(c.Coordinator.ShardMapperTimeout),
-			MetaClient: s.MetaClient,
-			NetStore:   s.TSDBStore,
-			Logger:     s.Logger.With(zap.String("shardMapper", "cluster")),
-		},
+		MetaClient:              s.MetaClient,
+		TaskManager:             s.QueryExecutor.TaskManager,
+		NetStorage:              s.TSDBStore,
+		ShardMapper:             shardMapper,
 		MetaExecutor:            metaExecutor,
 		MaxQueryMem:             int64(c.Coordinator.MaxQueryMem),
 		MaxRowSizeLimit:         int64(c.HTTP.MaxRowSizeLimit),
+		MaxSemiJoinValues:       c.Coordinator.MaxSemiJoinValues,
+		PlanStatistics:          c.Coordinator.PlanStatistics,
+		ShowShardMaintenance:    c.Coordinator.ShowShardMaintenance,
 		QueryTimeCompareEnabled: c.Coordinator.QueryTimeCompareEnabled,
 		RetentionPolicyLimit:    c.Coordinator.RetentionPolicyLimit,
 		StmtExecLogger:          Logger.NewLogger(errno.ModuleQueryEngine).With(zap.String("query", "StatementExecutor")),
+		Replication:             s.Replication,
 		Hostname:                config.CombineDomain(s.config.HTTP.Domain, s.config.HTTP.BindAddress),
 		SqlConfigs:              c.ShowConfigs(),
 	}
@@ -305,6 +332,10 @@ func (s *Server) Open() error {
 		return err
 	}
 
+	if err := tracing.OpenOTel(s.config.Trace, "ts-sql"); err != nil {
+		return fmt.Errorf("open trace export: %s", err)
+	}
+
 	s.PointsWriter.MetaClient = s.MetaClient
 	s.httpService.Handler.MetaClient = s.MetaClient
 	s.httpService.Handler.SQLConfig = s.config
@@ -318,6 +349,16 @@ func (s *Server) Open() error {
 		}
 	}
 
+	// the replication must be opened before any write is accepted
+	if s.Replication != nil {
+		if err := s.Replication.Open(); err != nil {
+			return err
+		}
+		s.PointsWriter.Replication = s.Replication
+		s.RecordWriter.Replication = s.Replication
+		syscontrol.SetReplicationController(s.Replication)
+	}
+
 	if err := s.httpService.Open(); err != nil {
 		return err
 	}
@@ -337,7 +378,6 @@ func (s *Server) Open() error {
 		s.SubscriberManager.InitWriters()
 		go s.SubscriberManager.Update()
 	}
-
 	if err := s.castorService.Open(); err != nil {
 		return err
 	}
@@ -356,6 +396,7 @@ func (s *Server) Open() error {
 
 		s.arrowFlightService.MetaClient = s.MetaClient
 		s.arrowFlightService.RecordWriter = s.RecordWriter
+		s.arrowFlightService.QueryExecutor = s.QueryExecutor
 		if err := s.arrowFlightService.Open(); err != nil {
 			return err
 		}
@@ -378,6 +419,12 @@ func (s *Server) Open() error {
 			return err
 		}
 	}
+	if s.queryHistoryService != nil {
+		s.queryHistoryService.MetaClient = s.MetaClient
+		if err := s.queryHistoryService.Open(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -425,6 +472,11 @@ func (s *Server) Close() error {
 		util.MustClose(s.httpService)
 	}
 
+	// the query history is flushed before the points writer is closed
+	if s.queryHistoryService != nil {
+		util.MustClose(s.queryHistoryService)
+	}
+
 	if s.arrowFlightService != nil {
 		util.MustClose(s.arrowFlightService)
 	}
@@ -449,6 +501,10 @@ func (s *Server) Close() error {
 		s.SubscriberManager.StopAllWriters()
 	}
 
+	if s.Replication != nil {
+		s.Replication.Close()
+	}
+
 	if s.sherlockService != nil {
 		s.sherlockService.Stop()
 	}
@@ -460,6 +516,10 @@ func (s *Server) Close() error {
 	if s.runtimeCfgService != nil {
 		util.MustClose(s.runtimeCfgService)
 	}
+
+	if err := tracing.CloseOTel(); err != nil {
+		s.Logger.Error("failed to close the trace export", zap.Error(err))
+	}
 	return nil
 }
 
@@ -521,6 +581,7 @@ func (s *Server) initStatisticsPusher() {
 	stat.NewErrnoStat().Init(globalTags)
 	stat.NewLogKeeperStatistics().Init(globalTags)
 	stat.NewCollector().SetGlobalTags(globalTags)
+	stat.NewReplicationStatistics().Init(globalTags)
 
 	s.statisticsPusher.Register(
 		stat.CollectHandlerStatistics,
@@ -531,6 +592,7 @@ func (s *Server) initStatisticsPusher() {
 		stat.NewErrnoStat().Collect,
 		stat.NewLogKeeperStatistics().Collect,
 		stat.NewCollector().Collect,
+		stat.NewReplicationStatistics().Collect,
 	)
 
 	s.statisticsPusher.RegisterOps(stat.CollectOpsHandlerStatistics)
@@ -17,6 +17,7 @@ package run
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
@@ -33,6 +34,8 @@ import (
 	"github.com/openGemini/openGemini/lib/compress"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/cpu"
+	"github.com/openGemini/openGemini/lib/crypto"
+	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/httpserver"
 	"github.com/openGemini/openGemini/lib/iodetector"
 	Logger "github.com/openGemini/openGemini/lib/logger"
@@ -42,6 +45,7 @@ import (
 	"github.com/openGemini/openGemini/lib/statisticsPusher"
 	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
@@ -102,6 +106,9 @@ func NewServer(c config.Config, info app.ServerInfo, logger *Logger.Logger) (app
 	immutable.SetChunkMetaCompressMode(conf.Data.ChunkMetaCompressMode)
 	config.SetStoreConfig(conf.Data)
 	config.SetIndexConfig(conf.Index)
+	if err := enableEncryption(&conf.Data); err != nil {
+		return nil, err
+	}
 	compress.Init()
 	mutable.Init(cpu.GetCpuNum())
 	shelf.Open()
@@ -113,6 +120,7 @@ func NewServer(c config.Config, info app.ServerInfo, logger *Logger.Logger) (app
 	// set query series limit
 	syscontrol.SetQuerySeriesLimit(conf.SelectSpec.QuerySeriesLimit)
 	syscontrol.SetQueryEnabledWhenExceedSeries(conf.SelectSpec.EnableWhenExceed)
+	syscontrol.SetQueryMemoryLimit(int64(conf.SelectSpec.QueryMemoryLimit), conf.SelectSpec.SpillDir)
 	syscontrol.SetIndexReadCachePersistent(conf.Data.IndexReadCachePersistent)
 	syscontrol.SetHierarchicalStorageEnabled(conf.HierarchicalStore.Enabled)
 	syscontrol.SetWriteColdShardEnabled(conf.HierarchicalStore.EnableWriteColdShard)
@@ -154,6 +162,19 @@ func NewServer(c config.Config, info app.ServerInfo, logger *Logger.Logger) (app
 	return s, nil
 }
 
+// enableEncryption encrypts the TSSP, index, WAL and raft log files of each database
+func enableEncryption(conf *config.Store) error {
+	if !conf.Encryption.Enabled {
+		return nil
+	}
+	provider, err := crypto.NewKeyProvider(conf.Encryption.KeyProvider, conf.Encryption.KeyFile)
+	if err != nil {
+		return err
+	}
+	fileops.EnableEncryption(provider, filepath.Join(conf.DataDir, config.DataDirectory), filepath.Join(conf.WALDir, config.WalDirectory))
+	return nil
+}
+
 // Err returns an error channel that multiplexes all out of band errors received from all services.
 func (s *Server) Err() <-chan error { return s.err }
 
@@ -166,6 +187,10 @@ func (s *Server) Open() error {
 	// Mark start-up in log.
 	app.LogStarting("TSStore", &s.info)
 
+	if err := tracing.OpenOTel(s.config.Trace, "ts-store"); err != nil {
+		return fmt.Errorf("open trace export: %s", err)
+	}
+
 	s.transServer = transport.NewServer(s.ingestAddr, s.selectAddr)
 	if err := s.transServer.Open(); err != nil {
 		return err
@@ -287,6 +312,10 @@ func (s *Server) Close() error {
 		s.iodetector.Close()
 	}
 
+	if err := tracing.CloseOTel(); err != nil {
+		log.Error("failed to close the trace export", zap.Error(err))
+	}
+
 	shelf.NewRunner().Close()
 	mutable.NewMemTablePoolManager().Close()
 	immutable.NewHotFileManager().Stop()
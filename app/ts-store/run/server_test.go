@@ -300,6 +300,9 @@ func (client *MockMetaClient) CreateMeasurement(database string, retentionPolicy
 func (client *MockMetaClient) AlterShardKey(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo) error {
 	return nil
 }
+func (client *MockMetaClient) AlterMeasurementTTL(database, retentionPolicy, mst string, ttl time.Duration) error {
+	return nil
+}
 func (client *MockMetaClient) CreateDatabase(name string, enableTagArray bool, replicaN uint32, options *obs.ObsOptions) (*meta2.DatabaseInfo, error) {
 	return nil, nil
 }
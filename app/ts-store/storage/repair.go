@@ -0,0 +1,164 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	meta "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// repairSeries re-writes the series of the pt with the rows read from the source replica.
+// The TSSP files can not be copied between the replicas since the series ids are local to each replica,
+// the rows are written through the write path instead, the rows with the same time are overwritten
+func (s *Storage) repairSeries(req *netstorage.SysCtrlRequest) (map[string]string, error) {
+	r, err := netstorage.ParseRepairSeriesRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if s.metaClient == nil {
+		return nil, errors.New("meta client is not set")
+	}
+
+	recs, err := netstorage.NewNetStorage(s.metaClient).FetchScrubSeries(r.SourceNode, r.Db, r.Rp, r.SourcePt, r.Mst, r.TimeRange, r.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("fetch series from pt %d on node %d: %v", r.SourcePt, r.SourceNode, err)
+	}
+	groups, err := s.metaClient.ShardGroupsByTimeRange(r.Db, r.Rp, time.Unix(0, r.TimeRange.Min), time.Unix(0, r.TimeRange.Max))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.writeRepairRows(r, recs, groups)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("series repaired from peer replica", zap.String("db", r.Db), zap.Uint32("pt", r.Pt), zap.String("mst", r.Mst),
+		zap.Uint32("sourcePt", r.SourcePt), zap.Int("series", result.Series), zap.Int64("rows", result.Rows))
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"result": string(buf)}, nil
+}
+
+// writeRepairRows writes the records to the shards of the pt, the shard of a pt is at the index of the pt
+// in the shard groups of the replicated databases
+func (s *Storage) writeRepairRows(r *netstorage.RepairSeriesRequest, recs map[string]*record.Record,
+	groups []meta.ShardGroupInfo) (*netstorage.RepairSeriesResult, error) {
+	result := &netstorage.RepairSeriesResult{Series: len(recs)}
+	for i := range groups {
+		sg := &groups[i]
+		if sg.Deleted() {
+			continue
+		}
+		if int(r.Pt) >= len(sg.Shards) {
+			return nil, fmt.Errorf("shard of pt %d not found in shard group %d", r.Pt, sg.ID)
+		}
+		shardID := sg.Shards[r.Pt].ID
+
+		tr := util.TimeRange{Min: max(sg.StartTime.UnixNano(), r.TimeRange.Min), Max: min(sg.EndTime.UnixNano()-1, r.TimeRange.Max)}
+		rows, err := repairRows(r.Mst, recs, tr)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		err = s.Write(r.Db, r.Rp, r.Mst, r.Pt, shardID, func() error {
+			return s.engine.WriteRows(r.Db, r.Rp, r.Pt, shardID, rows, nil, nil)
+		})
+		if err != nil {
+			return nil, err
+		}
+		result.Rows += int64(len(rows))
+	}
+	return result, nil
+}
+
+// repairRows converts the rows of the records within the time range to the rows of the write path,
+// the records are keyed by the series keys which carry the tags of the series
+func repairRows(mst string, recs map[string]*record.Record, tr util.TimeRange) ([]influx.Row, error) {
+	keys := make([]string, 0, len(recs))
+	for key := range recs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var rows []influx.Row
+	for _, key := range keys {
+		var tags influx.PointTags
+		if _, err := influx.IndexKeyToTags([]byte(key), true, &tags); err != nil {
+			return nil, fmt.Errorf("invalid series key %q: %v", key, err)
+		}
+
+		rec := recs[key]
+		times := rec.Times()
+		timeCol := rec.ColNums() - 1
+		for i := range times {
+			if times[i] < tr.Min || times[i] > tr.Max {
+				continue
+			}
+			row := influx.Row{Name: mst, Tags: tags, Timestamp: times[i]}
+			for c := 0; c < timeCol; c++ {
+				if field, ok := repairField(&rec.Schema[c], rec.Column(c), i); ok {
+					row.Fields = append(row.Fields, field)
+				}
+			}
+			if len(row.Fields) == 0 {
+				continue
+			}
+			sort.Sort(&row.Fields)
+			row.UnmarshalIndexKeys(nil)
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func repairField(f *record.Field, col *record.ColVal, i int) (influx.Field, bool) {
+	field := influx.Field{Key: f.Name, Type: int32(f.Type)}
+	if col.IsNil(i) {
+		return field, false
+	}
+	switch f.Type {
+	case influx.Field_Type_Int:
+		v, _ := col.IntegerValue(i)
+		field.NumValue = float64(v)
+	case influx.Field_Type_Float:
+		field.NumValue, _ = col.FloatValue(i)
+	case influx.Field_Type_Boolean:
+		if v, _ := col.BooleanValue(i); v {
+			field.NumValue = 1
+		}
+	case influx.Field_Type_String:
+		v, _ := col.StringValue(i)
+		field.StrValue = string(v)
+	default:
+		return field, false
+	}
+	return field, true
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/raftlog"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+type repairEngine struct {
+	netstorage.Engine
+	rows map[uint64][]influx.Row
+}
+
+func (e *repairEngine) WriteRows(_, _ string, _ uint32, shardID uint64, rows []influx.Row, _ []byte, _ *raftlog.SnapShotter) error {
+	e.rows[shardID] = append(e.rows[shardID], rows...)
+	return nil
+}
+
+func TestStorage_WriteRepairRows(t *testing.T) {
+	key := influx.MakeIndexKey("cpu_0000", influx.PointTags{{Key: "host", Value: "a"}}, nil)
+	rec := record.NewRecordBuilder(record.Schemas{
+		{Name: "name", Type: influx.Field_Type_String},
+		{Name: "value", Type: influx.Field_Type_Int},
+		{Name: record.TimeField, Type: influx.Field_Type_Int},
+	})
+	rec.ColVals[0].AppendString("x")
+	rec.ColVals[0].AppendStringNull()
+	rec.ColVals[0].AppendStringNull()
+	rec.ColVals[1].AppendIntegers(1, 2)
+	rec.ColVals[1].AppendIntegerNull()
+	rec.ColVals[2].AppendIntegers(10, 20, 30)
+
+	eng := &repairEngine{rows: make(map[uint64][]influx.Row)}
+	s := &Storage{engine: eng}
+	groups := []meta.ShardGroupInfo{
+		{ID: 1, StartTime: time.Unix(0, 0), EndTime: time.Unix(0, 15), Shards: []meta.ShardInfo{{ID: 10}, {ID: 11}}},
+		{ID: 2, StartTime: time.Unix(0, 15), EndTime: time.Unix(0, 100), Shards: []meta.ShardInfo{{ID: 20}, {ID: 21}}},
+	}
+	r := &netstorage.RepairSeriesRequest{Db: "db0", Rp: "rp0", Pt: 1, Mst: "cpu_0000", TimeRange: util.TimeRange{Min: 0, Max: 100}}
+	result, err := s.writeRepairRows(r, map[string]*record.Record{string(key): rec}, groups)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Series)
+	require.Equal(t, int64(2), result.Rows)
+
+	// the rows are written to the shards of the pt, the row without fields is skipped
+	require.Equal(t, 1, len(eng.rows[11]))
+	row := eng.rows[11][0]
+	require.Equal(t, "cpu_0000", row.Name)
+	require.Equal(t, int64(10), row.Timestamp)
+	require.Equal(t, influx.PointTags{{Key: "host", Value: "a"}}, row.Tags)
+	require.Equal(t, 2, len(row.Fields))
+	require.Equal(t, "x", row.Fields[0].StrValue)
+	require.Equal(t, float64(1), row.Fields[1].NumValue)
+	require.Equal(t, 1, len(eng.rows[21]))
+	require.Equal(t, int64(20), eng.rows[21][0].Timestamp)
+
+	r.Pt = 2
+	_, err = s.writeRepairRows(r, map[string]*record.Record{string(key): rec}, groups)
+	require.Error(t, err)
+}
@@ -51,6 +51,7 @@ import (
 	"github.com/openGemini/openGemini/services/downsample"
 	"github.com/openGemini/openGemini/services/hierarchical"
 	"github.com/openGemini/openGemini/services/retention"
+	"github.com/openGemini/openGemini/services/scrub"
 	"go.etcd.io/etcd/raft/v3/raftpb"
 	"go.uber.org/zap"
 )
@@ -146,6 +147,19 @@ func (s *Storage) appendHierarchicalService(c config.HierarchicalConfig) {
 	s.Services = append(s.Services, srv)
 }
 
+func (s *Storage) appendScrubService(c config.ScrubConfig) {
+	if !c.Enabled {
+		return
+	}
+
+	srv := scrub.NewService(c)
+	srv.Engine = s.engine
+	if c.RepairFromPeer {
+		srv.Fetcher = netstorage.NewNetStorage(s.metaClient)
+	}
+	s.Services = append(s.Services, srv)
+}
+
 func (s *Storage) appendDownSamplePolicyService(c retention2.Config) {
 	if !c.Enabled {
 		return
@@ -225,6 +239,10 @@ func OpenStorage(path string, node *metaclient.Node, cli *metaclient.Client, con
 	opt.ReadMetaPageSize = conf.Data.ReadCache.ReadMetaPageSize
 	opt.ReadMetaCacheLimit = uint64(conf.Data.ReadCache.ReadMetaCacheEn)
 	opt.ReadDataCacheLimit = uint64(conf.Data.ReadCache.ReadDataCacheEn)
+	opt.ColdCacheDir = conf.Data.ReadCache.ColdCacheDir
+	opt.ColdCacheSize = int64(conf.Data.ReadCache.ColdCacheSize)
+	opt.ColdCacheBlockSize = int64(conf.Data.ReadCache.ColdCacheBlockSize)
+	opt.ColdPrefetchMeta = conf.Data.ReadCache.ColdPrefetchMeta
 
 	opt.EnableMmapRead = conf.Data.EnableMmapRead
 	opt.OpenShardLimit = conf.Data.OpenShardLimit
@@ -289,6 +307,7 @@ func OpenStorage(path string, node *metaclient.Node, cli *metaclient.Client, con
 	s.appendRetentionPolicyService(conf.Retention)
 	s.appendDownSamplePolicyService(conf.DownSample)
 	s.appendHierarchicalService(conf.HierarchicalStore)
+	s.appendScrubService(conf.Scrub)
 	s.appendAnalysisService(conf.Analysis)
 	s.appendProactiveMgrService(conf.Data)
 
@@ -488,10 +507,44 @@ func (s *Storage) ExecuteDelete(req *netstorage.DeleteRequest) error {
 	case netstorage.MeasurementDelete:
 		// imply delete measurement
 		return s.engine.DropMeasurement(req.Database, req.Rp, req.Measurement, req.ShardIds)
+	case netstorage.SeriesDelete:
+		return s.deleteSeries(req)
+	case netstorage.ShardDelete:
+		return s.deleteShard(req)
+	}
+	return nil
+}
+
+func (s *Storage) deleteShard(req *netstorage.DeleteRequest) error {
+	for _, pt := range req.PtIds {
+		for _, id := range req.ShardIds {
+			err := s.engine.DeleteShard(req.Database, pt, id)
+			// the shard has not been created on this node if nothing was written into it
+			if err != nil && !errno.Equal(err, errno.ShardNotFound) {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+func (s *Storage) deleteSeries(req *netstorage.DeleteRequest) error {
+	var cond influxql.Expr
+	if req.Condition != "" {
+		var err error
+		if cond, err = influxql.ParseExpr(req.Condition); err != nil {
+			return err
+		}
+	}
+
+	sources := make([]influxql.Source, 0, len(req.Measurements))
+	for _, name := range req.Measurements {
+		sources = append(sources, &influxql.Measurement{Name: name})
+	}
+	_, err := s.engine.DropSeries(req.Database, req.Rp, sources, req.PtIds, cond)
+	return err
+}
+
 func (s *Storage) GetShardSplitPoints(db string, pt uint32, shardID uint64, idxes []int64) ([]string, error) {
 	return s.engine.GetShardSplitPoints(db, pt, shardID, idxes)
 }
@@ -504,6 +557,11 @@ func (s *Storage) UnrefEngineDbPt(db string, ptId uint32) {
 	s.engine.DbPTUnref(db, ptId)
 }
 
+// AddEngineDbPtQueryTime accumulates the time of the queries of the db pt, it is a part of the load of the db pt
+func (s *Storage) AddEngineDbPtQueryTime(db string, ptId uint32, d time.Duration) {
+	s.engine.AddDbPTQueryTime(db, ptId, d)
+}
+
 func (s *Storage) GetShardDownSampleLevel(db string, ptId uint32, shardID uint64) int {
 	return s.engine.GetShardDownSampleLevel(db, ptId, shardID)
 }
@@ -554,6 +612,9 @@ func (s *Storage) SeriesCardinality(db string, ptIDs []uint32, measurements []st
 }
 
 func (s *Storage) SendSysCtrlOnNode(req *netstorage.SysCtrlRequest) (map[string]string, error) {
+	if req.Mod() == netstorage.RepairSeriesMod {
+		return s.repairSeries(req)
+	}
 	return s.engine.SysCtrl(req)
 }
 
@@ -15,6 +15,7 @@
 package storage
 
 import (
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -108,6 +109,25 @@ func TestWriteRec(t *testing.T) {
 	assert.Equal(t, err, nil)
 }
 
+func (e *MockEngine) DeleteShard(_ string, ptId uint32, shardID uint64) error {
+	if shardID == 2 {
+		return errno.NewError(errno.ShardNotFound, shardID)
+	}
+	if ptId == 3 {
+		return errors.New("delete shard failed")
+	}
+	return nil
+}
+
+func TestExecuteShardDelete(t *testing.T) {
+	s := &Storage{engine: &MockEngine{}}
+	req := &netstorage.DeleteRequest{Type: netstorage.ShardDelete, Database: "db0", Rp: "rp0", ShardIds: []uint64{1, 2}, PtIds: []uint32{0, 1}}
+	assert.NoError(t, s.ExecuteDelete(req))
+
+	req.PtIds = []uint32{3}
+	assert.EqualError(t, s.ExecuteDelete(req), "delete shard failed")
+}
+
 type MockMetaClient struct {
 	GetShardRangeInfoFn       func(db string, rp string, shardID uint64) (*meta.ShardTimeRangeInfo, error)
 	GetMeasurementInfoStoreFn func(dbName string, rpName string, mstName string) (*meta.MeasurementInfo, error)
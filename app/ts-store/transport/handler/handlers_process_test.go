@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/openGemini/openGemini/app/ts-store/storage"
 	"github.com/openGemini/openGemini/engine/hybridqp"
@@ -176,6 +177,8 @@ func (e *MockEngine) DbPTRef(db string, ptId uint32) error {
 
 func (e *MockEngine) DbPTUnref(db string, ptId uint32) {}
 
+func (e *MockEngine) AddDbPTQueryTime(db string, ptId uint32, d time.Duration) {}
+
 type MockDDLPlans struct {
 	ExecuteFn func(tagKeys map[string][][]byte, condition influxql.Expr, tr util.TimeRange, limit int) (interface{}, error)
 	StopFn    func()
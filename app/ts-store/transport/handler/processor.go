@@ -33,6 +33,9 @@ import (
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/netstorage"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -182,8 +185,12 @@ func (p *SelectProcessor) Handle(w spdy.Responser, data interface{}) (err error)
 	}()
 	var queryIndexState int32 = 0
 	con := context.WithValue(context.Background(), index.QueryIndexState, &queryIndexState)
+	con, otelSpan := tracing.StartOTelSpan(tracing.ContextWithTraceContext(con, spdy.TraceContextOf(w)), "store select",
+		trace.SpanKindServer, attribute.String("db.name", req.Database), attribute.Int("pt.id", int(req.PtID)),
+		attribute.Int64("query.id", int64(req.Opt.QueryId)))
 	s.SetContext(con)
 	err = s.Process()
+	tracing.EndOTelSpan(otelSpan, err)
 	if err != nil {
 		logger.GetLogger().Error("failed to process the query request", zap.Error(err))
 		switch stderr := err.(type) {
@@ -209,7 +216,7 @@ func (p *SelectProcessor) Handle(w spdy.Responser, data interface{}) (err error)
 		}
 		return p.HandleIncQuery(w, req, false, iterMaxNum, 0)
 	}
-	err = w.Response(executor.NewFinishMessage(queryIndexState), true)
+	err = w.Response(executor.NewFinishMessageWithScan(queryIndexState, s.QueryStat()), true)
 	if err != nil {
 		logger.GetLogger().Error("failed to response finish message", zap.Error(err))
 	}
@@ -58,6 +58,8 @@ type Select struct {
 	createPlanSpan *tracing.Span
 	rootSpan       *tracing.Span
 	context        context.Context
+
+	queryStat *statistics.StoreSlowQueryStatistics
 }
 
 func NewSelect(store *storage.Storage, w spdy.Responser, req *executor.RemoteQuery) *Select {
@@ -131,6 +133,11 @@ func (s *Select) SetContext(c context.Context) {
 	s.context = c
 }
 
+// QueryStat returns the statistics of the query, nil for the queries of the _internal database
+func (s *Select) QueryStat() *statistics.StoreSlowQueryStatistics {
+	return s.queryStat
+}
+
 func (s *Select) Process() error {
 	if s.isAborted() {
 		s.logger().Info("[Select.Process] aborted")
@@ -217,6 +224,9 @@ func (s *Select) process(w spdy.Responser, node hybridqp.QueryNode, req *executo
 	} else {
 		ctx = context.WithValue(s.context, QueryDurationKey, qDuration)
 	}
+	// the engine records the series, the rows and the bytes read by the query
+	ctx = context.WithValue(ctx, query2.QueryDurationKey, qDuration)
+	s.queryStat = qDuration
 	if req.Analyze {
 		ctx = s.initTrace(ctx)
 	}
@@ -233,6 +243,9 @@ func (s *Select) process(w spdy.Responser, node hybridqp.QueryNode, req *executo
 			return err
 		}
 		defer s.store.UnrefEngineDbPt(req.Database, req.PtID)
+		defer func(start time.Time) {
+			s.store.AddEngineDbPtQueryTime(req.Database, req.PtID, time.Since(start))
+		}(time.Now())
 	}
 	start = time.Now()
 	executorBuilder := s.NewExecutorBuilder(w, req, ctx, int(parallelism))
@@ -15,6 +15,7 @@
 package transport
 
 import (
+	"context"
 	"fmt"
 	"sync/atomic"
 
@@ -29,6 +30,8 @@ import (
 	"github.com/openGemini/openGemini/lib/netstorage"
 	"github.com/openGemini/openGemini/lib/pointsdecoder"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type InsertServer struct {
@@ -91,13 +94,22 @@ func (p *InsertProcessor) Handle(w spdy.Responser, data interface{}) error {
 	}
 }
 
+// startWriteSpan starts the span of the write request as the child of the span of ts-sql
+func startWriteSpan(w spdy.Responser) trace.Span {
+	ctx := tracing.ContextWithTraceContext(context.Background(), spdy.TraceContextOf(w))
+	_, span := tracing.StartOTelSpan(ctx, "store write", trace.SpanKindServer)
+	return span
+}
+
 func (p *InsertProcessor) processWritePointsRequest(w spdy.Responser, msg *netstorage.WritePointsRequest) error {
 	atomic.AddInt64(&statistics.PerfStat.WriteActiveRequests, 1)
 	defer atomic.AddInt64(&statistics.PerfStat.WriteActiveRequests, -1)
+	span := startWriteSpan(w)
 	ww := pointsdecoder.GetDecoderWork()
 	ww.SetReqBuf(msg.Points())
 	err := writeHandler[config.GetHaPolicy()](ww, ww.GetLogger(), p.store)
 	pointsdecoder.PutDecoderWork(ww)
+	tracing.EndOTelSpan(span, err)
 
 	var rsp *netstorage.WritePointsResponse
 	switch stdErr := err.(type) {
@@ -114,6 +126,7 @@ func (p *InsertProcessor) processWritePointsRequest(w spdy.Responser, msg *netst
 func (p *InsertProcessor) processWriteStreamPointsRequest(w spdy.Responser, msg *netstorage.WriteStreamPointsRequest) error {
 	atomic.AddInt64(&statistics.PerfStat.WriteActiveRequests, 1)
 	defer atomic.AddInt64(&statistics.PerfStat.WriteActiveRequests, -1)
+	span := startWriteSpan(w)
 	ww := pointsdecoder.GetDecoderWork()
 	ww.SetReqBuf(msg.Points())
 	ww.SetStreamVars(msg.StreamVars())
@@ -122,6 +135,7 @@ func (p *InsertProcessor) processWriteStreamPointsRequest(w spdy.Responser, msg
 	if err != nil || !inUse || ww.UnRef() == 0 {
 		pointsdecoder.PutDecoderWork(ww)
 	}
+	tracing.EndOTelSpan(span, err)
 
 	var rsp *netstorage.WriteStreamPointsResponse
 	switch stdErr := err.(type) {
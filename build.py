@@ -28,7 +28,8 @@ targets = {
     'ts-server' : './app/ts-server',
     'ts-monitor' : './app/ts-monitor',
     'ts-data' : './app/ts-data',
-    'ts-recover': './app/ts-recover'
+    'ts-recover': './app/ts-recover',
+    'ts-inspect': './app/ts-inspect'
 }
 
 supported_builds = {
@@ -0,0 +1,1184 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// ReplicationSourceHeader marks the writes and statements shipped by the replication of the peer cluster.
+// They are accepted by a standby and are never replicated again.
+const ReplicationSourceHeader = "X-Opengemini-Replication"
+
+const (
+	replicationRoleFile       = "role"
+	replicationCheckpointFile = "checkpoint"
+	replicationSegmentSuffix  = ".seg"
+
+	replicationRecordHeaderSize = 8 // crc32 + payload length
+)
+
+const (
+	replicationRecordWrite     byte = 1 // line protocol with the timestamps in nanoseconds
+	replicationRecordStatement byte = 2 // influxql statement which modifies the database
+	replicationRecordAbort     byte = 3 // position of a record whose local execution failed
+)
+
+var errCorruptReplicationRecord = errors.New("corrupt replication record")
+
+var (
+	lineProtocolMeasurementEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, " ", `\ `)
+	lineProtocolKeyEscaper         = strings.NewReplacer(`\`, `\\`, ",", `\,`, "=", `\=`, " ", `\ `)
+	lineProtocolStringEscaper      = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+type replicationSourceKey struct{}
+
+// WithReplicationSource marks ctx if the request is shipped by the replication of the peer cluster
+func WithReplicationSource(ctx context.Context, header http.Header) context.Context {
+	if header.Get(ReplicationSourceHeader) == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, replicationSourceKey{}, true)
+}
+
+func IsReplicationSource(ctx context.Context) bool {
+	fromPeer, _ := ctx.Value(replicationSourceKey{}).(bool)
+	return fromPeer
+}
+
+// ReplicationStandbyError is returned if a client modifies a replicated database of a standby
+type ReplicationStandbyError struct {
+	Database string
+}
+
+func (e ReplicationStandbyError) Error() string {
+	return fmt.Sprintf("database %q is a replication standby and writing is not allowed", e.Database)
+}
+
+func (e ReplicationStandbyError) AuthorizationFailed() bool {
+	return true
+}
+
+// ReplicationEntry is a record appended to the replication log ahead of its local execution
+type ReplicationEntry struct {
+	log *replicationLog
+	pos replicationPosition
+}
+
+// ClusterReplicationManager replicates the writes and the statements modifying the databases of ts-sql
+// to the peer cluster asynchronously. Each of them is appended to a per-database log and synced to disk
+// before it is executed locally, whatever the protocol it comes from, so that nothing acknowledged by the
+// primary is lost by a crash. A record is shipped only after its local execution is finished, the records of failed executions
+// are skipped. A shipper of each database sends the log to the peer in batches and persists a checkpoint
+// after each batch, so that shipping is resumed from the checkpoint after a restart.
+//
+// The log is kept by ts-sql rather than shipped from the WAL of the shards or from lib/raftlog: both are
+// on the ts-store nodes and split by shard or pt, the WAL is removed once the memtables are flushed, the
+// raft log only exists with the replication HA policy and is truncated by its snapshots, and neither holds
+// the statements such as DROP MEASUREMENT. A shipper reading them would have to merge the logs of all the
+// stores and pin them until the peer acknowledges, so the writes are logged once at their entry instead.
+type ClusterReplicationManager struct {
+	mu     sync.RWMutex
+	conf   config.ClusterReplication
+	role   string
+	client *HTTPClient
+	logs   map[string]*replicationLog
+
+	closing chan struct{}
+	wg      sync.WaitGroup
+	Logger  *logger.Logger
+}
+
+func NewClusterReplicationManager(conf config.ClusterReplication, l *logger.Logger) (*ClusterReplicationManager, error) {
+	m := &ClusterReplicationManager{
+		conf:    conf,
+		role:    conf.Role,
+		logs:    make(map[string]*replicationLog),
+		closing: make(chan struct{}),
+		Logger:  l,
+	}
+	if conf.Peer == "" {
+		return m, nil
+	}
+
+	u, err := url.Parse(conf.Peer)
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse %s", err)
+	}
+	switch u.Scheme {
+	case "http":
+		m.client = NewHTTPClient(u, time.Duration(conf.HTTPTimeout))
+	case "https":
+		m.client, err = NewHTTPSClient(u, time.Duration(conf.HTTPTimeout), conf.InsecureSkipVerify, conf.HttpsCertificate)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown replication peer schema %s", u.Scheme)
+	}
+	return m, nil
+}
+
+// Open restores the role and the replication logs left by the last run and starts shipping them
+func (m *ClusterReplicationManager) Open() error {
+	if err := os.MkdirAll(m.conf.Dir, 0750); err != nil {
+		return err
+	}
+
+	role, err := os.ReadFile(filepath.Join(m.conf.Dir, replicationRoleFile))
+	if err == nil {
+		m.role = strings.TrimSpace(string(role))
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	entries, err := os.ReadDir(m.conf.Dir)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err = m.openLog(entry.Name()); err != nil {
+			return err
+		}
+	}
+	m.Logger.Info("open cluster replication", zap.String("role", m.role), zap.String("peer", m.conf.Peer),
+		zap.Int("logs", len(m.logs)))
+	return nil
+}
+
+func (m *ClusterReplicationManager) Close() {
+	select {
+	case <-m.closing:
+		return
+	default:
+		close(m.closing)
+	}
+	m.wg.Wait()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, l := range m.logs {
+		if err := l.close(); err != nil {
+			m.Logger.Error("close replication log failed", zap.String("db", l.db), zap.Error(err))
+		}
+	}
+}
+
+// openLog must be called with m.mu held
+func (m *ClusterReplicationManager) openLog(db string) (*replicationLog, error) {
+	l, err := openReplicationLog(filepath.Join(m.conf.Dir, db), db, int64(m.conf.SegmentSize))
+	if err != nil {
+		return nil, err
+	}
+	m.logs[db] = l
+	m.wg.Add(1)
+	go m.ship(l)
+	return l, nil
+}
+
+func (m *ClusterReplicationManager) getLog(db string) (*replicationLog, error) {
+	m.mu.RLock()
+	l, ok := m.logs[db]
+	m.mu.RUnlock()
+	if ok {
+		return l, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if l, ok = m.logs[db]; ok {
+		return l, nil
+	}
+	return m.openLog(db)
+}
+
+func (m *ClusterReplicationManager) Role() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.role
+}
+
+// checkWrite is called before a write or a statement is executed locally. The clients can not modify the
+// replicated databases of a standby. replicate is true if it should be appended to the replication log
+func (m *ClusterReplicationManager) checkWrite(db string, fromPeer bool) (bool, error) {
+	if !m.conf.IsReplicated(db) {
+		return false, nil
+	}
+	if m.Role() == config.ReplicationRoleStandby {
+		if fromPeer {
+			return false, nil
+		}
+		return false, ReplicationStandbyError{Database: db}
+	}
+	return !fromPeer && m.client != nil, nil
+}
+
+// CheckWrite returns an error if the clients are not allowed to write to the database.
+// It is used by the protocols whose writes are executed asynchronously
+func (m *ClusterReplicationManager) CheckWrite(db string) error {
+	if m == nil {
+		return nil
+	}
+	_, err := m.checkWrite(db, false)
+	return err
+}
+
+// BeginWrite appends the rows to the replication log before they are written locally, the timestamps
+// are stamped in nanoseconds, so that replaying the rows on the peer is idempotent.
+// The rows must not be written if an error is returned. It is a no-op if m is nil
+func (m *ClusterReplicationManager) BeginWrite(ctx context.Context, db, rp string, rows []influx.Row) (*ReplicationEntry, error) {
+	if m == nil || len(rows) == 0 {
+		return nil, nil
+	}
+	replicate, err := m.checkWrite(db, IsReplicationSource(ctx))
+	if err != nil || !replicate {
+		return nil, err
+	}
+	return m.begin(db, replicationRecordWrite, rp, appendRowsLineProtocol(nil, rows))
+}
+
+// BeginRecord is BeginWrite of the records written by arrow flight
+func (m *ClusterReplicationManager) BeginRecord(db, rp, mst string, rec *record.Record, schema *meta.CleanSchema) (*ReplicationEntry, error) {
+	if m == nil || rec.RowNums() == 0 {
+		return nil, nil
+	}
+	replicate, err := m.checkWrite(db, false)
+	if err != nil || !replicate {
+		return nil, err
+	}
+	return m.begin(db, replicationRecordWrite, rp, appendRecordLineProtocol(nil, mst, rec, schema))
+}
+
+// BeginStatement appends a statement modifying the database to the replication log before it is executed
+func (m *ClusterReplicationManager) BeginStatement(fromPeer bool, db, rp, stmt string) (*ReplicationEntry, error) {
+	if m == nil || db == "" {
+		return nil, nil
+	}
+	replicate, err := m.checkWrite(db, fromPeer)
+	if err != nil || !replicate {
+		return nil, err
+	}
+	return m.begin(db, replicationRecordStatement, rp, []byte(stmt))
+}
+
+func (m *ClusterReplicationManager) begin(db string, typ byte, rp string, body []byte) (*ReplicationEntry, error) {
+	l, err := m.getLog(db)
+	if err != nil {
+		return nil, err
+	}
+	pos, err := l.begin(typ, rp, body)
+	if err != nil {
+		return nil, err
+	}
+	entry := &ReplicationEntry{log: l, pos: pos}
+	if err = l.syncTo(pos); err != nil {
+		m.End(entry, err)
+		return nil, err
+	}
+	return entry, nil
+}
+
+// End finishes the entry with the result of its local execution. The entry is shipped if it is applied
+// locally even partially, otherwise it is never shipped.
+// The entries left unfinished by a crash are shipped, since their local executions may be applied
+func (m *ClusterReplicationManager) End(entry *ReplicationEntry, err error) {
+	if entry == nil {
+		return
+	}
+	var partialErr netstorage.PartialWriteError
+	applied := err == nil || errors.As(err, &partialErr)
+	if abortErr := entry.log.end(entry.pos, applied); abortErr != nil {
+		m.Logger.Error("append replication abort record failed", zap.String("db", entry.log.db), zap.Error(abortErr))
+	}
+	if applied && entry.log.pendingBytes() >= int64(m.conf.BatchSize) {
+		entry.log.notify()
+	}
+}
+
+func (m *ClusterReplicationManager) ship(l *replicationLog) {
+	defer m.wg.Done()
+	ticker := time.NewTicker(time.Duration(m.conf.FlushInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.closing:
+			return
+		case <-ticker.C:
+		case <-l.signal:
+		}
+		m.shipOnce(l)
+	}
+}
+
+// shipOnce ships the replication log until it is caught up or fails to send a batch
+func (m *ClusterReplicationManager) shipOnce(l *replicationLog) {
+	defer l.updateLag()
+
+	for m.client != nil {
+		select {
+		case <-m.closing:
+			return
+		default:
+		}
+
+		batch, err := l.readBatch(int(m.conf.BatchSize))
+		if err != nil {
+			m.Logger.Error("read replication log failed", zap.String("db", l.db), zap.Error(err))
+			l.setError(err)
+			return
+		}
+		if batch == nil {
+			l.setError(nil)
+			return
+		}
+		if len(batch.data) > 0 {
+			atomic.StoreInt64(&l.stat.LagMs, time.Since(time.Unix(0, batch.firstAppend)).Milliseconds())
+			err = m.send(l.db, batch)
+		}
+		var statusErr *httpStatusError
+		if errors.As(err, &statusErr) && statusErr.code == http.StatusBadRequest {
+			// the batch is rejected by the peer, e.g. a field type conflict, sending it again never succeeds
+			atomic.AddInt64(&l.stat.RejectedBatches, 1)
+			m.Logger.Warn("replication batch is rejected by the peer", zap.String("db", l.db), zap.String("peer", m.conf.Peer), zap.Error(err))
+			err = nil
+		}
+		if err != nil {
+			atomic.AddInt64(&l.stat.FailedBatches, 1)
+			m.Logger.Error("ship replication batch failed", zap.String("db", l.db), zap.String("peer", m.conf.Peer), zap.Error(err))
+			l.setError(err)
+			return
+		}
+		if len(batch.data) > 0 {
+			atomic.AddInt64(&l.stat.ShippedBatches, 1)
+			atomic.AddInt64(&l.stat.ShippedBytes, int64(len(batch.data)))
+		}
+		if err = l.commit(batch.next); err != nil {
+			m.Logger.Error("save replication checkpoint failed", zap.String("db", l.db), zap.Error(err))
+			l.setError(err)
+			return
+		}
+	}
+}
+
+func (m *ClusterReplicationManager) send(db string, batch *replicationBatch) error {
+	params := url.Values{}
+	params.Set("db", db)
+	if batch.rp != "" {
+		params.Set("rp", batch.rp)
+	}
+	header := http.Header{}
+	header.Set(ReplicationSourceHeader, "1")
+	if batch.typ == replicationRecordStatement {
+		params.Set("q", string(batch.data))
+		return m.client.query(params, header)
+	}
+	params.Set("precision", "ns")
+	return m.client.write(params, header, batch.data)
+}
+
+func (m *ClusterReplicationManager) setRole(role string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.role == role {
+		return nil
+	}
+	file := filepath.Join(m.conf.Dir, replicationRoleFile)
+	if err := writeFileSync(file, []byte(role)); err != nil {
+		return err
+	}
+	m.Logger.Info("cluster replication role changed", zap.String("from", m.role), zap.String("to", role))
+	m.role = role
+	return nil
+}
+
+// Promote makes this cluster the primary, the clients are allowed to write and the writes
+// are replicated to the peer. It is used by a failover on the standby, or by a failback on the old primary
+func (m *ClusterReplicationManager) Promote() error {
+	return m.setRole(config.ReplicationRolePrimary)
+}
+
+// Demote makes this cluster a standby, the client writes are rejected and the replication logs are
+// drained to the peer. An error is returned if the logs are not drained within the timeout,
+// the drain-timeout of the configuration is used if timeout is not positive.
+func (m *ClusterReplicationManager) Demote(timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = time.Duration(m.conf.DrainTimeout)
+	}
+	if err := m.setRole(config.ReplicationRoleStandby); err != nil {
+		return err
+	}
+	if m.client == nil {
+		return nil
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		pending := m.pendingBytes()
+		if pending == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("replication logs are not drained in %v, %d bytes left", timeout, pending)
+		}
+		m.mu.RLock()
+		for _, l := range m.logs {
+			l.notify()
+		}
+		m.mu.RUnlock()
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func (m *ClusterReplicationManager) pendingBytes() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var n int64
+	for _, l := range m.logs {
+		n += l.pendingBytes()
+	}
+	return n
+}
+
+type replicationDBStatus struct {
+	LagBytes        int64  `json:"lag_bytes"`
+	LagMs           int64  `json:"lag_ms"`
+	ShippedBytes    int64  `json:"shipped_bytes"`
+	ShippedBatches  int64  `json:"shipped_batches"`
+	FailedBatches   int64  `json:"failed_batches"`
+	RejectedBatches int64  `json:"rejected_batches"`
+	LastError       string `json:"last_error,omitempty"`
+}
+
+type replicationStatus struct {
+	Role      string                          `json:"role"`
+	Peer      string                          `json:"peer"`
+	Databases map[string]*replicationDBStatus `json:"databases"`
+}
+
+// Status returns the role and the replication lag of each database in json
+func (m *ClusterReplicationManager) Status() (string, error) {
+	m.mu.RLock()
+	status := &replicationStatus{
+		Role:      m.role,
+		Peer:      m.conf.Peer,
+		Databases: make(map[string]*replicationDBStatus, len(m.logs)),
+	}
+	for db, l := range m.logs {
+		status.Databases[db] = &replicationDBStatus{
+			LagBytes:        l.pendingBytes(),
+			LagMs:           atomic.LoadInt64(&l.stat.LagMs),
+			ShippedBytes:    atomic.LoadInt64(&l.stat.ShippedBytes),
+			ShippedBatches:  atomic.LoadInt64(&l.stat.ShippedBatches),
+			FailedBatches:   atomic.LoadInt64(&l.stat.FailedBatches),
+			RejectedBatches: atomic.LoadInt64(&l.stat.RejectedBatches),
+			LastError:       l.lastError(),
+		}
+	}
+	m.mu.RUnlock()
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+type replicationPosition struct {
+	Segment uint64 `json:"segment"`
+	Offset  int64  `json:"offset"`
+}
+
+func (p replicationPosition) before(o replicationPosition) bool {
+	return p.Segment < o.Segment || (p.Segment == o.Segment && p.Offset < o.Offset)
+}
+
+type replicationBatch struct {
+	typ         byte
+	rp          string
+	data        []byte
+	firstAppend int64
+	next        replicationPosition
+}
+
+type replicationRecord struct {
+	appendTime int64
+	typ        byte
+	rp         string
+	body       []byte
+}
+
+// replicationLog is the replication log of a database. It consists of segment files, a record is:
+// | crc32 (4B) | payload length (4B) | append time (8B) | type (1B) | rp length (2B) | rp | body |
+// A new segment is created each time the log is opened, so a record torn by a crash is always
+// at the tail of a sealed segment and it is skipped.
+// The records whose local executions are in progress are not shipped, nor are the records after them.
+type replicationLog struct {
+	mu          sync.Mutex
+	db          string
+	dir         string
+	segmentSize int64
+
+	segments   []uint64
+	sizes      map[uint64]int64
+	active     *os.File
+	activeID   uint64
+	activeSize int64
+	ckpt       replicationPosition
+	buf        []byte
+
+	syncMu sync.Mutex          // serializes the fsyncs of the active segment
+	synced replicationPosition // the records before it are synced to disk
+
+	inflight map[replicationPosition]struct{} // records whose local executions are in progress
+	aborted  map[replicationPosition]struct{} // records whose local executions failed, they are not shipped
+
+	signal chan struct{}
+	err    atomic.Value
+	stat   *statistics.ReplicationDBStat
+}
+
+func openReplicationLog(dir, db string, segmentSize int64) (*replicationLog, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+	l := &replicationLog{
+		db:          db,
+		dir:         dir,
+		segmentSize: segmentSize,
+		sizes:       make(map[uint64]int64),
+		inflight:    make(map[replicationPosition]struct{}),
+		aborted:     make(map[replicationPosition]struct{}),
+		signal:      make(chan struct{}, 1),
+		stat:        statistics.NewReplicationStatistics().Database(db),
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, replicationSegmentSuffix) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, replicationSegmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		l.segments = append(l.segments, id)
+		l.sizes[id] = info.Size()
+	}
+	sort.Slice(l.segments, func(i, j int) bool { return l.segments[i] < l.segments[j] })
+
+	if err = l.loadCheckpoint(); err != nil {
+		return nil, err
+	}
+
+	var id uint64 = 1
+	if n := len(l.segments); n > 0 {
+		id = l.segments[n-1] + 1
+	}
+	if err = l.createSegment(id); err != nil {
+		return nil, err
+	}
+	if size, ok := l.sizes[l.ckpt.Segment]; !ok {
+		l.ckpt = replicationPosition{Segment: l.segments[0]}
+	} else if l.ckpt.Offset > size {
+		l.ckpt.Offset = size
+	}
+	if err = l.loadAborted(); err != nil {
+		return nil, err
+	}
+	l.updateLag()
+	return l, nil
+}
+
+func (l *replicationLog) segmentPath(id uint64) string {
+	return filepath.Join(l.dir, fmt.Sprintf("%016d%s", id, replicationSegmentSuffix))
+}
+
+func (l *replicationLog) loadCheckpoint() error {
+	data, err := os.ReadFile(filepath.Join(l.dir, replicationCheckpointFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &l.ckpt)
+}
+
+// loadAborted restores the aborted records not shipped yet from the abort records
+func (l *replicationLog) loadAborted() error {
+	for _, id := range l.segments {
+		if id < l.ckpt.Segment {
+			continue
+		}
+		var from int64
+		if id == l.ckpt.Segment {
+			from = l.ckpt.Offset
+		}
+		_, err := l.scanSegment(id, from, l.sizes[id], func(_ replicationPosition, rec *replicationRecord) bool {
+			if pos, ok := decodeReplicationPosition(rec); ok {
+				l.aborted[pos] = struct{}{}
+			}
+			return true
+		})
+		if err != nil && !isTornReplicationRecord(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *replicationLog) createSegment(id uint64) error {
+	fd, err := os.OpenFile(l.segmentPath(id), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640)
+	if err != nil {
+		return err
+	}
+	l.active, l.activeID, l.activeSize = fd, id, 0
+	l.synced = replicationPosition{Segment: id}
+	l.segments = append(l.segments, id)
+	l.sizes[id] = 0
+	return nil
+}
+
+// begin appends a record ahead of its local execution, it is not shipped until end is called
+func (l *replicationLog) begin(typ byte, rp string, body []byte) (replicationPosition, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	pos, err := l.append(typ, rp, body)
+	if err != nil {
+		return pos, err
+	}
+	l.inflight[pos] = struct{}{}
+	return pos, nil
+}
+
+// end finishes the record appended by begin. An abort record is appended if the local execution
+// is not applied, so that the record is not shipped even after a restart
+func (l *replicationLog) end(pos replicationPosition, applied bool) error {
+	l.mu.Lock()
+	delete(l.inflight, pos)
+	if applied {
+		l.mu.Unlock()
+		return nil
+	}
+	l.aborted[pos] = struct{}{}
+	body := binary.BigEndian.AppendUint64(nil, pos.Segment)
+	body = binary.BigEndian.AppendUint64(body, uint64(pos.Offset))
+	abortPos, err := l.append(replicationRecordAbort, "", body)
+	l.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	// the record must not be shipped after a restart, since its failure has been returned to the client
+	return l.syncTo(abortPos)
+}
+
+// append must be called with l.mu held
+func (l *replicationLog) append(typ byte, rp string, body []byte) (replicationPosition, error) {
+	if l.activeSize > 0 && l.activeSize+int64(len(body)) > l.segmentSize {
+		if err := l.active.Sync(); err != nil {
+			return replicationPosition{}, err
+		}
+		if err := l.active.Close(); err != nil {
+			return replicationPosition{}, err
+		}
+		if err := l.createSegment(l.activeID + 1); err != nil {
+			return replicationPosition{}, err
+		}
+	}
+
+	pos := replicationPosition{Segment: l.activeID, Offset: l.activeSize}
+	l.buf = encodeReplicationRecord(l.buf[:0], &replicationRecord{appendTime: time.Now().UnixNano(), typ: typ, rp: rp, body: body})
+	n, err := l.active.Write(l.buf)
+	l.activeSize += int64(n)
+	l.sizes[l.activeID] = l.activeSize
+	if err != nil {
+		// seal the segment with the torn record, it is skipped by the shipper
+		_ = l.active.Sync()
+		_ = l.active.Close()
+		if createErr := l.createSegment(l.activeID + 1); createErr != nil {
+			return pos, createErr
+		}
+		return pos, err
+	}
+	atomic.AddInt64(&l.stat.AppendedBytes, int64(n))
+	return pos, nil
+}
+
+func (l *replicationLog) isAborted(pos replicationPosition) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, ok := l.aborted[pos]
+	return ok
+}
+
+// syncTo syncs the log to disk up to the record at pos included. The records appended while a sync
+// is in progress are synced together by the next one, so that the concurrent writes share an fsync.
+func (l *replicationLog) syncTo(pos replicationPosition) error {
+	l.syncMu.Lock()
+	defer l.syncMu.Unlock()
+
+	l.mu.Lock()
+	if pos.before(l.synced) {
+		l.mu.Unlock()
+		return nil
+	}
+	fd, end := l.active, replicationPosition{Segment: l.activeID, Offset: l.activeSize}
+	l.mu.Unlock()
+
+	err := fd.Sync()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err == nil {
+		if l.synced.before(end) {
+			l.synced = end
+		}
+		return nil
+	}
+	if pos.before(l.synced) {
+		// the segment is synced and sealed by an append meanwhile
+		return nil
+	}
+	return err
+}
+
+func (l *replicationLog) close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.active.Sync(); err != nil {
+		return err
+	}
+	return l.active.Close()
+}
+
+func (l *replicationLog) notify() {
+	select {
+	case l.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (l *replicationLog) setError(err error) {
+	msg := ""
+	if err != nil {
+		msg = err.Error()
+	}
+	l.err.Store(msg)
+}
+
+func (l *replicationLog) lastError() string {
+	msg, _ := l.err.Load().(string)
+	return msg
+}
+
+func (l *replicationLog) pendingBytes() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var n int64
+	for _, id := range l.segments {
+		if id >= l.ckpt.Segment {
+			n += l.sizes[id]
+		}
+	}
+	return n - l.ckpt.Offset
+}
+
+func (l *replicationLog) updateLag() {
+	pending := l.pendingBytes()
+	atomic.StoreInt64(&l.stat.LagBytes, pending)
+	if pending == 0 {
+		atomic.StoreInt64(&l.stat.LagMs, 0)
+	}
+}
+
+// readBatch reads the records after the checkpoint, the writes of a batch have the same rp and
+// a batch of statements has only one statement. nil is returned if there is nothing to ship.
+// The data of a batch is empty if all its records are skipped
+func (l *replicationLog) readBatch(maxSize int) (*replicationBatch, error) {
+	for {
+		l.mu.Lock()
+		pos := l.ckpt
+		limit := l.sizes[pos.Segment]
+		sealed := pos.Segment != l.activeID
+		for p := range l.inflight {
+			if p.Segment == pos.Segment && p.Offset < limit {
+				limit, sealed = p.Offset, false
+			}
+		}
+		l.mu.Unlock()
+
+		if pos.Offset >= limit {
+			if !sealed {
+				return nil, nil
+			}
+			if err := l.nextSegment(pos.Segment); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		batch, err := l.readSegment(pos, limit, maxSize)
+		if errors.Is(err, errCorruptReplicationRecord) && sealed {
+			logger.GetLogger().Warn("skip the torn tail of replication log segment", zap.String("db", l.db),
+				zap.Uint64("segment", pos.Segment), zap.Int64("offset", pos.Offset))
+			if err = l.nextSegment(pos.Segment); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		return batch, err
+	}
+}
+
+func (l *replicationLog) readSegment(pos replicationPosition, limit int64, maxSize int) (*replicationBatch, error) {
+	batch := &replicationBatch{next: pos}
+	next, err := l.scanSegment(pos.Segment, pos.Offset, limit, func(recPos replicationPosition, rec *replicationRecord) bool {
+		if rec.typ == replicationRecordAbort || l.isAborted(recPos) {
+			return true
+		}
+		if len(batch.data) > 0 && (rec.typ != batch.typ || rec.typ == replicationRecordStatement ||
+			rec.rp != batch.rp || len(batch.data)+len(rec.body) > maxSize) {
+			return false
+		}
+		if len(batch.data) == 0 {
+			batch.typ, batch.rp, batch.firstAppend = rec.typ, rec.rp, rec.appendTime
+		}
+		batch.data = append(batch.data, rec.body...)
+		if rec.typ == replicationRecordWrite && len(rec.body) > 0 && rec.body[len(rec.body)-1] != '\n' {
+			batch.data = append(batch.data, '\n')
+		}
+		return true
+	})
+	batch.next.Offset = next
+
+	if next > pos.Offset {
+		return batch, nil
+	}
+	if err == nil || isTornReplicationRecord(err) {
+		err = errCorruptReplicationRecord
+	}
+	return nil, err
+}
+
+// scanSegment calls fn with the records of the segment in [from, limit) until fn returns false,
+// the offset of the first record not accepted by fn is returned
+func (l *replicationLog) scanSegment(id uint64, from, limit int64, fn func(replicationPosition, *replicationRecord) bool) (int64, error) {
+	fd, err := os.Open(l.segmentPath(id))
+	if err != nil {
+		return from, err
+	}
+	defer fd.Close()
+	if _, err = fd.Seek(from, io.SeekStart); err != nil {
+		return from, err
+	}
+
+	reader := bufio.NewReader(io.LimitReader(fd, limit-from))
+	header := make([]byte, replicationRecordHeaderSize)
+	var payload []byte
+	offset := from
+	for offset < limit {
+		if _, err = io.ReadFull(reader, header); err != nil {
+			return offset, err
+		}
+		size := int(binary.BigEndian.Uint32(header[4:]))
+		if int64(size) > limit-offset-replicationRecordHeaderSize {
+			return offset, errCorruptReplicationRecord
+		}
+		if cap(payload) < size {
+			payload = make([]byte, size)
+		}
+		payload = payload[:size]
+		if _, err = io.ReadFull(reader, payload); err != nil {
+			return offset, err
+		}
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header) {
+			return offset, errCorruptReplicationRecord
+		}
+		rec, err := decodeReplicationRecord(payload)
+		if err != nil {
+			return offset, err
+		}
+		if !fn(replicationPosition{Segment: id, Offset: offset}, rec) {
+			return offset, nil
+		}
+		offset += int64(replicationRecordHeaderSize + size)
+	}
+	return offset, nil
+}
+
+// nextSegment moves the checkpoint to the next segment and removes the shipped one
+func (l *replicationLog) nextSegment(id uint64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	i := sort.Search(len(l.segments), func(i int) bool { return l.segments[i] > id })
+	if err := l.saveCheckpoint(replicationPosition{Segment: l.segments[i]}); err != nil {
+		return err
+	}
+
+	if err := os.Remove(l.segmentPath(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	l.segments = l.segments[i:]
+	delete(l.sizes, id)
+	return nil
+}
+
+func (l *replicationLog) commit(pos replicationPosition) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.saveCheckpoint(pos)
+}
+
+// saveCheckpoint must be called with l.mu held
+func (l *replicationLog) saveCheckpoint(pos replicationPosition) error {
+	data, err := json.Marshal(pos)
+	if err != nil {
+		return err
+	}
+	if err = writeFileSync(filepath.Join(l.dir, replicationCheckpointFile), data); err != nil {
+		return err
+	}
+	l.ckpt = pos
+	for p := range l.aborted {
+		if p.before(pos) {
+			delete(l.aborted, p)
+		}
+	}
+	return nil
+}
+
+func writeFileSync(file string, data []byte) error {
+	tmp := file + ".tmp"
+	fd, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640)
+	if err != nil {
+		return err
+	}
+	if _, err = fd.Write(data); err == nil {
+		err = fd.Sync()
+	}
+	if closeErr := fd.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
+
+func isTornReplicationRecord(err error) bool {
+	return errors.Is(err, errCorruptReplicationRecord) || err == io.EOF || err == io.ErrUnexpectedEOF
+}
+
+func encodeReplicationRecord(dst []byte, rec *replicationRecord) []byte {
+	size := 8 + 1 + 2 + len(rec.rp) + len(rec.body)
+	dst = append(dst, make([]byte, replicationRecordHeaderSize)...)
+	dst = binary.BigEndian.AppendUint64(dst, uint64(rec.appendTime))
+	dst = append(dst, rec.typ)
+	dst = binary.BigEndian.AppendUint16(dst, uint16(len(rec.rp)))
+	dst = append(dst, rec.rp...)
+	dst = append(dst, rec.body...)
+
+	binary.BigEndian.PutUint32(dst[0:], crc32.ChecksumIEEE(dst[replicationRecordHeaderSize:]))
+	binary.BigEndian.PutUint32(dst[4:], uint32(size))
+	return dst
+}
+
+func decodeReplicationRecord(payload []byte) (*replicationRecord, error) {
+	if len(payload) < 11 {
+		return nil, errCorruptReplicationRecord
+	}
+	rec := &replicationRecord{
+		appendTime: int64(binary.BigEndian.Uint64(payload)),
+		typ:        payload[8],
+	}
+	n := int(binary.BigEndian.Uint16(payload[9:]))
+	payload = payload[11:]
+	if len(payload) < n {
+		return nil, errCorruptReplicationRecord
+	}
+	rec.rp = string(payload[:n])
+	rec.body = payload[n:]
+	return rec, nil
+}
+
+// decodeReplicationPosition returns the position of the aborted record if rec is an abort record
+func decodeReplicationPosition(rec *replicationRecord) (replicationPosition, bool) {
+	if rec.typ != replicationRecordAbort || len(rec.body) != 16 {
+		return replicationPosition{}, false
+	}
+	return replicationPosition{
+		Segment: binary.BigEndian.Uint64(rec.body),
+		Offset:  int64(binary.BigEndian.Uint64(rec.body[8:])),
+	}, true
+}
+
+// appendRowsLineProtocol appends the rows in line protocol with the timestamps in nanoseconds,
+// it must be called before the measurement names of the rows are versioned by the write
+func appendRowsLineProtocol(dst []byte, rows []influx.Row) []byte {
+	for i := range rows {
+		row := &rows[i]
+		if len(row.Fields) == 0 {
+			continue
+		}
+		dst = append(dst, lineProtocolMeasurementEscaper.Replace(row.Name)...)
+		for j := range row.Tags {
+			if row.Tags[j].Value == "" {
+				continue
+			}
+			dst = append(dst, ',')
+			dst = append(dst, lineProtocolKeyEscaper.Replace(row.Tags[j].Key)...)
+			dst = append(dst, '=')
+			dst = append(dst, lineProtocolKeyEscaper.Replace(row.Tags[j].Value)...)
+		}
+		for j := range row.Fields {
+			field := &row.Fields[j]
+			if j == 0 {
+				dst = append(dst, ' ')
+			} else {
+				dst = append(dst, ',')
+			}
+			dst = append(dst, lineProtocolKeyEscaper.Replace(field.Key)...)
+			dst = append(dst, '=')
+			switch field.Type {
+			case influx.Field_Type_Int, influx.Field_Type_UInt:
+				dst = strconv.AppendInt(dst, int64(field.NumValue), 10)
+				dst = append(dst, 'i')
+			case influx.Field_Type_Boolean:
+				dst = strconv.AppendBool(dst, field.NumValue != 0)
+			case influx.Field_Type_String:
+				dst = appendLineProtocolString(dst, field.StrValue)
+			default:
+				dst = strconv.AppendFloat(dst, field.NumValue, 'f', -1, 64)
+			}
+		}
+		dst = append(dst, ' ')
+		dst = strconv.AppendInt(dst, row.Timestamp, 10)
+		dst = append(dst, '\n')
+	}
+	return dst
+}
+
+// appendRecordLineProtocol appends the rows of a record in line protocol, the columns
+// whose types are tag in the schema of the measurement are written as tags
+func appendRecordLineProtocol(dst []byte, mst string, rec *record.Record, schema *meta.CleanSchema) []byte {
+	var tags, fields []int
+	for i := 0; i < rec.ColNums()-1; i++ {
+		if schema != nil && (*schema)[rec.Schema[i].Name].Typ == influx.Field_Type_Tag {
+			tags = append(tags, i)
+		} else {
+			fields = append(fields, i)
+		}
+	}
+
+	times := rec.Times()
+	valueIdx := make([]int, rec.ColNums())
+	name := lineProtocolMeasurementEscaper.Replace(mst)
+	for row := 0; row < rec.RowNums(); row++ {
+		start := len(dst)
+		dst = append(dst, name...)
+		for _, i := range tags {
+			value, isNil := rec.ColVals[i].StringValue(row)
+			if isNil || len(value) == 0 {
+				continue
+			}
+			dst = append(dst, ',')
+			dst = append(dst, lineProtocolKeyEscaper.Replace(rec.Schema[i].Name)...)
+			dst = append(dst, '=')
+			dst = append(dst, lineProtocolKeyEscaper.Replace(string(value))...)
+		}
+
+		sep := byte(' ')
+		for _, i := range fields {
+			col := &rec.ColVals[i]
+			if col.IsNil(row) {
+				continue
+			}
+			dst = append(dst, sep)
+			sep = ','
+			dst = append(dst, lineProtocolKeyEscaper.Replace(rec.Schema[i].Name)...)
+			dst = append(dst, '=')
+			switch rec.Schema[i].Type {
+			case influx.Field_Type_Int:
+				dst = strconv.AppendInt(dst, col.IntegerValues()[valueIdx[i]], 10)
+				dst = append(dst, 'i')
+			case influx.Field_Type_Float:
+				dst = strconv.AppendFloat(dst, col.FloatValues()[valueIdx[i]], 'f', -1, 64)
+			case influx.Field_Type_Boolean:
+				dst = strconv.AppendBool(dst, col.BooleanValues()[valueIdx[i]])
+			case influx.Field_Type_String:
+				value, _ := col.StringValue(row)
+				dst = appendLineProtocolString(dst, string(value))
+			}
+			valueIdx[i]++
+		}
+		if sep == ' ' {
+			// a row without any field can not be written
+			dst = dst[:start]
+			continue
+		}
+		dst = append(dst, ' ')
+		dst = strconv.AppendInt(dst, times[row], 10)
+		dst = append(dst, '\n')
+	}
+	return dst
+}
+
+func appendLineProtocolString(dst []byte, s string) []byte {
+	dst = append(dst, '"')
+	dst = append(dst, lineProtocolStringEscaper.Replace(s)...)
+	return append(dst, '"')
+}
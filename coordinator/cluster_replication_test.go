@@ -0,0 +1,361 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb"
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPeer struct {
+	mu       sync.Mutex
+	fail     atomic.Bool
+	reject   atomic.Bool
+	requests []string
+	bodies   []string
+}
+
+func (p *mockPeer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if p.fail.Load() {
+		http.Error(w, "peer unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	if p.reject.Load() {
+		http.Error(w, "field type conflict", http.StatusBadRequest)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if r.URL.Path == "/query" {
+		values, _ := url.ParseQuery(string(body))
+		p.requests = append(p.requests, "query:"+values.Get("db")+"|"+r.Header.Get(ReplicationSourceHeader))
+		p.bodies = append(p.bodies, values.Get("q"))
+		_, _ = w.Write([]byte(`{"results":[{"statement_id":0}]}`))
+		return
+	}
+	p.requests = append(p.requests, r.URL.RawQuery+"|"+r.Header.Get(ReplicationSourceHeader))
+	p.bodies = append(p.bodies, string(body))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func newTestReplicationManager(t *testing.T, dir, peer string) *ClusterReplicationManager {
+	conf := config.NewClusterReplication()
+	conf.Enabled = true
+	conf.Peer = peer
+	conf.Dir = dir
+	conf.FlushInterval = toml.Duration(time.Hour)
+	conf.SegmentSize = 64
+	require.NoError(t, conf.Validate())
+
+	m, err := NewClusterReplicationManager(conf, logger.NewLogger(errno.ModuleCoordinator))
+	require.NoError(t, err)
+	require.NoError(t, m.Open())
+	return m
+}
+
+func testReplicationRows(mst string, values ...float64) []influx.Row {
+	rows := make([]influx.Row, len(values))
+	for i, v := range values {
+		rows[i] = influx.Row{
+			Name:      mst,
+			Timestamp: int64(v),
+			Fields:    influx.Fields{{Key: "v", NumValue: v, Type: influx.Field_Type_Float}},
+		}
+	}
+	return rows
+}
+
+func replicateWrite(t *testing.T, m *ClusterReplicationManager, db, rp string, rows []influx.Row, err error) {
+	entry, beginErr := m.BeginWrite(context.Background(), db, rp, rows)
+	require.NoError(t, beginErr)
+	require.NotNil(t, entry)
+	m.End(entry, err)
+}
+
+func TestClusterReplication_Ship(t *testing.T) {
+	peer := &mockPeer{}
+	server := httptest.NewServer(peer)
+	defer server.Close()
+
+	dir := t.TempDir()
+	m := newTestReplicationManager(t, dir, server.URL)
+	replicateWrite(t, m, "db0", "rp0", testReplicationRows("cpu", 1), nil)
+	replicateWrite(t, m, "db0", "rp0", testReplicationRows("cpu", 2), nil)
+	replicateWrite(t, m, "db0", "rp0", testReplicationRows("cpu", 3), nil)
+	replicateWrite(t, m, "db0", "", testReplicationRows("mem", 1), nil)
+
+	// the peer is not available, the log is kept
+	peer.fail.Store(true)
+	m.shipOnce(m.logs["db0"])
+	require.True(t, m.pendingBytes() > 0)
+	status, err := m.Status()
+	require.NoError(t, err)
+	require.Contains(t, status, "peer unavailable")
+	m.Close()
+
+	// shipping is resumed after a restart
+	peer.fail.Store(false)
+	m = newTestReplicationManager(t, dir, server.URL)
+	defer m.Close()
+	m.shipOnce(m.logs["db0"])
+	require.Equal(t, int64(0), m.pendingBytes())
+	require.Equal(t, []string{"db=db0&precision=ns&rp=rp0|1", "db=db0&precision=ns&rp=rp0|1", "db=db0&precision=ns|1"}, peer.requests)
+	require.Equal(t, []string{"cpu v=1 1\ncpu v=2 2\n", "cpu v=3 3\n", "mem v=1 1\n"}, peer.bodies)
+
+	// the shipped segments are removed
+	files, err := filepath.Glob(filepath.Join(dir, "db0", "*"+replicationSegmentSuffix))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+
+	// the writes shipped by the peer are not replicated again
+	ctx := WithReplicationSource(context.Background(), http.Header{ReplicationSourceHeader: []string{"1"}})
+	entry, err := m.BeginWrite(ctx, "db0", "rp0", testReplicationRows("cpu", 4))
+	require.NoError(t, err)
+	require.Nil(t, entry)
+}
+
+func TestClusterReplication_WriteAhead(t *testing.T) {
+	peer := &mockPeer{}
+	server := httptest.NewServer(peer)
+	defer server.Close()
+
+	dir := t.TempDir()
+	m := newTestReplicationManager(t, dir, server.URL)
+	first, err := m.BeginWrite(context.Background(), "db0", "rp0", testReplicationRows("cpu", 1))
+	require.NoError(t, err)
+	replicateWrite(t, m, "db0", "rp0", testReplicationRows("cpu", 2), nil)
+
+	// the records after a write in progress are not shipped
+	m.shipOnce(m.logs["db0"])
+	require.Empty(t, peer.bodies)
+
+	// the failed writes are never shipped, even after a restart
+	m.End(first, errors.New("shard is not available"))
+	replicateWrite(t, m, "db0", "rp0", testReplicationRows("cpu", 3), errors.New("shard is not available"))
+	replicateWrite(t, m, "db0", "rp0", testReplicationRows("cpu", 4), netstorage.PartialWriteError{Reason: errors.New("field type conflict"), Dropped: 1})
+	m.Close()
+
+	m = newTestReplicationManager(t, dir, server.URL)
+	defer m.Close()
+	m.shipOnce(m.logs["db0"])
+	require.Equal(t, int64(0), m.pendingBytes())
+	require.Equal(t, []string{"cpu v=2 2\n", "cpu v=4 4\n"}, peer.bodies)
+
+	// the batches rejected by the peer are not sent again
+	peer.reject.Store(true)
+	replicateWrite(t, m, "db0", "rp0", testReplicationRows("cpu", 5), nil)
+	m.shipOnce(m.logs["db0"])
+	require.Equal(t, int64(0), m.pendingBytes())
+	status, err := m.Status()
+	require.NoError(t, err)
+	require.Contains(t, status, `"rejected_batches":1`)
+}
+
+func TestClusterReplication_GroupCommit(t *testing.T) {
+	server := httptest.NewServer(&mockPeer{})
+	defer server.Close()
+
+	m := newTestReplicationManager(t, t.TempDir(), server.URL)
+	defer m.Close()
+
+	// each write is synced to disk before BeginWrite returns, across the segments rotated meanwhile
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(v float64) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				entry, err := m.BeginWrite(context.Background(), "db0", "rp0", testReplicationRows("cpu", v))
+				require.NoError(t, err)
+				l := entry.log
+				l.mu.Lock()
+				require.True(t, entry.pos.before(l.synced))
+				l.mu.Unlock()
+				m.End(entry, nil)
+			}
+		}(float64(i))
+	}
+	wg.Wait()
+	require.Greater(t, len(m.logs["db0"].segments), 1)
+}
+
+func TestClusterReplication_Statement(t *testing.T) {
+	peer := &mockPeer{}
+	server := httptest.NewServer(peer)
+	defer server.Close()
+
+	m := newTestReplicationManager(t, t.TempDir(), server.URL)
+	defer m.Close()
+
+	replicateWrite(t, m, "db0", "", testReplicationRows("cpu", 1), nil)
+	entry, err := m.BeginStatement(false, "db0", "", "DROP MEASUREMENT cpu")
+	require.NoError(t, err)
+	m.End(entry, nil)
+	entry, err = m.BeginStatement(false, "db0", "", "DELETE FROM mem")
+	require.NoError(t, err)
+	m.End(entry, nil)
+	replicateWrite(t, m, "db0", "", testReplicationRows("cpu", 2), nil)
+
+	// the statements shipped by the peer are not replicated again
+	entry, err = m.BeginStatement(true, "db0", "", "DROP MEASUREMENT cpu")
+	require.NoError(t, err)
+	require.Nil(t, entry)
+
+	m.shipOnce(m.logs["db0"])
+	require.Equal(t, []string{"db=db0&precision=ns|1", "query:db0|1", "query:db0|1", "db=db0&precision=ns|1"}, peer.requests)
+	require.Equal(t, []string{"cpu v=1 1\n", "DROP MEASUREMENT cpu", "DELETE FROM mem", "cpu v=2 2\n"}, peer.bodies)
+}
+
+func TestClusterReplication_TornSegment(t *testing.T) {
+	peer := &mockPeer{}
+	server := httptest.NewServer(peer)
+	defer server.Close()
+
+	dir := t.TempDir()
+	m := newTestReplicationManager(t, dir, server.URL)
+	replicateWrite(t, m, "db0", "rp0", testReplicationRows("cpu", 1), nil)
+	m.Close()
+
+	fd, err := os.OpenFile(filepath.Join(dir, "db0", "0000000000000001"+replicationSegmentSuffix), os.O_WRONLY|os.O_APPEND, 0640)
+	require.NoError(t, err)
+	_, err = fd.Write([]byte{1, 2, 3, 4, 0, 0, 0, 100, 1})
+	require.NoError(t, err)
+	require.NoError(t, fd.Close())
+
+	m = newTestReplicationManager(t, dir, server.URL)
+	defer m.Close()
+	replicateWrite(t, m, "db0", "rp0", testReplicationRows("cpu", 2), nil)
+	m.shipOnce(m.logs["db0"])
+	require.Equal(t, int64(0), m.pendingBytes())
+	require.Equal(t, []string{"cpu v=1 1\n", "cpu v=2 2\n"}, peer.bodies)
+}
+
+func TestClusterReplication_Failover(t *testing.T) {
+	peer := &mockPeer{}
+	server := httptest.NewServer(peer)
+	defer server.Close()
+
+	dir := t.TempDir()
+	m := newTestReplicationManager(t, dir, server.URL)
+	replicateWrite(t, m, "db0", "rp0", testReplicationRows("cpu", 1), nil)
+
+	// demote drains the replication logs
+	require.NoError(t, m.Demote(10*time.Second))
+	require.Equal(t, int64(0), m.pendingBytes())
+	require.Equal(t, []string{"cpu v=1 1\n"}, peer.bodies)
+
+	// the clients can not modify the database by any protocol
+	_, err := m.BeginWrite(context.Background(), "db0", "rp0", testReplicationRows("cpu", 2))
+	require.True(t, influxdb.IsAuthorizationError(err))
+	require.Error(t, m.CheckWrite("db0"))
+	_, err = m.BeginStatement(false, "db0", "", "DROP MEASUREMENT cpu")
+	require.Error(t, err)
+	ctx := WithReplicationSource(context.Background(), http.Header{ReplicationSourceHeader: []string{"1"}})
+	entry, err := m.BeginWrite(ctx, "db0", "rp0", testReplicationRows("cpu", 2))
+	require.NoError(t, err)
+	require.Nil(t, entry)
+
+	// the role is persisted
+	m.Close()
+	m = newTestReplicationManager(t, dir, server.URL)
+	defer m.Close()
+	require.Equal(t, config.ReplicationRoleStandby, m.Role())
+
+	require.NoError(t, m.Promote())
+	require.NoError(t, m.CheckWrite("db0"))
+
+	peer.fail.Store(true)
+	replicateWrite(t, m, "db0", "rp0", testReplicationRows("cpu", 2), nil)
+	require.Error(t, m.Demote(200*time.Millisecond))
+}
+
+func TestClusterReplicationRecord(t *testing.T) {
+	buf := encodeReplicationRecord(nil, &replicationRecord{appendTime: 100, typ: replicationRecordWrite, rp: "rp0", body: []byte("cpu v=1")})
+	rec, err := decodeReplicationRecord(buf[replicationRecordHeaderSize:])
+	require.NoError(t, err)
+	require.Equal(t, int64(100), rec.appendTime)
+	require.Equal(t, replicationRecordWrite, rec.typ)
+	require.Equal(t, "rp0", rec.rp)
+	require.Equal(t, "cpu v=1", string(rec.body))
+
+	_, err = decodeReplicationRecord(buf[replicationRecordHeaderSize : replicationRecordHeaderSize+12])
+	require.ErrorIs(t, err, errCorruptReplicationRecord)
+}
+
+func TestClusterReplicationLineProtocol(t *testing.T) {
+	rows := []influx.Row{{
+		Name:      "c pu,1",
+		Timestamp: 1700000000000000000,
+		Tags:      influx.PointTags{{Key: "host", Value: "a b"}, {Key: "empty"}, {Key: "k=1", Value: "v,1"}},
+		Fields: influx.Fields{
+			{Key: "i", NumValue: 10, Type: influx.Field_Type_Int},
+			{Key: "f", NumValue: 1.5, Type: influx.Field_Type_Float},
+			{Key: "s", StrValue: `say "hi" \`, Type: influx.Field_Type_String},
+			{Key: "b", NumValue: 1, Type: influx.Field_Type_Boolean},
+		},
+	}}
+	lp := appendRowsLineProtocol(nil, rows)
+	require.Equal(t, `c\ pu\,1,host=a\ b,k\=1=v\,1 i=10i,f=1.5,s="say \"hi\" \\",b=true 1700000000000000000`+"\n", string(lp))
+
+	parsed := &influx.PointRows{}
+	require.NoError(t, parsed.Unmarshal(string(lp), false))
+	require.Equal(t, 1, len(parsed.Rows))
+	require.Equal(t, "c pu,1", parsed.Rows[0].Name)
+	require.Equal(t, rows[0].Timestamp, parsed.Rows[0].Timestamp)
+	require.Equal(t, "v,1", parsed.Rows[0].Tags[1].Value)
+	require.Equal(t, `say "hi" \`, parsed.Rows[0].Fields[2].StrValue)
+}
+
+func TestClusterReplicationRecordLineProtocol(t *testing.T) {
+	schema := record.Schemas{
+		{Name: "host", Type: influx.Field_Type_String},
+		{Name: "v", Type: influx.Field_Type_Float},
+		{Name: "n", Type: influx.Field_Type_Int},
+		{Name: "time", Type: influx.Field_Type_Int},
+	}
+	rec := record.NewRecord(schema, false)
+	rec.ColVals[0].AppendStrings("a", "b")
+	rec.ColVals[1].AppendFloat(1.5)
+	rec.ColVals[1].AppendFloatNull()
+	rec.ColVals[2].AppendIntegerNull()
+	rec.ColVals[2].AppendInteger(2)
+	rec.ColVals[3].AppendIntegers(1, 2)
+
+	mstSchema := meta.CleanSchema{"host": {Typ: influx.Field_Type_Tag}, "v": {Typ: influx.Field_Type_Float}}
+	lp := appendRecordLineProtocol(nil, "cpu", rec, &mstSchema)
+	require.Equal(t, "cpu,host=a v=1.5 1\ncpu,host=b n=2i 2\n", string(lp))
+}
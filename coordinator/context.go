@@ -15,6 +15,7 @@
 package coordinator
 
 import (
+	"context"
 	"sort"
 	"sync"
 
@@ -53,6 +54,9 @@ type injestionCtx struct {
 	stream *Stream
 
 	writeCtx []*netstorage.WriteContext
+
+	// span of the write request exported by OpenTelemetry
+	traceCtx context.Context
 }
 
 func (s *injestionCtx) getShardRow(id uint64) *ShardRow {
@@ -118,6 +122,7 @@ func (s *injestionCtx) Reset() {
 	s.rp = nil
 	s.ms = nil
 	s.minTime = 0
+	s.traceCtx = nil
 	s.aliveShardIdxes = s.aliveShardIdxes[:0]
 	s.shardKeyInfo = nil
 }
@@ -289,6 +294,7 @@ func (s *injestionCtx) allocWriteContext(shard *meta.ShardInfo, rs []*influx.Row
 	ctx.StreamShards = ctx.StreamShards[:0]
 	ctx.Shard = shard
 	ctx.Rows = copyRows(rs, ctx.Rows[:0])
+	ctx.TraceCtx = s.traceCtx
 	return ctx
 }
 
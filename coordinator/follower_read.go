@@ -0,0 +1,169 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+const maxReplicaReadStateTTL = time.Second
+
+type ReplicaReadStateGetter interface {
+	GetReplicaReadState(nodeID uint64, db string) (map[uint32]*netstorage.ReplicaReadState, error)
+}
+
+type replicaReadStateKey struct {
+	nodeID uint64
+	db     string
+}
+
+type replicaReadStates struct {
+	fetchedAt time.Time
+	states    map[uint32]*netstorage.ReplicaReadState
+	err       error
+}
+
+// FollowerReader spreads the reads of the databases with the replication HA policy over the master
+// and the follower replicas of each replica group. A follower is only read if the data it has applied
+// is not older than maxStaleness. The read states of the raft nodes are cached for a short time and
+// refreshed in the background, so the queries never wait for them: a follower whose state is unknown
+// is not read, and the nodes which failed to report them are skipped until the cache expires.
+type FollowerReader struct {
+	maxStaleness time.Duration
+	stateTTL     time.Duration
+	store        ReplicaReadStateGetter
+
+	mu         sync.Mutex
+	states     map[replicaReadStateKey]*replicaReadStates
+	refreshing map[replicaReadStateKey]struct{}
+
+	next atomic.Uint64
+}
+
+func NewFollowerReader(maxStaleness time.Duration, store ReplicaReadStateGetter) *FollowerReader {
+	ttl := maxStaleness / 2
+	if ttl > maxReplicaReadStateTTL {
+		ttl = maxReplicaReadStateTTL
+	}
+	return &FollowerReader{
+		maxStaleness: maxStaleness,
+		stateTTL:     ttl,
+		store:        store,
+		states:       make(map[replicaReadStateKey]*replicaReadStates),
+		refreshing:   make(map[replicaReadStateKey]struct{}),
+	}
+}
+
+// SelectShards replaces each shard with the shard of a replica in the same replica group which can serve the read.
+// The order of the shards is kept, a shard is kept as it is if there is no other candidate.
+func (r *FollowerReader) SelectShards(db string, sgi *meta2.ShardGroupInfo, shards []meta2.ShardInfo, ptView meta2.DBPtInfos,
+	repGroups []meta2.ReplicaGroup) []meta2.ShardInfo {
+	ptShards := make(map[uint32]int, len(sgi.Shards))
+	for i := range sgi.Shards {
+		if len(sgi.Shards[i].Owners) > 0 {
+			ptShards[sgi.Shards[i].Owners[0]] = i
+		}
+	}
+
+	var candidates []uint32
+	start := r.next.Add(1)
+	for i := range shards {
+		if len(shards[i].Owners) == 0 || int(shards[i].Owners[0]) >= len(ptView) {
+			continue
+		}
+		rgID := ptView[shards[i].Owners[0]].RGID
+		if int(rgID) >= len(repGroups) {
+			continue
+		}
+		rg := &repGroups[rgID]
+
+		candidates = candidates[:0]
+		// the master is skipped if its node failed to report the read states, it may be down
+		// before the pt view is updated, e.g. the master fails over in the middle of the query
+		if r.ptOnline(ptView, rg.MasterPtID) && r.reachable(db, ptView[rg.MasterPtID].Owner.NodeID) {
+			candidates = append(candidates, rg.MasterPtID)
+		}
+		for _, peer := range rg.Peers {
+			if peer.ID != rg.MasterPtID && r.ptOnline(ptView, peer.ID) && r.isFresh(db, ptView[peer.ID].Owner.NodeID, peer.ID) {
+				candidates = append(candidates, peer.ID)
+			}
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		pt := candidates[(start+uint64(i))%uint64(len(candidates))]
+		if idx, ok := ptShards[pt]; ok {
+			shards[i] = sgi.Shards[idx]
+		}
+	}
+	return shards
+}
+
+func (r *FollowerReader) ptOnline(ptView meta2.DBPtInfos, pt uint32) bool {
+	return int(pt) < len(ptView) && ptView[pt].Status == meta2.Online
+}
+
+func (r *FollowerReader) reachable(db string, nodeID uint64) bool {
+	states := r.readStates(db, nodeID)
+	return states == nil || states.err == nil
+}
+
+func (r *FollowerReader) isFresh(db string, nodeID uint64, pt uint32) bool {
+	states := r.readStates(db, nodeID)
+	if states == nil || states.err != nil {
+		return false
+	}
+	state, ok := states.states[pt]
+	if !ok || state.Staleness < 0 {
+		return false
+	}
+	if state.Leader {
+		return true
+	}
+	staleness := time.Duration(state.Staleness)*time.Millisecond + time.Since(states.fetchedAt)
+	return staleness <= r.maxStaleness
+}
+
+// readStates returns the cached read states of the node, it is nil if they have never been fetched.
+// The expired states are refreshed in the background.
+func (r *FollowerReader) readStates(db string, nodeID uint64) *replicaReadStates {
+	key := replicaReadStateKey{nodeID: nodeID, db: db}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	states, ok := r.states[key]
+	if ok && time.Since(states.fetchedAt) < r.stateTTL {
+		return states
+	}
+	if _, ok := r.refreshing[key]; !ok {
+		r.refreshing[key] = struct{}{}
+		go r.refresh(key)
+	}
+	return states
+}
+
+func (r *FollowerReader) refresh(key replicaReadStateKey) {
+	fetchedAt := time.Now()
+	s, err := r.store.GetReplicaReadState(key.nodeID, key.db)
+	r.mu.Lock()
+	r.states[key] = &replicaReadStates{fetchedAt: fetchedAt, states: s, err: err}
+	delete(r.refreshing, key)
+	r.mu.Unlock()
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+type mockReplicaReadStateGetter struct {
+	mu     sync.Mutex
+	calls  int
+	states map[uint64]map[uint32]*netstorage.ReplicaReadState
+}
+
+func (m *mockReplicaReadStateGetter) GetReplicaReadState(nodeID uint64, db string) (map[uint32]*netstorage.ReplicaReadState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	states, ok := m.states[nodeID]
+	if !ok {
+		return nil, fmt.Errorf("node %d is unavailable", nodeID)
+	}
+	return states, nil
+}
+
+// two replica groups of three pts, pt i is on node i+1
+func newFollowerReadMeta() (*meta2.ShardGroupInfo, meta2.DBPtInfos, []meta2.ReplicaGroup) {
+	sgi := &meta2.ShardGroupInfo{ID: 1}
+	ptView := make(meta2.DBPtInfos, 6)
+	for pt := uint32(0); pt < 6; pt++ {
+		sgi.Shards = append(sgi.Shards, meta2.ShardInfo{ID: uint64(pt + 100), Owners: []uint32{pt}})
+		ptView[pt] = meta2.PtInfo{PtId: pt, Owner: meta2.PtOwner{NodeID: uint64(pt + 1)}, Status: meta2.Online, RGID: pt / 3}
+	}
+	repGroups := []meta2.ReplicaGroup{
+		{ID: 0, MasterPtID: 0, Peers: []meta2.Peer{{ID: 1, PtRole: meta2.Slave}, {ID: 2, PtRole: meta2.Slave}}},
+		{ID: 1, MasterPtID: 3, Peers: []meta2.Peer{{ID: 4, PtRole: meta2.Slave}, {ID: 5, PtRole: meta2.Slave}}},
+	}
+	return sgi, ptView, repGroups
+}
+
+func selectFollowerShards(r *FollowerReader, sgi *meta2.ShardGroupInfo, ptView meta2.DBPtInfos, repGroups []meta2.ReplicaGroup) []uint64 {
+	shards := r.SelectShards("db0", sgi, []meta2.ShardInfo{sgi.Shards[0], sgi.Shards[3]}, ptView, repGroups)
+	return []uint64{shards[0].ID, shards[1].ID}
+}
+
+// waitReadStates triggers the refresh of the read states and waits for it
+func waitReadStates(t *testing.T, r *FollowerReader, sgi *meta2.ShardGroupInfo, ptView meta2.DBPtInfos, repGroups []meta2.ReplicaGroup) {
+	selectFollowerShards(r, sgi, ptView, repGroups)
+	require.Eventually(t, func() bool {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return len(r.refreshing) == 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestFollowerReader_SelectShards(t *testing.T) {
+	store := &mockReplicaReadStateGetter{states: map[uint64]map[uint32]*netstorage.ReplicaReadState{
+		1: {0: {Leader: true}},
+		4: {3: {Leader: true}},
+		2: {1: {Staleness: 100}},
+		3: {2: {Staleness: 10000}}, // too stale
+		5: {4: {Staleness: -1}},    // never caught up
+		// node 6 is unavailable
+	}}
+	sgi, ptView, repGroups := newFollowerReadMeta()
+	r := NewFollowerReader(5*time.Second, store)
+
+	// the read states are unknown before they are fetched in the background, only the masters are read
+	require.Equal(t, []uint64{100, 103}, selectFollowerShards(r, sgi, ptView, repGroups))
+	waitReadStates(t, r, sgi, ptView, repGroups)
+
+	// the reads of the first group are spread over the master and the fresh follower
+	read := make(map[uint64]int)
+	for i := 0; i < 10; i++ {
+		ids := selectFollowerShards(r, sgi, ptView, repGroups)
+		read[ids[0]]++
+		require.Equal(t, uint64(103), ids[1])
+	}
+	require.Equal(t, map[uint64]int{100: 5, 101: 5}, read)
+	// the read states are cached
+	require.Equal(t, 6, store.calls)
+
+	// the master is offline, the reads are routed to the fresh follower only
+	ptView[0].Status = meta2.Offline
+	ptView[3].Status = meta2.Offline
+	for i := 0; i < 4; i++ {
+		ids := selectFollowerShards(r, sgi, ptView, repGroups)
+		require.Equal(t, []uint64{101, 103}, ids)
+	}
+
+	// the follower becomes the raft leader
+	store.mu.Lock()
+	store.states[5] = map[uint32]*netstorage.ReplicaReadState{4: {Leader: true}}
+	store.mu.Unlock()
+	r = NewFollowerReader(5*time.Second, store)
+	waitReadStates(t, r, sgi, ptView, repGroups)
+	ids := selectFollowerShards(r, sgi, ptView, repGroups)
+	require.Equal(t, []uint64{101, 104}, ids)
+}
+
+func TestFollowerReader_UnreachableMaster(t *testing.T) {
+	store := &mockReplicaReadStateGetter{states: map[uint64]map[uint32]*netstorage.ReplicaReadState{
+		// node 1 of the master of the first group is down, but the pt view is not updated yet
+		2: {1: {Staleness: 100}},
+		4: {3: {Leader: true}},
+	}}
+	sgi, ptView, repGroups := newFollowerReadMeta()
+	r := NewFollowerReader(5*time.Second, store)
+	waitReadStates(t, r, sgi, ptView, repGroups)
+
+	for i := 0; i < 4; i++ {
+		require.Equal(t, []uint64{101, 103}, selectFollowerShards(r, sgi, ptView, repGroups))
+	}
+
+	// there is no other candidate, the shard is kept as it is
+	store.mu.Lock()
+	delete(store.states, 2)
+	store.mu.Unlock()
+	r = NewFollowerReader(5*time.Second, store)
+	waitReadStates(t, r, sgi, ptView, repGroups)
+	require.Equal(t, []uint64{100, 103}, selectFollowerShards(r, sgi, ptView, repGroups))
+}
@@ -15,6 +15,7 @@
 package coordinator
 
 import (
+	"context"
 	"errors"
 	"math"
 	"sort"
@@ -94,6 +95,10 @@ type PointsWriter struct {
 
 	TSDBStore TSDBStore
 
+	// Replication appends the rows to the replication log before they are written, it is nil if
+	// the cross-cluster replication is disabled
+	Replication *ClusterReplicationManager
+
 	logger *logger.Logger
 }
 
@@ -226,11 +231,19 @@ func fixFields(fields influx.Fields) (influx.Fields, error) {
 
 // RetryWritePointRows make sure sql client got the latest metadata.
 func (w *PointsWriter) RetryWritePointRows(database, retentionPolicy string, rows []influx.Row) error {
-	var err error
+	return w.RetryWritePointRowsContext(context.Background(), database, retentionPolicy, rows)
+}
+
+// RetryWritePointRowsContext is RetryWritePointRows that sends the span of ctx with the write requests of the stores
+func (w *PointsWriter) RetryWritePointRowsContext(traceCtx context.Context, database, retentionPolicy string, rows []influx.Row) error {
+	entry, err := w.Replication.BeginWrite(traceCtx, database, retentionPolicy, rows)
+	if err != nil {
+		return err
+	}
 	start := time.Now()
 
 	for {
-		err = w.writePointRows(database, retentionPolicy, rows)
+		err = w.writePointRows(traceCtx, database, retentionPolicy, rows)
 		if err == nil {
 			break
 		}
@@ -253,6 +266,7 @@ func (w *PointsWriter) RetryWritePointRows(database, retentionPolicy string, row
 		w.resetRowsRouter(rows)
 		time.Sleep(time.Second)
 	}
+	w.Replication.End(entry, err)
 	return err
 }
 
@@ -264,10 +278,11 @@ func (w *PointsWriter) resetRowsRouter(rows []influx.Row) {
 	}
 }
 
-func (w *PointsWriter) writePointRows(database, retentionPolicy string, rows []influx.Row) error {
+func (w *PointsWriter) writePointRows(traceCtx context.Context, database, retentionPolicy string, rows []influx.Row) error {
 	ctx := getInjestionCtx()
 	defer putInjestionCtx(ctx)
 	ctx.writeHelper = newWriteHelper(w)
+	ctx.traceCtx = traceCtx
 
 	err := ctx.checkDBRP(database, retentionPolicy, w)
 	if err != nil {
@@ -16,6 +16,7 @@ package coordinator
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -1212,7 +1213,7 @@ func TestPointsWriter_WritePointRows_SameShardForStream(t *testing.T) {
 	pw.MetaClient = NewMockMetaClient()
 	pw.TSDBStore = NewMockNetStore()
 	rows := make([]influx.Row, 10)
-	err := pw.writePointRows("db0", "rp0", generateRows(10, rows))
+	err := pw.writePointRows(context.Background(), "db0", "rp0", generateRows(10, rows))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1225,7 +1226,7 @@ func TestPointsWriter_WritePointRows_NoStream_NoFieldIndex(t *testing.T) {
 	pw.MetaClient = NewMockMetaClient()
 	pw.TSDBStore = NewMockNetStore()
 	rows := make([]influx.Row, 10)
-	err := pw.writePointRows("db0", "rp0", generateRows(10, rows))
+	err := pw.writePointRows(context.Background(), "db0", "rp0", generateRows(10, rows))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1237,7 +1238,7 @@ func TestPointsWriter_WritePointRows_SameNodeForStream(t *testing.T) {
 	pw.MetaClient = NewMockMetaClient()
 	pw.TSDBStore = NewMockNetStore()
 	rows := make([]influx.Row, 10)
-	err := pw.writePointRows("db0", "rp0", generateRows(10, rows))
+	err := pw.writePointRows(context.Background(), "db0", "rp0", generateRows(10, rows))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1249,7 +1250,7 @@ func TestPointsWriter_WritePointRows_SameMstForStream(t *testing.T) {
 	pw.MetaClient = NewMockMetaClient()
 	pw.TSDBStore = NewMockNetStore()
 	rows := make([]influx.Row, 10)
-	err := pw.writePointRows("db0", "rp0", generateRows(10, rows))
+	err := pw.writePointRows(context.Background(), "db0", "rp0", generateRows(10, rows))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1261,7 +1262,7 @@ func TestPointsWriter_WritePointRows_CalculateOnSqlForStream(t *testing.T) {
 	pw.MetaClient = NewMockMetaClient()
 	pw.TSDBStore = NewMockNetStore()
 	rows := make([]influx.Row, 10)
-	err := pw.writePointRows("db0", "rp0", generateRows(10, rows))
+	err := pw.writePointRows(context.Background(), "db0", "rp0", generateRows(10, rows))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1282,7 +1283,7 @@ func TestPointsWriter_TimeRangeLimit(t *testing.T) {
 	rows = generateRows(10, rows)
 	rows[0].Timestamp = time.Now().Add(-time.Hour * 30).UnixNano()
 
-	err := pw.writePointRows("db0", "rp0", rows)
+	err := pw.writePointRows(context.Background(), "db0", "rp0", rows)
 	pw.Close()
 
 	exp := "partial write: " + errno.NewError(errno.WritePointOutOfRP).Error() + " dropped=1"
@@ -1319,7 +1320,7 @@ func TestPointsWriter_WritePointRows_DuplicateFields_TypeConflict(t *testing.T)
 			Timestamp: time.Now().UnixNano(),
 		},
 	}
-	err := pw.writePointRows("db0", "rp0", rows)
+	err := pw.writePointRows(context.Background(), "db0", "rp0", rows)
 	require.EqualError(t, err, "partial write: conflict field type: foo dropped=1")
 }
 
@@ -1359,7 +1360,7 @@ func TestPointsWriter_WritePointRows_DuplicateFields2(t *testing.T) {
 			Timestamp: time.Now().UnixNano(),
 		},
 	}
-	err := pw.writePointRows("db0", "rp0", rows)
+	err := pw.writePointRows(context.Background(), "db0", "rp0", rows)
 	require.EqualError(t, err, "partial write: conflict field type: foo dropped=1")
 }
 
@@ -1489,7 +1490,7 @@ func Benchmark_WritePointRows(t *testing.B) {
 	for i := 0; i < t.N; i++ {
 		generateRows(100000, rows)
 		t.StartTimer()
-		err := pw.writePointRows("db0", "rp0", rows)
+		err := pw.writePointRows(context.Background(), "db0", "rp0", rows)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1513,7 +1514,7 @@ func TestPointsWriter_InvalidMst(t *testing.T) {
 	rows := make([]influx.Row, 10)
 
 	rows = generateRows(5, rows)
-	err := pw.writePointRows("db0", "rp0", rows)
+	err := pw.writePointRows(context.Background(), "db0", "rp0", rows)
 	pw.Close()
 
 	exp := "partial write: " + errno.NewError(errno.InvalidMeasurement, "a/a").Error() + " dropped=5"
@@ -1540,7 +1541,7 @@ func TestPointsWriter_TagLimit(t *testing.T) {
 
 	SetTagLimit(1)
 	rows = generateRows(5, rows)
-	err := pw.writePointRows("db0", "rp0", rows)
+	err := pw.writePointRows(context.Background(), "db0", "rp0", rows)
 	pw.Close()
 
 	exp := "partial write: " + errno.NewError(errno.TooManyTagKeys).Error() + " dropped=2"
@@ -1600,7 +1601,7 @@ func TestPointsWriter_WritePointRows_TimeOutsideRange(t *testing.T) {
 	for i := range rows {
 		rows[i].Timestamp = 9223372036854775807
 	}
-	err := pw.writePointRows("db0", "rp0", rows)
+	err := pw.writePointRows(context.Background(), "db0", "rp0", rows)
 	if err == nil {
 		t.Fatal(err)
 	}
@@ -1633,7 +1634,7 @@ func TestPointsWriter_TagLimit_CleanSchemOpen(t *testing.T) {
 
 	SetTagLimit(1)
 	rows = generateRows(5, rows)
-	err := pw.writePointRows("db0", "rp0", rows)
+	err := pw.writePointRows(context.Background(), "db0", "rp0", rows)
 	pw.Close()
 
 	exp := "partial write: " + errno.NewError(errno.TooManyTagKeys).Error() + " dropped=5"
@@ -95,6 +95,10 @@ type RecordWriter struct {
 	StorageEngine interface {
 		WriteRec(db, rp, mst string, ptId uint32, shardID uint64, rec *record.Record, binaryRec []byte) error
 	}
+
+	// Replication appends the records to the replication log before they are written, it is nil if
+	// the cross-cluster replication is disabled
+	Replication *ClusterReplicationManager
 }
 
 func NewRecordWriter(timeout time.Duration, ptNum, recMsgChFactor int) *RecordWriter {
@@ -112,6 +116,9 @@ func NewRecordWriter(timeout time.Duration, ptNum, recMsgChFactor int) *RecordWr
 }
 
 func (w *RecordWriter) RetryWriteRecord(database, retentionPolicy, measurement string, rec arrow.Record) error {
+	if err := w.Replication.CheckWrite(database); err != nil {
+		return err
+	}
 	w.recMsgCh <- &RecMsg{
 		Database:        database,
 		RetentionPolicy: retentionPolicy,
@@ -388,7 +395,15 @@ func (w *RecordWriter) writeRecord(db, rp, mst string, rec arrow.Record, ptIdx i
 		return err
 	}
 	atomic.AddInt64(&statistics.HandlerStat.FieldsWritten, rec.NumRows()*rec.NumCols())
-	return w.splitAndWriteByShard(sgis, db, rp, mst, 0, r, ptIdx, ctx.ms.EngineType)
+
+	entry, err := w.Replication.BeginRecord(db, rp, originName, r, ctx.ms.Schema)
+	if err != nil {
+		w.logger.Error("append replication log failed", zap.String("db", db), zap.String("rp", rp), zap.String("mst", mst), zap.Error(err))
+		return err
+	}
+	err = w.splitAndWriteByShard(sgis, db, rp, mst, 0, r, ptIdx, ctx.ms.EngineType)
+	w.Replication.End(entry, err)
+	return err
 }
 
 func (w *RecordWriter) writeLogRecord(db, rp, mst string, totalLen int64, rec *record.Record, ptIdx int) error {
@@ -55,6 +55,8 @@ type ClusterShardMapper struct {
 	Timeout time.Duration
 	meta.MetaClient
 	NetStore netstorage.Storage
+	// FollowerReader is not nil if the reads may be routed to the follower replicas
+	FollowerReader *FollowerReader
 }
 
 func (csm *ClusterShardMapper) MapShards(sources influxql.Sources, t influxql.TimeRange, opt query.SelectOptions, condition influxql.Expr) (query.ShardGroup, error) {
@@ -107,6 +109,7 @@ func (csm *ClusterShardMapper) mapMstShards(s *influxql.Measurement, csming *Clu
 			} else {
 				shs = groups[i].TargetShards(measurements[0], shardKeyInfo, condition, aliveShardIdxes)
 			}
+			shs = csm.selectReplicaShards(s.Database, &groups[i], shs)
 
 			csm.updateShardInfosByPtID(s, g, shs, &shardInfosByPtID)
 		}
@@ -115,6 +118,21 @@ func (csm *ClusterShardMapper) mapMstShards(s *influxql.Measurement, csming *Clu
 	return nil
 }
 
+// selectReplicaShards routes the reads to the follower replicas if follower read is enabled
+func (csm *ClusterShardMapper) selectReplicaShards(db string, sgi *meta2.ShardGroupInfo, shs []meta2.ShardInfo) []meta2.ShardInfo {
+	if csm.FollowerReader == nil || !config.IsReplication() {
+		return shs
+	}
+	if replicaN, err := csm.MetaClient.GetReplicaN(db); err != nil || replicaN <= 1 {
+		return shs
+	}
+	ptView, err := csm.MetaClient.DBPtView(db)
+	if err != nil {
+		return shs
+	}
+	return csm.FollowerReader.SelectShards(db, sgi, shs, ptView, csm.MetaClient.DBRepGroups(db))
+}
+
 func (csm *ClusterShardMapper) updateShardInfosByPtID(s *influxql.Measurement, sg meta2.ShardGroupInfo, shs []meta2.ShardInfo,
 	shardInfosByPtID *map[uint32][]executor.ShardInfo) {
 	var ptID uint32
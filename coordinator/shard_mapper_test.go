@@ -107,6 +107,10 @@ func (m mocShardMapperMetaClient) AlterShardKey(database, retentionPolicy, mst s
 	return nil
 }
 
+func (m mocShardMapperMetaClient) AlterMeasurementTTL(database, retentionPolicy, mst string, ttl time.Duration) error {
+	return nil
+}
+
 func (m mocShardMapperMetaClient) CreateDatabase(name string, enableTagArray bool, replicaN uint32, options *obs.ObsOptions) (*meta.DatabaseInfo, error) {
 	return m.databases[name], nil
 }
@@ -17,10 +17,12 @@ package coordinator
 import (
 	"bytes"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -43,15 +45,21 @@ type HTTPClient struct {
 }
 
 func (c *HTTPClient) Send(db, rp string, lineProtocol []byte) error {
+	params := url.Values{}
+	params.Set("db", db)
+	params.Set("rp", rp)
+	return c.write(params, nil, lineProtocol)
+}
+
+func (c *HTTPClient) write(params url.Values, header http.Header, lineProtocol []byte) error {
 	r := bytes.NewReader(lineProtocol)
 	req, err := http.NewRequest("POST", c.url.String()+"/write", r)
 	if err != nil {
 		return err
 	}
-
-	params := req.URL.Query()
-	params.Set("db", db)
-	params.Set("rp", rp)
+	for k, v := range header {
+		req.Header[k] = v
+	}
 	req.URL.RawQuery = params.Encode()
 
 	resp, err := c.client.Do(req)
@@ -65,12 +73,67 @@ func (c *HTTPClient) Send(db, rp string, lineProtocol []byte) error {
 		if err != nil {
 			return err
 		}
-		err = fmt.Errorf(string(body))
+		return &httpStatusError{code: resp.StatusCode, msg: string(body)}
+	}
+	return nil
+}
+
+// query executes the statement of params on the destination, an error is returned
+// if the statement fails
+func (c *HTTPClient) query(params url.Values, header http.Header) error {
+	req, err := http.NewRequest("POST", c.url.String()+"/query", strings.NewReader(params.Encode()))
+	if err != nil {
+		return err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &httpStatusError{code: resp.StatusCode, msg: string(body)}
+	}
+
+	var response struct {
+		Results []struct {
+			Err string `json:"error"`
+		} `json:"results"`
+		Err string `json:"error"`
+	}
+	if err = json.Unmarshal(body, &response); err != nil {
 		return err
 	}
+	if response.Err != "" {
+		return &httpStatusError{code: http.StatusBadRequest, msg: response.Err}
+	}
+	for _, result := range response.Results {
+		if result.Err != "" {
+			return &httpStatusError{code: http.StatusBadRequest, msg: result.Err}
+		}
+	}
 	return nil
 }
 
+// httpStatusError is returned if the destination responds with an unexpected status code
+type httpStatusError struct {
+	code int
+	msg  string
+}
+
+func (e *httpStatusError) Error() string {
+	return e.msg
+}
+
 func (c *HTTPClient) Destination() string {
 	return c.url.String()
 }
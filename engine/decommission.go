@@ -0,0 +1,526 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"go.uber.org/zap"
+)
+
+const (
+	// ptFileChunkSize is the max size of a chunk of a file read by the copy of a db pt
+	ptFileChunkSize = 1024 * 1024
+
+	// decommissionDirectory holds the files of the db pts being copied to this node,
+	// it is under the data and the wal paths so that the files are moved to the db pt directories by a rename
+	decommissionDirectory = "decommission"
+
+	ptFileDataPrefix = "data/"
+	ptFileWalPrefix  = "wal/"
+
+	// ptLockFile is created by the node owning the db pt, it is not copied
+	ptLockFile = "LOCK"
+)
+
+var errPtCopyCancelled = errors.New("copy of db pt cancelled")
+
+type ptCopyJob struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu     sync.Mutex
+	status netstorage.PtCopyStatus
+}
+
+func (j *ptCopyJob) getStatus() netstorage.PtCopyStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status
+}
+
+func (j *ptCopyJob) addCopied(n int64) {
+	j.mu.Lock()
+	j.status.CopiedBytes += n
+	j.mu.Unlock()
+}
+
+func (j *ptCopyJob) finish(err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	switch {
+	case err == nil:
+		j.status.State = netstorage.PtCopyDone
+	case errors.Is(err, errPtCopyCancelled):
+		j.status.State = netstorage.PtCopyCancelled
+	default:
+		j.status.State = netstorage.PtCopyFailed
+		j.status.Error = err.Error()
+	}
+}
+
+func ptCopyKey(db string, pt uint32) string {
+	return db + "/" + strconv.FormatUint(uint64(pt), 10)
+}
+
+func (e *Engine) ptDataPath(db string, pt uint32) string {
+	return path.Join(e.dataPath, config.DataDirectory, db, strconv.FormatUint(uint64(pt), 10))
+}
+
+func (e *Engine) ptWalPath(db string, pt uint32) string {
+	return path.Join(e.walPath, config.WalDirectory, db, strconv.FormatUint(uint64(pt), 10))
+}
+
+func (e *Engine) ptStagingPaths(db string, pt uint32) (string, string) {
+	ptID := strconv.FormatUint(uint64(pt), 10)
+	return path.Join(e.dataPath, decommissionDirectory, db, ptID), path.Join(e.walPath, decommissionDirectory, db, ptID)
+}
+
+// ptFileLocalPath returns the path of the file of the db pt under the data and the wal directories.
+// The relative path comes from another node, it must not leave the directories
+func ptFileLocalPath(dataDir, walDir, rel string) (string, error) {
+	if rel == "" || filepath.IsAbs(rel) || path.Clean(rel) != rel {
+		return "", fmt.Errorf("invalid pt file path %q", rel)
+	}
+	for _, elem := range strings.Split(rel, "/") {
+		if elem == ".." {
+			return "", fmt.Errorf("invalid pt file path %q", rel)
+		}
+	}
+	switch {
+	case strings.HasPrefix(rel, ptFileDataPrefix):
+		return path.Join(dataDir, strings.TrimPrefix(rel, ptFileDataPrefix)), nil
+	case strings.HasPrefix(rel, ptFileWalPrefix):
+		return path.Join(walDir, strings.TrimPrefix(rel, ptFileWalPrefix)), nil
+	default:
+		return "", fmt.Errorf("invalid pt file path %q", rel)
+	}
+}
+
+// listPtFiles lists the files under dir, the paths are prefixed by prefix. A missing dir has no file
+func listPtFiles(dir, prefix string, checksum bool) ([]*netstorage.PtFile, error) {
+	var files []*netstorage.PtFile
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == dir && os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() || d.Name() == ptLockFile {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		f := &netstorage.PtFile{Path: prefix + filepath.ToSlash(rel), Size: info.Size()}
+		if checksum {
+			if f.Checksum, err = fileChecksum(p); err != nil {
+				return err
+			}
+		}
+		files = append(files, f)
+		return nil
+	})
+	return files, err
+}
+
+func fileChecksum(name string) (uint32, error) {
+	f, err := fileops.Open(name)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	h := crc32.NewIEEE()
+	if _, err = io.Copy(h, f); err != nil {
+		return 0, err
+	}
+	return h.Sum32(), nil
+}
+
+func (e *Engine) ptFileManifest(db string, pt uint32, checksum bool) ([]*netstorage.PtFile, error) {
+	files, err := listPtFiles(e.ptDataPath(db, pt), ptFileDataPrefix, checksum)
+	if err != nil {
+		return nil, err
+	}
+	walFiles, err := listPtFiles(e.ptWalPath(db, pt), ptFileWalPrefix, checksum)
+	if err != nil {
+		return nil, err
+	}
+	return append(files, walFiles...), nil
+}
+
+func (e *Engine) getPtFileManifest(req *netstorage.SysCtrlRequest) (map[string]string, error) {
+	db, pt, checksum, err := netstorage.ParsePtFileManifestRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	files, err := e.ptFileManifest(db, pt, checksum)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := json.Marshal(files)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"files": string(buf)}, nil
+}
+
+func (e *Engine) readPtFileChunk(db string, pt uint32, rel string, offset, size int64) ([]byte, error) {
+	name, err := ptFileLocalPath(e.ptDataPath(db, pt), e.ptWalPath(db, pt), rel)
+	if err != nil {
+		return nil, err
+	}
+	if size > ptFileChunkSize {
+		size = ptFileChunkSize
+	}
+	f, err := fileops.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	buf := make([]byte, size)
+	n, err := f.ReadAt(buf, offset)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+func (e *Engine) fetchPtFileChunk(req *netstorage.SysCtrlRequest) (map[string]string, error) {
+	db, pt, rel, offset, size, err := netstorage.ParsePtFileChunkRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	data, err := e.readPtFileChunk(db, pt, rel, offset, size)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"data": base64.StdEncoding.EncodeToString(data)}, nil
+}
+
+func (e *Engine) processPtCopy(req *netstorage.SysCtrlRequest) (map[string]string, error) {
+	r, err := netstorage.ParsePtCopyRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	return nil, e.startPtCopy(r, netstorage.NewNetStorage(e.metaClient))
+}
+
+// startPtCopy starts the copy of the db pt from the source node in the background.
+// A running copy of the db pt is kept if it is of the same kind, otherwise it is replaced
+func (e *Engine) startPtCopy(r *netstorage.PtCopyRequest, fetcher netstorage.PtFileFetcher) error {
+	key := ptCopyKey(r.Db, r.Pt)
+	e.ptCopyMu.Lock()
+	defer e.ptCopyMu.Unlock()
+	if old, ok := e.ptCopies[key]; ok {
+		if st := old.getStatus(); !st.Finished() && st.Final == r.Final {
+			return nil
+		}
+		old.cancel()
+		<-old.done
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &ptCopyJob{
+		cancel: cancel,
+		done:   make(chan struct{}),
+		status: netstorage.PtCopyStatus{
+			Db:         r.Db,
+			Pt:         r.Pt,
+			SourceNode: r.SourceNode,
+			Final:      r.Final,
+			State:      netstorage.PtCopyRunning,
+		},
+	}
+	if e.ptCopies == nil {
+		e.ptCopies = make(map[string]*ptCopyJob)
+	}
+	e.ptCopies[key] = job
+
+	go func() {
+		defer close(job.done)
+		err := e.copyPt(ctx, job, r, fetcher)
+		if err != nil && !errors.Is(err, errPtCopyCancelled) {
+			log.Error("copy db pt failed", zap.String("db", r.Db), zap.Uint32("pt", r.Pt),
+				zap.Uint64("source", r.SourceNode), zap.Bool("final", r.Final), zap.Error(err))
+		}
+		job.finish(err)
+	}()
+	return nil
+}
+
+func (e *Engine) copyPt(ctx context.Context, job *ptCopyJob, r *netstorage.PtCopyRequest, fetcher netstorage.PtFileFetcher) error {
+	if r.Final && e.ptLoaded(r.Db, r.Pt) {
+		return fmt.Errorf("db pt %s is loaded", ptCopyKey(r.Db, r.Pt))
+	}
+	files, err := fetcher.GetPtFileManifest(r.SourceNode, r.Db, r.Pt, r.Final)
+	if err != nil {
+		return err
+	}
+
+	job.mu.Lock()
+	job.status.Files = len(files)
+	for _, f := range files {
+		job.status.TotalBytes += f.Size
+	}
+	job.mu.Unlock()
+
+	stagingData, stagingWal := e.ptStagingPaths(r.Db, r.Pt)
+	stagingData, stagingWal = path.Join(stagingData, config.DataDirectory), path.Join(stagingWal, config.WalDirectory)
+	if err = removeExtraPtFiles(stagingData, stagingWal, files); err != nil {
+		return err
+	}
+
+	limiter := fileops.NewLimiter(int(r.Throughput), ptFileChunkSize)
+	for _, f := range files {
+		name, err := ptFileLocalPath(stagingData, stagingWal, f.Path)
+		if err != nil {
+			return err
+		}
+		if err = copyPtFile(ctx, job, r, fetcher, limiter, f, name); err != nil {
+			return err
+		}
+	}
+
+	if r.Final {
+		return e.promotePtCopy(r.Db, r.Pt, stagingData, stagingWal)
+	}
+	return nil
+}
+
+// copyPtFile downloads the file to name, the download resumes from the size of an existing file.
+// A file of a final copy is verified by its checksum, it is downloaded again if it was resumed
+func copyPtFile(ctx context.Context, job *ptCopyJob, r *netstorage.PtCopyRequest, fetcher netstorage.PtFileFetcher,
+	limiter fileops.Limiter, f *netstorage.PtFile, name string) error {
+	var offset int64
+	if info, err := fileops.Stat(name); err == nil {
+		offset = info.Size()
+	}
+	if offset > f.Size {
+		if err := fileops.Remove(name); err != nil {
+			return err
+		}
+		offset = 0
+	}
+	job.addCopied(offset)
+
+	if err := downloadPtFile(ctx, job, r, fetcher, limiter, f.Path, name, offset, f.Size); err != nil {
+		return err
+	}
+	if !r.Final {
+		return nil
+	}
+
+	sum, err := fileChecksum(name)
+	if err != nil {
+		return err
+	}
+	if sum == f.Checksum {
+		return nil
+	}
+	if offset == 0 {
+		return fmt.Errorf("checksum mismatch of pt file %s", f.Path)
+	}
+
+	// the resumed file was changed on the source node after it was copied
+	log.Warn("checksum mismatch of resumed pt file, download it again", zap.String("path", f.Path))
+	if err = fileops.Remove(name); err != nil {
+		return err
+	}
+	job.addCopied(-f.Size)
+	if err = downloadPtFile(ctx, job, r, fetcher, limiter, f.Path, name, 0, f.Size); err != nil {
+		return err
+	}
+	if sum, err = fileChecksum(name); err != nil {
+		return err
+	}
+	if sum != f.Checksum {
+		return fmt.Errorf("checksum mismatch of pt file %s", f.Path)
+	}
+	return nil
+}
+
+func downloadPtFile(ctx context.Context, job *ptCopyJob, r *netstorage.PtCopyRequest, fetcher netstorage.PtFileFetcher,
+	limiter fileops.Limiter, rel, name string, offset, size int64) error {
+	if err := fileops.MkdirAll(path.Dir(name), 0750); err != nil {
+		return err
+	}
+	fd, err := fileops.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	for offset < size {
+		n := size - offset
+		if n > ptFileChunkSize {
+			n = ptFileChunkSize
+		}
+		if err = limiter.WaitN(ctx, int(n)); err != nil {
+			if ctx.Err() != nil {
+				return errPtCopyCancelled
+			}
+			return err
+		}
+		data, err := fetcher.FetchPtFileChunk(r.SourceNode, r.Db, r.Pt, rel, offset, n)
+		if err != nil {
+			return err
+		}
+		if len(data) == 0 {
+			return fmt.Errorf("unexpected end of pt file %s at %d", rel, offset)
+		}
+		if _, err = fd.Write(data); err != nil {
+			return err
+		}
+		offset += int64(len(data))
+		job.addCopied(int64(len(data)))
+	}
+	return fd.Sync()
+}
+
+// removeExtraPtFiles removes the staged files which are no longer on the source node
+func removeExtraPtFiles(stagingData, stagingWal string, files []*netstorage.PtFile) error {
+	expected := make(map[string]struct{}, len(files))
+	for _, f := range files {
+		expected[f.Path] = struct{}{}
+	}
+	for dir, prefix := range map[string]string{stagingData: ptFileDataPrefix, stagingWal: ptFileWalPrefix} {
+		staged, err := listPtFiles(dir, prefix, false)
+		if err != nil {
+			return err
+		}
+		for _, f := range staged {
+			if _, ok := expected[f.Path]; ok {
+				continue
+			}
+			name, err := ptFileLocalPath(stagingData, stagingWal, f.Path)
+			if err != nil {
+				return err
+			}
+			if err = fileops.Remove(name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// promotePtCopy moves the verified files of the db pt from the staging directories to the db pt directories
+func (e *Engine) promotePtCopy(db string, pt uint32, stagingData, stagingWal string) error {
+	for staging, live := range map[string]string{stagingData: e.ptDataPath(db, pt), stagingWal: e.ptWalPath(db, pt)} {
+		if err := fileops.RemoveAll(live); err != nil {
+			return err
+		}
+		if _, err := fileops.Stat(staging); os.IsNotExist(err) {
+			continue
+		}
+		if err := fileops.MkdirAll(path.Dir(live), 0750); err != nil {
+			return err
+		}
+		if err := fileops.RenameFile(staging, live); err != nil {
+			return err
+		}
+	}
+	return e.removePtStaging(db, pt)
+}
+
+func (e *Engine) removePtStaging(db string, pt uint32) error {
+	stagingData, stagingWal := e.ptStagingPaths(db, pt)
+	if err := fileops.RemoveAll(stagingData); err != nil {
+		return err
+	}
+	return fileops.RemoveAll(stagingWal)
+}
+
+func (e *Engine) ptLoaded(db string, pt uint32) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	_, ok := e.DBPartitions[db][pt]
+	return ok
+}
+
+func (e *Engine) getPtCopyStatus(req *netstorage.SysCtrlRequest) (map[string]string, error) {
+	db, pt, err := netstorage.ParsePtCopyStatusRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	e.ptCopyMu.Lock()
+	job, ok := e.ptCopies[ptCopyKey(db, pt)]
+	e.ptCopyMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no copy of db pt %s", ptCopyKey(db, pt))
+	}
+	buf, err := json.Marshal(job.getStatus())
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"status": string(buf)}, nil
+}
+
+func (e *Engine) cancelPtCopy(req *netstorage.SysCtrlRequest) (map[string]string, error) {
+	db, pt, clean, err := netstorage.ParsePtCopyCancelRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	return nil, e.stopPtCopy(db, pt, clean)
+}
+
+// stopPtCopy stops the copy of the db pt, the staged files are kept for a later copy unless clean is set
+func (e *Engine) stopPtCopy(db string, pt uint32, clean bool) error {
+	e.ptCopyMu.Lock()
+	defer e.ptCopyMu.Unlock()
+	key := ptCopyKey(db, pt)
+	if job, ok := e.ptCopies[key]; ok {
+		job.cancel()
+		<-job.done
+		if clean {
+			delete(e.ptCopies, key)
+		}
+	}
+	if clean {
+		return e.removePtStaging(db, pt)
+	}
+	return nil
+}
+
+func (e *Engine) cancelPtCopies() {
+	e.ptCopyMu.Lock()
+	defer e.ptCopyMu.Unlock()
+	for _, job := range e.ptCopies {
+		job.cancel()
+	}
+}
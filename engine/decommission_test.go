@@ -0,0 +1,179 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPtFileFetcher reads the files of the db pts of the source engine
+type mockPtFileFetcher struct {
+	src     *Engine
+	corrupt bool
+}
+
+func (f *mockPtFileFetcher) GetPtFileManifest(nodeID uint64, db string, pt uint32, checksum bool) ([]*netstorage.PtFile, error) {
+	return f.src.ptFileManifest(db, pt, checksum)
+}
+
+func (f *mockPtFileFetcher) FetchPtFileChunk(nodeID uint64, db string, pt uint32, path string, offset, size int64) ([]byte, error) {
+	data, err := f.src.readPtFileChunk(db, pt, path, offset, size)
+	if f.corrupt && len(data) > 0 {
+		data[0]++
+	}
+	return data, err
+}
+
+func newDecommissionTestEngine(t *testing.T) *Engine {
+	dir := t.TempDir()
+	return &Engine{
+		dataPath:     path.Join(dir, "data"),
+		walPath:      path.Join(dir, "wal"),
+		DBPartitions: make(map[string]map[uint32]*DBPTInfo),
+	}
+}
+
+func writePtTestFile(t *testing.T, name, content string) {
+	require.NoError(t, os.MkdirAll(path.Dir(name), 0750))
+	require.NoError(t, os.WriteFile(name, []byte(content), 0640))
+}
+
+func readPtTestFile(t *testing.T, name string) string {
+	b, err := os.ReadFile(name)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func waitPtCopy(t *testing.T, e *Engine, db string, pt uint32) netstorage.PtCopyStatus {
+	var status netstorage.PtCopyStatus
+	require.Eventually(t, func() bool {
+		e.ptCopyMu.Lock()
+		job := e.ptCopies[ptCopyKey(db, pt)]
+		e.ptCopyMu.Unlock()
+		status = job.getStatus()
+		return status.Finished()
+	}, 10*time.Second, 5*time.Millisecond)
+	return status
+}
+
+func TestEngine_PtFileManifest(t *testing.T) {
+	e := newDecommissionTestEngine(t)
+	writePtTestFile(t, path.Join(e.ptDataPath("db0", 1), "rp0", "1_0_0", "tssp", "cpu_0000", "00000001-0000-00000000.tssp"), "tssp")
+	writePtTestFile(t, path.Join(e.ptDataPath("db0", 1), ptLockFile), "")
+	writePtTestFile(t, path.Join(e.ptWalPath("db0", 1), "rp0", "1_0_0", "1.wal"), "wal")
+
+	files, err := e.ptFileManifest("db0", 1, false)
+	require.NoError(t, err)
+	require.Equal(t, []*netstorage.PtFile{
+		{Path: "data/rp0/1_0_0/tssp/cpu_0000/00000001-0000-00000000.tssp", Size: 4},
+		{Path: "wal/rp0/1_0_0/1.wal", Size: 3},
+	}, files)
+
+	files, err = e.ptFileManifest("db0", 1, true)
+	require.NoError(t, err)
+	require.NotZero(t, files[0].Checksum)
+
+	// the db pt without files
+	files, err = e.ptFileManifest("db0", 2, false)
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	data, err := e.readPtFileChunk("db0", 1, "wal/rp0/1_0_0/1.wal", 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, "al", string(data))
+	for _, rel := range []string{"", "/etc/passwd", "data/../../1/LOCK", "data/./rp0", "other/rp0", "wal/rp0/../../../x"} {
+		_, err = e.readPtFileChunk("db0", 1, rel, 0, 10)
+		require.Error(t, err, rel)
+	}
+}
+
+func TestEngine_PtCopy(t *testing.T) {
+	src, dst := newDecommissionTestEngine(t), newDecommissionTestEngine(t)
+	tssp := path.Join(src.ptDataPath("db0", 1), "rp0", "1_0_0", "tssp", "cpu_0000", "00000001-0000-00000000.tssp")
+	wal := path.Join(src.ptWalPath("db0", 1), "rp0", "1_0_0", "1.wal")
+	writePtTestFile(t, tssp, "tssp")
+	writePtTestFile(t, wal, "wal")
+	fetcher := &mockPtFileFetcher{src: src}
+
+	req := &netstorage.PtCopyRequest{Db: "db0", Pt: 1, SourceNode: 1, Throughput: 1024 * 1024}
+	require.NoError(t, dst.startPtCopy(req, fetcher))
+	status := waitPtCopy(t, dst, "db0", 1)
+	require.Equal(t, netstorage.PtCopyDone, status.State)
+	require.Equal(t, 2, status.Files)
+	require.Equal(t, int64(7), status.TotalBytes)
+	require.Equal(t, int64(7), status.CopiedBytes)
+	// the files are staged, the db pt directories are not changed
+	stagingData, stagingWal := dst.ptStagingPaths("db0", 1)
+	require.Equal(t, "tssp", readPtTestFile(t, path.Join(stagingData, "data", "rp0", "1_0_0", "tssp", "cpu_0000", "00000001-0000-00000000.tssp")))
+	require.Equal(t, "wal", readPtTestFile(t, path.Join(stagingWal, "wal", "rp0", "1_0_0", "1.wal")))
+	_, err := os.Stat(dst.ptDataPath("db0", 1))
+	require.True(t, os.IsNotExist(err))
+
+	// the source is changed after the bulk copy: the tssp file is rewritten, the wal is appended and a file is added
+	writePtTestFile(t, tssp, "TSSP")
+	writePtTestFile(t, wal, "wal-tail")
+	writePtTestFile(t, path.Join(src.ptDataPath("db0", 1), "rp0", "index", "1_0_0", "part"), "index")
+
+	// the final copy is refused while the db pt is loaded
+	req.Final = true
+	dst.DBPartitions["db0"] = map[uint32]*DBPTInfo{1: {}}
+	require.NoError(t, dst.startPtCopy(req, fetcher))
+	status = waitPtCopy(t, dst, "db0", 1)
+	require.Equal(t, netstorage.PtCopyFailed, status.State)
+	require.Contains(t, status.Error, "is loaded")
+	delete(dst.DBPartitions, "db0")
+
+	require.NoError(t, dst.startPtCopy(req, fetcher))
+	status = waitPtCopy(t, dst, "db0", 1)
+	require.Equal(t, netstorage.PtCopyDone, status.State, status.Error)
+	require.Equal(t, status.TotalBytes, status.CopiedBytes)
+	require.Equal(t, "TSSP", readPtTestFile(t, path.Join(dst.ptDataPath("db0", 1), "rp0", "1_0_0", "tssp", "cpu_0000", "00000001-0000-00000000.tssp")))
+	require.Equal(t, "index", readPtTestFile(t, path.Join(dst.ptDataPath("db0", 1), "rp0", "index", "1_0_0", "part")))
+	require.Equal(t, "wal-tail", readPtTestFile(t, path.Join(dst.ptWalPath("db0", 1), "rp0", "1_0_0", "1.wal")))
+	_, err = os.Stat(stagingData)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestEngine_PtCopyFailedAndCancelled(t *testing.T) {
+	src, dst := newDecommissionTestEngine(t), newDecommissionTestEngine(t)
+	writePtTestFile(t, path.Join(src.ptDataPath("db0", 1), "rp0", "1_0_0", "tssp", "f.tssp"), "tssp")
+
+	// the files of a final copy are verified by their checksums
+	req := &netstorage.PtCopyRequest{Db: "db0", Pt: 1, SourceNode: 1, Throughput: 1024 * 1024, Final: true}
+	require.NoError(t, dst.startPtCopy(req, &mockPtFileFetcher{src: src, corrupt: true}))
+	status := waitPtCopy(t, dst, "db0", 1)
+	require.Equal(t, netstorage.PtCopyFailed, status.State)
+	require.Contains(t, status.Error, "checksum mismatch")
+	_, err := os.Stat(dst.ptDataPath("db0", 1))
+	require.True(t, os.IsNotExist(err))
+
+	// the staged files are kept by a cancel for a later copy, they are removed by a clean cancel
+	stagingData, _ := dst.ptStagingPaths("db0", 1)
+	require.NoError(t, dst.stopPtCopy("db0", 1, false))
+	_, err = os.Stat(stagingData)
+	require.NoError(t, err)
+	require.NoError(t, dst.stopPtCopy("db0", 1, true))
+	_, err = os.Stat(stagingData)
+	require.True(t, os.IsNotExist(err))
+
+	_, err = dst.getPtCopyStatus(netstorage.NewPtCopyStatusRequest("db0", 1))
+	require.EqualError(t, err, "no copy of db pt db0/1")
+}
@@ -97,6 +97,15 @@ type Engine struct {
 	metaClient    meta.MetaClient
 	fileInfos     chan []immutable.FileInfoExtend
 	backup        *Backup
+
+	maintenanceMu sync.Mutex
+	maintenances  map[uint64]*netstorage.ShardMaintenanceInfo // latest maintenance task of each shard
+
+	scrubMu sync.Mutex
+	scrubs  map[uint64]*netstorage.ScrubInfo // result of the last scrub of each shard
+
+	ptCopyMu sync.Mutex
+	ptCopies map[string]*ptCopyJob // copies of the db pts from the nodes being decommissioned
 }
 
 func NewEngine(dataPath, walPath string, options netstorage.EngineOptions, ctx *meta.LoadCtx) (netstorage.Engine, error) {
@@ -138,6 +147,10 @@ func NewEngine(dataPath, walPath string, options netstorage.EngineOptions, ctx *
 	immutable.SetMaxRowsPerSegment4TsStore(options.MaxRowsPerSegment)
 	obs.SetPrefixDataPath(dataPath)
 	immutable.Init()
+	if err := immutable.InitColdReadCache(options.ColdCacheDir, options.ColdCacheSize, options.ColdCacheBlockSize,
+		options.ColdPrefetchMeta); err != nil {
+		return nil, err
+	}
 
 	return eng, nil
 }
@@ -286,6 +299,7 @@ func (e *Engine) Close() error {
 	e.mu.Lock()
 
 	e.closed.Close()
+	e.cancelPtCopies()
 
 	start := time.Now()
 	log.Info("start close engine...")
@@ -588,6 +602,52 @@ func (e *Engine) ExpiredIndexes(nilIndexMap *map[uint64]*meta2.IndexDurationInfo
 	return res
 }
 
+// ExpireMeasurements deletes the rows of the measurements older than their TTL from the local shards.
+func (e *Engine) ExpireMeasurements(infos []meta2.ExpiredMeasurementInfos) error {
+	var firstErr error
+	for i := range infos {
+		if err := e.expireMeasurement(&infos[i]); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (e *Engine) expireMeasurement(info *meta2.ExpiredMeasurementInfos) error {
+	e.mu.RLock()
+	pts, ok := e.DBPartitions[info.Database]
+	if !ok || len(pts) == 0 {
+		e.mu.RUnlock()
+		return nil
+	}
+	ptIds, err := e.refDBPTsNoLock(pts, info.Database)
+	e.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	defer e.unrefDBPTs(info.Database, ptIds)
+
+	var firstErr error
+	for _, id := range ptIds {
+		pt := pts[id]
+		pt.mu.RLock()
+		for _, sh := range pt.shards {
+			if sh.GetIdent().Policy != info.Policy || sh.GetEngineType() != info.EngineType {
+				continue
+			}
+			if err = sh.ExpireMeasurement(info.Name, info.ExpireTime); err != nil {
+				e.log.Error("expire measurement fail", zap.String("db", info.Database), zap.Uint32("pt", id),
+					zap.Uint64("shard", sh.GetID()), zap.String("name", info.Name), zap.Error(err))
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
+		}
+		pt.mu.RUnlock()
+	}
+	return firstErr
+}
+
 // todo:need confirm
 func (e *Engine) DeleteShard(db string, ptId uint32, shardID uint64) error {
 	e.log.Info("start delete shard...", zap.String("db", db), zap.Uint64("shardID", shardID))
@@ -964,7 +1024,11 @@ func (e *Engine) WriteRows(db, rp string, ptId uint32, shardID uint64, rows []in
 	if snp != nil {
 		sh.SetSnapShotter(snp)
 	}
-	return sh.WriteRows(rows, binaryRows)
+	if err = sh.WriteRows(rows, binaryRows); err != nil {
+		return err
+	}
+	e.addDbPTWriteRows(db, ptId, int64(len(rows)))
+	return nil
 }
 
 func (e *Engine) WriteRec(db, mst string, ptId uint32, shardID uint64, rec *record.Record, binaryRec []byte) error {
@@ -972,7 +1036,11 @@ func (e *Engine) WriteRec(db, mst string, ptId uint32, shardID uint64, rec *reco
 	if err != nil {
 		return err
 	}
-	return sh.WriteCols(mst, rec, binaryRec)
+	if err = sh.WriteCols(mst, rec, binaryRec); err != nil {
+		return err
+	}
+	e.addDbPTWriteRows(db, ptId, int64(rec.RowNums()))
+	return nil
 }
 
 func (e *Engine) CreateShard(db, rp string, ptId uint32, shardID uint64, timeRangeInfo *meta2.ShardTimeRangeInfo, mstInfo *meta2.MeasurementInfo) error {
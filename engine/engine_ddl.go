@@ -236,8 +236,116 @@ func (e *Engine) DropMeasurement(db string, rp string, name string, shardIds []u
 	return nil
 }
 
-func (e *Engine) DropSeries(database string, sources []influxql.Source, ptId []uint32, condition influxql.Expr) (int, error) {
-	panic("implement me")
+// DropSeries deletes the rows of the series matching the condition, and drops the series from the index
+// whose time range is covered by the condition. Only the shards and indexes of rp are affected if rp is not empty.
+// The series are kept in an index whose time range is only partially covered, even if all of their rows are
+// deleted, since the index does not know the time range of each series. They are still returned by SHOW SERIES
+// until the index expires or a condition covering the time range of the index drops them.
+// Returns the number of matched series.
+func (e *Engine) DropSeries(database string, rp string, sources []influxql.Source, ptId []uint32, condition influxql.Expr) (int, error) {
+	expr, tr, err := influxql.ConditionExpr(condition, nil)
+	if err != nil {
+		return 0, err
+	}
+	tr = influxql.TimeRange{Min: tr.MinTime(), Max: tr.MaxTime()}
+
+	e.mu.RLock()
+	if ptId, err = e.checkAndAddRefPTSNoLock(database, ptId); err != nil {
+		e.mu.RUnlock()
+		return 0, err
+	}
+	defer e.unrefDBPTs(database, ptId)
+	pts, ok := e.DBPartitions[database]
+	e.mu.RUnlock()
+	if !ok {
+		return 0, nil
+	}
+
+	series := make(map[uint64]struct{})
+	for _, source := range sources {
+		m, ok := source.(*influxql.Measurement)
+		if !ok {
+			continue
+		}
+		for _, id := range ptId {
+			pt, ok := pts[id]
+			if !ok {
+				continue
+			}
+			pt.mu.RLock()
+			err = e.deleteSeries(pt, rp, m.Name, expr, tr, series)
+			pt.mu.RUnlock()
+			if err != nil {
+				e.log.Error("delete series fail", zap.String("db", database), zap.Uint32("pt", id),
+					zap.String("name", m.Name), zap.Error(err))
+				return 0, err
+			}
+		}
+	}
+	return len(series), nil
+}
+
+func (e *Engine) deleteSeries(pt *DBPTInfo, rp string, name string, expr influxql.Expr, tr influxql.TimeRange, series map[uint64]struct{}) error {
+	// the series of each index
+	indexSids := make(map[uint64][]uint64, len(pt.indexBuilder))
+	searchSids := func(iBuild *tsi.IndexBuilder) ([]uint64, error) {
+		if sids, ok := indexSids[iBuild.GetIndexID()]; ok {
+			return sids, nil
+		}
+		idx, ok := iBuild.GetPrimaryIndex().(*tsi.MergeSetIndex)
+		if !ok {
+			return nil, meta2.ErrUnsupportCommand
+		}
+		sids, err := idx.SearchTSIDs([]byte(name), expr)
+		if err != nil {
+			return nil, err
+		}
+		indexSids[iBuild.GetIndexID()] = sids
+		for _, sid := range sids {
+			series[sid] = struct{}{}
+		}
+		return sids, nil
+	}
+
+	// the indexes of the retention policy
+	rpIndexes := make(map[uint64]struct{})
+	for _, sh := range pt.shards {
+		if rp != "" && sh.GetRPName() != rp {
+			continue
+		}
+		rpIndexes[sh.GetIndexBuilder().GetIndexID()] = struct{}{}
+		if !sh.Intersect(&tr) {
+			continue
+		}
+		if sh.GetEngineType() != config.TSSTORE {
+			return meta2.ErrUnsupportCommand
+		}
+		sids, err := searchSids(sh.GetIndexBuilder())
+		if err != nil {
+			return err
+		}
+		if err = sh.DeleteSeries(name, sids, tr.MinTimeNano(), tr.MaxTimeNano()); err != nil {
+			return err
+		}
+	}
+
+	// drop the series from the index if all the rows are deleted
+	for id, iBuild := range pt.indexBuilder {
+		if _, ok := rpIndexes[id]; !ok && rp != "" {
+			continue
+		}
+		if !iBuild.CoveredBy(tr) {
+			continue
+		}
+		sids, err := searchSids(iBuild)
+		if err != nil {
+			return err
+		}
+		if err = iBuild.GetPrimaryIndex().(*tsi.MergeSetIndex).DeleteSeries(sids); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (e *Engine) TagKeys(db string, ptIDs []uint32, measurements [][]byte, condition influxql.Expr, tr influxql.TimeRange) ([]string, error) {
@@ -168,3 +168,22 @@ func (e *Engine) getRaftGroupId(dataBase string, ptId uint32) (uint32, error) {
 	}
 	return rgId, nil
 }
+
+// getReplicaReadState returns the read state of the raft nodes of the database on this node,
+// it is used by ts-sql to route the queries to the follower replicas
+func (e *Engine) getReplicaReadState(param map[string]string) (map[string]string, error) {
+	db := param["db"]
+	states := make(map[uint32]*netstorage.ReplicaReadState)
+
+	e.mu.RLock()
+	for pt, dbPt := range e.DBPartitions[db] {
+		if dbPt.node == nil {
+			continue
+		}
+		state := dbPt.node.ReadState()
+		states[pt] = &state
+	}
+	e.mu.RUnlock()
+
+	return netstorage.EncodeReplicaReadState(states)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/lib/netstorage"
 	"github.com/openGemini/openGemini/lib/raftconn"
 	"github.com/openGemini/openGemini/lib/raftlog"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
@@ -136,6 +137,10 @@ func (n mockNode) TransferLeadership(newLeader uint64) error {
 
 func (mockNode) StepRaftMessage(msg []raftpb.Message) {}
 
+func (mockNode) ReadState() netstorage.ReplicaReadState {
+	return netstorage.ReplicaReadState{Staleness: 10}
+}
+
 func (mockNode) Stop() {}
 
 func (n mockNode) GenerateProposeId() uint64 {
@@ -190,3 +195,23 @@ func TestCheckRepGroupStatus(t *testing.T) {
 	err2 := e.checkRepGroupStatus(1, "testUnFull", 1)
 	assert1.Equal(t, "Got database: testUnFull, ptId: 1 raftGroup is still UnFull, opId:1", err2.Error())
 }
+
+func TestGetReplicaReadState(t *testing.T) {
+	e := &Engine{
+		log: logger.NewLogger(errno.ModuleUnknown),
+		DBPartitions: map[string]map[uint32]*DBPTInfo{
+			"testDB": {
+				1: &DBPTInfo{
+					node: &mockNode{},
+				},
+				2: &DBPTInfo{},
+			},
+		},
+	}
+	res, err := e.getReplicaReadState(map[string]string{"db": "testDB"})
+	assert1.NoError(t, err)
+	states, err := netstorage.DecodeReplicaReadState(res)
+	assert1.NoError(t, err)
+	assert1.Equal(t, 1, len(states))
+	assert1.Equal(t, int64(10), states[1].Staleness)
+}
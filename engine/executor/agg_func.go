@@ -636,6 +636,14 @@ func NewStdReduce(isStddev bool) SliceReduce[float64] {
 	}
 }
 
+type sliceItemByTime[T util.NumberOnly] struct {
+	*SliceItem[T]
+}
+
+func (f sliceItemByTime[T]) Less(i, j int) bool {
+	return f.time[i] < f.time[j]
+}
+
 func BooleanFirstReduce(c Chunk, values []bool, ordinal, start, end int) (int, bool, bool) {
 	column := c.Column(ordinal)
 	times := c.Time()
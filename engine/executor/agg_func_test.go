@@ -559,3 +559,61 @@ func TestFloatStdReduce(t *testing.T) {
 		t.Fatal("not expect, value ", val, "time", time)
 	}
 }
+
+func TestTimeWeightedWindow(t *testing.T) {
+	times := []int64{100, 110, 130, 200}
+	values := []float64{2, 1, 4, 3}
+	noPrev := newPoint[float64]()
+
+	// (2+1)/2*10 + (1+4)/2*20 + (4+3)/2*70 = 310 over 100
+	area, duration := timeWeightedWindow(times, values, noPrev, 100, true, 0)
+	if area != 310 || duration != 100 {
+		t.Fatal("not expect, area ", area, "duration", duration)
+	}
+
+	// 2*10 + 1*20 + 4*70 = 320 over 100
+	area, duration = timeWeightedWindow(times, values, noPrev, 100, false, 0)
+	if area != 320 || duration != 100 {
+		t.Fatal("not expect, area ", area, "duration", duration)
+	}
+
+	// the 70ns gap is skipped: 2*10 + 1*20 = 40 over 30
+	area, duration = timeWeightedWindow(times, values, noPrev, 100, false, 50)
+	if area != 40 || duration != 30 {
+		t.Fatal("not expect, area ", area, "duration", duration)
+	}
+
+	// every gap is skipped: each point weighs one
+	area, duration = timeWeightedWindow(times, values, noPrev, 100, false, 1)
+	if area != 10 || duration != 4 {
+		t.Fatal("not expect, area ", area, "duration", duration)
+	}
+
+	// the previous window ended with 6 at 80, the window starts at 90
+	prev := newPoint[float64]()
+	prev.Set(0, 80, 6)
+	area, duration = timeWeightedWindow(times, values, prev, 90, false, 0)
+	if area != 320+6*10 || duration != 110 {
+		t.Fatal("not expect, area ", area, "duration", duration)
+	}
+	// the line from (80, 6) to (100, 2) is at 4 at the start of the window
+	area, duration = timeWeightedWindow(times, values, prev, 90, true, 0)
+	if area != 310+(4+2)/2*10 || duration != 110 {
+		t.Fatal("not expect, area ", area, "duration", duration)
+	}
+	// the previous point is 20ns before the first one, too far to be carried
+	area, duration = timeWeightedWindow(times, values, prev, 90, false, 15)
+	if area != 20 || duration != 10 {
+		t.Fatal("not expect, area ", area, "duration", duration)
+	}
+
+	// a single point covers the time since the start of the window
+	area, duration = timeWeightedWindow([]int64{100}, []float64{7}, prev, 90, false, 0)
+	if area != 60 || duration != 10 {
+		t.Fatal("not expect, area ", area, "duration", duration)
+	}
+	area, duration = timeWeightedWindow([]int64{100}, []float64{7}, noPrev, 90, false, 0)
+	if area != 7 || duration != 1 {
+		t.Fatal("not expect, area ", area, "duration", duration)
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"sort"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+func init() {
+	RegistryAggOp(query.TimeWeightedArea, &TimeWeightedOp{area: true})
+	RegistryAggOp(query.TimeWeightedDuration, &TimeWeightedOp{})
+}
+
+// TimeWeightedOp creates the routines of the partial calls time_weighted_avg is split into,
+// the weighted area of each window or the duration it covers.
+type TimeWeightedOp struct {
+	area bool
+}
+
+func (c *TimeWeightedOp) CreateRoutine(params *AggCallFuncParams) (Routine, error) {
+	inRowDataType, outRowDataType, opt, isSingleCall := params.InRowDataType, params.OutRowDataType, params.ExprOpt, params.IsSingleCall
+	call := opt.Expr.(*influxql.Call)
+	inOrdinal := inRowDataType.FieldIndex(call.Args[0].(*influxql.VarRef).Val)
+	outOrdinal := outRowDataType.FieldIndex(opt.Ref.Val)
+	if inOrdinal < 0 || outOrdinal < 0 {
+		return nil, errno.NewError(errno.SchemaNotAligned, call.Name, "input and output schemas are not aligned")
+	}
+
+	linear := true
+	if len(call.Args) > 1 {
+		if method, ok := call.Args[1].(*influxql.StringLiteral); ok {
+			linear = method.Val != query.TimeWeightLOCF
+		}
+	}
+	var maxGap int64
+	if len(call.Args) > 2 {
+		if gap, ok := call.Args[2].(*influxql.DurationLiteral); ok {
+			maxGap = int64(gap.Val)
+		}
+	}
+
+	dataType := inRowDataType.Field(inOrdinal).Expr.(*influxql.VarRef).Type
+	switch dataType {
+	case influxql.Float:
+		return NewRoutineImpl(NewTimeWeightedIterator[float64](Column.FloatValues, c.area, linear, maxGap,
+			isSingleCall, inOrdinal, outOrdinal, params.Opt), inOrdinal, outOrdinal), nil
+	case influxql.Integer:
+		return NewRoutineImpl(NewTimeWeightedIterator[int64](Column.IntegerValues, c.area, linear, maxGap,
+			isSingleCall, inOrdinal, outOrdinal, params.Opt), inOrdinal, outOrdinal), nil
+	default:
+		return nil, errno.NewError(errno.UnsupportedDataType, call.Name, dataType.String())
+	}
+}
+
+// TimeWeightedIterator computes one partial call of time_weighted_avg window by window. The points of a
+// window spanning several chunks are buffered until the window ends, and the latest point of each window is
+// carried into the next window of the same series to weight the gap before its first point.
+// The carry only applies to GROUP BY time in ascending order, and it does not cross the stores: the leading
+// gap of the first window a store reads is not weighted when the previous point is stored elsewhere.
+type TimeWeightedIterator[T util.NumberOnly] struct {
+	isSingleCall bool
+	area         bool
+	linear       bool
+	carry        bool
+	sameTag      bool
+	maxGap       int64
+	inOrdinal    int
+	outOrdinal   int
+	opt          *query.ProcessorOptions
+	getValues    func(Column) []T
+	buf          *SliceItem[T]
+	prev         *Point[float64]
+	values       []float64
+}
+
+func NewTimeWeightedIterator[T util.NumberOnly](getValues func(Column) []T, area, linear bool, maxGap int64,
+	isSingleCall bool, inOrdinal, outOrdinal int, opt *query.ProcessorOptions,
+) *TimeWeightedIterator[T] {
+	return &TimeWeightedIterator[T]{
+		isSingleCall: isSingleCall,
+		area:         area,
+		linear:       linear,
+		carry:        opt.Ascending && !opt.Interval.IsZero(),
+		maxGap:       maxGap,
+		inOrdinal:    inOrdinal,
+		outOrdinal:   outOrdinal,
+		opt:          opt,
+		getValues:    getValues,
+		buf:          NewSliceItem[T](),
+		prev:         newPoint[float64](),
+	}
+}
+
+func (r *TimeWeightedIterator[T]) Next(ie *IteratorEndpoint, p *IteratorParams) {
+	inChunk, outChunk := ie.InputPoint.Chunk, ie.OutputPoint.Chunk
+	inColumn := inChunk.Column(r.inOrdinal)
+	outColumn := outChunk.Column(r.outOrdinal)
+	values := r.getValues(inColumn)
+	tagIndex := inChunk.TagIndex()
+
+	var end, tagPos int
+	lastIndex := len(inChunk.IntervalIndex()) - 1
+	for i, start := range inChunk.IntervalIndex() {
+		if i < lastIndex {
+			end = inChunk.IntervalIndex()[i+1]
+		} else {
+			end = inChunk.NumberOfRows()
+		}
+		if i == 0 && r.buf.Len() == 0 && !r.sameTag {
+			r.prev.Reset()
+		}
+		for tagPos+1 < len(tagIndex) && start >= tagIndex[tagPos+1] {
+			tagPos++
+			r.prev.Reset()
+		}
+		if !r.isSingleCall {
+			start, end = inColumn.GetRangeValueIndexV2(start, end)
+			if start == end && r.buf.Len() == 0 && (i < lastIndex || !p.sameInterval) {
+				outColumn.AppendNil()
+				continue
+			}
+		}
+		r.buf.AppendItem(inChunk, r.inOrdinal, start, end, values)
+		if i == lastIndex && p.sameInterval {
+			// the window goes on in the next chunk
+			break
+		}
+		if r.buf.Len() > 0 {
+			r.reduce(outChunk)
+		}
+		r.buf.Reset()
+	}
+	r.sameTag = p.sameTag
+}
+
+func (r *TimeWeightedIterator[T]) reduce(outChunk Chunk) {
+	sort.Stable(sliceItemByTime[T]{r.buf})
+	times := r.buf.time
+	r.values = r.values[:0]
+	for _, v := range r.buf.value {
+		r.values = append(r.values, float64(v))
+	}
+
+	windowStart, _ := r.opt.Window(times[0])
+	area, duration := timeWeightedWindow(times, r.values, r.prev, windowStart, r.linear, r.maxGap)
+	if r.carry {
+		last := len(times) - 1
+		r.prev.Set(0, times[last], r.values[last])
+	}
+
+	value := duration
+	if r.area {
+		value = area
+	}
+	if r.isSingleCall {
+		outChunk.AppendTime(times[0])
+		outChunk.AppendIntervalIndex(outChunk.Len() - 1)
+	}
+	outColumn := outChunk.Column(r.outOrdinal)
+	outColumn.AppendNotNil()
+	outColumn.AppendFloatValue(value)
+}
+
+// timeWeightedWindow returns the area below the points of a window sorted by time and the duration they cover.
+// With linear weighting the area between two adjacent points is a trapezoid, with locf the earlier value is
+// carried forward until the next point. prev, when set, is the latest point before the window: it covers the
+// gap between the start of the window and its first point. Gaps longer than maxGap (when positive) are not
+// weighted at all, so a silent device does not dominate the result. When the points cover no time, each of
+// them weighs one so that the area divided by the duration falls back to their mean.
+func timeWeightedWindow(times []int64, values []float64, prev *Point[float64], windowStart int64,
+	linear bool, maxGap int64) (float64, float64) {
+	var area, duration float64
+	if !prev.isNil && prev.time < windowStart && windowStart < times[0] {
+		if gap := times[0] - prev.time; maxGap <= 0 || gap <= maxGap {
+			lead := float64(times[0] - windowStart)
+			if linear {
+				start := linearFloat(windowStart, prev.time, times[0], prev.value, values[0])
+				area += 0.5 * (start + values[0]) * lead
+			} else {
+				area += prev.value * lead
+			}
+			duration += lead
+		}
+	}
+
+	for i := 0; i < len(times)-1; i++ {
+		dt := times[i+1] - times[i]
+		if dt <= 0 || (maxGap > 0 && dt > maxGap) {
+			continue
+		}
+		if linear {
+			area += 0.5 * (values[i] + values[i+1]) * float64(dt)
+		} else {
+			area += values[i] * float64(dt)
+		}
+		duration += float64(dt)
+	}
+	if duration == 0 {
+		area = 0
+		for _, v := range values {
+			area += v
+		}
+		return area, float64(len(values))
+	}
+	return area, duration
+}
@@ -254,6 +254,7 @@ func (trans *StreamAggregateTransform) reduce(
 		}
 	}()
 	var ok bool
+	trans.iteratorParam.ctx = ctx
 	// get first chunk
 	trans.bufChunk, ok = <-trans.inputChunk
 	if !ok {
@@ -146,6 +146,71 @@ func TestStreamAggregateTransformIntegral(t *testing.T) {
 	)
 }
 
+func buildInRowDataTypeTimeWeighted() hybridqp.RowDataType {
+	return hybridqp.NewRowDataTypeImpl(
+		influxql.VarRef{Val: "value1", Type: influxql.Float},
+	)
+}
+
+func buildDstRowDataTypeTimeWeighted() hybridqp.RowDataType {
+	return hybridqp.NewRowDataTypeImpl(
+		influxql.VarRef{Val: "time_weighted_area(\"value1\", 'locf')", Type: influxql.Float},
+		influxql.VarRef{Val: "time_weighted_duration(\"value1\", 'locf')", Type: influxql.Float},
+	)
+}
+
+func TestStreamAggregateTransformTimeWeighted(t *testing.T) {
+	b := executor.NewChunkBuilder(buildInRowDataTypeTimeWeighted())
+
+	// the window [10, 20) of name=a goes on in the second chunk
+	inCk1 := b.NewChunk("mst")
+	inCk1.AppendTagsAndIndexes([]executor.ChunkTags{*ParseChunkTags("name=a")}, []int{0})
+	inCk1.AppendIntervalIndexes([]int{0, 2})
+	inCk1.AppendTimes([]int64{0, 5, 12})
+	inCk1.Column(0).AppendFloatValues([]float64{1, 3, 2})
+	inCk1.Column(0).AppendManyNotNil(3)
+
+	inCk2 := b.NewChunk("mst")
+	inCk2.AppendTagsAndIndexes([]executor.ChunkTags{*ParseChunkTags("name=a"), *ParseChunkTags("name=b")}, []int{0, 2})
+	inCk2.AppendIntervalIndexes([]int{0, 1, 2})
+	inCk2.AppendTimes([]int64{16, 25, 21})
+	inCk2.Column(0).AppendFloatValues([]float64{4, 6, 5})
+	inCk2.Column(0).AppendManyNotNil(3)
+
+	dst := executor.NewChunkBuilder(buildDstRowDataTypeTimeWeighted()).NewChunk("mst")
+	dst.AppendTagsAndIndexes([]executor.ChunkTags{*ParseChunkTags("name=a"), *ParseChunkTags("name=b")}, []int{0, 3})
+	dst.AppendIntervalIndexes([]int{0, 1, 2, 3})
+	dst.AppendTimes([]int64{0, 16, 25, 21})
+	// [10, 20) weighs 3 from its start to 12, [20, 30) weighs 4 from its start to 25,
+	// name=b has nothing to carry and its single point weighs one
+	dst.Column(0).AppendFloatValues([]float64{5, 3*2 + 2*4, 4 * 5, 5})
+	dst.Column(0).AppendManyNotNil(4)
+	dst.Column(1).AppendFloatValues([]float64{5, 6, 5, 1})
+	dst.Column(1).AppendManyNotNil(4)
+
+	exprOpt := make([]hybridqp.ExprOptions, 0, 2)
+	for i, name := range []string{query.TimeWeightedArea, query.TimeWeightedDuration} {
+		exprOpt = append(exprOpt, hybridqp.ExprOptions{
+			Expr: &influxql.Call{Name: name, Args: []influxql.Expr{hybridqp.MustParseExpr("value1"), &influxql.StringLiteral{Val: query.TimeWeightLOCF}}},
+			Ref:  *buildDstRowDataTypeTimeWeighted().Field(i).Expr.(*influxql.VarRef),
+		})
+	}
+	opt := query.ProcessorOptions{
+		Dimensions: []string{"name"},
+		Interval:   hybridqp.Interval{Duration: 10 * time.Nanosecond},
+		Ordered:    true,
+		Ascending:  true,
+		ChunkSize:  10,
+	}
+
+	testStreamAggregateTransformBase(
+		t,
+		[]executor.Chunk{inCk1, inCk2}, []executor.Chunk{dst},
+		buildInRowDataTypeTimeWeighted(), buildDstRowDataTypeTimeWeighted(),
+		exprOpt, &opt, false,
+	)
+}
+
 func buildInRowDataTypeElapsed() hybridqp.RowDataType {
 	rowDataType := hybridqp.NewRowDataTypeImpl(
 		influxql.VarRef{Val: "value1", Type: influxql.Integer},
@@ -0,0 +1,505 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/openGemini/openGemini/engine/op"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+// anomalyReduces are the native implementations of the common castor algorithms,
+// they run on the chunks of the query with no python worker
+var anomalyReduces = map[string]WideReduce{
+	op.MadAnomaly:    MadAnomalyReduce,
+	op.StlAnomaly:    StlAnomalyReduce,
+	op.EwmaAnomaly:   EwmaAnomalyReduce,
+	op.ArimaForecast: ArimaForecastReduce,
+}
+
+const (
+	// madScale converts the median absolute deviation into the standard deviation of a normal distribution
+	madScale = 1.4826
+	// meanAbsDevScale converts the mean absolute deviation into the standard deviation of a normal distribution
+	meanAbsDevScale = 1.2533
+
+	stlIterations = 3
+	fitEpsilon    = 1e-9
+)
+
+type anomalySeries struct {
+	tags   ChunkTags
+	times  []int64
+	values []float64
+}
+
+// groupAnomalySeries collects the not nil values of every series of the chunks, in the order the series come
+func groupAnomalySeries(in []Chunk) []*anomalySeries {
+	var series []*anomalySeries
+	index := make(map[string]*anomalySeries)
+	for _, c := range in {
+		tags, tagIdx := c.Tags(), c.TagIndex()
+		if len(tagIdx) == 0 {
+			tags, tagIdx = []ChunkTags{{}}, []int{0}
+		}
+		col, times := c.Column(0), c.Time()
+		isInteger := col.DataType() == influxql.Integer
+		valIdx := 0
+		for i := range tagIdx {
+			end := c.NumberOfRows()
+			if i < len(tagIdx)-1 {
+				end = tagIdx[i+1]
+			}
+			key := string(tags[i].subset)
+			s, ok := index[key]
+			if !ok {
+				s = &anomalySeries{tags: tags[i]}
+				index[key] = s
+				series = append(series, s)
+			}
+			for row := tagIdx[i]; row < end; row++ {
+				if col.IsNilV2(row) {
+					continue
+				}
+				s.times = append(s.times, times[row])
+				if isInteger {
+					s.values = append(s.values, float64(col.IntegerValues()[valIdx]))
+				} else {
+					s.values = append(s.values, col.FloatValues()[valIdx])
+				}
+				valIdx++
+			}
+		}
+	}
+	return series
+}
+
+func appendAnomalySeries(out Chunk, tags ChunkTags, times []int64, values []float64) {
+	if len(times) == 0 {
+		return
+	}
+	out.AppendIntervalIndex(out.Len())
+	out.AppendTagsAndIndex(tags, out.Len())
+	out.AppendTimes(times)
+	out.Column(0).AppendFloatValues(values)
+	out.Column(0).AppendManyNotNil(len(values))
+}
+
+// anomalyParams returns the literal arguments following the field, the omitted ones take the defaults
+func anomalyParams(args []interface{}, defaults ...float64) ([]float64, error) {
+	if len(args) == 0 {
+		return defaults, nil
+	}
+	exprs, ok := args[0].([]influxql.Expr)
+	if !ok {
+		return nil, errno.NewError(errno.TypeAssertFail, influxql.AnyField)
+	}
+	params := append([]float64{}, defaults...)
+	for i := 0; i < len(exprs) && i < len(params); i++ {
+		if v, ok := op.AnomalyArgValue(exprs[i]); ok {
+			params[i] = v
+		}
+	}
+	return params, nil
+}
+
+// detectAnomalies outputs the points of every series whose absolute score is above the threshold, with the score
+func detectAnomalies(in []Chunk, out Chunk, threshold float64, score func(values []float64) []float64) error {
+	if len(in) == 0 {
+		return errno.NewError(errno.EmptyData)
+	}
+	out.SetName(in[0].Name())
+	for _, s := range groupAnomalySeries(in) {
+		var times []int64
+		var values []float64
+		for i, z := range score(s.values) {
+			if math.Abs(z) > threshold {
+				times = append(times, s.times[i])
+				values = append(values, z)
+			}
+		}
+		appendAnomalySeries(out, s.tags, times, values)
+	}
+	return nil
+}
+
+// MadAnomalyReduce is mad_anomaly(field[, threshold]), the points whose robust z-score exceeds the threshold
+func MadAnomalyReduce(_ context.Context, in []Chunk, out Chunk, args ...interface{}) error {
+	params, err := anomalyParams(args, op.DefaultMadThreshold)
+	if err != nil {
+		return err
+	}
+	return detectAnomalies(in, out, params[0], RobustZScores)
+}
+
+// StlAnomalyReduce is stl_anomaly(field, period[, threshold]), the points whose residual of the seasonal
+// decomposition has a robust z-score above the threshold
+func StlAnomalyReduce(_ context.Context, in []Chunk, out Chunk, args ...interface{}) error {
+	params, err := anomalyParams(args, 0, op.DefaultStlThreshold)
+	if err != nil {
+		return err
+	}
+	period := int(params[0])
+	if period < 2 {
+		return errno.NewError(errno.InvalidFuncArg, "period", op.StlAnomaly, "must be at least 2")
+	}
+	return detectAnomalies(in, out, params[1], func(values []float64) []float64 {
+		_, _, residual := STLDecompose(values, period)
+		return RobustZScores(residual)
+	})
+}
+
+// EwmaAnomalyReduce is ewma_anomaly(field[, alpha[, k]]), the points where the EWMA leaves the k sigma control limits
+func EwmaAnomalyReduce(_ context.Context, in []Chunk, out Chunk, args ...interface{}) error {
+	params, err := anomalyParams(args, op.DefaultEwmaAlpha, op.DefaultEwmaK)
+	if err != nil {
+		return err
+	}
+	alpha := params[0]
+	return detectAnomalies(in, out, params[1], func(values []float64) []float64 {
+		return EwmaScores(values, alpha)
+	})
+}
+
+// ArimaForecastReduce is arima_forecast(field, n[, p]), the next n points of every series by an ARIMA(p,1,0) model,
+// the points are spaced by the median interval of the series
+func ArimaForecastReduce(_ context.Context, in []Chunk, out Chunk, args ...interface{}) error {
+	if len(in) == 0 {
+		return errno.NewError(errno.EmptyData)
+	}
+	params, err := anomalyParams(args, 0, op.DefaultArimaOrder)
+	if err != nil {
+		return err
+	}
+	n, p := int(params[0]), int(params[1])
+	if n < 1 || n > op.MaxForecastPoints {
+		return errno.NewError(errno.InvalidFuncArg, "n", op.ArimaForecast, "out of range")
+	}
+	out.SetName(in[0].Name())
+	for _, s := range groupAnomalySeries(in) {
+		if len(s.times) < 2 {
+			continue
+		}
+		step := medianStep(s.times)
+		if step <= 0 {
+			continue
+		}
+		values := ArimaForecast(s.values, n, p)
+		times := make([]int64, n)
+		last := s.times[len(s.times)-1]
+		for i := range times {
+			times[i] = last + int64(i+1)*step
+		}
+		appendAnomalySeries(out, s.tags, times, values)
+	}
+	return nil
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return (sorted[mid-1] + sorted[mid]) / 2
+}
+
+func medianStep(times []int64) int64 {
+	steps := make([]float64, 0, len(times)-1)
+	for i := 1; i < len(times); i++ {
+		steps = append(steps, float64(times[i]-times[i-1]))
+	}
+	return int64(median(steps))
+}
+
+// robustLocationScale returns the median and the robust standard deviation of the values,
+// the mean absolute deviation is used if more than half of the values are equal
+func robustLocationScale(values []float64) (float64, float64) {
+	med := median(values)
+	dev := make([]float64, len(values))
+	var sum float64
+	for i, v := range values {
+		dev[i] = math.Abs(v - med)
+		sum += dev[i]
+	}
+	if scale := madScale * median(dev); scale > 0 {
+		return med, scale
+	}
+	if len(values) == 0 {
+		return med, 0
+	}
+	return med, meanAbsDevScale * sum / float64(len(values))
+}
+
+// RobustZScores returns the robust z-score (x - median) / (1.4826 * MAD) of every value, all zero for a constant series
+func RobustZScores(values []float64) []float64 {
+	scores := make([]float64, len(values))
+	med, scale := robustLocationScale(values)
+	if scale == 0 {
+		return scores
+	}
+	for i, v := range values {
+		scores[i] = (v - med) / scale
+	}
+	return scores
+}
+
+// STLDecompose splits the values into trend, seasonal and residual components like STL. The seasonal component is the
+// median of every cycle-subseries of the detrended values and the trend is the local linear fit of the deseasonalized
+// values over a period. Both are refined by a few iterations, and the points with large residuals get small
+// bisquare robustness weights in the trend fit so that the anomalies do not leak into the trend of their neighbours.
+// The values are assumed to be evenly spaced, there is no seasonal component if the series is shorter than two periods
+func STLDecompose(values []float64, period int) ([]float64, []float64, []float64) {
+	n := len(values)
+	trend := make([]float64, n)
+	seasonal := make([]float64, n)
+	residual := make([]float64, n)
+	weights := make([]float64, n)
+	buf := make([]float64, n)
+	for i := range weights {
+		weights[i] = 1
+	}
+	window := period
+	if window%2 == 0 {
+		window++
+	}
+
+	for iter := 0; iter < stlIterations; iter++ {
+		if n >= 2*period {
+			cycle := make([]float64, period)
+			var sum float64
+			for p := range cycle {
+				sub := buf[:0]
+				for i := p; i < n; i += period {
+					sub = append(sub, values[i]-trend[i])
+				}
+				cycle[p] = median(sub)
+				sum += cycle[p]
+			}
+			mean := sum / float64(period)
+			for i := range seasonal {
+				seasonal[i] = cycle[i%period] - mean
+			}
+		}
+		for i := range buf {
+			buf[i] = values[i] - seasonal[i]
+		}
+		localLinearTrend(buf, weights, window, trend)
+		for i, v := range values {
+			residual[i] = v - trend[i] - seasonal[i]
+		}
+		bisquareWeights(residual, weights)
+	}
+	return trend, seasonal, residual
+}
+
+// bisquareWeights writes the robustness weights (1 - (r/h)^2)^2 of the residuals into dst, h is 6 times the median
+// absolute residual
+func bisquareWeights(residual []float64, dst []float64) {
+	abs := make([]float64, len(residual))
+	for i, r := range residual {
+		abs[i] = math.Abs(r)
+	}
+	h := 6 * median(abs)
+	for i, r := range abs {
+		switch {
+		case h == 0:
+			dst[i] = 1
+		case r >= h:
+			dst[i] = 0
+		default:
+			u := r / h
+			dst[i] = (1 - u*u) * (1 - u*u)
+		}
+	}
+}
+
+// localLinearTrend writes the weighted local linear fit of the values over a window of the given size into dst.
+// With equal weights it is the centered moving average inside the series, the window is shifted instead of shrunk
+// at both ends so that the trend is extrapolated there
+func localLinearTrend(values, weights []float64, window int, dst []float64) {
+	n := len(values)
+	if window > n {
+		window = n
+	}
+	// prefix sums of w, w*x, w*y, w*x*x and w*x*y with x the index
+	sw, sx, sy, sxx, sxy := make([]float64, n+1), make([]float64, n+1), make([]float64, n+1),
+		make([]float64, n+1), make([]float64, n+1)
+	for i, v := range values {
+		x, w := float64(i), weights[i]
+		sw[i+1], sx[i+1], sy[i+1] = sw[i]+w, sx[i]+w*x, sy[i]+w*v
+		sxx[i+1], sxy[i+1] = sxx[i]+w*x*x, sxy[i]+w*x*v
+	}
+	half := window / 2
+	for i := range values {
+		lo := i - half
+		if lo < 0 {
+			lo = 0
+		}
+		if lo > n-window {
+			lo = n - window
+		}
+		hi := lo + window
+		w := sw[hi] - sw[lo]
+		if w <= 0 {
+			// all the points of the window are outliers
+			dst[i] = values[i]
+			continue
+		}
+		mx, my := (sx[hi]-sx[lo])/w, (sy[hi]-sy[lo])/w
+		varX := (sxx[hi]-sxx[lo])/w - mx*mx
+		if varX <= fitEpsilon {
+			dst[i] = my
+			continue
+		}
+		slope := ((sxy[hi]-sxy[lo])/w - mx*my) / varX
+		dst[i] = my + slope*(float64(i)-mx)
+	}
+}
+
+// EwmaScores returns the distance of the EWMA from the median of the values, in the standard deviations of the EWMA.
+// The EWMA starts at the median and the deviation of the values is estimated robustly
+func EwmaScores(values []float64, alpha float64) []float64 {
+	scores := make([]float64, len(values))
+	med, scale := robustLocationScale(values)
+	if scale == 0 {
+		return scores
+	}
+	z, decay := med, 1.0
+	factor := alpha / (2 - alpha)
+	for i, v := range values {
+		z = alpha*v + (1-alpha)*z
+		decay *= (1 - alpha) * (1 - alpha)
+		scores[i] = (z - med) / (scale * math.Sqrt(factor*(1-decay)))
+	}
+	return scores
+}
+
+// ArimaForecast returns the next n values of an ARIMA(p,1,0) model: the first differences of the values are fitted by
+// an autoregressive model of order p with an intercept by least squares. The model falls back to the mean drift if the
+// series is too short or the fit is singular, such as a constant or linear series
+func ArimaForecast(values []float64, n, p int) []float64 {
+	if len(values) == 0 {
+		return nil
+	}
+	diffs := make([]float64, len(values)-1)
+	for i := range diffs {
+		diffs[i] = values[i+1] - values[i]
+	}
+	coef := fitAutoRegression(diffs, p)
+
+	forecast := make([]float64, n)
+	last := values[len(values)-1]
+	for i := range forecast {
+		d := coef[0]
+		for j := 1; j <= p; j++ {
+			if k := len(diffs) - j; k >= 0 {
+				d += coef[j] * diffs[k]
+			}
+		}
+		diffs = append(diffs, d)
+		last += d
+		forecast[i] = last
+	}
+	return forecast
+}
+
+// fitAutoRegression returns the intercept and the p coefficients of y[t] = c + a1*y[t-1] + ... + ap*y[t-p]
+func fitAutoRegression(y []float64, p int) []float64 {
+	coef := make([]float64, p+1)
+	drift := func() []float64 {
+		var sum float64
+		for _, v := range y {
+			sum += v
+		}
+		if len(y) > 0 {
+			coef[0] = sum / float64(len(y))
+		}
+		return coef
+	}
+	if len(y) < 2*(p+1) {
+		return drift()
+	}
+
+	// normal equations (X'X) coef = X'y with the rows [1, y[t-1], ..., y[t-p]]
+	dim := p + 1
+	a := make([][]float64, dim)
+	for i := range a {
+		a[i] = make([]float64, dim+1)
+	}
+	row := make([]float64, dim)
+	for t := p; t < len(y); t++ {
+		row[0] = 1
+		for j := 1; j <= p; j++ {
+			row[j] = y[t-j]
+		}
+		for i := 0; i < dim; i++ {
+			for j := 0; j < dim; j++ {
+				a[i][j] += row[i] * row[j]
+			}
+			a[i][dim] += row[i] * y[t]
+		}
+	}
+	if !solveLinear(a) {
+		return drift()
+	}
+	for i := range coef {
+		coef[i] = a[i][dim]
+	}
+	return coef
+}
+
+// solveLinear solves the augmented matrix by gaussian elimination with partial pivoting,
+// the solution is left in the last column, false if the matrix is nearly singular
+func solveLinear(a [][]float64) bool {
+	n := len(a)
+	var scale float64
+	for i := range a {
+		scale = math.Max(scale, math.Abs(a[i][i]))
+	}
+	for col := 0; col < n; col++ {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(a[r][col]) > math.Abs(a[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(a[pivot][col]) <= fitEpsilon*scale {
+			return false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		for r := 0; r < n; r++ {
+			if r == col {
+				continue
+			}
+			f := a[r][col] / a[col][col]
+			for c := col; c <= n; c++ {
+				a[r][c] -= f * a[col][c]
+			}
+		}
+	}
+	for r := 0; r < n; r++ {
+		a[r][n] /= a[r][r]
+	}
+	return true
+}
@@ -0,0 +1,168 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRobustZScores(t *testing.T) {
+	scores := executor.RobustZScores([]float64{10, 11, 9, 10, 12, 8, 10, 50})
+	for i, z := range scores[:7] {
+		require.Less(t, math.Abs(z), 3.5, i)
+	}
+	require.Greater(t, scores[7], 3.5)
+
+	// more than half of the values are equal
+	scores = executor.RobustZScores([]float64{1, 1, 1, 1, 1, 9})
+	require.Equal(t, float64(0), scores[0])
+	require.Greater(t, scores[5], 3.5)
+
+	require.Equal(t, []float64{0, 0, 0}, executor.RobustZScores([]float64{2, 2, 2}))
+	require.Equal(t, []float64{}, executor.RobustZScores(nil))
+}
+
+func TestSTLDecompose(t *testing.T) {
+	period := 12
+	values := make([]float64, 8*period)
+	for i := range values {
+		values[i] = 0.5*float64(i) + 10*math.Sin(2*math.Pi*float64(i)/float64(period)) + 0.3*math.Sin(2.3*float64(i))
+	}
+	values[50] += 30
+
+	trend, seasonal, residual := executor.STLDecompose(values, period)
+	require.Equal(t, len(values), len(trend))
+	require.Equal(t, len(values), len(seasonal))
+	// the seasonal component repeats every period
+	require.InDelta(t, seasonal[3], seasonal[3+period], 1e-9)
+
+	scores := executor.RobustZScores(residual)
+	for i, z := range scores {
+		if i == 50 {
+			require.Greater(t, z, 3.0)
+			continue
+		}
+		require.Less(t, math.Abs(z), 3.0, i)
+	}
+
+	// no seasonal component for the series shorter than two periods
+	_, seasonal, _ = executor.STLDecompose(values[:period], period)
+	require.Equal(t, make([]float64, period), seasonal)
+}
+
+func TestEwmaScores(t *testing.T) {
+	values := []float64{5, 6, 5, 4, 5, 6, 5, 4, 5, 6, 5, 4, 15, 16, 15, 16}
+	scores := executor.EwmaScores(values, 0.3)
+	for _, z := range scores[:12] {
+		require.Less(t, math.Abs(z), 3.0)
+	}
+	require.Greater(t, scores[15], 3.0)
+
+	// the ewma is the value itself if alpha is 1
+	require.Equal(t, executor.RobustZScores(values), executor.EwmaScores(values, 1))
+	require.Equal(t, []float64{0, 0}, executor.EwmaScores([]float64{1, 1}, 0.5))
+}
+
+func TestArimaForecast(t *testing.T) {
+	// linear series falls back to the drift
+	forecast := executor.ArimaForecast([]float64{1, 3, 5, 7, 9, 11, 13, 15}, 3, 2)
+	require.InDeltaSlice(t, []float64{17, 19, 21}, forecast, 1e-9)
+
+	// constant series
+	require.InDeltaSlice(t, []float64{4, 4}, executor.ArimaForecast([]float64{4, 4, 4, 4, 4, 4, 4}, 2, 1), 1e-9)
+
+	// the differences alternate, an AR(1) of the differences predicts it
+	values := []float64{0}
+	for i := 1; i < 30; i++ {
+		d := 1.0
+		if i%2 == 0 {
+			d = 3
+		}
+		values = append(values, values[i-1]+d)
+	}
+	last := values[len(values)-1]
+	forecast = executor.ArimaForecast(values, 4, 1)
+	require.InDeltaSlice(t, []float64{last + 3, last + 4, last + 7, last + 8}, forecast, 1e-6)
+
+	require.Nil(t, executor.ArimaForecast(nil, 3, 1))
+	require.InDeltaSlice(t, []float64{2, 2}, executor.ArimaForecast([]float64{2}, 2, 1), 1e-9)
+}
+
+func buildAnomalyChunk(values map[string][]float64) executor.Chunk {
+	rdt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "f", Type: influxql.Float})
+	c := executor.NewChunkBuilder(rdt).NewChunk("mst")
+	for _, tag := range []string{"t=a", "t=b"} {
+		vs, ok := values[tag]
+		if !ok {
+			continue
+		}
+		c.AppendTagsAndIndex(*ParseChunkTags(tag), c.Len())
+		c.AppendIntervalIndex(c.Len())
+		for i, v := range vs {
+			c.AppendTime(int64(i+1) * 10)
+			if math.IsNaN(v) {
+				c.Column(0).AppendNil()
+				continue
+			}
+			c.Column(0).AppendFloatValue(v)
+			c.Column(0).AppendNotNil()
+		}
+	}
+	return c
+}
+
+func newAnomalyOutChunk() executor.Chunk {
+	rdt := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "f", Type: influxql.Float})
+	return executor.NewChunkBuilder(rdt).NewChunk("")
+}
+
+func TestMadAnomalyReduce(t *testing.T) {
+	in := []executor.Chunk{
+		buildAnomalyChunk(map[string][]float64{"t=a": {10, 11, math.NaN(), 9, 10}}),
+		buildAnomalyChunk(map[string][]float64{"t=a": {12, 100}, "t=b": {1, 1, 1, 1}}),
+	}
+	out := newAnomalyOutChunk()
+	args := []influxql.Expr{&influxql.NumberLiteral{Val: 3}}
+	require.NoError(t, executor.MadAnomalyReduce(context.Background(), in, out, args))
+
+	require.Equal(t, "mst", out.Name())
+	require.Equal(t, 1, out.TagLen())
+	require.Equal(t, map[string]string{"t": "a"}, out.Tags()[0].KeyValues())
+	require.Equal(t, []int64{20}, out.Time())
+	require.Greater(t, out.Column(0).FloatValues()[0], 3.0)
+
+	require.Error(t, executor.MadAnomalyReduce(context.Background(), nil, out))
+}
+
+func TestArimaForecastReduce(t *testing.T) {
+	in := []executor.Chunk{buildAnomalyChunk(map[string][]float64{"t=a": {1, 2, 3, 4, 5}, "t=b": {7}})}
+	out := newAnomalyOutChunk()
+	args := []influxql.Expr{&influxql.IntegerLiteral{Val: 2}}
+	require.NoError(t, executor.ArimaForecastReduce(context.Background(), in, out, args))
+
+	// the series with a single point is not forecast
+	require.Equal(t, 1, out.TagLen())
+	require.Equal(t, []int64{60, 70}, out.Time())
+	require.InDeltaSlice(t, []float64{6, 7}, out.Column(0).FloatValues(), 1e-9)
+
+	require.Error(t, executor.ArimaForecastReduce(context.Background(), in, out, []influxql.Expr{&influxql.IntegerLiteral{Val: 0}}))
+}
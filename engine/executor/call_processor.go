@@ -17,7 +17,6 @@ package executor
 import (
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/engine/op"
@@ -51,13 +50,12 @@ func NewProcessors(inRowDataType, outRowDataType hybridqp.RowDataType, exprOpt [
 		switch expr := exprOpt[i].Expr.(type) {
 		case *influxql.Call:
 			if op.IsAggregateOp(expr) {
-				name := exprOpt[i].Expr.(*influxql.Call).Name
-				if strings.Contains(name, "castor") {
+				if op.IsUDAFOp(expr) {
 					processor, err := NewWideProcessorImpl(inRowDataType, outRowDataType, exprOpt)
-					proRes.coProcessor = processor.(*WideCoProcessorImpl)
 					if err != nil {
-						return nil, errors.New("unsupported aggregation operator of call processor")
+						return nil, err
 					}
+					proRes.coProcessor = processor.(*WideCoProcessorImpl)
 					proRes.isUDAFCall = true
 					return proRes, nil
 				}
@@ -235,6 +233,12 @@ func NewWideProcessorImpl(inRowDataType, outRowDataType hybridqp.RowDataType, ex
 	switch expr.Name {
 	case "castor":
 		wideRoutine = NewWideRoutineImpl(NewWideIterator(CastorReduce, args))
+	default:
+		reduce, ok := anomalyReduces[expr.Name]
+		if !ok {
+			return nil, errors.New("unsupported aggregation operator of call processor")
+		}
+		wideRoutine = NewWideRoutineImpl(NewWideIterator(reduce, args))
 	}
 	wideProcessor := NewWideCoProcessorImpl(wideRoutine)
 	return wideProcessor, nil
@@ -14,6 +14,8 @@
 
 package executor
 
+import "context"
+
 type IteratorParams struct {
 	sameInterval bool
 	sameTag      bool
@@ -24,12 +26,21 @@ type IteratorParams struct {
 	err          error
 	Table        ReflectionTable
 	winIdx       [][2]int
+	// ctx of the query, the iterators calling remote services stop waiting once it is done
+	ctx context.Context
 }
 
 func (i *IteratorParams) GetErr() error {
 	return i.err
 }
 
+func (i *IteratorParams) Context() context.Context {
+	if i.ctx == nil {
+		return context.Background()
+	}
+	return i.ctx
+}
+
 type EndPointPair struct {
 	Chunk   Chunk
 	Ordinal int
@@ -0,0 +1,379 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+// interpolateColumn is an interpolate() column of the FillTransform output, its empty buckets are filled from the
+// non-empty buckets of the same series
+type interpolateColumn struct {
+	ordinal int
+	method  string
+	maxGap  int64
+}
+
+func newInterpolateColumns(schema *QuerySchema) []interpolateColumn {
+	var columns []interpolateColumn
+	for i, call := range schema.InterpolateField() {
+		col := interpolateColumn{ordinal: i, method: call.Args[1].(*influxql.StringLiteral).Val}
+		if len(call.Args) > 2 {
+			col.maxGap = int64(call.Args[2].(*influxql.DurationLiteral).Val)
+		}
+		columns = append(columns, col)
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		return columns[i].ordinal < columns[j].ordinal
+	})
+	return columns
+}
+
+// interpolateBuffer holds the output chunks of the FillTransform while they have empty buckets not interpolated yet.
+// The buckets are interpolated as they stream in, an empty bucket is resolved once the buckets it is interpolated
+// from are known or once the series ends, so only the chunks of the current run of empty buckets are held
+type interpolateBuffer struct {
+	columns    []interpolateColumn
+	dimensions []string
+
+	interpolators []*bucketInterpolator
+	// the chunks not sent yet, pending[0] is the chunk of sequence number base
+	pending []Chunk
+	base    int
+	// measurement and tags of the last series pushed, it goes on if the next chunk starts with them
+	lastName string
+	lastTags []byte
+}
+
+func newInterpolateBuffer(columns []interpolateColumn, opt *query.ProcessorOptions) *interpolateBuffer {
+	b := &interpolateBuffer{columns: columns, dimensions: opt.Dimensions}
+	for _, col := range columns {
+		b.interpolators = append(b.interpolators, newBucketInterpolator(col.method, col.maxGap, opt.Ascending))
+	}
+	return b
+}
+
+// push takes a copy of c and returns the chunks ready to be sent
+func (b *interpolateBuffer) push(c Chunk) []Chunk {
+	if c.NumberOfRows() == 0 {
+		return nil
+	}
+	c = c.Clone()
+	seq := b.base + len(b.pending)
+	b.pending = append(b.pending, c)
+
+	for si := 0; si < c.TagLen(); si++ {
+		tags := c.Tags()[si].Subset(b.dimensions)
+		if si > 0 || c.Name() != b.lastName || !bytes.Equal(tags, b.lastTags) {
+			b.endSeries()
+		}
+		end := c.NumberOfRows()
+		if si < c.TagLen()-1 {
+			end = c.TagIndex()[si+1]
+		}
+		for row := c.TagIndex()[si]; row < end; row++ {
+			ref := bucketRef{seq: seq, row: row}
+			for i, col := range b.columns {
+				column := c.Column(col.ordinal)
+				if column.IsNilV2(row) {
+					b.interpolators[i].addEmpty(ref, c.TimeByIndex(row))
+				} else {
+					b.interpolators[i].addKnown(c.TimeByIndex(row), column.FloatValue(column.GetValueIndexV2(row)))
+				}
+			}
+		}
+	}
+	b.lastName = c.Name()
+	b.lastTags = append(b.lastTags[:0], c.Tags()[c.TagLen()-1].Subset(b.dimensions)...)
+	return b.ready()
+}
+
+// flush ends the last series and returns the held chunks
+func (b *interpolateBuffer) flush() []Chunk {
+	b.endSeries()
+	b.lastName, b.lastTags = "", b.lastTags[:0]
+	return b.ready()
+}
+
+func (b *interpolateBuffer) endSeries() {
+	for _, ip := range b.interpolators {
+		ip.endSeries()
+	}
+}
+
+// ready returns the chunks before the first empty bucket not resolved yet, with their resolved buckets filled
+func (b *interpolateBuffer) ready() []Chunk {
+	blocked := b.base + len(b.pending)
+	for _, ip := range b.interpolators {
+		if seq, ok := ip.firstPending(); ok && seq < blocked {
+			blocked = seq
+		}
+	}
+	n := blocked - b.base
+	if n == 0 {
+		return nil
+	}
+	ready := b.pending[:n]
+	for i, c := range ready {
+		seq := b.base + i
+		for j, ip := range b.interpolators {
+			if rows, ok := ip.fills[seq]; ok {
+				rewriteColumn(c, b.columns[j].ordinal, rows)
+				delete(ip.fills, seq)
+			}
+		}
+	}
+	b.pending = append([]Chunk{}, b.pending[n:]...)
+	b.base = blocked
+	return ready
+}
+
+// rewriteColumn replaces the column of c with the filled rows
+func rewriteColumn(c Chunk, ordinal int, filled map[int]float64) {
+	old := c.Column(ordinal)
+	col := NewColumnImpl(influxql.Float)
+	for row := 0; row < c.NumberOfRows(); row++ {
+		if v, ok := filled[row]; ok {
+			col.AppendFloatValue(v)
+			col.AppendNotNil()
+		} else if old.IsNilV2(row) {
+			col.AppendNil()
+		} else {
+			col.AppendFloatValue(old.FloatValue(old.GetValueIndexV2(row)))
+			col.AppendNotNil()
+		}
+	}
+	c.SetColumn(col, ordinal)
+}
+
+// bucketRef is a row of the chunk of sequence number seq
+type bucketRef struct {
+	seq int
+	row int
+}
+
+type knownBucket struct {
+	time  int64
+	value float64
+}
+
+type emptyBucket struct {
+	ref  bucketRef
+	time int64
+	// index of the known bucket before it in the series, -1 if none
+	after int
+}
+
+// bucketInterpolator interpolates the empty buckets of a series in the order the buckets come in. A bucket is only
+// filled from buckets at most maxGap apart when maxGap is positive: the previous bucket in time for step, the
+// previous and the next buckets for linear and spline. The spline is the cubic Hermite spline whose tangents are
+// the slopes between the neighbouring buckets, so only the two known buckets on each side are needed
+type bucketInterpolator struct {
+	method    string
+	maxGap    int64
+	ascending bool
+
+	// the latest known buckets of the series and the number of known buckets of the series
+	known []knownBucket
+	count int
+	// the empty buckets not resolved yet, in the order they came in
+	pending []emptyBucket
+	// values of the filled buckets by the sequence number of their chunk and their row
+	fills map[int]map[int]float64
+}
+
+// the spline of an empty bucket needs the two known buckets before it and the two after it
+const maxKnownBuckets = 4
+
+func newBucketInterpolator(method string, maxGap int64, ascending bool) *bucketInterpolator {
+	return &bucketInterpolator{method: method, maxGap: maxGap, ascending: ascending, fills: make(map[int]map[int]float64)}
+}
+
+func (ip *bucketInterpolator) withinGap(t1, t2 int64) bool {
+	if ip.maxGap <= 0 {
+		return true
+	}
+	if t1 > t2 {
+		t1, t2 = t2, t1
+	}
+	return t2-t1 <= ip.maxGap
+}
+
+// at returns the known bucket of index k in the series, it must be one of the latest
+func (ip *bucketInterpolator) at(k int) (knownBucket, bool) {
+	i := len(ip.known) - (ip.count - k)
+	if k < 0 || k >= ip.count || i < 0 {
+		return knownBucket{}, false
+	}
+	return ip.known[i], true
+}
+
+func (ip *bucketInterpolator) fill(ref bucketRef, value float64) {
+	rows, ok := ip.fills[ref.seq]
+	if !ok {
+		rows = make(map[int]float64)
+		ip.fills[ref.seq] = rows
+	}
+	rows[ref.row] = value
+}
+
+func (ip *bucketInterpolator) firstPending() (int, bool) {
+	if len(ip.pending) == 0 {
+		return 0, false
+	}
+	return ip.pending[0].ref.seq, true
+}
+
+func (ip *bucketInterpolator) stepForward() bool {
+	return ip.method == query.InterpolateStep || ip.method == query.InterpolateLOCF
+}
+
+func (ip *bucketInterpolator) addKnown(t int64, v float64) {
+	ip.known = append(ip.known, knownBucket{time: t, value: v})
+	if len(ip.known) > maxKnownBuckets {
+		ip.known = append(ip.known[:0], ip.known[1:]...)
+	}
+	ip.count++
+	cur := ip.count - 1
+
+	switch {
+	case ip.stepForward():
+		// in descending order the empty buckets before this one are later in time
+		for _, e := range ip.pending {
+			if ip.withinGap(e.time, t) {
+				ip.fill(e.ref, v)
+			}
+		}
+		ip.pending = ip.pending[:0]
+	case ip.method == query.InterpolateLinear:
+		prev, _ := ip.at(cur - 1)
+		if ip.withinGap(prev.time, t) {
+			for _, e := range ip.pending {
+				ip.fill(e.ref, prev.value+(v-prev.value)*float64(e.time-prev.time)/float64(t-prev.time))
+			}
+		}
+		ip.pending = ip.pending[:0]
+	case ip.method == query.InterpolateSpline:
+		// the empty buckets between the two previous known buckets have their second tangent now
+		ip.resolveSpline(cur-2, cur)
+	}
+}
+
+func (ip *bucketInterpolator) addEmpty(ref bucketRef, t int64) {
+	if ip.stepForward() {
+		if ip.ascending {
+			if last, ok := ip.at(ip.count - 1); ok && ip.withinGap(last.time, t) {
+				ip.fill(ref, last.value)
+			}
+			return
+		}
+		// filled by the next known bucket, which is earlier in time, the empty buckets farther than maxGap never are
+		kept := ip.pending[:0]
+		for _, e := range ip.pending {
+			if ip.withinGap(e.time, t) {
+				kept = append(kept, e)
+			}
+		}
+		ip.pending = append(kept, emptyBucket{ref: ref, time: t, after: ip.count - 1})
+		return
+	}
+
+	last, ok := ip.at(ip.count - 1)
+	if !ok {
+		// nothing to interpolate from before the bucket
+		return
+	}
+	if !ip.withinGap(last.time, t) {
+		// the next known bucket is even farther: the run of empty buckets can not be filled, and the spline
+		// between the two previous known buckets has no known bucket after them
+		if ip.method == query.InterpolateSpline {
+			ip.resolveSpline(ip.count-2, -1)
+		}
+		ip.pending = ip.pending[:0]
+		return
+	}
+	ip.pending = append(ip.pending, emptyBucket{ref: ref, time: t, after: ip.count - 1})
+}
+
+// endSeries resolves what can still be resolved without the buckets after the series and resets the series
+func (ip *bucketInterpolator) endSeries() {
+	if ip.method == query.InterpolateSpline {
+		ip.resolveSpline(ip.count-2, -1)
+	}
+	ip.known = ip.known[:0]
+	ip.pending = ip.pending[:0]
+	ip.count = 0
+}
+
+// resolveSpline fills the empty buckets between the known buckets a and a+1, next is the known bucket a+2 or -1.
+// The tangent at a known bucket is the slope between its neighbours, or the slope towards the other end of the
+// segment when a neighbour is missing or beyond maxGap
+func (ip *bucketInterpolator) resolveSpline(a, next int) {
+	if len(ip.pending) == 0 || ip.pending[0].after != a {
+		return
+	}
+	n := 0
+	for n < len(ip.pending) && ip.pending[n].after == a {
+		n++
+	}
+	resolved := append([]emptyBucket(nil), ip.pending[:n]...)
+	ip.pending = append(ip.pending[:0], ip.pending[n:]...)
+
+	p0, ok0 := ip.at(a)
+	p1, ok1 := ip.at(a + 1)
+	if !ok0 || !ok1 || !ip.withinGap(p0.time, p1.time) {
+		return
+	}
+	secant := (p1.value - p0.value) / float64(p1.time-p0.time)
+	m0, m1 := secant, secant
+	if prev, ok := ip.at(a - 1); ok && ip.withinGap(prev.time, p0.time) {
+		m0 = (p1.value - prev.value) / float64(p1.time-prev.time)
+	}
+	if after, ok := ip.at(next); ok && ip.withinGap(p1.time, after.time) {
+		m1 = (after.value - p0.value) / float64(after.time-p0.time)
+	}
+
+	h := float64(p1.time - p0.time)
+	for _, e := range resolved {
+		s := float64(e.time-p0.time) / h
+		s2, s3 := s*s, s*s*s
+		v := (2*s3-3*s2+1)*p0.value + (s3-2*s2+s)*h*m0 + (-2*s3+3*s2)*p1.value + (s3-s2)*h*m1
+		ip.fill(e.ref, v)
+	}
+}
+
+// InterpolateBuckets fills the nil buckets of a series ordered by time in place and reports which were filled,
+// see bucketInterpolator for the methods
+func InterpolateBuckets(times []int64, values []float64, isNil []bool, method string, maxGap int64) []bool {
+	ip := newBucketInterpolator(method, maxGap, true)
+	for i := range times {
+		if isNil[i] {
+			ip.addEmpty(bucketRef{row: i}, times[i])
+		} else {
+			ip.addKnown(times[i], values[i])
+		}
+	}
+	ip.endSeries()
+
+	filled := make([]bool, len(times))
+	for row, v := range ip.fills[0] {
+		values[row], filled[row] = v, true
+	}
+	return filled
+}
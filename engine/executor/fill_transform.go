@@ -60,6 +60,7 @@ type FillTransform struct {
 	nextChunk            Chunk
 	fillItem             []*FillItem
 	fillProcessor        []FillProcessor
+	interpolate          *interpolateBuffer
 	Inputs               ChunkPorts
 	Outputs              ChunkPorts
 	opt                  query.ProcessorOptions
@@ -148,6 +149,13 @@ func NewFillTransform(inRowDataType []hybridqp.RowDataType, outRowDataType []hyb
 	if err != nil {
 		return nil, err
 	}
+	// the empty buckets of interpolate() are filled with null first, then interpolated once the series is complete
+	if columns := newInterpolateColumns(schema); len(columns) > 0 {
+		for _, col := range columns {
+			trans.fillProcessor[col.ordinal] = NewFloatNullFillProcessor(col.ordinal, col.ordinal)
+		}
+		trans.interpolate = newInterpolateBuffer(columns, &trans.opt)
+	}
 	trans.getIntervalNum()
 	trans.coProcessor = FixedMergeColumnsIteratorHelper(outRowDataType[0])
 	trans.tmpChunk = NewChunkBuilder(outRowDataType[0]).NewChunk("")
@@ -315,6 +323,7 @@ func (trans *FillTransform) fill(ctx context.Context, errs *errno.Errs) {
 			if trans.newChunk.Len() > 0 {
 				trans.sendChunk()
 			}
+			trans.flushInterpolate()
 			return
 		}
 
@@ -323,7 +332,7 @@ func (trans *FillTransform) fill(ctx context.Context, errs *errno.Errs) {
 			windowStart, _ := trans.opt.Window(trans.opt.StartTime)
 			_, windowEnd := trans.opt.Window(trans.opt.EndTime)
 			if (windowEnd-windowStart)/trans.opt.Interval.Duration.Nanoseconds() == int64(trans.bufChunk.Len()) {
-				trans.Outputs[0].State <- trans.bufChunk
+				trans.output(trans.bufChunk)
 				trans.NextChunk()
 				trans.bufChunk = trans.nextChunk
 				trans.nextChunk = nil
@@ -360,10 +369,30 @@ func (trans *FillTransform) fill(ctx context.Context, errs *errno.Errs) {
 }
 
 func (trans *FillTransform) sendChunk() {
-	trans.Outputs[0].State <- trans.newChunk
+	trans.output(trans.newChunk)
 	trans.newChunk = trans.chunkPool.GetChunk()
 }
 
+// output sends the chunk, the chunks of interpolate() are held until their series are complete
+func (trans *FillTransform) output(c Chunk) {
+	if trans.interpolate == nil {
+		trans.Outputs[0].State <- c
+		return
+	}
+	for _, ready := range trans.interpolate.push(c) {
+		trans.Outputs[0].State <- ready
+	}
+}
+
+func (trans *FillTransform) flushInterpolate() {
+	if trans.interpolate == nil {
+		return
+	}
+	for _, ready := range trans.interpolate.flush() {
+		trans.Outputs[0].State <- ready
+	}
+}
+
 func (trans *FillTransform) updatePrevValues(c Chunk) {
 	for i := range trans.updatePrevValuesFunc {
 		trans.updatePrevValuesFunc[i](c, trans.prevValues, i)
@@ -16,6 +16,7 @@ package executor_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func buildFillRowDataType() hybridqp.RowDataType {
@@ -1696,3 +1698,129 @@ func TestFillTransformFillNumberDescendingSplitOneGroup(t *testing.T) {
 		schema,
 	)
 }
+
+func TestInterpolateBuckets(t *testing.T) {
+	times := []int64{0, 10, 20, 30, 40, 50, 60}
+	isNil := []bool{true, false, true, true, false, true, true}
+	run := func(method string, maxGap int64) ([]float64, []bool) {
+		values := []float64{0, 1, 0, 0, 4, 0, 0}
+		filled := executor.InterpolateBuckets(times, values, isNil, method, maxGap)
+		return values, filled
+	}
+
+	values, filled := run(query.InterpolateLinear, 0)
+	assert.Equal(t, []bool{false, false, true, true, false, false, false}, filled)
+	assert.InDeltaSlice(t, []float64{0, 1, 2, 3, 4, 0, 0}, values, 1e-9)
+
+	values, filled = run(query.InterpolateStep, 0)
+	assert.Equal(t, []bool{false, false, true, true, false, true, true}, filled)
+	assert.Equal(t, []float64{0, 1, 1, 1, 4, 4, 4}, values)
+
+	// the previous value is only carried 10ns forward
+	values, filled = run(query.InterpolateLOCF, 10)
+	assert.Equal(t, []bool{false, false, true, false, false, true, false}, filled)
+	assert.Equal(t, []float64{0, 1, 1, 0, 4, 4, 0}, values)
+
+	// the known buckets are 30ns apart
+	_, filled = run(query.InterpolateLinear, 20)
+	assert.Equal(t, make([]bool, len(times)), filled)
+
+	// the spline through 2 points is the line
+	values, _ = run(query.InterpolateSpline, 0)
+	assert.InDeltaSlice(t, []float64{0, 1, 2, 3, 4, 0, 0}, values, 1e-9)
+
+	// through (1, 1) and (3, 9) the tangents are the slopes from (0, 0) to (3, 9) and from (1, 1) to (4, 16): 3 and 5
+	times = []int64{0, 1, 2, 3, 4}
+	isNil = []bool{false, false, true, false, false}
+	values = []float64{0, 1, 0, 9, 16}
+	filled = executor.InterpolateBuckets(times, values, isNil, query.InterpolateSpline, 0)
+	assert.Equal(t, []bool{false, false, true, false, false}, filled)
+	assert.InDelta(t, 4.5, values[2], 1e-9)
+
+	// (4, 16) is beyond the gap, the tangent at (3, 9) falls back to the slope from (1, 1)
+	values = []float64{0, 1, 0, 9, 16}
+	isNil = []bool{false, false, true, false, false}
+	times = []int64{0, 1, 2, 3, 10}
+	executor.InterpolateBuckets(times, values, isNil, query.InterpolateSpline, 3)
+	assert.InDelta(t, 4.75, values[2], 1e-9)
+}
+
+func buildInterpolateRowDataType() hybridqp.RowDataType {
+	return hybridqp.NewRowDataTypeImpl(
+		influxql.VarRef{Val: "interpolate(\"v\", 'linear')", Type: influxql.Float},
+	)
+}
+
+func TestFillTransform_Interpolate(t *testing.T) {
+	rowDataType := buildInterpolateRowDataType()
+	b := executor.NewChunkBuilder(rowDataType)
+
+	// host=A goes on in the second chunk
+	ck1 := b.NewChunk("mst")
+	ck1.AppendTagsAndIndexes([]executor.ChunkTags{*ParseChunkTags("host=A")}, []int{0})
+	ck1.AppendIntervalIndexes([]int{0, 1})
+	ck1.AppendTimes([]int64{0, 30})
+	ck1.Column(0).AppendFloatValues([]float64{0, 3})
+	ck1.Column(0).AppendManyNotNil(2)
+
+	ck2 := b.NewChunk("mst")
+	ck2.AppendTagsAndIndexes([]executor.ChunkTags{*ParseChunkTags("host=A"), *ParseChunkTags("host=B")}, []int{0, 1})
+	ck2.AppendIntervalIndexes([]int{0, 1, 2})
+	ck2.AppendTimes([]int64{50, 10, 40})
+	ck2.Column(0).AppendFloatValues([]float64{5, 1, 4})
+	ck2.Column(0).AppendManyNotNil(3)
+
+	fields := influxql.Fields{&influxql.Field{Expr: &influxql.Call{
+		Name: "interpolate",
+		Args: []influxql.Expr{&influxql.VarRef{Val: "v", Type: influxql.Float}, &influxql.StringLiteral{Val: query.InterpolateLinear}},
+	}}}
+	opt := query.ProcessorOptions{
+		Dimensions: []string{"host"},
+		Interval:   hybridqp.Interval{Duration: 10 * time.Nanosecond},
+		StartTime:  0,
+		EndTime:    50,
+		Ordered:    true,
+		Ascending:  true,
+		ChunkSize:  100,
+		Fill:       influxql.NullFill,
+	}
+	schema := executor.NewQuerySchema(fields, []string{"v"}, &opt, nil)
+	schema.SetOpt(&opt)
+
+	source := NewSourceFromMultiChunk(rowDataType, []executor.Chunk{ck1, ck2})
+	trans, err := executor.NewFillTransform([]hybridqp.RowDataType{rowDataType}, []hybridqp.RowDataType{rowDataType}, nil, schema)
+	require.NoError(t, err)
+	sink := NewNilSink(rowDataType)
+	require.NoError(t, executor.Connect(source.Output, trans.Inputs[0]))
+	require.NoError(t, executor.Connect(trans.Outputs[0], sink.Input))
+	executors := executor.NewPipelineExecutor(executor.Processors{source, trans, sink})
+	require.NoError(t, executors.Execute(context.Background()))
+	executors.Release()
+
+	got := make(map[string][]interface{})
+	for _, c := range sink.Chunks {
+		col := c.Column(0)
+		for i := 0; i < c.TagLen(); i++ {
+			start, end := c.TagIndex()[i], c.NumberOfRows()
+			if i < c.TagLen()-1 {
+				end = c.TagIndex()[i+1]
+			}
+			host := string(c.Tags()[i].Subset(opt.Dimensions))
+			for row := start; row < end; row++ {
+				if col.IsNilV2(row) {
+					got[host] = append(got[host], nil)
+					continue
+				}
+				got[host] = append(got[host], col.FloatValue(col.GetValueIndexV2(row)))
+			}
+		}
+	}
+	require.Len(t, got, 2)
+	for host, values := range got {
+		if strings.Contains(host, "A") {
+			assert.Equal(t, []interface{}{0.0, 1.0, 2.0, 3.0, 4.0, 5.0}, values)
+		} else {
+			assert.Equal(t, []interface{}{nil, 1.0, 2.0, 3.0, 4.0, nil}, values)
+		}
+	}
+}
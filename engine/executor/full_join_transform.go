@@ -58,10 +58,15 @@ type FullJoinTransform struct {
 	opt                 *query.ProcessorOptions
 	nextChunksCloseOnce []sync.Once
 	errs                errno.Errs
+	memAccountant       *MemoryAccountant
+	inputQueues         []*chunkSpillQueue // the chunks read ahead from each input, spilled over the memory budget
+	buildSide           int                // the input read ahead, the other one is streamed, -1 if both are read ahead
 }
 
 const (
 	fullJoinTransformName = "FullJoinTransform"
+	// the number of chunks the input streamed by the join is read ahead
+	fullJoinStreamReadAhead = 2
 )
 
 type chunkElem struct {
@@ -101,7 +106,13 @@ func (c *FullJoinTransformCreator) Create(plan LogicalPlan, _ *query.ProcessorOp
 	}
 	joinCase := plan.Schema().(*QuerySchema).joinCases[0]
 	p, err := NewFullJoinTransform(inRowDataTypes, plan.RowDataType(), joinCase, plan.Schema().(*QuerySchema))
-	return p, err
+	if err != nil {
+		return nil, err
+	}
+	if join, ok := plan.(*LogicalFullJoin); ok {
+		p.SetBuildSide(join.BuildSide())
+	}
+	return p, nil
 }
 
 var _ = RegistryTransformCreator(&LogicalFullJoin{}, &FullJoinTransformCreator{})
@@ -118,6 +129,7 @@ func NewFullJoinTransform(inRowDataTypes []hybridqp.RowDataType, outRowDataType
 		schema:         schema,
 		opt:            schema.opt.(*query.ProcessorOptions),
 		fulljoinLogger: logger.NewLogger(errno.ModuleQueryEngine),
+		buildSide:      -1,
 	}
 	for i := range inRowDataTypes {
 		trans.inputs = append(trans.inputs, NewChunkPort(inRowDataTypes[i]))
@@ -145,6 +157,12 @@ func NewFullJoinTransform(inRowDataTypes []hybridqp.RowDataType, outRowDataType
 	return trans, nil
 }
 
+// SetBuildSide makes the join read ahead the input side only and stream the other one,
+// both inputs are read ahead if side is -1.
+func (trans *FullJoinTransform) SetBuildSide(side int) {
+	trans.buildSide = side
+}
+
 func (trans *FullJoinTransform) initNewName() error {
 	if trans.leftNewName == trans.rightNewName {
 		return errno.NewError(errno.UnsupportedConditionInFullJoin)
@@ -287,6 +305,7 @@ func (trans *FullJoinTransform) Close() {
 }
 
 func (trans *FullJoinTransform) runnable(ctx context.Context, errs *errno.Errs, i int) {
+	var err error
 	defer func() {
 		close(trans.inputChunks[i])
 		if e := recover(); e != nil {
@@ -295,23 +314,45 @@ func (trans *FullJoinTransform) runnable(ctx context.Context, errs *errno.Errs,
 				zap.Uint64("query_id", trans.opt.QueryId))
 			errs.Dispatch(err)
 		} else {
-			errs.Dispatch(nil)
+			errs.Dispatch(err)
 		}
 	}()
+	for {
+		var c Chunk
+		c, err = trans.inputQueues[i].Pop()
+		if err != nil || (c == nil && ctx.Err() != nil) {
+			trans.closeNextChunks(i)
+			return
+		}
+		if c == nil {
+			trans.addChunk(trans.bufChunks[i].chunk, i, -1)
+			return
+		}
+		trans.addChunk(c, i, 0)
+		_, iok := <-trans.nextChunks[i]
+		if !iok {
+			return
+		}
+	}
+}
+
+// readInput reads ahead the chunks of the input, so that the other input is not blocked while the
+// join waits for the rows of this one. The chunks over the memory budget of the query are spilled to disk.
+func (trans *FullJoinTransform) readInput(ctx context.Context, i int) {
+	queue := trans.inputQueues[i]
 	for {
 		select {
 		case c, ok := <-trans.inputs[i].State:
 			if !ok {
-				trans.addChunk(trans.bufChunks[i].chunk, i, -1)
+				queue.Close(nil)
 				return
 			}
-			trans.addChunk(c, i, 0)
-			_, iok := <-trans.nextChunks[i]
-			if !iok {
+			if err := queue.Push(c); err != nil {
+				queue.Close(err)
 				return
 			}
 		case <-ctx.Done():
-			trans.closeNextChunks(i)
+			queue.Close(nil)
 			return
 		}
 	}
@@ -320,7 +361,19 @@ func (trans *FullJoinTransform) runnable(ctx context.Context, errs *errno.Errs,
 func (trans *FullJoinTransform) Work(ctx context.Context) error {
 	span := trans.StartSpan("[fullJoinTransform] TotalWorkCost", false)
 	trans.workTracing = tracing.Start(span, "cost_for_fulljoin", false)
+	trans.memAccountant = MemoryAccountantFromContext(ctx)
+	trans.inputQueues = trans.inputQueues[:0]
+	for i := range trans.inputs {
+		queue := newChunkSpillQueue(fullJoinTransformName, trans.memAccountant, trans.inputs[i].RowDataType)
+		if trans.buildSide >= 0 && trans.buildSide != i {
+			queue.SetMaxChunks(fullJoinStreamReadAhead)
+		}
+		trans.inputQueues = append(trans.inputQueues, queue)
+	}
 	defer func() {
+		for _, queue := range trans.inputQueues {
+			queue.Release()
+		}
 		trans.Close()
 		tracing.Finish(span, trans.workTracing)
 	}()
@@ -328,6 +381,8 @@ func (trans *FullJoinTransform) Work(ctx context.Context) error {
 	errs := &trans.errs
 	errs.Init(3, trans.Close)
 
+	go trans.readInput(ctx, 0)
+	go trans.readInput(ctx, 1)
 	go trans.runnable(ctx, errs, 0)
 	go trans.runnable(ctx, errs, 1)
 	go trans.fullJoinHelper(ctx, errs)
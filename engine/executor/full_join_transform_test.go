@@ -16,13 +16,16 @@ package executor_test
 
 import (
 	"context"
+	"os"
 	"testing"
 
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/sysconfig"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func buildJoinCondition() influxql.Expr {
@@ -402,3 +405,52 @@ func TestFullJoinTransformDemo4(t *testing.T) {
 	_, err := executor.NewFullJoinTransform(inRowDataTypes, outputRowDataType, joinCase, schema)
 	assert.NotEqual(t, err, nil)
 }
+
+func runFullJoin(t *testing.T, ctx context.Context, buildSide int) string {
+	source1 := NewSourceFromMultiChunk(BuildInChunk2("m1").RowDataType(), []executor.Chunk{BuildInChunk2("m1")})
+	source2 := NewSourceFromMultiChunk(BuildInChunk1("m2").RowDataType(), []executor.Chunk{BuildInChunk1("m2"), BuildInChunk3("m2")})
+	inRowDataTypes := []hybridqp.RowDataType{source1.Output.RowDataType, source2.Output.RowDataType}
+	outputRowDataType := buildOutputRowDataType()
+	trans, err := executor.NewFullJoinTransform(inRowDataTypes, outputRowDataType, buildJoinCase(), buildFullJoinSchema())
+	require.NoError(t, err)
+	trans.SetBuildSide(buildSide)
+
+	var result string
+	sink := NewSinkFromFunction(outputRowDataType, func(chunk executor.Chunk) error {
+		result += StringToRows(chunk)
+		return nil
+	})
+	require.NoError(t, executor.Connect(source1.Output, trans.GetInputs()[0]))
+	require.NoError(t, executor.Connect(source2.Output, trans.GetInputs()[1]))
+	require.NoError(t, executor.Connect(trans.GetOutputs()[0], sink.Input))
+	executors := executor.NewPipelineExecutor(executor.Processors{source1, source2, trans, sink})
+	require.NoError(t, executors.Execute(ctx))
+	executors.Release()
+	return result
+}
+
+func TestFullJoinTransformSpill(t *testing.T) {
+	expStr := runFullJoin(t, context.Background(), -1)
+	require.NotEmpty(t, expStr)
+	// the streamed input is bounded
+	assert.Equal(t, expStr, runFullJoin(t, context.Background(), 0))
+	assert.Equal(t, expStr, runFullJoin(t, context.Background(), 1))
+
+	limit, dir := sysconfig.GetQueryMemoryLimit(), sysconfig.GetQuerySpillDir()
+	defer sysconfig.SetQueryMemoryLimit(limit)
+	defer sysconfig.SetQuerySpillDir(dir)
+	spillDir := t.TempDir()
+	sysconfig.SetQueryMemoryLimit(1)
+	sysconfig.SetQuerySpillDir(spillDir)
+
+	ctx := executor.NewContextWithMemoryAccountant(context.Background())
+	assert.Equal(t, expStr, runFullJoin(t, ctx, -1))
+
+	accountant := executor.MemoryAccountantFromContext(ctx)
+	assert.Greater(t, accountant.Spilled(), int64(0))
+	assert.Equal(t, int64(0), accountant.Used())
+
+	files, err := os.ReadDir(spillDir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime/debug"
+	"sort"
 	"sync/atomic"
 
 	"github.com/openGemini/openGemini/engine/hybridqp"
@@ -50,11 +51,26 @@ const (
 	changeInput
 )
 
+// chunkInDisk is the input of a pass over the groups spilled by the previous pass.
 type chunkInDisk struct {
+	file *chunkSpillFile
 }
 
-func (cid *chunkInDisk) GetChunk() (Chunk, bool) {
-	return nil, false
+func (cid *chunkInDisk) GetChunk() (Chunk, bool, error) {
+	if cid == nil {
+		return nil, false, nil
+	}
+	c, err := cid.file.Read()
+	if err != nil || c == nil {
+		return nil, false, err
+	}
+	return c, true, nil
+}
+
+func (cid *chunkInDisk) Close() {
+	if cid != nil {
+		cid.file.Close()
+	}
 }
 
 type GroupKeysMPool struct {
@@ -257,6 +273,11 @@ type HashAggTransform struct {
 	intervalEndTime        int64
 
 	diskChunks         *chunkInDisk
+	spillFile          *chunkSpillFile
+	spillChunk         Chunk
+	memAccountant      *MemoryAccountant
+	memUsed            int64
+	pendingMem         int64
 	isSpill            bool
 	isChildDrained     bool
 	hashAggType        HashAggType
@@ -264,6 +285,9 @@ type HashAggTransform struct {
 	firstOrLastFuncLoc int
 	haveTopBottomOp    bool
 	closedSignal       int32
+	// sortedOutput outputs the groups ordered by tags and the intervals of a group ordered by time,
+	// which is the order of the sort merge of the series
+	sortedOutput bool
 }
 
 type TimeFuncState uint32
@@ -414,6 +438,11 @@ func (trans *HashAggTransform) InitFuncs(inRowDataType, outRowDataType hybridqp.
 	return nil
 }
 
+// SetSortedOutput makes the transform output the groups in the order of the sort merge.
+func (trans *HashAggTransform) SetSortedOutput(sorted bool) {
+	trans.sortedOutput = sorted
+}
+
 func (trans *HashAggTransform) Name() string {
 	return hashAggTransfromName
 }
@@ -489,6 +518,7 @@ func (trans *HashAggTransform) Work(ctx context.Context) error {
 		)
 	}()
 
+	trans.memAccountant = MemoryAccountantFromContext(ctx)
 	errs := errno.NewErrsPool().Get()
 	errs.Init(len(trans.inputs)+1, trans.Close)
 	defer func() {
@@ -503,13 +533,13 @@ func (trans *HashAggTransform) Work(ctx context.Context) error {
 	return errs.Err()
 }
 
-func (trans *HashAggTransform) getChunkFromDisk() bool {
-	c, ok := trans.diskChunks.GetChunk()
+func (trans *HashAggTransform) getChunkFromDisk() (bool, error) {
+	c, ok, err := trans.diskChunks.GetChunk()
 	if !ok {
-		return false
+		return false, err
 	}
 	trans.bufChunk = c
-	return true
+	return true, nil
 }
 
 func (trans *HashAggTransform) getChunkFromChild() bool {
@@ -529,32 +559,37 @@ func (trans *HashAggTransform) getChunkFromChild() bool {
 	return true
 }
 
-func (trans *HashAggTransform) getChunk() hashAggGetChunkState {
+func (trans *HashAggTransform) getChunk() (hashAggGetChunkState, error) {
 	var ret bool
+	var err error
 	// The interrupt signal is received. No result is returned.
 	if atomic.LoadInt32(&trans.closedSignal) > 0 {
-		if !trans.initDiskAsInput() {
-			return noChunk
-		}
+		trans.resetResult()
+		return noChunk, nil
 	}
 	if trans.isChildDrained {
-		ret = trans.getChunkFromDisk()
+		ret, err = trans.getChunkFromDisk()
+		if err != nil {
+			return noChunk, err
+		}
 	} else {
 		ret = trans.getChunkFromChild()
 	}
 	if !ret {
 		trans.generateOutPut()
-		if !trans.initDiskAsInput() {
-			return noChunk
+		ok, err := trans.initDiskAsInput()
+		if err != nil || !ok {
+			return noChunk, err
 		}
-		return changeInput
+		return changeInput, nil
 	}
-	return hasChunk
+	return hasChunk, nil
 }
 
 func (trans *HashAggTransform) hashAggHelper(ctx context.Context, errs *errno.Errs) {
 	defer func() {
 		close(trans.inputChunk)
+		trans.closeSpill()
 		if e := recover(); e != nil {
 			err := errno.NewError(errno.RecoverPanic, e)
 			trans.hashAggLogger.Error(string(debug.Stack()), zap.String("query", "HashAggTransform"),
@@ -566,7 +601,11 @@ func (trans *HashAggTransform) hashAggHelper(ctx context.Context, errs *errno.Er
 	}()
 	for {
 		// 1. getChunk to bufChunk
-		state := trans.getChunk()
+		state, err := trans.getChunk()
+		if err != nil {
+			errs.Dispatch(err)
+			return
+		}
 		if state == noChunk {
 			break
 		} else if state == changeInput {
@@ -608,6 +647,10 @@ func (trans *HashAggTransform) hashAggHelper(ctx context.Context, errs *errno.Er
 			errs.Dispatch(err)
 			return
 		}
+		if err := trans.growMemory(); err != nil {
+			errs.Dispatch(err)
+			return
+		}
 		tracing.EndPP(trans.updateResultSpan)
 
 		// 7. put bufs back to pools
@@ -799,8 +842,23 @@ func (trans *HashAggTransform) newIntervalAggResults() []*aggOperatorMsg {
 	return intervalReslut
 }
 
+// spillUpdateResult updates the results of the groups in memory and spills the rows of the other groups.
 func (trans *HashAggTransform) spillUpdateResult(groupIds []uint64, intervalIds []uint64) error {
-	// todo: spill
+	var batchStartLoc = 0
+	for i := range groupIds {
+		if trans.bufSpillState[i] == 1 {
+			if trans.spillChunk.Len() == 0 {
+				trans.spillChunk.SetName(trans.bufChunk.Name())
+			}
+			appendChunkRows(trans.spillChunk, trans.bufChunk, batchStartLoc, trans.batchEndLocs[i])
+		} else if err := trans.updateBatch(i, groupIds[i], intervalIds[i], batchStartLoc); err != nil {
+			return err
+		}
+		batchStartLoc = trans.batchEndLocs[i]
+	}
+	if trans.spillChunk.Len() >= trans.schema.GetOptions().ChunkSizeNum() {
+		return trans.flushSpill()
+	}
 	return nil
 }
 
@@ -814,38 +872,43 @@ func (trans *HashAggTransform) updateResult(groupIds []uint64, intervalIds []uin
 	if trans.isSpill {
 		return trans.spillUpdateResult(groupIds, intervalIds)
 	}
-	var groupId uint64
-	var intervalId uint64
 	var batchStartLoc = 0
 	for i := range groupIds {
-		groupId = groupIds[i]
-		intervalId = intervalIds[i]
-		if groupId == uint64(len(trans.resultMap)) {
-			trans.resultMap = append(trans.resultMap, trans.newIntervalAggResults())
-			if trans.bufGroupTags[i] == nil {
-				var dimsVals []string
-				for _, col := range trans.bufChunk.Dims() {
-					dimsVals = append(dimsVals, ColumnStringValue(col, trans.batchEndLocs[i]-1))
-				}
-				trans.bufGroupTags[i] = NewChunkTagsByTagKVs(trans.opt.Dimensions, dimsVals)
-			}
-			trans.groupKeys = append(trans.groupKeys, *trans.bufGroupTags[i])
-		} else if groupId > uint64(len(trans.resultMap)) {
-			return errno.NewError(errno.HashAggTransformRunningErr)
+		if err := trans.updateBatch(i, groupIds[i], intervalIds[i], batchStartLoc); err != nil {
+			return err
 		}
-		if intervalId >= uint64(len(trans.resultMap[groupId])) {
-			n := intervalId + 1 - uint64(len(trans.resultMap[groupId]))
-			if n > 0 {
-				trans.resultMap[groupId] = append(trans.resultMap[groupId], trans.resultMapMPool.Alloc(int(n))...)
+		batchStartLoc = trans.batchEndLocs[i]
+	}
+	return nil
+}
+
+func (trans *HashAggTransform) updateBatch(i int, groupId, intervalId uint64, batchStartLoc int) error {
+	if groupId == uint64(len(trans.resultMap)) {
+		trans.resultMap = append(trans.resultMap, trans.newIntervalAggResults())
+		if trans.bufGroupTags[i] == nil {
+			var dimsVals []string
+			for _, col := range trans.bufChunk.Dims() {
+				dimsVals = append(dimsVals, ColumnStringValue(col, trans.batchEndLocs[i]-1))
 			}
+			trans.bufGroupTags[i] = NewChunkTagsByTagKVs(trans.opt.Dimensions, dimsVals)
 		}
-		if trans.resultMap[groupId][intervalId] == nil {
-			trans.resultMap[groupId][intervalId] = trans.newAggResultsMsg(batchStartLoc)
-		}
-		if err := trans.aggCompute(trans.resultMap[groupId][intervalId], batchStartLoc, trans.batchEndLocs[i]); err != nil {
-			return err
+		trans.groupKeys = append(trans.groupKeys, *trans.bufGroupTags[i])
+		trans.pendingMem += hashAggGroupMemSize + int64(trans.bufGroupTags[i].Size())
+	} else if groupId > uint64(len(trans.resultMap)) {
+		return errno.NewError(errno.HashAggTransformRunningErr)
+	}
+	if intervalId >= uint64(len(trans.resultMap[groupId])) {
+		n := intervalId + 1 - uint64(len(trans.resultMap[groupId]))
+		if n > 0 {
+			trans.resultMap[groupId] = append(trans.resultMap[groupId], trans.resultMapMPool.Alloc(int(n))...)
 		}
-		batchStartLoc = trans.batchEndLocs[i]
+	}
+	if trans.resultMap[groupId][intervalId] == nil {
+		trans.resultMap[groupId][intervalId] = trans.newAggResultsMsg(batchStartLoc)
+		trans.pendingMem += int64(len(trans.funcs)) * hashAggOperatorMemSize
+	}
+	if err := trans.aggCompute(trans.resultMap[groupId][intervalId], batchStartLoc, trans.batchEndLocs[i]); err != nil {
+		return err
 	}
 	return nil
 }
@@ -876,6 +939,10 @@ func (trans *HashAggTransform) mapIntervalKeys(groupIds []uint64) ([]uint64, err
 		}
 	} else {
 		for i, groupId := range groupIds {
+			if trans.isSpill && trans.bufSpillState[i] == 1 {
+				// the rows of the group are spilled
+				continue
+			}
 			if groupId != 0 {
 				groupId--
 			}
@@ -894,18 +961,12 @@ func (trans *HashAggTransform) mapIntervalKeys(groupIds []uint64) ([]uint64, err
 
 // to change to batch
 func (trans *HashAggTransform) spillMapGroupKeys() []uint64 {
-	values := trans.bufGroupKeysMPool.AllocValues(len(trans.bufGroupKeys))
-	trans.bufSpillState = trans.bufGroupKeysMPool.AllocZValues(len(trans.bufGroupKeys))
-	if len(trans.opt.Dimensions) == 0 {
-		return values
-	}
-	for i := 0; i < len(trans.bufGroupKeys); i++ {
-		value := trans.groupMap.Set(trans.bufGroupKeys[i])
-		values[i] = value
-	}
-
-	for i, find := range values {
-		if find == 0 {
+	values := trans.bufGroupKeysMPool.AllocValues(len(trans.batchEndLocs))
+	trans.bufSpillState = trans.bufGroupKeysMPool.AllocZValues(len(trans.batchEndLocs))
+	for i := 0; i < len(trans.batchEndLocs); i++ {
+		if id, ok := trans.groupMap.Find(trans.bufGroupKeys[i]); ok {
+			values[i] = id
+		} else {
 			trans.bufSpillState[i] = 1
 		}
 	}
@@ -1038,7 +1099,8 @@ func (trans *HashAggTransform) generateFixIntervalNullOrNumFillOutput() {
 	chunk = trans.outputChunkPool.GetChunk()
 	chunk.SetName(trans.bufChunk.Name())
 	keys := trans.schema.GetOptions().GetOptDimension()
-	for i, group := range trans.resultMap {
+	for _, i := range trans.groupOrder() {
+		group := trans.resultMap[i]
 		startTime := trans.intervalStartTime
 		tags := trans.getTags(keys, i)
 		for j, interval := range group {
@@ -1093,7 +1155,8 @@ func (trans *HashAggTransform) generateFixIntervalNoFillOutPut() {
 	chunk = trans.outputChunkPool.GetChunk()
 	chunk.SetName(trans.bufChunk.Name())
 	keys := trans.schema.GetOptions().GetOptDimension()
-	for i, group := range trans.resultMap {
+	for _, i := range trans.groupOrder() {
+		group := trans.groupIntervals(trans.resultMap[i])
 		tags := trans.getTags(keys, i)
 		chunk.AppendTagsAndIndex(*tags, chunk.Len())
 		for _, interval := range group {
@@ -1124,6 +1187,47 @@ func (trans *HashAggTransform) generateFixIntervalNoFillOutPut() {
 	trans.sendChunk(chunk)
 }
 
+// groupOrder returns the ids of the groups in the output order
+func (trans *HashAggTransform) groupOrder() []int {
+	order := make([]int, len(trans.resultMap))
+	for i := range order {
+		order[i] = i
+	}
+	if !trans.sortedOutput || len(trans.groupKeys) != len(trans.resultMap) {
+		return order
+	}
+	dims := trans.opt.Dimensions
+	sort.SliceStable(order, func(i, j int) bool {
+		x, y := trans.groupKeys[order[i]].Subset(dims), trans.groupKeys[order[j]].Subset(dims)
+		if trans.opt.Ascending {
+			return bytes.Compare(x, y) < 0
+		}
+		return bytes.Compare(x, y) > 0
+	})
+	return order
+}
+
+// groupIntervals returns the intervals of a group in the output order. The intervals of a fixed
+// size window are indexed by time, the others are in the order they are first seen.
+func (trans *HashAggTransform) groupIntervals(group []*aggOperatorMsg) []*aggOperatorMsg {
+	if !trans.sortedOutput || !trans.opt.HasInterval() || (trans.fixSizeInterval && trans.opt.Ascending) {
+		return group
+	}
+	intervals := make([]*aggOperatorMsg, 0, len(group))
+	for _, interval := range group {
+		if interval != nil {
+			intervals = append(intervals, interval)
+		}
+	}
+	sort.SliceStable(intervals, func(i, j int) bool {
+		if trans.opt.Ascending {
+			return intervals[i].intervalStartTime < intervals[j].intervalStartTime
+		}
+		return intervals[i].intervalStartTime > intervals[j].intervalStartTime
+	})
+	return intervals
+}
+
 // for normal hashagg
 func (trans *HashAggTransform) generateNormalOutPut() {
 	trans.generateFixIntervalNoFillOutPut()
@@ -1158,16 +1262,37 @@ func (trans *HashAggTransform) sendChunk(c Chunk) {
 	}
 }
 
-func (trans *HashAggTransform) initDiskAsInput() bool {
+// initDiskAsInput changes the input to the groups spilled by the finished pass.
+// It returns false if no group is spilled.
+func (trans *HashAggTransform) initDiskAsInput() (bool, error) {
 	// 1. change input
+	trans.diskChunks.Close()
+	trans.diskChunks = nil
+	if trans.spillFile != nil {
+		if err := trans.flushSpill(); err != nil {
+			return false, err
+		}
+		if err := trans.spillFile.Rewind(); err != nil {
+			return false, err
+		}
+		trans.diskChunks = &chunkInDisk{file: trans.spillFile}
+		trans.spillFile = nil
+		trans.groupMap = hashtable.DefaultStringHashMap()
+	}
 
 	// 2. reinit two hashmap and resultMap
+	trans.resetResult()
+	return trans.diskChunks != nil, nil
+}
+
+func (trans *HashAggTransform) resetResult() {
 	trans.groupResultMap = trans.groupResultMap[:0]
 	trans.groupKeys = trans.groupKeys[:0]
 
 	trans.resultMap = trans.resultMap[:0]
 	trans.resultMapMPool.Free()
-	return false
+	trans.isSpill = false
+	trans.releaseMemory()
 }
 
 func (trans *HashAggTransform) GetOutputs() Ports {
@@ -0,0 +1,88 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"go.uber.org/zap"
+)
+
+// the estimated memory of a group and of the aggregate operator of a group in a time interval
+const (
+	hashAggGroupMemSize    = 128
+	hashAggOperatorMemSize = 64
+)
+
+// canSpill returns true if the rows can be spilled by group.
+// Without GROUP BY tags all the rows belong to one group, which can not be split.
+// The groups of each pass are output separately, so a sorted output can not be spilled either.
+func (trans *HashAggTransform) canSpill() bool {
+	return len(trans.opt.Dimensions) > 0 && !trans.opt.Without && !trans.opt.IsPromQuery() && !trans.sortedOutput
+}
+
+// growMemory accounts the groups added by the last chunk. Once the query exceeds its memory
+// budget, the transform stops adding groups: the rows of the groups in memory are still
+// aggregated, while the rows of new groups are spilled to disk and aggregated by the next pass.
+func (trans *HashAggTransform) growMemory() error {
+	if trans.pendingMem == 0 {
+		return nil
+	}
+	size := trans.pendingMem
+	trans.pendingMem = 0
+	trans.memUsed += size
+	if trans.memAccountant.Grow(hashAggTransfromName, size) || trans.isSpill || !trans.canSpill() {
+		return nil
+	}
+
+	file, err := newChunkSpillFile(trans.inputs[0].RowDataType)
+	if err != nil {
+		return err
+	}
+	if trans.spillChunk == nil {
+		trans.spillChunk = NewChunkBuilder(trans.inputs[0].RowDataType).NewChunk("")
+	}
+	trans.spillFile = file
+	trans.isSpill = true
+	trans.hashAggLogger.Info("hash agg spill to disk", zap.Int("groups", len(trans.resultMap)),
+		zap.Int64("memory", trans.memUsed), zap.Uint64("query_id", trans.opt.QueryId))
+	return nil
+}
+
+func (trans *HashAggTransform) flushSpill() error {
+	if trans.spillChunk == nil || trans.spillChunk.Len() == 0 {
+		return nil
+	}
+	size := trans.spillFile.Size()
+	if err := trans.spillFile.Write(trans.spillChunk); err != nil {
+		return err
+	}
+	trans.memAccountant.Spill(trans.spillFile.Size() - size)
+	trans.spillChunk.Reset()
+	return nil
+}
+
+func (trans *HashAggTransform) releaseMemory() {
+	trans.memAccountant.Shrink(hashAggTransfromName, trans.memUsed)
+	trans.memUsed = 0
+}
+
+func (trans *HashAggTransform) closeSpill() {
+	trans.diskChunks.Close()
+	trans.diskChunks = nil
+	trans.spillFile.Close()
+	trans.spillFile = nil
+	trans.releaseMemory()
+}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
 	"reflect"
 	"strconv"
 	"testing"
@@ -26,6 +27,7 @@ import (
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/rand"
+	"github.com/openGemini/openGemini/lib/sysconfig"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
@@ -3201,3 +3203,125 @@ func TestNilValueInChunk(t *testing.T) {
 		}
 	}
 }
+
+func buildHashAggSpillChunk(rt hybridqp.RowDataType, groups ...string) executor.Chunk {
+	chunk := executor.NewChunkBuilder(rt).NewChunk("m1")
+	chunk.NewDims(1)
+	for i, g := range groups {
+		chunk.AppendTime(int64(i + 1))
+		chunk.AddDims([]string{g})
+		chunk.Column(0).AppendIntegerValue(int64(i + 1))
+		chunk.Column(0).AppendNotNil()
+	}
+	return chunk
+}
+
+func TestHashAggTransformSpill(t *testing.T) {
+	limit, dir := sysconfig.GetQueryMemoryLimit(), sysconfig.GetQuerySpillDir()
+	defer sysconfig.SetQueryMemoryLimit(limit)
+	defer sysconfig.SetQuerySpillDir(dir)
+	spillDir := t.TempDir()
+	sysconfig.SetQueryMemoryLimit(1)
+	sysconfig.SetQuerySpillDir(spillDir)
+
+	inRowDataType := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "val0", Type: influxql.Integer})
+	outRowDataType := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "val0", Type: influxql.Integer})
+	source := NewSourceFromMultiChunk(inRowDataType, []executor.Chunk{
+		buildHashAggSpillChunk(inRowDataType, "g0", "g1", "g0"),
+		buildHashAggSpillChunk(inRowDataType, "g0", "g2", "g3", "g2"),
+		buildHashAggSpillChunk(inRowDataType, "g1", "g4", "g3"),
+	})
+	opt := query.ProcessorOptions{
+		ChunkSize:   1024,
+		ChunkedSize: 10000,
+		Dimensions:  []string{"tag1"},
+		Ascending:   true,
+		StartTime:   influxql.MinTime,
+		EndTime:     influxql.MaxTime,
+	}
+	schema := executor.NewQuerySchema(nil, nil, &opt, nil)
+	exprOpt := []hybridqp.ExprOptions{{
+		Expr: &influxql.Call{Name: "count", Args: []influxql.Expr{hybridqp.MustParseExpr("val0")}},
+		Ref:  influxql.VarRef{Val: "val0", Type: influxql.Integer},
+	}}
+	trans, err := executor.NewHashAggTransform([]hybridqp.RowDataType{inRowDataType}, []hybridqp.RowDataType{outRowDataType}, exprOpt, schema, executor.Normal)
+	assert.NoError(t, err)
+
+	counts := make(map[string]int64)
+	sink := NewSinkFromFunction(outRowDataType, func(chunk executor.Chunk) error {
+		for i, tags := range chunk.Tags() {
+			_, vals := tags.GetChunkTagAndValues()
+			counts[vals[0]] += chunk.Column(0).IntegerValue(chunk.TagIndex()[i])
+		}
+		return nil
+	})
+	assert.NoError(t, executor.Connect(source.Output, trans.GetInputs()[0]))
+	assert.NoError(t, executor.Connect(trans.GetOutputs()[0], sink.Input))
+	executors := executor.NewPipelineExecutor(executor.Processors{source, trans, sink})
+	ctx := executor.NewContextWithMemoryAccountant(context.Background())
+	assert.NoError(t, executors.Execute(ctx))
+	executors.Release()
+
+	assert.Equal(t, map[string]int64{"g0": 3, "g1": 2, "g2": 2, "g3": 2, "g4": 1}, counts)
+	accountant := executor.MemoryAccountantFromContext(ctx)
+	assert.Greater(t, accountant.Spilled(), int64(0))
+	assert.Equal(t, int64(0), accountant.Used())
+
+	files, err := os.ReadDir(spillDir)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestHashAggTransformSortedOutput(t *testing.T) {
+	inRowDataType := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "val0", Type: influxql.Float})
+	outRowDataType := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "sumVal0", Type: influxql.Float})
+	chunk := executor.NewChunkBuilder(inRowDataType).NewChunk("m1")
+	chunk.AppendTimes([]int64{1, 2, 3, 4})
+	chunk.NewDims(1)
+	for _, tag := range []string{"tag1val3", "tag1val1", "tag1val2", "tag1val1"} {
+		chunk.AddDims([]string{tag})
+	}
+	chunk.Column(0).AppendFloatValues([]float64{1, 2, 3, 4})
+	chunk.Column(0).AppendManyNotNil(4)
+	source := NewSourceFromMultiChunk(inRowDataType, []executor.Chunk{chunk})
+
+	opt := query.ProcessorOptions{
+		ChunkSize:   1024,
+		ChunkedSize: 10000,
+		Dimensions:  []string{"tag1"},
+		Ascending:   true,
+		Fill:        influxql.NullFill,
+		StartTime:   influxql.MinTime,
+		EndTime:     influxql.MaxTime,
+	}
+	schema := executor.NewQuerySchema(nil, nil, &opt, nil)
+	exprOpt := []hybridqp.ExprOptions{{
+		Expr: &influxql.Call{Name: "sum", Args: []influxql.Expr{hybridqp.MustParseExpr("val0")}},
+		Ref:  influxql.VarRef{Val: "sumVal0", Type: influxql.Float},
+	}}
+	trans, err := executor.NewHashAggTransform([]hybridqp.RowDataType{inRowDataType}, []hybridqp.RowDataType{outRowDataType}, exprOpt, schema, executor.Normal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trans.(*executor.HashAggTransform).SetSortedOutput(true)
+
+	var tags []string
+	var values []float64
+	sink := NewSinkFromFunction(outRowDataType, func(chunk executor.Chunk) error {
+		for _, tag := range chunk.Tags() {
+			tags = append(tags, string(tag.Subset(nil))[5:13])
+		}
+		values = append(values, chunk.Column(0).FloatValues()...)
+		return nil
+	})
+	executor.Connect(source.Output, trans.GetInputs()[0])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	executors := executor.NewPipelineExecutor(executor.Processors{source, trans, sink})
+	if err = executors.Execute(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	executors.Release()
+
+	assert.Equal(t, []string{"tag1val1", "tag1val2", "tag1val3"}, tags)
+	assert.Equal(t, []float64{6, 3, 1}, values)
+}
@@ -227,7 +227,8 @@ func (trans *HashMergeTransform) Work(ctx context.Context) error {
 }
 
 func (trans *HashMergeTransform) getChunkFromDisk() bool {
-	c, ok := trans.diskChunks.GetChunk()
+	// HashMergeTransform does not spill, diskChunks is always empty
+	c, ok, _ := trans.diskChunks.GetChunk()
 	if !ok {
 		return false
 	}
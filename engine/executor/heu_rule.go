@@ -18,7 +18,6 @@ import (
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/logger"
-	"github.com/openGemini/openGemini/lib/sysconfig"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 )
 
@@ -397,7 +396,7 @@ func (r *AggPushdownToReaderRule) OnMatch(call *OptRuleCall) {
 		return
 	}
 
-	canSlidingWindowPushDown := reader.Schema().HasSlidingWindowCall() && sysconfig.GetEnableSlidingWindowPushUp() != sysconfig.OnSlidingWindowPushUp
+	canSlidingWindowPushDown := reader.Schema().HasSlidingWindowCall() && !IsSlidingWindowPushUp(reader.Schema())
 	if !reader.Schema().CanCallsPushdown() || (!reader.Schema().HasPercentileOGSketch() && !canSlidingWindowPushDown) {
 		return
 	}
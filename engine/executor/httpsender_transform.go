@@ -583,6 +583,7 @@ var aggregationCall = map[string]bool{
 	"count": true, "distinct": true, "sum": true,
 	"mean": true, "median": true, "spread": true,
 	"mode": true, "stddev": true, "integral": true,
+	"time_weighted_avg": true, "interpolate": true,
 }
 
 var transformationCall = map[string]bool{
@@ -23,4 +23,7 @@ func init() {
 	_ = op.GetOpFactory().AddOp(op.NewSumOp(op.FuncRoutineFactory(sumRoutineFactory)))
 	_ = op.GetOpFactory().AddOp(op.NewCountOp(op.FuncRoutineFactory(countRoutineFactory)))
 	_ = op.GetOpFactory().AddOp(op.NewCastorOp(op.FuncRoutineFactory(castorRoutineFactory)))
+	for _, anomalyOp := range op.NewAnomalyOps(op.FuncRoutineFactory(castorRoutineFactory)) {
+		_ = op.GetOpFactory().AddOp(anomalyOp)
+	}
 }
@@ -99,6 +99,38 @@ func PrintPlan(planName string, plan hybridqp.QueryNode) {
 	}
 }
 
+// PlanSummary returns the plan as its node names in pre-order, the children of a node are in parentheses,
+// such as LogicalAggregate(LogicalExchange(LogicalSeries))
+func PlanSummary(plan hybridqp.QueryNode) string {
+	if plan == nil {
+		return ""
+	}
+	var sb strings.Builder
+	writePlanSummary(&sb, plan)
+	return sb.String()
+}
+
+func writePlanSummary(sb *strings.Builder, plan hybridqp.QueryNode) {
+	sb.WriteString(GetTypeName(plan))
+	children := plan.Children()
+	if len(children) == 0 {
+		return
+	}
+	sb.WriteByte('(')
+	written := 0
+	for _, child := range children {
+		if child == nil {
+			continue
+		}
+		if written > 0 {
+			sb.WriteString(", ")
+		}
+		writePlanSummary(sb, child)
+		written++
+	}
+	sb.WriteByte(')')
+}
+
 func ValidateFieldsFromPlans(plans []hybridqp.QueryNode) bool {
 	if len(plans) == 0 {
 		return false
@@ -185,6 +217,9 @@ type LogicalPlanWriterImpl struct {
 	Builder *strings.Builder
 	Values  *list.List
 	Spacer  *Spacer
+
+	// Estimator appends the estimated rows and cost to each node if set
+	Estimator *PlanCostEstimator
 }
 
 func NewLogicalPlanWriterImpl(builder *strings.Builder) *LogicalPlanWriterImpl {
@@ -203,6 +238,10 @@ func (w *LogicalPlanWriterImpl) Explain(node LogicalPlan) {
 	w.Builder.WriteString(w.Spacer.String())
 	w.Builder.WriteString(node.String())
 
+	if w.Estimator != nil {
+		w.Item("estimate", w.Estimator.Estimate(node))
+	}
+
 	j := 0
 
 	e := w.Values.Front()
@@ -812,10 +851,24 @@ func (p *LogicalAggregate) CountToSum() {
 		if call.Name == "count" || call.Name == "count_prom" {
 			call.Name = "sum"
 			p.digest = false
+		} else if isTimeWeightedPartial(call) {
+			timeWeightedPartialToSum(call)
+			p.digest = false
 		}
 	}
 }
 
+func isTimeWeightedPartial(call *influxql.Call) bool {
+	return call.Name == query.TimeWeightedArea || call.Name == query.TimeWeightedDuration
+}
+
+// timeWeightedPartialToSum sums up the partial areas and durations of time_weighted_avg computed below,
+// the weighting arguments are only used where the points are read.
+func timeWeightedPartialToSum(call *influxql.Call) {
+	call.Name = "sum"
+	call.Args = call.Args[:1]
+}
+
 func (p *LogicalAggregate) Clone() hybridqp.QueryNode {
 	clone := &LogicalAggregate{}
 	*clone = *p
@@ -3587,6 +3640,7 @@ type LogicalFullJoin struct {
 	left      hybridqp.QueryNode
 	right     hybridqp.QueryNode
 	condition influxql.Expr
+	buildSide int // the input read ahead and kept in memory, the other one is streamed, -1 if not decided
 	LogicalPlanBase
 }
 
@@ -3595,6 +3649,7 @@ func NewLogicalFullJoin(left hybridqp.QueryNode, right hybridqp.QueryNode, condi
 		left:      left,
 		right:     right,
 		condition: condition,
+		buildSide: -1,
 		LogicalPlanBase: LogicalPlanBase{
 			id:     hybridqp.GenerateNodeId(),
 			schema: schema,
@@ -3652,9 +3707,24 @@ func (p *LogicalFullJoin) ReplaceChild(ordinal int, child hybridqp.QueryNode) {
 
 func (p *LogicalFullJoin) Explain(writer LogicalPlanWriter) {
 	p.ExplainIterms(writer)
+	switch p.buildSide {
+	case 0:
+		writer.Item("build", "left")
+	case 1:
+		writer.Item("build", "right")
+	}
 	writer.Explain(p)
 }
 
+// BuildSide returns the index of the input the join reads ahead, or -1 if both inputs are read ahead.
+func (p *LogicalFullJoin) BuildSide() int {
+	return p.buildSide
+}
+
+func (p *LogicalFullJoin) SetBuildSide(side int) {
+	p.buildSide = side
+}
+
 func (p *LogicalFullJoin) Type() string {
 	return GetType(p)
 }
@@ -3754,6 +3824,8 @@ type LogicalHashAgg struct {
 	callsOrder []string
 	LogicalExchangeBase
 	hashAggType HashAggType
+	// sortedOutput keeps the order of the sort merge, see HashAggTransform.SetSortedOutput
+	sortedOutput bool
 }
 
 func NewLogicalHashAgg(input hybridqp.QueryNode, schema hybridqp.Catalog, eType ExchangeType, eTraits []hybridqp.Trait) *LogicalHashAgg {
@@ -3935,6 +4007,9 @@ func (p *LogicalHashAgg) CountToSum() {
 		if call.Name == "count" {
 			call.Name = "sum"
 			p.digest = false
+		} else if isTimeWeightedPartial(call) {
+			timeWeightedPartialToSum(call)
+			p.digest = false
 		}
 	}
 }
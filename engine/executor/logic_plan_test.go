@@ -273,6 +273,14 @@ func TestNewLogicalSplitGroup(t *testing.T) {
 	}
 }
 
+func TestPlanSummary(t *testing.T) {
+	schema := createQuerySchemaWithCalls()
+	series := executor.NewLogicalSeries(schema)
+	agg := executor.NewLogicalAggregate(executor.NewLogicalReader(series, schema), schema)
+	assert.Equal(t, "LogicalAggregate(LogicalReader(LogicalSeries))", executor.PlanSummary(agg))
+	assert.Equal(t, "", executor.PlanSummary(nil))
+}
+
 func TestNewLogicalFullJoin(t *testing.T) {
 	schema := createQuerySchema()
 	node := executor.NewLogicalSeries(schema)
@@ -0,0 +1,150 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openGemini/openGemini/lib/sysconfig"
+)
+
+type memoryAccountantKey struct{}
+
+// MemoryAccountant tracks the memory held by the operators of one query.
+// Operators report the chunks they keep with Grow and the memory they free with Shrink.
+// When Grow reports that the budget is exceeded, an operator able to spill writes
+// its data to disk and shrinks its usage.
+// A nil MemoryAccountant accounts nothing and never exceeds the budget.
+type MemoryAccountant struct {
+	mu        sync.Mutex
+	limit     int64
+	used      int64
+	peak      int64
+	spilled   int64
+	operators map[string]int64
+}
+
+func NewMemoryAccountant(limit int64) *MemoryAccountant {
+	return &MemoryAccountant{
+		limit:     limit,
+		operators: make(map[string]int64),
+	}
+}
+
+// NewContextWithMemoryAccountant attaches a memory accountant with the configured
+// query-memory-limit to ctx, unless ctx already has one.
+func NewContextWithMemoryAccountant(ctx context.Context) context.Context {
+	if MemoryAccountantFromContext(ctx) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, memoryAccountantKey{}, NewMemoryAccountant(sysconfig.GetQueryMemoryLimit()))
+}
+
+func MemoryAccountantFromContext(ctx context.Context) *MemoryAccountant {
+	if ctx == nil {
+		return nil
+	}
+	a, _ := ctx.Value(memoryAccountantKey{}).(*MemoryAccountant)
+	return a
+}
+
+// Grow accounts size bytes to the operator and returns false if the query exceeds its budget.
+func (a *MemoryAccountant) Grow(operator string, size int64) bool {
+	if a == nil {
+		return true
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.used += size
+	a.operators[operator] += size
+	if a.used > a.peak {
+		a.peak = a.used
+	}
+	return a.limit <= 0 || a.used <= a.limit
+}
+
+func (a *MemoryAccountant) Shrink(operator string, size int64) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.used -= size
+	a.operators[operator] -= size
+	if a.operators[operator] <= 0 {
+		delete(a.operators, operator)
+	}
+}
+
+// Spill records that the operator has written size bytes to disk.
+func (a *MemoryAccountant) Spill(size int64) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	a.spilled += size
+	a.mu.Unlock()
+}
+
+func (a *MemoryAccountant) Limit() int64 {
+	if a == nil {
+		return 0
+	}
+	return a.limit
+}
+
+func (a *MemoryAccountant) Used() int64 {
+	if a == nil {
+		return 0
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.used
+}
+
+func (a *MemoryAccountant) Peak() int64 {
+	if a == nil {
+		return 0
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.peak
+}
+
+func (a *MemoryAccountant) Spilled() int64 {
+	if a == nil {
+		return 0
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.spilled
+}
+
+// OperatorUsage returns the memory held by each operator.
+func (a *MemoryAccountant) OperatorUsage() map[string]int64 {
+	usage := make(map[string]int64)
+	if a == nil {
+		return usage
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for k, v := range a.operators {
+		usage[k] = v
+	}
+	return usage
+}
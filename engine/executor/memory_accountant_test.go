@@ -0,0 +1,62 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/sysconfig"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryAccountant(t *testing.T) {
+	a := executor.NewMemoryAccountant(100)
+	assert.True(t, a.Grow("SortTransform", 60))
+	assert.False(t, a.Grow("HashAggTransform", 60))
+	assert.Equal(t, map[string]int64{"SortTransform": 60, "HashAggTransform": 60}, a.OperatorUsage())
+
+	a.Shrink("SortTransform", 60)
+	a.Spill(60)
+	assert.Equal(t, int64(60), a.Used())
+	assert.Equal(t, int64(120), a.Peak())
+	assert.Equal(t, int64(60), a.Spilled())
+	assert.Equal(t, map[string]int64{"HashAggTransform": 60}, a.OperatorUsage())
+
+	// unlimited
+	assert.True(t, executor.NewMemoryAccountant(0).Grow("SortTransform", 1<<40))
+
+	// nil accountant
+	var nilAccountant *executor.MemoryAccountant
+	assert.True(t, nilAccountant.Grow("SortTransform", 1<<40))
+	nilAccountant.Shrink("SortTransform", 1)
+	assert.Equal(t, int64(0), nilAccountant.Used())
+}
+
+func TestMemoryAccountantContext(t *testing.T) {
+	limit := sysconfig.GetQueryMemoryLimit()
+	defer sysconfig.SetQueryMemoryLimit(limit)
+	sysconfig.SetQueryMemoryLimit(1024)
+
+	assert.Nil(t, executor.MemoryAccountantFromContext(context.Background()))
+	ctx := executor.NewContextWithMemoryAccountant(context.Background())
+	a := executor.MemoryAccountantFromContext(ctx)
+	assert.Equal(t, int64(1024), a.Limit())
+	// the accountant of the query is kept
+	assert.Equal(t, ctx, executor.NewContextWithMemoryAccountant(ctx))
+}
@@ -71,6 +71,7 @@ type heapOrderByItem struct {
 	startTime int64
 	endTime   int64
 	chunk     Chunk
+	run       *chunkSpillFile // the run the chunk is read from, if the rows have been spilled
 }
 
 type OrderByTransform struct {
@@ -87,11 +88,18 @@ type OrderByTransform struct {
 	currChunk       chan Chunk
 	resultChunk     Chunk
 	dimensions      []string
-	transferHelper  func()
+	transferHelper  func() error
 	CoProcessor     CoProcessor
 	heapItems       *heapOrderByItems
+
+	memAccountant *MemoryAccountant
+	memUsed       int64
+	spillRuns     []*chunkSpillFile
+	spillChunk    Chunk
 }
 
+const orderByTransformName = "OrderByTransform"
+
 func NewOrderByTransform(inRowDataType hybridqp.RowDataType, outRowDataType hybridqp.RowDataType, ops []hybridqp.ExprOptions, opt *query.ProcessorOptions, dimensions []string) *OrderByTransform {
 	trans := &OrderByTransform{
 		input:        NewChunkPort(inRowDataType),
@@ -156,6 +164,12 @@ func (trans *OrderByTransform) Work(ctx context.Context) error {
 		tracing.Finish(span, trans.workTracing)
 	}()
 
+	trans.memAccountant = MemoryAccountantFromContext(ctx)
+	defer func() {
+		trans.releaseMemory(0)
+		trans.closeSpillRuns()
+	}()
+
 	runnable := func() {
 		defer wg.Done()
 		for {
@@ -180,9 +194,14 @@ func (trans *OrderByTransform) Work(ctx context.Context) error {
 	}
 	wg.Add(1)
 	go runnable()
-	trans.transferHelper()
+	err := trans.transferHelper()
+	if err != nil {
+		// unblock the runnable until the input is closed
+		for range trans.currChunk {
+		}
+	}
 	wg.Wait()
-	return nil
+	return err
 }
 
 func (trans *OrderByTransform) closeChunkChannel() {
@@ -231,11 +250,11 @@ func (trans *OrderByTransform) GetTagsResetTagIndexes(chunk Chunk) {
 	trans.currTagIndex = IndexUnion(trans.currTagIndex, []int{0})
 }
 
-func (trans *OrderByTransform) transferFast() {
+func (trans *OrderByTransform) transferFast() error {
 	for {
 		chunk, ok := <-trans.currChunk
 		if !ok {
-			return
+			return nil
 		}
 		trans.GetTagAndIndexes(chunk)
 		trans.resultChunk = chunk
@@ -247,21 +266,27 @@ func (trans *OrderByTransform) transferFast() {
 	}
 }
 
-func (trans *OrderByTransform) transferGroupByTime() {
+func (trans *OrderByTransform) transferGroupByTime() error {
 	var chunk Chunk
+	var chunkSize int64
+	var overBudget bool
 	for {
 		if len(trans.currTags) < 2 {
 			c, ok := <-trans.currChunk
 			if !ok {
-				trans.RebuildChunk()
+				if err := trans.RebuildChunk(); err != nil {
+					return err
+				}
+				trans.releaseMemory(0)
 				if trans.resultChunk.Len() == 0 {
-					return
+					return nil
 				}
 				trans.IntervalIndexReGen()
 				trans.SendChunk()
-				return
+				return nil
 			}
 			chunk = c
+			chunkSize, overBudget = trans.growMemory(chunk)
 			trans.GetTagsResetTagIndexes(chunk)
 		}
 		for len(trans.currTags) > 1 {
@@ -272,10 +297,19 @@ func (trans *OrderByTransform) transferGroupByTime() {
 			trans.heapItemsInit(trans.currTagIndex[0], trans.currTagIndex[1], chunk)
 			trans.currTags = trans.currTags[1:]
 			trans.currTagIndex = trans.currTagIndex[1:]
-			trans.RebuildChunk()
+			if err := trans.RebuildChunk(); err != nil {
+				return err
+			}
+			// the group is output, only the rows of the current chunk are still held
+			trans.releaseMemory(chunkSize)
 		}
 		trans.TagAndTagIndexHandler()
 		trans.heapItemsInit(trans.currTagIndex[0], chunk.Len(), chunk)
+		if overBudget {
+			if err := trans.spillRun(); err != nil {
+				return err
+			}
+		}
 	}
 }
 
@@ -286,15 +320,28 @@ func (trans *OrderByTransform) TagAndTagIndexHandler() {
 	}
 }
 
-func (trans *OrderByTransform) RebuildChunk() {
+// RebuildChunk outputs the rows of the group ordered by time, merging the rows held in memory with the runs spilled to disk.
+func (trans *OrderByTransform) RebuildChunk() error {
+	defer trans.closeSpillRuns()
+	if err := trans.loadSpillRuns(); err != nil {
+		return err
+	}
 	for trans.heapItems.Len() > 0 {
-		trans.OrderTime()
+		if err := trans.OrderTime(); err != nil {
+			return err
+		}
 		if trans.resultChunk.Len() >= trans.opt.ChunkSize {
+			tags := trans.resultChunk.Tags()
 			trans.IntervalIndexReGen()
 			trans.SendChunk()
 			trans.resultChunk = trans.ResultChunkPool.GetChunk()
+			if trans.heapItems.Len() > 0 {
+				// the group goes on in the next chunk
+				trans.resultChunk.AppendTagsAndIndex(tags[len(tags)-1], 0)
+			}
 		}
 	}
+	return nil
 }
 
 func (trans *OrderByTransform) heapItemsInit(start, end int, chunk Chunk) {
@@ -327,8 +374,9 @@ func (trans *OrderByTransform) heapItemsInit(start, end int, chunk Chunk) {
 	heap.Init(trans.heapItems)
 }
 
-func (trans *OrderByTransform) OrderTime() {
-	for len(trans.heapItems.items) > 0 {
+// OrderTime moves the rows of the heap to the result chunk until the heap is empty or the result chunk is full.
+func (trans *OrderByTransform) OrderTime() error {
+	for len(trans.heapItems.items) > 0 && (trans.opt.ChunkSize <= 0 || trans.resultChunk.Len() < trans.opt.ChunkSize) {
 		currItem, ok := heap.Pop(trans.heapItems).(*heapOrderByItem)
 		if !ok {
 			panic("trans.heapItems isn't heapOrderByItem")
@@ -342,12 +390,28 @@ func (trans *OrderByTransform) OrderTime() {
 			trans.resultChunk.SetName(currItem.chunk.Name())
 		}
 		trans.resultChunk.AppendTime(currItem.chunk.TimeByIndex(currItem.start))
-		currItem.start++
-		if currItem.start < currItem.end {
-			currItem.startTime, currItem.endTime = trans.opt.Window(currItem.chunk.TimeByIndex(currItem.start))
-			heap.Push(trans.heapItems, currItem)
+		if err := trans.nextItemRow(currItem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextItemRow moves the item to its next row and pushes it back to the heap, unless the rows of the item are all consumed.
+func (trans *OrderByTransform) nextItemRow(item *heapOrderByItem) error {
+	item.start++
+	if item.start >= item.end && item.run != nil {
+		c, err := item.run.Read()
+		if err != nil || c == nil {
+			return err
 		}
+		item.chunk, item.start, item.end = c, 0, c.Len()
+	}
+	if item.start < item.end {
+		item.startTime, item.endTime = trans.opt.Window(item.chunk.TimeByIndex(item.start))
+		heap.Push(trans.heapItems, item)
 	}
+	return nil
 }
 
 func (trans *OrderByTransform) SendChunk() {
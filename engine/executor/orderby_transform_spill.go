@@ -0,0 +1,111 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"container/heap"
+)
+
+// growMemory accounts the chunk held until the rows of its groups are output,
+// and returns false as the second value if the query exceeds its memory budget.
+func (trans *OrderByTransform) growMemory(c Chunk) (int64, bool) {
+	size := int64(c.Size())
+	trans.memUsed += size
+	return size, !trans.memAccountant.Grow(orderByTransformName, size)
+}
+
+// releaseMemory releases the chunks no longer held, keep is the size of the chunks still held.
+func (trans *OrderByTransform) releaseMemory(keep int64) {
+	trans.memAccountant.Shrink(orderByTransformName, trans.memUsed-keep)
+	trans.memUsed = keep
+}
+
+// spillRun writes the rows of the open group to disk as a run ordered by time,
+// so that the chunks they refer to are released.
+func (trans *OrderByTransform) spillRun() error {
+	file, err := newChunkSpillFile(trans.input.RowDataType)
+	if err != nil {
+		return err
+	}
+	trans.spillRuns = append(trans.spillRuns, file)
+	if trans.spillChunk == nil {
+		trans.spillChunk = NewChunkBuilder(trans.input.RowDataType).NewChunk("")
+	}
+	trans.spillChunk.Reset()
+
+	for trans.heapItems.Len() > 0 {
+		item, ok := heap.Pop(trans.heapItems).(*heapOrderByItem)
+		if !ok {
+			panic("trans.heapItems isn't heapOrderByItem")
+		}
+		if trans.spillChunk.Name() == "" {
+			trans.spillChunk.SetName(item.chunk.Name())
+		}
+		trans.spillChunk.AppendTime(item.chunk.TimeByIndex(item.start))
+		for i := range item.chunk.Columns() {
+			appendColumnRows(trans.spillChunk.Column(i), item.chunk.Column(i), item.start, item.start+1)
+		}
+		if trans.spillChunk.Len() >= trans.opt.ChunkSize {
+			if err = file.Write(trans.spillChunk); err != nil {
+				return err
+			}
+			trans.spillChunk.Reset()
+		}
+		if err = trans.nextItemRow(item); err != nil {
+			return err
+		}
+	}
+	if err = file.Write(trans.spillChunk); err != nil {
+		return err
+	}
+
+	trans.memAccountant.Spill(file.Size())
+	trans.releaseMemory(0)
+	return nil
+}
+
+// loadSpillRuns adds the first chunk of each spilled run of the group to the heap.
+func (trans *OrderByTransform) loadSpillRuns() error {
+	for _, run := range trans.spillRuns {
+		if err := run.Rewind(); err != nil {
+			return err
+		}
+		c, err := run.Read()
+		if err != nil {
+			return err
+		}
+		if c == nil {
+			continue
+		}
+		startTime, endTime := trans.opt.Window(c.TimeByIndex(0))
+		heap.Push(trans.heapItems, &heapOrderByItem{
+			end:       c.Len(),
+			startTime: startTime,
+			endTime:   endTime,
+			chunk:     c,
+			run:       run,
+		})
+	}
+	return nil
+}
+
+func (trans *OrderByTransform) closeSpillRuns() {
+	for _, run := range trans.spillRuns {
+		run.Close()
+	}
+	trans.spillRuns = trans.spillRuns[:0]
+}
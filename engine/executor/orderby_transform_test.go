@@ -17,13 +17,17 @@ limitations under the License.
 package executor_test
 
 import (
+	"context"
+	"os"
 	"testing"
 
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/sysconfig"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func buildOrderByTransformRowDataType() hybridqp.RowDataType {
@@ -111,3 +115,80 @@ func TestOrderByTransformInitPromDims2(t *testing.T) {
 	trans3.GetTagsResetTagIndexes(chunk)
 	assert.Equal(t, trans3.GetCurrTags(0), "\x02\x00\x05\x00\r\x00tag1\x00tag1val\x00")
 }
+
+func buildOrderByTransformSpillChunks() []executor.Chunk {
+	rowDataType := buildOrderByTransformRowDataType()
+	b := executor.NewChunkBuilder(rowDataType)
+
+	chunk1 := b.NewChunk("m")
+	chunk1.AppendTimes([]int64{1, 4, 7, 2, 5})
+	chunk1.AddTagAndIndex(*ParseChunkTags("host=h1,region=a"), 0)
+	chunk1.AddTagAndIndex(*ParseChunkTags("host=h2,region=a"), 3)
+	chunk1.AddIntervalIndex(0)
+	chunk1.AddIntervalIndex(3)
+	AppendFloatValues(chunk1, 0, []float64{1.1, 4.4, 7.7, 2.2, 5.5}, []bool{true, true, true, false, true})
+
+	chunk2 := b.NewChunk("m")
+	chunk2.AppendTimes([]int64{3, 6, 8, 1, 3})
+	chunk2.AddTagAndIndex(*ParseChunkTags("host=h3,region=a"), 0)
+	chunk2.AddTagAndIndex(*ParseChunkTags("host=h1,region=b"), 3)
+	chunk2.AddIntervalIndex(0)
+	chunk2.AddIntervalIndex(3)
+	AppendFloatValues(chunk2, 0, []float64{3.3, 6.6, 8.8, 10.1, 10.3}, []bool{true, true, true, true, true})
+
+	chunk3 := b.NewChunk("m")
+	chunk3.AppendTimes([]int64{2, 4})
+	chunk3.AddTagAndIndex(*ParseChunkTags("host=h2,region=b"), 0)
+	chunk3.AddIntervalIndex(0)
+	AppendFloatValues(chunk3, 0, []float64{10.2, 10.4}, []bool{true, false})
+	return []executor.Chunk{chunk1, chunk2, chunk3}
+}
+
+func runOrderByTransform(t *testing.T, ctx context.Context) string {
+	rowDataType := buildOrderByTransformRowDataType()
+	opt := &query.ProcessorOptions{
+		Interval:   hybridqp.Interval{Duration: 1},
+		Dimensions: []string{"region"},
+		Ascending:  true,
+		ChunkSize:  2,
+	}
+	source := NewSourceFromMultiChunk(rowDataType, buildOrderByTransformSpillChunks())
+	trans := executor.NewOrderByTransform(rowDataType, rowDataType, []hybridqp.ExprOptions{}, opt, opt.Dimensions)
+	var result string
+	sink := NewSinkFromFunction(rowDataType, func(chunk executor.Chunk) error {
+		assert.LessOrEqual(t, chunk.Len(), opt.ChunkSize)
+		result += StringToRows(chunk)
+		return nil
+	})
+	require.NoError(t, executor.Connect(source.Output, trans.GetInputs()[0]))
+	require.NoError(t, executor.Connect(trans.GetOutputs()[0], sink.Input))
+	e := executor.NewPipelineExecutor(executor.Processors{source, trans, sink})
+	require.NoError(t, e.Execute(ctx))
+	e.Release()
+	return result
+}
+
+func TestOrderByTransformSpill(t *testing.T) {
+	expStr := "value time\nregion\x00a\x00\n1.1 1\n 2\nvalue time\nregion\x00a\x00\n3.3 3\n4.4 4\n" +
+		"value time\nregion\x00a\x00\n5.5 5\n6.6 6\nvalue time\nregion\x00a\x00\n7.7 7\n8.8 8\n" +
+		"value time\nregion\x00b\x00\n10.1 1\n10.2 2\nvalue time\nregion\x00b\x00\n10.3 3\n 4\n"
+	assert.Equal(t, expStr, runOrderByTransform(t, context.Background()))
+
+	limit, dir := sysconfig.GetQueryMemoryLimit(), sysconfig.GetQuerySpillDir()
+	defer sysconfig.SetQueryMemoryLimit(limit)
+	defer sysconfig.SetQuerySpillDir(dir)
+	spillDir := t.TempDir()
+	sysconfig.SetQueryMemoryLimit(1)
+	sysconfig.SetQuerySpillDir(spillDir)
+
+	ctx := executor.NewContextWithMemoryAccountant(context.Background())
+	assert.Equal(t, expStr, runOrderByTransform(t, ctx))
+
+	accountant := executor.MemoryAccountantFromContext(ctx)
+	assert.Greater(t, accountant.Spilled(), int64(0))
+	assert.Equal(t, int64(0), accountant.Used())
+
+	files, err := os.ReadDir(spillDir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
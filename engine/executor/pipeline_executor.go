@@ -260,7 +260,7 @@ func (exec *PipelineExecutor) Execute(ctx context.Context) error {
 	exec.RunTimeStats.Begin()
 	defer exec.RunTimeStats.End()
 
-	err := exec.InitContext(ctx)
+	err := exec.InitContext(NewContextWithMemoryAccountant(ctx))
 	defer func() {
 		exec.Release()
 		exec.destroyContext()
@@ -1197,6 +1197,9 @@ func (builder *ExecutorBuilder) addHashAgg(hashAgg *LogicalHashAgg) (*TransformV
 		if err != nil {
 			return nil, err
 		}
+		if trans, ok := hash.(*HashAggTransform); ok {
+			trans.SetSortedOutput(hashAgg.sortedOutput)
+		}
 
 		vertex := NewTransformVertex(hashAgg, hash)
 		builder.dag.AddVertex(vertex)
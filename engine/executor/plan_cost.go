@@ -0,0 +1,496 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/sysconfig"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+// DefaultRowsPerSeries is used to estimate the number of rows of a measurement whose row count is unknown.
+const DefaultRowsPerSeries = 1024
+
+// The relative cost of processing one row by each kind of operator.
+const (
+	costScanRow     = 1.0
+	costNetworkRow  = 2.0
+	costMergeRow    = 0.2
+	costSortRow     = 0.5
+	costAggRow      = 0.5
+	costHashAggRow  = 0.8
+	costHashGroup   = 4.0
+	costJoinRow     = 1.0
+	costDefaultRow  = 0.1
+	defaultNDV      = 10
+	defaultFilter   = 0.5
+	selectivityEQ   = 0.1
+	selectivityNEQ  = 0.9
+	selectivityCmp  = 1.0 / 3
+	selectivityLike = 0.25
+	// the subquery of a semi join is restricted to the values of the outer measurement
+	// if it returns this many times more rows than the outer measurement has values
+	semiJoinRestrictRatio = 4
+	// the smaller input of a join is read ahead while the other one is streamed
+	// if the other one returns this many times more rows
+	joinBuildSideRatio = 2
+)
+
+// mergeableCalls are the calls whose results are merged from the partial results of the stores
+// by any aggregate operator of the sql node
+var mergeableCalls = map[string]struct{}{
+	"count": {}, "sum": {}, "min": {}, "max": {}, "mean": {}, "first": {}, "last": {},
+}
+
+// PlanCost is the estimated output and cumulative cost of a logical plan node.
+// Estimated is false if any statistic the estimation depends on is unknown,
+// in which case the planner keeps its heuristic choice.
+type PlanCost struct {
+	Rows      float64
+	Cost      float64
+	Estimated bool
+}
+
+func (c PlanCost) String() string {
+	if !c.Estimated {
+		return "unknown"
+	}
+	return fmt.Sprintf("rows=%.0f cost=%.0f", c.Rows, c.Cost)
+}
+
+// PlanCostEstimator estimates the cost of logical plans bottom-up with the statistics catalog.
+type PlanCostEstimator struct {
+	catalog *StatisticsCatalog
+	costs   map[uint64]PlanCost
+}
+
+func NewPlanCostEstimator(catalog *StatisticsCatalog) *PlanCostEstimator {
+	return &PlanCostEstimator{
+		catalog: catalog,
+		costs:   make(map[uint64]PlanCost),
+	}
+}
+
+func (e *PlanCostEstimator) Estimate(node hybridqp.QueryNode) PlanCost {
+	if node == nil {
+		return PlanCost{}
+	}
+	if c, ok := e.costs[node.ID()]; ok {
+		return c
+	}
+	c := e.estimate(node)
+	e.costs[node.ID()] = c
+	return c
+}
+
+func (e *PlanCostEstimator) estimate(node hybridqp.QueryNode) PlanCost {
+	children := node.Children()
+	inputs := make([]PlanCost, 0, len(children))
+	for _, child := range children {
+		inputs = append(inputs, e.Estimate(child))
+	}
+	in := sumPlanCost(inputs)
+
+	switch n := node.(type) {
+	case *LogicalReader, *LogicalSeries, *LogicalColumnStoreReader, *LogicalTSSPScan, *LogicalIndexScan, *LogicalSparseIndexScan:
+		if len(children) > 0 {
+			return in
+		}
+		rows, ok := e.ScanRows(node.Schema())
+		return PlanCost{Rows: rows, Cost: rows * costScanRow, Estimated: ok}
+	case *LogicalExchange:
+		if n.EType() == NODE_EXCHANGE {
+			return in.add(in.Rows, costNetworkRow)
+		}
+		return in.add(in.Rows, costMergeRow)
+	case *LogicalAggregate, *LogicalSlidingWindow, *LogicalIncAgg, *LogicalSequenceAggregate:
+		return e.estimateAgg(node.Schema(), in, costAggRow, 0)
+	case *LogicalHashAgg, *LogicalIncHashAgg:
+		return e.estimateAgg(node.Schema(), in, costHashAggRow, costHashGroup)
+	case *LogicalSortMerge, *LogicalSortAppend:
+		return in.add(in.Rows, costMergeRow*math.Max(1, math.Log2(float64(len(children)))))
+	case *LogicalSort, *LogicalOrderBy, *LogicalPromSort:
+		return in.add(in.Rows, costSortRow*math.Max(1, math.Log2(in.Rows)))
+	case *LogicalFullJoin, *LogicalJoin:
+		out := in.add(in.Rows, costJoinRow)
+		for _, c := range inputs {
+			out.Rows = math.Max(out.Rows, c.Rows)
+		}
+		if len(inputs) > 0 {
+			out.Rows = math.Min(out.Rows, in.Rows)
+		}
+		return out
+	case *LogicalFilter:
+		out := in.add(in.Rows, costDefaultRow)
+		out.Rows *= defaultFilter
+		return out
+	case *LogicalLimit:
+		out := in.add(in.Rows, costDefaultRow)
+		// the other limit types are applied per series
+		limitType := n.LimitPara.LimitType
+		if limitType == hybridqp.SingleRowIgnoreTagLimit || limitType == hybridqp.MultipleRowsIgnoreTagLimit {
+			out.Rows = math.Min(out.Rows, float64(n.LimitPara.Limit+n.LimitPara.Offset))
+		}
+		return out
+	default:
+		return in.add(in.Rows, costDefaultRow)
+	}
+}
+
+func sumPlanCost(costs []PlanCost) PlanCost {
+	sum := PlanCost{Estimated: len(costs) > 0}
+	for _, c := range costs {
+		sum.Rows += c.Rows
+		sum.Cost += c.Cost
+		sum.Estimated = sum.Estimated && c.Estimated
+	}
+	return sum
+}
+
+func (c PlanCost) add(rows float64, perRow float64) PlanCost {
+	c.Cost += rows * perRow
+	return c
+}
+
+func (e *PlanCostEstimator) estimateAgg(schema hybridqp.Catalog, in PlanCost, perRow, perGroup float64) PlanCost {
+	groups, ok := e.EstimateGroups(schema, in.Rows)
+	out := PlanCost{
+		Rows:      groups,
+		Cost:      in.Cost + in.Rows*perRow + groups*perGroup,
+		Estimated: in.Estimated && ok,
+	}
+	return out
+}
+
+// ScanRows estimates the number of rows read from the sources of the schema after the tag filter.
+func (e *PlanCostEstimator) ScanRows(schema hybridqp.Catalog) (float64, bool) {
+	if schema == nil {
+		return 0, false
+	}
+	var rows float64
+	known := len(schema.Sources()) > 0
+	for _, src := range schema.Sources() {
+		m, ok := src.(*influxql.Measurement)
+		if !ok {
+			known = false
+			continue
+		}
+		stats := e.catalog.GetByMeasurement(m)
+		if stats == nil {
+			known = false
+			continue
+		}
+		rows += float64(stats.EstimateRows()) * e.Selectivity(schema.Options().GetCondition(), stats)
+	}
+	return rows, known
+}
+
+// EstimateGroups estimates the number of rows produced by aggregating rows input rows
+// by the dimensions and the time interval of the schema.
+func (e *PlanCostEstimator) EstimateGroups(schema hybridqp.Catalog, rows float64) (float64, bool) {
+	if schema == nil {
+		return rows, false
+	}
+	opt := schema.Options()
+	groups, ok := 1.0, true
+	for _, dim := range opt.GetDimensions() {
+		ndv := e.tagNDV(schema, dim)
+		if ndv == 0 {
+			ok = false
+			ndv = defaultNDV
+		}
+		groups *= float64(ndv)
+	}
+	if opt.HasInterval() && opt.GetInterval() > 0 {
+		start, end := opt.GetStartTime(), opt.GetEndTime()
+		if start > influxql.MinTime && end < influxql.MaxTime && end > start {
+			groups *= math.Ceil(float64(end-start) / float64(opt.GetInterval()))
+		} else {
+			ok = false
+		}
+	}
+	return math.Min(groups, math.Max(rows, 1)), ok
+}
+
+func (e *PlanCostEstimator) tagNDV(schema hybridqp.Catalog, tag string) int64 {
+	var ndv int64
+	for _, src := range schema.Sources() {
+		m, ok := src.(*influxql.Measurement)
+		if !ok {
+			return 0
+		}
+		n := e.catalog.GetByMeasurement(m).TagCardinality(tag)
+		if n == 0 {
+			return 0
+		}
+		ndv += n
+	}
+	return ndv
+}
+
+// Selectivity estimates the fraction of the rows of a measurement satisfying cond.
+func (e *PlanCostEstimator) Selectivity(cond influxql.Expr, stats *MeasurementStatistics) float64 {
+	switch expr := cond.(type) {
+	case nil:
+		return 1
+	case *influxql.ParenExpr:
+		return e.Selectivity(expr.Expr, stats)
+	case *influxql.BinaryExpr:
+		switch expr.Op {
+		case influxql.AND:
+			return e.Selectivity(expr.LHS, stats) * e.Selectivity(expr.RHS, stats)
+		case influxql.OR:
+			return math.Min(1, e.Selectivity(expr.LHS, stats)+e.Selectivity(expr.RHS, stats))
+		case influxql.EQ:
+			if ndv := conditionTagNDV(expr, stats); ndv > 0 {
+				return 1 / float64(ndv)
+			}
+			return selectivityEQ
+		case influxql.NEQ:
+			if ndv := conditionTagNDV(expr, stats); ndv > 0 {
+				return 1 - 1/float64(ndv)
+			}
+			return selectivityNEQ
+		case influxql.LT, influxql.LTE, influxql.GT, influxql.GTE:
+			return e.rangeSelectivity(expr, stats)
+		case influxql.EQREGEX, influxql.NEQREGEX:
+			return selectivityLike
+		}
+	}
+	return 1
+}
+
+func conditionTagNDV(expr *influxql.BinaryExpr, stats *MeasurementStatistics) int64 {
+	ref, ok := expr.LHS.(*influxql.VarRef)
+	if !ok {
+		ref, ok = expr.RHS.(*influxql.VarRef)
+	}
+	if !ok {
+		return 0
+	}
+	return stats.TagCardinality(ref.Val)
+}
+
+// rangeSelectivity uses the min/max of the field to estimate the selectivity of `field <op> number`.
+func (e *PlanCostEstimator) rangeSelectivity(expr *influxql.BinaryExpr, stats *MeasurementStatistics) float64 {
+	ref, ok := expr.LHS.(*influxql.VarRef)
+	if !ok {
+		return selectivityCmp
+	}
+	var val float64
+	switch lit := expr.RHS.(type) {
+	case *influxql.NumberLiteral:
+		val = lit.Val
+	case *influxql.IntegerLiteral:
+		val = float64(lit.Val)
+	default:
+		return selectivityCmp
+	}
+	fs := stats.Field(ref.Val)
+	if fs == nil || fs.Max <= fs.Min {
+		return selectivityCmp
+	}
+	below := (val - fs.Min) / (fs.Max - fs.Min)
+	below = math.Max(0, math.Min(1, below))
+	if expr.Op == influxql.GT || expr.Op == influxql.GTE {
+		return 1 - below
+	}
+	return below
+}
+
+// PreferHashAgg compares aggregating the ordered outputs of the partitions below the first aggregate
+// of plan by sort merge with aggregating them by hash. Hash aggregation avoids the merge of many partitions
+// but pays for every group kept in memory.
+func (e *PlanCostEstimator) PreferHashAgg(plan hybridqp.QueryNode) bool {
+	agg := findChildAggNode(plan)
+	if agg == nil || len(agg.Children()) != 1 {
+		return false
+	}
+	in := e.Estimate(agg.Children()[0])
+	groups, ok := e.EstimateGroups(agg.Schema(), in.Rows)
+	if !in.Estimated || !ok {
+		return false
+	}
+
+	ways := 1.0
+	if exchange, ok := agg.Children()[0].(*LogicalExchange); ok {
+		ways = math.Max(ways, float64(len(exchange.eTraits)))
+	}
+	sortCost := in.Rows * (costMergeRow*math.Max(1, math.Log2(ways)) + costAggRow)
+	hashCost := in.Rows*costHashAggRow + groups*costHashGroup
+	return hashCost < sortCost
+}
+
+// PreferAggPushDown returns true if evaluating the aggregate of schema on the stores reduces
+// the rows sent to the sql node enough to be worth it.
+func (e *PlanCostEstimator) PreferAggPushDown(schema hybridqp.Catalog) (bool, bool) {
+	rows, ok := e.ScanRows(schema)
+	if !ok {
+		return false, false
+	}
+	groups, ok := e.EstimateGroups(schema, rows)
+	if !ok {
+		return false, false
+	}
+	// the aggregate is evaluated on the stores and merged on the sql node, so the store side
+	// must at least halve the rows to pay for the additional merge.
+	return groups*2 < rows, true
+}
+
+// IsSlidingWindowPushUp returns true if the sliding window of schema is evaluated on the sql node
+// instead of being pushed down to the stores. In the cost based mode the choice is made once per
+// schema, so that all the rules of the planner see the same decision.
+func IsSlidingWindowPushUp(schema hybridqp.Catalog) bool {
+	switch sysconfig.GetEnableSlidingWindowPushUp() {
+	case sysconfig.OnSlidingWindowPushUp:
+		return true
+	case sysconfig.CostSlidingWindowPushUp:
+	default:
+		return false
+	}
+
+	s, ok := schema.(*QuerySchema)
+	if !ok {
+		return true
+	}
+	if s.slidingWindowPushUp == nil {
+		pushDown, known := NewPlanCostEstimator(GetStatisticsCatalog()).PreferAggPushDown(schema)
+		pushUp := !known || !pushDown
+		s.slidingWindowPushUp = &pushUp
+	}
+	return *s.slidingWindowPushUp
+}
+
+// DecideAggPushUp makes the aggregates of schema evaluated by the sql node only if evaluating them on the
+// stores first does not reduce the rows enough, as the sliding window in the cost based mode. The choice
+// is kept in the options sent to the stores, so they build the same plan.
+func DecideAggPushUp(schema *QuerySchema) {
+	opt, ok := schema.Options().(*query.ProcessorOptions)
+	if !ok || opt.AggPushUp || !schema.HasCall() || schema.HasSlidingWindowCall() || !schema.CanAggPushDown() ||
+		schema.MatchPreAgg() || opt.IsPromQuery() || opt.IsIncQuery() || schema.Sources().IsSubQuery() {
+		return
+	}
+	for _, call := range schema.Calls() {
+		if _, ok := mergeableCalls[call.Name]; !ok {
+			return
+		}
+	}
+	pushDown, known := NewPlanCostEstimator(GetStatisticsCatalog()).PreferAggPushDown(schema)
+	opt.AggPushUp = known && !pushDown
+}
+
+// EstimateStatementRows estimates the number of rows returned by stmt, such as the subquery of a semi join.
+// It returns false if the statement does not only read measurements with known statistics.
+func (e *PlanCostEstimator) EstimateStatementRows(stmt *influxql.SelectStatement) (float64, bool) {
+	cond, timeRange, err := influxql.ConditionExpr(stmt.Condition, &influxql.NowValuer{Now: time.Now()})
+	if err != nil || len(stmt.Sources) == 0 {
+		return 0, false
+	}
+
+	var rows float64
+	var ndv = make(map[string]int64)
+	for _, src := range stmt.Sources {
+		m, ok := src.(*influxql.Measurement)
+		if !ok {
+			return 0, false
+		}
+		stats := e.catalog.GetByMeasurement(m)
+		if stats == nil {
+			return 0, false
+		}
+		rows += float64(stats.EstimateRows()) * e.Selectivity(cond, stats)
+		for _, dim := range stmt.Dimensions {
+			if ref, ok := dim.Expr.(*influxql.VarRef); ok {
+				ndv[ref.Val] += stats.TagCardinality(ref.Val)
+			}
+		}
+	}
+	if stmt.IsRawQuery {
+		return rows, true
+	}
+
+	groups := 1.0
+	for _, n := range ndv {
+		if n == 0 {
+			return rows, false
+		}
+		groups *= float64(n)
+	}
+	interval, err := stmt.GroupByInterval()
+	if err == nil && interval > 0 {
+		if timeRange.Min.IsZero() || timeRange.Max.IsZero() {
+			return rows, false
+		}
+		groups *= math.Ceil(float64(timeRange.Max.Sub(timeRange.Min)) / float64(interval))
+	}
+	return math.Min(groups, math.Max(rows, 1)), true
+}
+
+// JoinBuildSide returns the input of the join estimated to return fewer rows. The join reads ahead the
+// rows of this input, which are spilled over the memory budget of the query, and streams the rows of the
+// other one, so that the larger input is never buffered. It returns -1 if the rows of the inputs are
+// unknown or close to each other, in which case both inputs are read ahead.
+func (e *PlanCostEstimator) JoinBuildSide(join hybridqp.QueryNode) int {
+	children := join.Children()
+	if len(children) != 2 {
+		return -1
+	}
+	left, right := e.Estimate(children[0]), e.Estimate(children[1])
+	if !left.Estimated || !right.Estimated {
+		return -1
+	}
+	switch {
+	case left.Rows*joinBuildSideRatio <= right.Rows:
+		return 0
+	case right.Rows*joinBuildSideRatio <= left.Rows:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// DecideJoinBuildSide chooses the build side of the full joins of plan by cost.
+func DecideJoinBuildSide(plan hybridqp.QueryNode) {
+	if plan == nil {
+		return
+	}
+	var estimator *PlanCostEstimator
+	var walk func(node hybridqp.QueryNode)
+	walk = func(node hybridqp.QueryNode) {
+		if join, ok := node.(*LogicalFullJoin); ok {
+			if estimator == nil {
+				estimator = NewPlanCostEstimator(GetStatisticsCatalog())
+			}
+			join.SetBuildSide(estimator.JoinBuildSide(join))
+		}
+		for _, child := range node.Children() {
+			walk(child)
+		}
+	}
+	walk(plan)
+}
+
+// PreferRestrictSemiJoin returns true if the subquery of a semi join, returning innerRows rows, is better
+// restricted to the outerNDV values of the column in the outer measurement before it is executed.
+func PreferRestrictSemiJoin(outerNDV int64, innerRows float64) bool {
+	return outerNDV > 0 && float64(outerNDV)*semiJoinRestrictRatio < innerRows
+}
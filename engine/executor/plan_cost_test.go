@@ -0,0 +1,293 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/sysconfig"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCostTestStatistics() *executor.StatisticsCatalog {
+	catalog := executor.NewStatisticsCatalog(executor.DefaultStatisticsTTL)
+	stats := executor.NewMeasurementStatistics()
+	stats.SeriesN = 100
+	stats.TagNDV["host"] = 10
+	stats.TagNDV["region"] = 2
+	stats.Fields["value"] = &executor.FieldStatistics{Min: 0, Max: 100}
+	catalog.Set("db0", "rp0", "cpu", stats)
+	return catalog
+}
+
+func newCostTestSchema(mst string, condition influxql.Expr) *executor.QuerySchema {
+	fields := influxql.Fields{
+		&influxql.Field{
+			Expr: &influxql.Call{
+				Name: "count",
+				Args: []influxql.Expr{&influxql.VarRef{Val: "value", Type: influxql.Float}},
+			},
+		},
+	}
+	sources := influxql.Sources{&influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: mst}}
+	opt := query.ProcessorOptions{
+		Dimensions: []string{"host"},
+		Condition:  condition,
+		Sources:    sources,
+	}
+	return executor.NewQuerySchemaWithSources(fields, sources, []string{"count"}, &opt, nil)
+}
+
+func buildCostTestPlan(t *testing.T, schema *executor.QuerySchema, eTraits []hybridqp.Trait) hybridqp.QueryNode {
+	builder := executor.NewLogicalPlanBuilderImpl(schema)
+	builder.Series()
+	builder.Exchange(executor.SERIES_EXCHANGE, nil)
+	builder.Reader(config.TSSTORE)
+	builder.Exchange(executor.NODE_EXCHANGE, eTraits)
+	builder.Aggregate()
+	plan, err := builder.Build()
+	require.NoError(t, err)
+	return plan
+}
+
+func TestPlanCostEstimator(t *testing.T) {
+	catalog := newCostTestStatistics()
+	cond := &influxql.BinaryExpr{Op: influxql.EQ, LHS: &influxql.VarRef{Val: "region", Type: influxql.Tag}, RHS: &influxql.StringLiteral{Val: "r1"}}
+	plan := buildCostTestPlan(t, newCostTestSchema("cpu", cond), nil)
+
+	estimator := executor.NewPlanCostEstimator(catalog)
+	cost := estimator.Estimate(plan)
+	assert.True(t, cost.Estimated)
+	assert.Equal(t, float64(10), cost.Rows)
+
+	scan := estimator.Estimate(plan.Children()[0])
+	assert.Equal(t, float64(100*executor.DefaultRowsPerSeries/2), scan.Rows)
+	assert.Greater(t, cost.Cost, scan.Cost)
+
+	writer := executor.NewLogicalPlanWriterImpl(&strings.Builder{})
+	writer.Estimator = estimator
+	plan.(executor.LogicalPlan).Explain(writer)
+	assert.Contains(t, writer.String(), "estimate=[rows=10 cost=")
+
+	// unknown statistics
+	plan = buildCostTestPlan(t, newCostTestSchema("mem", nil), nil)
+	cost = executor.NewPlanCostEstimator(catalog).Estimate(plan)
+	assert.False(t, cost.Estimated)
+	assert.Equal(t, "unknown", cost.String())
+}
+
+func TestPlanCostSelectivity(t *testing.T) {
+	catalog := newCostTestStatistics()
+	stats := catalog.Get("db0", "rp0", "cpu")
+	estimator := executor.NewPlanCostEstimator(catalog)
+
+	cond, err := influxql.ParseExpr("value > 75 AND host != 'h1'")
+	require.NoError(t, err)
+	assert.InDelta(t, 0.25*0.9, estimator.Selectivity(cond, stats), 1e-9)
+
+	cond, err = influxql.ParseExpr("host = 'h1' OR host = 'h2'")
+	require.NoError(t, err)
+	assert.InDelta(t, 0.2, estimator.Selectivity(cond, stats), 1e-9)
+
+	cond, err = influxql.ParseExpr("other < 3")
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0/3, estimator.Selectivity(cond, stats), 1e-9)
+}
+
+func TestPlanCostPreferHashAgg(t *testing.T) {
+	catalog := newCostTestStatistics()
+
+	plan := buildCostTestPlan(t, newCostTestSchema("cpu", nil), make([]hybridqp.Trait, 8))
+	assert.True(t, executor.NewPlanCostEstimator(catalog).PreferHashAgg(plan))
+
+	plan = buildCostTestPlan(t, newCostTestSchema("cpu", nil), nil)
+	assert.False(t, executor.NewPlanCostEstimator(catalog).PreferHashAgg(plan))
+
+	plan = buildCostTestPlan(t, newCostTestSchema("mem", nil), make([]hybridqp.Trait, 8))
+	assert.False(t, executor.NewPlanCostEstimator(catalog).PreferHashAgg(plan))
+}
+
+func TestIsSlidingWindowPushUp(t *testing.T) {
+	origin := sysconfig.GetEnableSlidingWindowPushUp()
+	defer sysconfig.SetEnableSlidingWindowPushUp(origin)
+
+	catalog := executor.GetStatisticsCatalog()
+	defer catalog.Reset()
+	catalog.Set("db0", "rp0", "cpu", newCostTestStatistics().Get("db0", "rp0", "cpu"))
+
+	sysconfig.SetEnableSlidingWindowPushUp(sysconfig.OnSlidingWindowPushUp)
+	assert.True(t, executor.IsSlidingWindowPushUp(newCostTestSchema("cpu", nil)))
+
+	sysconfig.SetEnableSlidingWindowPushUp(1 - sysconfig.OnSlidingWindowPushUp)
+	assert.False(t, executor.IsSlidingWindowPushUp(newCostTestSchema("cpu", nil)))
+
+	sysconfig.SetEnableSlidingWindowPushUp(sysconfig.CostSlidingWindowPushUp)
+	schema := newCostTestSchema("cpu", nil)
+	assert.False(t, executor.IsSlidingWindowPushUp(schema))
+	catalog.Reset()
+	// the choice is kept for the schema
+	assert.False(t, executor.IsSlidingWindowPushUp(schema))
+	assert.True(t, executor.IsSlidingWindowPushUp(newCostTestSchema("cpu", nil)))
+}
+
+func TestStatisticsCatalog(t *testing.T) {
+	catalog := executor.NewStatisticsCatalog(executor.DefaultStatisticsTTL)
+	assert.True(t, catalog.Expired("db0", "rp0", "cpu"))
+	assert.Nil(t, catalog.Get("db0", "rp0", "cpu"))
+
+	stats := executor.NewMeasurementStatistics()
+	stats.SeriesN = 5
+	stats.TagNDV["host"] = 10
+	catalog.Set("db0", "rp0", "cpu", stats)
+	assert.False(t, catalog.Expired("db0", "rp0", "cpu"))
+	assert.Equal(t, int64(5), catalog.GetByMeasurement(&influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: "cpu"}).TagCardinality("host"))
+	assert.Equal(t, int64(5*executor.DefaultRowsPerSeries), stats.EstimateRows())
+
+	assert.True(t, catalog.StartRefresh("db0", "rp0", "cpu"))
+	assert.False(t, catalog.StartRefresh("db0", "rp0", "cpu"))
+	catalog.FinishRefresh("db0", "rp0", "cpu")
+	assert.True(t, catalog.StartRefresh("db0", "rp0", "cpu"))
+
+	catalog.Delete("db0", "rp0", "cpu")
+	assert.True(t, catalog.Expired("db0", "rp0", "cpu"))
+
+	assert.False(t, catalog.Failed("db0", "rp0", "cpu"))
+	catalog.SetFailed("db0", "rp0", "cpu")
+	assert.True(t, catalog.Failed("db0", "rp0", "cpu"))
+	catalog.Set("db0", "rp0", "cpu", stats)
+	assert.False(t, catalog.Failed("db0", "rp0", "cpu"))
+}
+
+func TestDecideAggPushUp(t *testing.T) {
+	catalog := executor.GetStatisticsCatalog()
+	defer catalog.Reset()
+	stats := newCostTestStatistics().Get("db0", "rp0", "cpu")
+	catalog.Set("db0", "rp0", "cpu", stats)
+
+	cond, err := influxql.ParseExpr("value > 50")
+	require.NoError(t, err)
+
+	// the stores reduce 100 series to 10 groups
+	schema := newCostTestSchema("cpu", cond)
+	executor.DecideAggPushUp(schema)
+	assert.False(t, schema.Options().(*query.ProcessorOptions).AggPushUp)
+	assert.True(t, schema.CanCallsPushdown())
+
+	// almost one row per group
+	stats.RowN = 15
+	schema = newCostTestSchema("cpu", cond)
+	executor.DecideAggPushUp(schema)
+	assert.True(t, schema.Options().(*query.ProcessorOptions).AggPushUp)
+	assert.False(t, schema.CanCallsPushdown())
+
+	// unknown statistics
+	schema = newCostTestSchema("mem", cond)
+	executor.DecideAggPushUp(schema)
+	assert.False(t, schema.Options().(*query.ProcessorOptions).AggPushUp)
+}
+
+func buildCostTestScan(t *testing.T, mst string) hybridqp.QueryNode {
+	builder := executor.NewLogicalPlanBuilderImpl(newCostTestSchema(mst, nil))
+	builder.Series()
+	builder.Exchange(executor.SERIES_EXCHANGE, nil)
+	builder.Reader(config.TSSTORE)
+	builder.Exchange(executor.NODE_EXCHANGE, nil)
+	plan, err := builder.Build()
+	require.NoError(t, err)
+	return plan
+}
+
+func TestPlanCostJoinBuildSide(t *testing.T) {
+	catalog := executor.GetStatisticsCatalog()
+	defer catalog.Reset()
+	catalog.Set("db0", "rp0", "cpu", newCostTestStatistics().Get("db0", "rp0", "cpu"))
+	stats := executor.NewMeasurementStatistics()
+	stats.SeriesN = 10
+	catalog.Set("db0", "rp0", "mem", stats)
+
+	cpu, mem := buildCostTestScan(t, "cpu"), buildCostTestScan(t, "mem")
+	schema := newCostTestSchema("cpu", nil)
+	estimator := executor.NewPlanCostEstimator(catalog)
+	assert.Equal(t, 1, estimator.JoinBuildSide(executor.NewLogicalFullJoin(cpu, mem, nil, schema)))
+	assert.Equal(t, 0, estimator.JoinBuildSide(executor.NewLogicalFullJoin(mem, cpu, nil, schema)))
+	assert.Equal(t, -1, estimator.JoinBuildSide(executor.NewLogicalFullJoin(cpu, buildCostTestScan(t, "cpu"), nil, schema)))
+	// unknown statistics
+	assert.Equal(t, -1, estimator.JoinBuildSide(executor.NewLogicalFullJoin(cpu, buildCostTestScan(t, "disk"), nil, schema)))
+
+	join := executor.NewLogicalFullJoin(cpu, mem, nil, schema)
+	assert.Equal(t, -1, join.BuildSide())
+	executor.DecideJoinBuildSide(join)
+	assert.Equal(t, 1, join.BuildSide())
+	writer := executor.NewLogicalPlanWriterImpl(&strings.Builder{})
+	join.Explain(writer)
+	assert.Contains(t, writer.String(), "build=[right]")
+}
+
+func TestEstimateStatementRows(t *testing.T) {
+	catalog := newCostTestStatistics()
+	catalog.Get("db0", "rp0", "cpu").RowN = 1000
+	estimator := executor.NewPlanCostEstimator(catalog)
+
+	parse := func(sql string) *influxql.SelectStatement {
+		stmt, err := influxql.ParseStatement(sql)
+		require.NoError(t, err)
+		return stmt.(*influxql.SelectStatement)
+	}
+
+	rows, ok := estimator.EstimateStatementRows(parse("SELECT value FROM db0.rp0.cpu WHERE value > 75"))
+	assert.True(t, ok)
+	assert.InDelta(t, 250, rows, 1e-9)
+
+	rows, ok = estimator.EstimateStatementRows(parse("SELECT max(value) FROM db0.rp0.cpu GROUP BY host"))
+	assert.True(t, ok)
+	assert.InDelta(t, 10, rows, 1e-9)
+
+	rows, ok = estimator.EstimateStatementRows(parse("SELECT max(value) FROM db0.rp0.cpu WHERE time >= 0 AND time < 10m GROUP BY host, time(1m)"))
+	assert.True(t, ok)
+	assert.InDelta(t, 100, rows, 1e-9)
+
+	_, ok = estimator.EstimateStatementRows(parse("SELECT max(value) FROM db0.rp0.mem GROUP BY host"))
+	assert.False(t, ok)
+
+	assert.True(t, executor.PreferRestrictSemiJoin(10, rows))
+	assert.False(t, executor.PreferRestrictSemiJoin(10, 40))
+	assert.False(t, executor.PreferRestrictSemiJoin(0, rows))
+}
+
+func TestReplaceNodeAggWithSortedHashAgg(t *testing.T) {
+	schema := newCostTestSchema("cpu", nil)
+	assert.True(t, executor.CanSortedHashAgg(schema))
+
+	plan := buildCostTestPlan(t, schema, make([]hybridqp.Trait, 8))
+	exchange := plan.Children()[0]
+	hashAgg, ok := executor.ReplaceNodeAggWithSortedHashAgg(plan).(*executor.LogicalHashAgg)
+	require.True(t, ok)
+	assert.Equal(t, exchange.Children(), hashAgg.Children())
+	assert.Equal(t, plan.RowDataType(), hashAgg.RowDataType())
+
+	// the previous fill is not supported by the hash aggregate
+	schema = newCostTestSchema("cpu", nil)
+	schema.Options().(*query.ProcessorOptions).Fill = influxql.PreviousFill
+	assert.False(t, executor.CanSortedHashAgg(schema))
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+const (
+	DefaultStatisticsTTL = 10 * time.Minute
+	// the statistics of a measurement failed to be collected are not collected again in this period
+	DefaultStatisticsRetryInterval = time.Minute
+)
+
+// FieldStatistics describes the value range of a numeric field.
+type FieldStatistics struct {
+	Min float64
+	Max float64
+}
+
+// MeasurementStatistics is the planner's view of a measurement.
+// A zero value means the statistic is unknown.
+type MeasurementStatistics struct {
+	SeriesN  int64
+	RowN     int64
+	TagNDV   map[string]int64
+	Fields   map[string]*FieldStatistics
+	UpdateAt time.Time
+}
+
+func NewMeasurementStatistics() *MeasurementStatistics {
+	return &MeasurementStatistics{
+		TagNDV: make(map[string]int64),
+		Fields: make(map[string]*FieldStatistics),
+	}
+}
+
+// TagCardinality returns the number of distinct values of the tag, or 0 if unknown.
+func (s *MeasurementStatistics) TagCardinality(tag string) int64 {
+	if s == nil {
+		return 0
+	}
+	ndv := s.TagNDV[tag]
+	if s.SeriesN > 0 && ndv > s.SeriesN {
+		return s.SeriesN
+	}
+	return ndv
+}
+
+// Field returns the statistics of the field, or nil if unknown.
+func (s *MeasurementStatistics) Field(name string) *FieldStatistics {
+	if s == nil {
+		return nil
+	}
+	return s.Fields[name]
+}
+
+// EstimateRows returns the estimated number of rows stored in the measurement.
+func (s *MeasurementStatistics) EstimateRows() int64 {
+	if s == nil {
+		return 0
+	}
+	if s.RowN > 0 {
+		return s.RowN
+	}
+	return s.SeriesN * DefaultRowsPerSeries
+}
+
+// StatisticsCatalog caches the statistics of measurements used by the cost based planner.
+// Statistics are collected by the sql node from the tsi cardinality and the metadata of the
+// files of the stores and expire after ttl, so the planner never blocks on a store request.
+type StatisticsCatalog struct {
+	mu         sync.RWMutex
+	ttl        time.Duration
+	retry      time.Duration
+	stats      map[string]*MeasurementStatistics
+	refreshing map[string]struct{}
+	failed     map[string]time.Time
+}
+
+func NewStatisticsCatalog(ttl time.Duration) *StatisticsCatalog {
+	return &StatisticsCatalog{
+		ttl:        ttl,
+		retry:      DefaultStatisticsRetryInterval,
+		stats:      make(map[string]*MeasurementStatistics),
+		refreshing: make(map[string]struct{}),
+		failed:     make(map[string]time.Time),
+	}
+}
+
+var statisticsCatalog = NewStatisticsCatalog(DefaultStatisticsTTL)
+
+func GetStatisticsCatalog() *StatisticsCatalog {
+	return statisticsCatalog
+}
+
+func statisticsKey(db, rp, mst string) string {
+	return db + "." + rp + "." + mst
+}
+
+func (c *StatisticsCatalog) Get(db, rp, mst string) *MeasurementStatistics {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.stats[statisticsKey(db, rp, mst)]
+}
+
+// GetByMeasurement returns the statistics of the source measurement, or nil if unknown.
+func (c *StatisticsCatalog) GetByMeasurement(m *influxql.Measurement) *MeasurementStatistics {
+	if m == nil || m.Regex != nil {
+		return nil
+	}
+	return c.Get(m.Database, m.RetentionPolicy, m.Name)
+}
+
+func (c *StatisticsCatalog) Set(db, rp, mst string, stats *MeasurementStatistics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if stats.UpdateAt.IsZero() {
+		stats.UpdateAt = time.Now()
+	}
+	c.stats[statisticsKey(db, rp, mst)] = stats
+	delete(c.failed, statisticsKey(db, rp, mst))
+}
+
+// SetFailed records that the statistics of the measurement failed to be collected,
+// so that they are not requested from the stores again by every query.
+func (c *StatisticsCatalog) SetFailed(db, rp, mst string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failed[statisticsKey(db, rp, mst)] = time.Now()
+}
+
+func (c *StatisticsCatalog) Delete(db, rp, mst string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.stats, statisticsKey(db, rp, mst))
+}
+
+func (c *StatisticsCatalog) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stats = make(map[string]*MeasurementStatistics)
+	c.refreshing = make(map[string]struct{})
+	c.failed = make(map[string]time.Time)
+}
+
+// Expired returns true if the statistics of the measurement are missing or older than ttl.
+func (c *StatisticsCatalog) Expired(db, rp, mst string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, ok := c.stats[statisticsKey(db, rp, mst)]
+	return !ok || time.Since(s.UpdateAt) > c.ttl
+}
+
+// Failed returns true if the last collection of the statistics of the measurement failed
+// less than the retry interval ago.
+func (c *StatisticsCatalog) Failed(db, rp, mst string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	at, ok := c.failed[statisticsKey(db, rp, mst)]
+	return ok && time.Since(at) < c.retry
+}
+
+// StartRefresh marks the statistics of the measurement as being collected.
+// It returns false if another collection is already in progress.
+func (c *StatisticsCatalog) StartRefresh(db, rp, mst string) bool {
+	key := statisticsKey(db, rp, mst)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.refreshing[key]; ok {
+		return false
+	}
+	c.refreshing[key] = struct{}{}
+	return true
+}
+
+func (c *StatisticsCatalog) FinishRefresh(db, rp, mst string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.refreshing, statisticsKey(db, rp, mst))
+}
@@ -74,7 +74,8 @@ func TestProcessorCodec(t *testing.T) {
 		MaxParallel: 0,
 		QueryId:     0,
 
-		HintType: hybridqp.ExactStatisticQuery,
+		HintType:  hybridqp.ExactStatisticQuery,
+		AggPushUp: true,
 	}
 	reg, err := regexp.Compile("/table_*/")
 	if err != nil {
@@ -129,6 +130,10 @@ func TestProcessorCodec(t *testing.T) {
 		t.Fatalf("failed to marshal ChunkSize. exp: %d; got: %d", opt.ChunkSize, other.ChunkSize)
 	}
 
+	if opt.AggPushUp != other.AggPushUp {
+		t.Fatalf("failed to marshal AggPushUp. exp: %v; got: %v", opt.AggPushUp, other.AggPushUp)
+	}
+
 	if opt.HintType != other.HintType {
 		t.Fatalf("failed to marshal HintType. exp: %d; got: %d", opt.HintType, other.HintType)
 	}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/engine/op"
 	"github.com/openGemini/openGemini/lib/syscontrol"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
@@ -681,6 +682,75 @@ func TestUDFCastor(t *testing.T) {
 	}
 }
 
+func TestUDFAnomaly(t *testing.T) {
+	ddl := func(c *Catalog) error {
+		db, err := c.CreateDatabase("db0", "rp0")
+		if err != nil {
+			return err
+		}
+		mst0 := NewTable("mst0")
+		dataTypes := make(map[string]influxql.DataType)
+		dataTypes["t"] = influxql.Tag
+		dataTypes["v_int"] = influxql.Integer
+		mst0.AddDataTypes(dataTypes)
+		db.AddTable(mst0)
+		return nil
+	}
+	dml := func(s *Storage) error {
+		rdt := hybridqp.NewRowDataTypeImpl(
+			influxql.VarRef{Val: "t", Type: influxql.String},
+			influxql.VarRef{Val: "v_int", Type: influxql.Integer})
+
+		builder := executor.NewChunkBuilder(rdt)
+		chunk1 := builder.NewChunk("mst0")
+		chunk1.AppendTimes([]int64{1, 2, 3, 4, 5, 6, 7})
+		chunk1.Column(0).AppendStringValues([]string{"a", "a", "a", "a", "a", "a", "a"})
+		chunk1.Column(0).AppendManyNotNil(7)
+		chunk1.Column(1).AppendIntegerValues([]int64{10, 11, 9, 10, 100, 10, 11})
+		chunk1.Column(1).AppendManyNotNil(7)
+		pts1 := influx.PointTags{influx.Tag{Key: "t", Value: "a"}}
+		s.Write("db0.rp0.mst0", &pts1, chunk1)
+		return nil
+	}
+	for _, tc := range []struct {
+		name      string
+		sql       string
+		validator func([]executor.Chunk)
+	}{
+		{
+			name: "select mad_anomaly(field) from mst",
+			sql:  "SELECT mad_anomaly(v_int) as v_int from db0.rp0.mst0 group by t",
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, 1, len(results))
+				assert.Equal(t, []int64{5}, results[0].Time())
+				assert.Greater(t, results[0].Columns()[0].FloatValues()[0], op.DefaultMadThreshold)
+			},
+		},
+		{
+			name: "select arima_forecast(field, n) from mst",
+			sql:  "SELECT arima_forecast(v_int, 2) as v_int from db0.rp0.mst0 group by t",
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, 1, len(results))
+				assert.Equal(t, []int64{8, 9}, results[0].Time())
+				assert.Equal(t, 2, len(results[0].Columns()[0].FloatValues()))
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tsdb := NewTSDBSystem()
+			if err := tsdb.DDL(ddl); err != nil {
+				t.Error(err)
+			}
+			if err := tsdb.DML(dml); err != nil {
+				t.Error(err)
+			}
+			if err := tsdb.ExecSQL(tc.sql, tc.validator, nil, false); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func TestMockTSDBSystemWhenExceedSchema(t *testing.T) {
 	syscontrol.SetQuerySchemaLimit(2)
 	defer syscontrol.SetQuerySchemaLimit(0)
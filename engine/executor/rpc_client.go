@@ -29,7 +29,11 @@ import (
 	index2 "github.com/openGemini/openGemini/lib/index"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/machine"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -123,11 +127,18 @@ func (c *RPCClient) GetCodec() transport.Codec {
 	return msg
 }
 
-func (c *RPCClient) Run() error {
+func (c *RPCClient) Run() (err error) {
 	if c.isAborted() {
 		return nil
 	}
-	err := c.sendRequest()
+	traceCtx, otelSpan := tracing.StartOTelSpan(c.ctx, "spdy select", trace.SpanKindClient,
+		attribute.Int64("node.id", int64(c.query.NodeID)), attribute.Int("pt.id", int(c.query.PtID)),
+		attribute.Int("shard.count", len(c.query.ShardIDs)))
+	defer func() {
+		tracing.EndOTelSpan(otelSpan, err)
+	}()
+
+	err = c.sendRequest(traceCtx)
 	if err != nil || c.trans == nil {
 		return err
 	}
@@ -140,7 +151,7 @@ func (c *RPCClient) Run() error {
 	return c.trans.Wait()
 }
 
-func (c *RPCClient) sendRequest() error {
+func (c *RPCClient) sendRequest(traceCtx context.Context) error {
 	c.trans = nil
 
 	begin := time.Now()
@@ -154,6 +165,7 @@ func (c *RPCClient) sendRequest() error {
 	}
 
 	c.trans = trans
+	trans.SetTraceContext(traceCtx)
 	if c.span != nil {
 		trans.StartAnalyze(c.span)
 		c.span.AddStringField("remote_addr", trans.Requester().Session().Connection().RemoteAddr().String())
@@ -268,11 +280,16 @@ func (c *RPCClient) errorMessage(data interface{}) error {
 }
 
 func (c *RPCClient) emptyMessage(data interface{}) error {
+	message, ok := data.(*Finish)
+	if !ok {
+		return nil
+	}
 	queryIndexState := c.ctx.Value(index2.QueryIndexState)
 	if queryIndexState != nil {
-		if message, ok := data.(*Finish); ok {
-			atomic.AddInt32(queryIndexState.(*int32), message.queryIndexState)
-		}
+		atomic.AddInt32(queryIndexState.(*int32), message.queryIndexState)
+	}
+	if qStat, _ := c.ctx.Value(query.SQLQueryStatKey).(*statistics.SQLSlowQueryStatistics); qStat != nil {
+		qStat.AddScan(message.scanSeries, message.scanRows, message.scanBytes)
 	}
 	return nil
 }
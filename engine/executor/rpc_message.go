@@ -17,12 +17,14 @@ package executor
 import (
 	"fmt"
 	"reflect"
+	"sync/atomic"
 
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
 	"github.com/openGemini/openGemini/engine/executor/spdy/rpc"
 	"github.com/openGemini/openGemini/engine/executor/spdy/transport"
 	"github.com/openGemini/openGemini/lib/codec"
 	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
@@ -85,14 +87,33 @@ func (e *Error) Instance() transport.Codec {
 
 type Finish struct {
 	queryIndexState int32
+
+	// the series, the rows and the bytes read by the query on the store
+	scanSeries int64
+	scanRows   int64
+	scanBytes  int64
 }
 
 func NewFinishMessage(queryIndexState int32) *rpc.Message {
 	return rpc.NewMessage(FinishMessage, &Finish{queryIndexState: queryIndexState})
 }
 
+// NewFinishMessageWithScan returns the finish message with the series, the rows and the bytes read by the query
+func NewFinishMessageWithScan(queryIndexState int32, stat *statistics.StoreSlowQueryStatistics) *rpc.Message {
+	msg := &Finish{queryIndexState: queryIndexState}
+	if stat != nil {
+		msg.scanSeries = atomic.LoadInt64(&stat.ScanSeries)
+		msg.scanRows = atomic.LoadInt64(&stat.ScanRows)
+		msg.scanBytes = atomic.LoadInt64(&stat.ScanBytes)
+	}
+	return rpc.NewMessage(FinishMessage, msg)
+}
+
 func (e *Finish) Marshal(buf []byte) ([]byte, error) {
 	buf = codec.AppendInt32(buf, e.queryIndexState)
+	buf = codec.AppendInt64(buf, e.scanSeries)
+	buf = codec.AppendInt64(buf, e.scanRows)
+	buf = codec.AppendInt64(buf, e.scanBytes)
 	return buf, nil
 }
 
@@ -102,11 +123,18 @@ func (e *Finish) Unmarshal(buf []byte) error {
 	}
 	dec := codec.NewBinaryDecoder(buf)
 	e.queryIndexState = dec.Int32()
+	// the stores of the old versions do not send the scan statistics
+	if len(buf) < e.Size() {
+		return nil
+	}
+	e.scanSeries = dec.Int64()
+	e.scanRows = dec.Int64()
+	e.scanBytes = dec.Int64()
 	return nil
 }
 
 func (e *Finish) Size() int {
-	return util.Int32SizeBytes
+	return util.Int32SizeBytes + 3*util.Int64SizeBytes
 }
 
 func (e *Finish) Instance() transport.Codec {
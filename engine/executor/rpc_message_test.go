@@ -29,8 +29,10 @@ import (
 	"github.com/openGemini/openGemini/engine/executor/spdy/transport"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/cache"
+	"github.com/openGemini/openGemini/lib/codec"
 	"github.com/openGemini/openGemini/lib/index"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
@@ -415,6 +417,33 @@ func TestHandlerIncQueryMessage(t *testing.T) {
 	assert.Equal(t, strings.Contains(err.Error(), "finish msg error"), true)
 }
 
+func TestFinishMessageWithScan(t *testing.T) {
+	storeStat := statistics.NewStoreSlowQueryStatistics()
+	storeStat.AddScan(3, 100, 4096)
+	msg := executor.NewFinishMessageWithScan(1, storeStat)
+	buf, err := msg.Data().(*executor.Finish).Marshal(nil)
+	require.NoError(t, err)
+	finish := &executor.Finish{}
+	require.NoError(t, finish.Unmarshal(buf))
+	require.Equal(t, msg.Data(), finish)
+
+	// the finish message of the stores of the old versions has no scan statistics
+	require.NoError(t, finish.Unmarshal(codec.AppendInt32(nil, 1)))
+
+	var queryIndexState int32 = 0
+	sqlStat := statistics.NewSqlSlowQueryStatistics("db0")
+	ctx := context.WithValue(context.Background(), index.QueryIndexState, &queryIndexState)
+	ctx = context.WithValue(ctx, query.SQLQueryStatKey, sqlStat)
+	client := executor.NewRPCClient(makeRemoteQueryMsg(1))
+	client.Init(ctx, nil)
+	require.NoError(t, client.Handle(msg))
+	require.NoError(t, client.Handle(executor.NewFinishMessageWithScan(0, nil)))
+	require.Equal(t, int32(1), queryIndexState)
+	require.Equal(t, int64(3), sqlStat.ScanSeries)
+	require.Equal(t, int64(100), sqlStat.ScanRows)
+	require.Equal(t, int64(4096), sqlStat.ScanBytes)
+}
+
 func TestIncQueryMessage(t *testing.T) {
 	var data []byte
 	var inc2 = &executor.IncQueryFinish{}
@@ -196,6 +196,9 @@ type QuerySchema struct {
 	hasFieldCondition bool
 	planType          PlanType
 	PromSubCalls      []*influxql.PromSubCall
+
+	// slidingWindowPushUp caches the cost based choice of IsSlidingWindowPushUp
+	slidingWindowPushUp *bool
 }
 
 func NewQuerySchema(fields influxql.Fields, columnNames []string, opt hybridqp.Options, sortFields influxql.SortFields) *QuerySchema {
@@ -346,28 +349,29 @@ func (qs *QuerySchema) rewriteBaseCallTransformExprCall(expr influxql.Expr) infl
 	case *influxql.ParenExpr:
 		return &influxql.ParenExpr{Expr: qs.rewriteBaseCallTransformExprCall(expr.Expr)}
 	case *influxql.Call:
-		if expr.Name == "mean" {
-			replacement := qs.meanToSumDivCount(expr)
-			typ, err := qs.deriveType(expr)
-			if err != nil {
-				panic(err.Error())
-			}
-			qs.mapDeriveType[replacement] = typ
-			return replacement
-		} else if expr.Name == "spread" {
-			replacement := qs.spreadToMaxSubMin(expr)
-			typ, err := qs.deriveType(expr)
-			if err != nil {
-				panic(err.Error())
-			}
-			qs.mapDeriveType[replacement] = typ
-			return replacement
-		} else {
+		var replacement influxql.Expr
+		switch expr.Name {
+		case "mean":
+			replacement = qs.meanToSumDivCount(expr)
+		case "spread":
+			replacement = qs.spreadToMaxSubMin(expr)
+		case "time_weighted_avg":
+			replacement = qs.timeWeightedAvgToAreaDivDuration(expr)
+		case "interpolate":
+			// the non-empty buckets hold the mean of their points, the FillTransform fills the empty ones
+			replacement = qs.meanToSumDivCount(expr)
+		default:
 			for i, arg := range expr.Args {
 				expr.Args[i] = qs.rewriteBaseCallTransformExprCall(arg)
 			}
+			return influxql.CloneExpr(expr)
 		}
-		return influxql.CloneExpr(expr)
+		typ, err := qs.deriveType(expr)
+		if err != nil {
+			panic(err.Error())
+		}
+		qs.mapDeriveType[replacement] = typ
+		return replacement
 	default:
 		return influxql.CloneExpr(expr)
 	}
@@ -387,6 +391,18 @@ func (qs *QuerySchema) meanToSumDivCount(call *influxql.Call) influxql.Expr {
 	return be
 }
 
+// timeWeightedAvgToAreaDivDuration splits time_weighted_avg into the weighted area and the weighted
+// duration of each window, both are summed up across the stores before the division.
+func (qs *QuerySchema) timeWeightedAvgToAreaDivDuration(call *influxql.Call) influxql.Expr {
+	lhs := &influxql.Call{Name: query.TimeWeightedArea, Args: nil}
+	rhs := &influxql.Call{Name: query.TimeWeightedDuration, Args: nil}
+	for _, arg := range call.Args {
+		lhs.Args = append(lhs.Args, influxql.CloneExpr(arg))
+		rhs.Args = append(rhs.Args, influxql.CloneExpr(arg))
+	}
+	return &influxql.BinaryExpr{Op: influxql.DIV, LHS: lhs, RHS: rhs}
+}
+
 func (qs *QuerySchema) spreadToMaxSubMin(call *influxql.Call) influxql.Expr {
 	lhs := &influxql.Call{Name: "max", Args: nil}
 	lhs.Args = append(lhs.Args, influxql.CloneExpr(call.Args[0]))
@@ -550,6 +566,17 @@ func (qs *QuerySchema) CountField() map[int]bool {
 	return fieldColIdx
 }
 
+// InterpolateField returns the interpolate() calls by the index of their column
+func (qs *QuerySchema) InterpolateField() map[int]*influxql.Call {
+	fieldColIdx := make(map[int]*influxql.Call)
+	for i, f := range qs.queryFields {
+		if c, ok := f.Expr.(*influxql.Call); ok && c.Name == "interpolate" {
+			fieldColIdx[i] = c
+		}
+	}
+	return fieldColIdx
+}
+
 func (qs *QuerySchema) HasMeanCall() bool {
 	for _, f := range qs.queryFields {
 		if c, ok := f.Expr.(*influxql.Call); ok && c.Name == "mean" {
@@ -926,9 +953,10 @@ func (qs *QuerySchema) CountDistinct() *influxql.Call {
 	return qs.countDistinct
 }
 
+// HasCastorCall returns true if the query calls castor() or a native function working on the whole series like it
 func (qs *QuerySchema) HasCastorCall() bool {
 	for _, v := range qs.calls {
-		if v.Name == "castor" {
+		if op.IsUDAFOp(v) {
 			return true
 		}
 	}
@@ -1265,6 +1293,9 @@ func (qs *QuerySchema) MatchPreAgg() bool {
 }
 
 func (qs *QuerySchema) CanCallsPushdown() bool {
+	if opt, ok := qs.opt.(*query.ProcessorOptions); ok && opt.AggPushUp {
+		return false
+	}
 	for _, call := range qs.calls {
 		if aggFunc := query.GetAggregateOperator(call.Name); aggFunc != nil && !aggFunc.CanPushDown() {
 			return false
@@ -186,6 +186,10 @@ func (p *preparedStatement) BuildLogicalPlan(ctx context.Context) (hybridqp.Quer
 	schema.SetPromCalls(p.stmt.PromSubCalls)
 
 	HaveOnlyCSStore := schema.Sources().HaveOnlyCSStore()
+	if !HaveOnlyCSStore {
+		// decided before matching the plan templates, which depend on whether the calls are pushed down
+		DecideAggPushUp(schema)
+	}
 	planType := GetPlanType(schema, p.stmt)
 	if planType != UNKNOWN {
 		if p != nil && !HaveOnlyCSStore {
@@ -223,7 +227,7 @@ func (p *preparedStatement) BuildLogicalPlan(ctx context.Context) (hybridqp.Quer
 	if HaveOnlyCSStore {
 		if schema.Options().IsUnifyPlan() {
 			if best.Schema().HasCall() {
-				if !best.Schema().CanSeqAggPushDown() {
+				if !best.Schema().CanSeqAggPushDown() || NewPlanCostEstimator(GetStatisticsCatalog()).PreferHashAgg(best) {
 					best = ReplaceSortAggMergeWithHashAgg(best)[0]
 					best = ReplaceSortMergeWithHashMerge(best)[0]
 				}
@@ -235,7 +239,10 @@ func (p *preparedStatement) BuildLogicalPlan(ctx context.Context) (hybridqp.Quer
 			RebuildAggNodes(best)
 			best = ReplaceSortAggWithHashAgg(best)[0]
 		}
+	} else if CanSortedHashAgg(best.Schema()) && NewPlanCostEstimator(GetStatisticsCatalog()).PreferHashAgg(best) {
+		best = ReplaceNodeAggWithSortedHashAgg(best)
 	}
+	DecideJoinBuildSide(best)
 
 	PrintPlan("optimized plan", best)
 	return best, mstsReqs, nil
@@ -249,6 +256,9 @@ func (p *preparedStatement) Select(ctx context.Context) (hybridqp.Executor, erro
 	if ok, err := p.isSchemaOverLimit(best); ok {
 		return nil, err
 	}
+	if qStat, _ := ctx.Value(query.QueryDurationKey).(*statistics.SQLSlowQueryStatistics); qStat != nil {
+		qStat.AddPlan(PlanSummary(best))
+	}
 	executorBuilder := p.creator()
 	span := tracing.SpanFromContext(ctx)
 	if span != nil {
@@ -292,7 +302,34 @@ func (p *preparedStatement) ChangeOptimizer(optimizer hybridqp.ExecutorBuilderOp
 }
 
 func (p *preparedStatement) Explain() (string, error) {
-	return "", nil
+	return p.explain(context.Background())
+}
+
+func (p *preparedStatement) explain(ctx context.Context) (string, error) {
+	best, _, err := p.BuildLogicalPlan(ctx)
+	if err != nil || best == nil {
+		return "", err
+	}
+	writer := NewLogicalPlanWriterImpl(&strings.Builder{})
+	writer.Estimator = NewPlanCostEstimator(GetStatisticsCatalog())
+	best.(LogicalPlan).Explain(writer)
+	return writer.String(), nil
+}
+
+// ExplainSelect builds the optimized logical plan of the statement without executing it,
+// and returns the plan with the estimated rows and cost of each node.
+func ExplainSelect(ctx context.Context, stmt *influxql.SelectStatement, shardMapper query.ShardMapper, opt query.SelectOptions) (string, error) {
+	s, err := query.Prepare(stmt, shardMapper, opt)
+	if err != nil {
+		return "", err
+	}
+	defer util.MustClose(s)
+
+	p, ok := s.(*preparedStatement)
+	if !ok {
+		return s.Explain()
+	}
+	return p.explain(ctx)
 }
 
 func (p *preparedStatement) Close() error {
@@ -561,7 +598,7 @@ func isBuildHashAgg(options hybridqp.Options) bool {
 
 func buildAggNode(builder *LogicalPlanBuilderImpl, schema hybridqp.Catalog, hasSlidingWindow bool) {
 	if hasSlidingWindow && (!schema.CanAggPushDown() ||
-		(schema.CanAggPushDown() && sysconfig.GetEnableSlidingWindowPushUp() == sysconfig.OnSlidingWindowPushUp) || schema.HasSubQuery()) {
+		(schema.CanAggPushDown() && IsSlidingWindowPushUp(schema)) || schema.HasSubQuery()) {
 		builder.SlidingWindow()
 	} else {
 		if !schema.Options().IsRangeVectorSelector() || schema.HasPromNestedCall() || schema.IsPromAbsentCall() {
@@ -817,6 +854,59 @@ func RebuildColumnStorePlan(plan hybridqp.QueryNode) []hybridqp.QueryNode {
 	return nodes
 }
 
+// CanSortedHashAgg returns true if the aggregate of the sql node over the tsstore nodes can be replaced
+// by a hash aggregate with a sorted output, see ReplaceNodeAggWithSortedHashAgg.
+func CanSortedHashAgg(schema hybridqp.Catalog) bool {
+	if schema == nil || !schema.HasCall() || len(schema.Sources()) != 1 {
+		return false
+	}
+	if m, ok := schema.Sources()[0].(*influxql.Measurement); !ok || m.Regex != nil {
+		return false
+	}
+	opt, ok := schema.Options().(*query.ProcessorOptions)
+	if !ok || len(opt.Dimensions) == 0 || opt.Without || opt.IsPromQuery() || opt.IsIncQuery() ||
+		opt.IsUnifyPlan() || (opt.Fill != influxql.NullFill && opt.Fill != influxql.NoFill) {
+		return false
+	}
+	for _, call := range schema.Calls() {
+		if _, ok := mergeableCalls[call.Name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ReplaceNodeAggWithSortedHashAgg replaces the aggregate of the sql node, which merges the ordered partial
+// results of the nodes, with a hash aggregate. The hash aggregate sorts its groups before the output,
+// so the series are still returned in the order of the sort merge, and leaves the fill to the fill node.
+func ReplaceNodeAggWithSortedHashAgg(plan hybridqp.QueryNode) hybridqp.QueryNode {
+	var parent hybridqp.QueryNode
+	node := plan
+	for node != nil {
+		if agg, ok := node.(*LogicalAggregate); ok && len(agg.Children()) == 1 {
+			exchange, ok := agg.Children()[0].(*LogicalExchange)
+			if !ok || exchange.EType() != NODE_EXCHANGE {
+				return plan
+			}
+			hashAgg := NewLogicalHashAgg(exchange, agg.Schema(), NODE_EXCHANGE, exchange.eTraits)
+			hashAgg.LogicalPlanBase = agg.LogicalPlanBase
+			hashAgg.SetInputs(exchange.Children())
+			hashAgg.hashAggType = Normal
+			hashAgg.sortedOutput = true
+			if parent == nil {
+				return hashAgg
+			}
+			parent.ReplaceChild(0, hashAgg)
+			return plan
+		}
+		if len(node.Children()) != 1 {
+			return plan
+		}
+		parent, node = node, node.Children()[0]
+	}
+	return plan
+}
+
 func findChildAggNode(plan hybridqp.QueryNode) hybridqp.QueryNode {
 	for plan != nil {
 		switch plan.(type) {
@@ -93,6 +93,11 @@ type SortTransform struct {
 	outputChunkPool        *CircularChunkPool
 	closedSignal           int32
 
+	memAccountant *MemoryAccountant
+	memUsed       int64
+	spillFiles    []*chunkSpillFile
+	spillChunk    Chunk
+
 	schema     *QuerySchema
 	opt        *query.ProcessorOptions
 	sortLogger *logger.Logger
@@ -311,6 +316,7 @@ func (trans *SortTransform) Work(ctx context.Context) error {
 		tracing.Finish(span, trans.span)
 	}()
 
+	trans.memAccountant = MemoryAccountantFromContext(ctx)
 	errs := &trans.errs
 	workerNum := sortWorkerNum
 	if workerNum != 1 {
@@ -369,69 +375,84 @@ func (trans *SortTransform) singleSortWorkerHelper(ctx context.Context, errs *er
 			// 3. sortLastPartition
 			trans.sort(i)
 			// 4. output sorted partitions
-			trans.sortWorkerOutput(i)
+			if err := trans.sortWorkerOutput(i); err != nil {
+				errs.Dispatch(err)
+				return
+			}
 			break
 		}
 		// 2.add bufChunk to partition
-		trans.addChunkToPartition(i)
+		if err := trans.addChunkToPartition(i); err != nil {
+			errs.Dispatch(err)
+			return
+		}
 	}
 }
 
 // todo: output to mergeWorker rather than outputTransform
-func (trans *SortTransform) sortWorkerOutput(i int) {
+func (trans *SortTransform) sortWorkerOutput(i int) error {
+	defer func() {
+		trans.closeSpillFiles()
+		trans.releaseMemory()
+	}()
+	if len(trans.spillFiles) > 0 {
+		return trans.mergeOutput(i)
+	}
 	for _, p := range trans.sortWorkerResult[i] {
-		trans.partitionOutput(p, i)
+		if err := trans.rowsOutput(&sortPartitionIterator{rows: p.rows}, trans.sortWorkerBufChunk[i].Name(), trans.outputChunkPool.GetChunk, trans.sendChunk); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
-func (trans *SortTransform) partitionOutput(p *sortPartition, i int) {
-	if len(p.rows) == 0 {
-		return
+// rowsOutput builds chunks of the sorted rows and passes them to send.
+func (trans *SortTransform) rowsOutput(it sortRowIterator, name string, getChunk func() Chunk, send func(Chunk) error) error {
+	row, err := it.Next()
+	if err != nil || row == nil {
+		return err
 	}
-	var chunk Chunk
-	chunk = trans.outputChunkPool.GetChunk()
-	chunk.SetName(trans.sortWorkerBufChunk[i].Name())
+	chunk := getChunk()
+	chunk.SetName(name)
 	preTagVals := make([]string, len(trans.dimension))
 	tmpTagVals := make([]string, len(trans.dimension))
-	for j := 0; j < len(trans.dimension); j++ {
-		preTagVals[j] = p.rows[0].sortEle[j].(*stringSortEle).val
-	}
-	chunk.AppendTagsAndIndex(*NewChunkTagsByTagKVs(trans.dimension, preTagVals), chunk.Len())
-	for _, row := range p.rows {
+	for ; row != nil; row, err = it.Next() {
 		for j := 0; j < len(trans.dimension); j++ {
 			tmpTagVals[j] = row.sortEle[j].(*stringSortEle).val
 		}
-		for j, tagsVals := range preTagVals {
-			if tagsVals != tmpTagVals[j] {
-				chunk.AppendTagsAndIndex(*NewChunkTagsByTagKVs(trans.dimension, tmpTagVals), chunk.Len())
-				preTagVals, tmpTagVals = tmpTagVals, preTagVals
-				break
-			}
+		// every chunk starts with the tags of its first row
+		newTags := chunk.TagLen() == 0
+		for j := 0; !newTags && j < len(preTagVals); j++ {
+			newTags = preTagVals[j] != tmpTagVals[j]
+		}
+		if newTags {
+			chunk.AppendTagsAndIndex(*NewChunkTagsByTagKVs(trans.dimension, tmpTagVals), chunk.Len())
+			preTagVals, tmpTagVals = tmpTagVals, preTagVals
 		}
 		row.AppendToChunk(chunk, len(trans.dimension))
 		if chunk.Len() >= trans.schema.GetOptions().ChunkSizeNum() {
-			if chunk.TagLen() == 0 {
-				chunk.AppendTagsAndIndex(*NewChunkTagsByTagKVs(trans.dimension, preTagVals), 0)
+			if err := send(chunk); err != nil {
+				return err
 			}
-			trans.sendChunk(chunk)
-			chunk = trans.outputChunkPool.GetChunk()
-			chunk.SetName(trans.sortWorkerBufChunk[i].Name())
+			chunk = getChunk()
+			chunk.SetName(name)
 		}
 	}
-	if chunk.TagLen() == 0 {
-		chunk.AppendTagsAndIndex(*NewChunkTagsByTagKVs(trans.dimension, preTagVals), 0)
+	if err != nil {
+		return err
 	}
-	trans.sendChunk(chunk)
+	return send(chunk)
 }
 
-func (trans *SortTransform) sendChunk(c Chunk) {
+func (trans *SortTransform) sendChunk(c Chunk) error {
 	if c.Len() > 0 {
 		trans.output.State <- c
 	}
+	return nil
 }
 
 // if oom then sort tmpPartition and spill it to disk, reset mem, partitionIdx++, add bufChunk to new partition
-func (trans *SortTransform) addChunkToPartition(i int) {
+func (trans *SortTransform) addChunkToPartition(i int) error {
 	tmpPartition := trans.sortWorkerResult[i][trans.sortWorkerPartitionIdx[i]]
 	bufRows := make([]*sortRowMsg, trans.sortWorkerBufChunk[i].Len())
 	for j, tags := range trans.sortWorkerBufChunk[i].Tags() {
@@ -448,7 +469,11 @@ func (trans *SortTransform) addChunkToPartition(i int) {
 		}
 	}
 	tmpPartition.AppendRows(bufRows)
+	if err := trans.growMemory(i, trans.sortWorkerBufChunk[i]); err != nil {
+		return err
+	}
 	trans.nextInputChunk <- signal
+	return nil
 }
 
 func (trans *SortTransform) newSortRow(chunk Chunk, startLoc int, tagVals []string) *sortRowMsg {
@@ -486,6 +511,8 @@ func (trans *SortTransform) GetInputNumber(_ Port) int {
 }
 
 func (trans *SortTransform) Release() error {
+	trans.closeSpillFiles()
+	trans.releaseMemory()
 	trans.sortWorkerBufChunk = trans.sortWorkerBufChunk[:0]
 	trans.sortWorkerPartitionIdx = trans.sortWorkerPartitionIdx[:0]
 	trans.sortWorkerResult = trans.sortWorkerResult[:0]
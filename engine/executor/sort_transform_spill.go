@@ -0,0 +1,234 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"container/heap"
+	"sort"
+
+	"go.uber.org/zap"
+)
+
+// sortEleMemSize is the estimated memory of one element of a sorted row.
+const sortEleMemSize = 32
+
+// sortRowIterator returns sorted rows one by one, and nil at the end.
+type sortRowIterator interface {
+	Next() (*sortRowMsg, error)
+}
+
+type sortPartitionIterator struct {
+	rows []*sortRowMsg
+	loc  int
+}
+
+func (it *sortPartitionIterator) Next() (*sortRowMsg, error) {
+	if it.loc >= len(it.rows) {
+		return nil, nil
+	}
+	it.loc++
+	return it.rows[it.loc-1], nil
+}
+
+// sortRunIterator reads a sorted run spilled to disk.
+type sortRunIterator struct {
+	trans   *SortTransform
+	file    *chunkSpillFile
+	chunk   Chunk
+	loc     int
+	tagLoc  int
+	tagVals []string
+}
+
+func (it *sortRunIterator) Next() (*sortRowMsg, error) {
+	for it.chunk == nil || it.loc >= it.chunk.Len() {
+		c, err := it.file.Read()
+		if err != nil || c == nil {
+			return nil, err
+		}
+		it.chunk, it.loc, it.tagLoc = c, 0, -1
+	}
+	for it.tagLoc+1 < it.chunk.TagLen() && it.chunk.TagIndex()[it.tagLoc+1] <= it.loc {
+		it.tagLoc++
+		it.tagVals = it.trans.dimensionValues(it.chunk.Tags()[it.tagLoc])
+	}
+	row := it.trans.newSortRow(it.chunk, it.loc, it.tagVals)
+	it.loc++
+	return row, nil
+}
+
+// sortMergeIterator merges sorted iterators.
+type sortMergeIterator struct {
+	trans *SortTransform
+	iters []sortRowIterator
+	heads []*sortRowMsg
+	items []int
+}
+
+func newSortMergeIterator(trans *SortTransform, iters []sortRowIterator) (*sortMergeIterator, error) {
+	it := &sortMergeIterator{
+		trans: trans,
+		iters: iters,
+		heads: make([]*sortRowMsg, len(iters)),
+	}
+	for i := range iters {
+		row, err := iters[i].Next()
+		if err != nil {
+			return nil, err
+		}
+		if row != nil {
+			it.heads[i] = row
+			it.items = append(it.items, i)
+		}
+	}
+	heap.Init(it)
+	return it, nil
+}
+
+func (it *sortMergeIterator) Len() int {
+	return len(it.items)
+}
+
+func (it *sortMergeIterator) Less(i, j int) bool {
+	return it.heads[it.items[i]].LessThan(it.heads[it.items[j]], it.trans.sortKeysIdxs, it.trans.ascending)
+}
+
+func (it *sortMergeIterator) Swap(i, j int) {
+	it.items[i], it.items[j] = it.items[j], it.items[i]
+}
+
+func (it *sortMergeIterator) Push(x interface{}) {
+	it.items = append(it.items, x.(int))
+}
+
+func (it *sortMergeIterator) Pop() interface{} {
+	x := it.items[len(it.items)-1]
+	it.items = it.items[:len(it.items)-1]
+	return x
+}
+
+func (it *sortMergeIterator) Next() (*sortRowMsg, error) {
+	if len(it.items) == 0 {
+		return nil, nil
+	}
+	i := it.items[0]
+	row := it.heads[i]
+	next, err := it.iters[i].Next()
+	if err != nil {
+		return nil, err
+	}
+	if next == nil {
+		heap.Pop(it)
+	} else {
+		it.heads[i] = next
+		heap.Fix(it, 0)
+	}
+	return row, nil
+}
+
+// dimensionValues returns the values of the GROUP BY tags in the order of the dimensions.
+func (trans *SortTransform) dimensionValues(tags ChunkTags) []string {
+	if len(trans.dimension) == 0 {
+		return nil
+	}
+	keys, vals := tags.GetChunkTagAndValues()
+	res := make([]string, len(trans.dimension))
+	for i, dim := range trans.dimension {
+		for j := range keys {
+			if keys[j] == dim {
+				res[i] = vals[j]
+				break
+			}
+		}
+	}
+	return res
+}
+
+// growMemory accounts the rows of the chunk added to the partition and spills
+// the partition to disk when the query exceeds its memory budget.
+func (trans *SortTransform) growMemory(i int, c Chunk) error {
+	size := int64(c.Size()) + int64(c.Len()*len(trans.newResultFuncs)*sortEleMemSize)
+	trans.memUsed += size
+	if trans.memAccountant.Grow(sortTransfromName, size) {
+		return nil
+	}
+	return trans.spillPartition(i)
+}
+
+func (trans *SortTransform) releaseMemory() {
+	trans.memAccountant.Shrink(sortTransfromName, trans.memUsed)
+	trans.memUsed = 0
+}
+
+// spillPartition sorts the partition and writes it to disk as a sorted run.
+func (trans *SortTransform) spillPartition(i int) error {
+	p := trans.sortWorkerResult[i][trans.sortWorkerPartitionIdx[i]]
+	if p.Len() == 0 {
+		return nil
+	}
+	sort.Sort(p)
+
+	file, err := newChunkSpillFile(trans.output.RowDataType)
+	if err != nil {
+		return err
+	}
+	trans.spillFiles = append(trans.spillFiles, file)
+	if trans.spillChunk == nil {
+		trans.spillChunk = NewChunkBuilder(trans.output.RowDataType).NewChunk("")
+	}
+	getChunk := func() Chunk {
+		trans.spillChunk.Reset()
+		return trans.spillChunk
+	}
+	if err = trans.rowsOutput(&sortPartitionIterator{rows: p.rows}, trans.sortWorkerBufChunk[i].Name(), getChunk, file.Write); err != nil {
+		return err
+	}
+
+	trans.memAccountant.Spill(file.Size())
+	trans.sortLogger.Info("sort spill to disk", zap.Int("rows", p.Len()), zap.Int64("bytes", file.Size()),
+		zap.Int64("memory", trans.memUsed), zap.Uint64("query_id", trans.opt.QueryId))
+	trans.releaseMemory()
+	trans.sortWorkerResult[i][trans.sortWorkerPartitionIdx[i]] = NewSortPartition(p.id, trans.sortKeysIdxs, trans.ascending)
+	return nil
+}
+
+// mergeOutput merges the sorted runs on disk and the sorted partitions in memory.
+func (trans *SortTransform) mergeOutput(i int) error {
+	iters := make([]sortRowIterator, 0, len(trans.spillFiles)+len(trans.sortWorkerResult[i]))
+	for _, file := range trans.spillFiles {
+		if err := file.Rewind(); err != nil {
+			return err
+		}
+		iters = append(iters, &sortRunIterator{trans: trans, file: file})
+	}
+	for _, p := range trans.sortWorkerResult[i] {
+		iters = append(iters, &sortPartitionIterator{rows: p.rows})
+	}
+
+	it, err := newSortMergeIterator(trans, iters)
+	if err != nil {
+		return err
+	}
+	return trans.rowsOutput(it, trans.sortWorkerBufChunk[i].Name(), trans.outputChunkPool.GetChunk, trans.sendChunk)
+}
+
+func (trans *SortTransform) closeSpillFiles() {
+	for _, file := range trans.spillFiles {
+		file.Close()
+	}
+	trans.spillFiles = nil
+}
@@ -16,13 +16,16 @@ package executor_test
 
 import (
 	"context"
+	"os"
 	"strconv"
 	"testing"
 
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/sysconfig"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -394,3 +397,44 @@ func TestSortTransformOrderByTagAndNilField(t *testing.T) {
 	}
 	e1.Release()
 }
+
+func TestSortTransformSpill(t *testing.T) {
+	limit, dir := sysconfig.GetQueryMemoryLimit(), sysconfig.GetQuerySpillDir()
+	defer sysconfig.SetQueryMemoryLimit(limit)
+	defer sysconfig.SetQuerySpillDir(dir)
+	spillDir := t.TempDir()
+	sysconfig.SetQueryMemoryLimit(1)
+	sysconfig.SetQuerySpillDir(spillDir)
+
+	sortFields := influxql.SortFields{
+		&influxql.SortField{Name: "tag2", Ascending: true},
+		&influxql.SortField{Name: "f1", Ascending: false},
+	}
+	schema := buildSortSchema(sortFields)
+	rt := buildSortRowDataType()
+	source := NewSourceFromSingleChunk(rt, []executor.Chunk{BuildSortChunk1(), BuildSortChunk2()})
+	trans, err := executor.NewSortTransform([]hybridqp.RowDataType{rt}, []hybridqp.RowDataType{rt}, schema, schema.GetSortFields())
+	require.NoError(t, err)
+	var expStr string = "f1 f2 f3 f4 time\ntag1\x003\x00tag2\x001\x00\n6 f 1 false 6\n5 e 2 true 5\ntag1\x002\x00tag2\x002\x00\n4 d 3 false 4\n3 c 4 true 3\ntag1\x001\x00tag2\x003\x00\n2 b 5 false 2\n1 a 6 true 1\n"
+	var resultStr string
+	finish := make(chan int, 1)
+	resultChunkOutPut := executor.NewChunkPort(rt)
+	require.NoError(t, executor.Connect(source.Output, trans.GetInputs()[0]))
+	require.NoError(t, executor.Connect(trans.GetOutputs()[0], resultChunkOutPut))
+	e1 := executor.NewPipelineExecutor(executor.Processors{source, trans})
+	go getResult(resultChunkOutPut, &resultStr, finish)
+	ctx := executor.NewContextWithMemoryAccountant(context.Background())
+	require.NoError(t, e1.Execute(ctx))
+	<-finish
+	assert.Equal(t, expStr, resultStr)
+	e1.Release()
+
+	accountant := executor.MemoryAccountantFromContext(ctx)
+	assert.Greater(t, accountant.Spilled(), int64(0))
+	assert.Equal(t, int64(0), accountant.Used())
+	assert.Greater(t, accountant.Peak(), int64(0))
+
+	files, err := os.ReadDir(spillDir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
@@ -60,6 +60,10 @@ const (
 	FIN_FLAG
 	RST_FLAG
 	DATA_ACK_FLAG
+	// TRACE_CONTEXT_FLAG is set in SYN by the nodes which can send the requests with the span context,
+	// and echoed in ACK by the nodes which can accept them. The older nodes ignore it, so the span
+	// context is only sent over the sessions whose ACK carries it, see TraceFlag
+	TRACE_CONTEXT_FLAG
 )
 
 type BuffWriter interface {
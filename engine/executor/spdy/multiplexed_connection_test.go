@@ -18,6 +18,7 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"io"
 	"net"
 	"sync"
 	"testing"
@@ -268,6 +269,58 @@ func TestEstablishConnection(t *testing.T) {
 	server.Stop()
 }
 
+func TestNegotiateTraceContext(t *testing.T) {
+	server := newMockServer(network, address)
+	server.Start()
+	client := newMockClient(network, address)
+	client.Dial()
+
+	session, err := client.conn.OpenSession()()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if !session.SupportTraceContext() {
+		t.Errorf("expect the session supports the trace context")
+	}
+	HandleError(session.Close())
+	client.Close()
+	server.Stop()
+
+	// the older nodes acknowledge SYN without TRACE_CONTEXT_FLAG
+	oldAddress := "127.0.0.2:38081"
+	listener, err := net.Listen(network, oldAddress)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		hdr := make(header, HEADER_SIZE)
+		if _, err := io.ReadFull(conn, hdr); err != nil || !Flags(hdr.Flags()).has(SYN_FLAG|TRACE_CONTEXT_FLAG) {
+			return
+		}
+		ack := make(header, HEADER_SIZE)
+		ack.encode(DATA_TYPE, ACK_FLAG, hdr.ConnID(), 0)
+		_, _ = conn.Write(ack)
+		_, _ = io.Copy(io.Discard, conn)
+	}()
+
+	client = newMockClient(network, oldAddress)
+	client.Dial()
+	defer client.Close()
+	session, err = client.conn.OpenSession()()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if session.SupportTraceContext() {
+		t.Errorf("expect the session does not support the trace context")
+	}
+}
+
 func TestCloseMultiplexedConnection(t *testing.T) {
 	server := newMockServer(network, address)
 	server.Start()
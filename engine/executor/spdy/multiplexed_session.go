@@ -60,6 +60,8 @@ type MultiplexedSession struct {
 	dataAck       *DataACK
 	ackRecvSig    chan struct{}
 	selectTimeout time.Duration
+	// both ends of the session support the requests with the span context, see TRACE_CONTEXT_FLAG
+	traceContext bool
 
 	closed    chan struct{}
 	closeOnce sync.Once
@@ -171,6 +173,11 @@ func (s *MultiplexedSession) ID() uint64 {
 	return s.id
 }
 
+// SupportTraceContext returns whether the requests of the session can be sent with the span context
+func (s *MultiplexedSession) SupportTraceContext() bool {
+	return s.traceContext
+}
+
 func (s *MultiplexedSession) Connection() *MultiplexedConnection {
 	return s.conn
 }
@@ -219,6 +226,7 @@ func (s *MultiplexedSession) handle(flags Flags, data []byte) error {
 	}
 
 	if flags.has(SYN_FLAG) {
+		s.traceContext = flags.has(TRACE_CONTEXT_FLAG)
 		if err := s.RecvSyn(); err != nil {
 			return err
 		}
@@ -232,6 +240,7 @@ func (s *MultiplexedSession) handle(flags Flags, data []byte) error {
 	}
 
 	if flags.has(ACK_FLAG) {
+		s.traceContext = flags.has(TRACE_CONTEXT_FLAG)
 		if err := s.RecvAck(); err != nil {
 			return err
 		}
@@ -292,7 +301,7 @@ func (s *MultiplexedSession) SendSyn(data []byte, ackRecvSig chan struct{}) erro
 
 func (s *MultiplexedSession) sendSyn(event event, transition *FSMTransition, data []byte) error {
 	var flags uint16
-	flags |= SYN_FLAG
+	flags |= SYN_FLAG | TRACE_CONTEXT_FLAG
 	if err := s.sendDataInternal(flags, data); err != nil {
 		return err
 	}
@@ -320,6 +329,9 @@ func (s *MultiplexedSession) SendAck(data []byte) error {
 func (s *MultiplexedSession) sendAck(event event, transition *FSMTransition, data []byte) error {
 	var flags uint16
 	flags |= ACK_FLAG
+	if s.traceContext {
+		flags |= TRACE_CONTEXT_FLAG
+	}
 	if err := s.sendDataInternal(flags, data); err != nil {
 		return err
 	}
@@ -38,6 +38,9 @@ const (
 	ReqFlag uint16 = 1 << iota
 	RspFlag
 	FullFlag
+	// TraceFlag means the data of the request starts with the span context of the caller, see tracing.SizeOfTraceContext.
+	// It is only set if the peer supports it, see TRACE_CONTEXT_FLAG
+	TraceFlag
 )
 
 const (
@@ -119,6 +122,8 @@ type Requester interface {
 	Sequence() uint64
 	StartAnalyze(span *tracing.Span)
 	FinishAnalyze()
+	SetTraceContext([]byte)
+	TraceContext() []byte
 }
 
 type Responser interface {
@@ -140,6 +145,8 @@ type BaseRequester struct {
 
 	encodeSpan *tracing.Span
 	sendSpan   *tracing.Span
+
+	traceContext []byte
 }
 
 func (base *BaseRequester) InitDerive(session *MultiplexedSession, sequence uint64, derive Requester) {
@@ -149,12 +156,23 @@ func (base *BaseRequester) InitDerive(session *MultiplexedSession, sequence uint
 }
 
 func (base *BaseRequester) sendFlags() uint16 {
+	if base.sendTraceContext() {
+		return ReqFlag | TraceFlag
+	}
 	return ReqFlag
 }
 
+// sendTraceContext returns whether the span context is sent with the request, the older nodes can not accept it
+func (base *BaseRequester) sendTraceContext() bool {
+	return len(base.traceContext) == tracing.SizeOfTraceContext && base.session.SupportTraceContext()
+}
+
 func (base *BaseRequester) Request(request interface{}) error {
 	begin := time.Now()
 	buf := base.session.conn.AllocData(ProtocolHeaderSize)
+	if base.sendTraceContext() {
+		buf = append(buf, base.traceContext...)
+	}
 	buf, err := base.derive.Encode(buf, request)
 	if err != nil {
 		return err
@@ -193,6 +211,15 @@ func (base *BaseRequester) FinishAnalyze() {
 	tracing.Finish(base.sendSpan, base.encodeSpan)
 }
 
+// SetTraceContext sets the span context sent with the request, see tracing.MarshalTraceContext
+func (base *BaseRequester) SetTraceContext(traceContext []byte) {
+	base.traceContext = traceContext
+}
+
+func (base *BaseRequester) TraceContext() []byte {
+	return base.traceContext
+}
+
 type BaseResponser struct {
 	session  *MultiplexedSession
 	sequence uint64
@@ -202,6 +229,8 @@ type BaseResponser struct {
 	decodeSpan *tracing.Span
 	sendSpan   *tracing.Span
 	waitSpan   *tracing.Span
+
+	traceContext []byte
 }
 
 func (base *BaseResponser) InitDerive(session *MultiplexedSession, sequence uint64, derive Responser) {
@@ -323,6 +352,24 @@ func (base *BaseResponser) FinishAnalyze() {
 	tracing.Finish(base.sendSpan, base.encodeSpan)
 }
 
+func (base *BaseResponser) SetTraceContext(traceContext []byte) {
+	base.traceContext = traceContext
+}
+
+// TraceContext returns the span context sent with the request of the responser
+func (base *BaseResponser) TraceContext() []byte {
+	return base.traceContext
+}
+
+// TraceContextOf returns the span context sent with the request of w, nil if the request is not traced
+func TraceContextOf(w Responser) []byte {
+	tc, ok := w.(interface{ TraceContext() []byte })
+	if !ok {
+		return nil
+	}
+	return tc.TraceContext()
+}
+
 type EventHandler interface {
 	CreateRequester(uint64) (Requester, error)
 	WarpRequester(uint64, []byte) (Requester, error)
@@ -20,6 +20,7 @@ import (
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"go.uber.org/zap"
 )
 
@@ -124,11 +125,23 @@ func (r *Reactor) HandleEvents() {
 			return
 		}
 
-		if requester, err := handler.WarpRequester(header.Sequence(), data[ProtocolHeaderSize:]); err != nil {
+		payload := data[ProtocolHeaderSize:]
+		var traceContext []byte
+		if header.Flags()&TraceFlag == TraceFlag {
+			if len(payload) < tracing.SizeOfTraceContext {
+				r.closeWithErr(errno.NewError(errno.ShortBufferSize, tracing.SizeOfTraceContext, len(payload)))
+				return
+			}
+			traceContext = append(traceContext, payload[:tracing.SizeOfTraceContext]...)
+			payload = payload[tracing.SizeOfTraceContext:]
+		}
+
+		if requester, err := handler.WarpRequester(header.Sequence(), payload); err != nil {
 			r.closeWithErr(err)
 			return
 		} else {
 			r.session.conn.FreeData(data)
+			requester.SetTraceContext(traceContext)
 			responser := requester.WarpResponser()
 			err := handler.Handle(requester, responser)
 			if err != nil {
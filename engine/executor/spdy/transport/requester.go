@@ -67,5 +67,7 @@ func (req *Requester) Type() uint8 {
 }
 
 func (req *Requester) WarpResponser() spdy.Responser {
-	return NewResponser(req.Session(), req.Type(), req.Sequence())
+	rsp := NewResponser(req.Session(), req.Type(), req.Sequence())
+	rsp.(*Responser).SetTraceContext(req.TraceContext())
+	return rsp
 }
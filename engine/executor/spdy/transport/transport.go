@@ -15,6 +15,7 @@
 package transport
 
 import (
+	"context"
 	"sync/atomic"
 	"time"
 
@@ -133,6 +134,11 @@ func (s *Transport) FinishAnalyze() {
 	}
 }
 
+// SetTraceContext sends the span context of ctx with the request, it is sent only if the trace export is enabled
+func (s *Transport) SetTraceContext(ctx context.Context) {
+	s.requester.SetTraceContext(tracing.MarshalTraceContext(ctx))
+}
+
 func (s *Transport) Send(data Codec) error {
 	if err := s.requester.Request(data); err != nil {
 		s.pool.Close()
@@ -23,6 +23,7 @@ import (
 	"github.com/openGemini/openGemini/engine/executor/spdy"
 	"github.com/openGemini/openGemini/engine/executor/spdy/transport"
 	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -285,3 +286,46 @@ func TestNewTransportByAddress(t *testing.T) {
 	_, err = transport.NewTransportByAddress(nodeID, address, spdy.SelectRequest, nil)
 	assert.EqualError(t, err, noConnectionAvailable)
 }
+
+type traceRPCServer struct {
+	traceContext chan []byte
+	data         chan string
+}
+
+func (c *traceRPCServer) Abort() {
+
+}
+
+func (c *traceRPCServer) Handle(w spdy.Responser, data interface{}) error {
+	c.traceContext <- spdy.TraceContextOf(w)
+	c.data <- data.(*Message).data
+	return w.Response(data.(*Message), true)
+}
+
+func TestTransport_TraceContext(t *testing.T) {
+	address := "127.0.0.13:18299"
+	var nodeID uint64 = 18300
+
+	handler := &traceRPCServer{traceContext: make(chan []byte, 2), data: make(chan string, 2)}
+	server := spdy.NewRRCServer(spdy.DefaultConfiguration(), "tcp", address)
+	server.RegisterEHF(transport.NewEventHandlerFactory(spdy.SelectRequest, handler, &Message{}))
+	require.NoError(t, server.Start())
+	defer server.Stop()
+	transport.NewNodeManager().Add(nodeID, address)
+
+	traceContext := make([]byte, tracing.SizeOfTraceContext)
+	for i := range traceContext {
+		traceContext[i] = byte(i + 1)
+	}
+	for _, exp := range [][]byte{traceContext, nil} {
+		trans, err := transport.NewTransport(nodeID, spdy.SelectRequest, &RPCClient{})
+		require.NoError(t, err)
+		trans.SetTimeout(5 * time.Second)
+		trans.Requester().SetTraceContext(exp)
+		require.NoError(t, trans.Send(&Message{data: "12345678"}))
+		require.NoError(t, trans.Wait())
+		require.Equal(t, exp, <-handler.traceContext)
+		// the span context is not a part of the message
+		require.Equal(t, "12345678", <-handler.data)
+	}
+}
@@ -0,0 +1,340 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/sysconfig"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+const spillFilePattern = "query-spill-*"
+
+// chunkSpillFile is a temporary file of chunks written by an operator exceeding the memory budget
+// of its query. Chunks are written with their length and read back in the same order.
+type chunkSpillFile struct {
+	fd     *os.File
+	writer *bufio.Writer
+	reader *bufio.Reader
+
+	rowDataType hybridqp.RowDataType
+	buf         []byte
+	size        int64
+	num         int
+}
+
+func newChunkSpillFile(rowDataType hybridqp.RowDataType) (*chunkSpillFile, error) {
+	dir := sysconfig.GetQuerySpillDir()
+	if dir == "" {
+		dir = os.TempDir()
+	} else if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+
+	fd, err := os.CreateTemp(dir, spillFilePattern)
+	if err != nil {
+		return nil, err
+	}
+	return &chunkSpillFile{
+		fd:          fd,
+		writer:      bufio.NewWriter(fd),
+		rowDataType: rowDataType,
+	}, nil
+}
+
+func (f *chunkSpillFile) Write(c Chunk) error {
+	if c == nil || c.Len() == 0 {
+		return nil
+	}
+	var err error
+	f.buf = binary.BigEndian.AppendUint32(f.buf[:0], 0)
+	f.buf, err = c.Marshal(f.buf)
+	if err != nil {
+		return err
+	}
+	binary.BigEndian.PutUint32(f.buf, uint32(len(f.buf)-4))
+	if _, err = f.writer.Write(f.buf); err != nil {
+		return err
+	}
+	f.size += int64(len(f.buf))
+	f.num++
+	return nil
+}
+
+// Rewind flushes the written chunks and reads the file from the beginning.
+func (f *chunkSpillFile) Rewind() error {
+	if err := f.writer.Flush(); err != nil {
+		return err
+	}
+	if _, err := f.fd.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	f.reader = bufio.NewReader(f.fd)
+	return nil
+}
+
+// Read returns the next chunk of the file, or nil at the end of the file.
+func (f *chunkSpillFile) Read() (Chunk, error) {
+	var head [4]byte
+	if _, err := io.ReadFull(f.reader, head[:]); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// the columns of the chunk refer to the buffer, so it is not reused
+	buf := make([]byte, binary.BigEndian.Uint32(head[:]))
+	if _, err := io.ReadFull(f.reader, buf); err != nil {
+		return nil, err
+	}
+	c := NewChunkImpl(f.rowDataType, "")
+	if err := c.Unmarshal(buf); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (f *chunkSpillFile) Size() int64 {
+	return f.size
+}
+
+func (f *chunkSpillFile) Len() int {
+	return f.num
+}
+
+// Close closes and removes the file.
+func (f *chunkSpillFile) Close() {
+	if f == nil || f.fd == nil {
+		return
+	}
+	name := f.fd.Name()
+	_ = f.fd.Close()
+	_ = os.Remove(name)
+	f.fd = nil
+}
+
+// appendChunkRows appends the rows [start, end) of src with their tags and dims to dst.
+func appendChunkRows(dst, src Chunk, start, end int) {
+	if start >= end {
+		return
+	}
+	tags, tagIndex := src.Tags(), src.TagIndex()
+	for i := range tags {
+		tagEnd := src.Len()
+		if i < len(tags)-1 {
+			tagEnd = tagIndex[i+1]
+		}
+		if tagEnd <= start || tagIndex[i] >= end {
+			continue
+		}
+		dst.AppendTagsAndIndex(tags[i], dst.Len())
+	}
+	dst.AppendTimes(src.Time()[start:end])
+
+	for i := range src.Columns() {
+		appendColumnRows(dst.Column(i), src.Column(i), start, end)
+	}
+	if len(src.Dims()) > 0 && len(dst.Dims()) == 0 {
+		dst.(*ChunkImpl).NewDims(len(src.Dims()))
+	}
+	for i := range src.Dims() {
+		appendColumnRows(dst.Dim(i), src.Dim(i), start, end)
+	}
+}
+
+func appendColumnRows(dst, src Column, start, end int) {
+	hasColumnTimes := len(src.ColumnTimes()) > 0
+	for row := start; row < end; row++ {
+		if src.IsNilV2(row) {
+			dst.AppendNil()
+			continue
+		}
+		idx := src.GetValueIndexV2(row)
+		switch src.DataType() {
+		case influxql.Float:
+			dst.AppendFloatValue(src.FloatValue(idx))
+		case influxql.Integer:
+			dst.AppendIntegerValue(src.IntegerValue(idx))
+		case influxql.Boolean:
+			dst.AppendBooleanValue(src.BooleanValue(idx))
+		case influxql.String, influxql.Tag:
+			dst.AppendStringValue(src.StringValue(idx))
+		case influxql.FloatTuple:
+			dst.AppendFloatTuple(src.FloatTuple(idx))
+		}
+		if hasColumnTimes {
+			dst.AppendColumnTime(src.ColumnTime(idx))
+		}
+		dst.AppendNotNil()
+	}
+}
+
+// chunkSpillQueue is a FIFO queue of chunks between a producer and a consumer running at different paces.
+// The chunks are kept in memory while the query is within its memory budget, the others are written to
+// a spill file which is read back once the chunks before it have been consumed.
+type chunkSpillQueue struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	operator    string
+	accountant  *MemoryAccountant
+	rowDataType hybridqp.RowDataType
+
+	mem       []Chunk
+	memSize   []int64
+	spilled   int             // the number of chunks spilled and not read yet
+	maxChunks int             // Push waits while the queue holds this many chunks, 0 if unbounded
+	writing   *chunkSpillFile // the file the producer appends the chunks over the budget to
+	reading   *chunkSpillFile // the file the consumer reads, written before the current one
+	closed    bool
+	err       error
+}
+
+func newChunkSpillQueue(operator string, accountant *MemoryAccountant, rowDataType hybridqp.RowDataType) *chunkSpillQueue {
+	q := &chunkSpillQueue{
+		operator:    operator,
+		accountant:  accountant,
+		rowDataType: rowDataType,
+	}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+// SetMaxChunks bounds the chunks held by the queue, so that the producer does not run ahead
+// of the consumer by more than n chunks. A bounded queue never spills as long as the memory
+// budget holds n chunks.
+func (q *chunkSpillQueue) SetMaxChunks(n int) {
+	q.mu.Lock()
+	q.maxChunks = n
+	q.mu.Unlock()
+}
+
+// Push copies the chunk into the queue, the producer may reuse the chunk once Push returns.
+func (q *chunkSpillQueue) Push(c Chunk) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for q.maxChunks > 0 && q.chunks() >= q.maxChunks && !q.closed {
+		q.cond.Wait()
+	}
+	if q.closed {
+		return q.err
+	}
+	defer q.cond.Broadcast()
+
+	// once a chunk is spilled, the next ones are spilled too until it is read, to keep the order
+	if q.writing == nil && q.reading == nil {
+		size := int64(c.Size())
+		if q.accountant.Grow(q.operator, size) {
+			q.mem = append(q.mem, c.Clone())
+			q.memSize = append(q.memSize, size)
+			return nil
+		}
+		q.accountant.Shrink(q.operator, size)
+	}
+
+	if q.writing == nil {
+		file, err := newChunkSpillFile(q.rowDataType)
+		if err != nil {
+			return err
+		}
+		q.writing = file
+	}
+	written := q.writing.Size()
+	if err := q.writing.Write(c); err != nil {
+		return err
+	}
+	q.spilled++
+	q.accountant.Spill(q.writing.Size() - written)
+	return nil
+}
+
+func (q *chunkSpillQueue) chunks() int {
+	return len(q.mem) + q.spilled
+}
+
+// Pop returns the oldest chunk of the queue. It waits for the producer while the queue is empty,
+// and returns nil once the queue is closed and empty.
+func (q *chunkSpillQueue) Pop() (Chunk, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for {
+		if len(q.mem) > 0 {
+			c := q.mem[0]
+			q.accountant.Shrink(q.operator, q.memSize[0])
+			q.mem, q.memSize = q.mem[1:], q.memSize[1:]
+			q.cond.Broadcast()
+			return c, nil
+		}
+
+		if q.reading == nil && q.writing != nil {
+			// the producer goes on with a new file
+			q.reading, q.writing = q.writing, nil
+			if err := q.reading.Rewind(); err != nil {
+				return nil, err
+			}
+		}
+		if q.reading != nil {
+			c, err := q.reading.Read()
+			if c != nil {
+				q.spilled--
+				q.cond.Broadcast()
+			}
+			if err != nil || c != nil {
+				return c, err
+			}
+			q.reading.Close()
+			q.reading = nil
+			continue
+		}
+
+		if q.closed {
+			return nil, q.err
+		}
+		q.cond.Wait()
+	}
+}
+
+// Close marks the end of the chunks pushed into the queue, err is returned to the consumer
+// once the pushed chunks are consumed.
+func (q *chunkSpillQueue) Close(err error) {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed, q.err = true, err
+	}
+	q.mu.Unlock()
+	q.cond.Broadcast()
+}
+
+// Release closes the queue and drops the chunks which have not been consumed.
+func (q *chunkSpillQueue) Release() {
+	q.Close(nil)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, size := range q.memSize {
+		q.accountant.Shrink(q.operator, size)
+	}
+	q.mem, q.memSize, q.spilled = nil, nil, 0
+	q.writing.Close()
+	q.reading.Close()
+	q.writing, q.reading = nil, nil
+}
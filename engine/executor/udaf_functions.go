@@ -15,7 +15,7 @@
 package executor
 
 import (
-	"time"
+	"context"
 
 	"github.com/influxdata/influxdb/uuid"
 	"github.com/openGemini/openGemini/lib/errno"
@@ -23,7 +23,7 @@ import (
 	"github.com/openGemini/openGemini/services/castor"
 )
 
-func CastorReduce(in []Chunk, out Chunk, args ...interface{}) error {
+func CastorReduce(ctx context.Context, in []Chunk, out Chunk, args ...interface{}) error {
 	if len(in) == 0 {
 		return errno.NewError(errno.EmptyData)
 	}
@@ -44,42 +44,26 @@ func CastorReduce(in []Chunk, out Chunk, args ...interface{}) error {
 	if err != nil {
 		return err
 	}
+	defer func() {
+		for _, r := range recs {
+			r.Release()
+		}
+	}()
 
-	// send data
-	respChan, err := castor.NewRespChan(len(recs))
-	if err != nil {
-		return err
+	resps, processErr := srv.Process(ctx, taskId, recs)
+	if processErr != nil {
+		return processErr
 	}
-	respCnt := 0
-	srv.RegisterResultChan(taskId, respChan)
 	defer func() {
-		srv.DeregisterResultChan(taskId)
-		respChan.Close()
+		for _, resp := range resps {
+			resp.Release()
+		}
 	}()
-	for _, r := range recs {
-		srv.HandleData(r)
-		defer r.Release()
-	}
-
-	// wait response
 	out.SetName(in[0].Name())
-	ticker := time.NewTicker(srv.Config.GetWaitTimeout())
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			return errno.NewError(errno.ResponseIncomplete, len(recs), respCnt)
-		case resp := <-respChan.C:
-			respCnt++
-			defer resp.Release()
-			if err := CopyArrowRecordToChunk(resp, out, castor.DesiredFieldKeySet); err != nil {
-				return err
-			}
-			if respCnt == len(recs) {
-				return nil
-			}
-		case err := <-respChan.ErrCh:
+	for _, resp := range resps {
+		if err := CopyArrowRecordToChunk(resp, out, castor.DesiredFieldKeySet); err != nil {
 			return err
 		}
 	}
+	return nil
 }
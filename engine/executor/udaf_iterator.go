@@ -15,13 +15,15 @@
 package executor
 
 import (
+	"context"
+
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 )
 
 const UDAFMaxRow = 10000
 
-type WideReduce func(input []Chunk, out Chunk, p ...interface{}) error
+type WideReduce func(ctx context.Context, input []Chunk, out Chunk, p ...interface{}) error
 
 type WideIterator struct {
 	isErrHappend bool
@@ -76,7 +78,7 @@ func (r *WideIterator) Next(ie *IteratorEndpoint, p *IteratorParams) {
 	if !p.lastChunk {
 		return
 	}
-	err := r.fn(r.chunkCache, outChunk, r.params...)
+	err := r.fn(p.Context(), r.chunkCache, outChunk, r.params...)
 	if err != nil {
 		p.err = err
 	}
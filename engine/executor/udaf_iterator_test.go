@@ -15,6 +15,7 @@
 package executor_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/openGemini/openGemini/engine/executor"
@@ -23,7 +24,9 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 )
 
-func mockCastorOp(input []executor.Chunk, out executor.Chunk, p ...interface{}) error { return nil }
+func mockCastorOp(ctx context.Context, input []executor.Chunk, out executor.Chunk, p ...interface{}) error {
+	return nil
+}
 
 func Test_WideIterator_MultiColumnChunk(t *testing.T) {
 	row := hybridqp.NewRowDataTypeImpl(
@@ -22,6 +22,7 @@ import (
 	"github.com/openGemini/openGemini/engine/comm"
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/engine/immutable"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
@@ -46,6 +47,8 @@ type groupCursor struct {
 	seriesTagFunc    func(sinfo comm.SeriesInfoIntf, pt *influx.PointTags, tmpSeriesKey []byte) ([]byte, error)
 	limitBound       int64
 	rowCount         int64
+	scanRows         int64
+	scanBytes        int64
 }
 
 func (c *groupCursor) SetOps(ops []*comm.CallOption) {
@@ -124,6 +127,7 @@ func (c *groupCursor) nextWithReuse() (*record.Record, comm.SeriesInfoIntf, erro
 			sameTag = false
 		}
 		c.rowCount += int64(rec.RowNums())
+		c.addScan(rec)
 		if !sameTag {
 			tmpSeriesKey, e = c.seriesTagFunc(info, currTags, tmpSeriesKey)
 			if e != nil {
@@ -161,6 +165,7 @@ func (c *groupCursor) next() (*record.Record, comm.SeriesInfoIntf, error) {
 			return nil, nil, err
 		}
 		if rec != nil {
+			c.addScan(rec)
 			return rec, info, nil
 		}
 
@@ -171,6 +176,11 @@ func (c *groupCursor) next() (*record.Record, comm.SeriesInfoIntf, error) {
 	}
 }
 
+func (c *groupCursor) addScan(rec *record.Record) {
+	c.scanRows += int64(rec.RowNums())
+	c.scanBytes += int64(rec.Size())
+}
+
 func (c *groupCursor) GetSchema() record.Schemas {
 	if len(c.tagSetCursors) > 0 {
 		return c.tagSetCursors[0].GetSchema()
@@ -181,6 +191,12 @@ func (c *groupCursor) GetSchema() record.Schemas {
 func (c *groupCursor) Close() error {
 	var err error
 	c.closeOnce.Do(func() {
+		if st := c.coldReadStat(); st != nil && st.Reads > 0 && c.ctx.queryStat != nil {
+			c.ctx.queryStat.AddColdRead(st.Reads, st.Bytes, st.RemoteBytes, st.Duration)
+		}
+		if c.ctx.scanStat != nil && c.scanRows > 0 {
+			c.ctx.scanStat.AddScan(0, c.scanRows, c.scanBytes)
+		}
 		c.ctx.decs.Release()
 		startPos := c.pos
 		if c.lazyInit {
@@ -230,14 +246,31 @@ func (c *groupCursor) StartSpan(span *tracing.Span) {
 			c.span.CreateCounter(tsmIterCount, "")
 			c.span.CreateCounter(tsmIterDuration, "ns")
 		}
+		c.span.CreateCounter(coldReadCount, "")
+		c.span.CreateCounter(coldReadBytes, "")
+		c.span.CreateCounter(coldRemoteBytes, "")
+		c.span.CreateCounter(coldReadDuration, "ns")
 		for _, cursor := range c.tagSetCursors {
 			cursor.StartSpan(c.span)
 		}
 	}
 }
 
+func (c *groupCursor) coldReadStat() *immutable.ColdReadStat {
+	if c.ctx == nil || c.ctx.decs == nil {
+		return nil
+	}
+	return c.ctx.decs.ColdReadStat()
+}
+
 func (c *groupCursor) EndSpan() {
 	if c.span != nil {
+		if st := c.coldReadStat(); st != nil {
+			c.span.Count(coldReadCount, st.Reads)
+			c.span.Count(coldReadBytes, st.Bytes)
+			c.span.Count(coldRemoteBytes, st.RemoteBytes)
+			c.span.Count(coldReadDuration, st.Duration)
+		}
 		c.span.Finish()
 	}
 }
@@ -182,6 +182,35 @@ func (c *ChunkIterator) IncrChunkUsed() {
 }
 
 func (c *ChunkIterator) Next() bool {
+	tombstones := FileTombstones(c.r)
+	for c.next() {
+		if len(tombstones) == 0 {
+			return true
+		}
+		if c.filterTombstones(tombstones) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterTombstones removes the deleted rows from the current record,
+// returns false if all the rows are deleted.
+func (c *ChunkIterator) filterTombstones(tombstones []*Tombstone) bool {
+	rec := FilterByTombstones(c.merge, c.id, tombstones)
+	if rec == nil {
+		return false
+	}
+	if rec != c.merge {
+		c.merge.Reset()
+		c.merge.SetSchema(c.fields)
+		c.merge.ReserveColVal(len(c.fields))
+		c.merge.AppendRec(rec, 0, rec.RowNums())
+	}
+	return true
+}
+
+func (c *ChunkIterator) next() bool {
 	if c.err != nil {
 		return false
 	}
@@ -0,0 +1,244 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/bufferpool"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/readcache"
+	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"go.uber.org/zap"
+)
+
+var coldPrefetchMeta = true
+
+// InitColdReadCache opens the disk cache of the TSSP files on the cold storage, empty dir disables it
+func InitColdReadCache(dir string, limit, blockSize int64, prefetchMeta bool) error {
+	coldPrefetchMeta = prefetchMeta
+	if dir == "" {
+		readcache.SetDiskBlockCache(nil)
+		return nil
+	}
+	c, err := readcache.NewDiskBlockCache(dir, limit, blockSize)
+	if err != nil {
+		return err
+	}
+	readcache.SetDiskBlockCache(c)
+	stat.NewColdRead().CacheSize.Store(c.Size())
+	return nil
+}
+
+// ColdReadStat is the statistics of the reads of the cold files in a query
+type ColdReadStat struct {
+	Reads       int64
+	Bytes       int64
+	RemoteBytes int64 // not hit by the disk cache
+	Duration    int64 // ns
+}
+
+func (s *ColdReadStat) add(bytes, remote int64, d time.Duration) {
+	atomic.AddInt64(&s.Reads, 1)
+	atomic.AddInt64(&s.Bytes, bytes)
+	atomic.AddInt64(&s.RemoteBytes, remote)
+	atomic.AddInt64(&s.Duration, int64(d))
+}
+
+// ColdFileReader reads a TSSP file on the object storage by the blocks of the disk cache,
+// the contiguous blocks missing in the cache are read by one range request
+type ColdFileReader struct {
+	fileops.BasicFileReader
+
+	cache *readcache.DiskBlockCache
+	path  string
+	size  int64
+}
+
+func NewColdFileReader(r fileops.BasicFileReader, cache *readcache.DiskBlockCache, size int64) *ColdFileReader {
+	return &ColdFileReader{
+		BasicFileReader: r,
+		cache:           cache,
+		path:            r.Name(),
+		size:            size,
+	}
+}
+
+// newColdFileReader returns nil if the file is not on the cold storage or the disk cache is disabled
+func newColdFileReader(r fileops.BasicFileReader, size int64) *ColdFileReader {
+	cache := readcache.GetDiskBlockCache()
+	if cache == nil || fileops.GetFsType(r.Name()) != fileops.Obs {
+		return nil
+	}
+	return NewColdFileReader(r, cache, size)
+}
+
+func (r *ColdFileReader) ReadAt(off int64, size uint32, dstPtr *[]byte, ioPriority int) ([]byte, error) {
+	if size < 1 {
+		return nil, nil
+	}
+	if off < 0 || off+int64(size) > r.size {
+		return nil, fmt.Errorf("invalid read offset %d size %d, file %s size %d", off, size, r.path, r.size)
+	}
+
+	*dstPtr = bufferpool.Resize(*dstPtr, int(size))
+	dst := (*dstPtr)[:size]
+	remote, err := r.read(off, dst, ioPriority)
+	if err != nil {
+		return nil, err
+	}
+
+	s := stat.NewColdRead()
+	s.ReadTotal.Incr()
+	s.ReadBytesTotal.Add(int64(size))
+	s.CacheHitBytesTotal.Add(int64(size) - min(remote, int64(size)))
+	return dst, nil
+}
+
+// Prefetch loads the blocks of [off, off+size) into the disk cache
+func (r *ColdFileReader) Prefetch(off, size int64, ioPriority int) error {
+	if size <= 0 {
+		return nil
+	}
+	bs := r.cache.BlockSize()
+	first, last := off/bs, (off+size-1)/bs
+	for id := first; id <= last; {
+		if r.cache.Contains(r.path, id) {
+			id++
+			continue
+		}
+		next := r.nextCached(id, last)
+		buf, err := r.fetch(id, next, ioPriority)
+		if err != nil {
+			return err
+		}
+		stat.NewColdRead().PrefetchBytesTotal.Add(int64(len(buf)))
+		id = next
+	}
+	return nil
+}
+
+// MissingBytes returns the bytes of the blocks of [off, off+size) that are not in the disk cache
+func (r *ColdFileReader) MissingBytes(off int64, size uint32) int64 {
+	if size < 1 {
+		return 0
+	}
+	bs := r.cache.BlockSize()
+	var n int64
+	for id := off / bs; id <= (off+int64(size)-1)/bs; id++ {
+		if !r.cache.Contains(r.path, id) {
+			n += r.blockEnd(id) - id*bs
+		}
+	}
+	return n
+}
+
+func (r *ColdFileReader) IsMmapRead() bool {
+	return false
+}
+
+func (r *ColdFileReader) Size() (int64, error) {
+	return r.size, nil
+}
+
+// read fills dst with the data at off, and returns the bytes read from the object storage
+func (r *ColdFileReader) read(off int64, dst []byte, ioPriority int) (int64, error) {
+	bs := r.cache.BlockSize()
+	end := off + int64(len(dst))
+	first, last := off/bs, (end-1)/bs
+
+	var remote int64
+	for id := first; id <= last; {
+		if block, ok := r.cache.Get(r.path, id); ok && int64(len(block)) == r.blockEnd(id)-id*bs {
+			copyBlock(dst, off, id*bs, block)
+			id++
+			continue
+		}
+
+		next := r.nextCached(id, last)
+		buf, err := r.fetch(id, next, ioPriority)
+		if err != nil {
+			return remote, err
+		}
+		remote += int64(len(buf))
+		copyBlock(dst, off, id*bs, buf)
+		id = next
+	}
+	return remote, nil
+}
+
+// nextCached returns the first block after id that is cached, or last+1
+func (r *ColdFileReader) nextCached(id, last int64) int64 {
+	next := id + 1
+	for next <= last && !r.cache.Contains(r.path, next) {
+		next++
+	}
+	return next
+}
+
+// fetch reads the blocks [from, to) by one range request and adds them to the disk cache
+func (r *ColdFileReader) fetch(from, to int64, ioPriority int) ([]byte, error) {
+	bs := r.cache.BlockSize()
+	off := from * bs
+	size := r.blockEnd(to-1) - off
+
+	var buf []byte
+	b, err := r.BasicFileReader.ReadAt(off, uint32(size), &buf, ioPriority)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) != size {
+		return nil, fmt.Errorf("short read of file %s, exp size: %d, got: %d", r.path, size, len(b))
+	}
+
+	s := stat.NewColdRead()
+	s.RemoteReadTotal.Incr()
+	s.RemoteBytesTotal.Add(size)
+	for id := from; id < to; id++ {
+		start, end := id*bs-off, r.blockEnd(id)-off
+		if err = r.cache.Add(r.path, id, b[start:end]); err != nil {
+			log.Warn("add block to the disk cache failed", zap.String("file", r.path), zap.Error(err))
+			break
+		}
+	}
+	s.CacheSize.Store(r.cache.Size())
+	return b, nil
+}
+
+func (r *ColdFileReader) blockEnd(id int64) int64 {
+	return min((id+1)*r.cache.BlockSize(), r.size)
+}
+
+// copyBlock copies the overlap of the block at blockOff to dst at off
+func copyBlock(dst []byte, off, blockOff int64, block []byte) {
+	if blockOff < off {
+		skip := off - blockOff
+		if skip >= int64(len(block)) {
+			return
+		}
+		copy(dst, block[skip:])
+		return
+	}
+	copy(dst[blockOff-off:], block)
+}
+
+// removeColdCache drops the cached blocks of a removed file
+func removeColdCache(name string) {
+	if cache := readcache.GetDiskBlockCache(); cache != nil && fileops.GetFsType(name) == fileops.Obs {
+		cache.Remove(name)
+	}
+}
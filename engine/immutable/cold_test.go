@@ -0,0 +1,138 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/stretchr/testify/require"
+)
+
+// s3StandIn is a local stand-in of the object storage, it serves HEAD and ranged GET of the objects
+type s3StandIn struct {
+	mu      sync.Mutex
+	objects map[string][]byte // keyed by /bucket/key
+	gets    int
+}
+
+func (s *s3StandIn) put(bucket, key string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects["/"+bucket+"/"+key] = data
+}
+
+func (s *s3StandIn) getCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.gets
+}
+
+func (s *s3StandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	data, ok := s.objects[r.URL.Path]
+	if r.Method == http.MethodGet {
+		s.gets++
+	}
+	s.mu.Unlock()
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+	if r.Method == http.MethodHead {
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		return
+	}
+
+	var start, end int
+	if _, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &start, &end); err != nil || end >= len(data) {
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(end-start+1))
+	w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+	w.WriteHeader(http.StatusPartialContent)
+	_, _ = w.Write(data[start : end+1])
+}
+
+func readAllChunks(t *testing.T, path string, stat *immutable.ColdReadStat) []string {
+	f, err := immutable.OpenTSSPFileReadonly(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	ctx := immutable.NewReadContext(true)
+	defer ctx.Release()
+	ctx.SetColdReadStat(stat)
+
+	var recs []string
+	require.NoError(t, immutable.WalkChunkMetas(f, func(_ int, _ *immutable.MetaIndex, cm *immutable.ChunkMeta) error {
+		rec, err := f.ReadAt(cm, 0, record.NewRecordBuilder(cm.Schema()), ctx, fileops.IO_PRIORITY_ULTRA_HIGH)
+		require.NoError(t, err)
+		recs = append(recs, rec.String())
+		return nil
+	}))
+	return recs
+}
+
+func TestColdFileReader(t *testing.T) {
+	local := writeInspectFile(t, t.TempDir(), 3)
+	exp := readAllChunks(t, local, nil)
+	buf, err := os.ReadFile(local)
+	require.NoError(t, err)
+
+	s3 := &s3StandIn{objects: make(map[string][]byte)}
+	srv := httptest.NewTLSServer(s3)
+	defer srv.Close()
+	key := "data/db0/0/rp0/1_0_0_0/columnstore/mst_0000/00000001-0000-00000000.tssp"
+	s3.put("bucket", key, buf)
+	remote := fileops.EncodeObsPath(srv.Listener.Addr().String(), "bucket", key, "ak", "sk")
+
+	cacheDir := t.TempDir()
+	require.NoError(t, immutable.InitColdReadCache(cacheDir, 1<<20, 64, true))
+	defer func() {
+		require.NoError(t, immutable.InitColdReadCache("", 0, 0, true))
+	}()
+
+	// the meta is prefetched by one request, the data blocks are read on demand
+	stat := &immutable.ColdReadStat{}
+	require.Equal(t, exp, readAllChunks(t, remote, stat))
+	gets := s3.getCount()
+	require.True(t, gets > 0)
+	require.Equal(t, int64(3), stat.Reads)
+	require.True(t, stat.RemoteBytes > 0)
+
+	// all the reads are hit by the disk cache
+	stat = &immutable.ColdReadStat{}
+	require.Equal(t, exp, readAllChunks(t, remote, stat))
+	require.Equal(t, gets, s3.getCount())
+	require.Equal(t, int64(3), stat.Reads)
+	require.Equal(t, int64(0), stat.RemoteBytes)
+
+	// the disk cache survives the restart
+	require.NoError(t, immutable.InitColdReadCache(cacheDir, 1<<20, 64, true))
+	require.Equal(t, exp, readAllChunks(t, remote, nil))
+	require.Equal(t, gets, s3.getCount())
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"hash/crc32"
 
+	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/encoding"
 	Log "github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/numberenc"
@@ -279,7 +280,7 @@ func (b *ColumnBuilder) encStringColumn(timeCols []record.ColVal, segCols []reco
 			b.data = append(b.data, segCol.Val...)
 		} else {
 			b.data = EncodeColumnHeader(segCol, b.data, encoding.BlockString)
-			b.data, err = encoding.EncodeStringBlock(segCol.Val, segCol.Offset, b.data, b.coder)
+			b.data, err = encodeStringBlock(segCol.Val, segCol.Offset, b.data, b.coder)
 			if err != nil {
 				b.log.Error("encode string value fail", zap.Error(err))
 				return err
@@ -443,6 +444,17 @@ func EncodeColumnHeader(col *record.ColVal, dst []byte, typ uint8) []byte {
 	return dst
 }
 
+// encodeStringBlock encodes the strings by dictionary if their cardinality is low enough
+func encodeStringBlock(in []byte, offset []uint32, out []byte, coder *encoding.CoderContext) ([]byte, error) {
+	if config.GetStoreConfig().StringDictEncoding {
+		out, ok, err := encoding.EncodeStringDictBlock(in, offset, out, coder)
+		if ok || err != nil {
+			return out, err
+		}
+	}
+	return encoding.EncodeStringBlock(in, offset, out, coder)
+}
+
 func DecodeColumnHeader(col *record.ColVal, data []byte, colType uint8) ([]byte, []byte, error) {
 	typ := data[0]
 	if encoding.IsBlockFull(data[0]) {
@@ -41,18 +41,19 @@ const (
 )
 
 var (
-	fullCompactingCount   int64
-	maxFullCompactor      = cpu.GetCpuNum() / 2
-	maxCompactor          = cpu.GetCpuNum()
-	compLimiter           = limiter.NewFixed(maxCompactor)
-	ErrCompStopped        = errors.New("compact stopped")
-	ErrDownSampleStopped  = errors.New("downSample stopped")
-	ErrDroppingMst        = errors.New("measurement is dropped")
-	ErrParquetStopped     = errors.New("parquet task stopped")
-	LevelCompactRule      = []uint16{0, 1, 0, 2, 0, 3, 0, 1, 2, 3, 0, 4, 0, 5, 0, 1, 2, 6}
-	LevelCompactRuleForCs = []uint16{0, 1, 0, 1, 0, 1} // columnStore currently only doing level 0 and level 1 compaction,but the full functionality is available
-	LeveLMinGroupFiles    = [CompactLevels]int{8, 4, 4, 4, 4, 4, 2}
-	compLogSeq            = uint64(time.Now().UnixNano())
+	fullCompactingCount    int64
+	maxFullCompactor       = cpu.GetCpuNum() / 2
+	maxCompactor           = cpu.GetCpuNum()
+	compLimiter            = limiter.NewFixed(maxCompactor)
+	ErrCompStopped         = errors.New("compact stopped")
+	ErrTombstonesNotPurged = errors.New("deleted rows of the files are not purged")
+	ErrDownSampleStopped   = errors.New("downSample stopped")
+	ErrDroppingMst         = errors.New("measurement is dropped")
+	ErrParquetStopped      = errors.New("parquet task stopped")
+	LevelCompactRule       = []uint16{0, 1, 0, 2, 0, 3, 0, 1, 2, 3, 0, 4, 0, 5, 0, 1, 2, 6}
+	LevelCompactRuleForCs  = []uint16{0, 1, 0, 1, 0, 1} // columnStore currently only doing level 0 and level 1 compaction,but the full functionality is available
+	LeveLMinGroupFiles     = [CompactLevels]int{8, 4, 4, 4, 4, 4, 2}
+	compLogSeq             = uint64(time.Now().UnixNano())
 
 	EnableMergeOutOfOrder = true
 	log                   = Log.GetLogger()
@@ -267,6 +268,29 @@ func (m *MmsTables) FullyCompacted() bool {
 	return m.ImmTable.FullyCompacted(m)
 }
 
+// CompactionProgress returns the number of measurements that have been fully compacted
+// without any out-of-order file left, and the total number of measurements
+func (m *MmsTables) CompactionProgress() (int, int) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	done, total := 0, len(m.Order)
+	for name, files := range m.Order {
+		if unordered, ok := m.OutOfOrder[name]; ok && unordered.Len() > 0 {
+			continue
+		}
+		if files.fullCompacted() {
+			done++
+		}
+	}
+	for name, unordered := range m.OutOfOrder {
+		if _, ok := m.Order[name]; !ok && unordered.Len() > 0 {
+			total++
+		}
+	}
+	return done, total
+}
+
 func (m *MmsTables) FreeSequencer() bool {
 	if !m.ImmTable.FullyCompacted(m) {
 		return false
@@ -439,13 +463,20 @@ func (m *MmsTables) RenameFileToLevel(plan *CompactGroup) error {
 		return err
 	}
 	file := files.files[0]
+	if fs, ok := m.getTSSPFiles(plan.name, true); ok && fs != nil {
+		// the tombstones are not added while the file is renamed
+		fs.lock.Lock()
+		defer fs.lock.Unlock()
+	}
+	oldKey := m.tombstones.fileKey(file)
 	tsspFileName := file.FileName()
 	tsspFileName.level = plan.toLevel
 	err = file.Rename(tsspFileName.Path(path.Dir(file.Path()), false))
-	if err == nil {
-		file.UpdateLevel(plan.toLevel)
+	if err != nil {
+		return err
 	}
-	return err
+	file.UpdateLevel(plan.toLevel)
+	return m.tombstones.RenameFile(oldKey, file)
 }
 
 func (m *MmsTables) buildCompactTasks(plans []*CompactGroup, full bool, shardId uint64) []scheduler.Task {
@@ -3386,6 +3386,55 @@ func TestCompactLog_AllNewFileExist2(t *testing.T) {
 	}
 }
 
+func TestCompactLog_OldFilesWithTombstones(t *testing.T) {
+	testCompDir := t.TempDir()
+	defer fileops.RemoveAll(testCompDir)
+
+	allFiles := []string{
+		"000000001-0000-0000.tssp", "000000002-0000-0000.tssp",
+		"000000003-0000-0000.tssp", "000000004-0000-0000.tssp",
+		"000000005-0000-0000.tssp", "000000006-0000-0000.tssp",
+	}
+
+	info := &CompactedFileInfo{
+		Name:    "mst1",
+		IsOrder: true,
+		OldFile: allFiles[:4],
+		NewFile: []string{"000000001-0001-0000.tssp.init"},
+	}
+
+	dir := filepath.Join(testCompDir, TsspDirName, "mst1")
+	mustTouchFiles(dir, allFiles[4:])
+	oldFiles := mustCreateTsspFiles(dir, info.OldFile)
+	newFiles := mustCreateTsspFiles(dir, info.NewFile)
+	defer func() {
+		mustCloseTsspFiles(oldFiles)
+		mustCloseTsspFiles(newFiles)
+	}()
+
+	lockPath := ""
+	store := &MmsTables{lock: &lockPath}
+	_, err := store.writeCompactedFileInfo(info.Name, oldFiles, newFiles, testCompDir, info.IsOrder)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the rows of an old file are deleted during the compaction, the new file is discarded
+	tombstones := NewTombstoneSet(filepath.Join(testCompDir, TsspDirName), &lockPath)
+	if err = tombstones.Add(NewTombstone("mst1", []uint64{1}, 0, 100), oldFiles[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if err = recoverFile(testCompDir, &lockPath, config.TSSTORE, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	files := filesInDir(dir)
+	sort.Strings(files)
+	if !reflect.DeepEqual(files, allFiles) {
+		t.Fatalf("processLog error, exp:%v, get:%v", allFiles, files)
+	}
+}
+
 func TestCompactLog_NewFileNotExit1(t *testing.T) {
 	testCompDir := t.TempDir()
 	defer fileops.RemoveAll(testCompDir)
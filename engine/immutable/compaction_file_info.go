@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync/atomic"
 
 	"github.com/openGemini/openGemini/lib/bufferpool"
@@ -247,6 +248,11 @@ func processLog(shardDir string, info *CompactedFileInfo, lockPath *string, engi
 		}
 	}
 
+	// the tombstones added to the old files during the compaction do not apply to the new files
+	if n == len(info.NewFile) && allOldFilesExist(info, oldFileExist) && hasCompactedTombstones(shardDir, info) {
+		return rollbackCompactedFiles(info, renameFile, mmDir, lockPath)
+	}
+
 	if n != len(info.NewFile) {
 		count := 0
 		for i := range info.OldFile {
@@ -280,6 +286,33 @@ func processLog(shardDir string, info *CompactedFileInfo, lockPath *string, engi
 	return nil
 }
 
+func allOldFilesExist(info *CompactedFileInfo, oldFileExist func(string) bool) bool {
+	for i := range info.OldFile {
+		if !oldFileExist(info.OldFile[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// rollbackCompactedFiles removes the new files and restores the old files of the compact log
+func rollbackCompactedFiles(info *CompactedFileInfo, renameFile func(nameInLog string) error, mmDir string, lockPath *string) error {
+	lock := fileops.FileLockOption(*lockPath)
+	for _, name := range info.NewFile {
+		for _, fName := range []string{name, strings.TrimSuffix(name, tmpFileSuffix)} {
+			if err := fileops.Remove(filepath.Join(mmDir, fName), lock); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	for _, name := range info.OldFile {
+		if err := renameFile(name + tmpFileSuffix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getProcessLogFuncs(dirs []os.FileInfo, mmDir string, lockPath *string) (func(string) bool, func(string) bool, func(string) error) {
 	newFileExist := func(newFile string) bool {
 		normalName := newFile[:len(newFile)-len(tmpFileSuffix)]
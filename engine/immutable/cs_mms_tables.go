@@ -455,6 +455,29 @@ func (c *csImmTableImpl) replaceIndexFiles(m *MmsTables, name string, oldIndexFi
 	return nil
 }
 
+// removeIndexFiles removes the primary index and the skip index files of the data file removed
+func (c *csImmTableImpl) removeIndexFiles(m *MmsTables, name string, dataPath string) error {
+	pkFileName := colstore.AppendPKIndexSuffix(RemoveTsspSuffix(dataPath))
+	if pkFiles, ok := m.getPKFiles(name); ok && pkFiles != nil {
+		m.mu.Lock()
+		pkFiles.DelPKInfo(pkFileName)
+		m.mu.Unlock()
+	}
+	files := []string{pkFileName}
+	if mstInfo, ok := c.GetMstInfo(name); ok {
+		for _, col := range mstInfo.IndexRelation.GetBloomFilterColumns() {
+			files = append(files, dataPath[:len(dataPath)-tsspFileSuffixLen]+"."+col+colstore.BloomFilterIndexFileSuffix)
+		}
+	}
+	lock := fileops.FileLockOption(*m.lock)
+	for _, f := range files {
+		if err := fileops.Remove(f, lock); err != nil && !os.IsNotExist(err) {
+			return errRemoveFail(f, err)
+		}
+	}
+	return nil
+}
+
 func (c *csImmTableImpl) ReplaceFiles(m *MmsTables, name string, oldFiles, newFiles []TSSPFile, isOrder bool, iList []string) (err error) {
 	if len(newFiles) == 0 || len(oldFiles) == 0 {
 		return nil
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"hash/crc32"
 
+	"github.com/openGemini/openGemini/lib/encoding"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/obs"
 	"github.com/openGemini/openGemini/lib/record"
@@ -37,6 +38,12 @@ type DetachedMetaDataReader struct {
 	r           fileops.BasicFileReader
 	task        map[int]*SegmentTask
 	positionMap map[int64]*position
+
+	filterOpts  *FilterOptions
+	dictFilter  *stringDictFilter
+	dictSegment int
+	dictSchema  record.Schemas
+	dictCtx     *encoding.CoderContext
 }
 
 func NewDetachedMetaDataReader(path string, obsOpts *obs.ObsOptions, isSort bool) (*DetachedMetaDataReader, error) {
@@ -112,14 +119,34 @@ func (reader *DetachedMetaDataReader) InitReadBatch(s []*SegmentMeta, schema rec
 	reader.ch = make(chan *request.StreamReader, 1)
 	reader.r.StreamReadBatch(offset, length, reader.ch, MetaIndexSegmentNum, true)
 }
+
+// SetFilterOptions sets the condition to skip the segments by the dictionaries of their string columns
+func (reader *DetachedMetaDataReader) SetFilterOptions(filterOpts *FilterOptions) {
+	reader.filterOpts = filterOpts
+}
+
 func (reader *DetachedMetaDataReader) ReadBatch(dst *record.Record, decs *ReadContext) (*record.Record, error) {
 	schema := dst.Schema
-	timeIndex := schema.Len() - 1
+	for {
+		finishSegment, err := reader.nextSegment()
+		if err != nil || finishSegment == -1 {
+			return nil, err
+		}
+		if reader.skipByStringDict(finishSegment, schema, decs) {
+			delete(reader.task, finishSegment)
+			continue
+		}
+		return reader.decodeSegment(finishSegment, dst, decs)
+	}
+}
+
+// nextSegment returns the segment whose columns are all read, -1 if all segments are read
+func (reader *DetachedMetaDataReader) nextSegment() (int, error) {
 	finishSegment := -1
 	var err error
 	for r := range reader.ch {
 		if r.Err != nil {
-			return nil, r.Err
+			return -1, r.Err
 		}
 		if len(r.Content) < crcSize {
 			err = fmt.Errorf("write wrong data")
@@ -144,14 +171,17 @@ func (reader *DetachedMetaDataReader) ReadBatch(dst *record.Record, decs *ReadCo
 	if err != nil {
 		for range reader.ch {
 		}
-		return nil, err
+		return -1, err
 	}
-	if finishSegment == -1 {
-		if len(reader.task) != 0 {
-			log.Error("read loss data fail", zap.String("file", reader.r.Name()))
-		}
-		return nil, nil
+	if finishSegment == -1 && len(reader.task) != 0 {
+		log.Error("read loss data fail", zap.String("file", reader.r.Name()))
 	}
+	return finishSegment, nil
+}
+
+func (reader *DetachedMetaDataReader) decodeSegment(finishSegment int, dst *record.Record, decs *ReadContext) (*record.Record, error) {
+	schema := dst.Schema
+	timeIndex := schema.Len() - 1
 	for _, v := range reader.task[finishSegment].data {
 		if v == nil {
 			continue
@@ -0,0 +1,86 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/crypto"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadEncryptedTSSPFile(t *testing.T) {
+	root := t.TempDir()
+	keyFile := filepath.Join(root, "keys")
+	require.NoError(t, os.WriteFile(keyFile, []byte("* k1 "+strings.Repeat("01", crypto.DataKeySize)), 0600))
+	provider, err := crypto.NewKeyProvider(crypto.KeyProviderLocal, keyFile)
+	require.NoError(t, err)
+	fileops.EnableEncryption(provider, root)
+	defer fileops.DisableEncryption()
+
+	for _, mmap := range []bool{false, true} {
+		fileops.EnableMmapRead(mmap)
+		dir := filepath.Join(root, "db0", "0", "rp0", fmt.Sprintf("1_%v", mmap))
+		conf := NewTsStoreConfig()
+		tier := uint64(util.Hot)
+		lockPath := ""
+		store := NewTableStore(dir, &lockPath, &tier, false, conf)
+		store.SetImmTableType(config.TSSTORE)
+
+		schema := record.Schemas{
+			record.Field{Type: influx.Field_Type_String, Name: "host"},
+			record.Field{Type: influx.Field_Type_Int, Name: "time"},
+		}
+		rec := record.NewRecordBuilder(schema)
+		for i := 0; i < 100; i++ {
+			rec.ColVals[0].AppendString(fmt.Sprintf("secret_host_%d", i))
+			rec.ColVals[1].AppendInteger(int64(i + 1))
+		}
+
+		fileName := NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+		msb := NewMsBuilder(dir, "mst", &lockPath, conf, 1, fileName, 0, store.Sequencer(), 2, config.TSSTORE, nil, 0)
+		require.NoError(t, msb.WriteData(1, rec))
+		f, err := msb.NewTSSPFile(false)
+		require.NoError(t, err)
+		store.AddTSSPFiles(msb.Name(), true, f)
+
+		raw, err := os.ReadFile(f.Path())
+		require.NoError(t, err)
+		require.False(t, bytes.Contains(raw, []byte("secret_host")))
+
+		midx, _ := f.MetaIndexAt(0)
+		require.NotNil(t, midx)
+		cm, err := f.ChunkMeta(midx.id, midx.offset, midx.size, midx.count, 0, nil, fileops.IO_PRIORITY_LOW_READ)
+		require.NoError(t, err)
+
+		dst := record.NewRecordBuilder(schema)
+		dst, err = f.ReadAt(cm, 0, dst, NewReadContext(true), fileops.IO_PRIORITY_ULTRA_HIGH)
+		require.NoError(t, err)
+		require.Equal(t, rec.ColVals[0].StringValues(nil), dst.ColVals[0].StringValues(nil))
+		require.Equal(t, rec.Times(), dst.Times())
+		store.Close()
+	}
+	fileops.EnableMmapRead(false)
+}
@@ -33,6 +33,8 @@ type Location struct {
 	segPos  int
 	fragPos int // Indicates the sequence number of a fragment range.
 	fragRgs []*fragment.FragmentRange
+
+	dictFilter *stringDictFilter
 }
 
 type ColAux struct {
@@ -274,7 +276,7 @@ func (l *Location) readData(filterOpts *FilterOptions, dst, filterRec *record.Re
 			return nil, oriRowCount, nil
 		}
 		if (!l.ctx.tr.Overlaps(l.getCurSegMinMax())) ||
-			(!l.overlapsForRowFilter(filterOpts.rowFilters)) {
+			(!l.overlapsForRowFilter(filterOpts.rowFilters)) || l.skipByStringDict(filterOpts) {
 			l.nextSegment(false)
 			continue
 		}
@@ -304,6 +306,11 @@ func (l *Location) readData(filterOpts *FilterOptions, dst, filterRec *record.Re
 				}
 			}
 
+			// filter the deleted rows not purged yet
+			if rec != nil {
+				rec = FilterByTombstones(rec, l.meta.sid, FileTombstones(l.r))
+			}
+
 			// filter by field
 			if rec != nil {
 				rec = FilterByField(rec, filterRec, filterOpts.options, filterOpts.cond, filterOpts.rowFilters, filterOpts.pointTags, filterBitmap, &filterOpts.colAux)
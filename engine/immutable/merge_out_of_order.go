@@ -77,6 +77,10 @@ func (m *MmsTables) mergeOutOfOrder(mst string, shId uint64, full bool, force bo
 			m.logger.Info("in parquet process skip merge", zap.String("mst", item.mst))
 			continue
 		}
+		if m.tombstones.HasPaths(item.unordered.path) {
+			m.logger.Info("deleted rows of the unordered files are not purged, skip merge", zap.String("mst", item.mst))
+			continue
+		}
 
 		m.lmt.Update(mst)
 		item.shId = shId
@@ -132,7 +136,9 @@ func (m *MmsTables) Listen(signal chan struct{}, onClose func()) {
 	}()
 }
 
-func (m *MmsTables) replaceMergedFiles(name string, lg *zap.Logger, old []TSSPFile, new []TSSPFile) error {
+func (m *MmsTables) replaceMergedFiles(name string, lg *zap.Logger, old []TSSPFile, new []TSSPFile, unordered []TSSPFile) error {
+	// the merged files contain the rows of all the order files and the unordered files
+	rt := &replaceTombstones{sources: append(append([]TSSPFile{}, old...), unordered...)}
 	needReplaced := make(map[string]TSSPFile, len(old))
 	for _, of := range old {
 		for _, nf := range new {
@@ -157,7 +163,7 @@ func (m *MmsTables) replaceMergedFiles(name string, lg *zap.Logger, old []TSSPFi
 			zap.Int64("new size", new[len(old)-1].FileSize()))
 	}
 
-	return m.ReplaceFiles(name, old, new, true)
+	return m.replaceFiles(name, old, new, true, rt)
 }
 
 func (m *MmsTables) getFilesByPath(mst string, path []string, order bool) (*TSSPFiles, error) {
@@ -226,6 +232,9 @@ func (m *MmsTables) buildMergeContext(mst string, full bool, force bool) []*Merg
 		return []*MergeContext{buildNormalMergeContext(mst, files)}
 	}
 
+	if window := config.GetStoreConfig().Merge.OutOfOrderWindowOf(m.db); window > 0 && !full {
+		return BuildWindowMergeContext(mst, files, window, m.lmt)
+	}
 	return BuildMergeContext(mst, files, full, m.lmt)
 }
 
@@ -281,6 +290,8 @@ func (m *MmsTables) matchOrderFiles(ctx *MergeContext) {
 	files.lock.RLock()
 	defer files.lock.RUnlock()
 
+	var matched []TSSPFile
+	var minTimes []int64
 	for _, f := range files.Files() {
 		if m.isClosed() {
 			return
@@ -290,10 +301,22 @@ func (m *MmsTables) matchOrderFiles(ctx *MergeContext) {
 			continue
 		}
 
-		if ctx.order.Len() > 0 || ctx.tr.Overlaps(min, max) || min > ctx.tr.Max {
-			ctx.order.add(f)
+		if len(matched) > 0 || ctx.tr.Overlaps(min, max) || min > ctx.tr.Max {
+			matched = append(matched, f)
+			minTimes = append(minTimes, min)
+		}
+	}
+
+	if ctx.overlappedOnly {
+		// the data of every series in the later files is newer than all the unordered data,
+		// skipping them keeps the data of each series ordered by the file sequence
+		for len(matched) > 1 && minTimes[len(matched)-1] > ctx.tr.Max {
+			matched = matched[:len(matched)-1]
 		}
 	}
+	for _, f := range matched {
+		ctx.order.add(f)
+	}
 
 	if ctx.order.Len() == 0 {
 		ctx.order.add(files.Files()[files.Len()-1])
@@ -320,6 +343,9 @@ func (m *MmsTables) deleteUnorderedFiles(mst string, files []TSSPFile) {
 			sort.Sort(tfs)
 		}
 	}()
+	if err := m.tombstones.purgeKeys(m.tombstones.fileKeys(files)); err != nil {
+		m.logger.Error("purge tombstones fail", zap.String("name", mst), zap.Error(err))
+	}
 
 	if !noFiles {
 		return
@@ -70,6 +70,11 @@ func (mt *mergeTool) mergePrepare(ctx *MergeContext) bool {
 		mt.zlg.Warn("acquire is false, skip merge")
 		return false
 	}
+	if mt.mts.tombstones.HasPaths(ctx.order.path) {
+		mt.mts.CompactDone(ctx.order.path)
+		mt.zlg.Info("deleted rows of the order files are not purged, skip merge")
+		return false
+	}
 
 	return true
 }
@@ -109,7 +114,7 @@ func (mt *mergeTool) merge(ctx *MergeContext) {
 		}
 
 		mt.stat.StatMergedFile(SumFilesSize(mergedFiles.Files()), mergedFiles.Len())
-		if err := mt.mts.replaceMergedFiles(ctx.mst, mt.zlg, order.Files(), mergedFiles.Files()); err != nil {
+		if err := mt.mts.replaceMergedFiles(ctx.mst, mt.zlg, order.Files(), mergedFiles.Files(), unordered.Files()); err != nil {
 			mt.zlg.Error("failed to replace merged files", zap.Error(err))
 			return
 		}
@@ -294,7 +299,8 @@ func (mt *mergeTool) mergeSelfStreamMode(ctx *MergeContext) {
 		}
 
 		mt.stat.StatMergedFile(SumFilesSize(mergedFiles.Files()), mergedFiles.Len())
-		if err := mt.mts.ReplaceFiles(ctx.mst, order.Files(), mergedFiles.Files(), false); err != nil {
+		rt := &replaceTombstones{sources: unordered.Files()}
+		if err := mt.mts.replaceFiles(ctx.mst, order.Files(), mergedFiles.Files(), false, rt); err != nil {
 			mt.zlg.Error("failed to replace merged files", zap.Error(err))
 			return
 		}
@@ -22,6 +22,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/influxdata/influxdb/toml"
 	"github.com/openGemini/openGemini/engine/immutable"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/fileops"
@@ -606,6 +607,48 @@ func TestMergeTool_Merge_mod18(t *testing.T) {
 	}
 }
 
+// only the ordered files overlapping the out-of-order window are merged
+// | --order file 1--  --order file 2--                      --order file 3--
+// |      --unordered--
+func TestMergeTool_OutOfOrderWindow(t *testing.T) {
+	var begin int64 = 1e12
+	defer beforeTest(t, 0)()
+	conf := &config.GetStoreConfig().Merge
+	conf.DatabaseOutOfOrderWindows = map[string]toml.Duration{"db0": toml.Duration(100 * defaultInterval)}
+	defer func() {
+		conf.DatabaseOutOfOrderWindows = nil
+	}()
+
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	mh.store.SetDatabase("db0")
+	rg := newRecordGenerator(begin, defaultInterval, true)
+
+	for _, sid := range []uint64{100, 200} {
+		mh.addRecord(sid, rg.generate(getDefaultSchemas(), 10))
+		rg.incrBegin(10)
+		require.NoError(t, mh.saveToOrder())
+	}
+
+	rg.setBegin(begin).incrBegin(1000)
+	for _, sid := range []uint64{100, 200} {
+		mh.addRecord(sid, rg.generate(getDefaultSchemas(), 10))
+	}
+	require.NoError(t, mh.saveToOrder())
+	lastFile := mh.store.Order["mst"].Files()[2]
+
+	rg.setBegin(begin).incrBegin(5)
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToUnordered())
+
+	require.NoError(t, mh.store.MergeOutOfOrder(1, false, false))
+	mh.store.Wait()
+	require.Equal(t, 0, mh.store.GetOutOfOrderFileNum())
+	require.Equal(t, 3, mh.store.Order["mst"].Len())
+	require.True(t, lastFile == mh.store.Order["mst"].Files()[2])
+	require.NoError(t, compareRecords(mh.readExpectRecord(), mh.readMergedRecord()))
+}
+
 func TestMergeTool_recentFile(t *testing.T) {
 	var begin int64 = 1e12
 	defer beforeTest(t, 0)()
@@ -47,6 +47,9 @@ type MergeContext struct {
 	tr        util.TimeRange
 	order     *mergeFileInfo
 	unordered *mergeFileInfo
+
+	// only the ordered files overlapping the time range of the unordered files are merged
+	overlappedOnly bool
 }
 
 func (ctx *MergeContext) reset() {
@@ -54,6 +57,7 @@ func (ctx *MergeContext) reset() {
 	ctx.shId = 0
 	ctx.tr.Min = math.MaxInt64
 	ctx.tr.Max = math.MinInt64
+	ctx.overlappedOnly = false
 	ctx.order.reset()
 	ctx.unordered.reset()
 }
@@ -169,6 +173,84 @@ func BuildMergeContext(mst string, files *TSSPFiles, full bool, lmt *lastMergeTi
 	return ret
 }
 
+// BuildWindowMergeContext builds the merge contexts of the unordered files partitioned by the out-of-order window.
+// The unordered files of each window are merged self by level first, and then merged with the overlapping ordered files
+func BuildWindowMergeContext(mst string, files *TSSPFiles, window int64, lmt *lastMergeTime) []*MergeContext {
+	files.RLock()
+	defer files.RUnlock()
+
+	if files.Len() == 0 || files.closing > 0 {
+		return nil
+	}
+
+	var ret []*MergeContext
+	var callback = func(ctx *MergeContext) {
+		if ctx != nil && ctx.UnorderedLen() > 0 {
+			ret = append(ret, ctx)
+		}
+	}
+
+	windows := splitFilesByWindow(files.Files(), window)
+	conf := config.GetStoreConfig()
+	for _, wf := range windows {
+		for i := uint16(0); i < conf.Merge.MaxMergeSelfLevel; i++ {
+			buildLevelMergeContext(mst, wf, i, callback)
+		}
+	}
+
+	if len(ret) == 0 &&
+		(files.MergedLevelCount(conf.Merge.MaxMergeSelfLevel) >= DefaultLevelMergeFileNum ||
+			!lmt.Nearly(mst, time.Duration(conf.Merge.MinInterval))) {
+		for _, wf := range windows {
+			ctx := buildNormalMergeContext(mst, wf)
+			ctx.overlappedOnly = true
+			ret = append(ret, ctx)
+		}
+	}
+
+	return ret
+}
+
+// splitFilesByWindow groups the files by the out-of-order window of the min time, in the order of the windows
+func splitFilesByWindow(files []TSSPFile, window int64) []*TSSPFiles {
+	groups := make(map[int64]*TSSPFiles)
+	var keys []int64
+	for _, f := range files {
+		minTime, _, err := f.MinMaxTime()
+		if err != nil {
+			log.Error("failed to get min, max time", zap.String("file", f.Path()), zap.Error(err))
+			continue
+		}
+
+		key := OutOfOrderWindowKey(minTime, window)
+		group, ok := groups[key]
+		if !ok {
+			group = &TSSPFiles{}
+			groups[key] = group
+			keys = append(keys, key)
+		}
+		group.files = append(group.files, f)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+	ret := make([]*TSSPFiles, 0, len(keys))
+	for _, key := range keys {
+		ret = append(ret, groups[key])
+	}
+	return ret
+}
+
+// OutOfOrderWindowKey returns the index of the out-of-order window which the time belongs to
+func OutOfOrderWindowKey(tm int64, window int64) int64 {
+	key := tm / window
+	if tm%window < 0 {
+		key--
+	}
+	return key
+}
+
 func buildUnorderedOnlyMergeContext(mst string, files *TSSPFiles, callback func(ctx *MergeContext)) {
 	maxLevel := files.MaxMerged()
 
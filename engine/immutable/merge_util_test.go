@@ -213,3 +213,16 @@ func TestBuildMergeContext(t *testing.T) {
 	arr := immutable.BuildMergeContext("mst", files, false, immutable.NewLastMergeTime())
 	require.Equal(t, 0, len(arr))
 }
+
+func TestOutOfOrderWindowKey(t *testing.T) {
+	require.Equal(t, int64(0), immutable.OutOfOrderWindowKey(0, 10))
+	require.Equal(t, int64(0), immutable.OutOfOrderWindowKey(9, 10))
+	require.Equal(t, int64(1), immutable.OutOfOrderWindowKey(10, 10))
+	require.Equal(t, int64(-1), immutable.OutOfOrderWindowKey(-1, 10))
+	require.Equal(t, int64(-1), immutable.OutOfOrderWindowKey(-10, 10))
+	require.Equal(t, int64(-2), immutable.OutOfOrderWindowKey(-11, 10))
+
+	files := &immutable.TSSPFiles{}
+	arr := immutable.BuildWindowMergeContext("mst", files, 10, immutable.NewLastMergeTime())
+	require.Equal(t, 0, len(arr))
+}
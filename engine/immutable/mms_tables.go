@@ -84,6 +84,11 @@ type TablesStore interface {
 	GetTableFileNum(string, bool) int
 	GetMstFileStat() *stats.FileStat
 	DropMeasurement(ctx context.Context, name string) error
+	AddTombstone(mst string, t *Tombstone) error
+	DropExpiredFiles(mst string, maxTime int64) (int, error)
+	HasTombstones() bool
+	PurgeTombstones() error
+	RepairFile(mst string, f TSSPFile, recs map[uint64]*record.Record) (*TSSPSalvageResult, error)
 	GetFileSeq() uint64
 	DisableCompAndMerge()
 	EnableCompAndMerge()
@@ -95,6 +100,7 @@ type TablesStore interface {
 	SeriesTotal() uint64
 	SetLockPath(lock *string)
 	FullyCompacted() bool
+	CompactionProgress() (int, int)
 	SetObsOption(option *obs.ObsOptions)
 	GetObsOption() *obs.ObsOptions
 	GetShardID() uint64
@@ -127,6 +133,7 @@ type MmsTables struct {
 	wg   sync.WaitGroup
 	path string
 	lock *string
+	db   string
 
 	shardId uint64 // this is only to track MmsTables open duration
 	opId    uint64 // this is only to track MmsTables open duration
@@ -159,6 +166,8 @@ type MmsTables struct {
 
 	indexMergeSet IndexMergeSet
 	scheduler     *scheduler.TaskScheduler
+
+	tombstones *TombstoneSet
 }
 
 func NewTableStore(dir string, lock *string, tier *uint64, compactRecovery bool, config *Config) *MmsTables {
@@ -182,6 +191,7 @@ func NewTableStore(dir string, lock *string, tier *uint64, compactRecovery bool,
 		compactRecovery: compactRecovery,
 		Conf:            config,
 		logger:          logger.NewLogger(errno.ModuleShard),
+		tombstones:      NewTombstoneSet(dir, lock),
 	}
 	store.scheduler = scheduler.NewTaskScheduler(store.Listen, compLimiter)
 	return store
@@ -195,6 +205,11 @@ func (m *MmsTables) SetImmTableType(engineType config.EngineType) {
 	}
 }
 
+// SetDatabase sets the database of the shard, which decides the out-of-order window of the merge
+func (m *MmsTables) SetDatabase(db string) {
+	m.db = db
+}
+
 func (m *MmsTables) SetMstInfo(name string, mstInfo *meta.MeasurementInfo) {
 	m.ImmTable.SetMstInfo(name, mstInfo)
 }
@@ -357,6 +372,9 @@ func (m *MmsTables) Open() (int64, error) {
 	tm = time.Now()
 	m.sortTSSPFiles()
 	stats.ShardStepDuration(m.shardId, m.opId, "SortTSSPFileDuration", time.Since(tm).Nanoseconds(), false)
+	if err = m.tombstones.Load(m); err != nil {
+		lg.Error("load tombstones failed", zap.Error(err))
+	}
 
 	lg.Info("table store open done",
 		zap.Int("file count", loader.total), zap.Duration("time used", time.Since(start)),
@@ -674,6 +692,10 @@ func (m *MmsTables) DropMeasurement(_ context.Context, name string) error {
 	m.sequencer.DelMmsIdTime(name)
 	m.mu.Unlock()
 
+	if err = m.tombstones.DropMeasurement(name); err != nil {
+		log.Error("drop measurement tombstones fail", zap.String("name", name), zap.Error(err))
+	}
+
 	mmsDir := filepath.Join(m.path, name)
 	lockFile := fileops.FileLockOption(*m.lock)
 	_ = fileops.RemoveAll(mmsDir, lockFile)
@@ -768,9 +790,34 @@ func (m *MmsTables) AddTable(mb *MsBuilder, isOrder bool, tmp bool) {
 }
 
 func (m *MmsTables) ReplaceFiles(name string, oldFiles, newFiles []TSSPFile, isOrder bool) (err error) {
+	return m.replaceFiles(name, oldFiles, newFiles, isOrder, nil)
+}
+
+// replaceTombstones describes the tombstones of the files rewritten into the new files.
+// The compaction and the merge do not remove the deleted rows, so none of the files they read may have
+// tombstones, as the tombstones are not applied to the new files which contain the rows of other files.
+type replaceTombstones struct {
+	// the new files are rewritten from a single old file, and inherit its tombstones added after seq
+	inherit bool
+	seq     uint64
+	// the files read by the merge besides the old files, which are removed after the old files are replaced
+	sources []TSSPFile
+}
+
+func (m *MmsTables) replaceFiles(name string, oldFiles, newFiles []TSSPFile, isOrder bool, rt *replaceTombstones) (err error) {
 	if len(newFiles) == 0 || len(oldFiles) == 0 {
 		return nil
 	}
+	if rt == nil {
+		rt = &replaceTombstones{}
+	}
+	// the keys must be read before the old files are removed
+	oldKeys := m.tombstones.fileKeys(oldFiles)
+	checkKeys := append(m.tombstones.fileKeys(rt.sources), oldKeys...)
+	if !rt.inherit && m.tombstones.HasKeys(checkKeys) {
+		m.discardNewFiles(name, newFiles, "")
+		return ErrTombstonesNotPurged
+	}
 
 	defer func() {
 		if e := recover(); e != nil {
@@ -806,6 +853,11 @@ func (m *MmsTables) ReplaceFiles(name string, oldFiles, newFiles []TSSPFile, isO
 
 	fs.lock.Lock()
 	defer fs.lock.Unlock()
+	// the tombstones are added to the files with the lock of the files held
+	if !rt.inherit && m.tombstones.HasKeys(checkKeys) {
+		m.discardNewFiles(name, newFiles, logFile)
+		return ErrTombstonesNotPurged
+	}
 	// remove old files
 	for _, f := range oldFiles {
 		if m.isClosed() || m.isCompMergeStopped() {
@@ -820,6 +872,15 @@ func (m *MmsTables) ReplaceFiles(name string, oldFiles, newFiles []TSSPFile, isO
 	fs.files = append(fs.files, newFiles...)
 	sort.Sort(fs)
 
+	if rt.inherit {
+		if err = m.tombstones.Inherit(oldKeys, newFiles, rt.seq); err != nil {
+			m.logger.Error("inherit tombstones fail", zap.String("name", name), zap.String("dir", shardDir), zap.Error(err))
+		}
+	}
+	if err = m.tombstones.purgeKeys(oldKeys); err != nil {
+		m.logger.Error("purge tombstones fail", zap.String("name", name), zap.String("dir", shardDir), zap.Error(err))
+	}
+
 	lock := fileops.FileLockOption(*m.lock)
 	if err = fileops.Remove(logFile, lock); err != nil {
 		m.logger.Error("remove compact log file error", zap.String("name", name), zap.String("dir", shardDir),
@@ -829,6 +890,22 @@ func (m *MmsTables) ReplaceFiles(name string, oldFiles, newFiles []TSSPFile, isO
 	return
 }
 
+// discardNewFiles removes the new files which do not replace the old files
+func (m *MmsTables) discardNewFiles(name string, newFiles []TSSPFile, logFile string) {
+	for _, f := range newFiles {
+		if err := f.Remove(); err != nil {
+			m.logger.Error("remove new file fail", zap.String("name", name), zap.String("file", f.Path()), zap.Error(err))
+		}
+	}
+	if len(logFile) == 0 {
+		return
+	}
+	lock := fileops.FileLockOption(*m.lock)
+	if err := fileops.Remove(logFile, lock); err != nil {
+		m.logger.Error("remove compact log file error", zap.String("name", name), zap.String("log", logFile), zap.Error(err))
+	}
+}
+
 func (m *MmsTables) ReplaceDownSampleFiles(mstNames []string, originFiles [][]TSSPFile, newFiles [][]TSSPFile, isOrder bool, callBack func()) (err error) {
 	for k := range mstNames {
 		if err := RenameTmpFiles(newFiles[k]); err != nil {
@@ -1191,6 +1268,9 @@ func (m *MmsTables) NewStreamWriteFile(mst string) *StreamWriteFile {
 
 func (m *MmsTables) SetLockPath(lock *string) {
 	m.lock = lock
+	if m.tombstones != nil {
+		m.tombstones.lock = lock
+	}
 }
 
 func (m *MmsTables) GetTableFileNum(name string, order bool) int {
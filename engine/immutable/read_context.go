@@ -42,6 +42,7 @@ type ReadContext struct {
 	readSpan     *tracing.Span
 	filterSpan   *tracing.Span
 	closedSignal *bool
+	coldStat     *ColdReadStat
 }
 
 func NewReadContext(ascending bool) *ReadContext {
@@ -73,6 +74,14 @@ func (d *ReadContext) SetSpan(readSpan, filterSpan *tracing.Span) {
 	d.filterSpan = filterSpan
 }
 
+func (d *ReadContext) SetColdReadStat(s *ColdReadStat) {
+	d.coldStat = s
+}
+
+func (d *ReadContext) ColdReadStat() *ColdReadStat {
+	return d.coldStat
+}
+
 func (d *ReadContext) GetOps() []*comm.CallOption {
 	return d.ops
 }
@@ -1175,7 +1175,7 @@ func (b *ColumnBuilder) encodeColumn(segCols []record.ColVal, tmCols []record.Co
 
 		switch ref.Type {
 		case influx.Field_Type_String:
-			b.data, err = encoding.EncodeStringBlock(segCol.Val, segCol.Offset, b.data, b.coder)
+			b.data, err = encodeStringBlock(segCol.Val, segCol.Offset, b.data, b.coder)
 			if len(tmCols) != 0 {
 				b.stringPreAggBuilder.addValues(segCol, tmCols[i].IntegerValues())
 			}
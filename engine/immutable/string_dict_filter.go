@@ -0,0 +1,252 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"github.com/openGemini/openGemini/lib/encoding"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// segmentDict is the dictionary of a string column in the current segment
+type segmentDict struct {
+	dict     encoding.StringDict
+	nilCount int
+}
+
+// stringDictFilter skips the segments whose string dictionaries can not match the condition,
+// only the dictionaries are decoded, the values of the skipped segments are never read.
+// The dictionaries of a segment that is not skipped are reused to decode its values
+type stringDictFilter struct {
+	dicts map[string]*segmentDict
+	pool  []*segmentDict
+	used  int
+	buf   []byte
+	col   record.ColVal
+
+	// tags are stored as columns if there is no point tag, such as column store
+	tagAsColumn bool
+	read        func(name string) *segmentDict
+}
+
+func (f *stringDictFilter) reset() {
+	for k := range f.dicts {
+		delete(f.dicts, k)
+	}
+	f.used = 0
+}
+
+func (f *stringDictFilter) alloc() *segmentDict {
+	if f.used == len(f.pool) {
+		f.pool = append(f.pool, &segmentDict{})
+	}
+	f.used++
+	return f.pool[f.used-1]
+}
+
+// lookup returns the dictionary of the column in the current segment,
+// nil if the column is not dictionary encoded
+func (f *stringDictFilter) lookup(ref *influxql.VarRef) *segmentDict {
+	switch ref.Type {
+	case influxql.String, influxql.Unknown:
+	case influxql.Tag:
+		if !f.tagAsColumn {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	d, ok := f.dicts[ref.Val]
+	if !ok {
+		d = f.read(ref.Val)
+		if f.dicts == nil {
+			f.dicts = make(map[string]*segmentDict)
+		}
+		f.dicts[ref.Val] = d
+	}
+	return d
+}
+
+// canSkip returns true only if none of the rows can match the condition
+func (f *stringDictFilter) canSkip(cond influxql.Expr) bool {
+	switch expr := cond.(type) {
+	case *influxql.ParenExpr:
+		return f.canSkip(expr.Expr)
+	case *influxql.BinaryExpr:
+		switch expr.Op {
+		case influxql.AND:
+			return f.canSkip(expr.LHS) || f.canSkip(expr.RHS)
+		case influxql.OR:
+			return f.canSkip(expr.LHS) && f.canSkip(expr.RHS)
+		case influxql.EQ, influxql.NEQ, influxql.EQREGEX, influxql.NEQREGEX:
+			return f.canSkipCompare(expr)
+		}
+	}
+	return false
+}
+
+func (f *stringDictFilter) canSkipCompare(expr *influxql.BinaryExpr) bool {
+	ref, ok := expr.LHS.(*influxql.VarRef)
+	lit := expr.RHS
+	if !ok {
+		ref, ok = expr.RHS.(*influxql.VarRef)
+		lit = expr.LHS
+	}
+	if !ok {
+		return false
+	}
+
+	switch v := lit.(type) {
+	case *influxql.StringLiteral:
+		// null values are stored as empty strings in the dictionary
+		if v.Val == "" || (expr.Op != influxql.EQ && expr.Op != influxql.NEQ) {
+			return false
+		}
+		d := f.lookup(ref)
+		if d == nil {
+			return false
+		}
+		val := util.Str2bytes(v.Val)
+		if expr.Op == influxql.EQ {
+			return !d.dict.Contains(val)
+		}
+		return d.nilCount == 0 && d.dict.Len() == 1 && string(d.dict.Get(0)) == v.Val
+	case *influxql.RegexLiteral:
+		if v.Val == nil || (expr.Op != influxql.EQREGEX && expr.Op != influxql.NEQREGEX) {
+			return false
+		}
+		d := f.lookup(ref)
+		if d == nil {
+			return false
+		}
+		for i := 0; i < d.dict.Len(); i++ {
+			matched := v.Val.Match(d.dict.Get(i))
+			if matched == (expr.Op == influxql.EQREGEX) {
+				return false
+			}
+		}
+		return expr.Op == influxql.EQREGEX || d.nilCount == 0
+	}
+	return false
+}
+
+// readStringDict reads the dictionary of the string column in the current segment
+func (l *Location) readStringDict(name string) *segmentDict {
+	f := l.dictFilter
+	idx := l.meta.columnIndex(&record.Field{Name: name, Type: influx.Field_Type_String})
+	if idx < 0 {
+		return nil
+	}
+
+	offset, size := l.meta.colMeta[idx].entries[l.segPos].offsetSize()
+	data, err := l.r.ReadData(offset, size, &f.buf, fileops.IO_PRIORITY_ULTRA_HIGH)
+	if err != nil {
+		return nil
+	}
+
+	d, err := f.decode(data, l.ctx.coderCtx)
+	if err != nil {
+		log.Warn("decode string dictionary failed", zap.String("file", l.r.Path()), zap.String("column", name), zap.Error(err))
+		return nil
+	}
+	return d
+}
+
+// decode decodes the dictionary of an encoded string column, nil is returned if the column is not dictionary encoded
+func (f *stringDictFilter) decode(data []byte, ctx *encoding.CoderContext) (*segmentDict, error) {
+	if len(data) == 0 || encoding.IsBlockOne(data[0]) || encoding.IsBlockEmpty(data[0]) {
+		return nil, nil
+	}
+	data, _, err := DecodeColumnHeader(&f.col, data, encoding.BlockString)
+	if err != nil || !encoding.IsStringDictBlock(data) {
+		return nil, nil
+	}
+
+	d := f.alloc()
+	d.nilCount = f.col.NilCount
+	if err = encoding.DecodeStringDict(data, &d.dict, ctx); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// skipByStringDict returns true if the current segment can be skipped by the dictionaries of its string columns
+func (l *Location) skipByStringDict(filterOpts *FilterOptions) bool {
+	if !canSkipByStringDict(filterOpts) || l.isPreAggRead() {
+		return false
+	}
+
+	if l.dictFilter == nil {
+		l.dictFilter = &stringDictFilter{read: l.readStringDict}
+	}
+	return l.dictFilter.skip(filterOpts, l.ctx.coderCtx)
+}
+
+func canSkipByStringDict(filterOpts *FilterOptions) bool {
+	return filterOpts != nil && filterOpts.cond != nil && (filterOpts.rowFilters == nil || len(*filterOpts.rowFilters) == 0)
+}
+
+func (f *stringDictFilter) skip(filterOpts *FilterOptions, ctx *encoding.CoderContext) bool {
+	f.tagAsColumn = filterOpts.pointTags == nil || len(*filterOpts.pointTags) == 0
+	f.reset()
+	// forget the dictionaries of the previous segment, the ones decoded below are reused to read the current segment
+	ctx.ResetStringDicts()
+	return f.canSkip(filterOpts.cond)
+}
+
+// readStringDict reads the dictionary of the string column in the segment streamed by the detached reader
+func (reader *DetachedMetaDataReader) readStringDict(name string) *segmentDict {
+	idx := reader.dictSchema.FieldIndex(name)
+	if idx < 0 || reader.dictSchema[idx].Type != influx.Field_Type_String {
+		return nil
+	}
+
+	for _, v := range reader.task[reader.dictSegment].data {
+		if v == nil {
+			continue
+		}
+		for _, index := range reader.positionMap[v.Offset].schemaIndex {
+			if index != idx {
+				continue
+			}
+			d, err := reader.dictFilter.decode(v.Content, reader.dictCtx)
+			if err != nil {
+				log.Warn("decode string dictionary failed", zap.String("file", reader.r.Name()), zap.String("column", name), zap.Error(err))
+				return nil
+			}
+			return d
+		}
+	}
+	return nil
+}
+
+// skipByStringDict returns true if the segment can be skipped by the dictionaries of its string columns.
+// The segment is already read, only the decoding of its values is saved
+func (reader *DetachedMetaDataReader) skipByStringDict(segment int, schema record.Schemas, decs *ReadContext) bool {
+	if !canSkipByStringDict(reader.filterOpts) {
+		return false
+	}
+
+	if reader.dictFilter == nil {
+		reader.dictFilter = &stringDictFilter{read: reader.readStringDict}
+	}
+	reader.dictSegment, reader.dictSchema, reader.dictCtx = segment, schema, decs.coderCtx
+	return reader.dictFilter.skip(reader.filterOpts, decs.coderCtx)
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/encoding"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/request"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringDictFilter_CanSkip(t *testing.T) {
+	dicts := map[string]*segmentDict{}
+	add := func(name string, nilCount int, values ...string) {
+		d := &segmentDict{nilCount: nilCount}
+		for _, v := range values {
+			d.dict.Offsets = append(d.dict.Offsets, uint32(len(d.dict.Values)))
+			d.dict.Values = append(d.dict.Values, v...)
+		}
+		dicts[name] = d
+	}
+	add("status", 0, "ok", "warn")
+	add("level", 0, "info")
+	add("region", 2, "", "cn")
+
+	f := &stringDictFilter{read: func(name string) *segmentDict {
+		return dicts[name]
+	}}
+
+	for cond, exp := range map[string]bool{
+		`status = 'ok'`:                          false,
+		`status = 'error'`:                       true,
+		`'error' = status`:                       true,
+		`status = ''`:                            false,
+		`status = 'error' OR status = 'fatal'`:   true,
+		`status = 'error' OR status = 'warn'`:    false,
+		`status = 'error' AND value > 1`:         true,
+		`(status = 'error' OR level = 'info')`:   false,
+		`level != 'info'`:                        true,
+		`status != 'ok'`:                         false,
+		`region != 'cn'`:                         false,
+		`status =~ /err.*/`:                      true,
+		`status =~ /o.*/`:                        false,
+		`level !~ /inf/`:                         true,
+		`region !~ /.*/`:                         false,
+		`host = 'a'`:                             false,
+		`value = 'a'`:                            false,
+		`status::tag = 'error'`:                  false,
+		`status::string = 'error' AND value > 1`: true,
+		`value > 1`:                              false,
+	} {
+		f.tagAsColumn = false
+		f.reset()
+		require.Equal(t, exp, f.canSkip(influxql.MustParseExpr(cond)), cond)
+	}
+
+	f.tagAsColumn = true
+	f.reset()
+	require.True(t, f.canSkip(influxql.MustParseExpr(`status::tag = 'error'`)))
+}
+
+func TestLocation_SkipByStringDict(t *testing.T) {
+	defer func(enabled bool) {
+		config.GetStoreConfig().StringDictEncoding = enabled
+	}(config.GetStoreConfig().StringDictEncoding)
+	config.GetStoreConfig().StringDictEncoding = true
+
+	dir := t.TempDir()
+	conf := NewTsStoreConfig()
+	conf.SetMaxRowsPerSegment(16)
+	tier := uint64(util.Hot)
+	lockPath := ""
+	store := NewTableStore(dir, &lockPath, &tier, false, conf)
+	store.SetImmTableType(config.TSSTORE)
+	defer store.Close()
+
+	// every segment has its own values of status
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_String, Name: "status"},
+		record.Field{Type: influx.Field_Type_Int, Name: "time"},
+	}
+	rec := record.NewRecordBuilder(schema)
+	segments := 4
+	for i := 0; i < segments*16; i++ {
+		seg := i / 16
+		if i%8 == 0 {
+			rec.ColVals[0].AppendStringNull()
+		} else {
+			rec.ColVals[0].AppendString(fmt.Sprintf("status_%d_%d", seg, i%2))
+		}
+		rec.ColVals[1].AppendInteger(int64(i + 1))
+	}
+
+	ctx := NewReadContext(true)
+	ctx.tr = util.TimeRange{Min: math.MinInt64, Max: math.MaxInt64}
+	var loc *Location
+	writeFile := func(seq uint64) {
+		fileName := NewTSSPFileName(seq, 0, 0, 0, true, &lockPath)
+		msb := NewMsBuilder(dir, "mst", &lockPath, conf, 1, fileName, 0, store.Sequencer(), 2, config.TSSTORE, nil, 0)
+		require.NoError(t, msb.WriteData(1, rec))
+		f, err := msb.NewTSSPFile(false)
+		require.NoError(t, err)
+		store.AddTSSPFiles(msb.Name(), true, f)
+
+		midx, _ := f.MetaIndexAt(0)
+		cm, err := f.ChunkMeta(midx.id, midx.offset, midx.size, midx.count, 0, nil, fileops.IO_PRIORITY_LOW_READ)
+		require.NoError(t, err)
+		require.Equal(t, segments, cm.segmentCount())
+
+		loc = NewLocation(f, ctx)
+		loc.meta = cm
+	}
+	writeFile(1)
+
+	skipped := func(cond string) []bool {
+		opts := NewFilterOpts(influxql.MustParseExpr(cond), &BaseFilterOptions{}, nil, nil)
+		res := make([]bool, segments)
+		for i := 0; i < segments; i++ {
+			loc.segPos = i
+			res[i] = loc.skipByStringDict(opts)
+		}
+		return res
+	}
+
+	require.Equal(t, []bool{true, false, true, true}, skipped(`status = 'status_1_0'`))
+	require.Equal(t, []bool{false, true, true, false}, skipped(`status = 'status_0_1' OR status = 'status_3_1'`))
+	require.Equal(t, []bool{true, true, false, false}, skipped(`status =~ /status_[23]_.*/`))
+	require.Equal(t, []bool{false, false, false, false}, skipped(`status != 'status_1_0'`))
+	require.Equal(t, []bool{false, false, false, false}, skipped(`time > 1`))
+
+	config.GetStoreConfig().StringDictEncoding = false
+	writeFile(2)
+	require.Equal(t, []bool{false, false, false, false}, skipped(`status = 'status_1_0'`))
+}
+
+func TestDetachedMetaDataReader_SkipByStringDict(t *testing.T) {
+	defer func(enabled bool) {
+		config.GetStoreConfig().StringDictEncoding = enabled
+	}(config.GetStoreConfig().StringDictEncoding)
+	config.GetStoreConfig().StringDictEncoding = true
+
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_String, Name: "status"},
+		record.Field{Type: influx.Field_Type_Int, Name: "time"},
+	}
+	coder := encoding.NewCoderContext()
+	coder.SetTimeCoder(encoding.GetTimeCoder())
+	withCrc := func(data []byte) []byte {
+		return append(binary.BigEndian.AppendUint32(nil, crc32.ChecksumIEEE(data)), data...)
+	}
+
+	// every segment has its own values of status, the columns are streamed in the order of the segments
+	segments := 3
+	reader := &DetachedMetaDataReader{task: make(map[int]*SegmentTask), positionMap: make(map[int64]*position)}
+	reader.ch = make(chan *request.StreamReader, segments*len(schema))
+	for seg := 0; seg < segments; seg++ {
+		status, times := &record.ColVal{}, &record.ColVal{}
+		for i := 0; i < 16; i++ {
+			status.AppendString(fmt.Sprintf("status_%d_%d", seg, i%2))
+			times.AppendInteger(int64(seg*16 + i + 1))
+		}
+
+		statusData := EncodeColumnHeader(status, nil, encoding.BlockString)
+		statusData, err := encodeStringBlock(status.Val, status.Offset, statusData, coder)
+		require.NoError(t, err)
+		require.True(t, encoding.IsStringDictBlock(statusData[5:]))
+		timeData := EncodeColumnHeader(times, nil, encoding.BlockInteger)
+		timeData, err = encoding.EncodeTimestampBlock(times.Val, timeData, coder)
+		require.NoError(t, err)
+
+		reader.task[seg] = &SegmentTask{fieldNum: len(schema), data: make([]*request.StreamReader, len(schema))}
+		for i, data := range [][]byte{statusData, timeData} {
+			offset := int64(seg*len(schema) + i)
+			reader.positionMap[offset] = &position{schemaIndex: []int{i}, segmentID: seg}
+			reader.ch <- &request.StreamReader{Offset: offset, Content: withCrc(data)}
+		}
+	}
+	close(reader.ch)
+
+	reader.SetFilterOptions(NewFilterOpts(influxql.MustParseExpr(`status = 'status_1_0'`), &BaseFilterOptions{}, nil, nil))
+	decs := NewReadContext(true)
+	var times []int64
+	for {
+		rec, err := reader.ReadBatch(record.NewRecordBuilder(schema), decs)
+		require.NoError(t, err)
+		if rec == nil {
+			break
+		}
+		require.Equal(t, 16, rec.RowNums())
+		v, _ := rec.ColVals[0].StringValueSafe(0)
+		require.Equal(t, "status_1_0", v)
+		times = append(times, rec.Times()[0])
+	}
+	// only the segment 1 is decoded
+	require.Equal(t, []int64{17}, times)
+	require.Equal(t, 0, len(reader.task))
+}
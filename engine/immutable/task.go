@@ -44,6 +44,11 @@ func (t *CompactTask) BeforeExecute() bool {
 	if !t.table.acquire(t.plan.group) {
 		return false
 	}
+	// the deleted rows are purged before the files are compacted
+	if t.table.tombstones.HasPaths(t.plan.group) {
+		t.table.CompactDone(t.plan.group)
+		return false
+	}
 	t.OnFinish(func() {
 		t.table.CompactDone(t.plan.group)
 		t.table.blockCompactStop(t.plan.name)
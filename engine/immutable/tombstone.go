@@ -0,0 +1,892 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immutable
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/record"
+	"go.uber.org/zap"
+)
+
+const (
+	TombstoneFileName = "tombstone"
+
+	tombstoneVersion = 1
+)
+
+// Tombstone records the rows of some series deleted in a time range.
+// A tombstone only applies to the files that exist when the rows are deleted,
+// the rows written later are not affected. The deleted rows are filtered at read time
+// and removed from disk when the files are rewritten.
+type Tombstone struct {
+	Measurement string // measurement name with version
+	MinTime     int64
+	MaxTime     int64
+	Sids        []uint64 // sorted series ids
+	AllSeries   bool     // the rows of all the series are deleted, Sids is ignored
+
+	files map[string]struct{} // files the tombstone applies to, relative to the table store path
+	seq   uint64              // order in which the tombstones are added since the table store is opened
+}
+
+func NewTombstone(mst string, sids []uint64, minTime, maxTime int64) *Tombstone {
+	sort.Slice(sids, func(i, j int) bool { return sids[i] < sids[j] })
+	return &Tombstone{
+		Measurement: mst,
+		MinTime:     minTime,
+		MaxTime:     maxTime,
+		Sids:        sids,
+		files:       make(map[string]struct{}),
+	}
+}
+
+// NewExpireTombstone deletes the rows of all the series not later than maxTime,
+// it is used to expire the data of the measurement with TTL.
+func NewExpireTombstone(mst string, maxTime int64) *Tombstone {
+	return &Tombstone{
+		Measurement: mst,
+		MinTime:     math.MinInt64,
+		MaxTime:     maxTime,
+		AllSeries:   true,
+		files:       make(map[string]struct{}),
+	}
+}
+
+func (t *Tombstone) Contains(sid uint64) bool {
+	if t.AllSeries {
+		return true
+	}
+	i := sort.Search(len(t.Sids), func(i int) bool { return t.Sids[i] >= sid })
+	return i < len(t.Sids) && t.Sids[i] == sid
+}
+
+func (t *Tombstone) Overlaps(min, max int64) bool {
+	return t.MinTime <= max && min <= t.MaxTime
+}
+
+func (t *Tombstone) marshal(dst []byte) []byte {
+	dst = numberenc.MarshalUint16Append(dst, uint16(len(t.Measurement)))
+	dst = append(dst, t.Measurement...)
+	dst = numberenc.MarshalInt64Append(dst, t.MinTime)
+	dst = numberenc.MarshalInt64Append(dst, t.MaxTime)
+	if t.AllSeries {
+		dst = append(dst, 1)
+	} else {
+		dst = append(dst, 0)
+	}
+	dst = numberenc.MarshalUint32Append(dst, uint32(len(t.Sids)))
+	for _, sid := range t.Sids {
+		dst = numberenc.MarshalUint64Append(dst, sid)
+	}
+
+	files := make([]string, 0, len(t.files))
+	for f := range t.files {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+	dst = numberenc.MarshalUint32Append(dst, uint32(len(files)))
+	for _, f := range files {
+		dst = numberenc.MarshalUint16Append(dst, uint16(len(f)))
+		dst = append(dst, f...)
+	}
+	return dst
+}
+
+func (t *Tombstone) unmarshal(src []byte) ([]byte, error) {
+	var err error
+	if t.Measurement, src, err = unmarshalTombstoneString(src); err != nil {
+		return nil, err
+	}
+	if len(src) < 21 {
+		return nil, fmt.Errorf("too small data for tombstone time range, %v", len(src))
+	}
+	t.MinTime, src = numberenc.UnmarshalInt64(src), src[8:]
+	t.MaxTime, src = numberenc.UnmarshalInt64(src), src[8:]
+	t.AllSeries, src = src[0] == 1, src[1:]
+	n := int(numberenc.UnmarshalUint32(src))
+	src = src[4:]
+	if len(src) < n*8+4 {
+		return nil, fmt.Errorf("too small data for tombstone series, %v < %v", len(src), n*8+4)
+	}
+	t.Sids = make([]uint64, n)
+	for i := range t.Sids {
+		t.Sids[i], src = numberenc.UnmarshalUint64(src), src[8:]
+	}
+
+	n = int(numberenc.UnmarshalUint32(src))
+	src = src[4:]
+	t.files = make(map[string]struct{}, n)
+	for i := 0; i < n; i++ {
+		var f string
+		if f, src, err = unmarshalTombstoneString(src); err != nil {
+			return nil, err
+		}
+		t.files[f] = struct{}{}
+	}
+	return src, nil
+}
+
+func unmarshalTombstoneString(src []byte) (string, []byte, error) {
+	if len(src) < 2 {
+		return "", nil, fmt.Errorf("too small data for string length, %v", len(src))
+	}
+	l := int(numberenc.UnmarshalUint16(src))
+	src = src[2:]
+	if len(src) < l {
+		return "", nil, fmt.Errorf("too small data for string, %v < %v", len(src), l)
+	}
+	return string(src[:l]), src[l:], nil
+}
+
+// TombstoneSet holds the tombstones of a table store, and persists them in the shard directory.
+type TombstoneSet struct {
+	mu         sync.RWMutex
+	dir        string // table store path
+	lock       *string
+	tombstones []*Tombstone
+	seq        uint64
+}
+
+func NewTombstoneSet(dir string, lock *string) *TombstoneSet {
+	return &TombstoneSet{dir: dir, lock: lock}
+}
+
+func (s *TombstoneSet) fileName() string {
+	return filepath.Join(filepath.Dir(s.dir), TombstoneFileName)
+}
+
+func (s *TombstoneSet) fileKey(f TSSPFile) string {
+	return s.pathKey(f.Path())
+}
+
+func (s *TombstoneSet) pathKey(path string) string {
+	if s == nil {
+		return path
+	}
+	// the file in use is renamed with the tmp suffix when it is removed
+	name := strings.TrimSuffix(path, tmpFileSuffix)
+	key, err := filepath.Rel(s.dir, name)
+	if err != nil {
+		return name
+	}
+	return key
+}
+
+func (s *TombstoneSet) Len() int {
+	if s == nil {
+		return 0
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.tombstones)
+}
+
+// Has returns true if the measurement has tombstones not purged yet.
+func (s *TombstoneSet) Has(mst string) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, t := range s.tombstones {
+		if t.Measurement == mst {
+			return true
+		}
+	}
+	return false
+}
+
+// Measurements returns the measurements which have tombstones not purged yet.
+func (s *TombstoneSet) Measurements() []string {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var msts []string
+	for _, t := range s.tombstones {
+		i := sort.SearchStrings(msts, t.Measurement)
+		if i < len(msts) && msts[i] == t.Measurement {
+			continue
+		}
+		msts = append(msts, "")
+		copy(msts[i+1:], msts[i:])
+		msts[i] = t.Measurement
+	}
+	return msts
+}
+
+// Add applies the tombstone to the files and persists it.
+func (s *TombstoneSet) Add(t *Tombstone, files []TSSPFile) error {
+	if len(files) == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, f := range files {
+		t.files[s.fileKey(f)] = struct{}{}
+	}
+	old := s.tombstones
+	s.tombstones = append(s.mergeExpired(t), t)
+	if err := s.flush(); err != nil {
+		s.tombstones = old
+		return err
+	}
+	s.seq++
+	t.seq = s.seq
+
+	for _, f := range files {
+		setFileTombstones(f, s.FileTombstones(s.fileKey(f)))
+	}
+	return nil
+}
+
+// mergeExpired returns the tombstones without the ones covered by the new tombstone of all the series,
+// their files are taken over by the new one, so that expiring a measurement periodically
+// does not pile up the tombstones.
+func (s *TombstoneSet) mergeExpired(t *Tombstone) []*Tombstone {
+	tombstones := make([]*Tombstone, 0, len(s.tombstones)+1)
+	for _, old := range s.tombstones {
+		if !t.AllSeries || !old.AllSeries || old.Measurement != t.Measurement || old.MinTime < t.MinTime || old.MaxTime > t.MaxTime {
+			tombstones = append(tombstones, old)
+			continue
+		}
+		for f := range old.files {
+			t.files[f] = struct{}{}
+		}
+	}
+	return tombstones
+}
+
+// Seq returns the sequence of the last tombstone added.
+func (s *TombstoneSet) Seq() uint64 {
+	if s == nil {
+		return 0
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.seq
+}
+
+// HasKeys returns true if some of the files have tombstones.
+// The compaction and the merge do not remove the deleted rows, so the files are purged before.
+func (s *TombstoneSet) HasKeys(keys []string) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, key := range keys {
+		if len(s.FileTombstones(key)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *TombstoneSet) HasPaths(paths []string) bool {
+	if s == nil {
+		return false
+	}
+	keys := make([]string, 0, len(paths))
+	for _, path := range paths {
+		keys = append(keys, s.pathKey(path))
+	}
+	return s.HasKeys(keys)
+}
+
+// Inherit applies the tombstones added after seq to the old file to the new files, which are rewritten
+// from the old file alone. The tombstones purged by the rewrite are not inherited.
+func (s *TombstoneSet) Inherit(oldKeys []string, newFiles []TSSPFile, after uint64) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := false
+	for _, t := range s.tombstones {
+		if t.seq <= after {
+			continue
+		}
+		for _, key := range oldKeys {
+			if _, ok := t.files[key]; !ok {
+				continue
+			}
+			for _, nf := range newFiles {
+				t.files[s.fileKey(nf)] = struct{}{}
+			}
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if err := s.flush(); err != nil {
+		return err
+	}
+	for _, f := range newFiles {
+		setFileTombstones(f, s.FileTombstones(s.fileKey(f)))
+	}
+	return nil
+}
+
+// RenameFile moves the tombstones of the file renamed from the old key.
+func (s *TombstoneSet) RenameFile(oldKey string, f TSSPFile) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := s.fileKey(f)
+	if key == oldKey {
+		return nil
+	}
+	changed := false
+	for _, t := range s.tombstones {
+		if _, ok := t.files[oldKey]; ok {
+			delete(t.files, oldKey)
+			t.files[key] = struct{}{}
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return s.flush()
+}
+
+// fileKeys must be called before the files are removed, the path of the removed file is empty
+func (s *TombstoneSet) fileKeys(files []TSSPFile) []string {
+	if s == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(files))
+	for _, f := range files {
+		keys = append(keys, s.fileKey(f))
+	}
+	return keys
+}
+
+func (s *TombstoneSet) purgeKeys(keys []string) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := false
+	for _, key := range keys {
+		if s.removeFile(key) {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return s.flush()
+}
+
+// DropMeasurement removes the tombstones of the dropped measurement.
+func (s *TombstoneSet) DropMeasurement(mst string) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := len(s.tombstones)
+	tombstones := s.tombstones[:0]
+	for _, t := range s.tombstones {
+		if t.Measurement != mst {
+			tombstones = append(tombstones, t)
+		}
+	}
+	s.tombstones = tombstones
+	if len(tombstones) == n {
+		return nil
+	}
+	return s.flush()
+}
+
+func (s *TombstoneSet) removeFile(key string) bool {
+	changed := false
+	tombstones := s.tombstones[:0]
+	for _, t := range s.tombstones {
+		if _, ok := t.files[key]; ok {
+			delete(t.files, key)
+			changed = true
+		}
+		if len(t.files) > 0 {
+			tombstones = append(tombstones, t)
+		}
+	}
+	s.tombstones = tombstones
+	return changed
+}
+
+func (s *TombstoneSet) FileTombstones(key string) []*Tombstone {
+	var res []*Tombstone
+	for _, t := range s.tombstones {
+		if _, ok := t.files[key]; ok {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// Load reads the persisted tombstones and applies them to the files of the table store.
+// The tombstones of the files that no longer exist are removed.
+func (s *TombstoneSet) Load(m *MmsTables) error {
+	if s == nil {
+		return nil
+	}
+	name := s.fileName()
+	if _, err := fileops.Stat(name); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	buf, err := fileops.ReadFile(name)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tombstones, err = unmarshalTombstones(buf); err != nil {
+		return err
+	}
+	for _, t := range s.tombstones {
+		s.seq++
+		t.seq = s.seq
+	}
+
+	exists := make(map[string]struct{})
+	walk := func(tables map[string]*TSSPFiles) {
+		for _, files := range tables {
+			for _, f := range files.Files() {
+				key := s.fileKey(f)
+				exists[key] = struct{}{}
+				if tombstones := s.FileTombstones(key); len(tombstones) > 0 {
+					setFileTombstones(f, tombstones)
+				}
+			}
+		}
+	}
+	walk(m.Order)
+	walk(m.OutOfOrder)
+
+	var missing []string
+	for _, t := range s.tombstones {
+		for key := range t.files {
+			if _, ok := exists[key]; !ok {
+				missing = append(missing, key)
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	for _, key := range missing {
+		s.removeFile(key)
+	}
+	return s.flush()
+}
+
+func (s *TombstoneSet) flush() error {
+	name := s.fileName()
+	lock := fileops.FileLockOption(*s.lock)
+	if len(s.tombstones) == 0 {
+		if err := fileops.Remove(name, lock); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	buf := numberenc.MarshalUint32Append(nil, tombstoneVersion)
+	buf = numberenc.MarshalUint32Append(buf, uint32(len(s.tombstones)))
+	for _, t := range s.tombstones {
+		buf = t.marshal(buf)
+	}
+
+	tmp := name + tmpFileSuffix
+	if err := fileops.WriteFile(tmp, buf, 0600, lock); err != nil {
+		log.Error("write tombstone file failed", zap.String("name", tmp), zap.Error(err))
+		return err
+	}
+	return fileops.RenameFile(tmp, name, lock)
+}
+
+func unmarshalTombstones(src []byte) ([]*Tombstone, error) {
+	if len(src) < 8 {
+		return nil, fmt.Errorf("too small data for tombstone file, %v", len(src))
+	}
+	version := numberenc.UnmarshalUint32(src)
+	if version != tombstoneVersion {
+		return nil, fmt.Errorf("unknown tombstone file version %d", version)
+	}
+	n := int(numberenc.UnmarshalUint32(src[4:]))
+	src = src[8:]
+
+	tombstones := make([]*Tombstone, 0, n)
+	for i := 0; i < n; i++ {
+		t := &Tombstone{}
+		var err error
+		if src, err = t.unmarshal(src); err != nil {
+			return nil, err
+		}
+		tombstones = append(tombstones, t)
+	}
+	return tombstones, nil
+}
+
+// hasCompactedTombstones returns true if some old files of the compact log have tombstones.
+func hasCompactedTombstones(shardDir string, info *CompactedFileInfo) bool {
+	buf, err := fileops.ReadFile(filepath.Join(shardDir, TombstoneFileName))
+	if err != nil {
+		return false
+	}
+	tombstones, err := unmarshalTombstones(buf)
+	if err != nil {
+		return false
+	}
+	dir := info.Name
+	if !info.IsOrder {
+		dir = filepath.Join(dir, unorderedDir)
+	}
+	for _, t := range tombstones {
+		for _, name := range info.OldFile {
+			if _, ok := t.files[filepath.Join(dir, strings.TrimSuffix(name, tmpFileSuffix))]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func setFileTombstones(f TSSPFile, tombstones []*Tombstone) {
+	if tf, ok := f.(*tsspFile); ok {
+		tf.tombstones.Store(tombstones)
+	}
+}
+
+// FileTombstones returns the tombstones of the rows deleted from the file but not purged yet,
+// the readers of the chunks filter them by FilterByTombstones
+func FileTombstones(f TSSPFile) []*Tombstone {
+	tf, ok := f.(*tsspFile)
+	if !ok {
+		return nil
+	}
+	tombstones, _ := tf.tombstones.Load().([]*Tombstone)
+	return tombstones
+}
+
+// AddTombstone deletes the rows of the series in the time range from all the files of the measurement.
+// The data in memory must be flushed before.
+func (m *MmsTables) AddTombstone(mst string, t *Tombstone) error {
+	var files []TSSPFile
+	for _, isOrder := range []bool{true, false} {
+		fs, ok := m.getTSSPFiles(mst, isOrder)
+		if !ok || fs == nil {
+			continue
+		}
+		// the files can not be replaced until the tombstone is added
+		fs.lock.RLock()
+		defer fs.lock.RUnlock()
+		for _, f := range fs.Files() {
+			minTime, maxTime, err := f.MinMaxTime()
+			if err == nil && !t.Overlaps(minTime, maxTime) {
+				continue
+			}
+			files = append(files, f)
+		}
+	}
+	return m.tombstones.Add(t, files)
+}
+
+// DropExpiredFiles removes the files of the measurement whose rows are all not later than maxTime,
+// instead of rewriting them to purge a tombstone. The files in compaction are skipped.
+// Returns the number of files removed.
+func (m *MmsTables) DropExpiredFiles(mst string, maxTime int64) (int, error) {
+	if !m.inMerge.Add(mst) {
+		return 0, nil
+	}
+	defer m.inMerge.Del(mst)
+
+	orders := []bool{true, false}
+	if m.ImmTable.GetEngineType() == config.COLUMNSTORE {
+		// the files of columnstore are all ordered
+		orders = orders[:1]
+	}
+	dropped := 0
+	for _, isOrder := range orders {
+		fs, ok := m.getTSSPFiles(mst, isOrder)
+		if !ok || fs == nil {
+			continue
+		}
+		fs.lock.RLock()
+		var files []TSSPFile
+		for _, f := range fs.Files() {
+			if _, maxT, err := f.MinMaxTime(); err == nil && maxT <= maxTime {
+				files = append(files, f)
+			}
+		}
+		fs.lock.RUnlock()
+
+		for _, f := range files {
+			group := []string{f.Path()}
+			if !m.acquire(group) {
+				continue
+			}
+			err := m.dropExpiredFile(mst, fs, f)
+			m.CompactDone(group)
+			if err != nil {
+				return dropped, err
+			}
+			dropped++
+		}
+	}
+	return dropped, nil
+}
+
+func (m *MmsTables) dropExpiredFile(mst string, fs *TSSPFiles, f TSSPFile) error {
+	keys := m.tombstones.fileKeys([]TSSPFile{f})
+	path := f.Path()
+	fs.lock.Lock()
+	fs.deleteFile(f)
+	err := m.deleteFiles(f)
+	fs.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	if cs, ok := m.ImmTable.(*csImmTableImpl); ok {
+		if err = cs.removeIndexFiles(m, mst, path); err != nil {
+			return err
+		}
+	}
+	return m.tombstones.purgeKeys(keys)
+}
+
+// HasTombstones returns true if the deleted rows of some files are not purged yet.
+func (m *MmsTables) HasTombstones() bool {
+	return m.tombstones.Len() > 0
+}
+
+// PurgeTombstones rewrites the files with tombstones to remove the deleted rows from disk.
+func (m *MmsTables) PurgeTombstones() error {
+	for _, mst := range m.tombstones.Measurements() {
+		if m.isClosed() || m.isCompMergeStopped() {
+			return ErrCompStopped
+		}
+		if !m.inMerge.Add(mst) {
+			continue
+		}
+		err := m.purgeMeasurementTombstones(mst)
+		m.inMerge.Del(mst)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MmsTables) purgeMeasurementTombstones(mst string) error {
+	for _, isOrder := range []bool{true, false} {
+		fs, ok := m.getTSSPFiles(mst, isOrder)
+		if !ok || fs == nil {
+			continue
+		}
+		fs.lock.RLock()
+		var files []TSSPFile
+		for _, f := range fs.Files() {
+			if len(FileTombstones(f)) > 0 {
+				files = append(files, f)
+			}
+		}
+		fs.lock.RUnlock()
+
+		for _, f := range files {
+			group := []string{f.Path()}
+			if !m.acquire(group) {
+				continue
+			}
+			err := m.purgeFileTombstones(mst, fs, f)
+			m.CompactDone(group)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *MmsTables) purgeFileTombstones(mst string, fs *TSSPFiles, f TSSPFile) error {
+	lg := m.logger.With(zap.String("name", mst), zap.String("file", f.Path()))
+	seq := m.tombstones.Seq()
+
+	fileName := f.FileName()
+	fileName.merge++
+	fileName.lock = m.lock
+	builder := NewMsBuilder(m.path, mst, m.lock, m.Conf, 0, fileName, FilesMergedTire([]TSSPFile{f}),
+		nil, 0, config.TSSTORE, nil, m.shardId)
+
+	itr := NewChunkIterator(NewFileIterator(f, lg))
+	itr.WithLog(lg)
+	var err error
+	for itr.Next() {
+		if m.isClosed() || m.isCompMergeStopped() {
+			err = ErrCompStopped
+			break
+		}
+		builder, err = builder.WriteRecord(itr.GetSeriesID(), itr.GetRecord(), nil)
+		if err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = itr.err
+	}
+	itr.Close()
+	if err != nil {
+		builder.Reset()
+		lg.Error("purge tombstones fail", zap.Error(err))
+		return err
+	}
+
+	var newFile TSSPFile
+	if builder.Size() > 0 {
+		newFile, err = builder.NewTSSPFile(true)
+		if err != nil {
+			lg.Error("purge tombstones fail", zap.Error(err))
+			return err
+		}
+	} else {
+		builder.removeEmptyFile()
+	}
+
+	// all the rows of the file are deleted
+	if newFile == nil {
+		keys := m.tombstones.fileKeys([]TSSPFile{f})
+		fs.lock.Lock()
+		fs.deleteFile(f)
+		err = m.deleteFiles(f)
+		fs.lock.Unlock()
+		if err != nil {
+			return err
+		}
+		return m.tombstones.purgeKeys(keys)
+	}
+
+	// the tombstones added during the rewrite are not applied to the new file
+	rt := &replaceTombstones{inherit: true, seq: seq}
+	if err = m.replaceFiles(mst, []TSSPFile{f}, []TSSPFile{newFile}, f.IsOrder(), rt); err != nil {
+		lg.Error("replace purged file fail", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// HasTombstones returns true if the deleted rows of some files are not purged yet.
+func (r *MmsReaders) HasTombstones() bool {
+	for _, f := range r.Orders {
+		if len(FileTombstones(f)) > 0 {
+			return true
+		}
+	}
+	for _, f := range r.OutOfOrders {
+		if len(FileTombstones(f)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterByTombstones removes the rows of the series deleted by the tombstones.
+// Returns nil if all the rows are deleted.
+func FilterByTombstones(rec *record.Record, sid uint64, tombstones []*Tombstone) *record.Record {
+	if rec == nil || len(tombstones) == 0 {
+		return rec
+	}
+
+	times := rec.Times()
+	if len(times) == 0 {
+		return rec
+	}
+	min, max := times[0], times[len(times)-1]
+	if min > max {
+		min, max = max, min
+	}
+	var deleted []*Tombstone
+	for _, t := range tombstones {
+		if t.Overlaps(min, max) && t.Contains(sid) {
+			deleted = append(deleted, t)
+		}
+	}
+	if len(deleted) == 0 {
+		return rec
+	}
+
+	isDeleted := func(tm int64) bool {
+		for _, t := range deleted {
+			if t.MinTime <= tm && tm <= t.MaxTime {
+				return true
+			}
+		}
+		return false
+	}
+
+	var dst *record.Record
+	start := 0
+	for i, tm := range times {
+		if !isDeleted(tm) {
+			continue
+		}
+		if dst == nil {
+			dst = record.NewRecordBuilder(rec.Schema)
+			dst.RecMeta = rec.RecMeta
+		}
+		if start < i {
+			dst.AppendRec(rec, start, i)
+		}
+		start = i + 1
+	}
+	if dst == nil {
+		return rec
+	}
+	if start < len(times) {
+		dst.AppendRec(rec, start, len(times))
+	}
+	if dst.RowNums() == 0 {
+		return nil
+	}
+	return dst
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable_test
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterByTombstones(t *testing.T) {
+	rg := newRecordGenerator(1e12, defaultInterval, true)
+	rec := rg.generate(getDefaultSchemas(), 10)
+
+	tombstones := []*immutable.Tombstone{
+		immutable.NewTombstone("mst", []uint64{100}, 1e12+2*defaultInterval, 1e12+4*defaultInterval),
+		immutable.NewTombstone("mst", []uint64{100, 101}, 1e12+9*defaultInterval, 1e12+20*defaultInterval),
+	}
+
+	require.Equal(t, rec, immutable.FilterByTombstones(rec, 102, tombstones))
+	require.Nil(t, immutable.FilterByTombstones(rec, 100, []*immutable.Tombstone{
+		immutable.NewTombstone("mst", []uint64{100}, 0, 2e12),
+	}))
+
+	filtered := immutable.FilterByTombstones(rec, 100, tombstones)
+	require.Equal(t, 6, filtered.RowNums())
+	require.Equal(t, []int64{1e12, 1e12 + defaultInterval, 1e12 + 5*defaultInterval, 1e12 + 6*defaultInterval,
+		1e12 + 7*defaultInterval, 1e12 + 8*defaultInterval}, filtered.Times())
+
+	filtered = immutable.FilterByTombstones(rec, 101, tombstones)
+	require.Equal(t, 9, filtered.RowNums())
+}
+
+func TestTombstone_PurgeAndReload(t *testing.T) {
+	var begin int64 = 1e12
+	defer beforeTest(t, 0)()
+
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	mh.disableCompare()
+	rg := newRecordGenerator(begin, defaultInterval, true)
+
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	mh.addRecord(101, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+	rg.setBegin(begin + 10*defaultInterval)
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+
+	// delete the first 5 rows of series 100, and all the rows of series 100 in the second file
+	tombstone := immutable.NewTombstone("mst", []uint64{100}, begin, begin+4*defaultInterval)
+	require.NoError(t, mh.store.AddTombstone("mst", tombstone))
+	tombstone = immutable.NewTombstone("mst", []uint64{100}, begin+10*defaultInterval, begin+100*defaultInterval)
+	require.NoError(t, mh.store.AddTombstone("mst", tombstone))
+	require.True(t, mh.store.HasTombstones())
+
+	check := func(store *immutable.MmsTables) {
+		rows := make(map[uint64]int)
+		for _, f := range store.Order["mst"].Files() {
+			itr := immutable.NewChunkIterator(immutable.NewFileIterator(f, immutable.CLog))
+			for itr.Next() {
+				rows[itr.GetSeriesID()] += itr.GetRecord().RowNums()
+			}
+			itr.Close()
+		}
+		require.Equal(t, map[uint64]int{100: 5, 101: 10}, rows)
+	}
+	check(mh.store)
+
+	// the tombstones are loaded when the store is reopened
+	lockPath := ""
+	store := immutable.NewTableStore(saveDir, &lockPath, &defaultTier, false, immutable.NewTsStoreConfig())
+	store.SetImmTableType(config.TSSTORE)
+	_, err := store.Open()
+	require.NoError(t, err)
+	require.True(t, store.HasTombstones())
+	check(store)
+	require.NoError(t, store.Close())
+
+	require.NoError(t, mh.store.PurgeTombstones())
+	require.False(t, mh.store.HasTombstones())
+	require.Equal(t, 1, mh.store.Order["mst"].Len())
+	check(mh.store)
+}
+
+func TestTombstone_ExpireAllSeries(t *testing.T) {
+	var begin int64 = 1e12
+	defer beforeTest(t, 0)()
+
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	mh.disableCompare()
+	rg := newRecordGenerator(begin, defaultInterval, true)
+
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	mh.addRecord(101, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+	rg.setBegin(begin + 10*defaultInterval)
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+
+	check := func(store *immutable.MmsTables, exp map[uint64]int) {
+		rows := make(map[uint64]int)
+		for _, f := range store.Order["mst"].Files() {
+			itr := immutable.NewChunkIterator(immutable.NewFileIterator(f, immutable.CLog))
+			for itr.Next() {
+				rows[itr.GetSeriesID()] += itr.GetRecord().RowNums()
+			}
+			itr.Close()
+		}
+		require.Equal(t, exp, rows)
+	}
+
+	require.NoError(t, mh.store.AddTombstone("mst", immutable.NewExpireTombstone("mst", begin+4*defaultInterval)))
+	check(mh.store, map[uint64]int{100: 15, 101: 5})
+
+	// the later expiration takes over the former one
+	require.NoError(t, mh.store.AddTombstone("mst", immutable.NewExpireTombstone("mst", begin+11*defaultInterval)))
+	check(mh.store, map[uint64]int{100: 8})
+
+	lockPath := ""
+	store := immutable.NewTableStore(saveDir, &lockPath, &defaultTier, false, immutable.NewTsStoreConfig())
+	store.SetImmTableType(config.TSSTORE)
+	_, err := store.Open()
+	require.NoError(t, err)
+	check(store, map[uint64]int{100: 8})
+	require.NoError(t, store.Close())
+
+	require.NoError(t, mh.store.PurgeTombstones())
+	require.False(t, mh.store.HasTombstones())
+	require.Equal(t, 1, mh.store.Order["mst"].Len())
+	check(mh.store, map[uint64]int{100: 8})
+}
+
+func TestTombstone_DropExpiredFiles(t *testing.T) {
+	var begin int64 = 1e12
+	defer beforeTest(t, 0)()
+
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	mh.disableCompare()
+	rg := newRecordGenerator(begin, defaultInterval, true)
+
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	mh.addRecord(101, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+	rg.setBegin(begin + 10*defaultInterval)
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+	require.NoError(t, mh.store.AddTombstone("mst", immutable.NewTombstone("mst", []uint64{101}, begin, begin+2*defaultInterval)))
+
+	// the files partially expired are kept
+	dropped, err := mh.store.DropExpiredFiles("mst", begin+8*defaultInterval)
+	require.NoError(t, err)
+	require.Equal(t, 0, dropped)
+	require.Equal(t, 2, mh.store.Order["mst"].Len())
+
+	// the file expired is removed with its tombstones, instead of being purged
+	dropped, err = mh.store.DropExpiredFiles("mst", begin+9*defaultInterval)
+	require.NoError(t, err)
+	require.Equal(t, 1, dropped)
+	require.Equal(t, 1, mh.store.Order["mst"].Len())
+	require.False(t, mh.store.HasTombstones())
+	minTime, _, err := mh.store.Order["mst"].Files()[0].MinMaxTime()
+	require.NoError(t, err)
+	require.Equal(t, begin+10*defaultInterval, minTime)
+}
+
+func TestTombstone_CompactAfterPurge(t *testing.T) {
+	var begin int64 = 1e12
+	defer beforeTest(t, 0)()
+
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	mh.disableCompare()
+	mh.store.CompactionEnable()
+	rg := newRecordGenerator(begin, defaultInterval, true)
+
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	mh.addRecord(101, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+	require.NoError(t, mh.store.AddTombstone("mst", immutable.NewTombstone("mst", []uint64{100}, begin, begin+100*defaultInterval)))
+
+	// the rows written after the deletion are not deleted
+	rg.setBegin(begin + 10*defaultInterval)
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+
+	check := func(files int) {
+		require.Equal(t, files, mh.store.Order["mst"].Len())
+		rows := make(map[uint64]int)
+		for _, f := range mh.store.Order["mst"].Files() {
+			itr := immutable.NewChunkIterator(immutable.NewFileIterator(f, immutable.CLog))
+			for itr.Next() {
+				rows[itr.GetSeriesID()] += itr.GetRecord().RowNums()
+			}
+			itr.Close()
+		}
+		require.Equal(t, map[uint64]int{100: 10, 101: 10}, rows)
+	}
+
+	// the files with tombstones are not compacted
+	require.NoError(t, mh.store.FullCompact(1))
+	mh.store.Wait()
+	check(2)
+
+	require.NoError(t, mh.store.PurgeTombstones())
+	require.False(t, mh.store.HasTombstones())
+	require.NoError(t, mh.store.FullCompact(1))
+	mh.store.Wait()
+	check(1)
+}
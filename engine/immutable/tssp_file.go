@@ -21,6 +21,7 @@ import (
 	"sort"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/golang/snappy"
 	"github.com/influxdata/influxdb/pkg/bloom"
@@ -175,7 +176,11 @@ func NewTSSPFileReader(name string, lockPath *string) (*tsspFileReader, error) {
 		return nil, err
 	}
 
-	dr := fileops.NewFileReader(fd, lockPath)
+	var dr fileops.BasicFileReader = fileops.NewFileReader(fd, lockPath)
+	cold := newColdFileReader(dr, size)
+	if cold != nil {
+		dr = cold
+	}
 
 	hd := header[:]
 	hb, err := dr.ReadAt(0, uint32(len(header[:])), &hd, fileops.IO_PRIORITY_ULTRA_HIGH)
@@ -230,6 +235,14 @@ func NewTSSPFileReader(name string, lockPath *string) (*tsspFileReader, error) {
 		return nil, err
 	}
 
+	if cold != nil && coldPrefetchMeta {
+		// chunk meta (with the pre-aggregates), meta index and bloom filter are read by one request
+		metaOff, _ := r.trailer.metaOffsetSize()
+		if err = cold.Prefetch(metaOff, trailOff-metaOff, fileops.IO_PRIORITY_ULTRA_HIGH); err != nil {
+			log.Warn("prefetch cold file meta failed", zap.String("file", name), zap.Error(err))
+		}
+	}
+
 	r.trailerOffset = trailOff
 	r.fileSize = size
 	r.version = version
@@ -371,7 +384,7 @@ func (r *tsspFileReader) readSegmentRecord(cm *ChunkMeta, segment int, dst *reco
 	var chunkData []byte
 	var cachePage *readcache.CachePage
 	if cm.size < defaultIoSize {
-		chunkData, cachePage, err = r.ReadDataBlock(cm.offset, cm.size, &decs.readBuf, ioPriority)
+		chunkData, cachePage, err = r.readDataBlock(cm.offset, cm.size, decs, ioPriority)
 		if err != nil {
 			log.Error("read chunk data fail", zap.String("file", r.r.Name()), zap.Error(err))
 			return nil, err
@@ -407,7 +420,7 @@ func (r *tsspFileReader) readSegmentRecord(cm *ChunkMeta, segment int, dst *reco
 			data = columnData(chunkData, cm.offset, segOff, segSize)
 		} else {
 			r.UnrefCachePage(cachePage)
-			data, cachePage, err = r.ReadDataBlock(segOff, segSize, &decs.readBuf, ioPriority)
+			data, cachePage, err = r.readDataBlock(segOff, segSize, decs, ioPriority)
 			if err != nil {
 				log.Error("read column data fail", zap.String("file", r.FileName()), zap.String("col", cMeta.Name()), zap.Error(err))
 				return nil, err
@@ -447,7 +460,7 @@ func (r *tsspFileReader) decodeTimeColumn(cm *ChunkMeta, segment int, chunkData
 	if len(chunkData) > 0 {
 		tmData = columnData(chunkData, cm.offset, segOff, segSize)
 	} else {
-		tmData, cachePage, err = r.ReadDataBlock(segOff, segSize, &decs.readBuf, ioPriority)
+		tmData, cachePage, err = r.readDataBlock(segOff, segSize, decs, ioPriority)
 		defer r.UnrefCachePage(cachePage)
 		if err != nil {
 			log.Error("read time column fail", zap.String("file", r.FileName()), zap.Error(err))
@@ -584,6 +597,22 @@ func (r *tsspFileReader) ReadDataBlock(offset int64, size uint32, dst *[]byte, i
 	return rb, cachePage, nil
 }
 
+// readDataBlock reads the data block for a query, the reads of a cold file are counted to the query
+func (r *tsspFileReader) readDataBlock(offset int64, size uint32, decs *ReadContext, ioPriority int) ([]byte, *readcache.CachePage, error) {
+	cold, ok := r.r.(*ColdFileReader)
+	if !ok || decs.coldStat == nil {
+		return r.ReadDataBlock(offset, size, &decs.readBuf, ioPriority)
+	}
+
+	start := time.Now()
+	remote := cold.MissingBytes(offset, size)
+	rb, cachePage, err := r.ReadDataBlock(offset, size, &decs.readBuf, ioPriority)
+	if err == nil {
+		decs.coldStat.add(int64(size), remote, time.Since(start))
+	}
+	return rb, cachePage, err
+}
+
 func (r *tsspFileReader) Read(offset int64, size uint32, dst *[]byte, ioPriority int) ([]byte, error) {
 	if err := r.lazyInit(); err != nil {
 		errInfo := errno.NewError(errno.LoadFilesFailed)
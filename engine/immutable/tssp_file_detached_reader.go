@@ -355,6 +355,7 @@ func (t *TSSPFileDetachedReader) initChunkMeta() (bool, error) {
 			chunkMetas = append(chunkMetas, s.(*SegmentMeta))
 		}
 	}
+	t.dataReader.SetFilterOptions(t.ctx.filterOpts)
 	t.dataReader.InitReadBatch(chunkMetas, t.ctx.schemas)
 	if t.span != nil {
 		t.span.Count(ReaderContentNumSpan, int64(len(chunkMetas)))
@@ -0,0 +1,438 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"fmt"
+	"hash/crc32"
+	"path/filepath"
+	"strings"
+
+	"github.com/openGemini/openGemini/engine/immutable/colstore"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// the accessors and helpers below are used by the offline inspection tool (ts-inspect)
+
+func (t *Trailer) DataOffset() int64 {
+	return t.dataOffset
+}
+
+func (t *Trailer) BloomSize() int64 {
+	return t.bloomSize
+}
+
+func (t *Trailer) IdTimeSize() int64 {
+	return t.idTimeSize
+}
+
+func (stat *TableStat) IdCount() int64 {
+	return stat.idCount
+}
+
+func (stat *TableStat) MinMaxID() (uint64, uint64) {
+	return stat.minId, stat.maxId
+}
+
+func (stat *TableStat) MinMaxTime() (int64, int64) {
+	return stat.minTime, stat.maxTime
+}
+
+func (stat *TableStat) Name() string {
+	return string(stat.name)
+}
+
+func (m *MetaIndex) MinMaxTime() (int64, int64) {
+	return m.minTime, m.maxTime
+}
+
+// DataOffsetSize returns the offset and size of the chunk data
+func (m *ChunkMeta) DataOffsetSize() (int64, uint32) {
+	return m.offset, m.size
+}
+
+// Schema returns the schema of the chunk, the time column is the last one
+func (m *ChunkMeta) Schema() record.Schemas {
+	schema := make(record.Schemas, len(m.colMeta))
+	for i := range m.colMeta {
+		schema[i].Name = m.colMeta[i].name
+		schema[i].Type = int(m.colMeta[i].ty)
+	}
+	return schema
+}
+
+// PreAggStat is the decoded pre-aggregation of a column, strings only have the count
+type PreAggStat struct {
+	Min     interface{}
+	MinTime int64
+	Max     interface{}
+	MaxTime int64
+	Sum     interface{}
+	Count   int64
+}
+
+func (m *ColumnMeta) DecodePreAgg() (*PreAggStat, error) {
+	switch m.ty {
+	case influx.Field_Type_Int, influx.Field_Type_Float, influx.Field_Type_String, influx.Field_Type_Boolean:
+	default:
+		return nil, fmt.Errorf("unknown type %d of column %s", m.ty, m.name)
+	}
+
+	builders := newPreAggBuilders()
+	defer builders.Release()
+	cb := builders.aggBuilder(&record.Field{Name: m.name, Type: int(m.ty)})
+	if _, err := cb.unmarshal(m.preAgg); err != nil {
+		return nil, err
+	}
+
+	stat := &PreAggStat{Sum: cb.sum(), Count: cb.count()}
+	if m.ty != influx.Field_Type_String {
+		stat.Min, stat.MinTime = cb.min()
+		stat.Max, stat.MaxTime = cb.max()
+	}
+	return stat, nil
+}
+
+// WalkChunkMetas calls fn for every chunk meta of the file in the order of the meta index
+func WalkChunkMetas(f TSSPFile, fn func(metaIdx int, mi *MetaIndex, cm *ChunkMeta) error) error {
+	return walkChunkMetas(f, fn, nil)
+}
+
+// walkChunkMetas calls fn for every chunk meta of the file in the order of the meta index,
+// the meta index failing to read is skipped and passed to onErr if it is not nil, otherwise the walk stops
+func walkChunkMetas(f TSSPFile, fn func(metaIdx int, mi *MetaIndex, cm *ChunkMeta) error, onErr func(metaIdx int, err error)) error {
+	var cms []ChunkMeta
+	for i := 0; i < int(f.FileStat().MetaIndexItemNum()); i++ {
+		mi, err := f.MetaIndexAt(i)
+		if err != nil {
+			err = fmt.Errorf("read meta index %d: %v", i, err)
+		} else if cms, err = f.ReadChunkMetaData(i, mi, cms[:0], fileops.IO_PRIORITY_LOW_READ); err != nil {
+			err = fmt.Errorf("read chunk metas of meta index %d: %v", i, err)
+		}
+		if err != nil {
+			if onErr == nil {
+				return err
+			}
+			onErr(i, err)
+			continue
+		}
+
+		for j := range cms {
+			if err = fn(i, mi, &cms[j]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReadChunk reads all the rows of a chunk, the crc of every column is verified before decoding.
+// The crc written as zero by the stream compaction is not verified
+func ReadChunk(f TSSPFile, cm *ChunkMeta, ctx *ReadContext) (rec *record.Record, err error) {
+	var buf []byte
+	buf, err = f.ReadData(cm.offset, cm.size, &buf, fileops.IO_PRIORITY_LOW_READ)
+	if err != nil {
+		return nil, err
+	}
+	if err = verifyChunkCrc(cm, buf); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		// the corrupted data may crash the decoders
+		if e := recover(); e != nil {
+			rec, err = nil, fmt.Errorf("decode chunk of series %d failed: %v", cm.sid, e)
+		}
+	}()
+	rec = record.NewRecordBuilder(cm.Schema())
+	if err = decodeRecord(ctx, buf, cm, rec); err != nil {
+		return nil, err
+	}
+	record.CheckRecord(rec)
+	return rec, nil
+}
+
+func verifyChunkCrc(cm *ChunkMeta, chunk []byte) error {
+	for i := range cm.colMeta {
+		col := &cm.colMeta[i]
+		if len(col.entries) == 0 {
+			return fmt.Errorf("column %s has no segment", col.name)
+		}
+		first, last := col.entries[0], col.entries[len(col.entries)-1]
+		begin := first.offset - crcSize - cm.offset
+		end := last.offset + int64(last.size) - cm.offset
+		if begin < 0 || end > int64(len(chunk)) || begin+crcSize > end {
+			return fmt.Errorf("column %s out of chunk: [%d, %d), chunk size %d", col.name, begin, end, len(chunk))
+		}
+
+		for j := 1; j < len(col.entries); j++ {
+			prev := col.entries[j-1]
+			if col.entries[j].offset != prev.offset+int64(prev.size) {
+				return fmt.Errorf("segment %d of column %s is not contiguous", j, col.name)
+			}
+		}
+
+		crc := numberenc.UnmarshalUint32(chunk[begin:])
+		if crc == 0 {
+			continue
+		}
+		if got := crc32.ChecksumIEEE(chunk[begin+crcSize : end]); got != crc {
+			return fmt.Errorf("column %s crc mismatch: expect %d, got %d", col.name, crc, got)
+		}
+	}
+	return nil
+}
+
+// TSSPCorruption is a corrupt part of a TSSP file, Sid is zero if the corruption is not in a chunk
+type TSSPCorruption struct {
+	Sid uint64
+	Err error
+}
+
+func (c TSSPCorruption) String() string {
+	if c.Sid == 0 {
+		return c.Err.Error()
+	}
+	return fmt.Sprintf("series %d: %v", c.Sid, c.Err)
+}
+
+type TSSPVerifyResult struct {
+	Chunks      int
+	Rows        int
+	Corruptions []TSSPCorruption
+}
+
+func (r *TSSPVerifyResult) add(sid uint64, format string, a ...interface{}) {
+	r.Corruptions = append(r.Corruptions, TSSPCorruption{Sid: sid, Err: fmt.Errorf(format, a...)})
+}
+
+// VerifyTSSPFile checks the trailer, the ordering of the meta index and chunk metas,
+// and the crc and decoding of every chunk
+func VerifyTSSPFile(f TSSPFile) *TSSPVerifyResult {
+	return VerifyTSSPFileThrottled(f, nil)
+}
+
+// VerifyTSSPFileThrottled is VerifyTSSPFile calling throttle with the size of every chunk meta block and chunk
+// before it is read, throttle may be nil
+func VerifyTSSPFileThrottled(f TSSPFile, throttle func(n int)) *TSSPVerifyResult {
+	if throttle == nil {
+		throttle = func(int) {}
+	}
+	res := &TSSPVerifyResult{}
+	tr := f.FileStat()
+	verifyTrailer(tr, f.FileSize(), res)
+
+	minID, maxID := tr.MinMaxID()
+	minTime, maxTime := tr.MinMaxTime()
+	mOff, mSize := tr.metaOffsetSize()
+	dataEnd := tr.dataOffset + tr.dataSize
+
+	ctx := NewReadContext(true)
+	defer ctx.Release()
+
+	var lastMeta *MetaIndex
+	var lastSid uint64
+	err := WalkChunkMetas(f, func(metaIdx int, mi *MetaIndex, cm *ChunkMeta) error {
+		if mi != lastMeta {
+			throttle(int(mi.size))
+			if lastMeta != nil && mi.id <= lastMeta.id {
+				res.add(0, "meta index %d: id %d is not greater than %d", metaIdx, mi.id, lastMeta.id)
+			}
+			if mi.offset < mOff || mi.offset+int64(mi.size) > mOff+mSize {
+				res.add(0, "meta index %d: chunk meta block [%d, %d) out of [%d, %d)", metaIdx, mi.offset, mi.offset+int64(mi.size), mOff, mOff+mSize)
+			}
+			if cm.sid != mi.id {
+				res.add(cm.sid, "meta index %d: id %d is not the first series %d", metaIdx, mi.id, cm.sid)
+			}
+			lastMeta = mi
+		}
+
+		res.Chunks++
+		if res.Chunks > 1 && cm.sid <= lastSid {
+			res.add(cm.sid, "series id is not greater than the previous %d", lastSid)
+		}
+		lastSid = cm.sid
+		if cm.sid < minID || cm.sid > maxID {
+			res.add(cm.sid, "series id out of trailer range [%d, %d]", minID, maxID)
+		}
+
+		cmMin, cmMax := cm.MinMaxTime()
+		if cmMin > cmMax || cmMin < mi.minTime || cmMax > mi.maxTime || cmMin < minTime || cmMax > maxTime {
+			res.add(cm.sid, "time range [%d, %d] out of meta index [%d, %d] or trailer [%d, %d]", cmMin, cmMax, mi.minTime, mi.maxTime, minTime, maxTime)
+		}
+		if cm.offset < tr.dataOffset || cm.offset+int64(cm.size) > dataEnd {
+			res.add(cm.sid, "chunk [%d, %d) out of data block [%d, %d)", cm.offset, cm.offset+int64(cm.size), tr.dataOffset, dataEnd)
+			return nil
+		}
+
+		throttle(int(cm.size))
+		rec, err := ReadChunk(f, cm, ctx)
+		if err != nil {
+			res.add(cm.sid, "%v", err)
+			return nil
+		}
+		res.Rows += rec.RowNums()
+		verifyChunkTimes(cm, rec.Times(), res)
+		return nil
+	})
+	if err != nil {
+		res.add(0, "%v", err)
+	}
+
+	if int64(res.Chunks) != tr.IdCount() && err == nil {
+		res.add(0, "%d chunks found, trailer id count is %d", res.Chunks, tr.IdCount())
+	}
+	return res
+}
+
+func verifyTrailer(tr *Trailer, fileSize int64, res *TSSPVerifyResult) {
+	if tr.dataOffset != int64(fileHeaderSize) {
+		res.add(0, "trailer: invalid data offset %d", tr.dataOffset)
+	}
+	end := tr.dataOffset + tr.dataSize + tr.indexSize + tr.metaIndexSize + tr.bloomSize + tr.idTimeSize
+	if tr.dataSize < 0 || tr.indexSize < 0 || tr.metaIndexSize < 0 || end > fileSize-8 {
+		res.add(0, "trailer: blocks end at %d beyond file size %d", end, fileSize)
+	}
+	if tr.minId > tr.maxId || tr.minTime > tr.maxTime {
+		res.add(0, "trailer: invalid id range [%d, %d] or time range [%d, %d]", tr.minId, tr.maxId, tr.minTime, tr.maxTime)
+	}
+	if tr.metaIndexItemNum <= 0 || tr.metaIndexItemNum > tr.idCount {
+		res.add(0, "trailer: invalid meta index count %d for %d series", tr.metaIndexItemNum, tr.idCount)
+	}
+}
+
+func verifyChunkTimes(cm *ChunkMeta, times []int64, res *TSSPVerifyResult) {
+	for i := 1; i < len(times); i++ {
+		if times[i] < times[i-1] {
+			res.add(cm.sid, "time %d at row %d is less than the previous %d", times[i], i, times[i-1])
+			return
+		}
+	}
+	if len(times) == 0 {
+		return
+	}
+	cmMin, cmMax := cm.MinMaxTime()
+	if times[0] < cmMin || times[len(times)-1] > cmMax {
+		res.add(cm.sid, "times [%d, %d] out of chunk meta range [%d, %d]", times[0], times[len(times)-1], cmMin, cmMax)
+	}
+}
+
+type TSSPSalvageResult struct {
+	Chunks  int
+	Rows    int
+	Skipped []TSSPCorruption
+}
+
+// SalvageTSSPFile copies the readable chunks of the file to a new file with the same name under dir/<measurement>,
+// the chunks failing the crc check or decoding and the broken chunk meta blocks are skipped.
+// Returns a nil file if no chunk is readable. The files of the column store are not supported
+func SalvageTSSPFile(f TSSPFile, dir string, lockPath *string) (TSSPFile, *TSSPSalvageResult, error) {
+	if isColumnStoreFile(f.Path()) {
+		return nil, nil, fmt.Errorf("salvage of the column store file %s is not supported", f.Path())
+	}
+
+	res := &TSSPSalvageResult{}
+	fileName := f.FileName()
+	fileName.lock = lockPath
+	mst := f.FileStat().Name()
+	for _, tmp := range []bool{false, true} {
+		p := fileName.Path(filepath.Join(dir, mst), tmp)
+		if _, err := fileops.Stat(p); err == nil {
+			return nil, nil, fmt.Errorf("file %s exists", p)
+		}
+	}
+
+	conf := NewTsStoreConfig()
+	tier := uint64(0)
+	msb := NewMsBuilder(dir, mst, lockPath, conf, int(f.FileStat().IdCount()), fileName, tier, nil, int(f.FileSize()), config.TSSTORE, nil, 0)
+	msb, err := writeReadableChunks(f, msb, res)
+	if err != nil {
+		tmpPath := msb.fd.Name()
+		msb.Reset()
+		_ = fileops.Remove(tmpPath, fileops.FileLockOption(*lockPath))
+		return nil, res, err
+	}
+
+	nf, err := msb.NewTSSPFile(true)
+	if err != nil || nf == nil {
+		return nil, res, err
+	}
+	if err = RenameTmpFiles([]TSSPFile{nf}); err != nil {
+		return nil, res, err
+	}
+	return nf, res, nil
+}
+
+// writeReadableChunks writes the chunks of the file passing the crc check and decoding to msb,
+// the skipped chunks are added to res. Returns the error of writing
+func writeReadableChunks(f TSSPFile, msb *MsBuilder, res *TSSPSalvageResult) (*MsBuilder, error) {
+	ctx := NewReadContext(true)
+	defer ctx.Release()
+
+	var lastSid uint64
+	onErr := func(_ int, err error) {
+		// the chunk metas of the broken meta block are lost, the following blocks are still salvaged
+		res.Skipped = append(res.Skipped, TSSPCorruption{Err: err})
+	}
+	err := walkChunkMetas(f, func(_ int, _ *MetaIndex, cm *ChunkMeta) error {
+		if res.Chunks > 0 && cm.sid <= lastSid {
+			res.Skipped = append(res.Skipped, TSSPCorruption{Sid: cm.sid, Err: fmt.Errorf("series id is not greater than the previous %d", lastSid)})
+			return nil
+		}
+		rec, err := ReadChunk(f, cm, ctx)
+		if err != nil {
+			res.Skipped = append(res.Skipped, TSSPCorruption{Sid: cm.sid, Err: err})
+			return nil
+		}
+		if msb, err = msb.WriteRecord(cm.sid, rec, nil); err != nil {
+			return err
+		}
+		res.Chunks++
+		res.Rows += rec.RowNums()
+		lastSid = cm.sid
+		return nil
+	}, onErr)
+	return msb, err
+}
+
+// isColumnStoreFile returns true if the file is written by the column store, whose chunks are not split by series
+// and whose primary key index is in a separate file
+func isColumnStoreFile(path string) bool {
+	for _, dir := range strings.Split(filepath.ToSlash(filepath.Dir(path)), "/") {
+		if dir == ColumnStoreDirName {
+			return true
+		}
+	}
+	pkFile := strings.TrimSuffix(path, tsspFileSuffix) + colstore.IndexFileSuffix
+	_, err := fileops.Stat(pkFile)
+	return err == nil
+}
+
+// OpenTSSPFileReadonly opens the file for inspection, unlike OpenTSSPFile the file too small is not removed
+func OpenTSSPFileReadonly(name string) (TSSPFile, error) {
+	fi, err := fileops.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() < minTableSize() {
+		return nil, fmt.Errorf("invalid file(%v) size:%v", name, fi.Size())
+	}
+	lockPath := ""
+	return OpenTSSPFile(name, &lockPath, !strings.Contains(name, unorderedDir))
+}
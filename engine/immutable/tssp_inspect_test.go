@@ -0,0 +1,215 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func writeInspectFile(t *testing.T, dir string, series int) string {
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_Float, Name: "value"},
+		record.Field{Type: influx.Field_Type_Int, Name: "time"},
+	}
+	lockPath := ""
+	conf := immutable.NewTsStoreConfig()
+	fileName := immutable.NewTSSPFileName(1, 0, 0, 0, true, &lockPath)
+	msb := immutable.NewMsBuilder(dir, "mst", &lockPath, conf, series, fileName, 0, nil, 2, config.TSSTORE, nil, 0)
+	for sid := 1; sid <= series; sid++ {
+		rec := record.NewRecordBuilder(schema)
+		for i := 0; i < 10; i++ {
+			rec.ColVals[0].AppendFloat(float64(sid*100 + i))
+			rec.ColVals[1].AppendInteger(int64(i + 1))
+		}
+		require.NoError(t, msb.WriteData(uint64(sid), rec))
+	}
+	f, err := msb.NewTSSPFile(true)
+	require.NoError(t, err)
+	require.NoError(t, immutable.RenameTmpFiles([]immutable.TSSPFile{f}))
+	path := f.Path()
+	require.NoError(t, f.Close())
+	return path
+}
+
+// corruptChunk flips a byte in the value column of the series
+func corruptChunk(t *testing.T, path string, sid uint64) {
+	f, err := immutable.OpenTSSPFileReadonly(path)
+	require.NoError(t, err)
+	var off int64 = -1
+	require.NoError(t, immutable.WalkChunkMetas(f, func(_ int, _ *immutable.MetaIndex, cm *immutable.ChunkMeta) error {
+		if cm.GetSid() == sid {
+			off, _ = cm.GetColMeta()[0].GetSegment(0)
+		}
+		return nil
+	}))
+	require.NoError(t, f.Close())
+	require.True(t, off > 0)
+
+	buf, err := os.ReadFile(path)
+	require.NoError(t, err)
+	buf[off+1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, buf, 0600))
+}
+
+func TestVerifyTSSPFile(t *testing.T) {
+	path := writeInspectFile(t, t.TempDir(), 3)
+
+	f, err := immutable.OpenTSSPFileReadonly(path)
+	require.NoError(t, err)
+	res := immutable.VerifyTSSPFile(f)
+	require.Empty(t, res.Corruptions)
+	require.Equal(t, 3, res.Chunks)
+	require.Equal(t, 30, res.Rows)
+	require.NoError(t, f.Close())
+
+	corruptChunk(t, path, 2)
+	f, err = immutable.OpenTSSPFileReadonly(path)
+	require.NoError(t, err)
+	defer f.Close()
+	res = immutable.VerifyTSSPFile(f)
+	require.Equal(t, 1, len(res.Corruptions))
+	require.Equal(t, uint64(2), res.Corruptions[0].Sid)
+	require.Contains(t, res.Corruptions[0].String(), "crc mismatch")
+}
+
+func TestSalvageTSSPFile(t *testing.T) {
+	path := writeInspectFile(t, t.TempDir(), 3)
+	corruptChunk(t, path, 2)
+
+	f, err := immutable.OpenTSSPFileReadonly(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	out := t.TempDir()
+	lockPath := ""
+	nf, res, err := immutable.SalvageTSSPFile(f, out, &lockPath)
+	require.NoError(t, err)
+	require.NotNil(t, nf)
+	defer nf.Close()
+	require.Equal(t, 2, res.Chunks)
+	require.Equal(t, 20, res.Rows)
+	require.Equal(t, 1, len(res.Skipped))
+	require.Equal(t, filepath.Join(out, "mst", filepath.Base(path)), nf.Path())
+
+	var sids []uint64
+	ctx := immutable.NewReadContext(true)
+	defer ctx.Release()
+	require.NoError(t, immutable.WalkChunkMetas(nf, func(_ int, _ *immutable.MetaIndex, cm *immutable.ChunkMeta) error {
+		sids = append(sids, cm.GetSid())
+		rec, err := immutable.ReadChunk(nf, cm, ctx)
+		require.NoError(t, err)
+		require.Equal(t, float64(cm.GetSid()*100), rec.Column(0).FloatValues()[0])
+		return nil
+	}))
+	require.Equal(t, []uint64{1, 3}, sids)
+	require.Empty(t, immutable.VerifyTSSPFile(nf).Corruptions)
+
+	// the salvaged file is never overwritten
+	_, _, err = immutable.SalvageTSSPFile(f, out, &lockPath)
+	require.EqualError(t, err, fmt.Sprintf("file %s exists", nf.Path()))
+}
+
+func TestSalvageTSSPFile_BrokenMetaIndex(t *testing.T) {
+	// 3 meta index blocks
+	path := writeInspectFile(t, t.TempDir(), 1100)
+
+	f, err := immutable.OpenTSSPFileReadonly(path)
+	require.NoError(t, err)
+	mi, err := f.MetaIndexAt(1)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	buf, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for i := mi.GetOffset(); i < mi.GetOffset()+int64(mi.GetSize()); i++ {
+		buf[i] = 0xff
+	}
+	require.NoError(t, os.WriteFile(path, buf, 0600))
+
+	f, err = immutable.OpenTSSPFileReadonly(path)
+	require.NoError(t, err)
+	defer f.Close()
+	lockPath := ""
+	nf, res, err := immutable.SalvageTSSPFile(f, t.TempDir(), &lockPath)
+	require.NoError(t, err)
+	require.NotNil(t, nf)
+	defer nf.Close()
+	require.Equal(t, 1, len(res.Skipped))
+	require.Contains(t, res.Skipped[0].String(), "meta index 1")
+	// the chunks of the meta index blocks after the broken one are salvaged
+	require.Equal(t, 1100-int(mi.GetCount()), res.Chunks)
+	require.Empty(t, immutable.VerifyTSSPFile(nf).Corruptions)
+}
+
+func TestSalvageTSSPFile_ColumnStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "columnstore")
+	path := writeInspectFile(t, dir, 3)
+
+	f, err := immutable.OpenTSSPFileReadonly(path)
+	require.NoError(t, err)
+	defer f.Close()
+	lockPath := ""
+	_, _, err = immutable.SalvageTSSPFile(f, t.TempDir(), &lockPath)
+	require.EqualError(t, err, fmt.Sprintf("salvage of the column store file %s is not supported", path))
+}
+
+func TestMmsTables_RepairFile(t *testing.T) {
+	var begin int64 = 1e12
+	defer beforeTest(t, 0)()
+
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	mh.disableCompare()
+	rg := newRecordGenerator(begin, defaultInterval, true)
+	peer := rg.generate(getDefaultSchemas(), 10)
+	mh.addRecord(100, peer)
+	mh.addRecord(101, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+
+	f := mh.store.Order["mst"].Files()[0]
+	corruptChunk(t, f.Path(), 100)
+	res := immutable.VerifyTSSPFile(f)
+	require.Equal(t, 1, len(res.Corruptions))
+	require.Equal(t, uint64(100), res.Corruptions[0].Sid)
+
+	salvaged, err := mh.store.RepairFile("mst", f, map[uint64]*record.Record{100: peer})
+	require.NoError(t, err)
+	require.Equal(t, 1, salvaged.Chunks)
+	require.Equal(t, 1, len(salvaged.Skipped))
+
+	rows := func(fs *immutable.TSSPFiles) map[uint64]int {
+		res := make(map[uint64]int)
+		for _, f := range fs.Files() {
+			require.Empty(t, immutable.VerifyTSSPFile(f).Corruptions)
+			itr := immutable.NewChunkIterator(immutable.NewFileIterator(f, immutable.CLog))
+			for itr.Next() {
+				res[itr.GetSeriesID()] += itr.GetRecord().RowNums()
+			}
+			itr.Close()
+		}
+		return res
+	}
+	require.Equal(t, map[uint64]int{101: 10}, rows(mh.store.Order["mst"]))
+	require.Equal(t, map[uint64]int{100: 10}, rows(mh.store.OutOfOrder["mst"]))
+}
@@ -348,6 +348,8 @@ type tsspFile struct {
 	lock *string
 
 	reader FileReader
+
+	tombstones atomic.Value // []*Tombstone, deleted rows not purged yet
 }
 
 func OpenTSSPFile(name string, lockPath *string, isOrder bool) (TSSPFile, error) {
@@ -663,6 +665,7 @@ func (f *tsspFile) Remove() error {
 			f.mu.Unlock()
 			return err
 		}
+		removeColdCache(name)
 		f.mu.Unlock()
 	}
 	return nil
@@ -783,6 +786,29 @@ func (f *tsspFile) MetaIndexItemNum() int64 {
 	return f.reader.Stat().MetaIndexItemNum()
 }
 
+// ReadSeriesIDs appends the id of every series stored in the file to dst
+func ReadSeriesIDs(f TSSPFile, dst []uint64) ([]uint64, error) {
+	f.RefFileReader()
+	defer f.UnrefFileReader()
+
+	var metas []ChunkMeta
+	for i := 0; i < int(f.MetaIndexItemNum()); i++ {
+		metaIndex, err := f.MetaIndexAt(i)
+		if err != nil {
+			return dst, err
+		}
+
+		metas, err = f.ReadChunkMetaData(i, metaIndex, metas[:0], fileops.IO_PRIORITY_LOW_READ)
+		if err != nil {
+			return dst, err
+		}
+		for j := range metas {
+			dst = append(dst, metas[j].GetSid())
+		}
+	}
+	return dst, nil
+}
+
 func (f *tsspFile) GetFileReaderRef() int64 {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
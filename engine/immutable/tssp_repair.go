@@ -0,0 +1,129 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/record"
+	"go.uber.org/zap"
+)
+
+// RepairFile replaces the corrupt file with its readable chunks, and writes the records re-fetched
+// from a peer replica to a new out-of-order file, so they are deduplicated against the other files by the merge.
+// recs is keyed by the series id, the file is removed if no chunk of it is readable
+func (m *MmsTables) RepairFile(mst string, f TSSPFile, recs map[uint64]*record.Record) (*TSSPSalvageResult, error) {
+	group := []string{f.Path()}
+	if !m.acquire(group) {
+		return nil, fmt.Errorf("file %s is in compaction", f.Path())
+	}
+	defer m.CompactDone(group)
+
+	fs, ok := m.getTSSPFiles(mst, f.IsOrder())
+	if !ok || fs == nil {
+		return nil, fmt.Errorf("measurement %s has no file %s", mst, f.Path())
+	}
+
+	// the re-fetched rows replace the chunks of the file, the rows deleted from the file stay deleted
+	// even if the peer replica has not purged them yet
+	tombstones := FileTombstones(f)
+	for sid, rec := range recs {
+		recs[sid] = FilterByTombstones(rec, sid, tombstones)
+	}
+
+	lg := m.logger.With(zap.String("name", mst), zap.String("file", f.Path()))
+	res, err := m.rewriteReadableChunks(mst, fs, f)
+	if err != nil {
+		lg.Error("rewrite corrupt file fail", zap.Error(err))
+		return res, err
+	}
+
+	if err = m.writeRepairedRecords(mst, recs); err != nil {
+		lg.Error("write repaired records fail", zap.Error(err))
+		return res, err
+	}
+	return res, nil
+}
+
+func (m *MmsTables) rewriteReadableChunks(mst string, fs *TSSPFiles, f TSSPFile) (*TSSPSalvageResult, error) {
+	res := &TSSPSalvageResult{}
+	fileName := f.FileName()
+	fileName.merge++
+	fileName.lock = m.lock
+	builder := NewMsBuilder(m.path, mst, m.lock, m.Conf, int(f.FileStat().IdCount()), fileName, m.Tier(),
+		nil, int(f.FileSize()), config.TSSTORE, nil, m.shardId)
+
+	builder, err := writeReadableChunks(f, builder, res)
+	if err != nil {
+		builder.Reset()
+		return res, err
+	}
+
+	var newFile TSSPFile
+	if builder.Size() > 0 {
+		if newFile, err = builder.NewTSSPFile(true); err != nil {
+			return res, err
+		}
+	} else {
+		builder.removeEmptyFile()
+	}
+
+	if newFile == nil {
+		fs.lock.Lock()
+		fs.deleteFile(f)
+		err = m.deleteFiles(f)
+		fs.lock.Unlock()
+		return res, err
+	}
+	// the readable chunks are rewritten with the deleted rows, so the new file keeps all the tombstones
+	return res, m.replaceFiles(mst, []TSSPFile{f}, []TSSPFile{newFile}, f.IsOrder(), &replaceTombstones{inherit: true})
+}
+
+func (m *MmsTables) writeRepairedRecords(mst string, recs map[uint64]*record.Record) error {
+	sids := make([]uint64, 0, len(recs))
+	for sid, rec := range recs {
+		if rec != nil && rec.RowNums() > 0 {
+			sids = append(sids, sid)
+		}
+	}
+	if len(sids) == 0 {
+		return nil
+	}
+	sort.Slice(sids, func(i, j int) bool { return sids[i] < sids[j] })
+
+	fileName := NewTSSPFileName(m.NextSequence(), 0, 0, 0, false, m.lock)
+	builder := NewMsBuilder(m.path, mst, m.lock, m.Conf, len(sids), fileName, m.Tier(),
+		nil, 0, config.TSSTORE, nil, m.shardId)
+
+	var err error
+	for _, sid := range sids {
+		if builder, err = builder.WriteRecord(sid, recs[sid], nil); err != nil {
+			builder.Reset()
+			return err
+		}
+	}
+
+	f, err := builder.NewTSSPFile(true)
+	if err != nil || f == nil {
+		return err
+	}
+	if err = RenameTmpFiles([]TSSPFile{f}); err != nil {
+		return err
+	}
+	m.AddTSSPFiles(mst, false, f)
+	return nil
+}
@@ -219,6 +219,11 @@ func (iBuilder *IndexBuilder) Overlaps(tr influxql.TimeRange) bool {
 	return !iBuilder.startTime.After(tr.Max) && iBuilder.endTime.After(tr.Min)
 }
 
+// CoveredBy returns true if the time range of the index is contained in tr.
+func (iBuilder *IndexBuilder) CoveredBy(tr influxql.TimeRange) bool {
+	return !iBuilder.startTime.Before(tr.Min) && !iBuilder.endTime.After(tr.Max)
+}
+
 func (iBuilder *IndexBuilder) CreateIndexIfNotExists(mmRows *dictpool.Dict, needSecondaryIndex bool) error {
 	primaryIndex := iBuilder.GetPrimaryIndex()
 	var wg sync.WaitGroup
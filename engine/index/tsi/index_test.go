@@ -1030,6 +1030,39 @@ func TestDeleteTSIDs(t *testing.T) {
 	})
 }
 
+func TestDeleteSeriesKeepsDeletedTSIDsFormat(t *testing.T) {
+	path := t.TempDir()
+	index, idxBuilder := getTestIndexAndBuilder(path, config.TSSTORE)
+	defer idxBuilder.Close()
+	CreateIndexByPts(index)
+	idx := index.(*MergeSetIndex)
+
+	deleted, err := idx.SearchTSIDs([]byte("mn-1_0000"), MustParseExpr(`tk1='value1'`))
+	require.NoError(t, err)
+	require.NotEmpty(t, deleted)
+	require.NoError(t, idx.DeleteTSIDs([]byte("mn-1_0000"), MustParseExpr(`tk1='value1'`), defaultTR))
+	dropped, err := idx.SearchTSIDs([]byte("mn-1_0000"), MustParseExpr(`tk1='value11'`))
+	require.NoError(t, err)
+	require.NotEmpty(t, dropped)
+	require.NoError(t, idx.DeleteSeries(dropped))
+	require.NoError(t, idx.reviveTSID(dropped[0]))
+	idx.DebugFlush()
+
+	// the items of the old prefix are readable by the old versions
+	items, err := idx.loadTSIDsWithTime(nsPrefixDeletedTSIDs, 8)
+	require.NoError(t, err)
+	require.Equal(t, len(deleted), len(items))
+
+	require.NoError(t, idx.loadDeletedTSIDs())
+	for _, tsid := range deleted {
+		require.True(t, idx.IsDeletedTSID(tsid))
+	}
+	require.False(t, idx.IsDeletedTSID(dropped[0]))
+	for _, tsid := range dropped[1:] {
+		require.True(t, idx.IsDeletedTSID(tsid))
+	}
+}
+
 func TestSearchTagValues(t *testing.T) {
 	path := t.TempDir()
 	idx, idxBuilder := getTestIndexAndBuilder(path, config.TSSTORE)
@@ -2294,3 +2327,109 @@ func TestGetSeriesIdBySeriesKeyFromCache(t *testing.T) {
 	row.Tags[0].Value = "[a,b,c]"
 	assertCreateSeries()
 }
+
+func TestMergeSetIndex_RebuildIndexes(t *testing.T) {
+	src, srcBuilder := getTestIndexAndBuilder(t.TempDir(), config.TSSTORE)
+	defer srcBuilder.Close()
+	CreateIndexByPts(src)
+	srcIdx := src.(*MergeSetIndex)
+
+	name := []byte("mn-1_0000")
+	tsids, err := srcIdx.searchTSIDs(name, nil, defaultTR)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(tsids))
+
+	// only the TSID -> series key items are left in the broken index
+	dst, dstBuilder := getTestIndexAndBuilder(t.TempDir(), config.TSSTORE)
+	defer dstBuilder.Close()
+	dstIdx := dst.(*MergeSetIndex)
+	var items [][]byte
+	for _, tsid := range tsids {
+		key, err := srcIdx.searchSeriesKey(nil, tsid)
+		require.NoError(t, err)
+		item := append([]byte{nsPrefixTSIDToKey}, encoding.MarshalUint64(nil, tsid)...)
+		items = append(items, append(item, key...))
+	}
+	require.NoError(t, dstIdx.tb.AddItems(items))
+	dstIdx.DebugFlush()
+
+	ids, err := dstIdx.searchTSIDs(name, MustParseExpr(`tk1='value1'`), defaultTR)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(ids))
+
+	// unknown series are skipped
+	require.NoError(t, dstIdx.RebuildIndexes(append(tsids, math.MaxUint64)))
+	dstIdx.DebugFlush()
+
+	ids, err = dstIdx.searchTSIDs(name, MustParseExpr(`tk1='value1'`), defaultTR)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ids))
+	ids, err = dstIdx.searchTSIDs(name, nil, defaultTR)
+	require.NoError(t, err)
+	require.ElementsMatch(t, tsids, ids)
+}
+
+func TestMergeSetIndex_RebuildIndexes_LostSeriesKeys(t *testing.T) {
+	src, srcBuilder := getTestIndexAndBuilder(t.TempDir(), config.TSSTORE)
+	defer srcBuilder.Close()
+	CreateIndexByPts(src)
+	srcIdx := src.(*MergeSetIndex)
+
+	name := []byte("mn-1_0000")
+	tsids, err := srcIdx.searchTSIDs(name, nil, defaultTR)
+	require.NoError(t, err)
+
+	// the TSID -> series key items are lost, only the series key -> TSID items are left
+	dst, dstBuilder := getTestIndexAndBuilder(t.TempDir(), config.TSSTORE)
+	defer dstBuilder.Close()
+	dstIdx := dst.(*MergeSetIndex)
+	var items [][]byte
+	keys := make(map[uint64][]byte)
+	for _, tsid := range tsids {
+		key, err := srcIdx.searchSeriesKey(nil, tsid)
+		require.NoError(t, err)
+		keys[tsid] = key
+		item := append([]byte{nsPrefixKeyToTSID}, key...)
+		item = append(item, kvSeparatorChar)
+		items = append(items, encoding.MarshalUint64(item, tsid))
+	}
+	require.NoError(t, dstIdx.tb.AddItems(items))
+	dstIdx.DebugFlush()
+
+	require.NoError(t, dstIdx.RebuildIndexes(tsids))
+	dstIdx.DebugFlush()
+
+	for _, tsid := range tsids {
+		key, err := dstIdx.searchSeriesKey(nil, tsid)
+		require.NoError(t, err)
+		require.Equal(t, keys[tsid], key)
+	}
+	ids, err := dstIdx.searchTSIDs(name, MustParseExpr(`tk1='value1'`), defaultTR)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ids))
+}
+
+func TestMergeSetIndex_RebuildIndexesByRows(t *testing.T) {
+	idx, idxBuilder := getTestIndexAndBuilder(t.TempDir(), config.TSSTORE)
+	defer idxBuilder.Close()
+	ms := idx.(*MergeSetIndex)
+
+	rows := []influx.Row{{
+		Name: "mn-1_0000",
+		Tags: influx.PointTags{{Key: "tk1", Value: "value1"}, {Key: "tk2", Value: "value2"}},
+	}}
+	rows[0].UnmarshalIndexKeys(nil)
+
+	// the series missing from the index is created
+	require.NoError(t, ms.RebuildIndexesByRows(rows))
+	sid, err := ms.GetSeriesIdBySeriesKey(rows[0].IndexKey)
+	require.NoError(t, err)
+	require.NotZero(t, sid)
+
+	// the series in the index keeps its TSID
+	require.NoError(t, ms.RebuildIndexesByRows(rows))
+	ms.DebugFlush()
+	ids, err := ms.searchTSIDs([]byte("mn-1_0000"), MustParseExpr(`tk2='value2'`), defaultTR)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{sid}, ids)
+}
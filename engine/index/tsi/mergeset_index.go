@@ -25,6 +25,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"text/tabwriter"
+	"time"
 
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/bytesutil"
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
@@ -64,6 +65,12 @@ const (
 
 	//  Prefix for column store
 	nsPrefixTagKeysToTagValues
+
+	// Prefixes for the series deleted by DROP SERIES and the series written again after that,
+	// the items are the tsid and the time. The items of nsPrefixDeletedTSIDs keep the 8 bytes tsid,
+	// so the indexes stay readable by the old versions, which skip the unknown prefixes.
+	nsPrefixRevivedTSIDs
+	nsPrefixDroppedTSIDs
 )
 
 const (
@@ -710,7 +717,7 @@ func (idx *MergeSetIndex) createIndexesIfNotExists(vkey, vname []byte, tags []in
 	}
 
 	if tsid != 0 {
-		return tsid, nil
+		return tsid, idx.reviveTSID(tsid)
 	}
 
 	if err = idx.indexBuilder.SeriesLimited(); err != nil {
@@ -754,7 +761,7 @@ func (idx *MergeSetIndex) createIndexesIfNotExistsWithTagArray(vkey, vname []byt
 	}
 
 	if tsid != 0 {
-		return tsid, nil
+		return tsid, idx.reviveTSID(tsid)
 	}
 
 	if err = idx.indexBuilder.SeriesLimited(); err != nil {
@@ -840,6 +847,161 @@ func (idx *MergeSetIndex) createIndexes(seriesKey []byte, name []byte, tags []in
 	return tsid, nil
 }
 
+// RebuildIndexes recreates the index items of the series referenced by the data files from their series keys,
+// so the TSIDs referenced by the data files are kept. The series keys are read from the TSID -> series key items,
+// the series keys of the TSIDs whose TSID -> series key items are lost are recovered from the series key -> TSID items
+func (idx *MergeSetIndex) RebuildIndexes(tsids []uint64) error {
+	if idx.EnabledTagArray() {
+		return errors.New("rebuild index is not supported when tag array is enabled")
+	}
+
+	keys := make([][]byte, 0, len(tsids))
+	found := make([]uint64, 0, len(tsids))
+	var missing map[uint64]struct{}
+	for _, tsid := range tsids {
+		seriesKey, err := idx.searchSeriesKey(nil, tsid)
+		if errno.Equal(err, errno.ErrSearchSeriesKey) {
+			if missing == nil {
+				missing = make(map[uint64]struct{})
+			}
+			missing[tsid] = struct{}{}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		keys = append(keys, seriesKey)
+		found = append(found, tsid)
+	}
+
+	if len(missing) > 0 {
+		err := idx.walkSeriesKeys(func(seriesKey []byte, tsid uint64) {
+			if _, ok := missing[tsid]; ok {
+				keys = append(keys, append([]byte(nil), seriesKey...))
+				found = append(found, tsid)
+				delete(missing, tsid)
+			}
+		})
+		if err != nil {
+			return err
+		}
+		for tsid := range missing {
+			idx.logger.Warn("series key not found, skip rebuilding index", zap.Uint64("tsid", tsid))
+		}
+	}
+	return idx.addSeriesIndexes(keys, found)
+}
+
+// RebuildIndexesByRows recreates the index items of the series of the rows read from the wal,
+// the series missing from the index are created
+func (idx *MergeSetIndex) RebuildIndexesByRows(rows []influx.Row) error {
+	if idx.EnabledTagArray() {
+		return errors.New("rebuild index is not supported when tag array is enabled")
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	keys := make([][]byte, 0, len(rows))
+	tsids := make([]uint64, 0, len(rows))
+	for i := range rows {
+		row := &rows[i]
+		tsid, err := idx.getSeriesIdBySeriesKey(row.IndexKey)
+		if err != nil {
+			return err
+		}
+		if tsid == 0 {
+			// all the index items of the new series are created
+			if _, err = idx.createIndexesIfNotExists(row.IndexKey, []byte(row.Name), row.Tags); err != nil {
+				return err
+			}
+			continue
+		}
+		keys = append(keys, row.IndexKey)
+		tsids = append(tsids, tsid)
+	}
+	return idx.addSeriesIndexes(keys, tsids)
+}
+
+// addSeriesIndexes adds all the index items of the series, the items which exist are merged by the merge set
+func (idx *MergeSetIndex) addSeriesIndexes(keys [][]byte, tsids []uint64) error {
+	ii := idxItemsPool.Get()
+	defer idxItemsPool.Put(ii)
+	compositeKey := kbPool.Get()
+	defer kbPool.Put(compositeKey)
+
+	var tags influx.PointTags
+	for i, seriesKey := range keys {
+		tsid := tsids[i]
+		name, _, err := influx.MeasurementName(seriesKey)
+		if err != nil {
+			return err
+		}
+		if _, err = influx.IndexKeyToTags(seriesKey, false, &tags); err != nil {
+			return err
+		}
+
+		ii.B = append(ii.B, nsPrefixKeyToTSID)
+		ii.B = append(ii.B, seriesKey...)
+		ii.B = append(ii.B, kvSeparatorChar)
+		ii.B = encoding.MarshalUint64(ii.B, tsid)
+		ii.Next()
+
+		ii.B = append(ii.B, nsPrefixTSIDToKey)
+		ii.B = encoding.MarshalUint64(ii.B, tsid)
+		ii.B = append(ii.B, seriesKey...)
+		ii.Next()
+
+		for j := range tags {
+			ii.B = idx.marshalTagToTSIDs(compositeKey.B, ii.B, name, tags[j], tsid)
+			ii.Next()
+		}
+
+		compositeKey.B = marshalCompositeTagKey(compositeKey.B[:0], name, nil)
+		ii.B = append(ii.B, nsPrefixTagToTSIDs)
+		ii.B = marshalTagValue(ii.B, compositeKey.B)
+		ii.B = marshalTagValue(ii.B, nil)
+		ii.B = encoding.MarshalUint64(ii.B, tsid)
+		ii.Next()
+	}
+
+	if len(ii.Items) == 0 {
+		return nil
+	}
+	return idx.tb.AddItems(ii.Items)
+}
+
+// walkSeriesKeys calls fn with the series key and the TSID of every series key -> TSID item
+func (idx *MergeSetIndex) walkSeriesKeys(fn func(seriesKey []byte, tsid uint64)) error {
+	is := idx.getIndexSearch()
+	defer idx.putIndexSearch(is)
+	ts := &is.ts
+	kb := &is.kb
+	kb.B = append(kb.B[:0], nsPrefixKeyToTSID)
+	ts.Seek(kb.B)
+	for ts.NextItem() {
+		if !bytes.HasPrefix(ts.Item, kb.B) {
+			break
+		}
+		tail := ts.Item[len(kb.B):]
+		if len(tail) < 9 || tail[len(tail)-9] != kvSeparatorChar {
+			continue
+		}
+		fn(tail[:len(tail)-9], encoding.UnmarshalUint64(tail[len(tail)-8:]))
+	}
+	return ts.Error()
+}
+
+// SeriesKey returns the series key of the TSID, the key is the same on every replica of the series
+func (idx *MergeSetIndex) SeriesKey(dst []byte, tsid uint64) ([]byte, error) {
+	return idx.searchSeriesKey(dst, tsid)
+}
+
+// VerifyParts reads all the file parts of the index and checks them against their part headers
+func (idx *MergeSetIndex) VerifyParts(onBlock func(size int)) (int, []mergeset.PartCorruption) {
+	return idx.tb.VerifyParts(onBlock)
+}
+
 func (idx *MergeSetIndex) marshalTagToTagValues(tmpB []byte, dstB []byte, name []byte, key, value []byte) []byte {
 	tmpB = marshalCompositeTagKey(tmpB[:0], name, key)
 	dstB = append(dstB, nsPrefixTagKeysToTagValues)
@@ -1578,12 +1740,45 @@ func mergeIndexRowsForColumnStore(data []byte, items []mergeset.Item, isArrowFli
 }
 
 func (idx *MergeSetIndex) loadDeletedTSIDs() error {
+	deleted, err := idx.loadTSIDsWithTime(nsPrefixDeletedTSIDs, 8)
+	if err != nil {
+		return err
+	}
+	dropped, err := idx.loadTSIDsWithTime(nsPrefixDroppedTSIDs, 16)
+	if err != nil {
+		return err
+	}
+	revived, err := idx.loadTSIDsWithTime(nsPrefixRevivedTSIDs, 16)
+	if err != nil {
+		return err
+	}
+
+	for tsid, droppedAt := range dropped {
+		if deletedAt, ok := deleted[tsid]; !ok || droppedAt > deletedAt {
+			deleted[tsid] = droppedAt
+		}
+	}
 	dmis := &uint64set.Set{}
+	for tsid, deletedAt := range deleted {
+		// the series is written again after it is deleted
+		if revivedAt, ok := revived[tsid]; ok && revivedAt > deletedAt {
+			continue
+		}
+		dmis.Add(tsid)
+	}
+	idx.deletedTSIDs.Store(dmis)
+	return nil
+}
+
+// loadTSIDsWithTime returns the latest time of each tsid with the prefix.
+// The items of size 8 have no time.
+func (idx *MergeSetIndex) loadTSIDsWithTime(prefix byte, size int) (map[uint64]int64, error) {
+	res := make(map[uint64]int64)
 	is := idx.getIndexSearch()
 	defer idx.putIndexSearch(is)
 	ts := &is.ts
 	kb := &is.kb
-	kb.B = append(kb.B[:0], nsPrefixDeletedTSIDs)
+	kb.B = append(kb.B[:0], prefix)
 	ts.Seek(kb.B)
 	for ts.NextItem() {
 		item := ts.Item
@@ -1591,18 +1786,22 @@ func (idx *MergeSetIndex) loadDeletedTSIDs() error {
 			break
 		}
 		item = item[len(kb.B):]
-		if len(item) != 8 {
-			return fmt.Errorf("unexpected item len; got %d bytes; want %d bytes", len(item), 8)
+		if len(item) != size {
+			return nil, fmt.Errorf("unexpected item len; got %d bytes; want %d bytes", len(item), size)
 		}
 		tsid := encoding.UnmarshalUint64(item)
-		dmis.Add(tsid)
+		var tm int64
+		if size == 16 {
+			tm = encoding.UnmarshalInt64(item[8:])
+		}
+		if last, ok := res[tsid]; !ok || tm > last {
+			res[tsid] = tm
+		}
 	}
 	if err := ts.Error(); err != nil {
-		return err
+		return nil, err
 	}
-
-	idx.deletedTSIDs.Store(dmis)
-	return nil
+	return res, nil
 }
 
 func (idx *MergeSetIndex) DeleteTSIDs(name []byte, condition influxql.Expr, tr TimeRange) error {
@@ -1621,10 +1820,10 @@ func (idx *MergeSetIndex) DeleteTSIDs(name []byte, condition influxql.Expr, tr T
 		return err
 	}
 
-	return idx.deleteTSIDs(tsids)
+	return idx.deleteTSIDs(tsids, nsPrefixDeletedTSIDs)
 }
 
-func (idx *MergeSetIndex) deleteTSIDs(tsids []uint64) error {
+func (idx *MergeSetIndex) deleteTSIDs(tsids []uint64, prefix byte) error {
 	ii := idxItemsPool.Get()
 	defer idxItemsPool.Put(ii)
 
@@ -1636,14 +1835,62 @@ func (idx *MergeSetIndex) deleteTSIDs(tsids []uint64) error {
 	idx.deletedTSIDs.Store(newDeleted)
 	idx.deletedTSIDsLock.Unlock()
 
+	now := time.Now().UnixNano()
 	for _, tsid := range tsids {
-		ii.B = append(ii.B, nsPrefixDeletedTSIDs)
+		ii.B = append(ii.B, prefix)
 		ii.B = encoding.MarshalUint64(ii.B, tsid)
+		if prefix == nsPrefixDroppedTSIDs {
+			ii.B = encoding.MarshalInt64(ii.B, now)
+		}
 		ii.Next()
 	}
 	return idx.tb.AddItems(ii.Items)
 }
 
+// SearchTSIDs returns the tsids of the measurement matching the tag condition.
+func (idx *MergeSetIndex) SearchTSIDs(name []byte, condition influxql.Expr) ([]uint64, error) {
+	return idx.searchTSIDs(name, condition, DefaultTR)
+}
+
+// DeleteSeries marks the series deleted, they are invisible to the index searches until written again.
+func (idx *MergeSetIndex) DeleteSeries(tsids []uint64) error {
+	if len(tsids) == 0 {
+		return nil
+	}
+	return idx.deleteTSIDs(tsids, nsPrefixDroppedTSIDs)
+}
+
+// IsDeletedTSID returns true if the series is deleted and not written again.
+func (idx *MergeSetIndex) IsDeletedTSID(tsid uint64) bool {
+	return idx.getDeletedTSIDs().Has(tsid)
+}
+
+// reviveTSID makes the deleted series visible again when it is written.
+func (idx *MergeSetIndex) reviveTSID(tsid uint64) error {
+	if !idx.IsDeletedTSID(tsid) {
+		return nil
+	}
+
+	idx.deletedTSIDsLock.Lock()
+	curDeleted := idx.getDeletedTSIDs()
+	if !curDeleted.Has(tsid) {
+		idx.deletedTSIDsLock.Unlock()
+		return nil
+	}
+	newDeleted := curDeleted.Clone()
+	newDeleted.Del(tsid)
+	idx.deletedTSIDs.Store(newDeleted)
+	idx.deletedTSIDsLock.Unlock()
+
+	ii := idxItemsPool.Get()
+	defer idxItemsPool.Put(ii)
+	ii.B = append(ii.B, nsPrefixRevivedTSIDs)
+	ii.B = encoding.MarshalUint64(ii.B, tsid)
+	ii.B = encoding.MarshalInt64(ii.B, time.Now().UnixNano())
+	ii.Next()
+	return idx.tb.AddItems(ii.Items)
+}
+
 func (idx *MergeSetIndex) getDeletedTSIDs() *uint64set.Set {
 	return idx.deletedTSIDs.Load().(*uint64set.Set)
 }
@@ -43,6 +43,8 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -54,6 +56,10 @@ const (
 	unorderRowCount  = "unorder_row_count"
 	unorderDuration  = "unorder_duration"
 	aggIterCount     = "agg_iter"
+	coldReadCount    = "cold_read_count"
+	coldReadBytes    = "cold_read_bytes"
+	coldRemoteBytes  = "cold_remote_bytes"
+	coldReadDuration = "cold_read_duration"
 
 	createTagSetCursorDuration = "create_lazy_tagset_cursor"
 )
@@ -131,6 +137,9 @@ func (s *shard) CreateCursor(ctx context.Context, schema *executor.QuerySchema)
 
 		defer span.Finish()
 	}
+	ctx, otelSpan := tracing.StartOTelSpan(ctx, "store create cursor", trace.SpanKindInternal,
+		attribute.Int64("shard.id", int64(s.GetID())), attribute.String("measurement", schema.Options().OptionsName()))
+	defer otelSpan.End()
 
 	start := time.Now()
 
@@ -148,6 +157,7 @@ func (s *shard) CreateCursor(ctx context.Context, schema *executor.QuerySchema)
 
 	if err != nil {
 		s.log.Error("get index result fail", zap.Error(err))
+		tracing.SetOTelSpanError(otelSpan, err)
 		return nil, err
 	}
 	if result == nil {
@@ -161,6 +171,9 @@ func (s *shard) CreateCursor(ctx context.Context, schema *executor.QuerySchema)
 	if qDuration != nil {
 		qDuration.AddDuration("LocalTagSetDuration", time.Since(start).Nanoseconds())
 	}
+	if scanStat := scanRecorder(ctx); scanStat != nil {
+		scanStat.AddScan(seriesNum, 0, 0)
+	}
 
 	if span != nil {
 		cloneMsSpan = span.StartSpan("clone_measurement")
@@ -269,6 +282,8 @@ func (s *shard) initGroupCursors(ctx context.Context, querySchema *executor.Quer
 	if !ok || closedSignal == nil {
 		s.log.Warn("there is no aborted signal to init group cursor")
 	}
+	queryStat, _ := ctx.Value(query.QueryDurationKey).(*statistics.StoreSlowQueryStatistics)
+	scanStat := scanRecorder(ctx)
 	cursors := make(comm.KeyCursors, 0, parallelism)
 	for groupIdx := 0; groupIdx < parallelism; groupIdx++ {
 		if closedSignal != nil && *closedSignal {
@@ -288,9 +303,12 @@ func (s *shard) initGroupCursors(ctx context.Context, querySchema *executor.Quer
 				querySchema:  querySchema,
 				interTr:      util.TimeRange{Min: iTr.Min, Max: iTr.Max},
 				closedSignal: closedSignal,
+				queryStat:    queryStat,
+				scanStat:     scanStat,
 			},
 			querySchema: querySchema,
 		}
+		c.ctx.decs.SetColdReadStat(&immutable.ColdReadStat{})
 
 		if groupIdx == 0 {
 			err := newCursorSchema(c.ctx, querySchema)
@@ -642,6 +660,20 @@ type idKeyCursorContext struct {
 	closedSignal    *bool
 	immTableReaders map[uint64]*immutable.MmsReaders
 	memTableReader  map[uint64]*mutable.MemTables
+	queryStat       *statistics.StoreSlowQueryStatistics
+	scanStat        statistics.ScanRecorder
+}
+
+// scanRecorder returns the statistics recording the series, the rows and the bytes read by the query,
+// the statistics of the ts-sql are used by the ts-server which reads the local storage directly
+func scanRecorder(ctx context.Context) statistics.ScanRecorder {
+	if stat, _ := ctx.Value(query.QueryDurationKey).(*statistics.StoreSlowQueryStatistics); stat != nil {
+		return stat
+	}
+	if stat, _ := ctx.Value(query.SQLQueryStatKey).(*statistics.SQLSlowQueryStatistics); stat != nil {
+		return stat
+	}
+	return nil
 }
 
 func (i *idKeyCursorContext) IsAborted() bool {
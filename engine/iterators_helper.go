@@ -114,6 +114,11 @@ func matchPreAgg(schema *executor.QuerySchema, ctx *idKeyCursorContext) bool {
 	if schema.Options().IsPromQuery() {
 		return false
 	}
+
+	// the pre-aggregated data of the files with deleted rows not purged is stale
+	if ctx.readers != nil && ctx.readers.HasTombstones() {
+		return false
+	}
 	return true
 }
 
@@ -0,0 +1,161 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util"
+)
+
+func (e *Engine) getMeasurementStats(req *netstorage.SysCtrlRequest) (map[string]string, error) {
+	r, err := netstorage.ParseMeasurementStatsRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := e.measurementStats(r)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := json.Marshal(stats)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"stats": string(buf)}, nil
+}
+
+// measurementStats estimates the statistics of the measurement from the metadata of its files,
+// no chunk data is read. The rows in the mem tables are not counted
+func (e *Engine) measurementStats(r *netstorage.MeasurementStatsRequest) (*netstorage.MeasurementStats, error) {
+	stats := &netstorage.MeasurementStats{Fields: make(map[string]*executor.FieldStatistics)}
+	for _, pt := range r.Pts {
+		if err := e.ptMeasurementStats(r.Db, r.Rp, pt, r.Mst, stats); err != nil {
+			return nil, err
+		}
+	}
+	return stats, nil
+}
+
+func (e *Engine) ptMeasurementStats(db, rp string, pt uint32, mst string, stats *netstorage.MeasurementStats) error {
+	if err := e.DbPTRef(db, pt); err != nil {
+		return err
+	}
+	defer e.DbPTUnref(db, pt)
+
+	shards, err := e.ptShardsByTime(db, rp, pt, util.TimeRange{Min: math.MinInt64, Max: math.MaxInt64})
+	if err != nil {
+		return err
+	}
+	for _, sh := range shards {
+		store := sh.GetTableStore()
+		if store == nil {
+			continue
+		}
+		if err = storeMeasurementStats(store, mst, stats); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func storeMeasurementStats(store immutable.TablesStore, mst string, stats *netstorage.MeasurementStats) error {
+	order, unordered, _ := store.GetBothFilesRef(mst, false, util.TimeRange{}, nil)
+	cs := store.CopyCSFiles(mst)
+	defer func() {
+		immutable.UnrefFiles(order...)
+		immutable.UnrefFiles(unordered...)
+		immutable.UnrefFiles(cs...)
+		immutable.UnrefFilesReader(cs...)
+	}()
+
+	for _, files := range [][]immutable.TSSPFile{order, unordered, cs} {
+		for _, f := range files {
+			if err := fileMeasurementStats(f, stats); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fileMeasurementStats samples the chunk metas of the first meta index block of the file.
+// The rows of the file are its series times the average rows of the sampled chunks, and the
+// value ranges of the numeric fields are merged from the pre-aggregation of the sampled chunks
+func fileMeasurementStats(f immutable.TSSPFile, stats *netstorage.MeasurementStats) error {
+	if f.FileStat().MetaIndexItemNum() == 0 {
+		return nil
+	}
+	mi, err := f.MetaIndexAt(0)
+	if err != nil {
+		return err
+	}
+	cms, err := f.ReadChunkMetaData(0, mi, nil, fileops.IO_PRIORITY_LOW_READ)
+	if err != nil || len(cms) == 0 {
+		return err
+	}
+
+	var rows int64
+	for i := range cms {
+		cols := cms[i].GetColMeta()
+		for j := range cols {
+			agg, err := cols[j].DecodePreAgg()
+			if err != nil {
+				// the columns of the types without pre-aggregation are skipped
+				continue
+			}
+			if cols[j].IsTime() {
+				rows += agg.Count
+				continue
+			}
+			mergeFieldRange(stats.Fields, cols[j].Name(), agg)
+		}
+	}
+	stats.Rows += rows * f.FileStat().IdCount() / int64(len(cms))
+	return nil
+}
+
+func mergeFieldRange(fields map[string]*executor.FieldStatistics, name string, agg *immutable.PreAggStat) {
+	minV, ok := preAggNumber(agg.Min)
+	if !ok {
+		return
+	}
+	maxV, ok := preAggNumber(agg.Max)
+	if !ok {
+		return
+	}
+	fs, ok := fields[name]
+	if !ok {
+		fields[name] = &executor.FieldStatistics{Min: minV, Max: maxV}
+		return
+	}
+	fs.Min = math.Min(fs.Min, minV)
+	fs.Max = math.Max(fs.Max, maxV)
+}
+
+func preAggNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_MeasurementStats(t *testing.T) {
+	eng, err := initEngine(t.TempDir())
+	require.NoError(t, err)
+	defer eng.Close()
+
+	tm := mustParseTime(time.RFC3339Nano, "1999-06-01T00:00:00Z")
+	points, _, _ := GenDataRecord([]string{"cpu"}, 10, 20, time.Second, tm, true, true, false)
+	require.NoError(t, eng.WriteRows(defaultDb, defaultRp, defaultPtId, defaultShardId, points, nil, nil))
+	eng.ForceFlush()
+
+	req := &netstorage.MeasurementStatsRequest{Db: defaultDb, Rp: defaultRp, Mst: "cpu", Pts: []uint32{defaultPtId}}
+	res, err := eng.processReq(req.SysCtrlRequest())
+	require.NoError(t, err)
+	stats := &netstorage.MeasurementStats{}
+	require.NoError(t, json.Unmarshal([]byte(res["stats"]), stats))
+	require.Equal(t, int64(200), stats.Rows)
+	require.Equal(t, float64(1), stats.Fields["field2_int"].Min)
+	require.Greater(t, stats.Fields["field2_int"].Max, float64(1))
+	require.NotNil(t, stats.Fields["field4_float"])
+	require.Nil(t, stats.Fields["field1_string"])
+	require.Nil(t, stats.Fields["time"])
+
+	req.Mst = "mem"
+	stats, err = eng.measurementStats(req)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), stats.Rows)
+
+	req.Pts = []uint32{defaultPtId + 100}
+	_, err = eng.measurementStats(req)
+	require.Error(t, err)
+
+	_, err = netstorage.ParseMeasurementStatsRequest((&netstorage.MeasurementStatsRequest{Db: defaultDb}).SysCtrlRequest())
+	require.Error(t, err)
+}
@@ -70,7 +70,7 @@ func (t *tsMemTableImpl) WriteRecordForFlush(rec *record.Record, msb *immutable.
 	return msb
 }
 
-func (t *tsMemTableImpl) FlushChunks(table *MemTable, dataPath, msName, _, _ string, lock *string, tbStore immutable.TablesStore, _ int64, fileInfos chan []immutable.FileInfoExtend) {
+func (t *tsMemTableImpl) FlushChunks(table *MemTable, dataPath, msName, db, _ string, lock *string, tbStore immutable.TablesStore, _ int64, fileInfos chan []immutable.FileInfoExtend) {
 	msInfo, ok := table.msInfoMap[msName]
 	if !ok || msInfo == nil {
 		return
@@ -83,12 +83,14 @@ func (t *tsMemTableImpl) FlushChunks(table *MemTable, dataPath, msName, _, _ str
 	hlp := record.NewColumnSortHelper()
 	defer hlp.Release()
 
-	var orderMsBuilder, unOrderMsBuilder *immutable.MsBuilder
+	var orderMsBuilder *immutable.MsBuilder
 	var mmsIdTime *immutable.MmsIdTime
+	var orderRowsTotal, unOrderRowsTotal int64
 	var flushTime int64 = math.MinInt64
 
 	recPool := []record.Record{{}, {}}
 	hasOrderFile := tbStore.GetTableFileNum(msName, true) > 0
+	unOrderWriter := newUnorderedWriter(tbStore, lock, dataPath, msName, db)
 
 	if hasOrderFile {
 		seq := tbStore.Sequencer()
@@ -123,39 +125,40 @@ func (t *tsMemTableImpl) FlushChunks(table *MemTable, dataPath, msName, _, _ str
 
 		unOrderRows := unOrderRec.RowNums()
 		if unOrderRows > 0 {
-			if unOrderMsBuilder == nil {
-				conf := immutable.GetTsStoreConfig()
-				unOrderMsBuilder = createMsBuilder(tbStore, false, lock, dataPath, msName, sidLen, unOrderRows, conf, config.TSSTORE)
-			}
-			unOrderMsBuilder = t.WriteRecordForFlush(unOrderRec, unOrderMsBuilder, tbStore, chunk.Sid)
+			unOrderWriter.write(t, unOrderRec, chunk.Sid)
 			atomic.AddInt64(&Statistics.PerfStat.FlushUnOrderRowsCount, int64(unOrderRows))
 
 			t.statUnordered(unOrderRec.Times(), flushTime)
 		}
 
 		atomic.AddInt64(&Statistics.PerfStat.FlushRowsCount, int64(orderRows+unOrderRows))
+		orderRowsTotal += int64(orderRows)
+		unOrderRowsTotal += int64(unOrderRows)
 	}
 
 	orderFiles := t.finish(orderMsBuilder, fileInfos)
-	unOrderFiles := t.finish(unOrderMsBuilder, fileInfos)
+	unOrderFiles := unOrderWriter.finish(t, fileInfos)
+	Statistics.NewOOOTimeDistribution().AddRows(db, msName, orderRowsTotal, unOrderRowsTotal)
 
 	// add both ordered/unordered files to list
 	tbStore.AddBothTSSPFiles(msInfo.GetFlushed(), msName, orderFiles, unOrderFiles)
 	PutSidsImpl(sids)
 }
 
-func (t *tsMemTableImpl) FlushRecords(tbStore immutable.TablesStore, itr RecordIterator, msName, dataPath string,
+func (t *tsMemTableImpl) FlushRecords(tbStore immutable.TablesStore, itr RecordIterator, msName, dataPath, db string,
 	lock *string, fileInfos chan []immutable.FileInfoExtend) ([]immutable.TSSPFile, []immutable.TSSPFile) {
 
 	hlp := record.NewColumnSortHelper()
 	defer hlp.Release()
 
-	var orderMsBuilder, unOrderMsBuilder *immutable.MsBuilder
+	var orderMsBuilder *immutable.MsBuilder
 	var mmsIdTime *immutable.MmsIdTime
+	var orderRowsTotal, unOrderRowsTotal int64
 	var flushTime int64 = math.MinInt64
 
 	recPool := []record.Record{{}, {}}
 	hasOrderFile := tbStore.GetTableFileNum(msName, true) > 0
+	unOrderWriter := newUnorderedWriter(tbStore, lock, dataPath, msName, db)
 
 	if hasOrderFile {
 		seq := tbStore.Sequencer()
@@ -203,21 +206,20 @@ func (t *tsMemTableImpl) FlushRecords(tbStore immutable.TablesStore, itr RecordI
 
 		unOrderRows := unOrderRec.RowNums()
 		if unOrderRows > 0 {
-			if unOrderMsBuilder == nil {
-				conf := immutable.GetTsStoreConfig()
-				unOrderMsBuilder = createMsBuilder(tbStore, false, lock, dataPath, msName, 0, unOrderRows, conf, config.TSSTORE)
-			}
-			unOrderMsBuilder = t.WriteRecordForFlush(unOrderRec, unOrderMsBuilder, tbStore, sid)
+			unOrderWriter.write(t, unOrderRec, sid)
 			atomic.AddInt64(&Statistics.PerfStat.FlushUnOrderRowsCount, int64(unOrderRows))
 
 			t.statUnordered(unOrderRec.Times(), flushTime)
 		}
 
 		atomic.AddInt64(&Statistics.PerfStat.FlushRowsCount, int64(orderRows+unOrderRows))
+		orderRowsTotal += int64(orderRows)
+		unOrderRowsTotal += int64(unOrderRows)
 	}
 
 	orderFiles := t.finish(orderMsBuilder, fileInfos)
-	unOrderFiles := t.finish(unOrderMsBuilder, fileInfos)
+	unOrderFiles := unOrderWriter.finish(t, fileInfos)
+	Statistics.NewOOOTimeDistribution().AddRows(db, msName, orderRowsTotal, unOrderRowsTotal)
 
 	return orderFiles, unOrderFiles
 }
@@ -249,6 +251,100 @@ func (t *tsMemTableImpl) finish(msb *immutable.MsBuilder, fileInfos chan []immut
 	return files
 }
 
+// unorderedWriter writes the unordered data of a measurement into the files partitioned by the out-of-order window,
+// so that the late-arriving data of each window is only merged with the overlapping ordered files
+type unorderedWriter struct {
+	tbStore  immutable.TablesStore
+	lock     *string
+	dataPath string
+	msName   string
+
+	window   int64
+	builders map[int64]*immutable.MsBuilder
+	rec      record.Record
+}
+
+func newUnorderedWriter(tbStore immutable.TablesStore, lock *string, dataPath, msName, db string) *unorderedWriter {
+	return &unorderedWriter{
+		tbStore:  tbStore,
+		lock:     lock,
+		dataPath: dataPath,
+		msName:   msName,
+		window:   config.GetStoreConfig().Merge.OutOfOrderWindowOf(db),
+		builders: make(map[int64]*immutable.MsBuilder),
+	}
+}
+
+func (w *unorderedWriter) write(t *tsMemTableImpl, rec *record.Record, sid uint64) {
+	if w.window <= 0 {
+		w.writeWindow(t, 0, rec, sid)
+		return
+	}
+
+	times := rec.Times()
+	for start := 0; start < len(times); {
+		key := immutable.OutOfOrderWindowKey(times[start], w.window)
+		end := start + sort.Search(len(times)-start, func(i int) bool {
+			return immutable.OutOfOrderWindowKey(times[start+i], w.window) > key
+		})
+
+		sub := rec
+		if start > 0 || end < len(times) {
+			sub = sliceNotNilColumns(rec, &w.rec, start, end)
+		}
+		w.writeWindow(t, key, sub, sid)
+		start = end
+	}
+}
+
+func (w *unorderedWriter) writeWindow(t *tsMemTableImpl, key int64, rec *record.Record, sid uint64) {
+	msb, ok := w.builders[key]
+	if !ok {
+		conf := immutable.GetTsStoreConfig()
+		msb = createMsBuilder(w.tbStore, false, w.lock, w.dataPath, w.msName, 0, rec.RowNums(), conf, config.TSSTORE)
+	}
+	w.builders[key] = t.WriteRecordForFlush(rec, msb, w.tbStore, sid)
+}
+
+func (w *unorderedWriter) finish(t *tsMemTableImpl, fileInfos chan []immutable.FileInfoExtend) []immutable.TSSPFile {
+	if len(w.builders) == 0 {
+		return nil
+	}
+
+	keys := make([]int64, 0, len(w.builders))
+	for key := range w.builders {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	var files []immutable.TSSPFile
+	for _, key := range keys {
+		files = append(files, t.finish(w.builders[key], fileInfos)...)
+	}
+	return files
+}
+
+// sliceNotNilColumns copies the rows [start, end) of the record into dst, the columns without value are skipped
+func sliceNotNilColumns(rec *record.Record, dst *record.Record, start, end int) *record.Record {
+	dst.Reset()
+	dst.ReserveColVal(len(rec.Schema))
+
+	idx := 0
+	for i := range rec.Schema {
+		col := &dst.ColVals[idx]
+		col.Init()
+		col.AppendColVal(&rec.ColVals[i], rec.Schema[i].Type, start, end)
+		if col.NilCount != col.Len {
+			dst.Schema = append(dst.Schema, rec.Schema[i])
+			idx++
+		}
+	}
+	dst.ColVals = dst.ColVals[:idx]
+	return dst
+}
+
 func SplitRecordByTime(rec *record.Record, pool []record.Record, time int64) (*record.Record, *record.Record) {
 	times := rec.Times()
 	if time >= times[len(times)-1] {
@@ -0,0 +1,176 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+// native anomaly detection and forecast functions, they work like castor() without the python worker
+const (
+	MadAnomaly    = "mad_anomaly"
+	StlAnomaly    = "stl_anomaly"
+	EwmaAnomaly   = "ewma_anomaly"
+	ArimaForecast = "arima_forecast"
+)
+
+const (
+	DefaultMadThreshold = 3.5
+	DefaultStlThreshold = 3.0
+	DefaultEwmaAlpha    = 0.3
+	DefaultEwmaK        = 3.0
+	DefaultArimaOrder   = 2
+
+	MaxArimaOrder     = 10
+	MaxForecastPoints = 10000
+)
+
+// AnomalyArg describes a literal argument following the field of an anomaly function
+type AnomalyArg struct {
+	Name    string
+	Integer bool
+	// Check returns the reason why the value is invalid, empty if it is valid
+	Check func(v float64) string
+}
+
+type AnomalyOp struct {
+	BaseOp
+	factory  RoutineFactory
+	required int
+	args     []AnomalyArg
+}
+
+// NewAnomalyOp returns the op of an anomaly function, the first required arguments of args can not be omitted
+func NewAnomalyOp(factory RoutineFactory, name string, id uint64, required int, args ...AnomalyArg) *AnomalyOp {
+	op := &AnomalyOp{
+		factory:  factory,
+		required: required,
+		args:     args,
+	}
+	op.init(op, name, id, len(args)+1)
+	return op
+}
+
+func (op *AnomalyOp) Clone() Op {
+	clone := &AnomalyOp{factory: op.factory, required: op.required, args: op.args}
+	clone.init(clone, op.name, op.id, op.arity)
+	return clone
+}
+
+func (op *AnomalyOp) Factory() RoutineFactory {
+	return op.factory
+}
+
+func (op *AnomalyOp) CanPushDownSeries() bool {
+	return false
+}
+
+func (op *AnomalyOp) Type(args ...influxql.DataType) (influxql.DataType, error) {
+	// the anomaly score or the forecast value
+	if len(args) == 0 || !(args[0] == influxql.Float || args[0] == influxql.Integer) {
+		return influxql.Unknown, errno.NewError(errno.DtypeNotSupport)
+	}
+	return influxql.Float, nil
+}
+
+func (op *AnomalyOp) Compile(call *influxql.Call) error {
+	nargs := len(call.Args)
+	if nargs < op.required+1 || nargs > op.arity {
+		return errno.NewError(errno.InvalidArgsNum, op.name, op.arity, nargs)
+	}
+	for i, arg := range call.Args[1:] {
+		spec := op.args[i]
+		v, ok := AnomalyArgValue(arg)
+		if !ok || (spec.Integer && !isIntegerLiteral(arg)) {
+			expected := "number"
+			if spec.Integer {
+				expected = "integer"
+			}
+			return errno.NewError(errno.InvalidFuncArg, spec.Name, op.name, "expected "+expected+" literal")
+		}
+		if reason := spec.Check(v); reason != "" {
+			return errno.NewError(errno.InvalidFuncArg, spec.Name, op.name, reason)
+		}
+	}
+	return nil
+}
+
+// AnomalyArgValue returns the value of a numeric literal argument
+func AnomalyArgValue(arg influxql.Expr) (float64, bool) {
+	switch a := arg.(type) {
+	case *influxql.NumberLiteral:
+		return a.Val, true
+	case *influxql.IntegerLiteral:
+		return float64(a.Val), true
+	default:
+		return 0, false
+	}
+}
+
+func isIntegerLiteral(arg influxql.Expr) bool {
+	_, ok := arg.(*influxql.IntegerLiteral)
+	return ok
+}
+
+func positive(v float64) string {
+	if v <= 0 {
+		return "must be positive"
+	}
+	return ""
+}
+
+// NewAnomalyOps returns the ops of all the native anomaly functions
+func NewAnomalyOps(factory RoutineFactory) []*AnomalyOp {
+	return []*AnomalyOp{
+		// mad_anomaly(field[, threshold])
+		NewAnomalyOp(factory, MadAnomaly, MAD_ANOMALY_OP, 0,
+			AnomalyArg{Name: "threshold", Check: positive}),
+		// stl_anomaly(field, period[, threshold])
+		NewAnomalyOp(factory, StlAnomaly, STL_ANOMALY_OP, 1,
+			AnomalyArg{Name: "period", Integer: true, Check: func(v float64) string {
+				if v < 2 {
+					return "must be at least 2"
+				}
+				return ""
+			}},
+			AnomalyArg{Name: "threshold", Check: positive}),
+		// ewma_anomaly(field[, alpha[, k]])
+		NewAnomalyOp(factory, EwmaAnomaly, EWMA_ANOMALY_OP, 0,
+			AnomalyArg{Name: "alpha", Check: func(v float64) string {
+				if v <= 0 || v > 1 {
+					return "must be in (0, 1]"
+				}
+				return ""
+			}},
+			AnomalyArg{Name: "k", Check: positive}),
+		// arima_forecast(field, n[, p])
+		NewAnomalyOp(factory, ArimaForecast, ARIMA_FORECAST_OP, 1,
+			AnomalyArg{Name: "n", Integer: true, Check: func(v float64) string {
+				if v < 1 || v > MaxForecastPoints {
+					return fmt.Sprintf("must be in [1, %d]", MaxForecastPoints)
+				}
+				return ""
+			}},
+			AnomalyArg{Name: "p", Integer: true, Check: func(v float64) string {
+				if v < 1 || v > MaxArimaOrder {
+					return fmt.Sprintf("must be in [1, %d]", MaxArimaOrder)
+				}
+				return ""
+			}}),
+	}
+}
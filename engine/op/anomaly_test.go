@@ -0,0 +1,81 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op_test
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/op"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/require"
+)
+
+func findAnomalyOp(t *testing.T, name string) *op.AnomalyOp {
+	for _, o := range op.NewAnomalyOps(nil) {
+		if o.Name() == name {
+			return o
+		}
+	}
+	t.Fatalf("anomaly op %s not found", name)
+	return nil
+}
+
+func TestAnomalyOp_Compile(t *testing.T) {
+	field := &influxql.VarRef{Val: "f"}
+	for _, tc := range []struct {
+		name string
+		args []influxql.Expr
+		code errno.Errno
+	}{
+		{name: op.MadAnomaly, args: []influxql.Expr{field}},
+		{name: op.MadAnomaly, args: []influxql.Expr{field, &influxql.NumberLiteral{Val: 2.5}}},
+		{name: op.MadAnomaly, args: []influxql.Expr{field, &influxql.IntegerLiteral{Val: 0}}, code: errno.InvalidFuncArg},
+		{name: op.MadAnomaly, args: []influxql.Expr{field, &influxql.StringLiteral{Val: "3"}}, code: errno.InvalidFuncArg},
+		{name: op.StlAnomaly, args: []influxql.Expr{field}, code: errno.InvalidArgsNum},
+		{name: op.StlAnomaly, args: []influxql.Expr{field, &influxql.IntegerLiteral{Val: 24}, &influxql.NumberLiteral{Val: 3}}},
+		{name: op.StlAnomaly, args: []influxql.Expr{field, &influxql.NumberLiteral{Val: 24.5}}, code: errno.InvalidFuncArg},
+		{name: op.StlAnomaly, args: []influxql.Expr{field, &influxql.IntegerLiteral{Val: 1}}, code: errno.InvalidFuncArg},
+		{name: op.EwmaAnomaly, args: []influxql.Expr{field, &influxql.NumberLiteral{Val: 0.2}, &influxql.IntegerLiteral{Val: 3}}},
+		{name: op.EwmaAnomaly, args: []influxql.Expr{field, &influxql.NumberLiteral{Val: 1.2}}, code: errno.InvalidFuncArg},
+		{name: op.EwmaAnomaly, args: []influxql.Expr{field, &influxql.NumberLiteral{Val: 0.2}, &influxql.IntegerLiteral{Val: 3},
+			&influxql.IntegerLiteral{Val: 3}}, code: errno.InvalidArgsNum},
+		{name: op.ArimaForecast, args: []influxql.Expr{field, &influxql.IntegerLiteral{Val: 10}}},
+		{name: op.ArimaForecast, args: []influxql.Expr{field, &influxql.IntegerLiteral{Val: 10}, &influxql.IntegerLiteral{Val: 11}},
+			code: errno.InvalidFuncArg},
+		{name: op.ArimaForecast, args: []influxql.Expr{field, &influxql.IntegerLiteral{Val: op.MaxForecastPoints + 1}},
+			code: errno.InvalidFuncArg},
+	} {
+		err := findAnomalyOp(t, tc.name).Compile(&influxql.Call{Name: tc.name, Args: tc.args})
+		if tc.code == 0 {
+			require.NoError(t, err, tc.name)
+			continue
+		}
+		require.True(t, errno.Equal(err, tc.code), "%s: %v", tc.name, err)
+	}
+}
+
+func TestAnomalyOp_Type(t *testing.T) {
+	o := findAnomalyOp(t, op.ArimaForecast)
+	typ, err := o.Type(influxql.Integer, influxql.Integer)
+	require.NoError(t, err)
+	require.Equal(t, influxql.Float, typ)
+
+	_, err = o.Type(influxql.String, influxql.Integer)
+	require.True(t, errno.Equal(err, errno.DtypeNotSupport))
+
+	require.False(t, o.CanPushDownSeries())
+	require.True(t, o.EqualTo(o.Clone()))
+}
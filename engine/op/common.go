@@ -20,5 +20,9 @@ const (
 	SUM_OP
 	COUNT_OP
 	CASTOR_OP
+	MAD_ANOMALY_OP
+	STL_ANOMALY_OP
+	EWMA_ANOMALY_OP
+	ARIMA_FORECAST_OP
 	UNKNOWN_OP
 )
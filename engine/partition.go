@@ -103,6 +103,10 @@ type DBPTInfo struct {
 	doingOff            bool
 	doingShardMoveN     int
 	dbObsOptions        *obs.ObsOptions
+
+	// loads of the db pt since it is loaded, ts-meta balances the db pts by their loads
+	writeRows   int64
+	queryTimeNs int64
 }
 
 func NewDBPTInfo(db string, id uint32, dataPath, walPath string, ctx *metaclient.LoadCtx, ch chan []immutable.FileInfoExtend, options *obs.ObsOptions) *DBPTInfo {
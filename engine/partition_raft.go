@@ -39,6 +39,7 @@ type raftNodeRequest interface {
 	RemoveCommittedDataC(*raftlog.DataWrapper)
 	RetCommittedDataC(*raftlog.DataWrapper, error)
 	TransferLeadership(newLeader uint64) error
+	ReadState() netstorage.ReplicaReadState
 	Stop()
 }
 
@@ -78,6 +79,7 @@ func readCommitFromRaft(node *raftconn.RaftNode, client metaclient.MetaClient, s
 			dealCommitData(node, client, storage, data, database, ptId)
 		}
 		node.SnapShotter.TryToUpdateCommittedIndex(committedIndex)
+		node.SetStorageApplied(committedIndex)
 	}
 }
 
@@ -31,9 +31,11 @@ import (
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/resourceallocator"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"go.uber.org/zap"
 )
@@ -45,6 +47,9 @@ func CreateCursor(ctx context.Context, schema *executor.QuerySchema, span *traci
 		cloneMsSpan = span.StartSpan("clone_measurement").StartPP()
 		defer span.Finish()
 	}
+	if scanStat := scanRecorder(ctx); scanStat != nil {
+		scanStat.AddScan(int64(seriesNum), 0, 0)
+	}
 
 	// get all of immTable and memTables from multiple shards
 	var OrderFileCount, outOrderFileCount int
@@ -130,6 +135,8 @@ func initGroupCursors(ctx context.Context, qCtx *idKeyCursorContext, schema *exe
 	if !ok || closedSignal == nil {
 		log.GetZapLogger().Warn("there is no aborted signal to init group cursor")
 	}
+	queryStat, _ := ctx.Value(query.QueryDurationKey).(*statistics.StoreSlowQueryStatistics)
+	scanStat := scanRecorder(ctx)
 
 	groupCursors := make(comm.KeyCursors, 0, parallelism)
 	for groupIdx := 0; groupIdx < parallelism; groupIdx++ {
@@ -153,8 +160,11 @@ func initGroupCursors(ctx context.Context, qCtx *idKeyCursorContext, schema *exe
 				closedSignal:    closedSignal,
 				immTableReaders: qCtx.immTableReaders,
 				memTableReader:  qCtx.memTableReader,
+				queryStat:       queryStat,
+				scanStat:        scanStat,
 			},
 		}
+		c.ctx.decs.SetColdReadStat(&immutable.ColdReadStat{})
 		c.seriesTagFunc = c.getSeriesTags
 		if groupIdx == 0 {
 			err := newCursorSchema(c.ctx, schema)
@@ -0,0 +1,114 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+)
+
+func (e *Engine) addDbPTWriteRows(db string, ptId uint32, n int64) {
+	if dbPT := e.getDBPTInfo(db, ptId); dbPT != nil {
+		atomic.AddInt64(&dbPT.writeRows, n)
+	}
+}
+
+// AddDbPTQueryTime accumulates the time of the queries of the db pt on this node,
+// the time of the queries approximates the cpu used by them
+func (e *Engine) AddDbPTQueryTime(db string, ptId uint32, d time.Duration) {
+	if dbPT := e.getDBPTInfo(db, ptId); dbPT != nil {
+		atomic.AddInt64(&dbPT.queryTimeNs, int64(d))
+	}
+}
+
+// ptLoads returns the loads of the loaded db pts, the size of a db pt is the size of its data and wal files
+func (e *Engine) ptLoads() ([]*netstorage.PtLoad, error) {
+	e.mu.RLock()
+	var loads []*netstorage.PtLoad
+	for db, pts := range e.DBPartitions {
+		for pt, dbPT := range pts {
+			loads = append(loads, &netstorage.PtLoad{
+				Db:          db,
+				Pt:          pt,
+				Rows:        atomic.LoadInt64(&dbPT.writeRows),
+				QueryTimeNs: atomic.LoadInt64(&dbPT.queryTimeNs),
+			})
+		}
+	}
+	e.mu.RUnlock()
+
+	sort.Slice(loads, func(i, j int) bool {
+		if loads[i].Db != loads[j].Db {
+			return loads[i].Db < loads[j].Db
+		}
+		return loads[i].Pt < loads[j].Pt
+	})
+	for _, load := range loads {
+		for _, dir := range []string{e.ptDataPath(load.Db, load.Pt), e.ptWalPath(load.Db, load.Pt)} {
+			size, err := dirSize(dir)
+			if err != nil {
+				return nil, err
+			}
+			load.Size += size
+		}
+	}
+	return loads, nil
+}
+
+// dirSize returns the size of the files in the directory, the files removed by the
+// compaction or the flush during the walk are ignored
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
+
+func (e *Engine) getPtLoads() (map[string]string, error) {
+	loads, err := e.ptLoads()
+	if err != nil {
+		return nil, err
+	}
+	buf, err := json.Marshal(loads)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"loads": string(buf)}, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_PtLoads(t *testing.T) {
+	e := newDecommissionTestEngine(t)
+	e.DBPartitions["db0"] = map[uint32]*DBPTInfo{1: {}, 0: {}}
+	writePtTestFile(t, path.Join(e.ptDataPath("db0", 1), "rp0", "1_0_0", "tssp", "f.tssp"), "tssp")
+	writePtTestFile(t, path.Join(e.ptWalPath("db0", 1), "rp0", "1_0_0", "1.wal"), "wal")
+
+	e.addDbPTWriteRows("db0", 1, 10)
+	e.addDbPTWriteRows("db0", 1, 5)
+	e.AddDbPTQueryTime("db0", 1, time.Second)
+	// the db pts not loaded are ignored
+	e.addDbPTWriteRows("db1", 1, 10)
+	e.AddDbPTQueryTime("db0", 2, time.Second)
+
+	res, err := e.processReq(netstorage.NewPtLoadRequest())
+	require.NoError(t, err)
+	var loads []*netstorage.PtLoad
+	require.NoError(t, json.Unmarshal([]byte(res["loads"]), &loads))
+	require.Equal(t, []*netstorage.PtLoad{
+		{Db: "db0", Pt: 0},
+		{Db: "db0", Pt: 1, Size: 7, Rows: 15, QueryTimeNs: int64(time.Second)},
+	}, loads)
+}
@@ -0,0 +1,276 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// repairSeriesBatch is the number of series read at a time when the digests are computed
+const repairSeriesBatch = 1024
+
+type repairWindowKey struct {
+	mst    string
+	window int64
+}
+
+// repairDigester accumulates the digests of the series read from the shards of a pt.
+// Only the rows in the TSSP files are digested, the rows in the memtables are not
+type repairDigester struct {
+	window    int64
+	perSeries bool
+
+	windows map[repairWindowKey]*netstorage.RepairDigest
+	series  map[string]*netstorage.RepairDigest
+	hash    *xxhash.Digest
+	buf     []byte
+}
+
+func newRepairDigester(window int64, perSeries bool) *repairDigester {
+	return &repairDigester{
+		window:    window,
+		perSeries: perSeries,
+		windows:   make(map[repairWindowKey]*netstorage.RepairDigest),
+		series:    make(map[string]*netstorage.RepairDigest),
+		hash:      xxhash.New(),
+	}
+}
+
+// windowStart returns the start of the window containing t, the windows are aligned to the unix epoch
+func (d *repairDigester) windowStart(t int64) int64 {
+	m := t % d.window
+	if m < 0 {
+		m += d.window
+	}
+	return t - m
+}
+
+// add splits the rows of the series by the windows, the rows of a series in a window are hashed together
+// with the series key, the series ids are not hashed since they are local to each replica
+func (d *repairDigester) add(mst string, key []byte, rec *record.Record) {
+	times := rec.Times()
+	for start := 0; start < len(times); {
+		window := d.windowStart(times[start])
+		end := start + 1
+		for end < len(times) && d.windowStart(times[end]) == window {
+			end++
+		}
+
+		h := d.hashRows(key, rec, start, end)
+		rows := int64(end - start)
+		wd, ok := d.windows[repairWindowKey{mst: mst, window: window}]
+		if !ok {
+			wd = &netstorage.RepairDigest{Mst: mst, Window: window}
+			d.windows[repairWindowKey{mst: mst, window: window}] = wd
+		}
+		wd.Series++
+		wd.Rows += rows
+		wd.Hash += h
+
+		if d.perSeries {
+			sd, ok := d.series[string(key)]
+			if !ok {
+				sd = &netstorage.RepairDigest{Mst: mst, Key: hex.EncodeToString(key), Series: 1}
+				d.series[string(key)] = sd
+			}
+			sd.Rows += rows
+			sd.Hash += h
+		}
+		start = end
+	}
+}
+
+// hashRows hashes the time and the non-null fields of the rows, the null fields are skipped
+// since the replicas may have different columns for the same series after compaction
+func (d *repairDigester) hashRows(key []byte, rec *record.Record, start, end int) uint64 {
+	d.hash.Reset()
+	_, _ = d.hash.Write(key)
+	times := rec.Times()
+	timeCol := rec.ColNums() - 1
+	for i := start; i < end; i++ {
+		d.buf = binary.BigEndian.AppendUint64(d.buf[:0], uint64(times[i]))
+		for c := 0; c < timeCol; c++ {
+			col := rec.Column(c)
+			if col.IsNil(i) {
+				continue
+			}
+			d.buf = append(d.buf, rec.Schema[c].Name...)
+			d.buf = append(d.buf, byte(rec.Schema[c].Type))
+			d.buf = appendRepairValue(d.buf, rec.Schema[c].Type, col, i)
+		}
+		_, _ = d.hash.Write(d.buf)
+	}
+	return d.hash.Sum64()
+}
+
+func appendRepairValue(dst []byte, typ int, col *record.ColVal, i int) []byte {
+	switch typ {
+	case influx.Field_Type_Int:
+		v, _ := col.IntegerValue(i)
+		return binary.BigEndian.AppendUint64(dst, uint64(v))
+	case influx.Field_Type_Float:
+		v, _ := col.FloatValue(i)
+		return binary.BigEndian.AppendUint64(dst, math.Float64bits(v))
+	case influx.Field_Type_Boolean:
+		if v, _ := col.BooleanValue(i); v {
+			return append(dst, 1)
+		}
+		return append(dst, 0)
+	case influx.Field_Type_String:
+		v, _ := col.StringValue(i)
+		dst = binary.BigEndian.AppendUint32(dst, uint32(len(v)))
+		return append(dst, v...)
+	}
+	return dst
+}
+
+func (d *repairDigester) digests() []*netstorage.RepairDigest {
+	var digests []*netstorage.RepairDigest
+	if d.perSeries {
+		digests = make([]*netstorage.RepairDigest, 0, len(d.series))
+		for _, sd := range d.series {
+			digests = append(digests, sd)
+		}
+	} else {
+		digests = make([]*netstorage.RepairDigest, 0, len(d.windows))
+		for _, wd := range d.windows {
+			digests = append(digests, wd)
+		}
+	}
+	netstorage.SortRepairDigests(digests)
+	return digests
+}
+
+// getRepairDigests computes the digests of the shards of the pt requested by the anti-entropy repair
+func (e *Engine) getRepairDigests(req *netstorage.SysCtrlRequest) (map[string]string, error) {
+	r, err := netstorage.ParseRepairDigestRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	digests, err := e.computeRepairDigests(r)
+	if err != nil {
+		return nil, err
+	}
+	return netstorage.EncodeRepairDigests(digests)
+}
+
+func (e *Engine) computeRepairDigests(r *netstorage.RepairDigestRequest) ([]*netstorage.RepairDigest, error) {
+	if err := e.DbPTRef(r.Db, r.Pt); err != nil {
+		return nil, err
+	}
+	defer e.DbPTUnref(r.Db, r.Pt)
+
+	shards, err := e.ptShardsByTime(r.Db, r.Rp, r.Pt, r.TimeRange)
+	if err != nil {
+		return nil, err
+	}
+
+	d := newRepairDigester(r.Window, r.PerSeries)
+	for _, sh := range shards {
+		store, iBuild := sh.GetTableStore(), sh.GetIndexBuilder()
+		if store == nil || iBuild == nil {
+			continue
+		}
+		idx, ok := iBuild.GetPrimaryIndex().(*tsi.MergeSetIndex)
+		if !ok {
+			continue
+		}
+
+		for _, mst := range uniqueMsts(store.GetAllMstList()) {
+			if r.Mst != "" && mst != r.Mst {
+				continue
+			}
+			if err = digestRepairMeasurement(d, store, idx, mst, r.TimeRange); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return d.digests(), nil
+}
+
+func digestRepairMeasurement(d *repairDigester, store immutable.TablesStore, idx *tsi.MergeSetIndex, mst string, tr util.TimeRange) error {
+	sids, err := repairSeriesIDs(store, mst, tr)
+	if err != nil {
+		return err
+	}
+
+	for len(sids) > 0 {
+		n := repairSeriesBatch
+		if n > len(sids) {
+			n = len(sids)
+		}
+		keys := make(map[uint64][]byte, n)
+		for _, sid := range sids[:n] {
+			key, err := idx.SeriesKey(nil, sid)
+			if err != nil {
+				return fmt.Errorf("series key of %d: %v", sid, err)
+			}
+			keys[sid] = key
+		}
+		sids = sids[n:]
+
+		recs, err := readScrubSeries(store, mst, keys, tr)
+		if err != nil {
+			return err
+		}
+		for sid, rec := range recs {
+			d.add(mst, keys[sid], rec)
+		}
+	}
+	return nil
+}
+
+// repairSeriesIDs returns the sorted ids of the series of the measurement having rows within the time range
+func repairSeriesIDs(store immutable.TablesStore, mst string, tr util.TimeRange) ([]uint64, error) {
+	order, unordered, _ := store.GetBothFilesRef(mst, true, tr, nil)
+	defer func() {
+		immutable.UnrefFiles(order...)
+		immutable.UnrefFiles(unordered...)
+	}()
+
+	set := make(map[uint64]struct{})
+	for _, files := range [][]immutable.TSSPFile{order, unordered} {
+		for _, f := range files {
+			err := immutable.WalkChunkMetas(f, func(_ int, _ *immutable.MetaIndex, cm *immutable.ChunkMeta) error {
+				if minTime, maxTime := cm.MinMaxTime(); minTime <= tr.Max && maxTime >= tr.Min {
+					set[cm.GetSid()] = struct{}{}
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sids := make([]uint64, 0, len(set))
+	for sid := range set {
+		sids = append(sids, sid)
+	}
+	sort.Slice(sids, func(i, j int) bool { return sids[i] < sids[j] })
+	return sids, nil
+}
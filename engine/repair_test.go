@@ -0,0 +1,128 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func newRepairRecord(times []int64, values []float64) *record.Record {
+	rec := record.NewRecord(record.Schemas{
+		{Name: "value", Type: influx.Field_Type_Float},
+		{Name: record.TimeField, Type: influx.Field_Type_Int},
+	}, false)
+	for i := range times {
+		rec.ColVals[0].AppendFloat(values[i])
+		rec.ColVals[1].AppendInteger(times[i])
+	}
+	return rec
+}
+
+func TestRepairDigester(t *testing.T) {
+	rec1 := newRepairRecord([]int64{-5, 1, 5, 12}, []float64{1, 2, 3, 4})
+	rec2 := newRepairRecord([]int64{3, 15}, []float64{5, 6})
+
+	d := newRepairDigester(10, false)
+	d.add("cpu", []byte("k1"), rec1)
+	d.add("cpu", []byte("k2"), rec2)
+	digests := d.digests()
+	require.Equal(t, 3, len(digests))
+	require.Equal(t, []int64{-10, 0, 10}, []int64{digests[0].Window, digests[1].Window, digests[2].Window})
+	require.Equal(t, []int64{1, 3, 2}, []int64{digests[0].Rows, digests[1].Rows, digests[2].Rows})
+	require.Equal(t, []int{1, 2, 2}, []int{digests[0].Series, digests[1].Series, digests[2].Series})
+
+	// the digests do not depend on the order the series are read in
+	other := newRepairDigester(10, false)
+	other.add("cpu", []byte("k2"), rec2)
+	other.add("cpu", []byte("k1"), rec1)
+	for i, od := range other.digests() {
+		require.True(t, digests[i].Equal(od))
+	}
+
+	// a changed value changes the digest of its window only
+	changed := newRepairDigester(10, false)
+	changed.add("cpu", []byte("k1"), newRepairRecord([]int64{-5, 1, 5, 12}, []float64{1, 2, 3, 40}))
+	changed.add("cpu", []byte("k2"), rec2)
+	cd := changed.digests()
+	require.True(t, digests[0].Equal(cd[0]))
+	require.True(t, digests[1].Equal(cd[1]))
+	require.False(t, digests[2].Equal(cd[2]))
+
+	series := newRepairDigester(10, true)
+	series.add("cpu", []byte("k1"), rec1)
+	series.add("cpu", []byte("k2"), rec2)
+	sd := series.digests()
+	require.Equal(t, 2, len(sd))
+	require.Equal(t, hex.EncodeToString([]byte("k1")), sd[0].Key)
+	require.Equal(t, int64(4), sd[0].Rows)
+	require.Equal(t, int64(2), sd[1].Rows)
+}
+
+func TestEngine_RepairDigests(t *testing.T) {
+	eng, err := initEngine(t.TempDir())
+	require.NoError(t, err)
+	defer eng.Close()
+
+	tm := mustParseTime(time.RFC3339Nano, "1999-06-01T00:00:00Z")
+	points, _, _ := GenDataRecord([]string{"cpu"}, 10, 20, time.Second, tm, false, true, false)
+	require.NoError(t, eng.WriteRows(defaultDb, defaultRp, defaultPtId, defaultShardId, points, nil, nil))
+	eng.ForceFlush()
+
+	tr := util.TimeRange{Min: tm.UnixNano(), Max: tm.Add(time.Hour).UnixNano()}
+	req := &netstorage.RepairDigestRequest{Db: defaultDb, Rp: defaultRp, Pt: defaultPtId, TimeRange: tr, Window: int64(5 * time.Second)}
+	res, err := eng.processReq(req.SysCtrlRequest())
+	require.NoError(t, err)
+	windows, err := netstorage.DecodeRepairDigests(res)
+	require.NoError(t, err)
+	require.NotEmpty(t, windows)
+	var rows int64
+	for _, d := range windows {
+		require.Equal(t, "cpu", d.Mst)
+		rows += d.Rows
+	}
+
+	req.Mst, req.PerSeries = "cpu", true
+	res, err = eng.processReq(req.SysCtrlRequest())
+	require.NoError(t, err)
+	series, err := netstorage.DecodeRepairDigests(res)
+	require.NoError(t, err)
+	require.NotEmpty(t, series)
+	var seriesRows int64
+	for _, d := range series {
+		require.NotEmpty(t, d.Key)
+		seriesRows += d.Rows
+	}
+	require.Equal(t, rows, seriesRows)
+
+	// the digests are stable
+	again, err := eng.computeRepairDigests(req)
+	require.NoError(t, err)
+	require.Equal(t, len(series), len(again))
+	for i := range series {
+		require.True(t, series[i].Equal(again[i]))
+	}
+
+	req.Pt = defaultPtId + 100
+	_, err = eng.processReq(req.SysCtrlRequest())
+	require.Error(t, err)
+}
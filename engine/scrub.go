@@ -0,0 +1,560 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/record"
+	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+var errScrubStopped = errors.New("scrub stopped")
+
+type scrubShard struct {
+	db string
+	pt uint32
+	sh Shard
+}
+
+// Scrub reads all the TSSP files, index parts and closed wal files of the opened shards to find the silent corruptions.
+// The corrupt series of TSSP files are re-fetched from the peer replicas if opt.Fetcher is set and the database is replicated
+func (e *Engine) Scrub(opt *netstorage.ScrubOptions) {
+	start := time.Now()
+	item := stat.NewScrub()
+	verifiedIndexes := make(map[*tsi.MergeSetIndex]struct{})
+	for _, s := range e.scrubShards() {
+		if err := e.DbPTRef(s.db, s.pt); err != nil {
+			continue
+		}
+		info, err := e.scrubShard(s, opt, verifiedIndexes)
+		e.DbPTUnref(s.db, s.pt)
+		if err == errScrubStopped {
+			log.Info("scrub stopped", zap.Duration("duration", time.Since(start)))
+			return
+		}
+
+		item.FilesScrubbed.Add(int64(info.Files))
+		item.BytesScrubbed.Add(info.Bytes)
+		item.CorruptFiles.Add(int64(len(info.Corrupt)))
+		item.RepairedFiles.Add(int64(len(info.Repaired)))
+
+		e.scrubMu.Lock()
+		if e.scrubs == nil {
+			e.scrubs = make(map[uint64]*netstorage.ScrubInfo)
+		}
+		e.scrubs[info.ShardID] = info
+		e.scrubMu.Unlock()
+	}
+
+	item.Rounds.Incr()
+	item.LastDuration.Store(time.Since(start).Milliseconds())
+	log.Info("scrub finished", zap.Duration("duration", time.Since(start)))
+}
+
+func (e *Engine) scrubShards() []scrubShard {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var shards []scrubShard
+	for db, partitions := range e.DBPartitions {
+		for pt, dbPTInfo := range partitions {
+			dbPTInfo.mu.RLock()
+			for _, sh := range dbPTInfo.shards {
+				if sh.IsOpened() {
+					shards = append(shards, scrubShard{db: db, pt: pt, sh: sh})
+				}
+			}
+			dbPTInfo.mu.RUnlock()
+		}
+	}
+	sort.Slice(shards, func(i, j int) bool { return shards[i].sh.GetID() < shards[j].sh.GetID() })
+	return shards
+}
+
+func (e *Engine) scrubShard(s scrubShard, opt *netstorage.ScrubOptions, verifiedIndexes map[*tsi.MergeSetIndex]struct{}) (*netstorage.ScrubInfo, error) {
+	info := &netstorage.ScrubInfo{
+		Database:  s.db,
+		PtID:      s.pt,
+		ShardID:   s.sh.GetID(),
+		LastScrub: time.Now().UnixNano(),
+	}
+	lg := log.With(zap.String("db", s.db), zap.Uint32("pt", s.pt), zap.Uint64("shard", info.ShardID))
+
+	stopped := func() bool {
+		select {
+		case <-opt.Closed:
+			return true
+		default:
+			return false
+		}
+	}
+	throttle := func(n int) {
+		info.Bytes += int64(n)
+		if opt.Throttle != nil {
+			opt.Throttle(n)
+		}
+	}
+
+	if store := s.sh.GetTableStore(); store != nil {
+		for _, mst := range uniqueMsts(store.GetAllMstList()) {
+			if stopped() {
+				return info, errScrubStopped
+			}
+			e.scrubMeasurement(s, store, mst, opt, info, throttle, lg)
+		}
+	}
+
+	if iBuild := s.sh.GetIndexBuilder(); iBuild != nil {
+		if idx, ok := iBuild.GetPrimaryIndex().(*tsi.MergeSetIndex); ok {
+			// the index is shared by the shards of the index group
+			if _, ok = verifiedIndexes[idx]; !ok {
+				verifiedIndexes[idx] = struct{}{}
+				parts, corruptions := idx.VerifyParts(throttle)
+				info.Files += parts
+				for i := range corruptions {
+					lg.Error("corrupt index part", zap.String("part", corruptions[i].Path), zap.Error(corruptions[i].Err))
+					info.Corrupt = append(info.Corrupt, corruptions[i].String())
+				}
+			}
+		}
+	}
+
+	for _, name := range closedWalFiles(s.sh.GetWalPath()) {
+		if stopped() {
+			return info, errScrubStopped
+		}
+		info.Files++
+		if err := verifyWalFile(name, throttle); err != nil {
+			lg.Error("corrupt wal file", zap.String("file", name), zap.Error(err))
+			info.Corrupt = append(info.Corrupt, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+	return info, nil
+}
+
+func uniqueMsts(msts []string) []string {
+	sort.Strings(msts)
+	n := 0
+	for i := range msts {
+		if i == 0 || msts[i] != msts[n-1] {
+			msts[n] = msts[i]
+			n++
+		}
+	}
+	return msts[:n]
+}
+
+func (e *Engine) scrubMeasurement(s scrubShard, store immutable.TablesStore, mst string, opt *netstorage.ScrubOptions,
+	info *netstorage.ScrubInfo, throttle func(int), lg *logger.Logger) {
+	order, unordered, _ := store.GetBothFilesRef(mst, false, util.TimeRange{}, nil)
+	defer func() {
+		immutable.UnrefFiles(order...)
+		immutable.UnrefFiles(unordered...)
+	}()
+
+	for _, files := range [][]immutable.TSSPFile{order, unordered} {
+		for _, f := range files {
+			info.Files++
+			res := immutable.VerifyTSSPFileThrottled(f, throttle)
+			if len(res.Corruptions) == 0 {
+				continue
+			}
+
+			path := f.Path()
+			lg.Error("corrupt tssp file", zap.String("file", path), zap.Int("corruptions", len(res.Corruptions)),
+				zap.String("first", res.Corruptions[0].String()))
+			info.Corrupt = append(info.Corrupt, fmt.Sprintf("%s: %s", path, res.Corruptions[0]))
+			if opt.Fetcher == nil {
+				continue
+			}
+
+			if err := e.repairTSSPFile(s, store, mst, f, res, opt.Fetcher); err != nil {
+				stat.NewScrub().RepairFailures.Incr()
+				lg.Error("repair tssp file failed", zap.String("file", path), zap.Error(err))
+				continue
+			}
+			lg.Info("tssp file repaired from peer replica", zap.String("file", path))
+			info.Repaired = append(info.Repaired, path)
+		}
+	}
+}
+
+// repairTSSPFile re-fetches the corrupt series of the file from a peer replica,
+// the series ids differ between the replicas so the series are fetched by the series keys
+func (e *Engine) repairTSSPFile(s scrubShard, store immutable.TablesStore, mst string, f immutable.TSSPFile,
+	res *immutable.TSSPVerifyResult, fetcher netstorage.ScrubFetcher) error {
+	sids := make(map[uint64]struct{})
+	for _, c := range res.Corruptions {
+		if c.Sid == 0 {
+			return fmt.Errorf("corruption out of chunks cannot be repaired: %v", c.Err)
+		}
+		sids[c.Sid] = struct{}{}
+	}
+
+	peers, err := e.scrubPeers(s.db, s.pt)
+	if err != nil {
+		return err
+	}
+	if len(peers) == 0 {
+		return errors.New("no peer replica available")
+	}
+
+	iBuild := s.sh.GetIndexBuilder()
+	if iBuild == nil {
+		return errors.New("shard is closed")
+	}
+	idx, ok := iBuild.GetPrimaryIndex().(*tsi.MergeSetIndex)
+	if !ok {
+		return errors.New("repair is only supported by the merge set index")
+	}
+	keys := make([][]byte, 0, len(sids))
+	keySids := make(map[string]uint64, len(sids))
+	for sid := range sids {
+		key, err := idx.SeriesKey(nil, sid)
+		if err != nil {
+			return fmt.Errorf("series key of %d: %v", sid, err)
+		}
+		keys = append(keys, key)
+		keySids[string(key)] = sid
+	}
+
+	minTime, maxTime := f.FileStat().MinMaxTime()
+	tr := util.TimeRange{Min: minTime, Max: maxTime}
+	var fetched map[string]*record.Record
+	for _, peer := range peers {
+		fetched, err = fetcher.FetchScrubSeries(peer.Owner.NodeID, s.db, s.sh.GetRPName(), peer.PtId, mst, tr, keys)
+		if err == nil {
+			break
+		}
+		log.Warn("fetch series from peer replica failed", zap.Uint64("node", peer.Owner.NodeID), zap.Uint32("pt", peer.PtId), zap.Error(err))
+	}
+	if err != nil {
+		return err
+	}
+
+	recs := make(map[uint64]*record.Record, len(fetched))
+	for key, rec := range fetched {
+		if sid, ok := keySids[key]; ok {
+			recs[sid] = rec
+		}
+	}
+	_, err = store.RepairFile(mst, f, recs)
+	return err
+}
+
+// scrubPeers returns the online pts in the same replication group as the pt
+func (e *Engine) scrubPeers(db string, pt uint32) ([]meta2.PtInfo, error) {
+	if e.metaClient == nil {
+		return nil, nil
+	}
+	dbi, err := e.metaClient.Database(db)
+	if err != nil {
+		return nil, err
+	}
+	if dbi.ReplicaN <= 1 {
+		return nil, nil
+	}
+
+	views, err := e.metaClient.DBPtView(db)
+	if err != nil {
+		return nil, err
+	}
+	var rgID uint32
+	for _, view := range views {
+		if view.PtId == pt {
+			rgID = view.RGID
+			break
+		}
+	}
+	var peers []meta2.PtInfo
+	for _, view := range views {
+		if view.PtId != pt && view.RGID == rgID && view.Status == meta2.Online {
+			peers = append(peers, view)
+		}
+	}
+	return peers, nil
+}
+
+// closedWalFiles returns the wal files of every partition except the newest one which may be in writing
+func closedWalFiles(walPath string) []string {
+	partitions, err := fileops.ReadDir(walPath)
+	if err != nil {
+		return nil
+	}
+
+	var files []string
+	for _, p := range partitions {
+		if !p.IsDir() {
+			continue
+		}
+		dir := filepath.Join(walPath, p.Name())
+		entries, err := fileops.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		var seqs []int
+		for _, entry := range entries {
+			name := entry.Name()
+			if !strings.HasSuffix(name, "."+WALFileSuffixes) {
+				continue
+			}
+			seq, err := strconv.Atoi(strings.TrimSuffix(name, "."+WALFileSuffixes))
+			if err == nil {
+				seqs = append(seqs, seq)
+			}
+		}
+		sort.Ints(seqs)
+		for i := 0; i < len(seqs)-1; i++ {
+			files = append(files, filepath.Join(dir, fmt.Sprintf("%d.%s", seqs[i], WALFileSuffixes)))
+		}
+	}
+	return files
+}
+
+// verifyWalFile checks the type, length and snappy encoding of every record of the wal file,
+// the line protocol rows are unmarshalled too
+func verifyWalFile(name string, throttle func(int)) error {
+	lock := fileops.FileLockOption("")
+	pri := fileops.FilePriorityOption(fileops.IO_PRIORITY_LOW_READ)
+	fd, err := fileops.OpenFile(name, os.O_RDONLY, 0600, lock, pri)
+	if err != nil {
+		return err
+	}
+	defer util.MustClose(fd)
+
+	var (
+		header  [WalRecordHeadSize]byte
+		comp    []byte
+		decoded []byte
+		offset  int64
+	)
+	objs := getWalRowsObjects()
+	defer putWalRowsObjects(objs)
+
+	fr := bufio.NewReader(fd)
+	for {
+		if _, err = io.ReadFull(fr, header[:]); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("read record header at %d: %v", offset, err)
+		}
+
+		typ := WalRecordType(header[0])
+		if typ <= WriteWalUnKnownType || typ >= WriteWalEnd {
+			return fmt.Errorf("unknown record type %d at %d", typ, offset)
+		}
+		size := binary.BigEndian.Uint32(header[1:])
+		comp = append(comp[:0], make([]byte, size)...)
+		if _, err = io.ReadFull(fr, comp); err != nil {
+			return fmt.Errorf("read record of %d bytes at %d: %v", size, offset, err)
+		}
+		if decoded, err = snappy.Decode(decoded[:cap(decoded)], comp); err != nil {
+			return fmt.Errorf("decode record at %d: %v", offset, err)
+		}
+		if typ == WriteWalLineProtocol {
+			objs.rows, objs.tags, objs.fields, objs.opts, objs.keys, err = influx.FastUnmarshalMultiRows(decoded, objs.rows, objs.tags, objs.fields, objs.opts, objs.keys)
+			if err != nil {
+				return fmt.Errorf("unmarshal rows at %d: %v", offset, err)
+			}
+		}
+
+		offset += WalRecordHeadSize + int64(size)
+		throttle(WalRecordHeadSize + int(size))
+	}
+}
+
+// fetchScrubSeries reads the series requested by a peer replica from the shards of the pt
+func (e *Engine) fetchScrubSeries(req *netstorage.SysCtrlRequest) (map[string]string, error) {
+	db, rp, pt, mst, tr, keys, err := netstorage.ParseScrubFetchSeriesRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err = e.DbPTRef(db, pt); err != nil {
+		return nil, err
+	}
+	defer e.DbPTUnref(db, pt)
+
+	shards, err := e.ptShardsByTime(db, rp, pt, tr)
+	if err != nil {
+		return nil, err
+	}
+
+	recs := make(map[string]*record.Record, len(keys))
+	for _, sh := range shards {
+		iBuild := sh.GetIndexBuilder()
+		if iBuild == nil {
+			continue
+		}
+		idx, ok := iBuild.GetPrimaryIndex().(*tsi.MergeSetIndex)
+		if !ok {
+			return nil, errors.New("fetch series is only supported by the merge set index")
+		}
+
+		sids := make(map[uint64][]byte, len(keys))
+		for _, key := range keys {
+			sid, err := idx.GetSeriesIdBySeriesKey(key)
+			if err != nil {
+				return nil, err
+			}
+			if sid != 0 {
+				sids[sid] = key
+			}
+		}
+		if len(sids) == 0 {
+			continue
+		}
+
+		shardRecs, err := readScrubSeries(sh.GetTableStore(), mst, sids, tr)
+		if err != nil {
+			return nil, err
+		}
+		for sid, rec := range shardRecs {
+			key := string(sids[sid])
+			recs[key] = mergeScrubRecord(rec, recs[key])
+		}
+	}
+
+	res := make(map[string]string, len(recs))
+	for key, rec := range recs {
+		netstorage.EncodeScrubSeries(res, []byte(key), rec)
+	}
+	return res, nil
+}
+
+// ptShardsByTime returns the opened shards of the retention policy of the pt which overlap the time range
+func (e *Engine) ptShardsByTime(db, rp string, pt uint32, tr util.TimeRange) ([]Shard, error) {
+	dbPTInfo := e.getDBPTInfo(db, pt)
+	if dbPTInfo == nil {
+		return nil, fmt.Errorf("pt %d of database %s not found", pt, db)
+	}
+	var shards []Shard
+	dbPTInfo.mu.RLock()
+	for _, sh := range dbPTInfo.shards {
+		if sh.IsOpened() && sh.GetRPName() == rp &&
+			sh.GetStartTime().UnixNano() <= tr.Max && sh.GetEndTime().UnixNano() > tr.Min {
+			shards = append(shards, sh)
+		}
+	}
+	dbPTInfo.mu.RUnlock()
+	sort.Slice(shards, func(i, j int) bool { return shards[i].GetID() < shards[j].GetID() })
+	return shards, nil
+}
+
+// readScrubSeries reads the rows of the series within the time range from the files of the measurement,
+// the rows of the out-of-order files overwrite the rows of the order files with the same time.
+// The rows deleted by the tombstones of a file are filtered as the queries do
+func readScrubSeries(store immutable.TablesStore, mst string, sids map[uint64][]byte, tr util.TimeRange) (map[uint64]*record.Record, error) {
+	order, unordered, _ := store.GetBothFilesRef(mst, true, tr, nil)
+	defer func() {
+		immutable.UnrefFiles(order...)
+		immutable.UnrefFiles(unordered...)
+	}()
+
+	ctx := immutable.NewReadContext(true)
+	defer ctx.Release()
+
+	recs := make(map[uint64]*record.Record, len(sids))
+	for _, files := range [][]immutable.TSSPFile{order, unordered} {
+		for _, f := range files {
+			tombstones := immutable.FileTombstones(f)
+			err := immutable.WalkChunkMetas(f, func(_ int, _ *immutable.MetaIndex, cm *immutable.ChunkMeta) error {
+				if _, ok := sids[cm.GetSid()]; !ok {
+					return nil
+				}
+				if minTime, maxTime := cm.MinMaxTime(); minTime > tr.Max || maxTime < tr.Min {
+					return nil
+				}
+				rec, err := immutable.ReadChunk(f, cm, ctx)
+				if err != nil {
+					return fmt.Errorf("read series %d of %s: %v", cm.GetSid(), f.Path(), err)
+				}
+				// the deleted rows not purged yet are neither digested nor shipped to the replica being repaired
+				if rec = immutable.FilterByTombstones(rec, cm.GetSid(), tombstones); rec == nil {
+					return nil
+				}
+				if rec = sliceByTime(rec, tr); rec != nil {
+					recs[cm.GetSid()] = mergeScrubRecord(rec, recs[cm.GetSid()])
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return recs, nil
+}
+
+func sliceByTime(rec *record.Record, tr util.TimeRange) *record.Record {
+	times := rec.Times()
+	start := sort.Search(len(times), func(i int) bool { return times[i] >= tr.Min })
+	end := sort.Search(len(times), func(i int) bool { return times[i] > tr.Max })
+	if start >= end {
+		return nil
+	}
+	if start == 0 && end == len(times) {
+		return rec
+	}
+	dst := &record.Record{}
+	dst.SliceFromRecord(rec, start, end)
+	return dst
+}
+
+func mergeScrubRecord(newRec, oldRec *record.Record) *record.Record {
+	if oldRec == nil {
+		return newRec
+	}
+	merged := &record.Record{}
+	merged.MergeRecord(newRec, oldRec)
+	return merged
+}
+
+func (e *Engine) getScrubStatus() (map[string]string, error) {
+	e.scrubMu.Lock()
+	defer e.scrubMu.Unlock()
+
+	res := make(map[string]string, len(e.scrubs))
+	for id, info := range e.scrubs {
+		buf, err := json.Marshal(info)
+		if err != nil {
+			return nil, err
+		}
+		res[strconv.FormatUint(id, 10)] = string(buf)
+	}
+	return res, nil
+}
@@ -0,0 +1,238 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/stretchr/testify/require"
+)
+
+func getScrubInfo(t *testing.T, eng *Engine, shardID uint64) *netstorage.ScrubInfo {
+	req := &netstorage.SysCtrlRequest{}
+	req.SetMod(netstorage.QueryScrubStatusMod)
+	res, err := eng.processReq(req)
+	require.NoError(t, err)
+
+	v, ok := res[strconv.FormatUint(shardID, 10)]
+	if !ok {
+		return nil
+	}
+	info := &netstorage.ScrubInfo{}
+	require.NoError(t, json.Unmarshal([]byte(v), info))
+	return info
+}
+
+func TestEngine_Scrub(t *testing.T) {
+	eng := getEngineBeforeTest(t, t.TempDir())
+	defer eng.Close()
+
+	var read int
+	eng.Scrub(&netstorage.ScrubOptions{Throttle: func(n int) { read += n }})
+	info := getScrubInfo(t, eng, defaultShardId)
+	require.NotNil(t, info)
+	require.Equal(t, defaultDb, info.Database)
+	require.True(t, info.Files > 0)
+	require.Equal(t, int64(read), info.Bytes)
+	require.Empty(t, info.Corrupt)
+
+	// flip a byte in the first chunk of the file
+	sh, err := eng.getShard(defaultDb, defaultPtId, defaultShardId)
+	require.NoError(t, err)
+	order, _, _ := sh.GetTableStore().GetBothFilesRef("cpu", false, util.TimeRange{}, nil)
+	require.NotEmpty(t, order)
+	path := order[0].Path()
+	var off int64
+	require.NoError(t, immutable.WalkChunkMetas(order[0], func(_ int, _ *immutable.MetaIndex, cm *immutable.ChunkMeta) error {
+		if off == 0 {
+			off, _ = cm.GetColMeta()[0].GetSegment(0)
+		}
+		return nil
+	}))
+	immutable.UnrefFiles(order...)
+	buf, err := os.ReadFile(path)
+	require.NoError(t, err)
+	buf[off+1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, buf, 0600))
+
+	eng.Scrub(&netstorage.ScrubOptions{})
+	info = getScrubInfo(t, eng, defaultShardId)
+	require.Equal(t, 1, len(info.Corrupt))
+	require.Contains(t, info.Corrupt[0], path)
+	require.Empty(t, info.Repaired)
+
+	// the round is not recorded once stopped
+	closed := make(chan struct{})
+	close(closed)
+	eng.scrubs = nil
+	eng.Scrub(&netstorage.ScrubOptions{Closed: closed})
+	require.Nil(t, getScrubInfo(t, eng, defaultShardId))
+}
+
+func TestEngine_FetchScrubSeries(t *testing.T) {
+	eng, err := initEngine(t.TempDir())
+	require.NoError(t, err)
+	defer eng.Close()
+
+	// the peer shards are found by the time range
+	tm := mustParseTime(time.RFC3339Nano, "1999-06-01T00:00:00Z")
+	points, _, _ := GenDataRecord([]string{"cpu"}, 10, 20, time.Second, tm, false, true, false)
+	require.NoError(t, eng.WriteRows(defaultDb, defaultRp, defaultPtId, defaultShardId, points, nil, nil))
+	eng.ForceFlush()
+
+	sh, err := eng.getShard(defaultDb, defaultPtId, defaultShardId)
+	require.NoError(t, err)
+	order, _, _ := sh.GetTableStore().GetBothFilesRef("cpu", false, util.TimeRange{}, nil)
+	require.NotEmpty(t, order)
+	var sid uint64
+	var rows int
+	ctx := immutable.NewReadContext(true)
+	require.NoError(t, immutable.WalkChunkMetas(order[0], func(_ int, _ *immutable.MetaIndex, cm *immutable.ChunkMeta) error {
+		if sid == 0 {
+			sid = cm.GetSid()
+			rec, err := immutable.ReadChunk(order[0], cm, ctx)
+			require.NoError(t, err)
+			rows = rec.RowNums()
+		}
+		return nil
+	}))
+	ctx.Release()
+	minTime, maxTime := order[0].FileStat().MinMaxTime()
+	immutable.UnrefFiles(order...)
+
+	idx := sh.GetIndexBuilder().GetPrimaryIndex().(*tsi.MergeSetIndex)
+	key, err := idx.SeriesKey(nil, sid)
+	require.NoError(t, err)
+
+	tr := util.TimeRange{Min: minTime, Max: maxTime}
+	res, err := eng.processReq(netstorage.NewScrubFetchSeriesRequest(defaultDb, defaultRp, defaultPtId, "cpu", tr, [][]byte{key, []byte("unknown")}))
+	require.NoError(t, err)
+	recs, err := netstorage.DecodeScrubSeries(res)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(recs))
+	require.Equal(t, rows, recs[string(key)].RowNums())
+
+	// only the rows within the time range are fetched
+	tr.Max = tr.Min
+	res, err = eng.processReq(netstorage.NewScrubFetchSeriesRequest(defaultDb, defaultRp, defaultPtId, "cpu", tr, [][]byte{key}))
+	require.NoError(t, err)
+	recs, err = netstorage.DecodeScrubSeries(res)
+	require.NoError(t, err)
+	require.True(t, recs[string(key)].RowNums() <= 1)
+
+	_, err = eng.processReq(netstorage.NewScrubFetchSeriesRequest(defaultDb, defaultRp, defaultPtId+100, "cpu", tr, [][]byte{key}))
+	require.Error(t, err)
+}
+
+func TestEngine_RepairDeletedSeries(t *testing.T) {
+	eng, err := initEngine(t.TempDir())
+	require.NoError(t, err)
+	defer eng.Close()
+
+	tm := mustParseTime(time.RFC3339Nano, "1999-06-01T00:00:00Z")
+	points, _, _ := GenDataRecord([]string{"cpu"}, 10, 20, time.Second, tm, false, true, false)
+	require.NoError(t, eng.WriteRows(defaultDb, defaultRp, defaultPtId, defaultShardId, points, nil, nil))
+	eng.ForceFlush()
+
+	sh, err := eng.getShard(defaultDb, defaultPtId, defaultShardId)
+	require.NoError(t, err)
+	store := sh.GetTableStore()
+	order, _, _ := store.GetBothFilesRef("cpu", false, util.TimeRange{}, nil)
+	require.NotEmpty(t, order)
+	f := order[0]
+	var sid uint64
+	var peer *record.Record
+	ctx := immutable.NewReadContext(true)
+	require.NoError(t, immutable.WalkChunkMetas(f, func(_ int, _ *immutable.MetaIndex, cm *immutable.ChunkMeta) error {
+		if sid == 0 {
+			sid = cm.GetSid()
+			peer, err = immutable.ReadChunk(f, cm, ctx)
+			require.NoError(t, err)
+		}
+		return nil
+	}))
+	ctx.Release()
+	minTime, maxTime := f.FileStat().MinMaxTime()
+	tr := util.TimeRange{Min: minTime, Max: maxTime}
+	idx := sh.GetIndexBuilder().GetPrimaryIndex().(*tsi.MergeSetIndex)
+	key, err := idx.SeriesKey(nil, sid)
+	require.NoError(t, err)
+
+	require.NoError(t, sh.DeleteSeries("cpu", []uint64{sid}, minTime, maxTime))
+
+	// the deleted series is neither fetched by the peer replicas nor digested
+	res, err := eng.processReq(netstorage.NewScrubFetchSeriesRequest(defaultDb, defaultRp, defaultPtId, "cpu", tr, [][]byte{key}))
+	require.NoError(t, err)
+	recs, err := netstorage.DecodeScrubSeries(res)
+	require.NoError(t, err)
+	require.Empty(t, recs)
+
+	req := &netstorage.RepairDigestRequest{Db: defaultDb, Rp: defaultRp, Pt: defaultPtId, Mst: "cpu", TimeRange: tr,
+		Window: int64(5 * time.Second), PerSeries: true}
+	digests, err := eng.computeRepairDigests(req)
+	require.NoError(t, err)
+	require.NotEmpty(t, digests)
+	for _, d := range digests {
+		require.NotEqual(t, hex.EncodeToString(key), d.Key)
+	}
+
+	// the file is repaired by a peer replica which has not applied the delete yet
+	_, err = store.RepairFile("cpu", f, map[uint64]*record.Record{sid: peer})
+	require.NoError(t, err)
+	immutable.UnrefFiles(order...)
+	live, err := readScrubSeries(store, "cpu", map[uint64][]byte{sid: key}, tr)
+	require.NoError(t, err)
+	require.Empty(t, live)
+}
+
+func TestVerifyWalFile(t *testing.T) {
+	dir := t.TempDir()
+	body := snappy.Encode(nil, []byte("abc"))
+	buf := []byte{WriteWalArrowFlight, 0, 0, 0, byte(len(body))}
+	buf = append(buf, body...)
+
+	name := filepath.Join(dir, "1.wal")
+	require.NoError(t, os.WriteFile(name, buf, 0600))
+	var read int
+	require.NoError(t, verifyWalFile(name, func(n int) { read += n }))
+	require.Equal(t, len(buf), read)
+
+	require.NoError(t, os.WriteFile(name, buf[:len(buf)-1], 0600))
+	require.ErrorContains(t, verifyWalFile(name, func(int) {}), "read record of")
+
+	buf[0] = WriteWalEnd
+	require.NoError(t, os.WriteFile(name, buf, 0600))
+	require.EqualError(t, verifyWalFile(name, func(int) {}), "unknown record type 3 at 0")
+
+	// the newest file of a partition is in writing
+	part := filepath.Join(dir, "0")
+	require.NoError(t, os.MkdirAll(part, 0750))
+	for _, f := range []string{"1.wal", "2.wal", "10.wal"} {
+		require.NoError(t, os.WriteFile(filepath.Join(part, f), nil, 0600))
+	}
+	require.Equal(t, []string{filepath.Join(part, "1.wal"), filepath.Join(part, "2.wal")}, closedWalFiles(dir))
+}
@@ -138,7 +138,9 @@ type Shard interface {
 	Close() error
 	ChangeShardTierToWarm()
 	DropMeasurement(ctx context.Context, name string) error
-	GetSplitPoints(idxes []int64) ([]string, error) // only work for tsstore (depends on sid)
+	ExpireMeasurement(name string, expireTime int64) error
+	DeleteSeries(name string, sids []uint64, minTime, maxTime int64) error // only work for tsstore
+	GetSplitPoints(idxes []int64) ([]string, error)                        // only work for tsstore (depends on sid)
 
 	// get private member
 	GetDataPath() string
@@ -183,6 +185,8 @@ type Shard interface {
 
 	// compaction && merge, only work for tsstore
 	Compact() error
+	ForceCompact(progress func(float64)) error
+	RebuildIndex(progress func(float64)) error
 	DisableCompAndMerge()
 	EnableCompAndMerge()
 	SetLockPath(lock *string)
@@ -219,6 +223,7 @@ type shard struct {
 	replayingWal       bool
 	wal                *WAL // for cases: 1. write 2. replay
 	snapshotLock       sync.RWMutex
+	deleteLock         sync.RWMutex // blocks the writes while the rows of series are deleted
 	memDataReadEnabled bool
 	activeTbl          *mutable.MemTable
 	snapshotTbl        *mutable.MemTable
@@ -407,7 +412,9 @@ func NewShard(dataPath, walPath string, lockPath *string, ident *meta.ShardIdent
 			s.tier = util.Warm
 		}
 	}
-	s.immTables = immutable.NewTableStore(filePath, s.lock, &s.tier, options.CompactRecovery, conf)
+	immTables := immutable.NewTableStore(filePath, s.lock, &s.tier, options.CompactRecovery, conf)
+	immTables.SetDatabase(s.ident.OwnerDb)
+	s.immTables = immTables
 	s.immTables.SetAddFunc(s.addRowCounts)
 	s.immTables.SetImmTableType(s.engineType)
 	return s
@@ -482,6 +489,8 @@ func (s *shard) WriteRows(rows []influx.Row, binaryRows []byte) error {
 
 	s.mu.RLock()
 	defer s.mu.RUnlock()
+	s.deleteLock.RLock()
+	defer s.deleteLock.RUnlock()
 
 	var err error
 	if config.ShelfModeEnabled() {
@@ -558,6 +567,8 @@ func (s *shard) WriteCols(mst string, cols *record.Record, binaryCols []byte) er
 	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
+	s.deleteLock.RLock()
+	defer s.deleteLock.RUnlock()
 	if err := s.storage.WriteCols(s, cols, mst, binaryCols); err != nil {
 		log.Error("write buffer failed", zap.Error(err))
 		atomic.AddInt64(&statistics.PerfStat.WriteReqErrors, 1)
@@ -634,6 +645,11 @@ func (s *shard) Compact() error {
 		if !s.immTables.CompactionEnabled() {
 			return nil
 		}
+		if s.engineType == config.TSSTORE && s.immTables.HasTombstones() {
+			if err := s.immTables.PurgeTombstones(); err != nil {
+				log.Error("purge tombstones error", zap.Uint64("shid", id), zap.Error(err))
+			}
+		}
 		nowTime := fasttime.UnixTimestamp()
 		lastWrite := s.LastWriteTime()
 		d := nowTime - lastWrite
@@ -1885,6 +1901,66 @@ func (s *shard) DropMeasurement(ctx context.Context, name string) error {
 	return s.immTables.DropMeasurement(ctx, name)
 }
 
+// DeleteSeries deletes the rows of the series in the time range,
+// the deleted rows are invisible immediately and removed from disk by compaction.
+func (s *shard) DeleteSeries(name string, sids []uint64, minTime, maxTime int64) error {
+	if len(sids) == 0 {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.replayingWal {
+		return fmt.Errorf("async replay wal not finish")
+	}
+
+	// the tombstone only applies to the files, so the rows in memory are flushed first. The writes are
+	// blocked until the tombstone is added, so that no row written before the delete is left in memory.
+	s.deleteLock.Lock()
+	defer s.deleteLock.Unlock()
+	s.ForceFlush()
+
+	return s.immTables.AddTombstone(name, immutable.NewTombstone(name, sids, minTime, maxTime))
+}
+
+// ExpireMeasurement deletes the rows of the measurement older than expireTime, which are expired by the TTL
+// of the measurement. The measurement is dropped from the shard if all the rows of the shard are expired,
+// and the files whose rows are all expired are removed. The expired rows left in the other files of tsstore
+// are deleted by a tombstone and removed from disk by compaction, the rows still in memory are expired after
+// flushed. Columnstore expires the rows by file, the rows of a file are kept until they are all expired.
+func (s *shard) ExpireMeasurement(name string, expireTime int64) error {
+	if s.startTime.UnixNano() >= expireTime || !s.hasMeasurementFiles(name) {
+		return nil
+	}
+	if s.endTime.UnixNano() <= expireTime {
+		return s.DropMeasurement(context.TODO(), name)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.replayingWal {
+		return fmt.Errorf("async replay wal not finish")
+	}
+	dropped, err := s.immTables.DropExpiredFiles(name, expireTime-1)
+	if dropped > 0 {
+		s.log.Info("drop expired files", zap.String("name", name), zap.Int("files", dropped), zap.Int64("expire time", expireTime))
+	}
+	if err != nil || s.engineType != config.TSSTORE {
+		return err
+	}
+	return s.immTables.AddTombstone(name, immutable.NewExpireTombstone(name, expireTime-1))
+}
+
+func (s *shard) hasMeasurementFiles(name string) bool {
+	if s.engineType == config.COLUMNSTORE {
+		files := s.immTables.CopyCSFiles(name)
+		immutable.UnrefFiles(files...)
+		immutable.UnrefFilesReader(files...)
+		return len(files) > 0
+	}
+	return s.immTables.GetTableFileNum(name, true) > 0 || s.immTables.GetTableFileNum(name, false) > 0
+}
+
 func (s *shard) GetStatistics(buffer []byte) ([]byte, error) {
 	s.mu.RLock()
 	if s.closed.Closed() {
@@ -0,0 +1,290 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+var forceCompactCheckInterval = time.Second
+
+// shardMaintenanceRetention is how long a finished maintenance task is kept for SHOW SHARDS
+var shardMaintenanceRetention = time.Hour
+
+// ForceCompact merges all out-of-order files and fully compacts every measurement of the shard,
+// it blocks until the shard is fully compacted or closed
+func (s *shard) ForceCompact(progress func(float64)) error {
+	if s.engineType != config.TSSTORE {
+		return fmt.Errorf("force compaction is not supported by engine type %d", s.engineType)
+	}
+	s.ForceFlush()
+
+	id := s.GetID()
+	ticker := time.NewTicker(forceCompactCheckInterval)
+	defer ticker.Stop()
+	for {
+		if !s.immTables.CompactionEnabled() || !s.immTables.MergeEnabled() {
+			return errors.New("compaction of the shard is disabled")
+		}
+		if s.immTables.HasTombstones() {
+			if err := s.immTables.PurgeTombstones(); err != nil {
+				log.Error("purge tombstones error", zap.Uint64("shid", id), zap.Error(err))
+			}
+		}
+
+		done, total := s.immTables.CompactionProgress()
+		if done >= total {
+			progress(100)
+			return nil
+		}
+		progress(float64(done) * 100 / float64(total))
+
+		if err := s.immTables.MergeOutOfOrder(id, true, true); err != nil {
+			log.Error("force merge out of order error", zap.Uint64("shid", id), zap.Error(err))
+		}
+		if err := s.immTables.FullCompact(id); err != nil {
+			log.Error("force full compact error", zap.Uint64("shid", id), zap.Error(err))
+		}
+
+		select {
+		case <-s.closed.Signal():
+			return errno.NewError(errno.ErrShardClosed, id)
+		case <-ticker.C:
+		}
+	}
+}
+
+// RebuildIndex rebuilds the index items of all series stored in the WAL and TSSP files of the shard.
+// The rows of the WAL carry their series keys, the series lost by the index are created again from them.
+// The TSSP files carry the TSIDs of the series only, their series keys are read from the index
+func (s *shard) RebuildIndex(progress func(float64)) error {
+	if s.engineType != config.TSSTORE {
+		return fmt.Errorf("rebuild index is not supported by engine type %d", s.engineType)
+	}
+
+	iBuild := s.GetIndexBuilder()
+	if iBuild == nil {
+		return errno.NewError(errno.ErrShardClosed, s.GetID())
+	}
+	idx, ok := iBuild.GetPrimaryIndex().(*tsi.MergeSetIndex)
+	if !ok {
+		return errors.New("rebuild index is only supported by the merge set index")
+	}
+	if err := s.wal.walkRows(func(rows influx.Rows) error {
+		return idx.RebuildIndexesByRows(rows)
+	}); err != nil {
+		return err
+	}
+	s.ForceFlush()
+
+	var msts []string
+	seen := make(map[string]struct{})
+	for _, mst := range s.immTables.GetAllMstList() {
+		if _, ok := seen[mst]; !ok {
+			seen[mst] = struct{}{}
+			msts = append(msts, mst)
+		}
+	}
+	var sids []uint64
+	for i, mst := range msts {
+		select {
+		case <-s.closed.Signal():
+			return errno.NewError(errno.ErrShardClosed, s.GetID())
+		default:
+		}
+
+		var err error
+		sids, err = s.readSeriesIDs(mst, sids[:0])
+		if err != nil {
+			return err
+		}
+		if err = idx.RebuildIndexes(sids); err != nil {
+			return err
+		}
+		progress(float64(i+1) * 100 / float64(len(msts)))
+	}
+	progress(100)
+	return nil
+}
+
+func (s *shard) readSeriesIDs(mst string, dst []uint64) ([]uint64, error) {
+	order, unordered, _ := s.immTables.GetBothFilesRef(mst, false, util.TimeRange{}, nil)
+	defer func() {
+		immutable.UnrefFiles(order...)
+		immutable.UnrefFiles(unordered...)
+	}()
+
+	var err error
+	for _, files := range [][]immutable.TSSPFile{order, unordered} {
+		for _, f := range files {
+			dst, err = immutable.ReadSeriesIDs(f, dst)
+			if err != nil {
+				return dst, err
+			}
+		}
+	}
+
+	sort.Slice(dst, func(i, j int) bool { return dst[i] < dst[j] })
+	n := 0
+	for i := range dst {
+		if i == 0 || dst[i] != dst[n-1] {
+			dst[n] = dst[i]
+			n++
+		}
+	}
+	return dst[:n], nil
+}
+
+func (e *Engine) startShardMaintenance(req *netstorage.SysCtrlRequest) error {
+	db, ptIds, shardID, action, err := netstorage.ParseShardMaintenanceRequest(req)
+	if err != nil {
+		return err
+	}
+
+	var run func(sh Shard, progress func(float64)) error
+	switch action {
+	case influxql.ShardActionFlush:
+		run = func(sh Shard, progress func(float64)) error {
+			sh.ForceFlush()
+			progress(100)
+			return nil
+		}
+	case influxql.ShardActionCompact:
+		run = func(sh Shard, progress func(float64)) error {
+			return sh.ForceCompact(progress)
+		}
+	case influxql.ShardActionRebuildIndex:
+		run = func(sh Shard, progress func(float64)) error {
+			return sh.RebuildIndex(progress)
+		}
+	default:
+		return fmt.Errorf("unknown shard maintenance action: %s", action)
+	}
+
+	// the pt ref is held until the task finishes, so that the shard is not closed or dropped under the task
+	sh, ptId, err := e.getShardWithRef(db, ptIds, shardID)
+	if err != nil {
+		return err
+	}
+
+	info := &netstorage.ShardMaintenanceInfo{
+		ShardID: shardID,
+		Action:  action,
+		State:   netstorage.ShardMaintenanceRunning,
+	}
+	e.maintenanceMu.Lock()
+	e.expireShardMaintenanceNoLock()
+	if prev, ok := e.maintenances[shardID]; ok && prev.State == netstorage.ShardMaintenanceRunning {
+		e.maintenanceMu.Unlock()
+		e.unrefDBPT(db, ptId)
+		return fmt.Errorf("shard %d is running %s", shardID, prev.Action)
+	}
+	if e.maintenances == nil {
+		e.maintenances = make(map[uint64]*netstorage.ShardMaintenanceInfo)
+	}
+	e.maintenances[shardID] = info
+	e.maintenanceMu.Unlock()
+
+	go func() {
+		defer e.unrefDBPT(db, ptId)
+
+		start := time.Now()
+		log.Info("start shard maintenance", zap.Uint64("shard", shardID), zap.String("action", action))
+		err := run(sh, func(progress float64) {
+			e.maintenanceMu.Lock()
+			info.Progress = progress
+			e.maintenanceMu.Unlock()
+		})
+
+		e.maintenanceMu.Lock()
+		if err != nil {
+			info.State, info.Err = netstorage.ShardMaintenanceFailed, err.Error()
+		} else {
+			info.State, info.Progress = netstorage.ShardMaintenanceDone, 100
+		}
+		info.FinishedAt = time.Now()
+		e.maintenanceMu.Unlock()
+		log.Info("shard maintenance finished", zap.Uint64("shard", shardID), zap.String("action", action),
+			zap.Duration("duration", time.Since(start)), zap.Error(err))
+	}()
+	return nil
+}
+
+// getShardWithRef returns the shard owned by one of the pts, the ref of the returned pt must be released by the caller
+func (e *Engine) getShardWithRef(db string, ptIds []uint32, shardID uint64) (Shard, uint32, error) {
+	var err error = errno.NewError(errno.ShardNotFound, shardID)
+	for _, pt := range ptIds {
+		e.mu.RLock()
+		if err = e.checkAndAddRefPTNoLock(db, pt); err != nil {
+			e.mu.RUnlock()
+			continue
+		}
+		sh := e.DBPartitions[db][pt].Shard(shardID)
+		e.mu.RUnlock()
+
+		if sh == nil {
+			e.unrefDBPT(db, pt)
+			err = errno.NewError(errno.ShardNotFound, shardID)
+			continue
+		}
+		if err = e.openShardLazy(sh); err != nil {
+			e.unrefDBPT(db, pt)
+			continue
+		}
+		return sh, pt, nil
+	}
+	return nil, 0, err
+}
+
+// expireShardMaintenanceNoLock removes the tasks finished longer than shardMaintenanceRetention ago
+func (e *Engine) expireShardMaintenanceNoLock() {
+	for id, info := range e.maintenances {
+		if info.State != netstorage.ShardMaintenanceRunning && !info.FinishedAt.IsZero() &&
+			time.Since(info.FinishedAt) > shardMaintenanceRetention {
+			delete(e.maintenances, id)
+		}
+	}
+}
+
+func (e *Engine) getShardMaintenance() (map[string]string, error) {
+	e.maintenanceMu.Lock()
+	defer e.maintenanceMu.Unlock()
+
+	e.expireShardMaintenanceNoLock()
+	res := make(map[string]string, len(e.maintenances))
+	for id, info := range e.maintenances {
+		buf, err := json.Marshal(info)
+		if err != nil {
+			return nil, err
+		}
+		res[strconv.FormatUint(id, 10)] = string(buf)
+	}
+	return res, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/require"
+)
+
+func getShardMaintenanceInfo(t *testing.T, eng *Engine, shardID uint64) *netstorage.ShardMaintenanceInfo {
+	req := &netstorage.SysCtrlRequest{}
+	req.SetMod(netstorage.QueryShardMaintenanceMod)
+	res, err := eng.processReq(req)
+	require.NoError(t, err)
+
+	v, ok := res[strconv.FormatUint(shardID, 10)]
+	if !ok {
+		return nil
+	}
+	info := &netstorage.ShardMaintenanceInfo{}
+	require.NoError(t, json.Unmarshal([]byte(v), info))
+	return info
+}
+
+func TestEngine_ShardMaintenance(t *testing.T) {
+	defer func(d time.Duration) {
+		forceCompactCheckInterval = d
+	}(forceCompactCheckInterval)
+	forceCompactCheckInterval = 10 * time.Millisecond
+
+	eng := getEngineBeforeTest(t, t.TempDir())
+	defer eng.Close()
+
+	// write the same series again to generate out-of-order files
+	rows, _, _ := GenDataRecord([]string{"cpu", "mem"}, 10, 20, time.Second, time.Now().Add(-time.Hour), false, true, false)
+	require.NoError(t, eng.WriteRows(defaultDb, defaultRp, defaultPtId, defaultShardId, rows, nil, nil))
+	eng.ForceFlush()
+
+	sh, err := eng.getShard(defaultDb, defaultPtId, defaultShardId)
+	require.NoError(t, err)
+	done, total := sh.(*shard).immTables.CompactionProgress()
+	require.Less(t, done, total)
+
+	for _, action := range []string{influxql.ShardActionFlush, influxql.ShardActionCompact, influxql.ShardActionRebuildIndex} {
+		req := netstorage.NewShardMaintenanceRequest(defaultDb, []uint32{defaultPtId}, defaultShardId, action)
+		_, err := eng.processReq(req)
+		require.NoError(t, err, action)
+
+		require.Eventually(t, func() bool {
+			info := getShardMaintenanceInfo(t, eng, defaultShardId)
+			require.NotNil(t, info)
+			require.Empty(t, info.Err)
+			return info.Action == action && info.State == netstorage.ShardMaintenanceDone && info.Progress == 100
+		}, 10*time.Second, 10*time.Millisecond, action)
+	}
+
+	done, total = sh.(*shard).immTables.CompactionProgress()
+	require.Equal(t, total, done)
+
+	// the pt ref held by the task is released once the task finishes
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&eng.DBPartitions[defaultDb][defaultPtId].exeCount) == 0
+	}, 10*time.Second, 10*time.Millisecond)
+
+	defer func(d time.Duration) {
+		shardMaintenanceRetention = d
+	}(shardMaintenanceRetention)
+	shardMaintenanceRetention = 0
+	require.Nil(t, getShardMaintenanceInfo(t, eng, defaultShardId))
+}
+
+func TestEngine_ShardMaintenanceError(t *testing.T) {
+	eng := getEngineBeforeTest(t, t.TempDir())
+	defer eng.Close()
+
+	req := netstorage.NewShardMaintenanceRequest(defaultDb, []uint32{defaultPtId}, defaultShardId, "drop")
+	_, err := eng.processReq(req)
+	require.EqualError(t, err, "unknown shard maintenance action: drop")
+
+	req = netstorage.NewShardMaintenanceRequest(defaultDb, []uint32{defaultPtId}, defaultShardId+100, influxql.ShardActionFlush)
+	_, err = eng.processReq(req)
+	require.Error(t, err)
+
+	eng.maintenances = map[uint64]*netstorage.ShardMaintenanceInfo{
+		defaultShardId: {ShardID: defaultShardId, Action: influxql.ShardActionCompact, State: netstorage.ShardMaintenanceRunning},
+	}
+	req = netstorage.NewShardMaintenanceRequest(defaultDb, []uint32{defaultPtId}, defaultShardId, influxql.ShardActionFlush)
+	_, err = eng.processReq(req)
+	require.EqualError(t, err, "shard 1 is running compact")
+}
@@ -33,6 +33,7 @@ import (
 
 	set "github.com/deckarep/golang-set/v2"
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/toml"
 	"github.com/influxdata/influxdb/pkg/tracing/fields"
 	originql "github.com/influxdata/influxql"
 	"github.com/openGemini/openGemini/coordinator"
@@ -1010,6 +1011,65 @@ func TestShard_NewColStoreShard(t *testing.T) {
 	require.NoError(t, closeShard(sh))
 }
 
+func TestShard_ExpireColStoreMeasurement(t *testing.T) {
+	testDir := t.TempDir()
+	sh, err := createShard(defaultDb, defaultRp, defaultPtId, testDir, config.COLUMNSTORE)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, closeShard(sh))
+	}()
+	mstInfo := &meta.MeasurementInfo{Name: defaultMeasurementName,
+		EngineType: config.COLUMNSTORE,
+		ColStoreInfo: &meta.ColStoreInfo{SortKey: []string{},
+			PrimaryKey: []string{"field1_string", "field2_int"}},
+		Schema: &meta.CleanSchema{
+			"field1_string": meta.SchemaVal{Typ: influx.Field_Type_String},
+			"field2_int":    meta.SchemaVal{Typ: influx.Field_Type_Int}}}
+	sh.SetMstInfo(mstInfo)
+	sh.SetClient(&MockMetaClient{mstInfo: []*meta.MeasurementInfo{mstInfo}})
+
+	st := sh.startTime.Add(time.Minute).Truncate(time.Second)
+	for _, begin := range []time.Time{st, st.Add(30 * time.Minute)} {
+		rows, _, _ := GenDataRecord([]string{defaultMeasurementName}, 4, 100, time.Second, begin, true, true, false, 1)
+		require.NoError(t, writeData(sh, rows, true))
+		sh.waitSnapshot()
+	}
+	indexFiles := func() []string {
+		var files []string
+		require.NoError(t, filepath.Walk(sh.GetDataPath(), func(path string, info os.FileInfo, err error) error {
+			if filepath.Ext(path) == colstore.IndexFileSuffix {
+				files = append(files, path)
+			}
+			return nil
+		}))
+		return files
+	}
+	dataFiles := func() []immutable.TSSPFile {
+		files := sh.immTables.CopyCSFiles(defaultMeasurementName)
+		immutable.UnrefFiles(files...)
+		immutable.UnrefFilesReader(files...)
+		return files
+	}
+	require.Equal(t, 2, len(dataFiles()))
+	require.Equal(t, 2, len(indexFiles()))
+
+	// the files partially expired are kept
+	require.NoError(t, sh.ExpireMeasurement(defaultMeasurementName, st.Add(time.Minute).UnixNano()))
+	require.Equal(t, 2, len(dataFiles()))
+
+	// the files whose rows are all expired are removed with their index files
+	require.NoError(t, sh.ExpireMeasurement(defaultMeasurementName, st.Add(20*time.Minute).UnixNano()))
+	files := dataFiles()
+	require.Equal(t, 1, len(files))
+	minTime, _, err := files[0].MinMaxTime()
+	require.NoError(t, err)
+	require.Equal(t, st.Add(30*time.Minute).UnixNano(), minTime)
+	require.Equal(t, []string{colstore.AppendPKIndexSuffix(immutable.RemoveTsspSuffix(files[0].Path()))}, indexFiles())
+	_, ok := sh.immTables.GetPKFile(defaultMeasurementName, colstore.AppendPKIndexSuffix(immutable.RemoveTsspSuffix(files[0].Path())))
+	require.True(t, ok)
+	require.False(t, sh.immTables.HasTombstones())
+}
+
 func TestShard_NewColStoreShardWithPKIndex(t *testing.T) {
 	testDir := t.TempDir()
 	_ = os.RemoveAll(testDir)
@@ -3737,6 +3797,37 @@ func TestDropMeasurement(t *testing.T) {
 	}
 }
 
+func TestShard_OutOfOrderWindow(t *testing.T) {
+	conf := &config.GetStoreConfig().Merge
+	conf.DatabaseOutOfOrderWindows = map[string]toml.Duration{defaultDb: toml.Duration(5 * time.Second)}
+	defer func() {
+		conf.DatabaseOutOfOrderWindows = nil
+	}()
+
+	sh, err := createShard(defaultDb, defaultRp, defaultPtId, t.TempDir(), config.TSSTORE)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, closeShard(sh))
+	}()
+
+	msNames := []string{"cpu"}
+	tm := time.Now().Truncate(time.Minute)
+	rows, _, _ := GenDataRecord(msNames, 1, 20, time.Second, tm, false, true, false)
+	require.NoError(t, writeData(sh, rows, true))
+
+	// the late data from tm+10s to tm+19s is buffered into the unordered files of 2 windows
+	rows, _, _ = GenDataRecord(msNames, 1, 20, time.Second, tm.Add(10*time.Second), false, true, false)
+	require.NoError(t, writeData(sh, rows, true))
+
+	store := sh.GetTableStore().(*immutable.MmsTables)
+	require.Equal(t, 2, store.GetOutOfOrderFileNum())
+
+	store.EnableCompAndMerge()
+	require.NoError(t, store.MergeOutOfOrder(sh.GetID(), false, false))
+	store.Wait()
+	require.Equal(t, 0, store.GetOutOfOrderFileNum())
+}
+
 func TestDropMeasurementOnWalReplay(t *testing.T) {
 	sh := &shard{stopDownSample: util.NewSignal()}
 	sh.replayingWal = true
@@ -7197,6 +7288,9 @@ func (client *MockMetaClient) CreateMeasurement(database string, retentionPolicy
 func (client *MockMetaClient) AlterShardKey(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo) error {
 	return nil
 }
+func (client *MockMetaClient) AlterMeasurementTTL(database, retentionPolicy, mst string, ttl time.Duration) error {
+	return nil
+}
 func (client *MockMetaClient) CreateDatabase(name string, enableTagArray bool, replicaN uint32, options *obs.ObsOptions) (*meta2.DatabaseInfo, error) {
 	return nil, nil
 }
@@ -7586,3 +7680,163 @@ func TestIsSameIndex(t *testing.T) {
 	s2.indexBuilder = tsi.NewIndexBuilder(opt)
 	assert2.True(t, s1.IsSameIndex(s2))
 }
+
+func TestEngine_DropSeries(t *testing.T) {
+	dir := t.TempDir()
+	eng, err := initEngine1(dir, config.TSSTORE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = eng.Close()
+	}()
+
+	msNames := []string{"cpu", "cpu1"}
+	tm := mustParseTime(time.RFC3339Nano, "1999-06-01T00:00:00Z")
+	rows, _, _ := GenDataRecord(msNames, 10, 200, time.Second, tm, false, true, false)
+	require.NoError(t, eng.WriteRows("db0", "rp0", 0, 1, rows, nil, nil))
+
+	dbInfo := eng.DBPartitions["db0"][0]
+	idx := dbInfo.indexBuilder[659].GetPrimaryIndex().(*tsi.MergeSetIndex)
+	idx.DebugFlush()
+	names := [][]byte{[]byte(msNames[0]), []byte(msNames[1])}
+	seriesKeys, err := eng.SeriesKeys("db0", []uint32{0}, names, nil, globalTime)
+	require.NoError(t, err)
+	require.Equal(t, 10, len(seriesKeys))
+
+	// delete the rows in a time range, the series are kept in the index
+	cond := influxql.MustParseExpr(fmt.Sprintf("time >= %d AND time < %d", tm.UnixNano(), tm.Add(100*time.Second).UnixNano()))
+	sources := []influxql.Source{&influxql.Measurement{Name: msNames[0]}}
+	n, err := eng.DropSeries("db0", "", sources, []uint32{0}, cond)
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+	sh := dbInfo.shards[1]
+	require.True(t, sh.GetTableStore().HasTombstones())
+	idx.DebugFlush()
+	seriesKeys, err = eng.SeriesKeys("db0", []uint32{0}, names, nil, globalTime)
+	require.NoError(t, err)
+	require.Equal(t, 10, len(seriesKeys))
+
+	// all the rows are deleted, but the time range of the index is not covered, so the series are kept
+	cond = influxql.MustParseExpr(fmt.Sprintf("time >= %d AND time < %d", tm.UnixNano(), tm.Add(time.Hour).UnixNano()))
+	n, err = eng.DropSeries("db0", "", sources, []uint32{0}, cond)
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+	idx.DebugFlush()
+	seriesKeys, err = eng.SeriesKeys("db0", []uint32{0}, names, nil, globalTime)
+	require.NoError(t, err)
+	require.Equal(t, 10, len(seriesKeys))
+
+	// drop the series
+	n, err = eng.DropSeries("db0", "", sources, []uint32{0}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+	idx.DebugFlush()
+	seriesKeys, err = eng.SeriesKeys("db0", []uint32{0}, names, nil, globalTime)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(seriesKeys))
+
+	// the deleted rows are removed from disk
+	require.NoError(t, sh.Compact())
+	require.False(t, sh.GetTableStore().HasTombstones())
+
+	// the series written again are visible
+	rows, _, _ = GenDataRecord(msNames, 10, 200, time.Second, tm.Add(time.Hour), false, true, false)
+	require.NoError(t, eng.WriteRows("db0", "rp0", 0, 1, rows, nil, nil))
+	idx.DebugFlush()
+	seriesKeys, err = eng.SeriesKeys("db0", []uint32{0}, names, nil, globalTime)
+	require.NoError(t, err)
+	require.Equal(t, 10, len(seriesKeys))
+}
+
+func TestShard_DeleteSeriesBlocksWrites(t *testing.T) {
+	sh, err := createShard(defaultDb, defaultRp, defaultPtId, t.TempDir(), config.TSSTORE)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, closeShard(sh))
+	}()
+
+	tm := mustParseTime(time.RFC3339Nano, "1999-06-01T00:00:00Z")
+	rows, _, _ := GenDataRecord([]string{defaultMeasurementName}, 4, 10, time.Second, tm, true, true, false)
+
+	// a delete in progress
+	sh.deleteLock.Lock()
+	done := make(chan error, 1)
+	go func() {
+		done <- sh.WriteRows(rows, nil)
+	}()
+	select {
+	case <-done:
+		t.Fatal("the write is not blocked by the delete")
+	case <-time.After(100 * time.Millisecond):
+	}
+	sh.deleteLock.Unlock()
+	require.NoError(t, <-done)
+}
+
+func TestEngine_ExpireMeasurements(t *testing.T) {
+	dir := t.TempDir()
+	eng, err := initEngine1(dir, config.TSSTORE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = eng.Close()
+	}()
+
+	msNames := []string{"cpu", "cpu1"}
+	tm := mustParseTime(time.RFC3339Nano, "1999-06-01T00:00:00Z")
+	rows, _, _ := GenDataRecord(msNames, 10, 200, time.Second, tm, false, true, false)
+	require.NoError(t, eng.WriteRows("db0", "rp0", 0, 1, rows, nil, nil))
+	sh := eng.DBPartitions["db0"][0].shards[1]
+	sh.ForceFlush()
+
+	countRows := func(name string) int {
+		total := 0
+		files, ok := sh.GetTableStore().GetTSSPFiles(name, true)
+		if !ok {
+			return 0
+		}
+		for _, f := range files.Files() {
+			itr := immutable.NewChunkIterator(immutable.NewFileIterator(f, immutable.CLog))
+			for itr.Next() {
+				total += itr.GetRecord().RowNums()
+			}
+			itr.Close()
+		}
+		immutable.UnrefFiles(files.Files()...)
+		immutable.UnrefFilesReader(files.Files()...)
+		return total
+	}
+	require.Equal(t, 1000, countRows("cpu"))
+
+	expire := func(name string, expireTime time.Time) {
+		require.NoError(t, eng.ExpireMeasurements([]meta2.ExpiredMeasurementInfos{
+			{Database: "db0", Policy: "rp0", Name: name, EngineType: config.TSSTORE, ExpireTime: expireTime.UnixNano()},
+		}))
+	}
+
+	// nothing is expired before the shard starts
+	expire("cpu", tm.AddDate(-1, 0, 0))
+	require.False(t, sh.GetTableStore().HasTombstones())
+
+	// the rows older than the expire time are deleted
+	expireTime := tm.Add(100 * time.Second)
+	kept := 0
+	for i := range rows {
+		if rows[i].Name == "cpu" && rows[i].Timestamp >= expireTime.UnixNano() {
+			kept++
+		}
+	}
+	expire("cpu", expireTime)
+	require.True(t, sh.GetTableStore().HasTombstones())
+	require.Equal(t, kept, countRows("cpu"))
+	require.NoError(t, sh.Compact())
+	require.False(t, sh.GetTableStore().HasTombstones())
+	require.Equal(t, kept, countRows("cpu"))
+
+	// the measurement is dropped from the shard if all the rows of the shard are expired
+	expire("cpu", tm.AddDate(1, 0, 0))
+	require.Equal(t, 0, sh.GetTableStore().GetTableFileNum("cpu", true))
+	require.Equal(t, 1000, countRows("cpu1"))
+}
@@ -378,7 +378,7 @@ func (s *Shard) convertWalToTSSP(wal *Wal) {
 
 	tsMemTable := mutable.NewTsMemTableImpl()
 	orderFiles, unorderedFiles := tsMemTable.FlushRecords(s.info.tbStore, itr, wal.mst,
-		s.info.filePath, s.info.lock, s.info.fileInfos)
+		s.info.filePath, s.info.ident.OwnerDb, s.info.lock, s.info.fileInfos)
 
 	s.mu.Lock()
 	s.info.tbStore.AddBothTSSPFiles(nil, wal.mst, orderFiles, unorderedFiles)
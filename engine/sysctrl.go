@@ -78,11 +78,44 @@ func (e *Engine) processReq(req *netstorage.SysCtrlRequest) (map[string]string,
 	if req.Mod() == queryShardStatus {
 		return e.getShardStatus(req.Param())
 	}
+	if req.Mod() == netstorage.QueryShardMaintenanceMod {
+		return e.getShardMaintenance()
+	}
+	if req.Mod() == netstorage.QueryScrubStatusMod {
+		return e.getScrubStatus()
+	}
+	if req.Mod() == netstorage.ScrubFetchSeriesMod {
+		return e.fetchScrubSeries(req)
+	}
+	if req.Mod() == netstorage.QueryReplicaReadStateMod {
+		return e.getReplicaReadState(req.Param())
+	}
+	if req.Mod() == netstorage.RepairDigestMod {
+		return e.getRepairDigests(req)
+	}
+	switch req.Mod() {
+	case netstorage.PtFileManifestMod:
+		return e.getPtFileManifest(req)
+	case netstorage.PtFileChunkMod:
+		return e.fetchPtFileChunk(req)
+	case netstorage.PtCopyMod:
+		return e.processPtCopy(req)
+	case netstorage.PtCopyStatusMod:
+		return e.getPtCopyStatus(req)
+	case netstorage.PtCopyCancelMod:
+		return e.cancelPtCopy(req)
+	case netstorage.PtLoadMod:
+		return e.getPtLoads()
+	case netstorage.MeasurementStatsMod:
+		return e.getMeasurementStats(req)
+	}
 
 	switch req.Mod() {
 	case dataFlush:
 		e.ForceFlush()
 		return nil, nil
+	case netstorage.ShardMaintenanceMod:
+		return nil, e.startShardMaintenance(req)
 	case compactionEn:
 		allEn, err := syscontrol.GetBoolValue(req.Param(), "allshards")
 		if err != nil && err != syscontrol.ErrNoSuchParam {
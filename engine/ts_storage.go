@@ -117,6 +117,10 @@ func (storage *tsstoreImpl) WriteIndex(s *shard, rowsPointer *influx.Rows, mw *m
 			if err != nil {
 				return err
 			}
+			// the series written again after it is deleted needs to be revived in the index
+			if ri.SeriesId != 0 && idx.IsDeletedTSID(ri.SeriesId) {
+				ri.SeriesId = 0
+			}
 			// PrimaryId is equal to SeriesId by default.
 			ri.PrimaryId = ri.SeriesId
 
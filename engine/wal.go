@@ -612,6 +612,32 @@ func (l *WAL) Replay(ctx context.Context, callBack ReplayCallFuncType) ([]string
 	return walFileNames, nil
 }
 
+// walkRows reads the line protocol rows of the wal files which are not flushed yet,
+// the writes of the wal are blocked until all the files are read
+func (l *WAL) walkRows(fn func(rows influx.Rows) error) error {
+	if !l.walEnabled {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i := range l.logWriter {
+		for _, fileName := range l.logWriter[i].fileNames {
+			err := l.replayWalFile(context.Background(), fileName, func(pc *walRecord) error {
+				if pc.rowsObjs == nil {
+					return nil
+				}
+				defer putWalRowsObjects(pc.rowsObjs)
+				return fn(pc.rowsObjs.rows)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (l *WAL) Close() error {
 	if !l.walEnabled {
 		return nil
@@ -231,6 +231,35 @@ func TestWalReplaySerial(t *testing.T) {
 	}
 }
 
+func TestWal_walkRows(t *testing.T) {
+	sh, err := createShard(defaultDb, defaultRp, defaultPtId, t.TempDir(), config.TSSTORE)
+	require.NoError(t, err)
+	defer closeShard(sh)
+	sh.SetWriteColdDuration(3 * time.Minute)
+
+	rows, _, _ := GenDataRecord([]string{"cpu", "disk"}, 10, 5, time.Second, time.Now(), false, true, true)
+	require.NoError(t, writeData(sh, rows, false))
+
+	// the rows of the wal carry their series keys
+	keys := make(map[string]struct{})
+	require.NoError(t, sh.wal.walkRows(func(walRows influx.Rows) error {
+		for i := range walRows {
+			keys[string(walRows[i].IndexKey)] = struct{}{}
+		}
+		return nil
+	}))
+	require.Equal(t, 10, len(keys))
+
+	// the flushed rows are not in the wal
+	sh.ForceFlush()
+	n := 0
+	require.NoError(t, sh.wal.walkRows(func(walRows influx.Rows) error {
+		n += len(walRows)
+		return nil
+	}))
+	require.Equal(t, 0, n)
+}
+
 func TestRemove(t *testing.T) {
 	fileNotExists := filepath.Join(t.TempDir(), "tmp", "not_exists.data")
 
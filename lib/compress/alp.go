@@ -0,0 +1,192 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/util"
+)
+
+// ALP is the adaptive lossless floating-point encoding from the paper
+// "ALP: Adaptive Lossless floating-Point Compression".
+// The decimal values are multiplied by 10^e / 10^f into integers, which are encoded by frame-of-reference.
+// The values which can not be restored exactly are stored as exceptions.
+
+const (
+	alpMaxExponent = 18
+	alpSampleSize  = 32
+
+	// the encoded integers must be exactly represented by float64
+	alpMaxEncoded = 1 << 52
+)
+
+var alpPow10 = func() [alpMaxExponent + 1]float64 {
+	var p [alpMaxExponent + 1]float64
+	p[0] = 1
+	for i := 1; i < len(p); i++ {
+		p[i] = p[i-1] * 10
+	}
+	return p
+}()
+
+var alpInvPow10 = func() [alpMaxExponent + 1]float64 {
+	var p [alpMaxExponent + 1]float64
+	for i := range p {
+		p[i] = 1 / alpPow10[i]
+	}
+	return p
+}()
+
+func alpEncodeValue(v float64, e, f uint8) (int64, bool) {
+	scaled := v * alpPow10[e] * alpInvPow10[f]
+	if math.IsNaN(scaled) || scaled >= alpMaxEncoded || scaled <= -alpMaxEncoded {
+		return 0, false
+	}
+	n := int64(math.Round(scaled))
+	return n, math.Float64bits(alpDecodeValue(n, e, f)) == math.Float64bits(v)
+}
+
+func alpDecodeValue(n int64, e, f uint8) float64 {
+	return float64(n) * alpPow10[f] * alpInvPow10[e]
+}
+
+// alpChooseExponent returns the exponent and factor with which the sample has the fewest exceptions,
+// and the narrowest range of the encoded integers
+func alpChooseExponent(values []float64) (uint8, uint8) {
+	step := 1
+	if len(values) > alpSampleSize {
+		step = len(values) / alpSampleSize
+	}
+
+	var bestE, bestF uint8
+	bestCost := math.MaxInt
+	for e := uint8(0); e <= alpMaxExponent; e++ {
+		for f := uint8(0); f <= e; f++ {
+			exceptions := 0
+			min, max := int64(math.MaxInt64), int64(math.MinInt64)
+			for i := 0; i < len(values); i += step {
+				n, ok := alpEncodeValue(values[i], e, f)
+				if !ok {
+					exceptions++
+					continue
+				}
+				if n < min {
+					min = n
+				}
+				if n > max {
+					max = n
+				}
+			}
+			width := 64
+			if min <= max {
+				width = bits.Len64(uint64(max) - uint64(min))
+			}
+			cost := exceptions*(64+16) + width
+			if cost < bestCost {
+				bestE, bestF, bestCost = e, f, cost
+			}
+		}
+	}
+	return bestE, bestF
+}
+
+func ALPEncoding(in []byte, out []byte) ([]byte, error) {
+	values := util.Bytes2Float64Slice(in)
+	out = binary.AppendUvarint(out, uint64(len(values)))
+	if len(values) == 0 {
+		return out, nil
+	}
+
+	e, f := alpChooseExponent(values)
+	encoded := make([]int64, len(values))
+	var exceptions []int
+	var last int64
+	for i, v := range values {
+		n, ok := alpEncodeValue(v, e, f)
+		if !ok {
+			// fill the exception with the previous integer to keep the range narrow
+			exceptions = append(exceptions, i)
+			n = last
+		}
+		encoded[i] = n
+		last = n
+	}
+
+	out = append(out, e, f)
+	out = forEncoding(encoded, out)
+	out = binary.AppendUvarint(out, uint64(len(exceptions)))
+	prev := 0
+	for _, i := range exceptions {
+		out = binary.AppendUvarint(out, uint64(i-prev))
+		out = numberenc.MarshalUint64Append(out, math.Float64bits(values[i]))
+		prev = i
+	}
+	return out, nil
+}
+
+func ALPDecoding(in []byte, out []byte) ([]byte, error) {
+	count, n := binary.Uvarint(in)
+	if n <= 0 {
+		return nil, fmt.Errorf("alp: invalid value count")
+	}
+	in = in[n:]
+	if count == 0 {
+		return out, nil
+	}
+	if len(in) < 2 {
+		return nil, fmt.Errorf("alp: too small data for exponent, %v", len(in))
+	}
+	e, f := in[0], in[1]
+	if e > alpMaxExponent || f > e {
+		return nil, fmt.Errorf("alp: invalid exponent %d and factor %d", e, f)
+	}
+
+	pos := len(out)
+	out = growInt64Buffer(out, int(count))
+	encoded := util.Bytes2Int64Slice(out[pos:])
+	in, err := forDecoding(in[2:], encoded)
+	if err != nil {
+		return nil, err
+	}
+	values := util.Bytes2Float64Slice(out[pos:])
+	for i, n := range encoded {
+		values[i] = alpDecodeValue(n, e, f)
+	}
+
+	exceptions, n := binary.Uvarint(in)
+	if n <= 0 {
+		return nil, fmt.Errorf("alp: invalid exception count")
+	}
+	in = in[n:]
+	idx := uint64(0)
+	for i := uint64(0); i < exceptions; i++ {
+		delta, n := binary.Uvarint(in)
+		if n <= 0 || len(in) < n+8 {
+			return nil, fmt.Errorf("alp: too small data for exception, %v", len(in))
+		}
+		idx += delta
+		if idx >= count {
+			return nil, fmt.Errorf("alp: invalid exception position %d", idx)
+		}
+		values[idx] = math.Float64frombits(numberenc.UnmarshalUint64(in[n:]))
+		in = in[n+8:]
+	}
+	return out, nil
+}
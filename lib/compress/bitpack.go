@@ -0,0 +1,160 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/openGemini/openGemini/lib/numberenc"
+)
+
+var errTooSmallBits = errors.New("too small data for decode bits")
+
+// bitWriter writes the bits from the most significant one
+type bitWriter struct {
+	buf []byte
+	cur uint64
+	n   uint // number of the pending bits in cur
+}
+
+func newBitWriter(buf []byte) *bitWriter {
+	return &bitWriter{buf: buf}
+}
+
+func (w *bitWriter) write(v uint64, nbits uint) {
+	if nbits > 32 {
+		w.write32(v>>32, nbits-32)
+		w.write32(v, 32)
+		return
+	}
+	w.write32(v, nbits)
+}
+
+func (w *bitWriter) write32(v uint64, nbits uint) {
+	if nbits == 0 {
+		return
+	}
+	w.cur = w.cur<<nbits | v&(1<<nbits-1)
+	w.n += nbits
+	for w.n >= 8 {
+		w.n -= 8
+		w.buf = append(w.buf, byte(w.cur>>w.n))
+	}
+	w.cur &= 1<<w.n - 1
+}
+
+// flush writes the pending bits padded with zero, and returns the buffer
+func (w *bitWriter) flush() []byte {
+	if w.n > 0 {
+		w.buf = append(w.buf, byte(w.cur<<(8-w.n)))
+		w.cur, w.n = 0, 0
+	}
+	return w.buf
+}
+
+type bitReader struct {
+	src []byte
+	pos uint // bit position
+}
+
+func newBitReader(src []byte) *bitReader {
+	return &bitReader{src: src}
+}
+
+func (r *bitReader) read(nbits uint) (uint64, error) {
+	if uint(len(r.src))*8 < r.pos+nbits {
+		return 0, errTooSmallBits
+	}
+	var v uint64
+	for nbits > 0 {
+		b := r.src[r.pos>>3]
+		avail := 8 - r.pos&7
+		take := avail
+		if nbits < take {
+			take = nbits
+		}
+		v = v<<take | uint64(b>>(avail-take))&(1<<take-1)
+		r.pos += take
+		nbits -= take
+	}
+	return v, nil
+}
+
+// remain returns the bytes after the bits read
+func (r *bitReader) remain() []byte {
+	return r.src[(r.pos+7)>>3:]
+}
+
+// forEncoding appends the values encoded by frame-of-reference and bit-packing:
+// the minimum value, the bit width of the offsets from the minimum, and the packed offsets
+func forEncoding(values []int64, out []byte) []byte {
+	if len(values) == 0 {
+		return out
+	}
+	min, max := values[0], values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		} else if v > max {
+			max = v
+		}
+	}
+	width := uint(bits.Len64(uint64(max) - uint64(min)))
+
+	out = numberenc.MarshalInt64Append(out, min)
+	out = append(out, byte(width))
+	if width == 0 {
+		return out
+	}
+	w := newBitWriter(out)
+	for _, v := range values {
+		w.write(uint64(v)-uint64(min), width)
+	}
+	return w.flush()
+}
+
+// forDecoding decodes len(values) values encoded by forEncoding, and returns the remaining data
+func forDecoding(in []byte, values []int64) ([]byte, error) {
+	if len(values) == 0 {
+		return in, nil
+	}
+	if len(in) < 9 {
+		return nil, fmt.Errorf("too small data for frame-of-reference, %v", len(in))
+	}
+	min := numberenc.UnmarshalInt64(in)
+	width := uint(in[8])
+	in = in[9:]
+	if width > 64 {
+		return nil, fmt.Errorf("invalid bit width of frame-of-reference, %v", width)
+	}
+	if width == 0 {
+		for i := range values {
+			values[i] = min
+		}
+		return in, nil
+	}
+
+	r := newBitReader(in)
+	for i := range values {
+		v, err := r.read(width)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = int64(uint64(min) + v)
+	}
+	return r.remain(), nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	"github.com/openGemini/openGemini/lib/util"
+)
+
+// Chimp is an improvement of the gorilla encoding from the paper
+// "Chimp: Efficient Lossless Floating Point Compression for Time Series Databases".
+// The xor with the previous value is written with the leading zeros rounded to one of 8 representatives,
+// and the trailing zeros are only skipped when there are many of them.
+
+const (
+	chimpTrailingThreshold = 6
+	chimpNoLeading         = 65
+)
+
+var chimpLeadingRepr = [8]uint{0, 8, 12, 16, 18, 20, 22, 24}
+
+var chimpLeadingRound = func() [65]uint8 {
+	var codes [65]uint8
+	for lz := range codes {
+		for code := len(chimpLeadingRepr) - 1; code >= 0; code-- {
+			if uint(lz) >= chimpLeadingRepr[code] {
+				codes[lz] = uint8(code)
+				break
+			}
+		}
+	}
+	return codes
+}()
+
+func ChimpEncoding(in []byte, out []byte) ([]byte, error) {
+	values := util.Bytes2Uint64Slice(in)
+	out = binary.AppendUvarint(out, uint64(len(values)))
+	if len(values) == 0 {
+		return out, nil
+	}
+
+	w := newBitWriter(out)
+	w.write(values[0], 64)
+	prev := values[0]
+	storedLeading := uint(chimpNoLeading)
+	for _, v := range values[1:] {
+		xor := prev ^ v
+		prev = v
+		if xor == 0 {
+			w.write(0b00, 2)
+			storedLeading = chimpNoLeading
+			continue
+		}
+
+		code := chimpLeadingRound[bits.LeadingZeros64(xor)]
+		leading := chimpLeadingRepr[code]
+		trailing := uint(bits.TrailingZeros64(xor))
+		if trailing > chimpTrailingThreshold {
+			significant := 64 - leading - trailing
+			w.write(0b01, 2)
+			w.write(uint64(code), 3)
+			w.write(uint64(significant), 6)
+			w.write(xor>>trailing, significant)
+			storedLeading = chimpNoLeading
+		} else if leading == storedLeading {
+			w.write(0b10, 2)
+			w.write(xor, 64-leading)
+		} else {
+			storedLeading = leading
+			w.write(0b11, 2)
+			w.write(uint64(code), 3)
+			w.write(xor, 64-leading)
+		}
+	}
+	return w.flush(), nil
+}
+
+func ChimpDecoding(in []byte, out []byte) ([]byte, error) {
+	count, n := binary.Uvarint(in)
+	if n <= 0 {
+		return nil, fmt.Errorf("chimp: invalid value count")
+	}
+	if count == 0 {
+		return out, nil
+	}
+	pos := len(out)
+	out = growInt64Buffer(out, int(count))
+	values := util.Bytes2Uint64Slice(out[pos:])
+
+	r := newBitReader(in[n:])
+	prev, err := r.read(64)
+	if err != nil {
+		return nil, err
+	}
+	values[0] = prev
+	storedLeading := uint(chimpNoLeading)
+	for i := 1; i < len(values); i++ {
+		flag, err := r.read(2)
+		if err != nil {
+			return nil, err
+		}
+
+		var xor uint64
+		switch flag {
+		case 0b00:
+			storedLeading = chimpNoLeading
+		case 0b01:
+			code, err := r.read(3)
+			if err != nil {
+				return nil, err
+			}
+			significant, err := r.read(6)
+			if err != nil {
+				return nil, err
+			}
+			leading := chimpLeadingRepr[code]
+			if leading+uint(significant) > 64 {
+				return nil, fmt.Errorf("chimp: invalid significant bits %d", significant)
+			}
+			if xor, err = r.read(uint(significant)); err != nil {
+				return nil, err
+			}
+			xor <<= 64 - leading - uint(significant)
+			storedLeading = chimpNoLeading
+		case 0b10:
+			if storedLeading == chimpNoLeading {
+				return nil, fmt.Errorf("chimp: no leading zeros stored")
+			}
+			if xor, err = r.read(64 - storedLeading); err != nil {
+				return nil, err
+			}
+		default:
+			code, err := r.read(3)
+			if err != nil {
+				return nil, err
+			}
+			storedLeading = chimpLeadingRepr[code]
+			if xor, err = r.read(64 - storedLeading); err != nil {
+				return nil, err
+			}
+		}
+		prev ^= xor
+		values[i] = prev
+	}
+	return out, nil
+}
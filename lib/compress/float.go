@@ -31,6 +31,8 @@ const (
 	floatCompressedSame       = 4
 	floatCompressedRLE        = 5
 	floatCompressMLF          = 6
+	floatCompressChimp        = 7
+	floatCompressALP          = 8
 
 	// if the length of the float slice is smaller than this value, not compress it
 	floatCompressThreshold    = 4
@@ -43,6 +45,11 @@ type Float struct {
 	rle             *RLE
 	mlfCompressor   mlf.Compressor
 	mlfDecompressor mlf.Decompressor
+
+	// buffers of the adaptive encoding with sampler
+	sample     []float64
+	sampleBuf  []byte
+	gorillaBuf []byte
 }
 
 func NewFloat() *Float {
@@ -54,6 +61,10 @@ func (c *Float) AdaptiveEncoding(in []byte, out []byte) ([]byte, error) {
 		return c.adaptiveEncodingWithMLF(in, out)
 	}
 
+	if IsEnableAdaptiveFloat() {
+		return c.adaptiveEncodingWithSampler(in, out)
+	}
+
 	return c.adaptiveEncoding(in, out)
 }
 
@@ -155,6 +166,10 @@ func (c *Float) AdaptiveDecoding(in, out []byte) ([]byte, error) {
 		values := c.mlfDecompressor.Decode(in[1:])
 		out = append(out, util.Float64Slice2byte(values)...)
 		return out, nil
+	case floatCompressChimp:
+		return ChimpDecoding(in[1:], out)
+	case floatCompressALP:
+		return ALPDecoding(in[1:], out)
 	default:
 		return nil, errno.NewError(errno.InvalidFloatBuffer, algo)
 	}
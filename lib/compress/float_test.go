@@ -38,6 +38,18 @@ func codecFloatBlockWithMLF(t *testing.T, values []float64) {
 	codecFloatBlock(t, values)
 }
 
+func codecFloatBlockWithAdaptive(t *testing.T, values []float64) {
+	config.GetStoreConfig().FloatCompressAlgorithm = compress.CompressAlgorithmAdaptive
+	compress.Init()
+
+	defer func() {
+		config.GetStoreConfig().FloatCompressAlgorithm = ""
+		compress.Init()
+	}()
+
+	codecFloatBlock(t, values)
+}
+
 func codecFloatBlock(t *testing.T, data []float64) {
 	values := append([]float64{}, data...)
 
@@ -68,6 +80,7 @@ func TestCodecFloatBlock_one(t *testing.T) {
 	var values = []float64{0}
 	codecFloatBlock(t, values)
 	codecFloatBlockWithMLF(t, values)
+	codecFloatBlockWithAdaptive(t, values)
 }
 
 func TestCodecFloatBlock_rand(t *testing.T) {
@@ -77,6 +90,7 @@ func TestCodecFloatBlock_rand(t *testing.T) {
 	}
 	codecFloatBlock(t, values)
 	codecFloatBlockWithMLF(t, values)
+	codecFloatBlockWithAdaptive(t, values)
 }
 
 func TestCodecFloatBlock_small(t *testing.T) {
@@ -86,6 +100,7 @@ func TestCodecFloatBlock_small(t *testing.T) {
 	}
 	codecFloatBlock(t, values)
 	codecFloatBlockWithMLF(t, values)
+	codecFloatBlockWithAdaptive(t, values)
 }
 
 func TestCodecFloatBlock_same(t *testing.T) {
@@ -97,12 +112,14 @@ func TestCodecFloatBlock_same(t *testing.T) {
 	}
 	codecFloatBlock(t, values)
 	codecFloatBlockWithMLF(t, values)
+	codecFloatBlockWithAdaptive(t, values)
 
 	for i := 0; i < 1000; i++ {
 		values[i] = 0
 	}
 	codecFloatBlock(t, values)
 	codecFloatBlockWithMLF(t, values)
+	codecFloatBlockWithAdaptive(t, values)
 }
 
 func TestCodecFloatBlock_int(t *testing.T) {
@@ -112,6 +129,7 @@ func TestCodecFloatBlock_int(t *testing.T) {
 	}
 	codecFloatBlock(t, values)
 	codecFloatBlockWithMLF(t, values)
+	codecFloatBlockWithAdaptive(t, values)
 }
 
 func TestCodecFloatBlock_smallDelta(t *testing.T) {
@@ -121,6 +139,7 @@ func TestCodecFloatBlock_smallDelta(t *testing.T) {
 	}
 	codecFloatBlock(t, values)
 	codecFloatBlockWithMLF(t, values)
+	codecFloatBlockWithAdaptive(t, values)
 }
 
 func TestCodecFloatBlock_RLE(t *testing.T) {
@@ -130,6 +149,7 @@ func TestCodecFloatBlock_RLE(t *testing.T) {
 	}
 	codecFloatBlock(t, values)
 	codecFloatBlockWithMLF(t, values)
+	codecFloatBlockWithAdaptive(t, values)
 }
 
 func TestCodecFloatBlock_Snappy(t *testing.T) {
@@ -148,6 +168,7 @@ func TestCodecFloatBlock_NaN(t *testing.T) {
 	values[1] = math.NaN()
 	codecFloatBlock(t, values)
 	codecFloatBlockWithMLF(t, values)
+	codecFloatBlockWithAdaptive(t, values)
 }
 
 func TestCodecFloatBlock_AllNaNorInf(t *testing.T) {
@@ -158,6 +179,7 @@ func TestCodecFloatBlock_AllNaNorInf(t *testing.T) {
 		}
 		codecFloatBlock(t, values)
 		codecFloatBlockWithMLF(t, values)
+		codecFloatBlockWithAdaptive(t, values)
 	}
 	run(math.NaN())
 	run(math.Inf(0))
@@ -186,6 +208,7 @@ func TestCodecFloatBlock_SameAbsoluteValue(t *testing.T) {
 	}
 	codecFloatBlock(t, values)
 	codecFloatBlockWithMLF(t, values)
+	codecFloatBlockWithAdaptive(t, values)
 }
 
 func TestCodecFloatBlock_other(t *testing.T) {
@@ -196,6 +219,70 @@ func TestCodecFloatBlock_other(t *testing.T) {
 	for i := 0; i < len(values); i++ {
 		codecFloatBlock(t, values[i])
 		codecFloatBlockWithMLF(t, values[i])
+		codecFloatBlockWithAdaptive(t, values[i])
+	}
+}
+
+func TestCodecFloatBlock_AdaptiveDecimal(t *testing.T) {
+	var values []float64
+	for i := 0; i < 3000; i++ {
+		values = append(values, float64(rand.Int63n(100000))/100)
+	}
+	codecFloatBlockWithAdaptive(t, values)
+
+	values = values[:0]
+	for i := 0; i < 3000; i++ {
+		values = append(values, 20+float64(i%50)*0.1)
+	}
+	values[10] = math.NaN()
+	values[20] = math.Copysign(0, -1)
+	values[30] = math.Inf(1)
+	values[40] = math.Pi
+	codecFloatBlockWithAdaptive(t, values)
+}
+
+func TestChimpAndALP(t *testing.T) {
+	codecs := []struct {
+		enc func(in []byte, out []byte) ([]byte, error)
+		dec func(in []byte, out []byte) ([]byte, error)
+	}{
+		{compress.ChimpEncoding, compress.ChimpDecoding},
+		{compress.ALPEncoding, compress.ALPDecoding},
+	}
+
+	var random []float64
+	for i := 0; i < 1000; i++ {
+		random = append(random, rand.Float64()*1000)
+	}
+	inputs := [][]float64{
+		nil,
+		{1.5},
+		{0, math.Copysign(0, -1), math.NaN(), math.Inf(-1), math.MaxFloat64, math.SmallestNonzeroFloat64},
+		{1.1, 1.2, 1.3, 1.3, 1.3, 100.25, -7.125},
+		random,
+	}
+
+	for _, codec := range codecs {
+		for _, values := range inputs {
+			prefix := []byte{1, 2, 3}
+			enc, err := codec.enc(util.Float64Slice2byte(values), nil)
+			require.NoError(t, err)
+
+			dec, err := codec.dec(enc, append([]byte{}, prefix...))
+			require.NoError(t, err)
+			require.Equal(t, prefix, dec[:len(prefix)])
+
+			other := util.Bytes2Float64Slice(dec[len(prefix):])
+			require.Equal(t, len(values), len(other))
+			for i := range values {
+				require.Equal(t, math.Float64bits(values[i]), math.Float64bits(other[i]))
+			}
+
+			_, err = codec.dec(enc[:len(enc)/2], nil)
+			if len(enc) > 2 {
+				require.Error(t, err)
+			}
+		}
 	}
 }
 
@@ -16,14 +16,32 @@ package compress
 
 import "github.com/openGemini/openGemini/lib/config"
 
-const FloatCompressAlgorithmMLF = "mlf"
+const (
+	FloatCompressAlgorithmMLF = "mlf"
+
+	// CompressAlgorithmAdaptive chooses the codec of each segment by sampling
+	CompressAlgorithmAdaptive = "adaptive"
+)
 
 var enableMlf = false
+var enableAdaptiveFloat = false
+var enableAdaptiveInteger = false
 
 func IsEnableMLF() bool {
 	return enableMlf
 }
 
+func IsEnableAdaptiveFloat() bool {
+	return enableAdaptiveFloat
+}
+
+func IsEnableAdaptiveInteger() bool {
+	return enableAdaptiveInteger
+}
+
 func Init() {
-	enableMlf = config.GetStoreConfig().FloatCompressAlgorithm == FloatCompressAlgorithmMLF
+	conf := config.GetStoreConfig()
+	enableMlf = conf.FloatCompressAlgorithm == FloatCompressAlgorithmMLF
+	enableAdaptiveFloat = conf.FloatCompressAlgorithm == CompressAlgorithmAdaptive
+	enableAdaptiveInteger = conf.IntegerCompressAlgorithm == CompressAlgorithmAdaptive
 }
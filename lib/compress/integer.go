@@ -0,0 +1,114 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/util"
+)
+
+// IntegerFOREncoding encodes the values by frame-of-reference and bit-packing,
+// it fits the values in a narrow range without order.
+func IntegerFOREncoding(values []int64, out []byte) []byte {
+	out = binary.AppendUvarint(out, uint64(len(values)))
+	return forEncoding(values, out)
+}
+
+func IntegerFORDecoding(in []byte, out []byte) ([]byte, error) {
+	count, n := binary.Uvarint(in)
+	if n <= 0 {
+		return nil, fmt.Errorf("integer: invalid frame-of-reference count")
+	}
+	pos := len(out)
+	out = growInt64Buffer(out, int(count))
+	if _, err := forDecoding(in[n:], util.Bytes2Int64Slice(out[pos:])); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IntegerDeltaOfDeltaEncoding encodes the first value, the first delta, and the delta of the deltas
+// by frame-of-reference. It fits the values growing at a nearly fixed rate, such as the counters.
+func IntegerDeltaOfDeltaEncoding(values []int64, out []byte) []byte {
+	out = binary.AppendUvarint(out, uint64(len(values)))
+	if len(values) == 0 {
+		return out
+	}
+	out = numberenc.MarshalInt64Append(out, values[0])
+	if len(values) == 1 {
+		return out
+	}
+	delta := values[1] - values[0]
+	out = numberenc.MarshalInt64Append(out, delta)
+
+	dods := make([]int64, 0, len(values)-2)
+	for i := 2; i < len(values); i++ {
+		d := values[i] - values[i-1]
+		dods = append(dods, d-delta)
+		delta = d
+	}
+	return forEncoding(dods, out)
+}
+
+func IntegerDeltaOfDeltaDecoding(in []byte, out []byte) ([]byte, error) {
+	count, n := binary.Uvarint(in)
+	if n <= 0 {
+		return nil, fmt.Errorf("integer: invalid delta-of-delta count")
+	}
+	in = in[n:]
+	if count == 0 {
+		return out, nil
+	}
+
+	head := 8
+	if count > 1 {
+		head = 16
+	}
+	if len(in) < head {
+		return nil, fmt.Errorf("integer: too small data for delta-of-delta, %v < %v", len(in), head)
+	}
+	pos := len(out)
+	out = growInt64Buffer(out, int(count))
+	values := util.Bytes2Int64Slice(out[pos:])
+	values[0] = numberenc.UnmarshalInt64(in)
+	if count == 1 {
+		return out, nil
+	}
+	delta := numberenc.UnmarshalInt64(in[8:])
+	values[1] = values[0] + delta
+
+	// decode the deltas of deltas in place, then accumulate them
+	dods := values[2:]
+	if _, err := forDecoding(in[16:], dods); err != nil {
+		return nil, err
+	}
+	for i := 2; i < len(values); i++ {
+		delta += values[i]
+		values[i] = values[i-1] + delta
+	}
+	return out, nil
+}
+
+func growInt64Buffer(out []byte, count int) []byte {
+	pos := len(out)
+	size := pos + count*util.Int64SizeBytes
+	if cap(out) < size {
+		out = append(make([]byte, 0, size), out...)
+	}
+	return out[:size]
+}
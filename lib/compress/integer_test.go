@@ -0,0 +1,83 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/compress"
+	"github.com/openGemini/openGemini/lib/rand"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegerFORAndDeltaOfDelta(t *testing.T) {
+	codecs := []struct {
+		enc func(values []int64, out []byte) []byte
+		dec func(in []byte, out []byte) ([]byte, error)
+	}{
+		{compress.IntegerFOREncoding, compress.IntegerFORDecoding},
+		{compress.IntegerDeltaOfDeltaEncoding, compress.IntegerDeltaOfDeltaDecoding},
+	}
+
+	var counter, random []int64
+	for i := 0; i < 1000; i++ {
+		counter = append(counter, int64(i*10+rand.Intn(3)))
+		random = append(random, rand.Int63()-math.MaxInt64/2)
+	}
+	inputs := [][]int64{
+		nil,
+		{7},
+		{7, -7},
+		{5, 5, 5, 5},
+		{math.MinInt64, math.MaxInt64, 0, -1, 1},
+		counter,
+		random,
+	}
+
+	for _, codec := range codecs {
+		for _, values := range inputs {
+			prefix := []byte{1, 2, 3}
+			enc := codec.enc(values, nil)
+			dec, err := codec.dec(enc, append([]byte{}, prefix...))
+			require.NoError(t, err)
+			require.Equal(t, prefix, dec[:len(prefix)])
+			if len(values) > 0 {
+				require.Equal(t, values, util.Bytes2Int64Slice(dec[len(prefix):]))
+			}
+		}
+	}
+
+	_, err := compress.IntegerFORDecoding(compress.IntegerFOREncoding(counter, nil)[:20], nil)
+	require.Error(t, err)
+	_, err = compress.IntegerDeltaOfDeltaDecoding(compress.IntegerDeltaOfDeltaEncoding(counter, nil)[:10], nil)
+	require.Error(t, err)
+}
+
+func TestSample(t *testing.T) {
+	values := make([]int64, 100)
+	require.Equal(t, values, compress.Sample(values, nil))
+
+	values = make([]int64, 10000)
+	for i := range values {
+		values[i] = int64(i)
+	}
+	sample := compress.Sample(values, nil)
+	require.Equal(t, 512, len(sample))
+	require.Equal(t, int64(0), sample[0])
+	require.Equal(t, int64(2500), sample[128])
+	require.Equal(t, int64(7500+127), sample[511])
+}
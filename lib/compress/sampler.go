@@ -0,0 +1,134 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"math"
+
+	"github.com/openGemini/openGemini/lib/util"
+)
+
+const (
+	sampleWindows    = 4
+	sampleWindowSize = 128
+)
+
+// Sample returns some evenly spaced windows of the values. The codec producing the smallest output
+// for the sample is used to encode all the values, so that every candidate codec does not need
+// to encode the whole segment. The values are returned as is if they are not more than the sample.
+func Sample[T int64 | float64](values []T, dst []T) []T {
+	if len(values) <= sampleWindows*sampleWindowSize {
+		return values
+	}
+	step := len(values) / sampleWindows
+	for i := 0; i < sampleWindows; i++ {
+		ofs := i * step
+		dst = append(dst, values[ofs:ofs+sampleWindowSize]...)
+	}
+	return dst
+}
+
+type floatCodec struct {
+	algo   uint8
+	encode func(c *Float, in []byte, out []byte) ([]byte, error)
+}
+
+var adaptiveFloatCodecs = []floatCodec{
+	{algo: floatCompressedGorilla, encode: (*Float).gorillaEncodingAppend},
+	{algo: floatCompressedSnappy, encode: func(_ *Float, in []byte, out []byte) ([]byte, error) {
+		return SnappyEncoding(in, out)
+	}},
+	{algo: floatCompressChimp, encode: func(_ *Float, in []byte, out []byte) ([]byte, error) {
+		return ChimpEncoding(in, out)
+	}},
+	{algo: floatCompressALP, encode: func(_ *Float, in []byte, out []byte) ([]byte, error) {
+		return ALPEncoding(in, out)
+	}},
+}
+
+// adaptiveEncodingWithSampler encodes the values by the codec chosen by the sample of the segment,
+// the codec is recorded in the header so that the segment can be decoded without the configuration.
+func (c *Float) adaptiveEncodingWithSampler(in []byte, out []byte) ([]byte, error) {
+	values := util.Bytes2Float64Slice(in)
+	ctx := GenerateContext(values)
+	defer ctx.Release()
+
+	if ctx.NotCompress() {
+		return c.compressNull(in, out), nil
+	}
+
+	if ctx.Same() {
+		out = append(out, floatCompressedSame<<4)
+		return c.rle.SameValueEncoding(in, out)
+	}
+
+	if ctx.RLE() {
+		out = append(out, floatCompressedRLE<<4)
+		return c.rle.Encoding(in, out)
+	}
+
+	codec := c.chooseCodec(values, ctx.extremeDataValues)
+	pos := len(out)
+	out = append(out, codec.algo<<4)
+	out, err := codec.encode(c, in, out)
+	if err != nil {
+		return nil, err
+	}
+
+	// compression ratio greater than 90%
+	if len(out)-pos > len(in)*90/100 {
+		out = c.compressNull(in, out[:pos])
+	}
+	return out, nil
+}
+
+func (c *Float) chooseCodec(values []float64, extremeDataValues bool) floatCodec {
+	sampled := Sample(values, c.sample[:0])
+	if len(sampled) < len(values) {
+		c.sample = sampled
+	}
+	sample := util.Float64Slice2byte(sampled)
+
+	best, bestSize := -1, math.MaxInt
+	for i := range adaptiveFloatCodecs {
+		// gorilla takes NaN as the end of the values
+		if extremeDataValues && adaptiveFloatCodecs[i].algo == floatCompressedGorilla {
+			continue
+		}
+		buf, err := adaptiveFloatCodecs[i].encode(c, sample, c.sampleBuf[:0])
+		if err != nil {
+			continue
+		}
+		c.sampleBuf = buf
+		if len(buf) < bestSize {
+			best, bestSize = i, len(buf)
+		}
+	}
+	if best < 0 {
+		// snappy never fails
+		return adaptiveFloatCodecs[1]
+	}
+	return adaptiveFloatCodecs[best]
+}
+
+func (c *Float) gorillaEncodingAppend(in []byte, out []byte) ([]byte, error) {
+	// the gorilla encoding writes from the beginning of the buffer
+	buf, err := GorillaEncoding(in, c.gorillaBuf[:0])
+	if err != nil {
+		return nil, err
+	}
+	c.gorillaBuf = buf
+	return append(out, buf...), nil
+}
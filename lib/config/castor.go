@@ -21,12 +21,24 @@ import (
 	"strings"
 	"time"
 
+	"github.com/influxdata/influxdb/toml"
 	"github.com/openGemini/openGemini/lib/errno"
 )
 
 const (
 	DefaultPoolSize    int = 30
 	DefaultWaitTimeout int = 30
+
+	DefaultHealthCheckInterval = 5 * time.Second
+	DefaultHealthCheckTimeout  = 2 * time.Second
+)
+
+const (
+	// CastorProtocolIPC sends the data to the connection pools of pyworker-addr over arrow ipc
+	CastorProtocolIPC = "ipc"
+	// CastorProtocolFlight sends the data to the arrow flight workers, the healthy and least loaded worker
+	// serving the algorithm is picked for every series
+	CastorProtocolFlight = "flight"
 )
 
 type algorithmType string
@@ -47,6 +59,18 @@ type Castor struct {
 	Detect            algoConfig `toml:"detect"`
 	Predict           algoConfig `toml:"predict"`
 	Fit               algoConfig `toml:"fit"`
+
+	Protocol            string         `toml:"protocol"`
+	HealthCheckInterval toml.Duration  `toml:"health-check-interval"`
+	HealthCheckTimeout  toml.Duration  `toml:"health-check-timeout"`
+	Workers             []CastorWorker `toml:"worker"`
+}
+
+// CastorWorker is an arrow flight worker with the algorithms routed to it
+type CastorWorker struct {
+	Addr string `toml:"addr"`
+	// all the algorithms reported by the health check of the worker are routed to it if it is empty
+	Algorithms []string `toml:"algorithms"`
 }
 
 type algoConfig struct {
@@ -56,8 +80,11 @@ type algoConfig struct {
 
 func NewCastor() Castor {
 	return Castor{
-		ConnPoolSize:      DefaultPoolSize,
-		ResultWaitTimeout: DefaultWaitTimeout,
+		ConnPoolSize:        DefaultPoolSize,
+		ResultWaitTimeout:   DefaultWaitTimeout,
+		Protocol:            CastorProtocolIPC,
+		HealthCheckInterval: toml.Duration(DefaultHealthCheckInterval),
+		HealthCheckTimeout:  toml.Duration(DefaultHealthCheckTimeout),
 	}
 }
 
@@ -78,6 +105,10 @@ func (c Castor) Validate() error {
 		return errno.NewError(errno.InvalidResultWaitTimeout)
 	}
 
+	if err := c.checkProtocol(); err != nil {
+		return err
+	}
+
 	if err := c.checkUrl(); err != nil {
 		return err
 	}
@@ -98,11 +129,46 @@ func (c Castor) Validate() error {
 	return nil
 }
 
+func (c *Castor) checkProtocol() *errno.Error {
+	switch c.Protocol {
+	case CastorProtocolIPC, "":
+		return nil
+	case CastorProtocolFlight:
+		if c.HealthCheckInterval <= 0 || c.HealthCheckTimeout <= 0 {
+			return errno.NewError(errno.InvalidHealthCheck)
+		}
+		return nil
+	default:
+		return errno.NewError(errno.InvalidCastorProtocol, c.Protocol)
+	}
+}
+
+// IsFlight returns true if the workers are arrow flight workers
+func (c *Castor) IsFlight() bool {
+	return c.Protocol == CastorProtocolFlight
+}
+
+// GetWorkers returns the flight workers of pyworker-addr, which serve all their algorithms, and [[castor.worker]]
+func (c *Castor) GetWorkers() []CastorWorker {
+	workers := make([]CastorWorker, 0, len(c.PyWorkerAddr)+len(c.Workers))
+	for _, addr := range c.PyWorkerAddr {
+		workers = append(workers, CastorWorker{Addr: addr})
+	}
+	return append(workers, c.Workers...)
+}
+
 func (c *Castor) checkUrl() *errno.Error {
-	if len(c.PyWorkerAddr) == 0 {
+	addrs := c.PyWorkerAddr
+	if c.IsFlight() {
+		addrs = addrs[:0:0]
+		for _, w := range c.GetWorkers() {
+			addrs = append(addrs, w.Addr)
+		}
+	}
+	if len(addrs) == 0 {
 		return errno.NewError(errno.InvalidAddr)
 	}
-	for _, addr := range c.PyWorkerAddr {
+	for _, addr := range addrs {
 		sp := strings.Split(addr, ":")
 		if len(sp) != 2 {
 			return errno.NewError(errno.InvalidAddr)
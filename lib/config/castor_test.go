@@ -16,6 +16,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/openGemini/openGemini/lib/errno"
@@ -233,3 +234,74 @@ func Test_CheckAlgoAndConfExistence(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func Test_FlightConfig(t *testing.T) {
+	confStr := `
+	[castor]
+		enabled = true
+		protocol = "flight"
+		pyworker-addr = ["127.0.0.1:6666"]
+		health-check-interval = "1s"
+		health-check-timeout = "500ms"
+	[[castor.worker]]
+		addr = "127.0.0.1:6667"
+		algorithms = ["DIFFERENTIATEAD"]
+	`
+	c := newConf()
+	toml.Decode(confStr, c)
+	if err := c.C.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if !c.C.IsFlight() || time.Duration(c.C.HealthCheckTimeout) != 500*time.Millisecond {
+		t.Fatal("flight config not decoded")
+	}
+	workers := c.C.GetWorkers()
+	if len(workers) != 2 || workers[0].Addr != "127.0.0.1:6666" || len(workers[0].Algorithms) != 0 ||
+		workers[1].Addr != "127.0.0.1:6667" || workers[1].Algorithms[0] != "DIFFERENTIATEAD" {
+		t.Fatalf("unexpected workers %v", workers)
+	}
+}
+
+func Test_InvalidProtocol(t *testing.T) {
+	confStr := `
+	[castor]
+		enabled = true
+		protocol = "http"
+		pyworker-addr = ["127.0.0.1:6666"]
+	`
+	c := newConf()
+	toml.Decode(confStr, c)
+	if err := c.C.Validate(); !errno.Equal(err, errno.InvalidCastorProtocol) {
+		t.Fatal(err)
+	}
+}
+
+func Test_InvalidHealthCheck(t *testing.T) {
+	confStr := `
+	[castor]
+		enabled = true
+		protocol = "flight"
+		pyworker-addr = ["127.0.0.1:6666"]
+		health-check-timeout = "0s"
+	`
+	c := newConf()
+	toml.Decode(confStr, c)
+	if err := c.C.Validate(); !errno.Equal(err, errno.InvalidHealthCheck) {
+		t.Fatal(err)
+	}
+}
+
+func Test_InvalidWorkerAddr(t *testing.T) {
+	confStr := `
+	[castor]
+		enabled = true
+		protocol = "flight"
+	[[castor.worker]]
+		addr = "abc:6666"
+	`
+	c := newConf()
+	toml.Decode(confStr, c)
+	if err := c.C.Validate(); !errno.Equal(err, errno.InvalidAddr) {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	ReplicationRolePrimary = "primary"
+	ReplicationRoleStandby = "standby"
+
+	DefaultReplicationBatchSize     = 4 * MB
+	DefaultReplicationSegmentSize   = 64 * MB
+	DefaultReplicationFlushInterval = time.Second
+	DefaultReplicationDrainTimeout  = 30 * time.Second
+)
+
+// ClusterReplication is the configuration of the asynchronous database-level
+// replication to a standby openGemini cluster in another site
+type ClusterReplication struct {
+	Enabled bool   `toml:"enabled"`
+	Role    string `toml:"role"`
+
+	// Peer is the http address of a ts-sql of the other cluster, e.g. http://10.0.0.1:8086
+	Peer string `toml:"peer"`
+	// Databases to be replicated, all databases are replicated if it is empty
+	Databases []string `toml:"databases"`
+	// Dir is where the replication log and checkpoints are persisted
+	Dir string `toml:"dir"`
+
+	BatchSize     toml.Size     `toml:"batch-size"`
+	SegmentSize   toml.Size     `toml:"segment-size"`
+	FlushInterval toml.Duration `toml:"flush-interval"`
+	DrainTimeout  toml.Duration `toml:"drain-timeout"`
+
+	HTTPTimeout        toml.Duration `toml:"http-timeout"`
+	InsecureSkipVerify bool          `toml:"insecure-skip-verify"`
+	HttpsCertificate   string        `toml:"https-certificate"`
+}
+
+func NewClusterReplication() ClusterReplication {
+	return ClusterReplication{
+		Enabled:       false,
+		Role:          ReplicationRolePrimary,
+		BatchSize:     toml.Size(DefaultReplicationBatchSize),
+		SegmentSize:   toml.Size(DefaultReplicationSegmentSize),
+		FlushInterval: toml.Duration(DefaultReplicationFlushInterval),
+		DrainTimeout:  toml.Duration(DefaultReplicationDrainTimeout),
+		HTTPTimeout:   toml.Duration(DefaultHTTPTimeout),
+	}
+}
+
+func (c ClusterReplication) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Role != ReplicationRolePrimary && c.Role != ReplicationRoleStandby {
+		return fmt.Errorf("cluster-replication role must be %s or %s", ReplicationRolePrimary, ReplicationRoleStandby)
+	}
+	if c.Dir == "" {
+		return errors.New("cluster-replication dir must be specified")
+	}
+	if c.Peer != "" {
+		u, err := url.Parse(c.Peer)
+		if err != nil {
+			return fmt.Errorf("cluster-replication invalid peer: %v", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("cluster-replication unknown peer schema %s", u.Scheme)
+		}
+	} else if c.Role == ReplicationRolePrimary {
+		return errors.New("cluster-replication peer must be specified for the primary")
+	}
+	if c.BatchSize <= 0 || c.SegmentSize <= 0 {
+		return errors.New("cluster-replication batch-size and segment-size can not be zero or negative")
+	}
+	if c.FlushInterval <= 0 || c.HTTPTimeout <= 0 {
+		return errors.New("cluster-replication flush-interval and http-timeout can not be zero or negative")
+	}
+	return nil
+}
+
+// IsReplicated returns true if the database is replicated to the peer cluster
+func (c *ClusterReplication) IsReplicated(db string) bool {
+	if len(c.Databases) == 0 {
+		return true
+	}
+	for _, name := range c.Databases {
+		if name == db {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ClusterReplication) ShowConfigs() map[string]interface{} {
+	return map[string]interface{}{
+		"cluster-replication.enabled":        c.Enabled,
+		"cluster-replication.role":           c.Role,
+		"cluster-replication.peer":           c.Peer,
+		"cluster-replication.databases":      c.Databases,
+		"cluster-replication.dir":            c.Dir,
+		"cluster-replication.batch-size":     c.BatchSize,
+		"cluster-replication.segment-size":   c.SegmentSize,
+		"cluster-replication.flush-interval": c.FlushInterval,
+		"cluster-replication.drain-timeout":  c.DrainTimeout,
+		"cluster-replication.http-timeout":   c.HTTPTimeout,
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	burntToml "github.com/BurntSushi/toml"
 	"github.com/influxdata/influxdb/toml"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/stretchr/testify/assert"
@@ -339,6 +340,33 @@ func TestTSMetaBindJoinErr(t *testing.T) {
 	assert.Error(t, conf.Common.Validate())
 }
 
+func TestMerge_OutOfOrderWindow(t *testing.T) {
+	conf := config.NewTSStore(true)
+	_, err := burntToml.Decode(`
+[data.merge]
+  out-of-order-window = "1h"
+  [data.merge.database-out-of-order-windows]
+    iot = "6h"
+    db0 = "0s"
+`, conf)
+	require.NoError(t, err)
+
+	merge := &conf.Data.Merge
+	require.Equal(t, int64(time.Hour), merge.OutOfOrderWindowOf("db1"))
+	require.Equal(t, int64(6*time.Hour), merge.OutOfOrderWindowOf("iot"))
+	require.Equal(t, int64(0), merge.OutOfOrderWindowOf("db0"))
+
+	conf.Data.IngesterAddress = "127.0.0.1:8800"
+	conf.Data.SelectAddress = "127.0.0.1:8801"
+	conf.Data.DataDir = "/opt/gemini"
+	conf.Data.MetaDir = "/opt/gemini/meta"
+	conf.Data.WALDir = "/opt/gemini/wal"
+	require.NoError(t, conf.Data.Validate())
+
+	merge.DatabaseOutOfOrderWindows["iot"] = -1
+	require.EqualError(t, conf.Data.Validate(), "data.merge database-out-of-order-windows iot must be greater than 0. got: -1")
+}
+
 func TestHotMode(t *testing.T) {
 	conf := config.HotMode{}
 	require.True(t, conf.GetMemoryAllowedPercent() == config.DefaultHotModeMemoryAllowedPercent)
@@ -0,0 +1,54 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultDecommissionThroughput    = 64 * 1024 * 1024
+	DefaultDecommissionCheckInterval = 5 * time.Second
+)
+
+// DecommissionConfig is the configuration of the decommission of the ts-store nodes of the local-storage clusters,
+// the files of the db pts are copied from the decommissioned node to the other nodes before the db pts are moved
+type DecommissionConfig struct {
+	// Max bytes per second read from the decommissioned node by the copy of a db pt
+	Throughput toml.Size `toml:"throughput"`
+
+	// Interval between two checks of the progress of the copy of a db pt
+	CheckInterval toml.Duration `toml:"check-interval"`
+}
+
+func NewDecommissionConfig() DecommissionConfig {
+	return DecommissionConfig{
+		Throughput:    toml.Size(DefaultDecommissionThroughput),
+		CheckInterval: toml.Duration(DefaultDecommissionCheckInterval),
+	}
+}
+
+func (c DecommissionConfig) Validate() error {
+	if c.Throughput <= 0 {
+		return errors.New("meta decommission throughput must be positive")
+	}
+	if c.CheckInterval <= 0 {
+		return errors.New("meta decommission check-interval must be positive")
+	}
+	return nil
+}
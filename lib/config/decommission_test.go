@@ -0,0 +1,33 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecommissionConfig_Validate(t *testing.T) {
+	conf := NewDecommissionConfig()
+	assert.NoError(t, conf.Validate())
+
+	conf.Throughput = 0
+	assert.EqualError(t, conf.Validate(), "meta decommission throughput must be positive")
+
+	conf.Throughput = 1024
+	conf.CheckInterval = 0
+	assert.EqualError(t, conf.Validate(), "meta decommission check-interval must be positive")
+}
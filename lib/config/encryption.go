@@ -0,0 +1,50 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+)
+
+const DefaultKeyProvider = "local"
+
+// Encryption is the config of encryption at rest for TSSP, WAL, index and raft log files
+type Encryption struct {
+	Enabled bool `toml:"enabled"`
+	// the name of key provider, "local" reads the keys from key-file
+	KeyProvider string `toml:"key-provider"`
+	// the config of key provider, such as the path of key file for the local key provider
+	KeyFile string `toml:"key-file"`
+}
+
+func NewEncryptionConfig() Encryption {
+	return Encryption{
+		Enabled:     false,
+		KeyProvider: DefaultKeyProvider,
+	}
+}
+
+func (c Encryption) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.KeyProvider == "" {
+		return errors.New("data encryption key-provider must be set if encryption is enabled")
+	}
+	if c.KeyProvider == DefaultKeyProvider && c.KeyFile == "" {
+		return errors.New("data encryption key-file must be set for the local key provider")
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultLoadBalanceWeight             = 1.0
+	DefaultLoadBalanceMaxMoves           = 2
+	DefaultLoadBalanceMaxMoveSize        = 20 * 1024 * 1024 * 1024
+	DefaultLoadBalanceImbalanceThreshold = 0.2
+	DefaultLoadBalanceSampleInterval     = 10 * time.Second
+	DefaultLoadBalanceInterval           = 10 * time.Minute
+)
+
+// LoadBalanceAlgoVer is the balance-algorithm-version of the load-aware balance. It moves a db pt by changing
+// its owner without copying its data, so it is only supported by the shared-storage HA policy
+const LoadBalanceAlgoVer = "v2.0"
+
+// LoadBalanceConfig is the configuration of the load-aware balance algorithm of the db pts (balance-algorithm-version v2.0).
+// The load of a db pt is the weighted sum of its shares of the size, the ingest rate and the query time of the cluster
+type LoadBalanceConfig struct {
+	SizeWeight   float64 `toml:"size-weight"`
+	IngestWeight float64 `toml:"ingest-weight"`
+	QueryWeight  float64 `toml:"query-weight"`
+
+	// Max db pts moved by a balance round
+	MaxMoves int `toml:"max-moves"`
+
+	// Max total size of the db pts moved by a balance round, 0 means no limit
+	MaxMoveSize toml.Size `toml:"max-move-size"`
+
+	// The db pts are not moved while (max node load - min node load) / mean node load is below the threshold
+	ImbalanceThreshold float64 `toml:"imbalance-threshold"`
+
+	// The ingest rate and the query time of the db pts are measured between two samples at least sample-interval apart
+	SampleInterval toml.Duration `toml:"sample-interval"`
+
+	// Interval between two balance rounds
+	Interval toml.Duration `toml:"interval"`
+}
+
+func NewLoadBalanceConfig() LoadBalanceConfig {
+	return LoadBalanceConfig{
+		SizeWeight:         DefaultLoadBalanceWeight,
+		IngestWeight:       DefaultLoadBalanceWeight,
+		QueryWeight:        DefaultLoadBalanceWeight,
+		MaxMoves:           DefaultLoadBalanceMaxMoves,
+		MaxMoveSize:        toml.Size(DefaultLoadBalanceMaxMoveSize),
+		ImbalanceThreshold: DefaultLoadBalanceImbalanceThreshold,
+		SampleInterval:     toml.Duration(DefaultLoadBalanceSampleInterval),
+		Interval:           toml.Duration(DefaultLoadBalanceInterval),
+	}
+}
+
+func (c LoadBalanceConfig) Validate() error {
+	if c.SizeWeight < 0 || c.IngestWeight < 0 || c.QueryWeight < 0 {
+		return errors.New("meta load-balance weights must not be negative")
+	}
+	if c.SizeWeight+c.IngestWeight+c.QueryWeight == 0 {
+		return errors.New("meta load-balance needs a positive weight")
+	}
+	if c.MaxMoves <= 0 {
+		return errors.New("meta load-balance max-moves must be positive")
+	}
+	if c.ImbalanceThreshold < 0 {
+		return errors.New("meta load-balance imbalance-threshold must not be negative")
+	}
+	if c.SampleInterval <= 0 || c.Interval <= 0 {
+		return errors.New("meta load-balance sample-interval and interval must be positive")
+	}
+	return nil
+}
+
+// ValidateHaPolicy returns an error if the load-aware balance is used by a HA policy other than shared-storage
+func (c LoadBalanceConfig) ValidateHaPolicy(algo, haPolicy string) error {
+	if algo == LoadBalanceAlgoVer && haPolicy != SSPolicy {
+		return fmt.Errorf("meta balance-algorithm-version %s requires ha-policy %s, got %s", LoadBalanceAlgoVer, SSPolicy, haPolicy)
+	}
+	return nil
+}
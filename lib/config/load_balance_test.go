@@ -0,0 +1,59 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadBalanceConfig_Validate(t *testing.T) {
+	conf := NewLoadBalanceConfig()
+	assert.NoError(t, conf.Validate())
+
+	conf.QueryWeight = -1
+	assert.EqualError(t, conf.Validate(), "meta load-balance weights must not be negative")
+
+	conf.SizeWeight, conf.IngestWeight, conf.QueryWeight = 0, 0, 0
+	assert.EqualError(t, conf.Validate(), "meta load-balance needs a positive weight")
+
+	conf = NewLoadBalanceConfig()
+	conf.MaxMoves = 0
+	assert.EqualError(t, conf.Validate(), "meta load-balance max-moves must be positive")
+
+	conf = NewLoadBalanceConfig()
+	conf.ImbalanceThreshold = -0.1
+	assert.EqualError(t, conf.Validate(), "meta load-balance imbalance-threshold must not be negative")
+
+	conf = NewLoadBalanceConfig()
+	conf.SampleInterval = 0
+	assert.EqualError(t, conf.Validate(), "meta load-balance sample-interval and interval must be positive")
+}
+
+func TestLoadBalanceConfig_ValidateHaPolicy(t *testing.T) {
+	conf := NewLoadBalanceConfig()
+	assert.NoError(t, conf.ValidateHaPolicy(LoadBalanceAlgoVer, SSPolicy))
+	assert.NoError(t, conf.ValidateHaPolicy(DefaultBalanceAlgoVer, WAFPolicy))
+	assert.EqualError(t, conf.ValidateHaPolicy(LoadBalanceAlgoVer, WAFPolicy),
+		"meta balance-algorithm-version v2.0 requires ha-policy shared-storage, got write-available-first")
+	assert.Error(t, conf.ValidateHaPolicy(LoadBalanceAlgoVer, RepPolicy))
+
+	tsMeta := NewTSMeta(false)
+	tsMeta.Meta.BalanceAlgo = LoadBalanceAlgoVer
+	tsMeta.Common.HaPolicy = RepPolicy
+	assert.EqualError(t, tsMeta.Validate(),
+		"meta balance-algorithm-version v2.0 requires ha-policy shared-storage, got replication")
+}
@@ -120,7 +120,7 @@ func (c *TSMeta) Validate() error {
 		}
 	}
 
-	return nil
+	return c.Meta.LoadBalance.ValidateHaPolicy(c.Meta.BalanceAlgo, c.Common.HaPolicy)
 }
 
 // ApplyEnvOverrides apply the environment configuration on top of the config.
@@ -195,6 +195,10 @@ type Meta struct {
 
 	MetaEventHandleEn bool `toml:"meta-event-handle-enable"`
 	BindPeers         []string
+
+	Repair       RepairConfig       `toml:"repair"`
+	Decommission DecommissionConfig `toml:"decommission"`
+	LoadBalance  LoadBalanceConfig  `toml:"load-balance"`
 }
 
 // NewMeta builds a new configuration with default values.
@@ -228,6 +232,9 @@ func NewMeta() *Meta {
 		UseIncSyncData:          true,
 		SchemaCleanEn:           true,
 		BindPeers:               []string{},
+		Repair:                  NewRepairConfig(),
+		Decommission:            NewDecommissionConfig(),
+		LoadBalance:             NewLoadBalanceConfig(),
 	}
 }
 
@@ -259,7 +266,13 @@ func (c *Meta) Validate() error {
 		return err
 	}
 
-	return nil
+	if err := c.Repair.Validate(); err != nil {
+		return err
+	}
+	if err := c.Decommission.Validate(); err != nil {
+		return err
+	}
+	return c.LoadBalance.Validate()
 }
 
 func (c *Meta) BuildRaft() *raft.Config {
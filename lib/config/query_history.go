@@ -0,0 +1,80 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultQueryHistoryLogFile        = "sql_slow_query"
+	DefaultQueryHistoryBufferSize     = 1024
+	DefaultQueryHistoryFlushInterval  = 10 * time.Second
+	DefaultQueryHistoryMaxQueryLength = 4096
+	DefaultQueryHistoryRetention      = 7 * 24 * time.Hour
+)
+
+// QueryHistory is the configuration of the history of the slow queries of the ts-sql,
+// a query is slow if it takes more than http.slow-query-time
+type QueryHistory struct {
+	Enabled bool `toml:"enabled"`
+
+	// Write the slow queries into the measurement query_history of the _internal database
+	WriteMeasurement bool `toml:"write-measurement"`
+	// Name of the rotating log file of the slow queries in the logging path, no log file if it is empty
+	LogFile string `toml:"log-file"`
+
+	// Max slow queries waiting to be recorded, the slow queries above are dropped
+	BufferSize    int           `toml:"buffer-size"`
+	FlushInterval toml.Duration `toml:"flush-interval"`
+	// The statements longer than max-query-length are truncated
+	MaxQueryLength int `toml:"max-query-length"`
+	// Retention of the _internal database if it is created for the query history
+	Retention toml.Duration `toml:"retention"`
+}
+
+func NewQueryHistory() QueryHistory {
+	return QueryHistory{
+		Enabled:          true,
+		WriteMeasurement: true,
+		LogFile:          DefaultQueryHistoryLogFile,
+		BufferSize:       DefaultQueryHistoryBufferSize,
+		FlushInterval:    toml.Duration(DefaultQueryHistoryFlushInterval),
+		MaxQueryLength:   DefaultQueryHistoryMaxQueryLength,
+		Retention:        toml.Duration(DefaultQueryHistoryRetention),
+	}
+}
+
+func (c QueryHistory) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.BufferSize <= 0 {
+		return errors.New("query-history buffer-size must be positive")
+	}
+	if c.FlushInterval <= 0 {
+		return errors.New("query-history flush-interval must be positive")
+	}
+	if c.MaxQueryLength <= 0 {
+		return errors.New("query-history max-query-length must be positive")
+	}
+	if c.Retention < 0 {
+		return errors.New("query-history retention must not be negative")
+	}
+	return nil
+}
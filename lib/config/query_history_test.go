@@ -0,0 +1,45 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryHistory_Validate(t *testing.T) {
+	conf := NewQueryHistory()
+	assert.NoError(t, conf.Validate())
+
+	conf.BufferSize = 0
+	assert.EqualError(t, conf.Validate(), "query-history buffer-size must be positive")
+
+	// the config is not validated if the query history is disabled
+	conf.Enabled = false
+	assert.NoError(t, conf.Validate())
+
+	conf = NewQueryHistory()
+	conf.FlushInterval = 0
+	assert.EqualError(t, conf.Validate(), "query-history flush-interval must be positive")
+
+	conf = NewQueryHistory()
+	conf.MaxQueryLength = -1
+	assert.EqualError(t, conf.Validate(), "query-history max-query-length must be positive")
+
+	conf = NewQueryHistory()
+	conf.Retention = -1
+	assert.EqualError(t, conf.Validate(), "query-history retention must not be negative")
+}
@@ -15,6 +15,8 @@
 package config
 
 import (
+	"errors"
+
 	"github.com/influxdata/influxdb/toml"
 	"github.com/openGemini/openGemini/lib/memory"
 )
@@ -22,6 +24,9 @@ import (
 const (
 	DefaultReadMetaCachePercent = 3
 	DefaultReadDataCachePercent = 10
+
+	DefaultColdCacheSize      = 10 * GB
+	DefaultColdCacheBlockSize = 1 * MB
 )
 
 var ReadMetaCachePct = DefaultReadMetaCachePercent
@@ -34,6 +39,12 @@ type ReadCache struct {
 	ReadMetaCacheEnPct toml.Size `toml:"read-meta-cache-limit-pct"`
 	ReadDataCacheEn    toml.Size `toml:"enable-data-cache"`
 	ReadDataCacheEnPct toml.Size `toml:"read-data-cache-limit-pct"`
+
+	// the blocks of the TSSP files on the cold storage are cached in ColdCacheDir, empty is disabled
+	ColdCacheDir       string    `toml:"cold-cache-dir"`
+	ColdCacheSize      toml.Size `toml:"cold-cache-size"`
+	ColdCacheBlockSize toml.Size `toml:"cold-cache-block-size"`
+	ColdPrefetchMeta   bool      `toml:"cold-prefetch-meta"`
 }
 
 func NewReadCacheConfig() ReadCache {
@@ -46,7 +57,20 @@ func NewReadCacheConfig() ReadCache {
 		ReadMetaCacheEnPct: DefaultReadMetaCachePercent,
 		ReadDataCacheEn:    toml.Size(getReadMetaCacheLimitSize(uint64(memorySize))),
 		ReadDataCacheEnPct: DefaultReadDataCachePercent,
+		ColdCacheSize:      DefaultColdCacheSize,
+		ColdCacheBlockSize: DefaultColdCacheBlockSize,
+		ColdPrefetchMeta:   true,
+	}
+}
+
+func (c ReadCache) Validate() error {
+	if c.ColdCacheDir == "" {
+		return nil
+	}
+	if c.ColdCacheBlockSize == 0 || c.ColdCacheBlockSize > c.ColdCacheSize {
+		return errors.New("data readcache cold-cache-block-size must be in (0, cold-cache-size]")
 	}
+	return nil
 }
 
 func SetReadMetaCachePct(pct int) {
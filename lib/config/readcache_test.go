@@ -0,0 +1,36 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadCache_Validate(t *testing.T) {
+	conf := NewReadCacheConfig()
+	conf.ColdCacheBlockSize = 0
+	assert.NoError(t, conf.Validate())
+
+	conf.ColdCacheDir = "/tmp/cold"
+	assert.EqualError(t, conf.Validate(), "data readcache cold-cache-block-size must be in (0, cold-cache-size]")
+
+	conf.ColdCacheBlockSize = conf.ColdCacheSize + 1
+	assert.Error(t, conf.Validate())
+
+	conf.ColdCacheBlockSize = DefaultColdCacheBlockSize
+	assert.NoError(t, conf.Validate())
+}
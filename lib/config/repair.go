@@ -0,0 +1,70 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultRepairLookback    = 7 * 24 * time.Hour
+	DefaultRepairMinAge      = time.Hour
+	DefaultRepairWindow      = time.Hour
+	DefaultRepairBatchSeries = 1000
+)
+
+// RepairConfig is the configuration of the anti-entropy repair between the replicas of the replicated databases,
+// the repair rounds are run by the ts-meta leader
+type RepairConfig struct {
+	// Interval between two scheduled rounds of repair, 0 disables the scheduled repair
+	RunInterval toml.Duration `toml:"run-interval"`
+
+	// Only the data within the last lookback is checked by the scheduled rounds
+	Lookback toml.Duration `toml:"lookback"`
+
+	// The data newer than min-age is not checked, it may be still in the memtables or in the raft logs
+	MinAge toml.Duration `toml:"min-age"`
+
+	// Size of the time windows the digests of the replicas are computed and compared for
+	Window toml.Duration `toml:"window"`
+
+	// Max number of series repaired by one request to a ts-store
+	BatchSeries int `toml:"batch-series"`
+}
+
+func NewRepairConfig() RepairConfig {
+	return RepairConfig{
+		Lookback:    toml.Duration(DefaultRepairLookback),
+		MinAge:      toml.Duration(DefaultRepairMinAge),
+		Window:      toml.Duration(DefaultRepairWindow),
+		BatchSeries: DefaultRepairBatchSeries,
+	}
+}
+
+func (c RepairConfig) Validate() error {
+	if c.RunInterval < 0 || c.Lookback < 0 || c.MinAge < 0 {
+		return errors.New("meta repair run-interval, lookback and min-age can not be negative")
+	}
+	if c.Window <= 0 {
+		return errors.New("meta repair window must be positive")
+	}
+	if c.BatchSeries <= 0 {
+		return errors.New("meta repair batch-series must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepairConfig_Validate(t *testing.T) {
+	conf := NewRepairConfig()
+	assert.NoError(t, conf.Validate())
+
+	conf.RunInterval = -1
+	assert.EqualError(t, conf.Validate(), "meta repair run-interval, lookback and min-age can not be negative")
+
+	conf.RunInterval = 10
+	conf.Window = 0
+	assert.EqualError(t, conf.Validate(), "meta repair window must be positive")
+
+	conf.Window = 10
+	conf.BatchSeries = 0
+	assert.EqualError(t, conf.Validate(), "meta repair batch-series must be positive")
+}
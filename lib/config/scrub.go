@@ -0,0 +1,64 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultScrubRunInterval    = 24 * time.Hour
+	DefaultScrubReadThroughput = 16 * 1024 * 1024
+)
+
+// ScrubConfig represents a configuration for the background data scrubbing service.
+type ScrubConfig struct {
+	// If false, close the scrub service
+	Enabled bool `toml:"enabled"`
+
+	// Interval time between two rounds of scrubbing all the files of the node
+	RunInterval toml.Duration `toml:"run-interval"`
+
+	// Max bytes read per second by the scrubber
+	ReadThroughput toml.Size `toml:"read-throughput"`
+
+	// Re-fetch the corrupt series of a TSSP file from a peer replica in replicated databases
+	RepairFromPeer bool `toml:"repair-from-peer"`
+}
+
+func NewScrubConfig() ScrubConfig {
+	return ScrubConfig{
+		Enabled:        false,
+		RunInterval:    toml.Duration(DefaultScrubRunInterval),
+		ReadThroughput: toml.Size(DefaultScrubReadThroughput),
+		RepairFromPeer: true,
+	}
+}
+
+func (c ScrubConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.RunInterval <= 0 {
+		return errors.New("scrub run-interval must be positive")
+	}
+	if c.ReadThroughput <= 0 {
+		return errors.New("scrub read-throughput must be positive")
+	}
+	return nil
+}
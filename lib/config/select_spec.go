@@ -14,6 +14,8 @@
 
 package config
 
+import "github.com/influxdata/influxdb/toml"
+
 const (
 	DefaultSeriesCount = 0
 
@@ -24,6 +26,11 @@ type SelectSpecConfig struct {
 	EnableWhenExceed bool `toml:"enable-query-when-exceed"`
 	QuerySeriesLimit int  `toml:"query-series-limit"`
 	QuerySchemaLimit int  `toml:"query-schema-limit"`
+
+	// QueryMemoryLimit is the memory budget of the sort and hash aggregation operators of one query.
+	// Operators exceeding the budget spill their data to SpillDir.
+	QueryMemoryLimit toml.Size `toml:"query-memory-limit"`
+	SpillDir         string    `toml:"spill-dir"`
 }
 
 func NewSelectSpecConfig() SelectSpecConfig {
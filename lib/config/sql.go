@@ -49,6 +49,13 @@ const (
 	DefaultShardTier                = "warm"
 	DefaultForceBroadcastQuery      = false
 	DefaultRetentionPolicyLimit     = 100
+
+	// DefaultFollowerReadMaxStaleness is the default max staleness of the data read from the follower replicas.
+	DefaultFollowerReadMaxStaleness = 5 * time.Second
+
+	// DefaultMaxSemiJoinValues is the default max number of the distinct values returned by the subquery of an
+	// IN or NOT IN condition.
+	DefaultMaxSemiJoinValues = 10000
 )
 
 /*
@@ -88,12 +95,16 @@ type TSSql struct {
 
 	Subscriber Subscriber `toml:"subscriber"`
 
+	ClusterReplication ClusterReplication `toml:"cluster-replication"`
+
 	ContinuousQuery ContinuousQueryConfig `toml:"continuous_queries"`
 	Data            Store                 `toml:"data"`
 
 	Limits        Limits            `toml:"limits"`
 	RuntimeConfig RuntimeConfig     `toml:"runtime-config"`
 	RecordWrite   RecordWriteConfig `toml:"record-write"`
+	Trace         Trace             `toml:"trace"`
+	QueryHistory  QueryHistory      `toml:"query-history"`
 }
 
 // NewTSSql returns an instance of Config with reasonable defaults.
@@ -109,12 +120,15 @@ func NewTSSql(enableGossip bool) *TSSql {
 	c.Sherlock = NewSherlockConfig()
 	c.SelectSpec = NewSelectSpecConfig()
 	c.Subscriber = NewSubscriber()
+	c.ClusterReplication = NewClusterReplication()
 	c.ContinuousQuery = NewContinuousQueryConfig()
 	c.Gossip = NewGossip(enableGossip)
 	c.Data = NewStore()
 	c.Limits = NewLimits()
 	c.RuntimeConfig = NewRuntimeConfig()
 	c.RecordWrite = NewRecordWriteConfig()
+	c.Trace = NewTrace()
+	c.QueryHistory = NewQueryHistory()
 	return c
 }
 
@@ -174,9 +188,12 @@ func (c *TSSql) Validate() error {
 		c.Analysis,
 		c.Sherlock,
 		c.Subscriber,
+		c.ClusterReplication,
 		c.ContinuousQuery,
 		c.RuntimeConfig,
 		c.RecordWrite,
+		c.Trace,
+		c.QueryHistory,
 	}
 
 	for _, item := range items {
@@ -222,6 +239,9 @@ func (c *TSSql) ShowConfigs() map[string]interface{} {
 	for k, v := range c.Subscriber.ShowConfigs() {
 		sqlConfig[k] = v
 	}
+	for k, v := range c.ClusterReplication.ShowConfigs() {
+		sqlConfig[k] = v
+	}
 	for k, v := range c.ContinuousQuery.ShowConfigs() {
 		sqlConfig[k] = v
 	}
@@ -263,6 +283,21 @@ type Coordinator struct {
 	ForceBroadcastQuery     bool `toml:"force-broadcast-query"`
 
 	HardWrite bool `toml:"hard-write"`
+
+	// FollowerRead routes the queries of the databases with the replication HA policy to the
+	// master or to the follower replicas whose data is not older than FollowerReadMaxStaleness
+	FollowerRead             bool          `toml:"follower-read"`
+	FollowerReadMaxStaleness toml.Duration `toml:"follower-read-max-staleness"`
+
+	// Maximum number of the distinct values returned by the subquery of an IN or NOT IN condition
+	MaxSemiJoinValues int `toml:"max-semi-join-values"`
+
+	// PlanStatistics collects the statistics of the queried measurements from the tsi index
+	// and the file metadata of the stores for the cost based planner
+	PlanStatistics bool `toml:"plan-statistics"`
+
+	// ShowShardMaintenance queries the maintenance tasks of every data node in SHOW SHARDS
+	ShowShardMaintenance bool `toml:"show-shard-maintenance"`
 }
 
 // NewCoordinator returns an instance of Config with defaults.
@@ -283,6 +318,8 @@ func NewCoordinator() Coordinator {
 		RetentionPolicyLimit:     DefaultRetentionPolicyLimit,
 		ForceBroadcastQuery:      DefaultForceBroadcastQuery,
 		HardWrite:                false,
+		FollowerReadMaxStaleness: toml.Duration(DefaultFollowerReadMaxStaleness),
+		MaxSemiJoinValues:        DefaultMaxSemiJoinValues,
 	}
 }
 
@@ -297,6 +334,12 @@ func (c Coordinator) Validate() error {
 	if c.ShardMapperTimeout < 0 {
 		return errors.New("coordinator shard-mapper-timeout can not be negative")
 	}
+	if c.FollowerRead && c.FollowerReadMaxStaleness <= 0 {
+		return errors.New("coordinator follower-read-max-staleness must be positive")
+	}
+	if c.MaxSemiJoinValues <= 0 {
+		return errors.New("coordinator max-semi-join-values must be positive")
+	}
 	return nil
 }
 
@@ -320,5 +363,9 @@ func (c *Coordinator) ShowConfigs() map[string]interface{} {
 		"coordinator.time-range-limit":            c.TimeRangeLimit,
 		"coordinator.tag-limit":                   c.TagLimit,
 		"coordinator.hard-write":                  c.HardWrite,
+		"coordinator.follower-read":               c.FollowerRead,
+		"coordinator.follower-read-max-staleness": c.FollowerReadMaxStaleness,
+		"coordinator.max-semi-join-values":        c.MaxSemiJoinValues,
+		"coordinator.plan-statistics":             c.PlanStatistics,
 	}
 }
@@ -89,6 +89,7 @@ type TSStore struct {
 	Retention         retention.Config   `toml:"retention"`
 	DownSample        retention.Config   `toml:"downsample"`
 	HierarchicalStore HierarchicalConfig `toml:"hierarchical_storage"`
+	Scrub             ScrubConfig        `toml:"scrub"`
 	Stream            stream.Config      `toml:"stream"`
 
 	// TLS provides configuration options for all https endpoints.
@@ -106,6 +107,8 @@ type TSStore struct {
 
 	// logkeeper config
 	LogStore *LogStoreConfig `toml:"logstore"`
+
+	Trace Trace `toml:"trace"`
 }
 
 // NewTSStore returns an instance of Config with reasonable defaults.
@@ -124,6 +127,7 @@ func NewTSStore(enableGossip bool) *TSStore {
 	c.Retention = retention.NewConfig()
 	c.DownSample = retention.NewConfig()
 	c.HierarchicalStore = NewHierarchicalConfig()
+	c.Scrub = NewScrubConfig()
 	c.Gossip = NewGossip(enableGossip)
 
 	c.Analysis = NewCastor()
@@ -134,6 +138,7 @@ func NewTSStore(enableGossip bool) *TSStore {
 	c.Meta = NewMeta()
 	c.ClvConfig = NewClvConfig()
 	c.LogStore = NewLogStoreConfig()
+	c.Trace = NewTrace()
 	return c
 }
 
@@ -150,12 +155,14 @@ func (c *TSStore) Validate() error {
 		c.Retention,
 		c.DownSample,
 		c.HierarchicalStore,
+		c.Scrub,
 		c.TLS,
 		c.Logging,
 		c.Spdy,
 		c.Analysis,
 		c.Sherlock,
 		c.IODetector,
+		c.Trace,
 	}
 
 	for _, item := range items {
@@ -238,6 +245,9 @@ type Store struct {
 	// configs for readCache
 	ReadCache ReadCache `toml:"readcache"`
 
+	// configs for encryption at rest
+	Encryption Encryption `toml:"encryption"`
+
 	EnableMmapRead bool `toml:"enable-mmap-read"`
 	Readonly       bool `toml:"readonly"`
 
@@ -274,8 +284,12 @@ type Store struct {
 	ChunkMetaCompressMode      int    `toml:"chunk-meta-compress-mode"`
 	IndexReadCachePersistent   bool   `toml:"index-read-cache-persistent"`
 	FloatCompressAlgorithm     string `toml:"float-compress-algorithm"`
+	IntegerCompressAlgorithm   string `toml:"integer-compress-algorithm"`
 
 	StringCompressAlgo string `toml:"string-compress-algo"`
+	// encode the string columns by dictionary, chosen per segment by the cardinality of the values,
+	// the files written with it can not be read by the versions without the dictionary support
+	StringDictEncoding bool `toml:"string-dict-encoding"`
 	// Ordered data and unordered data are not distinguished. All data is processed as unordered data.
 	UnorderedOnly bool `toml:"unordered-only"`
 
@@ -322,6 +336,7 @@ func NewStore() Store {
 		Wal:                          NewWalConfig(),
 		ReadCache:                    NewReadCacheConfig(),
 		RaftStorage:                  NewRaftStorageConfig(),
+		Encryption:                   NewEncryptionConfig(),
 		EnableMmapRead:               false,
 		WriteConcurrentLimit:         0,
 		OpsMonitor:                   NewOpsMonitorConfig(),
@@ -333,6 +348,7 @@ func NewStore() Store {
 		InterruptSqlMemPct:           DefaultInterruptSqlMemPct,
 		IndexReadCachePersistent:     false,
 		StringCompressAlgo:           CompressAlgoSnappy,
+		StringDictEncoding:           true,
 		Merge:                        defaultMerge(),
 		MaxRowsPerSegment:            util.DefaultMaxRowsPerSegment4TsStore,
 		ShardMoveLayoutSwitchEnabled: false,
@@ -419,13 +435,20 @@ func (c Store) Validate() error {
 		{"data max-full-compactions", int64(c.Compact.MaxFullCompactions), true},
 		{"data write-cold-duration", int64(c.MemTable.WriteColdDuration), false},
 		{"data max-write-hang-time", int64(c.MemTable.MaxWriteHangTime), false},
+		{"data.merge out-of-order-window", int64(c.Merge.OutOfOrderWindow), true},
+	}
+	for db, w := range c.Merge.DatabaseOutOfOrderWindows {
+		ivItems = append(ivItems, intValidatorItem{"data.merge database-out-of-order-windows " + db, int64(w), true})
 	}
 	iv := intValidator{0, math.MaxInt64}
 	if err := iv.Validate(ivItems); err != nil {
 		return err
 	}
 
-	return nil
+	if err := c.ReadCache.Validate(); err != nil {
+		return err
+	}
+	return c.Encryption.Validate()
 }
 
 func (c Store) ValidateEngine(engines []string) error {
@@ -509,6 +532,20 @@ type Merge struct {
 	MinInterval toml.Duration `toml:"min-interval"`
 
 	StreamMergeModeLevel int `toml:"stream-merge-mode-level"`
+
+	// Late-arriving data is buffered into the unordered files partitioned by the window,
+	// and each window is only merged with the overlapping ordered files. 0 means disabled
+	OutOfOrderWindow toml.Duration `toml:"out-of-order-window"`
+	// The out-of-order windows of some databases, which override OutOfOrderWindow
+	DatabaseOutOfOrderWindows map[string]toml.Duration `toml:"database-out-of-order-windows"`
+}
+
+// OutOfOrderWindowOf returns the out-of-order window of the database in nanoseconds
+func (m *Merge) OutOfOrderWindowOf(db string) int64 {
+	if w, ok := m.DatabaseOutOfOrderWindows[db]; ok {
+		return int64(w)
+	}
+	return int64(m.OutOfOrderWindow)
 }
 
 func defaultMerge() Merge {
@@ -0,0 +1,95 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	TraceProtocolGRPC = "grpc"
+	TraceProtocolHTTP = "http"
+
+	DefaultTraceEndpoint           = "127.0.0.1:4317"
+	DefaultTraceURLPath            = "/v1/traces"
+	DefaultTraceSampleRatio        = 0.1
+	DefaultTraceBatchTimeout       = 5 * time.Second
+	DefaultTraceExportTimeout      = 10 * time.Second
+	DefaultTraceMaxQueueSize       = 2048
+	DefaultTraceMaxExportBatchSize = 512
+)
+
+// Trace is the configuration of the OpenTelemetry trace export of the queries and the writes
+type Trace struct {
+	Enabled bool `toml:"enabled"`
+
+	// OTLP protocol of the collector, grpc or http
+	Protocol string `toml:"protocol"`
+	// host:port of the collector
+	Endpoint string `toml:"endpoint"`
+	// URL path of the collector, only used by the http protocol
+	URLPath  string `toml:"url-path"`
+	Insecure bool   `toml:"insecure"`
+
+	// Ratio of the traces sampled by the root spans, between 0 and 1
+	SampleRatio float64 `toml:"sample-ratio"`
+	// Spans with a remote parent, such as a traceparent header or a request of ts-sql, follow the sampling of the parent
+	ParentBased bool `toml:"parent-based"`
+
+	BatchTimeout       toml.Duration `toml:"batch-timeout"`
+	ExportTimeout      toml.Duration `toml:"export-timeout"`
+	MaxQueueSize       int           `toml:"max-queue-size"`
+	MaxExportBatchSize int           `toml:"max-export-batch-size"`
+}
+
+func NewTrace() Trace {
+	return Trace{
+		Protocol:           TraceProtocolGRPC,
+		Endpoint:           DefaultTraceEndpoint,
+		URLPath:            DefaultTraceURLPath,
+		Insecure:           true,
+		SampleRatio:        DefaultTraceSampleRatio,
+		ParentBased:        true,
+		BatchTimeout:       toml.Duration(DefaultTraceBatchTimeout),
+		ExportTimeout:      toml.Duration(DefaultTraceExportTimeout),
+		MaxQueueSize:       DefaultTraceMaxQueueSize,
+		MaxExportBatchSize: DefaultTraceMaxExportBatchSize,
+	}
+}
+
+func (c Trace) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Protocol != TraceProtocolGRPC && c.Protocol != TraceProtocolHTTP {
+		return errors.New("trace protocol must be grpc or http")
+	}
+	if c.Endpoint == "" {
+		return errors.New("trace endpoint must not be empty")
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return errors.New("trace sample-ratio must be between 0 and 1")
+	}
+	if c.BatchTimeout <= 0 || c.ExportTimeout <= 0 {
+		return errors.New("trace batch-timeout and export-timeout must be positive")
+	}
+	if c.MaxQueueSize <= 0 || c.MaxExportBatchSize <= 0 || c.MaxExportBatchSize > c.MaxQueueSize {
+		return errors.New("trace max-export-batch-size must be positive and not above max-queue-size")
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrace_Validate(t *testing.T) {
+	conf := NewTrace()
+	conf.Protocol = "udp"
+	// the config is not validated if the export is disabled
+	assert.NoError(t, conf.Validate())
+
+	conf.Enabled = true
+	assert.EqualError(t, conf.Validate(), "trace protocol must be grpc or http")
+
+	conf.Protocol = TraceProtocolHTTP
+	assert.NoError(t, conf.Validate())
+
+	conf.Endpoint = ""
+	assert.EqualError(t, conf.Validate(), "trace endpoint must not be empty")
+
+	conf = NewTrace()
+	conf.Enabled = true
+	conf.SampleRatio = 1.5
+	assert.EqualError(t, conf.Validate(), "trace sample-ratio must be between 0 and 1")
+
+	conf = NewTrace()
+	conf.Enabled = true
+	conf.ExportTimeout = 0
+	assert.EqualError(t, conf.Validate(), "trace batch-timeout and export-timeout must be positive")
+
+	conf = NewTrace()
+	conf.Enabled = true
+	conf.MaxExportBatchSize = conf.MaxQueueSize + 1
+	assert.EqualError(t, conf.Validate(), "trace max-export-batch-size must be positive and not above max-queue-size")
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DataKeySize is the size of the AES-256 keys used to encrypt the data files
+	DataKeySize = 32
+
+	// MaxKeyIDLen is the max length of a key id, the key id is stored in the header of every encrypted file
+	MaxKeyIDLen = 32
+
+	// DefaultKeyDatabase is the database name in the key file for the databases without their own keys
+	DefaultKeyDatabase = "*"
+
+	KeyProviderLocal = "local"
+)
+
+// KeyProvider provides the keys to encrypt the data files of each database.
+// Files are encrypted with the current key of the database and remember the id of the key,
+// so a retired key must be kept by the provider until all files encrypted by it are rewritten by compaction
+type KeyProvider interface {
+	// CurrentKey returns the key used to encrypt the new files of the database
+	CurrentKey(db string) (id string, key []byte, err error)
+	// Key returns the key by id to decrypt the existing files
+	Key(id string) ([]byte, error)
+}
+
+type KeyProviderCreator func(conf string) (KeyProvider, error)
+
+var keyProviders = map[string]KeyProviderCreator{
+	KeyProviderLocal: func(conf string) (KeyProvider, error) {
+		return NewLocalKeyProvider(conf, time.Minute)
+	},
+}
+
+// RegisterKeyProvider registers a key provider, such as a KMS client
+func RegisterKeyProvider(name string, creator KeyProviderCreator) {
+	keyProviders[name] = creator
+}
+
+func NewKeyProvider(name string, conf string) (KeyProvider, error) {
+	creator, ok := keyProviders[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown key provider: %s", name)
+	}
+	return creator(conf)
+}
+
+/*
+LocalKeyProvider reads the keys from a local key file, every line of the key file is:
+
+	<database> <key id> <hex encoded 32 bytes key>
+
+database "*" is used by the databases without their own keys. The last key of a database is the current key,
+keys are rotated by appending new lines to the key file, which is reloaded when it is modified
+*/
+type LocalKeyProvider struct {
+	path           string
+	reloadInterval time.Duration
+
+	mu        sync.RWMutex
+	current   map[string]string
+	keys      map[string][]byte
+	modTime   time.Time
+	lastCheck time.Time
+}
+
+func NewLocalKeyProvider(path string, reloadInterval time.Duration) (*LocalKeyProvider, error) {
+	p := &LocalKeyProvider{
+		path:           filepath.Clean(path),
+		reloadInterval: reloadInterval,
+	}
+	if err := p.Reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Reload reloads the key file if it has been modified
+func (p *LocalKeyProvider) Reload() error {
+	fi, err := os.Stat(p.path)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lastCheck = time.Now()
+	if p.keys != nil && fi.ModTime().Equal(p.modTime) {
+		return nil
+	}
+
+	buf, err := os.ReadFile(p.path)
+	if err != nil {
+		return err
+	}
+	current, keys, err := parseKeyFile(buf)
+	if err != nil {
+		return fmt.Errorf("invalid key file %s: %v", p.path, err)
+	}
+	p.current, p.keys, p.modTime = current, keys, fi.ModTime()
+	return nil
+}
+
+func (p *LocalKeyProvider) tryReload() {
+	p.mu.RLock()
+	expired := p.reloadInterval > 0 && time.Since(p.lastCheck) > p.reloadInterval
+	p.mu.RUnlock()
+	if !expired {
+		return
+	}
+	if err := p.Reload(); err != nil && logger != nil {
+		logger.Error("reload key file failed, keep using the loaded keys", zap.Error(err), zap.String("file", p.path))
+	}
+}
+
+func (p *LocalKeyProvider) CurrentKey(db string) (string, []byte, error) {
+	p.tryReload()
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	id, ok := p.current[db]
+	if !ok {
+		id, ok = p.current[DefaultKeyDatabase]
+	}
+	if !ok {
+		return "", nil, fmt.Errorf("no key for database %s", db)
+	}
+	return id, p.keys[id], nil
+}
+
+func (p *LocalKeyProvider) Key(id string) ([]byte, error) {
+	p.mu.RLock()
+	key, ok := p.keys[id]
+	p.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	// the key may be added after the last reload
+	if err := p.Reload(); err != nil {
+		return nil, err
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if key, ok = p.keys[id]; !ok {
+		return nil, fmt.Errorf("key %s not found", id)
+	}
+	return key, nil
+}
+
+func parseKeyFile(buf []byte) (map[string]string, map[string][]byte, error) {
+	current := make(map[string]string)
+	keys := make(map[string][]byte)
+
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	line := 0
+	for scanner.Scan() {
+		line++
+		s := strings.TrimSpace(scanner.Text())
+		if s == "" || strings.HasPrefix(s, "#") {
+			continue
+		}
+
+		items := strings.Fields(s)
+		if len(items) != 3 {
+			return nil, nil, fmt.Errorf("line %d: expect <database> <key id> <key>", line)
+		}
+		db, id := items[0], items[1]
+		if len(id) > MaxKeyIDLen {
+			return nil, nil, fmt.Errorf("line %d: key id is longer than %d", line, MaxKeyIDLen)
+		}
+		key, err := hex.DecodeString(items[2])
+		if err != nil || len(key) != DataKeySize {
+			return nil, nil, fmt.Errorf("line %d: key must be %d bytes in hex", line, DataKeySize)
+		}
+		if prev, ok := keys[id]; ok && !bytes.Equal(prev, key) {
+			return nil, nil, fmt.Errorf("line %d: duplicate key id %s", line, id)
+		}
+		keys[id] = key
+		current[db] = id
+	}
+	return current, keys, scanner.Err()
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalKeyProvider(t *testing.T) {
+	key1 := strings.Repeat("01", crypto.DataKeySize)
+	key2 := strings.Repeat("02", crypto.DataKeySize)
+	key3 := strings.Repeat("03", crypto.DataKeySize)
+
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(keyFile, []byte("# keys\n* k1 "+key1+"\ndb0 k2 "+key2+"\n"), 0600))
+
+	p, err := crypto.NewKeyProvider(crypto.KeyProviderLocal, keyFile)
+	require.NoError(t, err)
+
+	id, key, err := p.CurrentKey("db0")
+	require.NoError(t, err)
+	require.Equal(t, "k2", id)
+	require.Equal(t, byte(2), key[0])
+
+	id, _, err = p.CurrentKey("db1")
+	require.NoError(t, err)
+	require.Equal(t, "k1", id)
+
+	// rotate the key of db0, the old key is still available
+	require.NoError(t, os.WriteFile(keyFile, []byte("* k1 "+key1+"\ndb0 k2 "+key2+"\ndb0 k3 "+key3+"\n"), 0600))
+	require.NoError(t, os.Chtimes(keyFile, time.Now(), time.Now().Add(time.Second)))
+	key, err = p.Key("k3")
+	require.NoError(t, err)
+	require.Equal(t, byte(3), key[0])
+	id, _, err = p.CurrentKey("db0")
+	require.NoError(t, err)
+	require.Equal(t, "k3", id)
+	_, err = p.Key("k2")
+	require.NoError(t, err)
+
+	_, err = p.Key("k4")
+	require.EqualError(t, err, "key k4 not found")
+}
+
+func TestLocalKeyProvider_Error(t *testing.T) {
+	_, err := crypto.NewKeyProvider("kms", "")
+	require.EqualError(t, err, "unknown key provider: kms")
+
+	dir := t.TempDir()
+	_, err = crypto.NewKeyProvider(crypto.KeyProviderLocal, filepath.Join(dir, "not_exists"))
+	require.Error(t, err)
+
+	key := strings.Repeat("01", crypto.DataKeySize)
+	for _, content := range []string{
+		"db0 k1",
+		"db0 k1 0102",
+		"db0 " + strings.Repeat("k", crypto.MaxKeyIDLen+1) + " " + key,
+		"db0 k1 " + key + "\ndb1 k1 " + strings.Repeat("02", crypto.DataKeySize),
+	} {
+		keyFile := filepath.Join(dir, "keys")
+		require.NoError(t, os.WriteFile(keyFile, []byte(content), 0600))
+		_, err = crypto.NewLocalKeyProvider(keyFile, 0)
+		require.Error(t, err, content)
+	}
+
+	keyFile := filepath.Join(dir, "keys")
+	require.NoError(t, os.WriteFile(keyFile, []byte("db0 k1 "+key), 0600))
+	p, err := crypto.NewLocalKeyProvider(keyFile, 0)
+	require.NoError(t, err)
+	_, _, err = p.CurrentKey("db1")
+	require.EqualError(t, err, "no key for database db1")
+}
@@ -248,7 +248,10 @@ type CoderContext struct {
 	floatCoder  *Float
 	stringCoder *String
 	boolCoder   *Boolean
+	stringDict  *StringDict
 	buf         []byte
+
+	decodedDicts []*StringDict
 }
 
 func NewCoderContext() *CoderContext {
@@ -280,6 +283,7 @@ func (ctx *CoderContext) Release() {
 		PutDataCoder(ctx.timeCoder)
 		ctx.timeCoder = nil
 	}
+	ctx.ResetStringDicts()
 }
 
 func (ctx *CoderContext) GetTimeCoder() *Time {
@@ -562,6 +566,10 @@ func DecodeStringBlock(in []byte, out *[]byte, dstOffset *[]uint32, ctx *CoderCo
 		return *out, *dstOffset, nil
 	}
 
+	if IsStringDictBlock(in) {
+		return decodeStringDictBlock(in, out, dstOffset, ctx)
+	}
+
 	var err error
 	if ctx.stringCoder == nil {
 		ctx.stringCoder = GetStringCoder()
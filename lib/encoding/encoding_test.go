@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/openGemini/openGemini/lib/compress"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/stretchr/testify/assert"
@@ -786,6 +787,42 @@ func TestEncodeIncompressibleInteger(t *testing.T) {
 	}
 }
 
+func TestEncoding_IntBlock_Adaptive(t *testing.T) {
+	config.GetStoreConfig().IntegerCompressAlgorithm = compress.CompressAlgorithmAdaptive
+	compress.Init()
+	defer func() {
+		config.GetStoreConfig().IntegerCompressAlgorithm = ""
+		compress.Init()
+	}()
+
+	var counter, narrow, wide []int64
+	for i := 0; i < 3000; i++ {
+		counter = append(counter, int64(i*1000+i%7))
+		narrow = append(narrow, int64(1000000+(i*7919)%100))
+		wide = append(wide, int64(i%2)<<62-int64(i))
+	}
+	inValues := [][]int64{
+		{1, 2, 4},
+		{10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+		counter,
+		narrow,
+		wide,
+	}
+
+	for _, values := range inValues {
+		in := util.Int64Slice2byte(append([]int64{}, values...))
+		out, err := EncodeIntegerBlock(in, nil, decs)
+		require.NoError(t, err)
+
+		prefix := util.Int64Slice2byte([]int64{11, 22})
+		decOut := append([]byte{}, prefix...)
+		got, err := DecodeIntegerBlock(out, &decOut, decs)
+		require.NoError(t, err)
+		require.Equal(t, []int64{11, 22}, got[:2])
+		require.Equal(t, values, got[2:])
+	}
+}
+
 func TestEncodeTimestampBlock(t *testing.T) {
 	valueCount := 1000
 	tmTest := func(precision time.Duration, randTm bool, prefix []byte) {
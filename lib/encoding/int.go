@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/klauspost/compress/zstd"
+	"github.com/openGemini/openGemini/lib/compress"
 	"github.com/openGemini/openGemini/lib/numberenc"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/encoding/simple8b"
@@ -29,6 +30,8 @@ const (
 	intCompressedSimple8b   = 2
 	intCompressZSTD         = 3
 	intUncompressed         = 4
+	intCompressFOR          = 5
+	intCompressDeltaOfDelta = 6
 )
 
 // ZigZagEncode ZigZag encoding maps signed integers to unsigned integers from: https://developers.google.com/protocol-buffers/docs/encoding
@@ -53,6 +56,11 @@ type Integer struct {
 	outPos       int
 	out          []byte
 	zigZagDeltas []uint64
+
+	// buffers of the adaptive encoding with sampler
+	sample       []int64
+	sampleDeltas []uint64
+	sampleBuf    []byte
 }
 
 func (enc *Integer) reset() {
@@ -63,7 +71,8 @@ func (enc *Integer) reset() {
 
 func (enc *Integer) validEncodingType() bool {
 	switch enc.encodingType {
-	case intCompressedConstDelta, intCompressZSTD, intCompressedSimple8b, intUncompressed:
+	case intCompressedConstDelta, intCompressZSTD, intCompressedSimple8b, intUncompressed,
+		intCompressFOR, intCompressDeltaOfDelta:
 		return true
 	default:
 		return false
@@ -143,9 +152,21 @@ func (enc *Integer) encodingZSTD(in, out []byte) ([]byte, error) {
 	out = numberenc.MarshalUint32Append(out, 0)               // compressed data len
 	encPos := len(out)
 
-	var err error
 	enc.buf.Reset(out[encPos:])
+	encData := enc.zstdEncoder().EncodeAll(in, out[encPos:])
+	compLen := len(encData) + encPos
+	if compressionRation(compLen, len(in)) > minCompReta {
+		return enc.uncompressedData(in, out[:pos])
+	}
+
+	l := uint32(len(encData))
+	numberenc.MarshalUint32Copy(out[pos+5:], l)
+	return out[:compLen], nil
+}
+
+func (enc *Integer) zstdEncoder() *zstd.Encoder {
 	if enc.zstdEnc == nil {
+		var err error
 		enc.zstdEnc, err = zstd.NewWriter(enc.buf,
 			zstd.WithEncoderCRC(false),
 			zstd.WithEncoderLevel(zstd.SpeedFastest))
@@ -153,16 +174,63 @@ func (enc *Integer) encodingZSTD(in, out []byte) ([]byte, error) {
 			panic(err)
 		}
 	}
+	return enc.zstdEnc
+}
 
-	encData := enc.zstdEnc.EncodeAll(in, out[encPos:])
-	compLen := len(encData) + encPos
-	if compressionRation(compLen, len(in)) > minCompReta {
+// encodingAdaptive encodes the values by the codec producing the smallest output for the sample of the segment
+func (enc *Integer) encodingAdaptive(in []byte, arr []int64, out []byte) ([]byte, error) {
+	enc.encodingType = enc.chooseEncodingType(arr)
+	pos := len(out)
+	switch enc.encodingType {
+	case intCompressedSimple8b:
+		return enc.encodingSimple8b(out)
+	case intCompressFOR:
+		out = append(out, byte(enc.encodingType)<<4)
+		out = compress.IntegerFOREncoding(arr, out)
+	case intCompressDeltaOfDelta:
+		out = append(out, byte(enc.encodingType)<<4)
+		out = compress.IntegerDeltaOfDeltaEncoding(arr, out)
+	default:
+		return enc.encodingZSTD(in, out)
+	}
+
+	if len(out)-pos > len(in)+5 {
 		return enc.uncompressedData(in, out[:pos])
 	}
+	return out, nil
+}
 
-	l := uint32(len(encData))
-	numberenc.MarshalUint32Copy(out[pos+5:], l)
-	return out[:compLen], nil
+func (enc *Integer) chooseEncodingType(arr []int64) int {
+	sample := compress.Sample(arr, enc.sample[:0])
+	if len(sample) < len(arr) {
+		enc.sample = sample
+	}
+
+	best := intCompressZSTD
+	enc.sampleBuf = enc.zstdEncoder().EncodeAll(util.Int64Slice2byte(sample), enc.sampleBuf[:0])
+	bestSize := len(enc.sampleBuf)
+	try := func(ty int, size int) {
+		if size < bestSize {
+			best, bestSize = ty, size
+		}
+	}
+
+	if enc.isSimple8b {
+		enc.sampleDeltas = enc.sampleDeltas[:0]
+		for i := 1; i < len(sample); i++ {
+			enc.sampleDeltas = append(enc.sampleDeltas, ZigZagEncode(sample[i]-sample[i-1]))
+		}
+		// the windows of the sample are joined by a large delta sometimes
+		if encData, err := simple8b.EncodeAll(enc.sampleDeltas); err == nil {
+			try(intCompressedSimple8b, 9+8*(len(encData)+1))
+		}
+	}
+
+	enc.sampleBuf = compress.IntegerFOREncoding(sample, enc.sampleBuf[:0])
+	try(intCompressFOR, len(enc.sampleBuf))
+	enc.sampleBuf = compress.IntegerDeltaOfDeltaEncoding(sample, enc.sampleBuf[:0])
+	try(intCompressDeltaOfDelta, len(enc.sampleBuf))
+	return best
 }
 
 func (enc *Integer) uncompressedData(in []byte, out []byte) ([]byte, error) {
@@ -192,6 +260,8 @@ func (enc *Integer) Encoding(in []byte, out []byte) ([]byte, error) {
 	if enc.isConstDelta {
 		enc.encodingType = intCompressedConstDelta
 		out, err = enc.encodingConstDelta(out)
+	} else if len(enc.zigZagDeltas) >= 2 && compress.IsEnableAdaptiveInteger() {
+		out, err = enc.encodingAdaptive(in, intArr, out)
 	} else if enc.isSimple8b {
 		enc.encodingType = intCompressedSimple8b
 		out, err = enc.encodingSimple8b(out)
@@ -344,7 +414,7 @@ func (enc *Integer) decodeInit(in, out []byte) error {
 		enc.outPos = len(out)
 		if cap(out[enc.outPos:]) < srcLen {
 			n := srcLen - cap(out[enc.outPos:])
-			out = out[:cap(out[enc.outPos:])]
+			out = out[:cap(out)]
 			out = append(out, make([]byte, n)...)
 		}
 		out = out[:enc.outPos]
@@ -372,13 +442,18 @@ func (enc *Integer) Decoding(in []byte, out []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	if enc.encodingType == intUncompressed {
+	switch enc.encodingType {
+	case intUncompressed:
 		return enc.decodingUncompressed()
-	} else if enc.encodingType == intCompressedConstDelta {
+	case intCompressedConstDelta:
 		return enc.decodingConstDelta()
-	} else if enc.encodingType == intCompressedSimple8b {
+	case intCompressedSimple8b:
 		return enc.decodingSimple8b()
-	} else {
+	case intCompressFOR:
+		return compress.IntegerFORDecoding(enc.buf.Bytes(), enc.out)
+	case intCompressDeltaOfDelta:
+		return compress.IntegerDeltaOfDeltaDecoding(enc.buf.Bytes(), enc.out)
+	default:
 		return enc.decodingZSTD()
 	}
 }
@@ -0,0 +1,239 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/numberenc"
+)
+
+const (
+	// stringDictionary is stored in the high 4 bits of the first byte, the same as the compression type of String
+	stringDictionary = 4
+
+	// MaxStringDictSize is the max number of distinct values of a dictionary encoded block,
+	// so that the index of each value fits in one byte
+	MaxStringDictSize = 256
+
+	// a value must repeat at least minStringDictRepeat times on average to use dictionary encoding
+	minStringDictRepeat = 4
+)
+
+/*
+StringDict is the dictionary of a string block with low cardinality, a dictionary encoded block is:
+
+	| stringDictionary<<4 | length of dictionary block | dictionary block | count of values | index_1 | ... | index_n |
+
+the dictionary block is a string block of the distinct values, encoded by EncodeStringBlock
+*/
+type StringDict struct {
+	Values  []byte
+	Offsets []uint32
+
+	ids   []byte
+	index map[string]uint8
+	block []byte // the dictionary block decoded by DecodeStringDict
+}
+
+func (d *StringDict) Reset() {
+	d.Values = d.Values[:0]
+	d.Offsets = d.Offsets[:0]
+	d.ids = d.ids[:0]
+	d.block = d.block[:0]
+	for k := range d.index {
+		delete(d.index, k)
+	}
+}
+
+func (d *StringDict) Len() int {
+	return len(d.Offsets)
+}
+
+func (d *StringDict) Get(i int) []byte {
+	if i == len(d.Offsets)-1 {
+		return d.Values[d.Offsets[i]:]
+	}
+	return d.Values[d.Offsets[i]:d.Offsets[i+1]]
+}
+
+func (d *StringDict) Contains(v []byte) bool {
+	for i := 0; i < d.Len(); i++ {
+		if bytes.Equal(d.Get(i), v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Build builds the dictionary of the strings, returns false if the cardinality is too high for dictionary encoding
+func (d *StringDict) Build(in []byte, offset []uint32) bool {
+	d.Reset()
+	maxSize := len(offset) / minStringDictRepeat
+	if maxSize > MaxStringDictSize {
+		maxSize = MaxStringDictSize
+	}
+	if maxSize == 0 {
+		return false
+	}
+	if d.index == nil {
+		d.index = make(map[string]uint8, maxSize)
+	}
+
+	last := len(offset) - 1
+	for i := range offset {
+		var v []byte
+		if i == last {
+			v = in[offset[i]:]
+		} else {
+			v = in[offset[i]:offset[i+1]]
+		}
+
+		id, ok := d.index[string(v)]
+		if !ok {
+			if len(d.index) == maxSize {
+				return false
+			}
+			id = uint8(len(d.index))
+			d.index[string(v)] = id
+			d.Offsets = append(d.Offsets, uint32(len(d.Values)))
+			d.Values = append(d.Values, v...)
+		}
+		d.ids = append(d.ids, id)
+	}
+	return true
+}
+
+func (ctx *CoderContext) getStringDict() *StringDict {
+	if ctx.stringDict == nil {
+		ctx.stringDict = &StringDict{}
+	}
+	return ctx.stringDict
+}
+
+// EncodeStringDictBlock encodes the strings with a dictionary if their cardinality is low enough,
+// otherwise nothing is appended to out and false is returned
+func EncodeStringDictBlock(in []byte, offset []uint32, out []byte, ctx *CoderContext) ([]byte, bool, error) {
+	dict := ctx.getStringDict()
+	if !dict.Build(in, offset) {
+		return out, false, nil
+	}
+
+	start := len(out)
+	out = append(out, byte(stringDictionary<<4))
+	out = numberenc.MarshalUint32Append(out, 0) // preset dictionary block length
+	pos := len(out)
+	out, err := EncodeStringBlock(dict.Values, dict.Offsets, out, ctx)
+	if err != nil {
+		return out[:start], false, err
+	}
+	numberenc.MarshalUint32Copy(out[pos-4:], uint32(len(out)-pos))
+
+	out = numberenc.MarshalUint32Append(out, uint32(len(dict.ids)))
+	out = append(out, dict.ids...)
+	return out, true, nil
+}
+
+func IsStringDictBlock(in []byte) bool {
+	return len(in) > 0 && in[0]>>4 == stringDictionary
+}
+
+func splitStringDictBlock(in []byte) ([]byte, []byte, error) {
+	if len(in) < 5 {
+		return nil, nil, fmt.Errorf("too small data for string dictionary, %v", len(in))
+	}
+	in = in[1:]
+	dictLen := int(numberenc.UnmarshalUint32(in))
+	in = in[4:]
+	if len(in) < dictLen+4 {
+		return nil, nil, fmt.Errorf("too small data for string dictionary, %v < %v", len(in), dictLen+4)
+	}
+	dictBlock, in := in[:dictLen], in[dictLen:]
+
+	n := int(numberenc.UnmarshalUint32(in))
+	in = in[4:]
+	if len(in) < n {
+		return nil, nil, fmt.Errorf("too small data for string dictionary index, %v < %v", len(in), n)
+	}
+	return dictBlock, in[:n], nil
+}
+
+// DecodeStringDict decodes only the dictionary of a dictionary encoded block,
+// the values of the block can be filtered by the dictionary without being decoded.
+// The dictionary is kept by ctx until ResetStringDicts, so that decoding the same block again reuses it
+func DecodeStringDict(in []byte, dict *StringDict, ctx *CoderContext) error {
+	dictBlock, _, err := splitStringDictBlock(in)
+	if err != nil {
+		return err
+	}
+
+	dict.Reset()
+	dict.Values, dict.Offsets, err = DecodeStringBlock(dictBlock, &dict.Values, &dict.Offsets, ctx)
+	if err != nil {
+		return err
+	}
+	dict.block = append(dict.block, dictBlock...)
+	ctx.decodedDicts = append(ctx.decodedDicts, dict)
+	return nil
+}
+
+// ResetStringDicts forgets the dictionaries decoded by DecodeStringDict
+func (ctx *CoderContext) ResetStringDicts() {
+	for i := range ctx.decodedDicts {
+		ctx.decodedDicts[i] = nil
+	}
+	ctx.decodedDicts = ctx.decodedDicts[:0]
+}
+
+func (ctx *CoderContext) decodedStringDict(dictBlock []byte) *StringDict {
+	for _, dict := range ctx.decodedDicts {
+		if bytes.Equal(dict.block, dictBlock) {
+			return dict
+		}
+	}
+	return nil
+}
+
+func decodeStringDictBlock(in []byte, out *[]byte, dstOffset *[]uint32, ctx *CoderContext) ([]byte, []uint32, error) {
+	dictBlock, ids, err := splitStringDictBlock(in)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dict := ctx.decodedStringDict(dictBlock)
+	if dict == nil {
+		dict = ctx.getStringDict()
+		dict.Reset()
+		dict.Values, dict.Offsets, err = DecodeStringBlock(dictBlock, &dict.Values, &dict.Offsets, ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	offs := (*dstOffset)[:0]
+	values := *out
+	base := uint32(len(values))
+	for _, id := range ids {
+		if int(id) >= dict.Len() {
+			return nil, nil, fmt.Errorf("invalid string dictionary index %v, dictionary size %v", id, dict.Len())
+		}
+		offs = append(offs, uint32(len(values))-base)
+		values = append(values, dict.Get(int(id))...)
+	}
+
+	*out, *dstOffset = values, offs
+	return *out, *dstOffset, nil
+}
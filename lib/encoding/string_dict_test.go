@@ -0,0 +1,126 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func genStrings(n int, cardinality int) ([]byte, []uint32) {
+	var val []byte
+	offset := make([]uint32, 0, n)
+	for i := 0; i < n; i++ {
+		offset = append(offset, uint32(len(val)))
+		if i%10 == 0 {
+			// null value
+			continue
+		}
+		val = append(val, fmt.Sprintf("status_%d", i%cardinality)...)
+	}
+	return val, offset
+}
+
+func TestEncoding_StringDictBlock(t *testing.T) {
+	ctx := NewCoderContext()
+	in, offset := genStrings(1000, 3)
+
+	buf, ok, err := EncodeStringDictBlock(in, offset, nil, ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, IsStringDictBlock(buf))
+	require.Less(t, len(buf), len(in)/2)
+
+	var val []byte
+	var off []uint32
+	val, off, err = DecodeStringBlock(buf, &val, &off, ctx)
+	require.NoError(t, err)
+	require.Equal(t, in, val)
+	require.Equal(t, offset, off)
+
+	// decode the dictionary only
+	dict := &StringDict{}
+	require.NoError(t, DecodeStringDict(buf, dict, ctx))
+	require.Equal(t, 4, dict.Len())
+	require.True(t, dict.Contains([]byte("")))
+	require.True(t, dict.Contains([]byte("status_2")))
+	require.False(t, dict.Contains([]byte("status_3")))
+}
+
+func TestEncoding_StringDictBlock_HighCardinality(t *testing.T) {
+	ctx := NewCoderContext()
+	for _, n := range []int{0, 3, 100, 2000} {
+		in, offset := genStrings(n, n)
+		buf, ok, err := EncodeStringDictBlock(in, offset, []byte{1}, ctx)
+		require.NoError(t, err)
+		require.False(t, ok)
+		require.Equal(t, []byte{1}, buf)
+	}
+
+	// more distinct values than MaxStringDictSize
+	in, offset := genStrings(MaxStringDictSize*10, MaxStringDictSize+1)
+	_, ok, err := EncodeStringDictBlock(in, offset, nil, ctx)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestEncoding_StringDictBlock_Corrupt(t *testing.T) {
+	ctx := NewCoderContext()
+	in, offset := genStrings(100, 5)
+	buf, ok, err := EncodeStringDictBlock(in, offset, nil, ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	var val []byte
+	var off []uint32
+	for _, data := range [][]byte{buf[:3], buf[:20], buf[:len(buf)-1]} {
+		_, _, err = DecodeStringBlock(data, &val, &off, ctx)
+		require.Error(t, err)
+		require.Error(t, DecodeStringDict(data[:3], &StringDict{}, ctx))
+	}
+
+	// index out of the dictionary
+	buf[len(buf)-1] = 255
+	_, _, err = DecodeStringBlock(buf, &val, &off, ctx)
+	require.Error(t, err)
+}
+
+func TestEncoding_StringDictBlock_ReuseDecodedDict(t *testing.T) {
+	ctx := NewCoderContext()
+	in, offset := genStrings(100, 3)
+	buf, ok, err := EncodeStringDictBlock(in, offset, nil, ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	dict := &StringDict{}
+	require.NoError(t, DecodeStringDict(buf, dict, ctx))
+	// the values are decoded by the dictionary decoded above
+	dict.Values[len(dict.Values)-1] = 'x'
+
+	var val []byte
+	var off []uint32
+	val, _, err = DecodeStringBlock(buf, &val, &off, ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, in, val)
+
+	ctx.ResetStringDicts()
+	val, off = val[:0], off[:0]
+	val, off, err = DecodeStringBlock(buf, &val, &off, ctx)
+	require.NoError(t, err)
+	require.Equal(t, in, val)
+	require.Equal(t, offset, off)
+}
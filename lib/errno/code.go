@@ -109,6 +109,7 @@ const (
 	ChunkReaderCursor            = 1127
 	ApplyFuncErr                 = 1128
 	QueryAborted                 = 1129
+	QueryMemoryLimitExceeded     = 1130
 )
 
 // promql2influxql
@@ -297,6 +298,9 @@ const (
 	SqlNodeNotFound                    = 4056
 	PtIsDoingSomeShardMove             = 4057
 	MetaNodeNotFound                   = 4058
+	ReplicaOnSameNode                  = 4059
+	ReplicaInSameAz                    = 4060
+	AvailabilityZoneRequired           = 4061
 )
 
 // meta-client process
@@ -452,4 +456,8 @@ const (
 	ExceedRetryChance        = 8035
 	UnknownErr               = 8036
 	InvalidHaPolicy          = 8037
+	InvalidFuncArg           = 8038
+	InvalidCastorProtocol    = 8039
+	InvalidHealthCheck       = 8040
+	NoAvailableWorker        = 8041
 )
@@ -180,6 +180,7 @@ var messageMap = map[Errno]*Message{
 	InvalidQueryStat:             newWarnMessage("invalid query stat", ModuleQueryEngine),
 	ErrQueryNotFound:             newWarnMessage("no such query id: %d", ModuleQueryEngine),
 	ErrQueryKilled:               newWarnMessage("query(%d) killed", ModuleQueryEngine),
+	QueryMemoryLimitExceeded:     newWarnMessage("query result of %d bytes exceeds the memory limit of %d bytes, use chunked query to stream the result", ModuleQueryEngine),
 	ErrSameTagSet:                newFatalMessage("vector cannot contain metrics with the same labelset", ModuleQueryEngine),
 
 	// store engine error codes
@@ -276,32 +277,35 @@ var messageMap = map[Errno]*Message{
 	JsonPathIllegal:         newWarnMessage("json path format is incorrect", ModuleQueryInterface),
 
 	// meta error codes
-	InvalidTagKey:           newWarnMessage(`tag key can't be time, measurement is '%s'`, ModuleMeta),
-	ConflictWithRep:         newWarnMessage("current feature conflicts with replication", ModuleMeta),
-	ReplicaNumberNotEqual:   newWarnMessage("replication number of retention policy is not equal to database", ModuleMeta),
-	ReplicaNumberNotSupport: newWarnMessage("replication number is not odd", ModuleMeta),
-	ReplicaNodeNumIncorrect: newWarnMessage("node num %d is not an integer multiple of replicaN %d", ModuleMeta),
-	FieldTypeConflict:       newWarnMessage(`field type conflict: input field "%s" on measurement "%s" is type %s, already exists as type %s`, ModuleMeta),
-	DatabaseNotFound:        newWarnMessage("database not found: %s", ModuleMeta),
-	DataNodeNotFound:        newWarnMessage("dataNode(id=%d,host=%s) not found", ModuleMeta),
-	SqlNodeNotFound:         newWarnMessage("sqlNode(id=%d,host=%s) not found", ModuleMeta),
-	DataNoAlive:             newWarnMessage("dataNode(id=%d,host=%s) is not alive", ModuleMeta),
-	MetaNodeNotFound:        newWarnMessage("metaNode(id=%d,host=%s) not found", ModuleMeta),
-	ShardMetaNotFound:       newWarnMessage("shard(id=%d) meta not found", ModuleMeta),
-	DataIsOlder:             newWarnMessage("current data is older than remote", ModuleMeta),
-	DatabaseIsBeingDelete:   newWarnMessage("database(%s) is being delete", ModuleMeta),
-	MetaIsNotLeader:         newWarnMessage("node is not the leader", ModuleMeta),
-	RaftIsNotOpen:           newWarnMessage("raft is not open", ModuleMeta),
-	ShardKeyConflict:        newWarnMessage("shard key conflict", ModuleMeta),
-	ErrMeasurementNotFound:  newWarnMessage("measurement not found", ModuleMeta),
-	PtNotFound:              newWarnMessage("pt not found", ModuleMeta),
-	StreamHasExist:          newWarnMessage("stream has been existed", ModuleMeta),
-	StreamNotFound:          newWarnMessage("stream not found", ModuleMeta),
-	DataNodeSplitBrain:      newWarnMessage("data node split brain", ModuleMeta),
-	OlderEvent:              newWarnMessage("older event", ModuleMeta),
-	RpIsBeingDelete:         newWarnMessage("retention policy is being delete", ModuleMeta),
-	ShardIsBeingDelete:      newWarnMessage("shard is being delete", ModuleMeta),
-	MstIsBeingDelete:        newWarnMessage("measurement is being delete", ModuleMeta),
+	InvalidTagKey:            newWarnMessage(`tag key can't be time, measurement is '%s'`, ModuleMeta),
+	ConflictWithRep:          newWarnMessage("current feature conflicts with replication", ModuleMeta),
+	ReplicaNumberNotEqual:    newWarnMessage("replication number of retention policy is not equal to database", ModuleMeta),
+	ReplicaNumberNotSupport:  newWarnMessage("replication number is not odd", ModuleMeta),
+	ReplicaNodeNumIncorrect:  newWarnMessage("node num %d is not an integer multiple of replicaN %d", ModuleMeta),
+	FieldTypeConflict:        newWarnMessage(`field type conflict: input field "%s" on measurement "%s" is type %s, already exists as type %s`, ModuleMeta),
+	DatabaseNotFound:         newWarnMessage("database not found: %s", ModuleMeta),
+	DataNodeNotFound:         newWarnMessage("dataNode(id=%d,host=%s) not found", ModuleMeta),
+	SqlNodeNotFound:          newWarnMessage("sqlNode(id=%d,host=%s) not found", ModuleMeta),
+	DataNoAlive:              newWarnMessage("dataNode(id=%d,host=%s) is not alive", ModuleMeta),
+	MetaNodeNotFound:         newWarnMessage("metaNode(id=%d,host=%s) not found", ModuleMeta),
+	ReplicaOnSameNode:        newWarnMessage("pt %s$%d can not be placed on node %d which holds pt %d of the same replica group %d", ModuleMeta),
+	ReplicaInSameAz:          newWarnMessage("pt %s$%d can not be placed on node %d, availability zone %q holds pt %d of the same replica group %d", ModuleMeta),
+	AvailabilityZoneRequired: newWarnMessage("data node %s has no availability-zone, which is required by rep-dis-policy = 1", ModuleMeta),
+	ShardMetaNotFound:        newWarnMessage("shard(id=%d) meta not found", ModuleMeta),
+	DataIsOlder:              newWarnMessage("current data is older than remote", ModuleMeta),
+	DatabaseIsBeingDelete:    newWarnMessage("database(%s) is being delete", ModuleMeta),
+	MetaIsNotLeader:          newWarnMessage("node is not the leader", ModuleMeta),
+	RaftIsNotOpen:            newWarnMessage("raft is not open", ModuleMeta),
+	ShardKeyConflict:         newWarnMessage("shard key conflict", ModuleMeta),
+	ErrMeasurementNotFound:   newWarnMessage("measurement not found", ModuleMeta),
+	PtNotFound:               newWarnMessage("pt not found", ModuleMeta),
+	StreamHasExist:           newWarnMessage("stream has been existed", ModuleMeta),
+	StreamNotFound:           newWarnMessage("stream not found", ModuleMeta),
+	DataNodeSplitBrain:       newWarnMessage("data node split brain", ModuleMeta),
+	OlderEvent:               newWarnMessage("older event", ModuleMeta),
+	RpIsBeingDelete:          newWarnMessage("retention policy is being delete", ModuleMeta),
+	ShardIsBeingDelete:       newWarnMessage("shard is being delete", ModuleMeta),
+	MstIsBeingDelete:         newWarnMessage("measurement is being delete", ModuleMeta),
 
 	NeedChangeStore:            newWarnMessage("need change store", ModuleHA),
 	StateMachineIsNotRunning:   newWarnMessage("state machine is not running", ModuleHA),
@@ -418,6 +422,10 @@ var messageMap = map[Errno]*Message{
 	ExceedRetryChance:        newNoticeMessage("exceed retry chance", ModuleCastor),
 	InvalidHaPolicy:          newNoticeMessage("HaPolicy should in (write-available-first, shared-storage, replication)", ModuleCastor),
 	UnknownErr:               newNoticeMessage("unknown error", ModuleCastor),
+	InvalidFuncArg:           newNoticeMessage("invalid %s argument of %s, %s", ModuleCastor),
+	InvalidCastorProtocol:    newNoticeMessage("protocol should be ipc or flight, got:%s", ModuleCastor),
+	InvalidHealthCheck:       newNoticeMessage("health-check-interval and health-check-timeout must > 0", ModuleCastor),
+	NoAvailableWorker:        newWarnMessage("no healthy worker serves algorithm %s", ModuleCastor),
 
 	// promql2influxql
 	UnsupportedPromExpr:    newNoticeMessage("unsupported promql expression", ModuleQueryEngine),
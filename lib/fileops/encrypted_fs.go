@@ -0,0 +1,699 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileops
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/openGemini/openGemini/lib/crypto"
+	"github.com/openGemini/openGemini/lib/request"
+	"github.com/openGemini/openGemini/lib/util"
+)
+
+/*
+Encrypted files are the plaintext split into blocks of encryptionBlockSize bytes, every block is sealed by
+AES-256-GCM with a random nonce and the block number as the additional data:
+
+	| magic(8) | key id length(1) | key id(MaxKeyIDLen) | salt(16) | padding | block 0 | block 1 | ... |
+	block: | nonce(12) | ciphertext(<= encryptionBlockSize) | tag(16) |
+
+the key of a file is derived from the key of the database and the random salt of the file, so the number of nonces
+drawn under one key stays small. Every write of a block draws a new nonce, so the blocks can be overwritten in place
+and the tag of every block detects the modified data. Only the last block of a file can be shorter, so the files can
+still be read at any offset. The header is hidden from the readers and writers.
+Files written before the encryption is enabled are still read as plaintext
+*/
+const (
+	encryptionHeaderSize = 64
+	encryptionMagicSize  = 8
+	encryptionSaltPos    = encryptionMagicSize + 1 + crypto.MaxKeyIDLen
+	encryptionSaltSize   = 16
+
+	encryptionBlockSize     = 4096
+	encryptionNonceSize     = 12
+	encryptionBlockOverhead = encryptionNonceSize + 16
+	encryptionSealedSize    = encryptionBlockSize + encryptionBlockOverhead
+)
+
+var encryptionMagic = []byte("OGCRYPT2")
+
+// encryptedFileSuffixes are the files to encrypt: TSSP files, WAL segments, raft logs and mergeset index parts
+var encryptedFileSuffixes = []string{".tssp", ".tssp.init", ".wal", ".entry", "items.bin", "lens.bin", "index.bin", "metaindex.bin"}
+
+var encryptionBlockPool = sync.Pool{New: func() any {
+	buf := make([]byte, encryptionSealedSize)
+	return &buf
+}}
+
+var encryption struct {
+	enabled  atomic.Bool
+	mu       sync.RWMutex
+	provider crypto.KeyProvider
+	roots    []string
+}
+
+var encryptedLocalFS VFS = encryptedFS{VFS: localFS}
+
+// EnableEncryption encrypts the data files created under the root directories with the keys from the provider,
+// the first directory under a root is the database name used to choose the key
+func EnableEncryption(provider crypto.KeyProvider, roots ...string) {
+	encryption.mu.Lock()
+	defer encryption.mu.Unlock()
+	encryption.provider = provider
+	encryption.roots = encryption.roots[:0]
+	for _, root := range roots {
+		encryption.roots = append(encryption.roots, filepath.Clean(root))
+	}
+	encryption.enabled.Store(provider != nil)
+}
+
+func DisableEncryption() {
+	EnableEncryption(nil)
+}
+
+func EncryptionEnabled() bool {
+	return encryption.enabled.Load()
+}
+
+// encryptionDatabase returns the database of the file and whether the file should be encrypted
+func encryptionDatabase(name string) (string, bool) {
+	encryption.mu.RLock()
+	defer encryption.mu.RUnlock()
+	if encryption.provider == nil {
+		return "", false
+	}
+
+	name = filepath.Clean(name)
+	matched := false
+	for _, suffix := range encryptedFileSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return "", false
+	}
+
+	for _, root := range encryption.roots {
+		rel, err := filepath.Rel(root, name)
+		if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+			continue
+		}
+		db, _, found := strings.Cut(rel, string(filepath.Separator))
+		if found {
+			return db, true
+		}
+	}
+	return "", false
+}
+
+func encryptionProvider() crypto.KeyProvider {
+	encryption.mu.RLock()
+	defer encryption.mu.RUnlock()
+	return encryption.provider
+}
+
+// IsEncryptedFile returns true if the file is encrypted, the content of an encrypted file can not be mapped to memory
+func IsEncryptedFile(f File) bool {
+	_, ok := f.(*encryptedFile)
+	return ok
+}
+
+type encryptionHeader struct {
+	keyID string
+	salt  [encryptionSaltSize]byte
+}
+
+func (h *encryptionHeader) marshal() []byte {
+	buf := make([]byte, encryptionHeaderSize)
+	copy(buf, encryptionMagic)
+	buf[encryptionMagicSize] = uint8(len(h.keyID))
+	copy(buf[encryptionMagicSize+1:], h.keyID)
+	copy(buf[encryptionSaltPos:], h.salt[:])
+	return buf
+}
+
+func (h *encryptionHeader) unmarshal(buf []byte) bool {
+	if len(buf) < encryptionHeaderSize || !bytes.Equal(buf[:encryptionMagicSize], encryptionMagic) {
+		return false
+	}
+	n := int(buf[encryptionMagicSize])
+	if n > crypto.MaxKeyIDLen {
+		return false
+	}
+	h.keyID = string(buf[encryptionMagicSize+1 : encryptionMagicSize+1+n])
+	copy(h.salt[:], buf[encryptionSaltPos:])
+	return true
+}
+
+// readEncryptionHeader reads the header of the file, returns nil if the file is not encrypted
+func readEncryptionHeader(r io.ReaderAt) (*encryptionHeader, error) {
+	buf := make([]byte, encryptionHeaderSize)
+	n, err := r.ReadAt(buf, 0)
+	if n < encryptionHeaderSize {
+		if err == nil || err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	h := &encryptionHeader{}
+	if !h.unmarshal(buf) {
+		return nil, nil
+	}
+	return h, nil
+}
+
+func newEncryptionHeader(db string) (*encryptionHeader, cipher.AEAD, error) {
+	id, key, err := encryptionProvider().CurrentKey(db)
+	if err != nil {
+		return nil, nil, err
+	}
+	h := &encryptionHeader{keyID: id}
+	if _, err = rand.Read(h.salt[:]); err != nil {
+		return nil, nil, err
+	}
+	aead, err := h.newAEAD(key)
+	return h, aead, err
+}
+
+func (h *encryptionHeader) cipher() (cipher.AEAD, error) {
+	provider := encryptionProvider()
+	if provider == nil {
+		return nil, errors.New("the file is encrypted but the encryption is not enabled")
+	}
+	key, err := provider.Key(h.keyID)
+	if err != nil {
+		return nil, err
+	}
+	return h.newAEAD(key)
+}
+
+// newAEAD derives the key of the file from the key of the database and the salt of the file
+func (h *encryptionHeader) newAEAD(key []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(h.salt[:])
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptedBlockOffset returns the offset of the sealed block in the file
+func encryptedBlockOffset(idx int64) int64 {
+	return encryptionHeaderSize + idx*encryptionSealedSize
+}
+
+// encryptedPlainSize returns the size of the plaintext of an encrypted file of the size
+func encryptedPlainSize(size int64) int64 {
+	size -= encryptionHeaderSize
+	if size <= 0 {
+		return 0
+	}
+	n := size / encryptionSealedSize * encryptionBlockSize
+	if rem := size % encryptionSealedSize; rem > encryptionBlockOverhead {
+		n += rem - encryptionBlockOverhead
+	}
+	return n
+}
+
+// encryptedFile encrypts the data written to the file and decrypts the data read from the file
+type encryptedFile struct {
+	File
+
+	// guards pos and the blocks being sealed, a block is never read while it is written
+	mu       sync.RWMutex
+	aead     cipher.AEAD
+	pos      int64
+	size     int64
+	appendTo bool
+	buf      []byte
+	plain    []byte
+
+	// the plaintext of the last block if it is shorter than encryptionBlockSize,
+	// so that the small appends do not read the last block back
+	tailIdx int64
+	tail    []byte
+}
+
+func newEncryptedFile(f File, aead cipher.AEAD, flag int) (*encryptedFile, error) {
+	ef := &encryptedFile{File: f, aead: aead, appendTo: flag&os.O_APPEND != 0, tailIdx: -1}
+	size, err := f.Size()
+	if err != nil {
+		return nil, err
+	}
+	ef.size = encryptedPlainSize(size)
+	return ef, nil
+}
+
+// openBlock reads and decrypts the block, the returned plaintext is shorter than
+// encryptionBlockSize only for the last block, it is empty if the block does not exist
+func (f *encryptedFile) openBlock(idx int64, buf []byte, dst []byte) ([]byte, error) {
+	n, err := f.File.ReadAt(buf[:encryptionSealedSize], encryptedBlockOffset(idx))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if n <= encryptionBlockOverhead {
+		return dst[:0], nil
+	}
+
+	var ad [8]byte
+	binary.BigEndian.PutUint64(ad[:], uint64(idx))
+	sealed := buf[:n]
+	dst, err = f.aead.Open(dst[:0], sealed[:encryptionNonceSize], sealed[encryptionNonceSize:], ad[:])
+	if err != nil {
+		return nil, fmt.Errorf("decrypt block %d of %s failed: %v", idx, f.Name(), err)
+	}
+	return dst, nil
+}
+
+// sealBlock encrypts the plaintext of the block by a new nonce and writes it to the file
+func (f *encryptedFile) sealBlock(idx int64, plain []byte) error {
+	if cap(f.buf) < encryptionSealedSize {
+		f.buf = make([]byte, 0, encryptionSealedSize)
+	}
+	f.buf = f.buf[:encryptionNonceSize]
+	if _, err := rand.Read(f.buf); err != nil {
+		return err
+	}
+	var ad [8]byte
+	binary.BigEndian.PutUint64(ad[:], uint64(idx))
+	f.buf = f.aead.Seal(f.buf, f.buf[:encryptionNonceSize], plain, ad[:])
+
+	if _, err := f.File.Seek(encryptedBlockOffset(idx), io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := f.File.Write(f.buf); err != nil {
+		return err
+	}
+
+	end := idx*encryptionBlockSize + int64(len(plain))
+	if end >= f.size {
+		f.size = end
+		if len(plain) < encryptionBlockSize {
+			f.tailIdx, f.tail = idx, append(f.tail[:0], plain...)
+			return nil
+		}
+	}
+	if f.tailIdx == idx {
+		f.tailIdx = -1
+	}
+	return nil
+}
+
+func (f *encryptedFile) readAt(b []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("invalid offset %d", off)
+	}
+	bufp := encryptionBlockPool.Get().(*[]byte)
+	defer encryptionBlockPool.Put(bufp)
+	var plain [encryptionBlockSize]byte
+
+	n := 0
+	for n < len(b) {
+		idx := off / encryptionBlockSize
+		data, err := f.openBlock(idx, *bufp, plain[:0])
+		if err != nil {
+			return n, err
+		}
+		start := int(off % encryptionBlockSize)
+		if start >= len(data) {
+			return n, io.EOF
+		}
+		m := copy(b[n:], data[start:])
+		n += m
+		off += int64(m)
+	}
+	return n, nil
+}
+
+func (f *encryptedFile) Read(b []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n, err := f.readAt(b, f.pos)
+	f.pos += int64(n)
+	if n > 0 && err == io.EOF {
+		err = nil
+	}
+	return n, err
+}
+
+func (f *encryptedFile) ReadAt(b []byte, off int64) (int, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.readAt(b, off)
+}
+
+func (f *encryptedFile) Write(b []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.appendTo {
+		f.pos = f.size
+	}
+	if f.pos > f.size {
+		// fill the hole with zeros
+		if err := f.writeAt(make([]byte, f.pos-f.size), f.size); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := f.writeAt(b, f.pos); err != nil {
+		return 0, err
+	}
+	f.pos += int64(len(b))
+	return len(b), nil
+}
+
+// writeAt writes the data at the offset, the offset must not be beyond the end of the file
+func (f *encryptedFile) writeAt(b []byte, off int64) error {
+	for len(b) > 0 {
+		idx := off / encryptionBlockSize
+		start := int(off % encryptionBlockSize)
+		n := encryptionBlockSize - start
+		if n > len(b) {
+			n = len(b)
+		}
+
+		// read back the block unless it is overwritten entirely
+		blockEnd := idx*encryptionBlockSize + encryptionBlockSize
+		if blockEnd > f.size {
+			blockEnd = f.size
+		}
+		f.plain = f.plain[:0]
+		if start > 0 || off+int64(n) < blockEnd {
+			var err error
+			if f.plain, err = f.readBlock(idx); err != nil {
+				return err
+			}
+		}
+		if len(f.plain) < start+n {
+			f.plain = append(f.plain, make([]byte, start+n-len(f.plain))...)
+		}
+		copy(f.plain[start:], b[:n])
+
+		if err := f.sealBlock(idx, f.plain); err != nil {
+			return err
+		}
+		b = b[n:]
+		off += int64(n)
+	}
+	return nil
+}
+
+func (f *encryptedFile) readBlock(idx int64) ([]byte, error) {
+	if idx == f.tailIdx {
+		return append(f.plain[:0], f.tail...), nil
+	}
+	bufp := encryptionBlockPool.Get().(*[]byte)
+	defer encryptionBlockPool.Put(bufp)
+	return f.openBlock(idx, *bufp, f.plain[:0])
+}
+
+func (f *encryptedFile) Seek(offset int64, whence int) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.pos
+	case io.SeekEnd:
+		offset += f.size
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("invalid offset %d", offset)
+	}
+	f.pos = offset
+	return f.pos, nil
+}
+
+func (f *encryptedFile) Truncate(size int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if size >= f.size {
+		if size == f.size {
+			return nil
+		}
+		return f.writeAt(make([]byte, size-f.size), f.size)
+	}
+
+	idx, rem := size/encryptionBlockSize, int(size%encryptionBlockSize)
+	end := encryptedBlockOffset(idx)
+	if rem > 0 {
+		// the last block is sealed again by a new nonce
+		plain, err := f.readBlock(idx)
+		if err != nil {
+			return err
+		}
+		f.plain = plain
+		f.size = idx * encryptionBlockSize
+		if err = f.sealBlock(idx, f.plain[:rem]); err != nil {
+			return err
+		}
+		end += int64(rem) + encryptionBlockOverhead
+	}
+	if err := f.File.Truncate(end); err != nil {
+		return err
+	}
+	f.size = size
+	if f.tailIdx > idx || (f.tailIdx == idx && rem == 0) {
+		f.tailIdx = -1
+	}
+	return nil
+}
+
+func (f *encryptedFile) Stat() (os.FileInfo, error) {
+	fi, err := f.File.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedFileInfo{FileInfo: fi}, nil
+}
+
+func (f *encryptedFile) Size() (int64, error) {
+	size, err := f.File.Size()
+	return encryptedPlainSize(size), err
+}
+
+func (f *encryptedFile) StreamReadBatch(offs []int64, sizes []int64, minBlockSize int64, c chan *request.StreamReader, obsRangeSize int, isStat bool) {
+	for i, offset := range offs {
+		content := make([]byte, sizes[i])
+		_, err := f.ReadAt(content, offset)
+		c <- &request.StreamReader{
+			Offset:  offset,
+			Err:     err,
+			Content: content,
+		}
+		if err != nil {
+			break
+		}
+	}
+	close(c)
+}
+
+type encryptedFileInfo struct {
+	os.FileInfo
+}
+
+func (fi *encryptedFileInfo) Size() int64 {
+	return encryptedPlainSize(fi.FileInfo.Size())
+}
+
+// encryptedFS encrypts the files to encrypt and passes through the other files to the underlying file system
+type encryptedFS struct {
+	VFS
+}
+
+func (efs encryptedFS) openFile(name string, flag int, f File, opt ...FSOption) (File, error) {
+	db, ok := encryptionDatabase(name)
+	if !ok {
+		return f, nil
+	}
+
+	size, err := f.Size()
+	if err != nil {
+		util.MustClose(f)
+		return nil, err
+	}
+
+	var h *encryptionHeader
+	var aead cipher.AEAD
+	if size == 0 {
+		if flag&(os.O_WRONLY|os.O_RDWR) == 0 {
+			return f, nil
+		}
+		h, aead, err = newEncryptionHeader(db)
+		if err == nil {
+			_, err = f.Write(h.marshal())
+		}
+	} else {
+		h, err = efs.readHeader(name, flag, f)
+		if err == nil && h != nil {
+			aead, err = h.cipher()
+		}
+	}
+	if err == nil && h == nil {
+		// plaintext written before the encryption is enabled
+		return f, nil
+	}
+	if err == nil && flag&(os.O_WRONLY|os.O_APPEND) != 0 {
+		// a partially written block is read back and written in place
+		f, err = efs.reopen(name, f, opt...)
+	}
+	var ef *encryptedFile
+	if err == nil {
+		ef, err = newEncryptedFile(f, aead, flag)
+	}
+	if err != nil {
+		util.MustClose(f)
+		return nil, fmt.Errorf("open encrypted file %s failed: %v", name, err)
+	}
+	return ef, nil
+}
+
+// reopen opens the file for reading and writing at any offset, f is closed if the file is opened
+func (efs encryptedFS) reopen(name string, f File, opt ...FSOption) (File, error) {
+	rf, err := efs.VFS.OpenFile(name, os.O_RDWR, 0, opt...)
+	if err != nil {
+		return f, err
+	}
+	util.MustClose(f)
+	return rf, nil
+}
+
+func (efs encryptedFS) readHeader(name string, flag int, f File) (*encryptionHeader, error) {
+	if flag&os.O_WRONLY == 0 {
+		return readEncryptionHeader(f)
+	}
+	// the write only file can not be read
+	rf, err := efs.VFS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer util.MustClose(rf)
+	return readEncryptionHeader(rf)
+}
+
+func (efs encryptedFS) Open(name string, opt ...FSOption) (File, error) {
+	f, err := efs.VFS.Open(name, opt...)
+	if err != nil {
+		return f, err
+	}
+	return efs.openFile(name, os.O_RDONLY, f, opt...)
+}
+
+func (efs encryptedFS) OpenFile(name string, flag int, perm os.FileMode, opt ...FSOption) (File, error) {
+	f, err := efs.VFS.OpenFile(name, flag, perm, opt...)
+	if err != nil {
+		return f, err
+	}
+	return efs.openFile(name, flag, f, opt...)
+}
+
+func (efs encryptedFS) Create(name string, opt ...FSOption) (File, error) {
+	f, err := efs.VFS.Create(name, opt...)
+	if err != nil {
+		return f, err
+	}
+	return efs.openFile(name, os.O_RDWR, f, opt...)
+}
+
+func (efs encryptedFS) CreateV1(name string, opt ...FSOption) (File, error) {
+	return efs.Create(name, opt...)
+}
+
+func (efs encryptedFS) CreateV2(name string, opt ...FSOption) (File, error) {
+	return efs.Create(name, opt...)
+}
+
+func (efs encryptedFS) Stat(name string) (os.FileInfo, error) {
+	fi, err := efs.VFS.Stat(name)
+	if err != nil || fi.IsDir() {
+		return fi, err
+	}
+	if _, ok := encryptionDatabase(name); !ok {
+		return fi, nil
+	}
+
+	f, err := efs.VFS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer util.MustClose(f)
+	h, err := readEncryptionHeader(f)
+	if err != nil || h == nil {
+		return fi, err
+	}
+	return &encryptedFileInfo{FileInfo: fi}, nil
+}
+
+func (efs encryptedFS) WriteFile(filename string, data []byte, perm os.FileMode, opt ...FSOption) error {
+	if _, ok := encryptionDatabase(filename); !ok {
+		return efs.VFS.WriteFile(filename, data, perm, opt...)
+	}
+	f, err := efs.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm, opt...)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	return err
+}
+
+func (efs encryptedFS) ReadFile(filename string, opt ...FSOption) ([]byte, error) {
+	if _, ok := encryptionDatabase(filename); !ok {
+		return efs.VFS.ReadFile(filename, opt...)
+	}
+	f, err := efs.Open(filename, opt...)
+	if err != nil {
+		return nil, err
+	}
+	defer util.MustClose(f)
+	return io.ReadAll(f)
+}
+
+func (efs encryptedFS) Truncate(name string, size int64, opt ...FSOption) error {
+	if _, ok := encryptionDatabase(name); !ok {
+		return efs.VFS.Truncate(name, size, opt...)
+	}
+	f, err := efs.OpenFile(name, os.O_RDWR, 0, opt...)
+	if err != nil {
+		return err
+	}
+	err = f.Truncate(size)
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	return err
+}
@@ -0,0 +1,318 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileops
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockKeyProvider struct {
+	current map[string]string
+	keys    map[string][]byte
+}
+
+func newMockKeyProvider() *mockKeyProvider {
+	return &mockKeyProvider{
+		current: map[string]string{"db0": "k0", "db1": "k1"},
+		keys: map[string][]byte{
+			"k0": bytes.Repeat([]byte{1}, 32),
+			"k1": bytes.Repeat([]byte{2}, 32),
+			"k2": bytes.Repeat([]byte{3}, 32),
+		},
+	}
+}
+
+func (p *mockKeyProvider) CurrentKey(db string) (string, []byte, error) {
+	id, ok := p.current[db]
+	if !ok {
+		return "", nil, fmt.Errorf("no key for database %s", db)
+	}
+	return id, p.keys[id], nil
+}
+
+func (p *mockKeyProvider) Key(id string) ([]byte, error) {
+	key, ok := p.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", id)
+	}
+	return key, nil
+}
+
+func genPlaintext(n int) []byte {
+	buf := make([]byte, n)
+	for i := range buf {
+		buf[i] = byte(i % 251)
+	}
+	return buf
+}
+
+func TestEncryptedFile(t *testing.T) {
+	root := t.TempDir()
+	provider := newMockKeyProvider()
+	EnableEncryption(provider, root)
+	defer DisableEncryption()
+
+	name := filepath.Join(root, "db0", "0", "rp0", "00000001-0001-00000000.tssp")
+	require.NoError(t, MkdirAll(filepath.Dir(name), 0750))
+
+	data := genPlaintext(1000)
+	f, err := Create(name)
+	require.NoError(t, err)
+	require.True(t, IsEncryptedFile(f))
+	for i := 0; i < len(data); i += 77 {
+		end := i + 77
+		if end > len(data) {
+			end = len(data)
+		}
+		_, err = f.Write(data[i:end])
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.Close())
+
+	raw, err := os.ReadFile(name)
+	require.NoError(t, err)
+	require.Equal(t, len(data)+encryptionHeaderSize+encryptionBlockOverhead, len(raw))
+	require.False(t, bytes.Contains(raw, data[100:200]))
+
+	fi, err := Stat(name)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), fi.Size())
+
+	f, err = Open(name)
+	require.NoError(t, err)
+	require.True(t, IsEncryptedFile(f))
+	size, err := f.Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), size)
+
+	for _, off := range []int{0, 1, 15, 16, 17, 500, 990} {
+		buf := make([]byte, 10)
+		_, err = f.ReadAt(buf, int64(off))
+		require.NoError(t, err)
+		require.Equal(t, data[off:off+10], buf, off)
+	}
+	all, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.Equal(t, data, all)
+
+	pos, err := f.Seek(-10, io.SeekEnd)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)-10), pos)
+	all, err = io.ReadAll(f)
+	require.NoError(t, err)
+	require.Equal(t, data[len(data)-10:], all)
+	require.NoError(t, f.Close())
+
+	// the key of the database is rotated, the existing file is still read by the old key
+	provider.current["db0"] = "k2"
+	f, err = OpenFile(name, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("append"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	buf, err := ReadFile(name)
+	require.NoError(t, err)
+	require.Equal(t, append(data, "append"...), buf)
+
+	// overwrite in place
+	f, err = OpenFile(name, os.O_RDWR, 0600)
+	require.NoError(t, err)
+	_, err = f.Seek(3, io.SeekStart)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("xyz"))
+	require.NoError(t, err)
+	require.NoError(t, f.Truncate(100))
+	require.NoError(t, f.Close())
+
+	buf, err = ReadFile(name)
+	require.NoError(t, err)
+	require.Equal(t, append(append(append([]byte{}, data[:3]...), "xyz"...), data[6:100]...), buf)
+
+	require.NoError(t, Truncate(name, 50))
+	fi, err = Stat(name)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), fi.Size())
+
+	require.NoError(t, WriteFile(name, data[:20], 0600))
+	buf, err = ReadFile(name)
+	require.NoError(t, err)
+	require.Equal(t, data[:20], buf)
+	raw, err = os.ReadFile(name)
+	require.NoError(t, err)
+	require.Equal(t, "k2", string(raw[encryptionMagicSize+1:encryptionMagicSize+3]))
+}
+
+func TestEncryptedFile_Plaintext(t *testing.T) {
+	root := t.TempDir()
+	data := genPlaintext(100)
+
+	// files written before the encryption is enabled
+	oldFile := filepath.Join(root, "db0", "0", "00000001.wal")
+	require.NoError(t, os.MkdirAll(filepath.Dir(oldFile), 0750))
+	require.NoError(t, os.WriteFile(oldFile, data, 0600))
+
+	EnableEncryption(newMockKeyProvider(), root)
+	defer DisableEncryption()
+
+	f, err := Open(oldFile)
+	require.NoError(t, err)
+	require.False(t, IsEncryptedFile(f))
+	require.NoError(t, f.Close())
+	buf, err := ReadFile(oldFile)
+	require.NoError(t, err)
+	require.Equal(t, data, buf)
+
+	// the files not to encrypt
+	for _, name := range []string{
+		filepath.Join(root, "db0", "0", "LOCK"),
+		filepath.Join(t.TempDir(), "db0", "0.wal"),
+		filepath.Join(root, "0.wal"),
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(name), 0750))
+		require.NoError(t, WriteFile(name, data, 0600))
+		raw, err := os.ReadFile(name)
+		require.NoError(t, err)
+		require.Equal(t, data, raw, name)
+	}
+
+	// no key for the database
+	_, err = Create(filepath.Join(root, "db2", "0", "1.wal"))
+	require.Error(t, err)
+
+	name := filepath.Join(root, "db1", "0", "1.wal")
+	require.NoError(t, os.MkdirAll(filepath.Dir(name), 0750))
+	require.NoError(t, WriteFile(name, data, 0600))
+	DisableEncryption()
+	raw, err := os.ReadFile(name)
+	require.NoError(t, err)
+	require.NotEqual(t, data, raw[encryptionHeaderSize:])
+
+	// the key is lost
+	provider := newMockKeyProvider()
+	delete(provider.keys, "k1")
+	EnableEncryption(provider, root)
+	_, err = Open(name)
+	require.Error(t, err)
+}
+
+func TestEncryptedFile_Blocks(t *testing.T) {
+	root := t.TempDir()
+	EnableEncryption(newMockKeyProvider(), root)
+	defer DisableEncryption()
+
+	name := filepath.Join(root, "db0", "0", "raft", "1.entry")
+	require.NoError(t, MkdirAll(filepath.Dir(name), 0750))
+
+	data := genPlaintext(3*encryptionBlockSize + 100)
+	f, err := OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	for i := 0; i < len(data); i += 1000 {
+		_, err = f.Write(data[i:min(i+1000, len(data))])
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.Close())
+
+	buf, err := ReadFile(name)
+	require.NoError(t, err)
+	require.Equal(t, data, buf)
+	fi, err := Stat(name)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), fi.Size())
+
+	// the overwritten block is sealed by a new nonce
+	raw, err := os.ReadFile(name)
+	require.NoError(t, err)
+	f, err = OpenFile(name, os.O_RDWR, 0600)
+	require.NoError(t, err)
+	_, err = f.Seek(encryptionBlockSize-2, io.SeekStart)
+	require.NoError(t, err)
+	_, err = f.Write(data[encryptionBlockSize-2 : encryptionBlockSize+2])
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	overwritten, err := os.ReadFile(name)
+	require.NoError(t, err)
+	require.NotEqual(t, raw[encryptedBlockOffset(0):encryptedBlockOffset(1)], overwritten[encryptedBlockOffset(0):encryptedBlockOffset(1)])
+	require.NotEqual(t, raw[encryptedBlockOffset(1):encryptedBlockOffset(2)], overwritten[encryptedBlockOffset(1):encryptedBlockOffset(2)])
+	require.Equal(t, raw[encryptedBlockOffset(2):], overwritten[encryptedBlockOffset(2):])
+	buf, err = ReadFile(name)
+	require.NoError(t, err)
+	require.Equal(t, data, buf)
+
+	// truncate inside a block
+	require.NoError(t, Truncate(name, 2*encryptionBlockSize+10))
+	buf, err = ReadFile(name)
+	require.NoError(t, err)
+	require.Equal(t, data[:2*encryptionBlockSize+10], buf)
+
+	// the modified data is detected
+	raw, err = os.ReadFile(name)
+	require.NoError(t, err)
+	raw[encryptedBlockOffset(1)+100] ^= 1
+	require.NoError(t, os.WriteFile(name, raw, 0600))
+	f, err = Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+	b := make([]byte, 10)
+	_, err = f.ReadAt(b, 10)
+	require.NoError(t, err)
+	require.Equal(t, data[10:20], b)
+	_, err = f.ReadAt(b, encryptionBlockSize+10)
+	require.ErrorContains(t, err, "decrypt block 1")
+}
+
+func TestEncryptedFile_ConcurrentReadAt(t *testing.T) {
+	root := t.TempDir()
+	EnableEncryption(newMockKeyProvider(), root)
+	defer DisableEncryption()
+
+	name := filepath.Join(root, "db0", "0", "raft", "1.entry")
+	require.NoError(t, MkdirAll(filepath.Dir(name), 0750))
+	data := genPlaintext(2 * encryptionBlockSize)
+	f, err := OpenFile(name, os.O_RDWR|os.O_CREATE, 0600)
+	require.NoError(t, err)
+	defer f.Close()
+	_, err = f.Write(data)
+	require.NoError(t, err)
+
+	// the first block is sealed again by a new nonce while it is read
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			if _, err := f.Seek(10, io.SeekStart); err != nil {
+				return
+			}
+			if _, err := f.Write(data[10:20]); err != nil {
+				return
+			}
+		}
+	}()
+
+	b := make([]byte, 100)
+	for i := 0; i < 200; i++ {
+		_, err = f.ReadAt(b, 0)
+		require.NoError(t, err)
+		require.Equal(t, data[:100], b)
+	}
+	<-done
+}
@@ -523,6 +523,9 @@ func GetFs(t FsType) VFS {
 	switch t {
 	case Local:
 		once.Do(SetLogger)
+		if EncryptionEnabled() {
+			return encryptedLocalFS
+		}
 		return localFS
 	case Obs:
 		return obsFS
@@ -112,7 +112,7 @@ func NewFileReader(f File, lock *string) *fileReader {
 	fileSize := fi.Size()
 	r := &fileReader{fd: f, fileSize: fileSize, lock: lock, name: fName, once: new(sync.Once)}
 
-	if MmapEn {
+	if MmapEn && !IsEncryptedFile(f) {
 		r.mmapData, err = Mmap(int(f.Fd()), 0, int(fileSize))
 		if err != nil {
 			err = errMapFail(fName, err)
@@ -320,7 +320,7 @@ func (r *fileReader) ReOpen() error {
 		return err
 	}
 
-	if MmapEn {
+	if MmapEn && !IsEncryptedFile(r.fd) {
 		r.mmapData, err = Mmap(int(r.fd.Fd()), 0, int(r.fileSize))
 		if err != nil {
 			err = errMapFail(r.name, err)
@@ -17,6 +17,7 @@ package hashtable
 import (
 	"crypto/rand"
 	"encoding/binary"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -33,6 +34,21 @@ func TestStringHashMap_SetGet(t *testing.T) {
 	}
 }
 
+func TestStringHashMap_Find(t *testing.T) {
+	m := DefaultStringHashMap()
+	for i := 0; i < 10000; i++ {
+		m.Set([]byte(strconv.Itoa(i)))
+	}
+	for i := 0; i < 10000; i++ {
+		id, ok := m.Find([]byte(strconv.Itoa(i)))
+		assert.True(t, ok)
+		assert.Equal(t, uint64(i), id)
+	}
+	_, ok := m.Find([]byte("10000"))
+	assert.False(t, ok)
+	assert.Equal(t, uint64(10000), m.Set([]byte("10000")))
+}
+
 func TestIntHashMap_SetGet(t *testing.T) {
 	m := DefaultIntHashMap()
 	for i := 1; i < 1000000; i++ {
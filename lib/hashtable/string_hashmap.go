@@ -76,6 +76,21 @@ func (m *StringHashMap) Set(key []byte) uint64 {
 	}
 }
 
+// Find returns the id of the key without adding it to the map.
+func (m *StringHashMap) Find(key []byte) (uint64, bool) {
+	slot := m.hashFunc(key) & m.mask
+	for {
+		id := m.id(slot)
+		if id == -1 {
+			return 0, false
+		}
+		if string(key) == string(m.peek(uint64(id))) {
+			return uint64(id), true
+		}
+		slot = (slot + 1) & m.mask
+	}
+}
+
 func (m *StringHashMap) Get(id uint64, dst []byte) []byte {
 	startOffset := m.startOffsets.get(id)
 	length := m.startOffsets.get(id+1) - startOffset
@@ -158,6 +158,9 @@ type MetaClient interface {
 	CreateMeasurement(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo, numOfShards int32, indexR *influxql.IndexRelation, engineType config.EngineType,
 		colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options) (*meta2.MeasurementInfo, error)
 	AlterShardKey(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo) error
+	CreateMeasurementWithTTL(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo, numOfShards int32, indexR *influxql.IndexRelation, engineType config.EngineType,
+		colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options, ttl time.Duration) (*meta2.MeasurementInfo, error)
+	AlterMeasurementTTL(database, retentionPolicy, mst string, ttl time.Duration) error
 	CreateDatabase(name string, enableTagArray bool, replicaN uint32, options *obs.ObsOptions) (*meta2.DatabaseInfo, error)
 	CreateDatabaseWithRetentionPolicy(name string, spec *meta2.RetentionPolicySpec, shardKey *meta2.ShardKeyInfo, enableTagArray bool, replicaN uint32) (*meta2.DatabaseInfo, error)
 	CreateRetentionPolicy(database string, spec *meta2.RetentionPolicySpec, makeDefault bool) (*meta2.RetentionPolicyInfo, error)
@@ -399,6 +402,7 @@ var applyFunc = map[proto2.Command_Type]func(c *Client, op *proto2.Command) erro
 	proto2.Command_ReShardingCommand:                applyReSharding,
 	proto2.Command_UpdateSchemaCommand:              applyUpdateSchema,
 	proto2.Command_AlterShardKeyCmd:                 applyAlterShardKey,
+	proto2.Command_AlterMeasurementTTLCmd:           applyAlterMeasurementTTL,
 	proto2.Command_PruneGroupsCommand:               applyPruneGroups,
 	proto2.Command_MarkMeasurementDeleteCommand:     applyMarkMeasurementDelete,
 	proto2.Command_DropMeasurementCommand:           applyDropMeasurement,
@@ -1053,8 +1057,16 @@ func (c *Client) buildClusterRows(clusterInfo *meta2.ShowClusterInfo) models.Row
 		eventRow.Values = append(eventRow.Values, []interface{}{event.OpId, event.EventType, event.Db, event.PtId, event.SrcNodeId, event.DstNodeId, event.CurrState, event.PreState})
 	}
 
-	var availability string
-	nodeRow := &models.Row{Columns: []string{"time", "status", "hostname", "nodeID", "nodeType", "availability"}}
+	// the availability zones of the data nodes come from the cached meta data
+	azs := make(map[uint64]string)
+	c.mu.RLock()
+	for i := range c.cacheData.DataNodes {
+		azs[c.cacheData.DataNodes[i].ID] = c.cacheData.DataNodes[i].Az
+	}
+	c.mu.RUnlock()
+
+	var availability, az string
+	nodeRow := &models.Row{Columns: []string{"time", "status", "hostname", "nodeID", "nodeType", "availability", "az"}}
 	for _, node := range clusterInfo.Nodes {
 		availability = "available"
 		if node.Status != "alive" {
@@ -1063,10 +1075,13 @@ func (c *Client) buildClusterRows(clusterInfo *meta2.ShowClusterInfo) models.Row
 		if _, ok := srcNodeMap[node.NodeID]; ok {
 			availability = "unavailable"
 		}
+		az = ""
 		if node.NodeType == "meta" {
 			node.Status = "alive"
+		} else {
+			az = azs[node.NodeID]
 		}
-		nodeRow.Values = append(nodeRow.Values, []interface{}{node.Timestamp, node.Status, node.HostName, node.NodeID, node.NodeType, availability})
+		nodeRow.Values = append(nodeRow.Values, []interface{}{node.Timestamp, node.Status, node.HostName, node.NodeID, node.NodeType, availability, az})
 	}
 	return []*models.Row{nodeRow, eventRow}
 }
@@ -1203,6 +1218,15 @@ func (c *Client) InsertFiles(fileInfos []meta2.FileInfo) error {
 
 func (c *Client) CreateMeasurement(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo, NumOfShards int32, indexR *influxql.IndexRelation,
 	engineType config.EngineType, colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options) (*meta2.MeasurementInfo, error) {
+	return c.CreateMeasurementWithTTL(database, retentionPolicy, mst, shardKey, NumOfShards, indexR, engineType, colStoreInfo, schemaInfo, options, 0)
+}
+
+// CreateMeasurementWithTTL creates the measurement and sets its TTL in one command, a zero TTL means following the retention policy.
+func (c *Client) CreateMeasurementWithTTL(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo, NumOfShards int32, indexR *influxql.IndexRelation,
+	engineType config.EngineType, colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options, ttl time.Duration) (*meta2.MeasurementInfo, error) {
+	if ttl != 0 && ttl < meta2.MinRetentionPolicyDuration {
+		return nil, meta2.ErrMeasurementTTLTooLow
+	}
 	msti, err := c.Measurement(database, retentionPolicy, mst)
 	if msti != nil {
 		// check shardkey equal or not
@@ -1253,6 +1277,10 @@ func (c *Client) CreateMeasurement(database, retentionPolicy, mst string, shardK
 		cmd.Options = options.Marshal()
 	}
 
+	if ttl != 0 {
+		cmd.TTL = proto.Int64(int64(ttl))
+	}
+
 	err = c.retryUntilExec(proto2.Command_CreateMeasurementCommand, proto2.E_CreateMeasurementCommand_Command, cmd)
 	if err != nil {
 		return nil, err
@@ -1276,6 +1304,26 @@ func (c *Client) AlterShardKey(database, retentionPolicy, mst string, shardKey *
 	return c.retryUntilExec(proto2.Command_AlterShardKeyCmd, proto2.E_AlterShardKeyCmd_Command, cmd)
 }
 
+// AlterMeasurementTTL sets the TTL of the measurement, a zero TTL means following the retention policy.
+func (c *Client) AlterMeasurementTTL(database, retentionPolicy, mst string, ttl time.Duration) error {
+	if ttl != 0 && ttl < meta2.MinRetentionPolicyDuration {
+		return meta2.ErrMeasurementTTLTooLow
+	}
+	_, err := c.Measurement(database, retentionPolicy, mst)
+	if err != nil {
+		return err
+	}
+
+	cmd := &proto2.AlterMeasurementTTLCmd{
+		DBName: proto.String(database),
+		RpName: proto.String(retentionPolicy),
+		Name:   proto.String(mst),
+		TTL:    proto.Int64(int64(ttl)),
+	}
+
+	return c.retryUntilExec(proto2.Command_AlterMeasurementTTLCmd, proto2.E_AlterMeasurementTTLCmd_Command, cmd)
+}
+
 // CreateDatabase creates a database or returns it if it already exists.
 func (c *Client) CreateDatabase(name string, enableTagArray bool, replicaN uint32, options *obs.ObsOptions) (*meta2.DatabaseInfo, error) {
 	if strings.Count(name, "") > maxDbOrRpName {
@@ -1596,6 +1644,38 @@ func (c *Client) GetExpiredIndexes() []meta2.ExpiredIndexInfos {
 	return expiredIndexes
 }
 
+// GetExpiredMeasurements returns the measurements with TTL, and the time before which their data is expired.
+func (c *Client) GetExpiredMeasurements() []meta2.ExpiredMeasurementInfos {
+	now := time.Now().UTC().UnixNano()
+	var expired []meta2.ExpiredMeasurementInfos
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for dbName, db := range c.cacheData.Databases {
+		if db.MarkDeleted {
+			continue
+		}
+		for rpName, rp := range db.RetentionPolicies {
+			if rp.MarkDeleted {
+				continue
+			}
+			for _, msti := range rp.Measurements {
+				if msti.MarkDeleted || msti.TTL <= 0 {
+					continue
+				}
+				expired = append(expired, meta2.ExpiredMeasurementInfos{
+					Database:   dbName,
+					Policy:     rpName,
+					Name:       msti.Name,
+					EngineType: msti.EngineType,
+					ExpireTime: now - int64(msti.TTL),
+				})
+			}
+		}
+	}
+	return expired
+}
+
 // CreateRetentionPolicy creates a retention policy on the specified database.
 func (c *Client) CreateRetentionPolicy(database string, spec *meta2.RetentionPolicySpec, makeDefault bool) (*meta2.RetentionPolicyInfo, error) {
 
@@ -3611,6 +3691,10 @@ func applyAlterShardKey(c *Client, cmd *proto2.Command) error {
 	return meta2.ApplyAlterShardKey(c.cacheData, cmd)
 }
 
+func applyAlterMeasurementTTL(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyAlterMeasurementTTL(c.cacheData, cmd)
+}
+
 func applyPruneGroups(c *Client, cmd *proto2.Command) error {
 	return meta2.ApplyPruneGroups(c.cacheData, cmd)
 }
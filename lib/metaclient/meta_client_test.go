@@ -158,7 +158,7 @@ func (c *RPCServer) HandleCreateNode(w spdy.Responser, msg *message.CreateNodeRe
 
 func (c *RPCServer) HandleShowCluster(w spdy.Responser, msg *message.ShowClusterRequest) error {
 	fmt.Printf("server HandleShowCluster: %+v \n", msg)
-	showClusterInfo := meta2.ShowClusterInfo{Nodes: []meta2.NodeRow{{NodeID: 123}}, Events: []meta2.EventRow{{SrcNodeId: 123}}}
+	showClusterInfo := meta2.ShowClusterInfo{Nodes: []meta2.NodeRow{{NodeID: 123, NodeType: "data"}}, Events: []meta2.EventRow{{SrcNodeId: 123}}}
 	buf, _ := showClusterInfo.MarshalBinary()
 	rsp := &message.ShowClusterResponse{
 		Data: buf,
@@ -2588,6 +2588,7 @@ var newPbFunc = map[proto2.Command_Type]func() (interface{}, *proto.ExtensionDes
 	proto2.Command_ReShardingCommand:                newReShardingPb,
 	proto2.Command_UpdateSchemaCommand:              newUpdateSchemaPb,
 	proto2.Command_AlterShardKeyCmd:                 newAlterShardKeyPb,
+	proto2.Command_AlterMeasurementTTLCmd:           newAlterMeasurementTTLPb,
 	proto2.Command_PruneGroupsCommand:               newPruneGroupsPb,
 	proto2.Command_MarkMeasurementDeleteCommand:     newMarkMeasurementDeletePb,
 	proto2.Command_DropMeasurementCommand:           newDropMeasurementPb,
@@ -2790,6 +2791,10 @@ func newAlterShardKeyPb() (interface{}, *proto.ExtensionDesc) {
 	return &proto2.AlterShardKeyCmd{}, proto2.E_AlterShardKeyCmd_Command
 }
 
+func newAlterMeasurementTTLPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.AlterMeasurementTTLCmd{}, proto2.E_AlterMeasurementTTLCmd_Command
+}
+
 func newPruneGroupsPb() (interface{}, *proto.ExtensionDesc) {
 	return &proto2.PruneGroupsCommand{}, proto2.E_PruneGroupsCommand_Command
 }
@@ -3457,7 +3462,7 @@ func TestClient_ShowClusterWithCondition(t *testing.T) {
 		logger:         logger.NewLogger(errno.ModuleUnknown),
 		SendRPCMessage: &RPCMessageSender{},
 		metaServers:    []string{"127.0.0.1:8491", "127.0.0.1:8492"},
-		cacheData:      &meta2.Data{},
+		cacheData:      &meta2.Data{DataNodes: []meta2.DataNode{{NodeInfo: meta2.NodeInfo{ID: 123}, Az: "az1"}}},
 	}
 	connectedServer = nodeId
 	clusterRows, err := mc.ShowClusterWithCondition("", 0)
@@ -3466,6 +3471,7 @@ func TestClient_ShowClusterWithCondition(t *testing.T) {
 	ret2 := (clusterRows[1].Values[0][4]).(uint64)
 	assert.Equal(t, ret1, "unavailable")
 	assert.Equal(t, ret2, uint64(123))
+	assert.Equal(t, "az1", clusterRows[0].Values[0][6])
 }
 
 func TestClient_ShowCluster_Err(t *testing.T) {
@@ -545,6 +545,9 @@ type DeleteRequest struct {
 	ShardIDs             []uint64 `protobuf:"varint,4,rep,name=ShardIDs" json:"ShardIDs,omitempty"`
 	DeleteType           *int32   `protobuf:"varint,5,req,name=DeleteType" json:"DeleteType,omitempty"`
 	PtId                 *uint32  `protobuf:"varint,6,opt,name=PtId" json:"PtId,omitempty"`
+	PtIds                []uint32 `protobuf:"varint,7,rep,name=PtIds" json:"PtIds,omitempty"`
+	Msts                 []string `protobuf:"bytes,8,rep,name=Msts" json:"Msts,omitempty"`
+	Condition            *string  `protobuf:"bytes,9,opt,name=Condition" json:"Condition,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -616,6 +619,27 @@ func (m *DeleteRequest) GetPtId() uint32 {
 	return 0
 }
 
+func (m *DeleteRequest) GetPtIds() []uint32 {
+	if m != nil {
+		return m.PtIds
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetMsts() []string {
+	if m != nil {
+		return m.Msts
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetCondition() string {
+	if m != nil && m.Condition != nil {
+		return *m.Condition
+	}
+	return ""
+}
+
 type DeleteResponse struct {
 	Err                  *string  `protobuf:"bytes,1,opt,name=Err" json:"Err,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
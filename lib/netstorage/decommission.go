@@ -0,0 +1,263 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstorage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// sys ctrl mods used by the decommission of a node to copy the files of the db pts to the other nodes.
+// The target node pulls the files of a db pt from the source node, the copy runs in the background of the target node
+const (
+	PtFileManifestMod = "ptFileManifest"
+	PtFileChunkMod    = "ptFileChunk"
+	PtCopyMod         = "ptCopy"
+	PtCopyStatusMod   = "ptCopyStatus"
+	PtCopyCancelMod   = "ptCopyCancel"
+)
+
+// states of the copy of a db pt
+const (
+	PtCopyRunning   = "running"
+	PtCopyDone      = "done"
+	PtCopyFailed    = "failed"
+	PtCopyCancelled = "cancelled"
+)
+
+// PtFile is a file of a db pt, the path is relative to the data or the wal directory of the db pt
+// and starts with "data/" or "wal/". Checksum is the crc32 of the file, it is only set if requested
+type PtFile struct {
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+	Checksum uint32 `json:"checksum,omitempty"`
+}
+
+// PtFileFetcher reads the files of a db pt on the source node of a copy
+type PtFileFetcher interface {
+	GetPtFileManifest(nodeID uint64, db string, pt uint32, checksum bool) ([]*PtFile, error)
+	FetchPtFileChunk(nodeID uint64, db string, pt uint32, path string, offset, size int64) ([]byte, error)
+}
+
+// PtCopyRequest asks the target node to copy the files of the db pt from the source node.
+// The files are copied to a staging directory, a final copy is only allowed once the db pt is offloaded
+// from the source node, it verifies the checksums of all the files and moves them to the db pt directories
+type PtCopyRequest struct {
+	Db         string
+	Pt         uint32
+	SourceNode uint64
+	Throughput int64 // bytes per second
+	Final      bool
+}
+
+type PtCopyStatus struct {
+	Db          string `json:"db"`
+	Pt          uint32 `json:"pt"`
+	SourceNode  uint64 `json:"sourceNode"`
+	Final       bool   `json:"final"`
+	State       string `json:"state"`
+	Files       int    `json:"files"`
+	TotalBytes  int64  `json:"totalBytes"`
+	CopiedBytes int64  `json:"copiedBytes"`
+	Error       string `json:"error,omitempty"`
+}
+
+func (s *PtCopyStatus) Finished() bool {
+	return s.State != PtCopyRunning
+}
+
+func NewPtFileManifestRequest(db string, pt uint32, checksum bool) *SysCtrlRequest {
+	req := &SysCtrlRequest{}
+	req.SetMod(PtFileManifestMod)
+	req.SetParam(map[string]string{
+		"db":       db,
+		"pt":       strconv.FormatUint(uint64(pt), 10),
+		"checksum": strconv.FormatBool(checksum),
+	})
+	return req
+}
+
+func ParsePtFileManifestRequest(req *SysCtrlRequest) (string, uint32, bool, error) {
+	db, pt, err := parseDbPt(req.param)
+	if err != nil {
+		return "", 0, false, err
+	}
+	checksum, err := strconv.ParseBool(req.param["checksum"])
+	if err != nil {
+		return "", 0, false, fmt.Errorf("invalid checksum %q: %v", req.param["checksum"], err)
+	}
+	return db, pt, checksum, nil
+}
+
+func NewPtFileChunkRequest(db string, pt uint32, path string, offset, size int64) *SysCtrlRequest {
+	req := &SysCtrlRequest{}
+	req.SetMod(PtFileChunkMod)
+	req.SetParam(map[string]string{
+		"db":     db,
+		"pt":     strconv.FormatUint(uint64(pt), 10),
+		"path":   path,
+		"offset": strconv.FormatInt(offset, 10),
+		"size":   strconv.FormatInt(size, 10),
+	})
+	return req
+}
+
+func ParsePtFileChunkRequest(req *SysCtrlRequest) (db string, pt uint32, path string, offset, size int64, err error) {
+	if db, pt, err = parseDbPt(req.param); err != nil {
+		return
+	}
+	path = req.param["path"]
+	if path == "" {
+		err = fmt.Errorf("invalid pt file chunk request: %v", req.param)
+		return
+	}
+	if offset, err = strconv.ParseInt(req.param["offset"], 10, 64); err != nil || offset < 0 {
+		err = fmt.Errorf("invalid offset %q", req.param["offset"])
+		return
+	}
+	if size, err = strconv.ParseInt(req.param["size"], 10, 64); err != nil || size <= 0 {
+		err = fmt.Errorf("invalid size %q", req.param["size"])
+	}
+	return
+}
+
+func (r *PtCopyRequest) SysCtrlRequest() *SysCtrlRequest {
+	req := &SysCtrlRequest{}
+	req.SetMod(PtCopyMod)
+	req.SetParam(map[string]string{
+		"db":         r.Db,
+		"pt":         strconv.FormatUint(uint64(r.Pt), 10),
+		"sourceNode": strconv.FormatUint(r.SourceNode, 10),
+		"throughput": strconv.FormatInt(r.Throughput, 10),
+		"final":      strconv.FormatBool(r.Final),
+	})
+	return req
+}
+
+func ParsePtCopyRequest(req *SysCtrlRequest) (*PtCopyRequest, error) {
+	r := &PtCopyRequest{}
+	var err error
+	if r.Db, r.Pt, err = parseDbPt(req.param); err != nil {
+		return nil, err
+	}
+	if r.SourceNode, err = strconv.ParseUint(req.param["sourceNode"], 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid source node %q: %v", req.param["sourceNode"], err)
+	}
+	if r.Throughput, err = strconv.ParseInt(req.param["throughput"], 10, 64); err != nil || r.Throughput <= 0 {
+		return nil, fmt.Errorf("invalid throughput %q", req.param["throughput"])
+	}
+	if r.Final, err = strconv.ParseBool(req.param["final"]); err != nil {
+		return nil, fmt.Errorf("invalid final %q: %v", req.param["final"], err)
+	}
+	return r, nil
+}
+
+// NewPtCopyCancelRequest stops the copy of the db pt, the copied files are removed if clean is set,
+// otherwise they are kept so that the copy resumes from them
+func NewPtCopyCancelRequest(db string, pt uint32, clean bool) *SysCtrlRequest {
+	req := &SysCtrlRequest{}
+	req.SetMod(PtCopyCancelMod)
+	req.SetParam(map[string]string{
+		"db":    db,
+		"pt":    strconv.FormatUint(uint64(pt), 10),
+		"clean": strconv.FormatBool(clean),
+	})
+	return req
+}
+
+func ParsePtCopyCancelRequest(req *SysCtrlRequest) (string, uint32, bool, error) {
+	db, pt, err := parseDbPt(req.param)
+	if err != nil {
+		return "", 0, false, err
+	}
+	clean, err := strconv.ParseBool(req.param["clean"])
+	if err != nil {
+		return "", 0, false, fmt.Errorf("invalid clean %q: %v", req.param["clean"], err)
+	}
+	return db, pt, clean, nil
+}
+
+func NewPtCopyStatusRequest(db string, pt uint32) *SysCtrlRequest {
+	req := &SysCtrlRequest{}
+	req.SetMod(PtCopyStatusMod)
+	req.SetParam(map[string]string{
+		"db": db,
+		"pt": strconv.FormatUint(uint64(pt), 10),
+	})
+	return req
+}
+
+func ParsePtCopyStatusRequest(req *SysCtrlRequest) (string, uint32, error) {
+	return parseDbPt(req.param)
+}
+
+func parseDbPt(param map[string]string) (string, uint32, error) {
+	db := param["db"]
+	if db == "" {
+		return "", 0, fmt.Errorf("invalid request: %v", param)
+	}
+	pt, err := strconv.ParseUint(param["pt"], 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid pt id %q: %v", param["pt"], err)
+	}
+	return db, uint32(pt), nil
+}
+
+// GetPtFileManifest returns the files of the db pt on the node
+func (s *NetStorage) GetPtFileManifest(nodeID uint64, db string, pt uint32, checksum bool) ([]*PtFile, error) {
+	res, err := s.sysCtrlOnNode(nodeID, NewPtFileManifestRequest(db, pt, checksum))
+	if err != nil {
+		return nil, err
+	}
+	var files []*PtFile
+	if err = json.Unmarshal([]byte(res["files"]), &files); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// FetchPtFileChunk reads at most size bytes of the file of the db pt on the node from the offset
+func (s *NetStorage) FetchPtFileChunk(nodeID uint64, db string, pt uint32, path string, offset, size int64) ([]byte, error) {
+	res, err := s.sysCtrlOnNode(nodeID, NewPtFileChunkRequest(db, pt, path, offset, size))
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(res["data"])
+}
+
+// StartPtCopy starts the copy of the db pt on the target node, the progress is reported by GetPtCopyStatus
+func (s *NetStorage) StartPtCopy(nodeID uint64, req *PtCopyRequest) error {
+	_, err := s.sysCtrlOnNode(nodeID, req.SysCtrlRequest())
+	return err
+}
+
+func (s *NetStorage) GetPtCopyStatus(nodeID uint64, db string, pt uint32) (*PtCopyStatus, error) {
+	res, err := s.sysCtrlOnNode(nodeID, NewPtCopyStatusRequest(db, pt))
+	if err != nil {
+		return nil, err
+	}
+	status := &PtCopyStatus{}
+	if err = json.Unmarshal([]byte(res["status"]), status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func (s *NetStorage) CancelPtCopy(nodeID uint64, db string, pt uint32, clean bool) error {
+	_, err := s.sysCtrlOnNode(nodeID, NewPtCopyCancelRequest(db, pt, clean))
+	return err
+}
@@ -17,6 +17,7 @@ package netstorage
 import (
 	"context"
 	"sort"
+	"time"
 
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
@@ -72,6 +73,7 @@ type Engine interface {
 	ExpiredShards(nilShardMap *map[uint64]*meta.ShardDurationInfo) []*meta.ShardIdentifier
 	ExpiredIndexes(nilIndexMap *map[uint64]*meta.IndexDurationInfo) []*meta.IndexIdentifier
 	ExpiredCacheIndexes() []*meta.IndexIdentifier
+	ExpireMeasurements(infos []meta.ExpiredMeasurementInfos) error
 	FetchShardsNeedChangeStore() ([]*meta.ShardIdentifier, []*meta.ShardIdentifier)
 	ChangeShardTierToWarm(db string, ptId uint32, shardID uint64) error
 
@@ -99,10 +101,11 @@ type Engine interface {
 
 	TagValues(db string, ptId []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (TablesTagSets, error)
 	TagValuesCardinality(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (map[string]uint64, error)
-	DropSeries(database string, sources []influxql.Source, ptId []uint32, condition influxql.Expr) (int, error)
+	DropSeries(database string, rp string, sources []influxql.Source, ptId []uint32, condition influxql.Expr) (int, error)
 
 	DbPTRef(db string, ptId uint32) error
 	DbPTUnref(db string, ptId uint32)
+	AddDbPTQueryTime(db string, ptId uint32, d time.Duration)
 	CreateLogicalPlan(ctx context.Context, db string, ptId uint32, shardID []uint64, sources influxql.Sources, schema *executor.QuerySchema) (hybridqp.QueryNode, error)
 	ScanWithSparseIndex(ctx context.Context, db string, ptId uint32, shardIDs []uint64, schema *executor.QuerySchema) (executor.ShardsFragments, error)
 	GetIndexInfo(db string, ptId uint32, shardIDs uint64, schema *executor.QuerySchema) (*executor.AttachedIndexInfo, error)
@@ -135,6 +138,7 @@ type Engine interface {
 	CheckPtsRemovedDone() bool
 	TransferLeadership(database string, nodeId uint64, oldMasterPtId, newMasterPtId uint32) error
 	HierarchicalStorage(db string, ptId uint32, shardID uint64) bool
+	Scrub(opt *ScrubOptions)
 
 	RaftMessage
 	CreateDDLBasePlans(planType hybridqp.DDLType, db string, ptIDs []uint32, tr *influxql.TimeRange) DDLBasePlans
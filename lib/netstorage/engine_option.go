@@ -75,6 +75,10 @@ type EngineOptions struct {
 	ReadMetaCacheLimit uint64
 	ReadDataCacheLimit uint64
 	EnableMmapRead     bool
+	ColdCacheDir       string
+	ColdCacheSize      int64
+	ColdCacheBlockSize int64
+	ColdPrefetchMeta   bool
 	CompactionMethod   int // 0:auto, 1:stream, 2: non-stream
 
 	OpenShardLimit int
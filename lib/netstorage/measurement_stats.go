@@ -0,0 +1,89 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstorage
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/openGemini/openGemini/engine/executor"
+)
+
+// MeasurementStatsMod queries the statistics of a measurement kept in the metadata of its files,
+// the sql node plans the queries with them
+const MeasurementStatsMod = "measurementStats"
+
+// MeasurementStats is the number of rows of a measurement and the value range of its numeric fields
+// estimated by a ts-store without reading the data of the files
+type MeasurementStats struct {
+	Rows   int64                                `json:"rows"`
+	Fields map[string]*executor.FieldStatistics `json:"fields,omitempty"`
+}
+
+type MeasurementStatsRequest struct {
+	Db  string
+	Rp  string
+	Mst string
+	Pts []uint32
+}
+
+func (r *MeasurementStatsRequest) SysCtrlRequest() *SysCtrlRequest {
+	pts := make([]string, len(r.Pts))
+	for i, pt := range r.Pts {
+		pts[i] = strconv.FormatUint(uint64(pt), 10)
+	}
+	req := &SysCtrlRequest{}
+	req.SetMod(MeasurementStatsMod)
+	req.SetParam(map[string]string{
+		"db":  r.Db,
+		"rp":  r.Rp,
+		"mst": r.Mst,
+		"pts": strings.Join(pts, ","),
+	})
+	return req
+}
+
+func ParseMeasurementStatsRequest(req *SysCtrlRequest) (*MeasurementStatsRequest, error) {
+	r := &MeasurementStatsRequest{Db: req.param["db"], Rp: req.param["rp"], Mst: req.param["mst"]}
+	if r.Db == "" || r.Rp == "" || r.Mst == "" {
+		return nil, fmt.Errorf("invalid measurement stats request: %v", req.param)
+	}
+	for _, s := range strings.Split(req.param["pts"], ",") {
+		if s == "" {
+			continue
+		}
+		pt, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pt %q: %v", s, err)
+		}
+		r.Pts = append(r.Pts, uint32(pt))
+	}
+	return r, nil
+}
+
+// GetMeasurementStats returns the statistics of the measurement in the pts of the node
+func (s *NetStorage) GetMeasurementStats(nodeID uint64, req *MeasurementStatsRequest) (*MeasurementStats, error) {
+	res, err := s.sysCtrlOnNode(nodeID, req.SysCtrlRequest())
+	if err != nil {
+		return nil, err
+	}
+	stats := &MeasurementStats{}
+	if err = json.Unmarshal([]byte(res["stats"]), stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
@@ -125,6 +125,38 @@ func TestDeleteRequestMessage(t *testing.T) {
 	assert.Empty(t, other.Measurement, "expected value of Measurement is empty, got: %v", other.Measurement)
 	assert.Empty(t, other.ShardIds, "expected value of ShardIds is empty, got: %+v", other.ShardIds)
 	assert.Empty(t, other.Rp, "expected value of Rp is empty, got: %+v", other.Rp)
+
+	req, other = makeDeleteRequestMessage(t, netstorage.SeriesDelete)
+	assert.Equal(t, req.Database, other.Database)
+	assert.Equal(t, req.Rp, other.Rp)
+	assert.Empty(t, other.Measurement)
+	assert.Empty(t, other.ShardIds)
+
+	req, other = makeDeleteRequestMessage(t, netstorage.ShardDelete)
+	assert.Equal(t, req.Rp, other.Rp)
+	assert.Equal(t, req.ShardIds, other.ShardIds)
+	assert.Empty(t, other.Measurement)
+}
+
+func TestDeleteSeriesRequestMessage(t *testing.T) {
+	req := &netstorage.DeleteRequest{
+		Type:         netstorage.SeriesDelete,
+		Database:     "db0",
+		Rp:           "rp0",
+		PtIds:        []uint32{1, 3},
+		Measurements: []string{"cpu_0000", "mem_0000"},
+		Condition:    "host = 'a' AND time < '2024-01-01T00:00:00Z'",
+	}
+
+	msg := netstorage.NewDDLMessage(netstorage.DeleteRequestMessage, req)
+	buf, err := msg.Marshal(nil)
+	require.NoError(t, err)
+
+	msg2 := msg.Instance()
+	require.NoError(t, msg2.Unmarshal(buf))
+	other, ok := msg2.(*netstorage.DDLMessage).Data.(*netstorage.DeleteRequest)
+	require.True(t, ok)
+	assert.Equal(t, req, other)
 }
 
 func TestShowTagValuesRequest(t *testing.T) {
@@ -0,0 +1,52 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstorage
+
+import (
+	"encoding/json"
+)
+
+// PtLoadMod queries the load of the db pts loaded by a node, ts-meta balances the db pts by their loads
+const PtLoadMod = "ptLoad"
+
+// PtLoad is the load of a db pt on a node. Size is the size of the files of the db pt,
+// Rows and QueryTimeNs are accumulated since the db pt is loaded by the node
+type PtLoad struct {
+	Db          string `json:"db"`
+	Pt          uint32 `json:"pt"`
+	Size        int64  `json:"size"`
+	Rows        int64  `json:"rows"`
+	QueryTimeNs int64  `json:"queryTimeNs"`
+}
+
+func NewPtLoadRequest() *SysCtrlRequest {
+	req := &SysCtrlRequest{}
+	req.SetMod(PtLoadMod)
+	req.SetParam(map[string]string{})
+	return req
+}
+
+// GetPtLoads returns the loads of the db pts loaded by the node
+func (s *NetStorage) GetPtLoads(nodeID uint64) ([]*PtLoad, error) {
+	res, err := s.sysCtrlOnNode(nodeID, NewPtLoadRequest())
+	if err != nil {
+		return nil, err
+	}
+	var loads []*PtLoad
+	if err = json.Unmarshal([]byte(res["loads"]), &loads); err != nil {
+		return nil, err
+	}
+	return loads, nil
+}
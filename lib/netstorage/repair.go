@@ -0,0 +1,266 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstorage
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/util"
+)
+
+// sys ctrl mods used by the anti-entropy repair to compare the replicas and to repair the series of a replica
+const (
+	RepairDigestMod = "repairDigest"
+	RepairSeriesMod = "repairSeries"
+)
+
+// RepairDigest summarizes the rows of a measurement within a time window of a pt,
+// or the rows of a series within the time range of the request if Key is set.
+// Hash is the sum of the hashes of the series, so it does not depend on the order the series are read in
+type RepairDigest struct {
+	Mst    string `json:"mst"`
+	Window int64  `json:"window,omitempty"` // start time of the window
+	Key    string `json:"key,omitempty"`    // hex encoded series key
+	Series int    `json:"series"`
+	Rows   int64  `json:"rows"`
+	Hash   uint64 `json:"hash"`
+}
+
+func (d *RepairDigest) Equal(o *RepairDigest) bool {
+	return d.Series == o.Series && d.Rows == o.Rows && d.Hash == o.Hash
+}
+
+// RepairDigestRequest asks a ts-store for the digests of the shards of a pt within the time range.
+// The digests of the series of Mst are returned instead of the digests of the windows if PerSeries is set
+type RepairDigestRequest struct {
+	Db        string
+	Rp        string
+	Pt        uint32
+	Mst       string
+	TimeRange util.TimeRange
+	Window    int64
+	PerSeries bool
+}
+
+// RepairSeriesRequest asks a ts-store to re-write the rows of the series of a pt with the rows of the same
+// series read from the source pt, which is a peer replica on the source node
+type RepairSeriesRequest struct {
+	Db         string
+	Rp         string
+	Pt         uint32
+	Mst        string
+	TimeRange  util.TimeRange
+	SourceNode uint64
+	SourcePt   uint32
+	Keys       [][]byte
+}
+
+type RepairSeriesResult struct {
+	Series int   `json:"series"`
+	Rows   int64 `json:"rows"`
+}
+
+func (r *RepairDigestRequest) SysCtrlRequest() *SysCtrlRequest {
+	req := &SysCtrlRequest{}
+	req.SetMod(RepairDigestMod)
+	req.SetParam(map[string]string{
+		"db":        r.Db,
+		"rp":        r.Rp,
+		"pt":        strconv.FormatUint(uint64(r.Pt), 10),
+		"mst":       r.Mst,
+		"min":       strconv.FormatInt(r.TimeRange.Min, 10),
+		"max":       strconv.FormatInt(r.TimeRange.Max, 10),
+		"window":    strconv.FormatInt(r.Window, 10),
+		"perSeries": strconv.FormatBool(r.PerSeries),
+	})
+	return req
+}
+
+func ParseRepairDigestRequest(req *SysCtrlRequest) (*RepairDigestRequest, error) {
+	r := &RepairDigestRequest{Db: req.param["db"], Rp: req.param["rp"], Mst: req.param["mst"]}
+	if r.Db == "" || r.Rp == "" {
+		return nil, fmt.Errorf("invalid repair digest request: %v", req.param)
+	}
+	var err error
+	if r.Pt, r.TimeRange, err = parseRepairPtAndTime(req.param); err != nil {
+		return nil, err
+	}
+	if r.Window, err = strconv.ParseInt(req.param["window"], 10, 64); err != nil || r.Window <= 0 {
+		return nil, fmt.Errorf("invalid window %q", req.param["window"])
+	}
+	if r.PerSeries, err = strconv.ParseBool(req.param["perSeries"]); err != nil {
+		return nil, fmt.Errorf("invalid perSeries %q: %v", req.param["perSeries"], err)
+	}
+	if r.PerSeries && r.Mst == "" {
+		return nil, fmt.Errorf("measurement is required by the series digests")
+	}
+	return r, nil
+}
+
+func (r *RepairSeriesRequest) SysCtrlRequest() *SysCtrlRequest {
+	hexKeys := make([]string, len(r.Keys))
+	for i := range r.Keys {
+		hexKeys[i] = hex.EncodeToString(r.Keys[i])
+	}
+
+	req := &SysCtrlRequest{}
+	req.SetMod(RepairSeriesMod)
+	req.SetParam(map[string]string{
+		"db":         r.Db,
+		"rp":         r.Rp,
+		"pt":         strconv.FormatUint(uint64(r.Pt), 10),
+		"mst":        r.Mst,
+		"min":        strconv.FormatInt(r.TimeRange.Min, 10),
+		"max":        strconv.FormatInt(r.TimeRange.Max, 10),
+		"sourceNode": strconv.FormatUint(r.SourceNode, 10),
+		"sourcePt":   strconv.FormatUint(uint64(r.SourcePt), 10),
+		"keys":       strings.Join(hexKeys, ","),
+	})
+	return req
+}
+
+func ParseRepairSeriesRequest(req *SysCtrlRequest) (*RepairSeriesRequest, error) {
+	r := &RepairSeriesRequest{Db: req.param["db"], Rp: req.param["rp"], Mst: req.param["mst"]}
+	if r.Db == "" || r.Rp == "" || r.Mst == "" || req.param["keys"] == "" {
+		return nil, fmt.Errorf("invalid repair series request: %v", req.param)
+	}
+	var err error
+	if r.Pt, r.TimeRange, err = parseRepairPtAndTime(req.param); err != nil {
+		return nil, err
+	}
+	if r.SourceNode, err = strconv.ParseUint(req.param["sourceNode"], 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid source node %q: %v", req.param["sourceNode"], err)
+	}
+	pt, err := strconv.ParseUint(req.param["sourcePt"], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source pt %q: %v", req.param["sourcePt"], err)
+	}
+	r.SourcePt = uint32(pt)
+
+	for _, s := range strings.Split(req.param["keys"], ",") {
+		key, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid series key %q: %v", s, err)
+		}
+		r.Keys = append(r.Keys, key)
+	}
+	return r, nil
+}
+
+func parseRepairPtAndTime(param map[string]string) (uint32, util.TimeRange, error) {
+	var tr util.TimeRange
+	pt, err := strconv.ParseUint(param["pt"], 10, 32)
+	if err != nil {
+		return 0, tr, fmt.Errorf("invalid pt id %q: %v", param["pt"], err)
+	}
+	if tr.Min, err = strconv.ParseInt(param["min"], 10, 64); err != nil {
+		return 0, tr, fmt.Errorf("invalid min time %q: %v", param["min"], err)
+	}
+	if tr.Max, err = strconv.ParseInt(param["max"], 10, 64); err != nil {
+		return 0, tr, fmt.Errorf("invalid max time %q: %v", param["max"], err)
+	}
+	return uint32(pt), tr, nil
+}
+
+func EncodeRepairDigests(digests []*RepairDigest) (map[string]string, error) {
+	res := make(map[string]string, len(digests))
+	for i, d := range digests {
+		buf, err := json.Marshal(d)
+		if err != nil {
+			return nil, err
+		}
+		res[strconv.Itoa(i)] = string(buf)
+	}
+	return res, nil
+}
+
+// DecodeRepairDigests decodes the digests in the order they are encoded in
+func DecodeRepairDigests(src map[string]string) ([]*RepairDigest, error) {
+	digests := make([]*RepairDigest, len(src))
+	for k, v := range src {
+		i, err := strconv.Atoi(k)
+		if err != nil || i < 0 || i >= len(src) {
+			return nil, fmt.Errorf("invalid repair digest index %q", k)
+		}
+		d := &RepairDigest{}
+		if err = json.Unmarshal([]byte(v), d); err != nil {
+			return nil, err
+		}
+		digests[i] = d
+	}
+	return digests, nil
+}
+
+func SortRepairDigests(digests []*RepairDigest) {
+	sort.Slice(digests, func(i, j int) bool {
+		if digests[i].Mst != digests[j].Mst {
+			return digests[i].Mst < digests[j].Mst
+		}
+		if digests[i].Window != digests[j].Window {
+			return digests[i].Window < digests[j].Window
+		}
+		return digests[i].Key < digests[j].Key
+	})
+}
+
+// GetRepairDigests returns the digests of the pt on the node
+func (s *NetStorage) GetRepairDigests(nodeID uint64, req *RepairDigestRequest) ([]*RepairDigest, error) {
+	res, err := s.sysCtrlOnNode(nodeID, req.SysCtrlRequest())
+	if err != nil {
+		return nil, err
+	}
+	return DecodeRepairDigests(res)
+}
+
+// RepairSeries asks the node to repair the series of its pt from the source replica
+func (s *NetStorage) RepairSeries(nodeID uint64, req *RepairSeriesRequest) (*RepairSeriesResult, error) {
+	res, err := s.sysCtrlOnNode(nodeID, req.SysCtrlRequest())
+	if err != nil {
+		return nil, err
+	}
+	result := &RepairSeriesResult{}
+	if err = json.Unmarshal([]byte(res["result"]), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// sysCtrlOnNode sends the request to the node and returns the error reported by the node
+func (s *NetStorage) sysCtrlOnNode(nodeID uint64, req *SysCtrlRequest) (map[string]string, error) {
+	r := NewRequester(0, nil, s.metaClient)
+	if err := r.initWithNodeID(nodeID); err != nil {
+		return nil, err
+	}
+
+	v, err := r.sysCtrl(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := v.(*SysCtrlResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.SysCtrlResponse", v)
+	}
+	if err = resp.Error(); err != nil {
+		return nil, err
+	}
+	return resp.Result(), nil
+}
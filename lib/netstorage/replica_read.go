@@ -0,0 +1,73 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstorage
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// QueryReplicaReadStateMod is the sys ctrl mod used to query the read state of the raft nodes of a database on a ts-store
+const QueryReplicaReadStateMod = "queryReplicaReadState"
+
+// ReplicaReadState is the state of the raft node of a pt used to decide whether the pt can serve follower reads
+type ReplicaReadState struct {
+	Leader  bool   `json:"leader"`
+	Commit  uint64 `json:"commit"`  // the commit index of the leader confirmed by the last ReadIndex
+	Applied uint64 `json:"applied"` // the largest index applied to the shards
+	// Staleness in milliseconds, the data of the pt contains all the writes committed by the
+	// leader before now-Staleness. It is 0 for the leader and negative if it is unknown
+	Staleness int64 `json:"staleness"`
+}
+
+// GetReplicaReadState returns the read state of the raft nodes of the database on the node, the key is the pt id
+func (s *NetStorage) GetReplicaReadState(nodeID uint64, db string) (map[uint32]*ReplicaReadState, error) {
+	req := SysCtrlRequest{}
+	req.SetMod(QueryReplicaReadStateMod)
+	req.SetParam(map[string]string{"db": db})
+	result, err := s.SendQueryRequestOnNode(nodeID, req)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeReplicaReadState(result)
+}
+
+func EncodeReplicaReadState(states map[uint32]*ReplicaReadState) (map[string]string, error) {
+	result := make(map[string]string, len(states))
+	for pt, state := range states {
+		data, err := json.Marshal(state)
+		if err != nil {
+			return nil, err
+		}
+		result[strconv.FormatUint(uint64(pt), 10)] = string(data)
+	}
+	return result, nil
+}
+
+func DecodeReplicaReadState(result map[string]string) (map[uint32]*ReplicaReadState, error) {
+	states := make(map[uint32]*ReplicaReadState, len(result))
+	for k, v := range result {
+		pt, err := strconv.ParseUint(k, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		state := &ReplicaReadState{}
+		if err = json.Unmarshal([]byte(v), state); err != nil {
+			return nil, err
+		}
+		states[uint32(pt)] = state
+	}
+	return states, nil
+}
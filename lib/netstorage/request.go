@@ -33,6 +33,8 @@ const (
 	DatabaseDelete DeleteType = iota
 	RetentionPolicyDelete
 	MeasurementDelete
+	SeriesDelete
+	ShardDelete
 )
 
 type RunStateType int32
@@ -50,12 +52,26 @@ type DeleteRequest struct {
 	ShardIds    []uint64
 	Type        DeleteType
 	PtId        uint32
+
+	// used by SeriesDelete and ShardDelete, SeriesDelete with an empty Rp applies to all the retention policies
+	PtIds        []uint32
+	Measurements []string // measurement names with version
+	Condition    string
 }
 
 func (ddr *DeleteRequest) MarshalBinary() ([]byte, error) {
 	dr := &internal2.DeleteRequest{DB: proto.String(ddr.Database)}
 	dr.DeleteType = proto.Int(int(ddr.Type))
 	switch ddr.Type {
+	case SeriesDelete:
+		dr.Rp = proto.String(ddr.Rp)
+		dr.PtIds = ddr.PtIds
+		dr.Msts = ddr.Measurements
+		dr.Condition = proto.String(ddr.Condition)
+	case ShardDelete:
+		dr.Rp = proto.String(ddr.Rp)
+		dr.ShardIDs = ddr.ShardIds
+		dr.PtIds = ddr.PtIds
 	case MeasurementDelete:
 		dr.Mst = proto.String(ddr.Measurement)
 		dr.ShardIDs = ddr.ShardIds
@@ -78,6 +94,17 @@ func (ddr *DeleteRequest) UnmarshalBinary(data []byte) error {
 	}
 	ddr.Type = DeleteType(pb.GetDeleteType())
 	switch ddr.Type {
+	case SeriesDelete:
+		ddr.Database = pb.GetDB()
+		ddr.Rp = pb.GetRp()
+		ddr.PtIds = pb.GetPtIds()
+		ddr.Measurements = pb.GetMsts()
+		ddr.Condition = pb.GetCondition()
+	case ShardDelete:
+		ddr.Database = pb.GetDB()
+		ddr.Rp = pb.GetRp()
+		ddr.ShardIds = pb.GetShardIDs()
+		ddr.PtIds = pb.GetPtIds()
 	case MeasurementDelete:
 		ddr.Measurement = pb.GetMst()
 		ddr.ShardIds = pb.GetShardIDs()
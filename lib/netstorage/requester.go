@@ -15,6 +15,7 @@
 package netstorage
 
 import (
+	"context"
 	"time"
 
 	"github.com/openGemini/openGemini/engine/executor/spdy"
@@ -37,6 +38,9 @@ type Requester struct {
 
 	insert  bool
 	timeout time.Duration
+
+	// span of the request exported by OpenTelemetry
+	traceCtx context.Context
 }
 
 func NewRequester(msgTyp uint8, data codec.BinaryCodec, mc meta.MetaClient) *Requester {
@@ -138,6 +142,9 @@ func (r *Requester) request(queryTyp uint8, data transport.Codec, cb transport.C
 	if err != nil {
 		return err
 	}
+	if r.traceCtx != nil {
+		trans.SetTraceContext(r.traceCtx)
+	}
 
 	if err := trans.Send(data); err != nil {
 		return err
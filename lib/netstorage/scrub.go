@@ -0,0 +1,167 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstorage
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
+)
+
+// sys ctrl mods used to query the scrub results of a ts-store and to fetch the series of a peer replica
+const (
+	QueryScrubStatusMod = "queryScrubStatus"
+	ScrubFetchSeriesMod = "scrubFetchSeries"
+)
+
+// ScrubInfo is the result of the last scrub of a shard reported by its owning ts-store
+type ScrubInfo struct {
+	Database  string   `json:"db"`
+	PtID      uint32   `json:"pt"`
+	ShardID   uint64   `json:"shard_id"`
+	LastScrub int64    `json:"last_scrub"` // unix nano
+	Files     int      `json:"files"`
+	Bytes     int64    `json:"bytes"`
+	Corrupt   []string `json:"corrupt,omitempty"`
+	Repaired  []string `json:"repaired,omitempty"`
+}
+
+// ScrubFetcher fetches the series from the ts-store holding a peer replica,
+// the shards of the peer pt are found by the retention policy and the time range since every pt has its own shard ids
+type ScrubFetcher interface {
+	FetchScrubSeries(nodeID uint64, db, rp string, pt uint32, mst string, tr util.TimeRange, keys [][]byte) (map[string]*record.Record, error)
+}
+
+// ScrubOptions controls a round of scrubbing on a ts-store
+type ScrubOptions struct {
+	// Throttle blocks until n more bytes are allowed to be read
+	Throttle func(n int)
+	// the round is stopped once Closed is closed
+	Closed <-chan struct{}
+	// Fetcher re-fetches the corrupt series of TSSP files from peer replicas, nil disables the repair
+	Fetcher ScrubFetcher
+}
+
+func NewScrubFetchSeriesRequest(db, rp string, pt uint32, mst string, tr util.TimeRange, keys [][]byte) *SysCtrlRequest {
+	hexKeys := make([]string, len(keys))
+	for i := range keys {
+		hexKeys[i] = hex.EncodeToString(keys[i])
+	}
+
+	req := &SysCtrlRequest{}
+	req.SetMod(ScrubFetchSeriesMod)
+	req.SetParam(map[string]string{
+		"db":   db,
+		"rp":   rp,
+		"pt":   strconv.FormatUint(uint64(pt), 10),
+		"mst":  mst,
+		"min":  strconv.FormatInt(tr.Min, 10),
+		"max":  strconv.FormatInt(tr.Max, 10),
+		"keys": strings.Join(hexKeys, ","),
+	})
+	return req
+}
+
+func ParseScrubFetchSeriesRequest(req *SysCtrlRequest) (db, rp string, pt uint32, mst string, tr util.TimeRange, keys [][]byte, err error) {
+	db, rp, mst = req.param["db"], req.param["rp"], req.param["mst"]
+	if db == "" || rp == "" || mst == "" || req.param["keys"] == "" {
+		err = fmt.Errorf("invalid scrub fetch series request: %v", req.param)
+		return
+	}
+
+	var n uint64
+	if n, err = strconv.ParseUint(req.param["pt"], 10, 32); err != nil {
+		err = fmt.Errorf("invalid pt id %q: %v", req.param["pt"], err)
+		return
+	}
+	pt = uint32(n)
+	if tr.Min, err = strconv.ParseInt(req.param["min"], 10, 64); err != nil {
+		err = fmt.Errorf("invalid min time %q: %v", req.param["min"], err)
+		return
+	}
+	if tr.Max, err = strconv.ParseInt(req.param["max"], 10, 64); err != nil {
+		err = fmt.Errorf("invalid max time %q: %v", req.param["max"], err)
+		return
+	}
+
+	for _, s := range strings.Split(req.param["keys"], ",") {
+		key, e := hex.DecodeString(s)
+		if e != nil {
+			err = fmt.Errorf("invalid series key %q: %v", s, e)
+			return
+		}
+		keys = append(keys, key)
+	}
+	return
+}
+
+// EncodeScrubSeries adds the record of the series to the result of a scrub fetch series request
+func EncodeScrubSeries(dst map[string]string, key []byte, rec *record.Record) {
+	dst[hex.EncodeToString(key)] = base64.StdEncoding.EncodeToString(rec.Marshal(nil))
+}
+
+// DecodeScrubSeries decodes the result of a scrub fetch series request, the records are keyed by the series keys
+func DecodeScrubSeries(src map[string]string) (map[string]*record.Record, error) {
+	res := make(map[string]*record.Record, len(src))
+	for k, v := range src {
+		key, err := hex.DecodeString(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid series key %q: %v", k, err)
+		}
+		buf, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid record of series %q: %v", key, err)
+		}
+		rec := &record.Record{}
+		rec.Unmarshal(buf)
+		res[string(key)] = rec
+	}
+	return res, nil
+}
+
+// FetchScrubSeries reads the rows of the series within the time range from the peer replica pt on the node
+func (s *NetStorage) FetchScrubSeries(nodeID uint64, db, rp string, pt uint32, mst string, tr util.TimeRange, keys [][]byte) (map[string]*record.Record, error) {
+	res, err := s.sysCtrlOnNode(nodeID, NewScrubFetchSeriesRequest(db, rp, pt, mst, tr, keys))
+	if err != nil {
+		return nil, err
+	}
+	return DecodeScrubSeries(res)
+}
+
+// GetScrubStatus returns the result of the last scrub of each shard on the node
+func (s *NetStorage) GetScrubStatus(nodeID uint64) ([]*ScrubInfo, error) {
+	req := SysCtrlRequest{}
+	req.SetMod(QueryScrubStatusMod)
+	result, err := s.SendQueryRequestOnNode(nodeID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*ScrubInfo, 0, len(result))
+	for _, v := range result {
+		info := &ScrubInfo{}
+		if err = json.Unmarshal([]byte(v), info); err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstorage
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/executor"
+)
+
+// sys ctrl mods used to run and query the maintenance tasks of a single shard
+const (
+	ShardMaintenanceMod      = "shard_maintenance"
+	QueryShardMaintenanceMod = "queryShardMaintenance"
+)
+
+const (
+	ShardMaintenanceRunning = "running"
+	ShardMaintenanceDone    = "done"
+	ShardMaintenanceFailed  = "failed"
+)
+
+// ShardMaintenanceInfo is the latest maintenance task of a shard reported by its owning ts-store
+type ShardMaintenanceInfo struct {
+	ShardID  uint64  `json:"shard_id"`
+	Action   string  `json:"action"`
+	State    string  `json:"state"`
+	Progress float64 `json:"progress"` // percent, 0 ~ 100
+	Err      string  `json:"error,omitempty"`
+
+	FinishedAt time.Time `json:"-"` // used by the ts-store to expire the finished tasks
+}
+
+func NewShardMaintenanceRequest(db string, ptIds []uint32, shardID uint64, action string) *SysCtrlRequest {
+	pts := make([]string, len(ptIds))
+	for i := range ptIds {
+		pts[i] = strconv.FormatUint(uint64(ptIds[i]), 10)
+	}
+
+	req := &SysCtrlRequest{}
+	req.SetMod(ShardMaintenanceMod)
+	req.SetParam(map[string]string{
+		"db":     db,
+		"pts":    strings.Join(pts, ","),
+		"shard":  strconv.FormatUint(shardID, 10),
+		"action": action,
+	})
+	return req
+}
+
+func ParseShardMaintenanceRequest(req *SysCtrlRequest) (db string, ptIds []uint32, shardID uint64, action string, err error) {
+	db, action = req.param["db"], req.param["action"]
+	if db == "" || action == "" {
+		return "", nil, 0, "", fmt.Errorf("invalid shard maintenance request: %v", req.param)
+	}
+
+	shardID, err = strconv.ParseUint(req.param["shard"], 10, 64)
+	if err != nil {
+		return "", nil, 0, "", fmt.Errorf("invalid shard id %q: %v", req.param["shard"], err)
+	}
+
+	for _, s := range strings.Split(req.param["pts"], ",") {
+		pt, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return "", nil, 0, "", fmt.Errorf("invalid pt id %q: %v", s, err)
+		}
+		ptIds = append(ptIds, uint32(pt))
+	}
+	return db, ptIds, shardID, action, nil
+}
+
+// ShardMaintenance starts a maintenance action of the shard on the node, the action runs in the background
+func (s *NetStorage) ShardMaintenance(nodeID uint64, db string, ptIds []uint32, shardID uint64, action string) error {
+	r := NewRequester(0, nil, s.metaClient)
+	if err := r.initWithNodeID(nodeID); err != nil {
+		return err
+	}
+
+	v, err := r.sysCtrl(NewShardMaintenanceRequest(db, ptIds, shardID, action))
+	if err != nil {
+		return err
+	}
+
+	resp, ok := v.(*SysCtrlResponse)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.SysCtrlResponse", v)
+	}
+	return resp.Error()
+}
+
+// GetShardMaintenance returns the latest maintenance task of each shard on the node
+func (s *NetStorage) GetShardMaintenance(nodeID uint64) ([]*ShardMaintenanceInfo, error) {
+	req := SysCtrlRequest{}
+	req.SetMod(QueryShardMaintenanceMod)
+	result, err := s.SendQueryRequestOnNode(nodeID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*ShardMaintenanceInfo, 0, len(result))
+	for _, v := range result {
+		info := &ShardMaintenanceInfo{}
+		if err = json.Unmarshal([]byte(v), info); err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
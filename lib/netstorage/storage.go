@@ -15,6 +15,7 @@
 package netstorage
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -28,12 +29,15 @@ import (
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"go.etcd.io/etcd/raft/v3/raftpb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -62,12 +66,26 @@ type Storage interface {
 
 	SendQueryRequestOnNode(nodeID uint64, req SysCtrlRequest) (map[string]string, error)
 	SendSysCtrlOnNode(nodID uint64, req SysCtrlRequest) (map[string]string, error)
+	ShardMaintenance(nodeID uint64, db string, ptIds []uint32, shardID uint64, action string) error
+	GetShardMaintenance(nodeID uint64) ([]*ShardMaintenanceInfo, error)
+	GetScrubStatus(nodeID uint64) ([]*ScrubInfo, error)
+	GetReplicaReadState(nodeID uint64, db string) (map[uint32]*ReplicaReadState, error)
+	GetRepairDigests(nodeID uint64, req *RepairDigestRequest) ([]*RepairDigest, error)
+	RepairSeries(nodeID uint64, req *RepairSeriesRequest) (*RepairSeriesResult, error)
+	StartPtCopy(nodeID uint64, req *PtCopyRequest) error
+	GetPtCopyStatus(nodeID uint64, db string, pt uint32) (*PtCopyStatus, error)
+	CancelPtCopy(nodeID uint64, db string, pt uint32, clean bool) error
+	GetPtLoads(nodeID uint64) ([]*PtLoad, error)
+	GetMeasurementStats(nodeID uint64, req *MeasurementStatsRequest) (*MeasurementStats, error)
+	ScrubFetcher
+	PtFileFetcher
 
 	GetShardSplitPoints(node *meta2.DataNode, database string, pt uint32,
 		shardId uint64, idxes []int64) ([]string, error)
 	DeleteDatabase(node *meta2.DataNode, database string, pt uint32) error
 	DeleteRetentionPolicy(node *meta2.DataNode, db string, rp string, pt uint32) error
 	DeleteMeasurement(node *meta2.DataNode, db string, rp string, name string, shardIds []uint64) error
+	DeleteSeries(nodeID uint64, db string, rp string, ptIds []uint32, measurements []string, condition influxql.Expr) error
 	MigratePt(nodeID uint64, data transport.Codec, cb transport.Callback) error
 
 	GetQueriesOnNode(nodeID uint64) ([]*QueryExeInfo, error)
@@ -93,6 +111,9 @@ type WriteContext struct {
 	Shard        *meta2.ShardInfo
 	Buf          []byte
 	StreamShards []uint64
+
+	// span of the write request exported by OpenTelemetry, nil if the write is not traced
+	TraceCtx context.Context
 }
 
 func NewNetStorage(mcli meta.MetaClient) Storage {
@@ -153,6 +174,31 @@ func (s *NetStorage) DeleteMeasurement(node *meta2.DataNode, db string, rp strin
 	return s.HandleDeleteReq(node, deleteReq)
 }
 
+func (s *NetStorage) DeleteSeries(nodeID uint64, db string, rp string, ptIds []uint32, measurements []string, condition influxql.Expr) error {
+	deleteReq := &DeleteRequest{
+		Type:         SeriesDelete,
+		Database:     db,
+		Rp:           rp,
+		PtIds:        ptIds,
+		Measurements: measurements,
+	}
+	if condition != nil {
+		deleteReq.Condition = condition.String()
+	}
+
+	v, err := s.ddlRequestWithNodeId(nodeID, DeleteRequestMessage, deleteReq)
+	if err != nil {
+		return err
+	}
+
+	resp, ok := v.(*DeleteResponse)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.DeleteResponse", v)
+	}
+
+	return resp.Err
+}
+
 func (s *NetStorage) DeleteRetentionPolicy(node *meta2.DataNode, db string, rp string, pt uint32) error {
 	deleteReq := &DeleteRequest{
 		Type:     RetentionPolicyDelete,
@@ -204,6 +250,16 @@ func (s *NetStorage) WriteRows(ctx *WriteContext, nodeID uint64, pt uint32, data
 		return err
 	}
 
+	if ctx.TraceCtx != nil {
+		var span trace.Span
+		r.traceCtx, span = tracing.StartOTelSpan(ctx.TraceCtx, "spdy write", trace.SpanKindClient,
+			attribute.Int64("node.id", int64(nodeID)), attribute.Int("pt.id", int(pt)),
+			attribute.Int64("shard.id", int64(ctx.Shard.ID)), attribute.Int("rows", len(rows)))
+		defer func() {
+			tracing.EndOTelSpan(span, err)
+		}()
+	}
+
 	if len(ctx.StreamShards) > 0 {
 		streamVars := make([]*StreamVar, len(rows))
 		for i := range rows {
@@ -386,7 +442,25 @@ func (s *NetStorage) ShowSeries(nodeID uint64, db string, ptIDs []uint32, measur
 }
 
 func (s *NetStorage) DropShard(nodeID uint64, database, rpName string, dbPts []uint32, shardID uint64) error {
-	return nil
+	deleteReq := &DeleteRequest{
+		Type:     ShardDelete,
+		Database: database,
+		Rp:       rpName,
+		ShardIds: []uint64{shardID},
+		PtIds:    dbPts,
+	}
+
+	v, err := s.ddlRequestWithNodeId(nodeID, DeleteRequestMessage, deleteReq)
+	if err != nil {
+		return err
+	}
+
+	resp, ok := v.(*DeleteResponse)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.DeleteResponse", v)
+	}
+
+	return resp.Err
 }
 
 func (s *NetStorage) SendQueryRequestOnNode(nodeID uint64, req SysCtrlRequest) (map[string]string, error) {
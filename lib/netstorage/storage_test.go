@@ -21,6 +21,8 @@ import (
 
 	"github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
@@ -158,3 +160,159 @@ func TestNetStorage_SetRaftMsg(t *testing.T) {
 	err = store.SendRaftMessages(1024, "db0", 0, raftpb.Message{})
 	assert.Equal(t, "no connections available, node: 1024, 192.168.0.1:8400", err.Error())
 }
+
+func TestShardMaintenanceRequest(t *testing.T) {
+	req := netstorage.NewShardMaintenanceRequest("db0", []uint32{1, 3}, 10, "compact")
+	require.Equal(t, netstorage.ShardMaintenanceMod, req.Mod())
+
+	db, pts, shardID, action, err := netstorage.ParseShardMaintenanceRequest(req)
+	require.NoError(t, err)
+	require.Equal(t, "db0", db)
+	require.Equal(t, []uint32{1, 3}, pts)
+	require.Equal(t, uint64(10), shardID)
+	require.Equal(t, "compact", action)
+
+	req.SetParam(map[string]string{"db": "db0", "pts": "1", "shard": "abc", "action": "flush"})
+	_, _, _, _, err = netstorage.ParseShardMaintenanceRequest(req)
+	require.Error(t, err)
+
+	req.SetParam(map[string]string{"db": "db0", "pts": "", "shard": "1", "action": "flush"})
+	_, _, _, _, err = netstorage.ParseShardMaintenanceRequest(req)
+	require.Error(t, err)
+}
+
+func TestScrubFetchSeriesRequest(t *testing.T) {
+	keys := [][]byte{[]byte("cpu,host=a"), {0, 1, ','}}
+	req := netstorage.NewScrubFetchSeriesRequest("db0", "rp0", 2, "cpu_0000", util.TimeRange{Min: 1, Max: 10}, keys)
+	require.Equal(t, netstorage.ScrubFetchSeriesMod, req.Mod())
+
+	db, rp, pt, mst, tr, got, err := netstorage.ParseScrubFetchSeriesRequest(req)
+	require.NoError(t, err)
+	require.Equal(t, "db0", db)
+	require.Equal(t, "rp0", rp)
+	require.Equal(t, uint32(2), pt)
+	require.Equal(t, "cpu_0000", mst)
+	require.Equal(t, util.TimeRange{Min: 1, Max: 10}, tr)
+	require.Equal(t, keys, got)
+
+	req.SetParam(map[string]string{"db": "db0", "rp": "rp0", "pt": "2", "mst": "cpu", "min": "1", "max": "10", "keys": "xyz"})
+	_, _, _, _, _, _, err = netstorage.ParseScrubFetchSeriesRequest(req)
+	require.Error(t, err)
+
+	req.SetParam(map[string]string{"db": "db0", "pt": "2", "mst": "cpu", "min": "1", "max": "10", "keys": "00"})
+	_, _, _, _, _, _, err = netstorage.ParseScrubFetchSeriesRequest(req)
+	require.Error(t, err)
+}
+
+func TestScrubSeriesEncoding(t *testing.T) {
+	schema := record.Schemas{
+		record.Field{Type: influx.Field_Type_Float, Name: "value"},
+		record.Field{Type: influx.Field_Type_Int, Name: "time"},
+	}
+	rec := record.NewRecordBuilder(schema)
+	rec.ColVals[0].AppendFloats(1.5, 2.5)
+	rec.ColVals[1].AppendIntegers(1, 2)
+
+	res := make(map[string]string)
+	netstorage.EncodeScrubSeries(res, []byte("cpu,host=a"), rec)
+	recs, err := netstorage.DecodeScrubSeries(res)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(recs))
+	got := recs["cpu,host=a"]
+	require.Equal(t, []float64{1.5, 2.5}, got.ColVals[0].FloatValues())
+	require.Equal(t, []int64{1, 2}, got.Times())
+
+	_, err = netstorage.DecodeScrubSeries(map[string]string{"zz": ""})
+	require.Error(t, err)
+}
+
+func TestRepairDigestRequest(t *testing.T) {
+	r := &netstorage.RepairDigestRequest{Db: "db0", Rp: "rp0", Pt: 2, Mst: "cpu", TimeRange: util.TimeRange{Min: 1, Max: 10},
+		Window: 5, PerSeries: true}
+	req := r.SysCtrlRequest()
+	require.Equal(t, netstorage.RepairDigestMod, req.Mod())
+	got, err := netstorage.ParseRepairDigestRequest(req)
+	require.NoError(t, err)
+	require.Equal(t, r, got)
+
+	req.SetParam(map[string]string{"db": "db0", "rp": "rp0", "pt": "2", "min": "1", "max": "10", "window": "5", "perSeries": "true"})
+	_, err = netstorage.ParseRepairDigestRequest(req)
+	require.EqualError(t, err, "measurement is required by the series digests")
+
+	req.SetParam(map[string]string{"db": "db0", "rp": "rp0", "pt": "2", "min": "1", "max": "10", "window": "0", "perSeries": "false"})
+	_, err = netstorage.ParseRepairDigestRequest(req)
+	require.Error(t, err)
+}
+
+func TestRepairSeriesRequest(t *testing.T) {
+	r := &netstorage.RepairSeriesRequest{Db: "db0", Rp: "rp0", Pt: 2, Mst: "cpu", TimeRange: util.TimeRange{Min: 1, Max: 10},
+		SourceNode: 3, SourcePt: 1, Keys: [][]byte{[]byte("cpu,host=a"), {0, 1, ','}}}
+	req := r.SysCtrlRequest()
+	require.Equal(t, netstorage.RepairSeriesMod, req.Mod())
+	got, err := netstorage.ParseRepairSeriesRequest(req)
+	require.NoError(t, err)
+	require.Equal(t, r, got)
+
+	req.SetParam(map[string]string{"db": "db0", "rp": "rp0", "pt": "2", "mst": "cpu", "min": "1", "max": "10",
+		"sourceNode": "x", "sourcePt": "1", "keys": "00"})
+	_, err = netstorage.ParseRepairSeriesRequest(req)
+	require.Error(t, err)
+}
+
+func TestRepairDigestsEncoding(t *testing.T) {
+	digests := []*netstorage.RepairDigest{
+		{Mst: "cpu", Window: 10, Series: 2, Rows: 5, Hash: 1},
+		{Mst: "cpu", Window: 0, Series: 1, Rows: 2, Hash: 2},
+		{Mst: "aaa", Key: "00", Series: 1, Rows: 1, Hash: 3},
+	}
+	res, err := netstorage.EncodeRepairDigests(digests)
+	require.NoError(t, err)
+	got, err := netstorage.DecodeRepairDigests(res)
+	require.NoError(t, err)
+	require.Equal(t, digests, got)
+
+	netstorage.SortRepairDigests(got)
+	require.Equal(t, "aaa", got[0].Mst)
+	require.Equal(t, int64(0), got[1].Window)
+	require.True(t, got[2].Equal(&netstorage.RepairDigest{Series: 2, Rows: 5, Hash: 1}))
+
+	_, err = netstorage.DecodeRepairDigests(map[string]string{"5": "{}"})
+	require.Error(t, err)
+}
+
+func TestPtCopyRequests(t *testing.T) {
+	r := &netstorage.PtCopyRequest{Db: "db0", Pt: 2, SourceNode: 3, Throughput: 1024, Final: true}
+	req := r.SysCtrlRequest()
+	require.Equal(t, netstorage.PtCopyMod, req.Mod())
+	got, err := netstorage.ParsePtCopyRequest(req)
+	require.NoError(t, err)
+	require.Equal(t, r, got)
+
+	req.SetParam(map[string]string{"db": "db0", "pt": "2", "sourceNode": "3", "throughput": "0", "final": "true"})
+	_, err = netstorage.ParsePtCopyRequest(req)
+	require.EqualError(t, err, `invalid throughput "0"`)
+
+	db, pt, checksum, err := netstorage.ParsePtFileManifestRequest(netstorage.NewPtFileManifestRequest("db0", 2, true))
+	require.NoError(t, err)
+	require.Equal(t, "db0", db)
+	require.Equal(t, uint32(2), pt)
+	require.True(t, checksum)
+
+	db, pt, path, offset, size, err := netstorage.ParsePtFileChunkRequest(netstorage.NewPtFileChunkRequest("db0", 2, "wal/1.wal", 10, 20))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"db0", uint32(2), "wal/1.wal", int64(10), int64(20)}, []interface{}{db, pt, path, offset, size})
+	_, _, _, _, _, err = netstorage.ParsePtFileChunkRequest(netstorage.NewPtFileChunkRequest("db0", 2, "wal/1.wal", -1, 20))
+	require.Error(t, err)
+
+	db, pt, clean, err := netstorage.ParsePtCopyCancelRequest(netstorage.NewPtCopyCancelRequest("db0", 2, true))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"db0", uint32(2), true}, []interface{}{db, pt, clean})
+
+	_, _, err = netstorage.ParsePtCopyStatusRequest(netstorage.NewPtCopyStatusRequest("", 2))
+	require.Error(t, err)
+
+	status := &netstorage.PtCopyStatus{State: netstorage.PtCopyRunning}
+	require.False(t, status.Finished())
+	status.State = netstorage.PtCopyCancelled
+	require.True(t, status.Finished())
+}
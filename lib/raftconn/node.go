@@ -43,6 +43,11 @@ const (
 	tickInterval    = 400 * time.Millisecond
 	maxProposeId    = math.MaxUint64 - 10000
 	identitySep     = "_"
+
+	// a follower keeps confirming the commit index of the leader by ReadIndex for followerReadActive
+	// after its read state is queried, the requests are sent once per tick at most
+	followerReadActive = 10 * time.Second
+	readIndexTimeout   = time.Second
 )
 
 type Commit struct {
@@ -103,6 +108,23 @@ type RaftNode struct {
 	Identity string // db_ptId
 
 	tolerateStartTime atomic.Int64
+
+	// used to serve the follower reads, see ReadState
+	leader       atomic.Bool
+	followerRead followerReadState
+}
+
+// followerReadState tracks the commit index of the leader confirmed by ReadIndex. Once a follower
+// has applied the index confirmed by a request, it contains all the writes committed by the leader
+// before the request was sent.
+type followerReadState struct {
+	mu          sync.Mutex
+	queriedAt   time.Time // the last time the read state is queried
+	requestedAt time.Time // the last time a ReadIndex request is sent
+	index       uint64    // the commit index confirmed by the last ReadIndex request
+	indexAt     int64     // the time the last confirmed ReadIndex request was sent
+	applied     uint64    // the largest index applied to the shards
+	caughtUpAt  int64
 }
 
 func StartNode(store *raftlog.RaftDiskStorage, nodeId uint64, database string, id uint64,
@@ -315,11 +337,15 @@ func (n *RaftNode) serveChannels() {
 			return
 		case <-n.tick.C:
 			n.node.Tick()
+			if !leader {
+				n.requestReadIndex()
+			}
 
 		case rd := <-n.node.Ready():
 			start := time.Now()
 			if rd.SoftState != nil {
 				leader = rd.RaftState == raft.StateLeader
+				n.leader.Store(leader)
 			}
 
 			if leader {
@@ -353,6 +379,8 @@ func (n *RaftNode) serveChannels() {
 				break
 			}
 
+			n.onReadStates(rd.ReadStates)
+
 			ok := n.PublishEntries(n.entriesToApply(rd.CommittedEntries))
 			if !ok {
 				n.Stop()
@@ -552,6 +580,94 @@ func (n *RaftNode) StepRaftMessage(msg []raftpb.Message) {
 	}
 }
 
+// requestReadIndex asks the leader to confirm its commit index if the follower reads are served recently
+func (n *RaftNode) requestReadIndex() {
+	f := &n.followerRead
+	now := time.Now()
+	f.mu.Lock()
+	if now.Sub(f.queriedAt) > followerReadActive || now.Sub(f.requestedAt) < tickInterval {
+		f.mu.Unlock()
+		return
+	}
+	f.requestedAt = now
+	f.mu.Unlock()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(n.ctx, readIndexTimeout)
+		defer cancel()
+		// the request time is carried by the request, it is returned with the confirmed commit index
+		if err := n.node.ReadIndex(ctx, encoding.MarshalUint64(nil, uint64(now.UnixNano()))); err != nil {
+			n.logger.Debug("request read index failed", zap.String("identity", n.Identity), zap.Error(err))
+		}
+	}()
+}
+
+// onReadStates records the commit indexes confirmed by the leader
+func (n *RaftNode) onReadStates(states []raft.ReadState) {
+	if len(states) == 0 {
+		return
+	}
+	f := &n.followerRead
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := range states {
+		if len(states[i].RequestCtx) != 8 {
+			continue
+		}
+		requestedAt := int64(encoding.UnmarshalUint64(states[i].RequestCtx))
+		if requestedAt > f.indexAt {
+			f.index, f.indexAt = states[i].Index, requestedAt
+		}
+	}
+	f.updateCaughtUp()
+}
+
+// SetStorageApplied is called after the committed entries up to index are written to the shards
+func (n *RaftNode) SetStorageApplied(index uint64) {
+	f := &n.followerRead
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if index > f.applied {
+		f.applied = index
+	}
+	f.updateCaughtUp()
+}
+
+// updateCaughtUp must be called with f.mu held
+func (f *followerReadState) updateCaughtUp() {
+	if f.indexAt > f.caughtUpAt && f.applied >= f.index {
+		f.caughtUpAt = f.indexAt
+	}
+}
+
+// ReadState returns the state used to decide whether the pt can serve the follower reads.
+// A follower contains all the writes committed by the leader before the last ReadIndex request
+// whose confirmed commit index it has applied. Querying the state of a follower keeps it
+// confirming the commit index of the leader for a while, see requestReadIndex.
+func (n *RaftNode) ReadState() netstorage.ReplicaReadState {
+	f := &n.followerRead
+	f.mu.Lock()
+	f.queriedAt = time.Now()
+	state := netstorage.ReplicaReadState{
+		Leader:  n.leader.Load(),
+		Commit:  f.index,
+		Applied: f.applied,
+	}
+	caughtUpAt := f.caughtUpAt
+	f.mu.Unlock()
+	if state.Leader {
+		return state
+	}
+
+	n.requestReadIndex()
+	if caughtUpAt == 0 {
+		state.Staleness = -1
+		return state
+	}
+	state.Staleness = time.Since(time.Unix(0, caughtUpAt)).Milliseconds()
+	return state
+}
+
 // ConfState would return the latest ConfState stored in node.
 func (n *RaftNode) ConfState() *raftpb.ConfState {
 	n.lock.RLock()
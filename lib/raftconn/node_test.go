@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/encoding"
 	set "github.com/deckarep/golang-set/v2"
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/toml"
@@ -57,6 +58,16 @@ func (m *mockRaftNodeTransfer) Step(ctx context.Context, msg raftpb.Message) err
 	return nil
 }
 
+type mockRaftNodeReadIndex struct {
+	mockRaftNodeTransfer
+	requests chan []byte
+}
+
+func (m *mockRaftNodeReadIndex) ReadIndex(ctx context.Context, rctx []byte) error {
+	m.requests <- rctx
+	return nil
+}
+
 func TestStepRaftMessages(t *testing.T) {
 	node := &RaftNode{
 		nodeId: 1,
@@ -69,6 +80,51 @@ func TestStepRaftMessages(t *testing.T) {
 	node.StepRaftMessage([]raftpb.Message{m1, m2})
 }
 
+func TestReadState(t *testing.T) {
+	raftNode := &mockRaftNodeReadIndex{requests: make(chan []byte, 4)}
+	node := &RaftNode{
+		nodeId: 1,
+		id:     1,
+		ctx:    context.TODO(),
+		node:   raftNode,
+		logger: logger.NewLogger(errno.ModuleUnknown),
+	}
+	// the follower has never caught up with the leader, querying the state asks the leader to confirm its commit index
+	require.Equal(t, int64(-1), node.ReadState().Staleness)
+	rctx := <-raftNode.requests
+
+	node.SetStorageApplied(3)
+	node.onReadStates([]raft.ReadState{{Index: 5, RequestCtx: rctx}})
+	require.Equal(t, int64(-1), node.ReadState().Staleness)
+
+	node.SetStorageApplied(5)
+	state := node.ReadState()
+	require.Equal(t, uint64(5), state.Commit)
+	require.Equal(t, uint64(5), state.Applied)
+	require.True(t, state.Staleness >= 0 && state.Staleness < 1000)
+
+	// the requests are sent once per tick at most
+	node.requestReadIndex()
+	require.Equal(t, 0, len(raftNode.requests))
+
+	// the staleness grows if the follower lags behind the leader
+	node.followerRead.caughtUpAt = time.Now().Add(-time.Minute).UnixNano()
+	node.followerRead.requestedAt = time.Time{}
+	require.True(t, node.ReadState().Staleness >= time.Minute.Milliseconds())
+	rctx = <-raftNode.requests
+	node.onReadStates([]raft.ReadState{{Index: 8, RequestCtx: rctx}})
+	require.True(t, node.ReadState().Staleness >= time.Minute.Milliseconds())
+	node.SetStorageApplied(8)
+	require.True(t, node.ReadState().Staleness < 1000)
+
+	// a stale response does not move the confirmed commit index back
+	node.onReadStates([]raft.ReadState{{Index: 6, RequestCtx: encoding.MarshalUint64(nil, 1)}})
+	require.Equal(t, uint64(8), node.ReadState().Commit)
+
+	node.leader.Store(true)
+	require.Equal(t, int64(0), node.ReadState().Staleness)
+}
+
 func TestSend_ToSelf(t *testing.T) {
 	node := &RaftNode{
 		nodeId: 1,
@@ -442,6 +498,9 @@ func (client *MockMetaClient) CreateMeasurement(database string, retentionPolicy
 func (client *MockMetaClient) AlterShardKey(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo) error {
 	return nil
 }
+func (client *MockMetaClient) AlterMeasurementTTL(database, retentionPolicy, mst string, ttl time.Duration) error {
+	return nil
+}
 func (client *MockMetaClient) CreateDatabase(name string, enableTagArray bool, replicaN uint32, options *obs.ObsOptions) (*meta2.DatabaseInfo, error) {
 	return nil, nil
 }
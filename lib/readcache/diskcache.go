@@ -0,0 +1,325 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readcache
+
+import (
+	"container/list"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/openGemini/openGemini/lib/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	diskBlockTmpSuffix = ".tmp"
+
+	DefaultDiskBlockSize int64 = 1024 * 1024
+)
+
+var diskBlockCacheIns *DiskBlockCache
+
+// SetDiskBlockCache sets the cache of the blocks read from the cold storage, nil disables it
+func SetDiskBlockCache(c *DiskBlockCache) {
+	diskBlockCacheIns = c
+}
+
+func GetDiskBlockCache() *DiskBlockCache {
+	return diskBlockCacheIns
+}
+
+type diskBlock struct {
+	file  string // hash of the remote file path
+	id    int64  // offset / blockSize
+	size  int64
+	owner *diskBlockFile
+}
+
+type diskBlockFile struct {
+	blocks map[int64]*list.Element
+}
+
+// DiskBlockCache is a LRU cache of the fixed size blocks of remote files, the blocks are stored in the local
+// directory so that the cache survives the restart.
+// The remote files are immutable, so a cached block never needs to be invalidated but by Remove
+type DiskBlockCache struct {
+	dir       string
+	blockSize int64
+	limit     int64
+
+	mu    sync.Mutex
+	lru   *list.List // front is the most recently used
+	files map[string]*diskBlockFile
+	size  int64
+
+	hitRecord cacheStat
+}
+
+// NewDiskBlockCache opens the cache in dir, and loads the blocks cached before the restart
+func NewDiskBlockCache(dir string, limit, blockSize int64) (*DiskBlockCache, error) {
+	if blockSize <= 0 {
+		blockSize = DefaultDiskBlockSize
+	}
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+
+	c := &DiskBlockCache{
+		dir:       dir,
+		blockSize: blockSize,
+		limit:     limit,
+		lru:       list.New(),
+		files:     make(map[string]*diskBlockFile),
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	evicted := c.evict()
+	c.mu.Unlock()
+	c.removeBlocks(evicted)
+	return c, nil
+}
+
+func (c *DiskBlockCache) load() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+
+	type loaded struct {
+		block *diskBlock
+		mtime int64
+	}
+	blocks := make([]loaded, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(name, diskBlockTmpSuffix) {
+			_ = os.Remove(filepath.Join(c.dir, name))
+			continue
+		}
+		file, id, ok := parseDiskBlockName(name)
+		if !ok {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		blocks = append(blocks, loaded{
+			block: &diskBlock{file: file, id: id, size: info.Size()},
+			mtime: info.ModTime().UnixNano(),
+		})
+	}
+
+	// the least recently written blocks are evicted first
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i].mtime < blocks[j].mtime })
+	for i := range blocks {
+		c.insert(blocks[i].block)
+	}
+	logger.GetLogger().Info("load disk block cache", zap.String("dir", c.dir),
+		zap.Int("blocks", len(blocks)), zap.Int64("size", c.size))
+	return nil
+}
+
+func (c *DiskBlockCache) BlockSize() int64 {
+	return c.blockSize
+}
+
+// Get returns the cached block id of the remote file path
+func (c *DiskBlockCache) Get(path string, id int64) ([]byte, bool) {
+	file := diskBlockFileKey(path)
+	c.mu.Lock()
+	elem, ok := c.lookup(file, id)
+	if ok {
+		c.lru.MoveToFront(elem)
+	}
+	c.mu.Unlock()
+
+	if ok {
+		buf, err := os.ReadFile(c.blockPath(file, id))
+		if err == nil {
+			c.recordHit(true)
+			return buf, true
+		}
+		// the block file is lost, drop it from the cache
+		c.mu.Lock()
+		if elem, ok = c.lookup(file, id); ok {
+			c.unlink(elem)
+		}
+		c.mu.Unlock()
+	}
+	c.recordHit(false)
+	return nil, false
+}
+
+// Contains checks if the block is cached without updating the recent-ness
+func (c *DiskBlockCache) Contains(path string, id int64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.lookup(diskBlockFileKey(path), id)
+	return ok
+}
+
+// Add caches the block id of the remote file path, the least recently used blocks are evicted if the cache is full
+func (c *DiskBlockCache) Add(path string, id int64, data []byte) error {
+	if int64(len(data)) > c.limit {
+		return nil
+	}
+	file := diskBlockFileKey(path)
+	name := c.blockPath(file, id)
+	tmp := name + diskBlockTmpSuffix
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, name); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	c.mu.Lock()
+	if elem, ok := c.lookup(file, id); ok {
+		c.unlink(elem)
+	}
+	c.insert(&diskBlock{file: file, id: id, size: int64(len(data))})
+	evicted := c.evict()
+	c.mu.Unlock()
+
+	c.removeBlocks(evicted)
+	return nil
+}
+
+// Remove drops all the cached blocks of the remote file path
+func (c *DiskBlockCache) Remove(path string) {
+	file := diskBlockFileKey(path)
+	c.mu.Lock()
+	bf, ok := c.files[file]
+	var removed []*diskBlock
+	if ok {
+		removed = make([]*diskBlock, 0, len(bf.blocks))
+		for _, elem := range bf.blocks {
+			removed = append(removed, elem.Value.(*diskBlock))
+			c.unlink(elem)
+		}
+	}
+	c.mu.Unlock()
+	c.removeBlocks(removed)
+}
+
+func (c *DiskBlockCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+func (c *DiskBlockCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *DiskBlockCache) GetHitRatio() float64 {
+	return c.hitRecord.getHitRatio()
+}
+
+func (c *DiskBlockCache) recordHit(isHit bool) {
+	atomic.AddInt64(&c.hitRecord.requests, 1)
+	if isHit {
+		atomic.AddInt64(&c.hitRecord.hitCount, 1)
+	}
+}
+
+func (c *DiskBlockCache) lookup(file string, id int64) (*list.Element, bool) {
+	bf, ok := c.files[file]
+	if !ok {
+		return nil, false
+	}
+	elem, ok := bf.blocks[id]
+	return elem, ok
+}
+
+func (c *DiskBlockCache) insert(b *diskBlock) {
+	bf, ok := c.files[b.file]
+	if !ok {
+		bf = &diskBlockFile{blocks: make(map[int64]*list.Element)}
+		c.files[b.file] = bf
+	}
+	b.owner = bf
+	bf.blocks[b.id] = c.lru.PushFront(b)
+	c.size += b.size
+}
+
+func (c *DiskBlockCache) unlink(elem *list.Element) {
+	b := elem.Value.(*diskBlock)
+	c.lru.Remove(elem)
+	c.size -= b.size
+	delete(b.owner.blocks, b.id)
+	if len(b.owner.blocks) == 0 {
+		delete(c.files, b.file)
+	}
+}
+
+func (c *DiskBlockCache) evict() []*diskBlock {
+	var evicted []*diskBlock
+	for c.size > c.limit {
+		elem := c.lru.Back()
+		if elem == nil {
+			break
+		}
+		evicted = append(evicted, elem.Value.(*diskBlock))
+		c.unlink(elem)
+	}
+	return evicted
+}
+
+func (c *DiskBlockCache) removeBlocks(blocks []*diskBlock) {
+	for _, b := range blocks {
+		if err := os.Remove(c.blockPath(b.file, b.id)); err != nil && !os.IsNotExist(err) {
+			logger.GetLogger().Warn("remove disk cache block failed", zap.Error(err))
+		}
+	}
+}
+
+func (c *DiskBlockCache) blockPath(file string, id int64) string {
+	return filepath.Join(c.dir, file+"_"+strconv.FormatInt(id, 10))
+}
+
+func diskBlockFileKey(path string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(path))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+func parseDiskBlockName(name string) (string, int64, bool) {
+	i := strings.IndexByte(name, '_')
+	if i != 16 {
+		return "", 0, false
+	}
+	id, err := strconv.ParseInt(name[i+1:], 10, 64)
+	if err != nil || id < 0 {
+		return "", 0, false
+	}
+	return name[:i], id, true
+}
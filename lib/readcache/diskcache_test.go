@@ -0,0 +1,69 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readcache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskBlockCache(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewDiskBlockCache(dir, 10, 4)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), c.BlockSize())
+
+	require.NoError(t, c.Add("obs://a", 0, []byte("0123")))
+	require.NoError(t, c.Add("obs://a", 1, []byte("4567")))
+	buf, ok := c.Get("obs://a", 0)
+	require.True(t, ok)
+	require.Equal(t, "0123", string(buf))
+	_, ok = c.Get("obs://b", 0)
+	require.False(t, ok)
+	require.Equal(t, float64(50), c.GetHitRatio())
+
+	// block 1 is the least recently used
+	require.NoError(t, c.Add("obs://b", 0, []byte("89a")))
+	require.False(t, c.Contains("obs://a", 1))
+	require.True(t, c.Contains("obs://a", 0))
+	require.Equal(t, int64(7), c.Size())
+
+	// the cached blocks survive the restart, the temporary files are dropped
+	require.NoError(t, os.WriteFile(filepath.Join(dir, diskBlockFileKey("obs://c")+"_0"+diskBlockTmpSuffix), []byte("x"), 0600))
+	c, err = NewDiskBlockCache(dir, 10, 4)
+	require.NoError(t, err)
+	require.Equal(t, 2, c.Len())
+	buf, ok = c.Get("obs://b", 0)
+	require.True(t, ok)
+	require.Equal(t, "89a", string(buf))
+	_, err = os.Stat(filepath.Join(dir, diskBlockFileKey("obs://c")+"_0"+diskBlockTmpSuffix))
+	require.True(t, os.IsNotExist(err))
+
+	c.Remove("obs://a")
+	require.False(t, c.Contains("obs://a", 0))
+	require.Equal(t, 1, c.Len())
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+
+	// a lost block file is a miss
+	require.NoError(t, os.Remove(c.blockPath(diskBlockFileKey("obs://b"), 0)))
+	_, ok = c.Get("obs://b", 0)
+	require.False(t, ok)
+	require.Equal(t, 0, c.Len())
+}
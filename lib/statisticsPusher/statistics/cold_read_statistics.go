@@ -0,0 +1,39 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+func init() {
+	NewCollector().Register(coldRead)
+}
+
+var coldRead = &ColdRead{}
+
+func NewColdRead() *ColdRead {
+	coldRead.enabled = true
+	return coldRead
+}
+
+// ColdRead is the statistics of the reads of the TSSP files on the cold storage
+type ColdRead struct {
+	BaseCollector
+
+	ReadTotal          *ItemInt64
+	ReadBytesTotal     *ItemInt64
+	CacheHitBytesTotal *ItemInt64
+	RemoteReadTotal    *ItemInt64
+	RemoteBytesTotal   *ItemInt64
+	PrefetchBytesTotal *ItemInt64
+	CacheSize          *ItemInt64
+}
@@ -16,12 +16,14 @@ package statistics
 
 import (
 	"math"
+	"sync"
 	"sync/atomic"
 	"time"
 )
 
 const (
 	OOOTimeDistributionMst = "ooo_time_distribution"
+	OOORatioMst            = "ooo_ratio"
 )
 
 var oooTimeDistribution *OOOTimeDistribution
@@ -35,6 +37,7 @@ func init() {
 
 	oooTimeDistribution.counts = make([]int64, len(oooTimeDistribution.intervals))
 	oooTimeDistribution.swap = make([]int64, len(oooTimeDistribution.intervals))
+	oooTimeDistribution.rows = make(map[oooRowsKey]*oooRows)
 }
 
 func NewOOOTimeDistribution() *OOOTimeDistribution {
@@ -48,6 +51,19 @@ type OOOTimeDistribution struct {
 	swap      []int64
 	intervals []int64
 	keys      []string
+
+	mu   sync.Mutex
+	rows map[oooRowsKey]*oooRows
+}
+
+type oooRowsKey struct {
+	db  string
+	mst string
+}
+
+type oooRows struct {
+	ordered   int64
+	unordered int64
 }
 
 func (d *OOOTimeDistribution) Add(interval int64, n int64) {
@@ -61,6 +77,26 @@ func (d *OOOTimeDistribution) Add(interval int64, n int64) {
 	}
 }
 
+// AddRows counts the ordered and unordered rows flushed of the measurement,
+// the out-of-order ratio of each measurement is collected as the ooo_ratio statistics
+func (d *OOOTimeDistribution) AddRows(db, mst string, ordered, unordered int64) {
+	if ordered == 0 && unordered == 0 {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := oooRowsKey{db: db, mst: mst}
+	item, ok := d.rows[key]
+	if !ok {
+		item = &oooRows{}
+		d.rows[key] = item
+	}
+	item.ordered += ordered
+	item.unordered += unordered
+}
+
 func (d *OOOTimeDistribution) Collect(buffer []byte) ([]byte, error) {
 	copy(d.swap, d.counts)
 
@@ -71,5 +107,26 @@ func (d *OOOTimeDistribution) Collect(buffer []byte) ([]byte, error) {
 	}
 
 	buffer = AddPointToBuffer(OOOTimeDistributionMst, d.tags, valueMap, buffer)
-	return buffer, nil
+	return d.collectRatio(buffer), nil
+}
+
+func (d *OOOTimeDistribution) collectRatio(buffer []byte) []byte {
+	d.mu.Lock()
+	rows := d.rows
+	d.rows = make(map[oooRowsKey]*oooRows, len(rows))
+	d.mu.Unlock()
+
+	tags := make(map[string]string, len(d.tags)+2)
+	AllocTagMap(tags, d.tags)
+	for key, item := range rows {
+		tags["database"] = key.db
+		tags["measurement"] = key.mst
+		valueMap := map[string]interface{}{
+			"ordered":   item.ordered,
+			"unordered": item.unordered,
+			"ratio":     float64(item.unordered) / float64(item.ordered+item.unordered),
+		}
+		buffer = AddPointToBuffer(OOORatioMst, tags, valueMap, buffer)
+	}
+	return buffer
 }
@@ -53,3 +53,41 @@ func TestOOOTimeDistribution(t *testing.T) {
 	}
 	require.NoError(t, compareBuffer(statistics.OOOTimeDistributionMst, tags, fields, buf))
 }
+
+func TestOOORatio(t *testing.T) {
+	tags := map[string]string{
+		"hostname": "localhost",
+		"app":      "store",
+	}
+	obj := statistics.NewOOOTimeDistribution()
+	obj.Init(tags)
+
+	obj.AddRows("db0", "cpu", 0, 0)
+	obj.AddRows("db0", "cpu", 20, 5)
+	obj.AddRows("db0", "cpu", 10, 5)
+
+	statistics.NewTimestamp().Init(time.Second)
+	buf, err := obj.Collect(nil)
+	require.NoError(t, err)
+
+	fields := map[string]interface{}{
+		"ordered":   int64(30),
+		"unordered": int64(10),
+		"ratio":     0.25,
+	}
+	compareRowIndex = 1
+	defer func() {
+		compareRowIndex = 0
+	}()
+	require.NoError(t, compareBuffer(statistics.OOORatioMst, map[string]string{
+		"hostname":    "localhost",
+		"app":         "store",
+		"database":    "db0",
+		"measurement": "cpu",
+	}, fields, buf))
+
+	// the counters are reset after collected
+	buf, err = obj.Collect(nil)
+	require.NoError(t, err)
+	require.NotContains(t, string(buf), statistics.OOORatioMst)
+}
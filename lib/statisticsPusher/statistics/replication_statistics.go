@@ -0,0 +1,96 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+const ReplicationMst = "cluster_replication"
+
+var replicationStat = &ReplicationStatistics{dbs: make(map[string]*ReplicationDBStat)}
+
+func NewReplicationStatistics() *ReplicationStatistics {
+	return replicationStat
+}
+
+// ReplicationStatistics collects the cross-cluster replication statistics of each database
+type ReplicationStatistics struct {
+	Metric
+
+	mu  sync.RWMutex
+	dbs map[string]*ReplicationDBStat
+}
+
+type ReplicationDBStat struct {
+	AppendedBytes   int64
+	ShippedBytes    int64
+	ShippedBatches  int64
+	FailedBatches   int64
+	RejectedBatches int64 // batches rejected by the peer, they are not sent again
+	LagBytes        int64 // bytes of the replication log not yet shipped
+	LagMs           int64 // age of the oldest write not yet shipped
+}
+
+func (s *ReplicationStatistics) Database(db string) *ReplicationDBStat {
+	s.mu.RLock()
+	stat, ok := s.dbs[db]
+	s.mu.RUnlock()
+	if ok {
+		return stat
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stat, ok = s.dbs[db]
+	if !ok {
+		stat = &ReplicationDBStat{}
+		s.dbs[db] = stat
+	}
+	return stat
+}
+
+func (s *ReplicationStatistics) Databases() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	dbs := make([]string, 0, len(s.dbs))
+	for db := range s.dbs {
+		dbs = append(dbs, db)
+	}
+	sort.Strings(dbs)
+	return dbs
+}
+
+func (s *ReplicationStatistics) Collect(buffer []byte) ([]byte, error) {
+	tags := make(map[string]string, len(s.tags)+1)
+	AllocTagMap(tags, s.tags)
+	for _, db := range s.Databases() {
+		stat := s.Database(db)
+		tags["database"] = db
+		valueMap := map[string]interface{}{
+			"appended_bytes":   atomic.LoadInt64(&stat.AppendedBytes),
+			"shipped_bytes":    atomic.LoadInt64(&stat.ShippedBytes),
+			"shipped_batches":  atomic.LoadInt64(&stat.ShippedBatches),
+			"failed_batches":   atomic.LoadInt64(&stat.FailedBatches),
+			"rejected_batches": atomic.LoadInt64(&stat.RejectedBatches),
+			"lag_bytes":        atomic.LoadInt64(&stat.LagBytes),
+			"lag_ms":           atomic.LoadInt64(&stat.LagMs),
+		}
+		buffer = AddPointToBuffer(ReplicationMst, tags, valueMap, buffer)
+	}
+	return buffer, nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+func init() {
+	NewCollector().Register(scrub)
+}
+
+var scrub = &Scrub{}
+
+func NewScrub() *Scrub {
+	scrub.enabled = true
+	return scrub
+}
+
+type Scrub struct {
+	BaseCollector
+
+	Rounds         *ItemInt64
+	FilesScrubbed  *ItemInt64
+	BytesScrubbed  *ItemInt64
+	CorruptFiles   *ItemInt64
+	RepairedFiles  *ItemInt64
+	RepairFailures *ItemInt64
+	LastDuration   *ItemInt64 // ms
+}
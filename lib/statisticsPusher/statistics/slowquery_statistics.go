@@ -15,6 +15,8 @@
 package statistics
 
 import (
+	"strings"
+	"sync"
 	"sync/atomic"
 
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics/opsStat"
@@ -31,8 +33,22 @@ const (
 	StatQueryBatch          = "queryBatch"
 	StatRpcDuration         = "rpcDuration"
 	StatChunkReaderDuration = "chunkReaderDuration"
+	StatColdReadCount       = "coldReadCount"
+	StatColdReadBytes       = "coldReadBytes"
+	StatColdRemoteBytes     = "coldRemoteBytes"
+	StatColdReadDuration    = "coldReadDuration"
+	StatSlowQueryUser       = "user"
+	StatScanSeries          = "scanSeries"
+	StatScanRows            = "scanRows"
+	StatScanBytes           = "scanBytes"
+	StatPlan                = "plan"
 )
 
+// ScanRecorder records the series, the rows and the bytes read by a query
+type ScanRecorder interface {
+	AddScan(series, rows, bytes int64)
+}
+
 // SQL Statistics
 type SQLSlowQueryStatistics struct {
 	TotalDuration    int64
@@ -43,12 +59,24 @@ type SQLSlowQueryStatistics struct {
 	QueryLocs        [][2]int // <stmtId, <startLoc, endLoc>>
 	DB               string
 	QueryBatch       int64
+	User             string
+
+	ScanSeries int64
+	ScanRows   int64
+	ScanBytes  int64
+
+	mu    sync.Mutex
+	plans []string
 }
 
 var SlowQueryTagMap map[string]string
 var SqlSlowQueryStatisticsName = "sql_slow_queries"
 var SlowQueries = make(chan *SQLSlowQueryStatistics, 256)
 
+// the slow queries of the ts-sql are recorded in the measurement query_history of the _internal database
+var QueryHistoryDatabase = "_internal"
+var QueryHistoryName = "query_history"
+
 func NewSqlSlowQueryStatistics(db string) *SQLSlowQueryStatistics {
 	return &SQLSlowQueryStatistics{
 		DB:        db,
@@ -122,6 +150,33 @@ func (s *SQLSlowQueryStatistics) SetDatabase(db string) {
 	}
 }
 
+func (s *SQLSlowQueryStatistics) SetUser(user string) {
+	if s != nil {
+		s.User = user
+	}
+}
+
+// AddScan adds the series, the rows and the bytes read by the stores for the query
+func (s *SQLSlowQueryStatistics) AddScan(series, rows, bytes int64) {
+	atomic.AddInt64(&s.ScanSeries, series)
+	atomic.AddInt64(&s.ScanRows, rows)
+	atomic.AddInt64(&s.ScanBytes, bytes)
+}
+
+// AddPlan adds the summary of the plan of a statement of the query
+func (s *SQLSlowQueryStatistics) AddPlan(plan string) {
+	s.mu.Lock()
+	s.plans = append(s.plans, plan)
+	s.mu.Unlock()
+}
+
+// Plan returns the summaries of the plans of the statements of the query
+func (s *SQLSlowQueryStatistics) Plan() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return strings.Join(s.plans, "; ")
+}
+
 func allocSqlSlowQueryValueMap(d *SQLSlowQueryStatistics) map[string]interface{} {
 	return map[string]interface{}{
 		StatTotalDuration:    atomic.LoadInt64(&d.TotalDuration),
@@ -191,6 +246,13 @@ type StoreSlowQueryStatistics struct {
 	RpcDuration         int64
 	ChunkReaderDuration int64
 	ChunkReaderCount    int64
+	ColdReadCount       int64
+	ColdReadBytes       int64
+	ColdRemoteBytes     int64
+	ColdReadDuration    int64
+	ScanSeries          int64
+	ScanRows            int64
+	ScanBytes           int64
 	Query               string
 	DB                  string
 }
@@ -229,7 +291,22 @@ func (s *StoreSlowQueryStatistics) AddDuration(durationName string, d int64) {
 }
 
 func (s *StoreSlowQueryStatistics) AddChunkReaderCount(count int64) {
-	s.ChunkReaderCount += count
+	atomic.AddInt64(&s.ChunkReaderCount, count)
+}
+
+// AddColdRead adds the reads of the files on the cold storage, remote is the bytes not hit by the disk cache
+func (s *StoreSlowQueryStatistics) AddColdRead(count, bytes, remote, d int64) {
+	atomic.AddInt64(&s.ColdReadCount, count)
+	atomic.AddInt64(&s.ColdReadBytes, bytes)
+	atomic.AddInt64(&s.ColdRemoteBytes, remote)
+	atomic.AddInt64(&s.ColdReadDuration, d)
+}
+
+// AddScan adds the series, the rows and the bytes read by the query
+func (s *StoreSlowQueryStatistics) AddScan(series, rows, bytes int64) {
+	atomic.AddInt64(&s.ScanSeries, series)
+	atomic.AddInt64(&s.ScanRows, rows)
+	atomic.AddInt64(&s.ScanBytes, bytes)
 }
 
 func (s *StoreSlowQueryStatistics) SetQuery(q string) {
@@ -250,6 +327,13 @@ func allocStoreSlowQueryValueMap(d *StoreSlowQueryStatistics) map[string]interfa
 		StatRpcDuration:         d.RpcDuration,
 		StatChunkReaderDuration: d.ChunkReaderDuration,
 		StatChunkReaderCount:    d.ChunkReaderCount,
+		StatColdReadCount:       d.ColdReadCount,
+		StatColdReadBytes:       d.ColdReadBytes,
+		StatColdRemoteBytes:     d.ColdRemoteBytes,
+		StatColdReadDuration:    d.ColdReadDuration,
+		StatScanSeries:          atomic.LoadInt64(&d.ScanSeries),
+		StatScanRows:            atomic.LoadInt64(&d.ScanRows),
+		StatScanBytes:           atomic.LoadInt64(&d.ScanBytes),
 		StatQuery:               d.Query,
 	}
 }
@@ -48,6 +48,22 @@ func TestResultCache(t *testing.T) {
 	runTest(t, "resultCache", "TotalCacheSize=1000", "InuseCacheSize=800", "CacheTotal=1")
 }
 
+func TestScrub(t *testing.T) {
+	obj := stat.NewScrub()
+	obj.FilesScrubbed.Add(3)
+	obj.CorruptFiles.Incr()
+
+	runTest(t, "scrub", "FilesScrubbed=3", "CorruptFiles=1")
+}
+
+func TestColdRead(t *testing.T) {
+	obj := stat.NewColdRead()
+	obj.ReadTotal.Add(2)
+	obj.RemoteBytesTotal.Add(1024)
+
+	runTest(t, "coldRead", "ReadTotal=2", "RemoteBytesTotal=1024")
+}
+
 func runTest(t *testing.T, mst string, contents ...string) {
 	col := stat.NewCollector()
 	col.SetGlobalTags(map[string]string{
@@ -27,11 +27,15 @@ var (
 	EnableSlidingWindowPushUp int64 = 0
 	EnableForceBroadcastQuery int64 = 0
 	OnSlidingWindowPushUp     int64 = 0
+	CostSlidingWindowPushUp   int64 = 2 // choose by the statistics of the measurement
 	OnPrintLogicalPlan        int64 = 1
 	OnForceBroadcastQuery     int64 = 1
 
 	querySchemaLimit int = 0 // query schema upper bound
 
+	queryMemoryLimit int64 = 0 // memory budget of the operators of one query, 0 means unlimited
+	querySpillDir          = ""
+
 	InterruptQuery       = false
 	UpperMemPct    int64 = 0
 )
@@ -76,6 +80,22 @@ func GetQuerySchemaLimit() int {
 	return querySchemaLimit
 }
 
+func SetQueryMemoryLimit(limit int64) {
+	atomic.StoreInt64(&queryMemoryLimit, limit)
+}
+
+func GetQueryMemoryLimit() int64 {
+	return atomic.LoadInt64(&queryMemoryLimit)
+}
+
+func SetQuerySpillDir(dir string) {
+	querySpillDir = dir
+}
+
+func GetQuerySpillDir() string {
+	return querySpillDir
+}
+
 func SetInterruptQuery(interrupt bool) {
 	logger.GetLogger().Info("SetInterruptQuery:", zap.Bool("InterruptQuery", interrupt))
 	InterruptQuery = interrupt
@@ -83,6 +83,9 @@ curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=force_broadcast_query&enabl
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=time_filter_protection&enabled=true'
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=disablewrite&switchon=true'
 curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=disableread&switchon=true'
+curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=replication&action=status'
+curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=replication&action=demote&timeout=30s'
+curl -i -XPOST 'http://127.0.0.1:8086/debug/ctrl?mod=replication&action=promote'
 */
 
 const (
@@ -113,6 +116,8 @@ const (
 	AbortBackup        = "abort_backup"
 
 	WriteStreamPointsEnable = "write_stream_points_enable"
+
+	ClusterReplication = "replication"
 )
 
 var (
@@ -136,6 +141,20 @@ var (
 	indexReadCachePersistent bool = true
 )
 
+// ReplicationController controls the cross-cluster replication of ts-sql.
+// A controlled failover demotes the primary, which drains its replication logs, then promotes the standby.
+type ReplicationController interface {
+	Status() (string, error)
+	Promote() error
+	Demote(timeout time.Duration) error
+}
+
+var replicationCtl ReplicationController
+
+func SetReplicationController(ctl ReplicationController) {
+	replicationCtl = ctl
+}
+
 func SetDisableWrite(en bool) {
 	DisableWrites = en
 	logger.GetLogger().Info("DisableWrites", zap.Bool("switch", en))
@@ -162,6 +181,11 @@ func SetQuerySchemaLimit(limit int) {
 	sysconfig.SetQuerySchemaLimit(limit)
 }
 
+func SetQueryMemoryLimit(limit int64, spillDir string) {
+	sysconfig.SetQueryMemoryLimit(limit)
+	sysconfig.SetQuerySpillDir(spillDir)
+}
+
 func SetQueryEnabledWhenExceedSeries(enabled bool) {
 	queryEnabledWhenExceedSeries = enabled
 }
@@ -414,7 +438,7 @@ func ProcessRequest(req netstorage.SysCtrlRequest, resp *strings.Builder) (err e
 		if err != nil {
 			return err
 		}
-		if enabled != 0 && enabled != 1 {
+		if enabled != 0 && enabled != 1 && enabled != sysconfig.CostSlidingWindowPushUp {
 			return fmt.Errorf("invalid enabled:%v", enabled)
 		}
 		sysconfig.SetEnableSlidingWindowPushUp(enabled)
@@ -500,6 +524,8 @@ func ProcessRequest(req netstorage.SysCtrlRequest, resp *strings.Builder) (err e
 			return err
 		}
 		return broadcastCmdToStore(req, resp)
+	case ClusterReplication:
+		return handleReplicationCmd(req, resp)
 	default:
 		return fmt.Errorf("unknown sysctrl mod: %v", req.Mod())
 	}
@@ -572,6 +598,42 @@ func sendCmdToStoreAsync(req netstorage.SysCtrlRequest, resp *strings.Builder, n
 func sendBackupCmdToStoreAsync(req netstorage.SysCtrlRequest, nid uint64, host string) {
 	_ = sendCmdToStore(req, nid, host)
 }
+func handleReplicationCmd(req netstorage.SysCtrlRequest, resp *strings.Builder) error {
+	if replicationCtl == nil {
+		return fmt.Errorf("cluster replication is not enabled")
+	}
+
+	switch action := req.Param()["action"]; action {
+	case "status":
+		status, err := replicationCtl.Status()
+		if err != nil {
+			return err
+		}
+		resp.WriteString(status)
+		return nil
+	case "promote":
+		if err := replicationCtl.Promote(); err != nil {
+			return err
+		}
+	case "demote":
+		timeout, err := GetDurationValue(req.Param(), "timeout")
+		if err == ErrNoSuchParam {
+			// use the drain-timeout of the configuration
+			timeout, err = 0, nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = replicationCtl.Demote(timeout); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid replication action:%v", action)
+	}
+	resp.WriteString("\n\tsuccess")
+	return nil
+}
+
 func handleLogRowsCmd(req netstorage.SysCtrlRequest) error {
 	switchon, err := GetBoolValue(req.Param(), "switchon")
 	if err != nil {
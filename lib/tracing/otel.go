@@ -0,0 +1,217 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	otelInstrumentationName = "github.com/openGemini/openGemini"
+
+	// SizeOfTraceContext is the size of the span context propagated by the spdy requests,
+	// 16 bytes trace id, 8 bytes span id and 1 byte trace flags
+	SizeOfTraceContext = 16 + 8 + 1
+)
+
+// the spans exported by OpenTelemetry, the ts-sql and the ts-store of ts-server share the exporter
+var otelExport = struct {
+	mu       sync.Mutex
+	refs     int
+	provider *sdktrace.TracerProvider
+	tracer   atomic.Pointer[trace.Tracer]
+}{}
+
+var noopSpan = trace.SpanFromContext(context.Background())
+
+// OpenOTel starts to export the spans of the queries and the writes to the OTLP collector
+func OpenOTel(conf config.Trace, service string) error {
+	if !conf.Enabled {
+		return nil
+	}
+	otelExport.mu.Lock()
+	defer otelExport.mu.Unlock()
+
+	if otelExport.provider != nil {
+		otelExport.refs++
+		return nil
+	}
+	exporter, err := newOTelExporter(conf)
+	if err != nil {
+		return err
+	}
+
+	var sampler = sdktrace.TraceIDRatioBased(conf.SampleRatio)
+	if conf.ParentBased {
+		sampler = sdktrace.ParentBased(sampler)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter,
+			sdktrace.WithBatchTimeout(time.Duration(conf.BatchTimeout)),
+			sdktrace.WithExportTimeout(time.Duration(conf.ExportTimeout)),
+			sdktrace.WithMaxQueueSize(conf.MaxQueueSize),
+			sdktrace.WithMaxExportBatchSize(conf.MaxExportBatchSize)),
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", service))),
+	)
+	tracer := provider.Tracer(otelInstrumentationName)
+	otelExport.provider = provider
+	otelExport.refs = 1
+	otelExport.tracer.Store(&tracer)
+	return nil
+}
+
+func newOTelExporter(conf config.Trace) (sdktrace.SpanExporter, error) {
+	if conf.Protocol == config.TraceProtocolHTTP {
+		opts := []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(conf.Endpoint),
+			otlptracehttp.WithURLPath(conf.URLPath),
+			otlptracehttp.WithTimeout(time.Duration(conf.ExportTimeout)),
+		}
+		if conf.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(context.Background(), opts...)
+	}
+
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(conf.Endpoint),
+		otlptracegrpc.WithTimeout(time.Duration(conf.ExportTimeout)),
+	}
+	if conf.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	return otlptracegrpc.New(context.Background(), opts...)
+}
+
+// CloseOTel flushes the spans not exported and stops the export after the last user closes it
+func CloseOTel() error {
+	otelExport.mu.Lock()
+	defer otelExport.mu.Unlock()
+
+	if otelExport.provider == nil {
+		return nil
+	}
+	otelExport.refs--
+	if otelExport.refs > 0 {
+		return nil
+	}
+	otelExport.tracer.Store(nil)
+	provider := otelExport.provider
+	otelExport.provider = nil
+	return provider.Shutdown(context.Background())
+}
+
+func OTelEnabled() bool {
+	return otelExport.tracer.Load() != nil
+}
+
+// StartOTelSpan starts a span as the child of the span in ctx, the span does nothing if the export is disabled
+func StartOTelSpan(ctx context.Context, name string, kind trace.SpanKind, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	tracer := otelExport.tracer.Load()
+	if tracer == nil {
+		return ctx, noopSpan
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return (*tracer).Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+}
+
+// SetOTelSpanError records the error of the operation of the span
+func SetOTelSpanError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// EndOTelSpan records the error of the operation and ends the span
+func EndOTelSpan(span trace.Span, err error) {
+	SetOTelSpanError(span, err)
+	span.End()
+}
+
+// ExtractHTTP returns a context with the remote span of the W3C traceparent header of the request
+func ExtractHTTP(ctx context.Context, header http.Header) context.Context {
+	if !OTelEnabled() {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// ContextWithOTelSpan returns dst with the span of src, dst is not canceled with src
+func ContextWithOTelSpan(dst, src context.Context) context.Context {
+	if src == nil {
+		return dst
+	}
+	span := trace.SpanFromContext(src)
+	if !span.SpanContext().IsValid() {
+		return dst
+	}
+	return trace.ContextWithSpan(dst, span)
+}
+
+// MarshalTraceContext encodes the span context of ctx to propagate it by the spdy requests,
+// nothing is propagated if the export is disabled
+func MarshalTraceContext(ctx context.Context) []byte {
+	if ctx == nil || !OTelEnabled() {
+		return nil
+	}
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+	traceID, spanID := sc.TraceID(), sc.SpanID()
+	buf := make([]byte, 0, SizeOfTraceContext)
+	buf = append(buf, traceID[:]...)
+	buf = append(buf, spanID[:]...)
+	return append(buf, byte(sc.TraceFlags()))
+}
+
+// ContextWithTraceContext returns a context with the remote span context decoded from buf
+func ContextWithTraceContext(ctx context.Context, buf []byte) context.Context {
+	if len(buf) != SizeOfTraceContext {
+		return ctx
+	}
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	copy(traceID[:], buf[:16])
+	copy(spanID[:], buf[16:24])
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.TraceFlags(buf[24]),
+		Remote:     true,
+	})
+	if !sc.IsValid() {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
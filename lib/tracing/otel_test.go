@@ -0,0 +1,182 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing_test
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	otlptrace "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testSpanID  = "00f067aa0ba902b7"
+)
+
+// collector is a stand-in of the OTLP collector, it keeps the spans exported by the grpc or the http protocol
+type collector struct {
+	collectortrace.UnimplementedTraceServiceServer
+
+	mu    sync.Mutex
+	spans []*otlptrace.Span
+}
+
+func (c *collector) Export(_ context.Context, req *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, rs := range req.ResourceSpans {
+		for _, ss := range rs.ScopeSpans {
+			c.spans = append(c.spans, ss.Spans...)
+		}
+	}
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	req := &collectortrace.ExportTraceServiceRequest{}
+	if err = proto.Unmarshal(body, req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	rsp, _ := c.Export(r.Context(), req)
+	buf, _ := proto.Marshal(rsp)
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(buf)
+}
+
+func (c *collector) span(name string) *otlptrace.Span {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, s := range c.spans {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+func newTestTraceConf(protocol, endpoint string) config.Trace {
+	conf := config.NewTrace()
+	conf.Enabled = true
+	conf.Protocol = protocol
+	conf.Endpoint = endpoint
+	conf.SampleRatio = 1
+	return conf
+}
+
+func TestOTel_Disabled(t *testing.T) {
+	require.False(t, tracing.OTelEnabled())
+	require.NoError(t, tracing.OpenOTel(config.NewTrace(), "ts-sql"))
+	require.False(t, tracing.OTelEnabled())
+
+	header := http.Header{}
+	header.Set("traceparent", "00-"+testTraceID+"-"+testSpanID+"-01")
+	ctx := tracing.ExtractHTTP(context.Background(), header)
+	require.False(t, trace.SpanContextFromContext(ctx).IsValid())
+
+	ctx, span := tracing.StartOTelSpan(ctx, "query", trace.SpanKindServer)
+	require.False(t, span.IsRecording())
+	require.Nil(t, tracing.MarshalTraceContext(ctx))
+	tracing.EndOTelSpan(span, io.EOF)
+	require.NoError(t, tracing.CloseOTel())
+}
+
+func TestOTel_Export(t *testing.T) {
+	grpcCollector := &collector{}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(server, grpcCollector)
+	go func() {
+		_ = server.Serve(ln)
+	}()
+	defer server.Stop()
+
+	httpCollector := &collector{}
+	httpServer := httptest.NewServer(httpCollector)
+	defer httpServer.Close()
+
+	for _, tc := range []struct {
+		conf      config.Trace
+		collector *collector
+	}{
+		{newTestTraceConf(config.TraceProtocolGRPC, ln.Addr().String()), grpcCollector},
+		{newTestTraceConf(config.TraceProtocolHTTP, httpServer.Listener.Addr().String()), httpCollector},
+	} {
+		t.Run(tc.conf.Protocol, func(t *testing.T) {
+			require.NoError(t, tracing.OpenOTel(tc.conf, "ts-sql"))
+			// ts-store of ts-server shares the export
+			require.NoError(t, tracing.OpenOTel(tc.conf, "ts-store"))
+			require.NoError(t, tracing.CloseOTel())
+			require.True(t, tracing.OTelEnabled())
+
+			header := http.Header{}
+			header.Set("traceparent", "00-"+testTraceID+"-"+testSpanID+"-01")
+			ctx, span := tracing.StartOTelSpan(tracing.ExtractHTTP(context.Background(), header), "query", trace.SpanKindServer)
+			require.True(t, span.IsRecording())
+
+			// the span context is propagated by the spdy request to ts-store
+			buf := tracing.MarshalTraceContext(ctx)
+			require.Equal(t, tracing.SizeOfTraceContext, len(buf))
+			remote := tracing.ContextWithTraceContext(context.Background(), buf)
+			require.True(t, trace.SpanContextFromContext(remote).IsRemote())
+			_, storeSpan := tracing.StartOTelSpan(remote, "store select", trace.SpanKindServer)
+			tracing.EndOTelSpan(storeSpan, io.EOF)
+			span.End()
+
+			// the spans not exported are flushed by the close
+			require.NoError(t, tracing.CloseOTel())
+			require.False(t, tracing.OTelEnabled())
+
+			query := tc.collector.span("query")
+			require.NotNil(t, query)
+			require.Equal(t, testTraceID, hex.EncodeToString(query.TraceId))
+			require.Equal(t, testSpanID, hex.EncodeToString(query.ParentSpanId))
+
+			store := tc.collector.span("store select")
+			require.NotNil(t, store)
+			require.Equal(t, testTraceID, hex.EncodeToString(store.TraceId))
+			require.Equal(t, query.SpanId, store.ParentSpanId)
+			require.Equal(t, otlptrace.Status_STATUS_CODE_ERROR, store.Status.Code)
+		})
+	}
+}
+
+func TestContextWithTraceContext(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, ctx, tracing.ContextWithTraceContext(ctx, nil))
+	// invalid trace id
+	require.Equal(t, ctx, tracing.ContextWithTraceContext(ctx, make([]byte, tracing.SizeOfTraceContext)))
+	require.Equal(t, ctx, tracing.ContextWithOTelSpan(ctx, nil))
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"go.uber.org/zap"
+)
+
+// planStatisticsTarget is a measurement queried by a statement and the tags whose cardinality the planner needs.
+type planStatisticsTarget struct {
+	mst  *influxql.Measurement
+	tags []string
+}
+
+// refreshPlanStatistics collects the statistics of the measurements queried by stmt into the
+// statistics catalog of the cost based planner. The statistics are collected in the background
+// unless wait is true, so that a select statement never waits for the stores.
+// Nothing is collected unless the plan statistics are enabled.
+func (e *StatementExecutor) refreshPlanStatistics(stmt *influxql.SelectStatement, wait bool) {
+	if !e.PlanStatistics || e.NetStorage == nil || e.MetaExecutor == nil || e.MetaClient == nil {
+		return
+	}
+
+	catalog := executor.GetStatisticsCatalog()
+	var wg sync.WaitGroup
+	for _, target := range planStatisticsTargets(stmt) {
+		m := target.mst
+		old := catalog.Get(m.Database, m.RetentionPolicy, m.Name)
+		if !catalog.Expired(m.Database, m.RetentionPolicy, m.Name) && hasTagStatistics(old, target.tags) {
+			continue
+		}
+		if catalog.Failed(m.Database, m.RetentionPolicy, m.Name) {
+			continue
+		}
+		if !catalog.StartRefresh(m.Database, m.RetentionPolicy, m.Name) {
+			continue
+		}
+
+		tags := target.tags
+		if old != nil {
+			for tag := range old.TagNDV {
+				tags = append(tags, tag)
+			}
+		}
+		wg.Add(1)
+		go func(m *influxql.Measurement, tags []string) {
+			defer wg.Done()
+			defer catalog.FinishRefresh(m.Database, m.RetentionPolicy, m.Name)
+
+			stats, err := e.collectMeasurementStatistics(m, uniqueStrings(tags))
+			if err != nil {
+				e.StmtExecLogger.Warn("failed to collect statistics of measurement",
+					zap.String("db", m.Database), zap.String("rp", m.RetentionPolicy), zap.String("mst", m.Name), zap.Error(err))
+				catalog.SetFailed(m.Database, m.RetentionPolicy, m.Name)
+				return
+			}
+			if err = e.collectMeasurementValues(m, stats); err != nil {
+				// the number of rows is estimated from the series
+				e.StmtExecLogger.Warn("failed to collect value range of measurement",
+					zap.String("db", m.Database), zap.String("rp", m.RetentionPolicy), zap.String("mst", m.Name), zap.Error(err))
+			}
+			catalog.Set(m.Database, m.RetentionPolicy, m.Name, stats)
+		}(m, tags)
+	}
+	if wait {
+		wg.Wait()
+	}
+}
+
+// collectMeasurementStatistics reads the series cardinality of the measurement and the number of
+// distinct values of the tags from the tsi index of all the stores.
+func (e *StatementExecutor) collectMeasurementStatistics(m *influxql.Measurement, tags []string) (*executor.MeasurementStatistics, error) {
+	mst, err := e.MetaClient.Measurement(m.Database, m.RetentionPolicy, m.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := executor.NewMeasurementStatistics()
+	lock := new(sync.Mutex)
+	err = e.MetaExecutor.EachDBNodes(m.Database, func(nodeID uint64, pts []uint32) error {
+		infos, err := e.NetStorage.SeriesCardinality(nodeID, m.Database, pts, []string{mst.Name}, nil)
+		if err != nil {
+			return err
+		}
+		var seriesN int64
+		for i := range infos {
+			for _, info := range infos[i].CardinalityInfos {
+				seriesN += int64(info.Cardinality)
+			}
+		}
+
+		ndv := make(map[string]int64, len(tags))
+		for _, tag := range tags {
+			tagKeys := map[string]map[string]struct{}{mst.Name: {tag: {}}}
+			res, err := e.NetStorage.TagValuesCardinality(nodeID, m.Database, pts, tagKeys, nil)
+			if err != nil {
+				return err
+			}
+			ndv[tag] = int64(res[m.Name])
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+		stats.SeriesN += seriesN
+		for tag, n := range ndv {
+			// the values of a tag are usually spread over all the nodes,
+			// so the largest one is the closest estimation of the distinct values.
+			if n > stats.TagNDV[tag] {
+				stats.TagNDV[tag] = n
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// collectMeasurementValues reads the number of rows and the value range of the numeric fields of the
+// measurement from the metadata of the files kept by the stores, no data is scanned at planning time.
+func (e *StatementExecutor) collectMeasurementValues(m *influxql.Measurement, stats *executor.MeasurementStatistics) error {
+	mst, err := e.MetaClient.Measurement(m.Database, m.RetentionPolicy, m.Name)
+	if err != nil {
+		return err
+	}
+
+	lock := new(sync.Mutex)
+	return e.MetaExecutor.EachDBNodes(m.Database, func(nodeID uint64, pts []uint32) error {
+		req := &netstorage.MeasurementStatsRequest{Db: m.Database, Rp: m.RetentionPolicy, Mst: mst.Name, Pts: pts}
+		res, err := e.NetStorage.GetMeasurementStats(nodeID, req)
+		if err != nil {
+			return err
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+		mergeMeasurementValues(res, stats)
+		return nil
+	})
+}
+
+// mergeMeasurementValues adds the rows of a node to the statistics and widens the value ranges of the fields.
+func mergeMeasurementValues(res *netstorage.MeasurementStats, stats *executor.MeasurementStatistics) {
+	if res == nil {
+		return
+	}
+	stats.RowN += res.Rows
+	for name, f := range res.Fields {
+		old, ok := stats.Fields[name]
+		if !ok {
+			stats.Fields[name] = &executor.FieldStatistics{Min: f.Min, Max: f.Max}
+			continue
+		}
+		old.Min = math.Min(old.Min, f.Min)
+		old.Max = math.Max(old.Max, f.Max)
+	}
+}
+
+func hasTagStatistics(stats *executor.MeasurementStatistics, tags []string) bool {
+	if stats == nil {
+		return false
+	}
+	for _, tag := range tags {
+		if _, ok := stats.TagNDV[tag]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// planStatisticsTargets returns the measurements of stmt and its subqueries with the tags used by
+// the GROUP BY clause and the condition.
+func planStatisticsTargets(stmt *influxql.SelectStatement) []*planStatisticsTarget {
+	var targets []*planStatisticsTarget
+	var walk func(stmt *influxql.SelectStatement)
+	walk = func(stmt *influxql.SelectStatement) {
+		var tags []string
+		for _, dim := range stmt.Dimensions {
+			if ref, ok := dim.Expr.(*influxql.VarRef); ok {
+				tags = append(tags, ref.Val)
+			}
+		}
+		influxql.WalkFunc(stmt.Condition, func(node influxql.Node) {
+			if ref, ok := node.(*influxql.VarRef); ok && ref.Val != "time" && (ref.Type == influxql.Tag || ref.Type == influxql.Unknown) {
+				tags = append(tags, ref.Val)
+			}
+		})
+		tags = uniqueStrings(tags)
+
+		for _, src := range stmt.Sources {
+			switch s := src.(type) {
+			case *influxql.Measurement:
+				if s.Name == "" || s.Regex != nil || s.Database == "" {
+					continue
+				}
+				targets = append(targets, &planStatisticsTarget{mst: s, tags: tags})
+			case *influxql.SubQuery:
+				walk(s.Statement)
+			}
+		}
+	}
+	walk(stmt)
+	return targets
+}
+
+func uniqueStrings(s []string) []string {
+	if len(s) == 0 {
+		return s
+	}
+	sort.Strings(s)
+	n := 1
+	for i := 1; i < len(s); i++ {
+		if s[i] != s[n-1] {
+			s[n] = s[i]
+			n++
+		}
+	}
+	return s[:n]
+}
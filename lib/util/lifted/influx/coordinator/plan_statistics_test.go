@@ -0,0 +1,84 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/coordinator"
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanStatisticsTargets(t *testing.T) {
+	stmt := mustParseSemiJoinSelect(t, "SELECT max(v) FROM (SELECT mean(value) AS v FROM db0.rp0.cpu WHERE region = 'r1' GROUP BY host, time(1m)) GROUP BY host")
+	targets := planStatisticsTargets(stmt)
+	require.Equal(t, 1, len(targets))
+	assert.Equal(t, "cpu", targets[0].mst.Name)
+	assert.Equal(t, []string{"host", "region"}, targets[0].tags)
+
+	stmt = mustParseSemiJoinSelect(t, "SELECT value FROM db0.rp0.mem WHERE zone = 'z1' AND value > 1")
+	targets = planStatisticsTargets(stmt)
+	require.Equal(t, 1, len(targets))
+	assert.Equal(t, "mem", targets[0].mst.Name)
+	assert.Equal(t, []string{"value", "zone"}, targets[0].tags)
+
+	stmt = mustParseSemiJoinSelect(t, "SELECT value FROM /c.*/")
+	assert.Equal(t, 0, len(planStatisticsTargets(stmt)))
+}
+
+func TestHasTagStatistics(t *testing.T) {
+	assert.False(t, hasTagStatistics(nil, nil))
+
+	stats := executor.NewMeasurementStatistics()
+	stats.TagNDV["host"] = 0
+	assert.True(t, hasTagStatistics(stats, []string{"host"}))
+	assert.False(t, hasTagStatistics(stats, []string{"host", "region"}))
+}
+
+func TestUniqueStrings(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, uniqueStrings([]string{"c", "a", "b", "a", "c"}))
+	assert.Equal(t, 0, len(uniqueStrings(nil)))
+}
+
+func TestMergeMeasurementValues(t *testing.T) {
+	stats := executor.NewMeasurementStatistics()
+	mergeMeasurementValues(nil, stats)
+	mergeMeasurementValues(&netstorage.MeasurementStats{
+		Rows:   100,
+		Fields: map[string]*executor.FieldStatistics{"value": {Min: 1.5, Max: 9.5}},
+	}, stats)
+	mergeMeasurementValues(&netstorage.MeasurementStats{
+		Rows:   20,
+		Fields: map[string]*executor.FieldStatistics{"value": {Min: 0.5, Max: 3}, "idle": {Min: 7, Max: 7}},
+	}, stats)
+	assert.Equal(t, int64(120), stats.RowN)
+	assert.Equal(t, &executor.FieldStatistics{Min: 0.5, Max: 9.5}, stats.Field("value"))
+	assert.Equal(t, &executor.FieldStatistics{Min: 7, Max: 7}, stats.Field("idle"))
+	assert.Nil(t, stats.Field("status"))
+}
+
+func TestRefreshPlanStatisticsDisabled(t *testing.T) {
+	catalog := executor.GetStatisticsCatalog()
+	defer catalog.Reset()
+
+	e := &StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}, MetaExecutor: &coordinator.MetaExecutor{}}
+	e.refreshPlanStatistics(mustParseSemiJoinSelect(t, "SELECT value FROM db0.rp0.cpu"), true)
+	assert.True(t, catalog.Expired("db0", "rp0", "cpu"))
+}
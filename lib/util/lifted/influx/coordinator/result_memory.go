@@ -0,0 +1,70 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+// resultMemoryTracker estimates the memory held by the results of a query on the sql node.
+// A chunked query streams every result to the client before the next one is produced, so
+// only the result being sent is held. Otherwise the http handler buffers all the results
+// until the query is finished.
+type resultMemoryTracker struct {
+	limit     int64
+	streaming bool
+
+	init              bool
+	byteSizePerSeries int
+	byteSizePerRow    int
+	size              int64
+}
+
+func newResultMemoryTracker(limit int64, streaming bool) *resultMemoryTracker {
+	return &resultMemoryTracker{limit: limit, streaming: streaming}
+}
+
+// Add accounts the result and returns an error if the memory held by the query exceeds the limit.
+func (t *resultMemoryTracker) Add(result *query.Result) error {
+	if t.limit <= 0 {
+		return nil
+	}
+	if !t.init {
+		if len(result.Series) == 0 || len(result.Series[0].Values) == 0 {
+			return nil
+		}
+		t.byteSizePerSeries, t.byteSizePerRow = getPerSeriesAndRowSize(result.Series[0])
+		t.init = true
+	}
+
+	if t.streaming {
+		// the previous results have been written to the client
+		t.size = 0
+	}
+	for i := range result.Series {
+		t.size += int64(t.byteSizePerSeries + len(result.Series[i].Values)*t.byteSizePerRow)
+	}
+	if t.size > t.limit {
+		return errno.NewError(errno.QueryMemoryLimitExceeded, t.size, t.limit)
+	}
+	return nil
+}
+
+func (t *resultMemoryTracker) Size() int64 {
+	return t.size
+}
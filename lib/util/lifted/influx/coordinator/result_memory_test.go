@@ -0,0 +1,60 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newResultMemoryTestResult(rows int) *query.Result {
+	values := make([][]interface{}, rows)
+	for i := range values {
+		values[i] = []interface{}{int64(i), float64(i)}
+	}
+	// series size: len("cpu") + len("time") + len("value") = 12, row size: 16
+	return &query.Result{Series: models.Rows{{Name: "cpu", Columns: []string{"time", "value"}, Values: values}}}
+}
+
+func TestResultMemoryTracker(t *testing.T) {
+	tracker := newResultMemoryTracker(100, false)
+	require.NoError(t, tracker.Add(&query.Result{}))
+	require.NoError(t, tracker.Add(newResultMemoryTestResult(3)))
+	assert.Equal(t, int64(12+3*16), tracker.Size())
+
+	err := tracker.Add(newResultMemoryTestResult(2))
+	require.Error(t, err)
+	assert.True(t, errno.Equal(err, errno.QueryMemoryLimitExceeded))
+	assert.Equal(t, int64(2*12+5*16), tracker.Size())
+
+	// a chunked query only holds the result being sent
+	tracker = newResultMemoryTracker(100, true)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, tracker.Add(newResultMemoryTestResult(5)))
+	}
+	assert.Equal(t, int64(12+5*16), tracker.Size())
+	require.Error(t, tracker.Add(newResultMemoryTestResult(6)))
+
+	// no limit
+	tracker = newResultMemoryTracker(0, false)
+	require.NoError(t, tracker.Add(newResultMemoryTestResult(1000)))
+}
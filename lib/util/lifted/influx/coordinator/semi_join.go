@@ -0,0 +1,497 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/coordinator"
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/index"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"go.uber.org/zap"
+)
+
+// hasSemiJoinCondition returns true if the condition contains an IN (SELECT ...) or EXISTS (SELECT ...) predicate.
+func hasSemiJoinCondition(cond influxql.Expr) bool {
+	found := false
+	influxql.WalkFunc(cond, func(node influxql.Node) {
+		switch node.(type) {
+		case *influxql.InCondition, *influxql.ExistsCondition:
+			found = true
+		}
+	})
+	return found
+}
+
+// rewriteSemiJoinConditions executes the subqueries of the semi/anti join predicates in the WHERE clause first,
+// and replaces each predicate with the distinct values it returned. The rewritten tag conditions are
+// pushed down to the tag filter search of each store like any other condition.
+// The predicates are executed from the one returning the fewest rows, and the others are skipped once
+// the condition is decided, as `false AND ...`.
+// It returns false if the condition can never be satisfied, so the statement does not need to be executed.
+func (e *StatementExecutor) rewriteSemiJoinConditions(stmt *influxql.SelectStatement, ctx *query.ExecutionContext) (bool, error) {
+	if stmt.Condition == nil || !hasSemiJoinCondition(stmt.Condition) {
+		return true, nil
+	}
+
+	if err := checkCorrelatedSemiJoin(stmt); err != nil {
+		return false, err
+	}
+	estimator := executor.NewPlanCostEstimator(executor.GetStatisticsCatalog())
+	cond := stmt.Condition
+	for {
+		next := e.nextSemiJoinCondition(cond, estimator, ctx)
+		if next == nil {
+			break
+		}
+		result, err := e.executeSemiJoinCondition(stmt, next, estimator, ctx)
+		if err != nil {
+			return false, err
+		}
+		cond = influxql.RewriteExpr(cond, func(expr influxql.Expr) influxql.Expr {
+			if expr == next {
+				return result
+			}
+			return expr
+		})
+
+		cond = influxql.Reduce(cond, nil)
+		if lit, ok := cond.(*influxql.BooleanLiteral); ok {
+			if !lit.Val {
+				return false, nil
+			}
+			cond = nil
+			break
+		}
+	}
+	stmt.Condition = cond
+	return true, nil
+}
+
+// explainSemiJoinConditions takes the semi join predicates out of the WHERE clause without executing their
+// subqueries, so that EXPLAIN does not run any query. It returns the subqueries, which are explained after the
+// plan of the statement, in the order they are written.
+func (e *StatementExecutor) explainSemiJoinConditions(stmt *influxql.SelectStatement, ctx *query.ExecutionContext) ([]*influxql.SelectStatement, error) {
+	if stmt.Condition == nil || !hasSemiJoinCondition(stmt.Condition) {
+		return nil, nil
+	}
+	if err := checkCorrelatedSemiJoin(stmt); err != nil {
+		return nil, err
+	}
+
+	var subqueries []*influxql.SelectStatement
+	cond := influxql.RewriteExpr(stmt.Condition, func(expr influxql.Expr) influxql.Expr {
+		var sub *influxql.SelectStatement
+		switch c := expr.(type) {
+		case *influxql.InCondition:
+			sub = c.Stmt
+		case *influxql.ExistsCondition:
+			sub = c.Stmt
+		default:
+			return expr
+		}
+		subqueries = append(subqueries, sub)
+		return &influxql.BooleanLiteral{Val: true}
+	})
+	for _, sub := range subqueries {
+		if err := e.NormalizeStatement(sub, ctx.Database, ctx.RetentionPolicy); err != nil {
+			return nil, err
+		}
+	}
+
+	cond = influxql.Reduce(cond, nil)
+	if _, ok := cond.(*influxql.BooleanLiteral); ok {
+		cond = nil
+	}
+	stmt.Condition = cond
+	return subqueries, nil
+}
+
+// checkCorrelatedSemiJoin rejects the semi join predicates whose subquery refers to the outer statement,
+// e.g. `EXISTS (SELECT value FROM mem WHERE mem.host = cpu.host)`. Their subqueries are executed once
+// before the statement, so they can not be evaluated for each outer row.
+func checkCorrelatedSemiJoin(stmt *influxql.SelectStatement) error {
+	outer := sourceQualifiers(stmt.Sources)
+	var err error
+	influxql.WalkFunc(stmt.Condition, func(node influxql.Node) {
+		var sub *influxql.SelectStatement
+		switch c := node.(type) {
+		case *influxql.InCondition:
+			sub = c.Stmt
+		case *influxql.ExistsCondition:
+			sub = c.Stmt
+		default:
+			return
+		}
+		if err != nil || sub.Condition == nil {
+			return
+		}
+
+		inner := sourceQualifiers(sub.Sources)
+		influxql.WalkFunc(sub.Condition, func(n influxql.Node) {
+			ref, ok := n.(*influxql.VarRef)
+			if !ok || err != nil {
+				return
+			}
+			i := strings.IndexByte(ref.Val, '.')
+			if i <= 0 {
+				return
+			}
+			qualifier := ref.Val[:i]
+			if _, ok := outer[qualifier]; !ok {
+				return
+			}
+			if _, ok := inner[qualifier]; !ok {
+				err = fmt.Errorf("correlated subquery is not supported: %s refers to the outer measurement %s", ref.Val, qualifier)
+			}
+		})
+	})
+	return err
+}
+
+// sourceQualifiers returns the names and aliases the columns of the measurements of sources may be qualified with.
+func sourceQualifiers(sources influxql.Sources) map[string]struct{} {
+	qualifiers := make(map[string]struct{}, len(sources))
+	for _, source := range sources {
+		switch src := source.(type) {
+		case *influxql.Measurement:
+			if src.Name != "" {
+				qualifiers[src.Name] = struct{}{}
+			}
+			if src.Alias != "" {
+				qualifiers[src.Alias] = struct{}{}
+			}
+		case *influxql.SubQuery:
+			if src.Alias != "" {
+				qualifiers[src.Alias] = struct{}{}
+			}
+		}
+	}
+	return qualifiers
+}
+
+// nextSemiJoinCondition returns the semi join predicate of cond whose subquery is estimated to return
+// the fewest rows. The predicates without statistics are executed last, in the order they are written.
+func (e *StatementExecutor) nextSemiJoinCondition(cond influxql.Expr, estimator *executor.PlanCostEstimator, ctx *query.ExecutionContext) influxql.Expr {
+	var next influxql.Expr
+	minRows := math.Inf(1)
+	influxql.WalkFunc(cond, func(node influxql.Node) {
+		var sub *influxql.SelectStatement
+		switch c := node.(type) {
+		case *influxql.InCondition:
+			sub = c.Stmt
+		case *influxql.ExistsCondition:
+			sub = c.Stmt
+		default:
+			return
+		}
+
+		rows := math.Inf(1)
+		if err := e.NormalizeStatement(sub, ctx.Database, ctx.RetentionPolicy); err == nil {
+			e.refreshPlanStatistics(sub, false)
+			if n, ok := estimator.EstimateStatementRows(sub); ok {
+				rows = n
+			}
+		}
+		if next == nil || rows < minRows {
+			next, minRows = node.(influxql.Expr), rows
+		}
+	})
+	return next
+}
+
+// executeSemiJoinCondition executes the subquery of a semi join predicate and returns the condition replacing it.
+func (e *StatementExecutor) executeSemiJoinCondition(stmt *influxql.SelectStatement, expr influxql.Expr, estimator *executor.PlanCostEstimator, ctx *query.ExecutionContext) (influxql.Expr, error) {
+	switch c := expr.(type) {
+	case *influxql.InCondition:
+		ref, ok := c.Column.(*influxql.VarRef)
+		if !ok {
+			return nil, fmt.Errorf("unsupported column of in condition: %s", c.Column)
+		}
+		e.restrictSemiJoin(stmt, c, ref, estimator)
+		rows, err := e.querySemiJoinRows(c.Stmt, ctx)
+		if err != nil {
+			return nil, err
+		}
+		values, err := semiJoinValues(rows, ref.Val, e.maxSemiJoinValues())
+		if err != nil {
+			return nil, err
+		}
+		return buildSemiJoinCondition(ref, values, c.NotIn), nil
+	case *influxql.ExistsCondition:
+		rows, err := e.querySemiJoinRows(c.Stmt, ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &influxql.BooleanLiteral{Val: semiJoinExists(rows) != c.NotExists}, nil
+	default:
+		return nil, fmt.Errorf("unsupported semi join condition: %s", expr)
+	}
+}
+
+// restrictSemiJoin makes the subquery of `tag IN (SELECT ... GROUP BY tag)` return only the groups of the
+// tag values of the outer measurement, if the subquery returns many more rows than the outer measurement
+// has values. The series without the tag are kept, as they match the outer series without the tag.
+func (e *StatementExecutor) restrictSemiJoin(stmt *influxql.SelectStatement, c *influxql.InCondition, ref *influxql.VarRef, estimator *executor.PlanCostEstimator) {
+	sub := c.Stmt
+	if c.NotIn || sub.Limit > 0 || sub.Offset > 0 || sub.SLimit > 0 || sub.SOffset > 0 ||
+		!hasTagDimension(sub, ref.Val) || len(stmt.Sources) != 1 {
+		return
+	}
+	m, ok := stmt.Sources[0].(*influxql.Measurement)
+	if !ok {
+		return
+	}
+	ndv := executor.GetStatisticsCatalog().GetByMeasurement(m).TagCardinality(ref.Val)
+	rows, ok := estimator.EstimateStatementRows(sub)
+	if !ok || !executor.PreferRestrictSemiJoin(ndv, rows) || ndv > int64(e.maxSemiJoinValues()) {
+		return
+	}
+
+	values, err := e.semiJoinTagValues(m, ref.Val)
+	if err != nil {
+		e.StmtExecLogger.Warn("failed to restrict semi join subquery", zap.String("stmt", sub.String()), zap.Error(err))
+		return
+	}
+	values = append(values, &influxql.StringLiteral{Val: ""})
+	restrict := buildSemiJoinCondition(&influxql.VarRef{Val: ref.Val, Type: influxql.Tag}, values, false)
+	if sub.Condition == nil {
+		sub.Condition = restrict
+		return
+	}
+	sub.Condition = &influxql.BinaryExpr{Op: influxql.AND, LHS: &influxql.ParenExpr{Expr: sub.Condition}, RHS: restrict}
+}
+
+// semiJoinTagValues returns the values of the tag in the measurement.
+func (e *StatementExecutor) semiJoinTagValues(m *influxql.Measurement, tag string) ([]influxql.Expr, error) {
+	show, err := query.RewriteStatement(&influxql.ShowTagValuesStatement{
+		Database:   m.Database,
+		Sources:    influxql.Sources{&influxql.Measurement{Database: m.Database, RetentionPolicy: m.RetentionPolicy, Name: m.Name}},
+		Op:         influxql.EQ,
+		TagKeyExpr: &influxql.StringLiteral{Val: tag},
+	})
+	if err != nil {
+		return nil, err
+	}
+	exec := coordinator.NewShowTagValuesExecutor(e.StmtExecLogger, e.MetaClient, e.MetaExecutor, e.NetStorage)
+	rows, err := exec.Execute(show.(*influxql.ShowTagValuesStatement))
+	if err != nil {
+		return nil, err
+	}
+	return semiJoinValues(rows, "value", e.maxSemiJoinValues())
+}
+
+func hasTagDimension(stmt *influxql.SelectStatement, tag string) bool {
+	for _, dim := range stmt.Dimensions {
+		if ref, ok := dim.Expr.(*influxql.VarRef); ok && ref.Val == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// maxSemiJoinValues limits the number of distinct values a subquery of an IN predicate may return.
+func (e *StatementExecutor) maxSemiJoinValues() int {
+	if e.MaxSemiJoinValues <= 0 {
+		return config.DefaultMaxSemiJoinValues
+	}
+	return e.MaxSemiJoinValues
+}
+
+// querySemiJoinRows executes the subquery of a semi join predicate and returns all of its rows.
+func (e *StatementExecutor) querySemiJoinRows(stmt *influxql.SelectStatement, ctx *query.ExecutionContext) (models.Rows, error) {
+	rows, err := e.queryStatementRows(ctx, stmt, ctx.ExecutionOptions)
+	if err != nil && ctx.Err() != nil {
+		e.StmtExecLogger.Info("semi join subquery aborted by user", zap.String("stmt", stmt.String()))
+	}
+	return rows, err
+}
+
+// queryStatementRows executes a select statement issued by the sql node itself and returns all of its rows.
+func (e *StatementExecutor) queryStatementRows(ctx context.Context, stmt *influxql.SelectStatement, opt query.ExecutionOptions) (models.Rows, error) {
+	if err := e.NormalizeStatement(stmt, opt.Database, opt.RetentionPolicy); err != nil {
+		return nil, err
+	}
+
+	proxy := newRowChanProxy()
+	pipelineExecutor, err := e.retryCreatePipelineExecutor(ctx, stmt, opt, proxy.rc)
+	if err == influxql.ErrDeclareEmptyCollection {
+		err = nil
+		pipelineExecutor = nil
+	}
+	if err != nil || pipelineExecutor == nil {
+		proxy.close()
+		return nil, err
+	}
+
+	ec := make(chan error, 1)
+	go func() {
+		var queryIndexState int32 = 0
+		ctxWithState := context.WithValue(context.Background(), index.QueryIndexState, &queryIndexState)
+		ec <- pipelineExecutor.ExecuteExecutor(ctxWithState)
+		close(ec)
+		proxy.close()
+	}()
+
+	var rows models.Rows
+	for {
+		select {
+		case rowsChan, ok := <-proxy.rc:
+			if !ok {
+				if err := <-ec; err != nil {
+					return nil, err
+				}
+				return rows, nil
+			}
+			rows = append(rows, rowsChan.Rows...)
+		case <-ctx.Done():
+			pipelineExecutor.Abort()
+			go proxy.wait()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// semiJoinValues collects the distinct values of column from the rows returned by a subquery.
+// The column is looked up in the series tags first (e.g. GROUP BY host), then in the selected columns.
+// If the subquery selects a single column of another name, that column is used.
+func semiJoinValues(rows models.Rows, column string, limit int) ([]influxql.Expr, error) {
+	seen := make(map[string]struct{})
+	var values []influxql.Expr
+	add := func(v influxql.Expr) error {
+		key := v.String()
+		if _, ok := seen[key]; ok {
+			return nil
+		}
+		if len(values) >= limit {
+			return fmt.Errorf("subquery of in condition returns more than %d values", limit)
+		}
+		seen[key] = struct{}{}
+		values = append(values, v)
+		return nil
+	}
+
+	for _, row := range rows {
+		if tag, ok := row.Tags[column]; ok {
+			if err := add(&influxql.StringLiteral{Val: tag}); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		idx := semiJoinColumnIndex(row.Columns, column)
+		if idx < 0 {
+			return nil, fmt.Errorf("subquery of in condition does not return column %s", column)
+		}
+		for _, value := range row.Values {
+			if idx >= len(value) {
+				continue
+			}
+			lit := semiJoinLiteral(value[idx])
+			if lit == nil {
+				continue
+			}
+			if err := add(lit); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].String() < values[j].String()
+	})
+	return values, nil
+}
+
+func semiJoinColumnIndex(columns []string, column string) int {
+	single := -1
+	for i, c := range columns {
+		if c == column {
+			return i
+		}
+		if c == "time" {
+			continue
+		}
+		if single == -1 {
+			single = i
+		} else {
+			single = -2
+		}
+	}
+	if single >= 0 {
+		return single
+	}
+	return -1
+}
+
+func semiJoinLiteral(v interface{}) influxql.Expr {
+	switch val := v.(type) {
+	case string:
+		return &influxql.StringLiteral{Val: val}
+	case float64:
+		return &influxql.NumberLiteral{Val: val}
+	case int64:
+		return &influxql.IntegerLiteral{Val: val}
+	case uint64:
+		return &influxql.UnsignedLiteral{Val: val}
+	case bool:
+		return &influxql.BooleanLiteral{Val: val}
+	default:
+		return nil
+	}
+}
+
+// semiJoinExists returns true if the subquery of an EXISTS predicate returned at least one row.
+func semiJoinExists(rows models.Rows) bool {
+	for _, row := range rows {
+		if len(row.Values) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// buildSemiJoinCondition turns `column IN (values)` into `column = v1 OR column = v2 ...`,
+// and `column NOT IN (values)` into `column != v1 AND column != v2 ...`.
+func buildSemiJoinCondition(column *influxql.VarRef, values []influxql.Expr, notIn bool) influxql.Expr {
+	if len(values) == 0 {
+		return &influxql.BooleanLiteral{Val: notIn}
+	}
+
+	op, join := influxql.Token(influxql.EQ), influxql.Token(influxql.OR)
+	if notIn {
+		op, join = influxql.Token(influxql.NEQ), influxql.Token(influxql.AND)
+	}
+	var cond influxql.Expr
+	for _, v := range values {
+		expr := &influxql.BinaryExpr{Op: op, LHS: &influxql.VarRef{Val: column.Val, Type: column.Type}, RHS: v}
+		if cond == nil {
+			cond = expr
+			continue
+		}
+		cond = &influxql.BinaryExpr{Op: join, LHS: cond, RHS: expr}
+	}
+	return &influxql.ParenExpr{Expr: cond}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	Logger "github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasSemiJoinCondition(t *testing.T) {
+	stmt := mustParseSemiJoinSelect(t, "SELECT value FROM cpu WHERE host IN (SELECT value FROM mem) AND region = 'east'")
+	assert.True(t, hasSemiJoinCondition(stmt.Condition))
+
+	stmt = mustParseSemiJoinSelect(t, "SELECT value FROM cpu WHERE NOT EXISTS (SELECT value FROM mem)")
+	assert.True(t, hasSemiJoinCondition(stmt.Condition))
+
+	stmt = mustParseSemiJoinSelect(t, "SELECT value FROM cpu WHERE region = 'east'")
+	assert.False(t, hasSemiJoinCondition(stmt.Condition))
+}
+
+func TestSemiJoinValues(t *testing.T) {
+	// values from the group by tags
+	rows := models.Rows{
+		{Name: "mem", Tags: map[string]string{"host": "h2"}, Columns: []string{"time", "max"}, Values: [][]interface{}{{int64(1), 1.0}}},
+		{Name: "mem", Tags: map[string]string{"host": "h1"}, Columns: []string{"time", "max"}, Values: [][]interface{}{{int64(1), 2.0}}},
+		{Name: "mem", Tags: map[string]string{"host": "h2"}, Columns: []string{"time", "max"}, Values: [][]interface{}{{int64(2), 3.0}}},
+	}
+	values, err := semiJoinValues(rows, "host", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []influxql.Expr{&influxql.StringLiteral{Val: "h1"}, &influxql.StringLiteral{Val: "h2"}}, values)
+
+	// values from the selected column with the same name
+	rows = models.Rows{
+		{Name: "mem", Columns: []string{"time", "host", "value"}, Values: [][]interface{}{{int64(1), "h3", 1.0}, {int64(2), nil, 1.0}, {int64(3), "h3", 2.0}}},
+	}
+	values, err = semiJoinValues(rows, "host", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []influxql.Expr{&influxql.StringLiteral{Val: "h3"}}, values)
+
+	// values from the only selected column
+	rows = models.Rows{
+		{Name: "mem", Columns: []string{"time", "distinct"}, Values: [][]interface{}{{int64(1), int64(7)}, {int64(2), int64(5)}}},
+	}
+	values, err = semiJoinValues(rows, "id", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []influxql.Expr{&influxql.IntegerLiteral{Val: 5}, &influxql.IntegerLiteral{Val: 7}}, values)
+
+	// ambiguous column
+	rows = models.Rows{
+		{Name: "mem", Columns: []string{"time", "a", "b"}, Values: [][]interface{}{{int64(1), "x", "y"}}},
+	}
+	_, err = semiJoinValues(rows, "host", 10)
+	assert.Error(t, err)
+
+	// too many values
+	rows = models.Rows{
+		{Name: "mem", Columns: []string{"time", "host"}, Values: [][]interface{}{{int64(1), "h1"}, {int64(2), "h2"}}},
+	}
+	_, err = semiJoinValues(rows, "host", 1)
+	assert.Error(t, err)
+}
+
+func TestBuildSemiJoinCondition(t *testing.T) {
+	ref := &influxql.VarRef{Val: "host"}
+	values := []influxql.Expr{&influxql.StringLiteral{Val: "h1"}, &influxql.StringLiteral{Val: "h2"}}
+
+	assert.Equal(t, "(host = 'h1' OR host = 'h2')", buildSemiJoinCondition(ref, values, false).String())
+	assert.Equal(t, "(host != 'h1' AND host != 'h2')", buildSemiJoinCondition(ref, values, true).String())
+	assert.Equal(t, "false", buildSemiJoinCondition(ref, nil, false).String())
+	assert.Equal(t, "true", buildSemiJoinCondition(ref, nil, true).String())
+}
+
+func TestStatementExecutor_MaxSemiJoinValues(t *testing.T) {
+	assert.Equal(t, config.DefaultMaxSemiJoinValues, (&StatementExecutor{}).maxSemiJoinValues())
+	assert.Equal(t, 5, (&StatementExecutor{MaxSemiJoinValues: 5}).maxSemiJoinValues())
+}
+
+func TestSemiJoinExists(t *testing.T) {
+	assert.False(t, semiJoinExists(nil))
+	assert.False(t, semiJoinExists(models.Rows{{Name: "mem"}}))
+	assert.True(t, semiJoinExists(models.Rows{{Name: "mem", Values: [][]interface{}{{int64(1), 1.0}}}}))
+}
+
+type semiJoinMetaClient struct {
+	MockMetaClient
+}
+
+func (m *semiJoinMetaClient) Database(name string) (*meta2.DatabaseInfo, error) {
+	return &meta2.DatabaseInfo{Name: name, DefaultRetentionPolicy: "rp0"}, nil
+}
+
+func TestNextSemiJoinCondition(t *testing.T) {
+	catalog := executor.GetStatisticsCatalog()
+	defer catalog.Reset()
+	mem := executor.NewMeasurementStatistics()
+	mem.SeriesN, mem.RowN = 100, 100000
+	catalog.Set("db0", "rp0", "mem", mem)
+	disk := executor.NewMeasurementStatistics()
+	disk.SeriesN, disk.RowN = 1, 10
+	catalog.Set("db0", "rp0", "disk", disk)
+
+	e := &StatementExecutor{MetaClient: &semiJoinMetaClient{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	ctx := &query.ExecutionContext{ExecutionOptions: query.ExecutionOptions{Database: "db0"}}
+	estimator := executor.NewPlanCostEstimator(catalog)
+
+	// the subquery returning the fewest rows is executed first
+	stmt := mustParseSemiJoinSelect(t, "SELECT value FROM cpu WHERE host IN (SELECT value FROM mem) AND host IN (SELECT value FROM disk)")
+	next, ok := e.nextSemiJoinCondition(stmt.Condition, estimator, ctx).(*influxql.InCondition)
+	require.True(t, ok)
+	assert.Equal(t, "disk", next.Stmt.Sources[0].(*influxql.Measurement).Name)
+	assert.Equal(t, "rp0", next.Stmt.Sources[0].(*influxql.Measurement).RetentionPolicy)
+
+	// the subqueries without statistics are executed last
+	stmt = mustParseSemiJoinSelect(t, "SELECT value FROM cpu WHERE EXISTS (SELECT value FROM net) AND host IN (SELECT value FROM mem)")
+	next, ok = e.nextSemiJoinCondition(stmt.Condition, estimator, ctx).(*influxql.InCondition)
+	require.True(t, ok)
+	assert.Equal(t, "mem", next.Stmt.Sources[0].(*influxql.Measurement).Name)
+
+	stmt = mustParseSemiJoinSelect(t, "SELECT value FROM cpu WHERE host = 'h1'")
+	assert.Nil(t, e.nextSemiJoinCondition(stmt.Condition, estimator, ctx))
+}
+
+func TestCheckCorrelatedSemiJoin(t *testing.T) {
+	stmt := mustParseSemiJoinSelect(t, "SELECT value FROM cpu WHERE EXISTS (SELECT value FROM mem WHERE mem.host = cpu.host)")
+	err := checkCorrelatedSemiJoin(stmt)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "correlated subquery is not supported")
+
+	stmt = mustParseSemiJoinSelect(t, "SELECT value FROM cpu AS c WHERE host IN (SELECT host FROM mem WHERE mem.region = c.region)")
+	assert.Error(t, checkCorrelatedSemiJoin(stmt))
+
+	// the subquery only refers to its own measurement
+	stmt = mustParseSemiJoinSelect(t, "SELECT value FROM cpu WHERE EXISTS (SELECT value FROM mem WHERE mem.host = 'h1')")
+	assert.NoError(t, checkCorrelatedSemiJoin(stmt))
+
+	stmt = mustParseSemiJoinSelect(t, "SELECT value FROM cpu WHERE EXISTS (SELECT value FROM cpu WHERE cpu.host = 'h1')")
+	assert.NoError(t, checkCorrelatedSemiJoin(stmt))
+}
+
+func TestExplainSemiJoinConditions(t *testing.T) {
+	e := &StatementExecutor{MetaClient: &semiJoinMetaClient{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	ctx := &query.ExecutionContext{ExecutionOptions: query.ExecutionOptions{Database: "db0"}}
+
+	// the predicates are taken out without executing the subqueries
+	stmt := mustParseSemiJoinSelect(t, "SELECT value FROM cpu WHERE host IN (SELECT value FROM mem) AND NOT EXISTS (SELECT value FROM disk) AND region = 'east'")
+	subqueries, err := e.explainSemiJoinConditions(stmt, ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(subqueries))
+	assert.Equal(t, "mem", subqueries[0].Sources[0].(*influxql.Measurement).Name)
+	assert.Equal(t, "rp0", subqueries[0].Sources[0].(*influxql.Measurement).RetentionPolicy)
+	assert.Equal(t, "disk", subqueries[1].Sources[0].(*influxql.Measurement).Name)
+	assert.Equal(t, "region = 'east'", stmt.Condition.String())
+
+	stmt = mustParseSemiJoinSelect(t, "SELECT value FROM cpu WHERE EXISTS (SELECT value FROM mem)")
+	subqueries, err = e.explainSemiJoinConditions(stmt, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(subqueries))
+	assert.Nil(t, stmt.Condition)
+
+	stmt = mustParseSemiJoinSelect(t, "SELECT value FROM cpu WHERE EXISTS (SELECT value FROM mem WHERE mem.host = cpu.host)")
+	_, err = e.explainSemiJoinConditions(stmt, ctx)
+	assert.Error(t, err)
+}
+
+func TestHasTagDimension(t *testing.T) {
+	stmt := mustParseSemiJoinSelect(t, "SELECT max(value) FROM mem GROUP BY host, time(1m)")
+	assert.True(t, hasTagDimension(stmt, "host"))
+	assert.False(t, hasTagDimension(stmt, "region"))
+}
+
+func mustParseSemiJoinSelect(t *testing.T, sql string) *influxql.SelectStatement {
+	yyParser := influxql.NewYyParser(influxql.NewScanner(strings.NewReader(sql)), nil)
+	yyParser.ParseTokens()
+	q, err := yyParser.GetQuery()
+	require.NoError(t, err)
+	return q.Statements[0].(*influxql.SelectStatement)
+}
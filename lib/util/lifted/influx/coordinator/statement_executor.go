@@ -91,12 +91,19 @@ type StatementExecutor struct {
 	MaxSelectBucketsN       int
 	MaxQueryMem             int64
 	MaxRowSizeLimit         int64
+	MaxSemiJoinValues       int
+	PlanStatistics          bool
+	ShowShardMaintenance    bool
 	QueryTimeCompareEnabled bool
 	RetentionPolicyLimit    int
 	MaxQueryParallel        int
 
 	StmtExecLogger *logger.Logger
 
+	// Replication appends the statements modifying the databases to the replication log
+	// before they are executed, it is nil if the cross-cluster replication is disabled
+	Replication *coordinator.ClusterReplicationManager
+
 	// hostname for show configs statement
 	Hostname   string
 	SqlConfigs map[string]interface{}
@@ -232,9 +239,12 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 	}
 
 	e.StmtExecLogger.Info("start execute statement", zap.Any("stmt", stmtString))
+	entry, err := e.Replication.BeginStatement(ctx.ReplicationSource, replicatedDatabase(stmt, ctx.Database), ctx.RetentionPolicy, stmtString)
+	if err != nil {
+		return err
+	}
 	var rows models.Rows
 	var messages []*query.Message
-	var err error
 	switch stmt := stmt.(type) {
 	case *influxql.AlterRetentionPolicyStatement:
 		if ctx.ReadOnly {
@@ -246,6 +256,11 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeAlterShardKeyStatement(stmt)
+	case *influxql.AlterMeasurementTTLStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeAlterMeasurementTTLStatement(stmt)
 	case *influxql.CreateDatabaseStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
@@ -281,7 +296,9 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		}
 		err = e.executeCreateUserStatement(stmt)
 	case *influxql.DeleteSeriesStatement:
-		return meta2.ErrUnsupportCommand
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
 		_, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.DropDatabaseStatement:
 		if ctx.ReadOnly {
@@ -294,7 +311,6 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		}
 		_, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.DropSeriesStatement:
-		return meta2.ErrUnsupportCommand
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
@@ -305,7 +321,15 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		}
 		_, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.DropShardStatement:
-		return meta2.ErrUnsupportCommand
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeDropShardStatement(stmt)
+	case *influxql.AlterShardStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeAlterShardStatement(stmt)
 	case *influxql.DropSubscriptionStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
@@ -374,6 +398,8 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		rows, err = e.executeShowShardsStatement(stmt)
 	case *influxql.ShowShardGroupsStatement:
 		rows, err = e.executeShowShardGroupsStatement(stmt)
+	case *influxql.ShowScrubStatusStatement:
+		rows, err = e.executeShowScrubStatusStatement()
 	case *influxql.ShowSubscriptionsStatement:
 		rows, err = e.executeShowSubscriptionsStatement(stmt)
 	case *influxql.ShowFieldKeysStatement:
@@ -448,6 +474,7 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		return query.ErrInvalidQuery
 	}
 
+	e.Replication.End(entry, err)
 	if err != nil {
 		return err
 	}
@@ -458,6 +485,33 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 	}, seq, nil)
 }
 
+// replicatedDatabase returns the database modified by the statement which is replicated
+// to the peer cluster, an empty string is returned if the statement is not replicated
+func replicatedDatabase(stmt influxql.Statement, defaultDB string) string {
+	switch stmt := stmt.(type) {
+	case *influxql.CreateDatabaseStatement:
+		return stmt.Name
+	case *influxql.DropDatabaseStatement:
+		return stmt.Name
+	case *influxql.CreateRetentionPolicyStatement:
+		return stmt.Database
+	case *influxql.AlterRetentionPolicyStatement:
+		return stmt.Database
+	case *influxql.DropRetentionPolicyStatement:
+		return stmt.Database
+	case *influxql.CreateMeasurementStatement:
+		return stmt.Database
+	case *influxql.AlterShardKeyStatement:
+		return stmt.Database
+	case *influxql.AlterMeasurementTTLStatement:
+		return stmt.Database
+	case *influxql.DropMeasurementStatement, *influxql.DeleteSeriesStatement, *influxql.DropSeriesStatement:
+		return defaultDB
+	default:
+		return ""
+	}
+}
+
 func (e *StatementExecutor) retryExecuteStatement(stmt influxql.Statement, ctx *query.ExecutionContext, seq int) (models.Rows, error) {
 	startTime := time.Now()
 	var retryNum uint32 = 0
@@ -474,6 +528,10 @@ func (e *StatementExecutor) retryExecuteStatement(stmt influxql.Statement, ctx *
 			err = e.executeDropDatabaseStatement(stmt)
 		case *influxql.DropMeasurementStatement:
 			err = e.executeDropMeasurementStatement(stmt, ctx.Database)
+		case *influxql.DeleteSeriesStatement:
+			err = e.executeDeleteSeriesStatement(stmt.Sources, stmt.Condition, ctx.Database)
+		case *influxql.DropSeriesStatement:
+			err = e.executeDropSeriesStatement(stmt, ctx.Database)
 		case *influxql.DropRetentionPolicyStatement:
 			err = e.executeDropRetentionPolicyStatement(stmt)
 		case *influxql.ShowTagKeysStatement:
@@ -674,7 +732,8 @@ func (e *StatementExecutor) executeCreateMeasurementStatement(stmt *influxql.Cre
 	if stmt.EngineType != "" && !ok {
 		return errors.New("ENGINETYPE \"" + stmt.EngineType + "\" IS NOT SUPPORTED!")
 	}
-	_, err = e.MetaClient.CreateMeasurement(stmt.Database, stmt.RetentionPolicy, stmt.Name, ski, int32(stmt.NumOfShards), indexR, engineType, colStoreInfo, schemaInfo, nil)
+	_, err = e.MetaClient.CreateMeasurementWithTTL(stmt.Database, stmt.RetentionPolicy, stmt.Name, ski, int32(stmt.NumOfShards), indexR, engineType,
+		colStoreInfo, schemaInfo, nil, stmt.TTL)
 	return err
 }
 
@@ -686,6 +745,10 @@ func (e *StatementExecutor) executeAlterShardKeyStatement(stmt *influxql.AlterSh
 	return e.MetaClient.AlterShardKey(stmt.Database, stmt.RetentionPolicy, stmt.Name, ski)
 }
 
+func (e *StatementExecutor) executeAlterMeasurementTTLStatement(stmt *influxql.AlterMeasurementTTLStatement) error {
+	return e.MetaClient.AlterMeasurementTTL(stmt.Database, stmt.RetentionPolicy, stmt.Name, stmt.TTL)
+}
+
 func (e *StatementExecutor) executeCreateDatabaseStatement(stmt *influxql.CreateDatabaseStatement) error {
 	if !meta2.ValidName(stmt.Name) {
 		// TODO This should probably be in `(*meta.Data).CreateDatabase`
@@ -875,6 +938,68 @@ func (e *StatementExecutor) executeDropMeasurementStatement(stmt *influxql.DropM
 	return e.MetaClient.MarkMeasurementDelete(database, stmt.RpName, stmt.Name)
 }
 
+func (e *StatementExecutor) executeDropSeriesStatement(stmt *influxql.DropSeriesStatement, database string) error {
+	if influxql.HasTimeExpr(stmt.Condition) {
+		return errors.New("DROP SERIES doesn't support time in WHERE clause")
+	}
+	return e.executeDeleteSeriesStatement(stmt.Sources, stmt.Condition, database)
+}
+
+// executeDeleteSeriesStatement deletes the rows of the series matching the tag condition in the time range of the condition.
+// The series are dropped from the index if there is no time condition.
+func (e *StatementExecutor) executeDeleteSeriesStatement(sources influxql.Sources, condition influxql.Expr, database string) error {
+	mis, err := e.MetaClient.MatchMeasurements(database, sources.Measurements())
+	if err != nil {
+		return err
+	}
+	if len(mis) == 0 {
+		return nil
+	}
+
+	if condition != nil {
+		// evaluate now() on the sql node, all the store nodes delete the same time range
+		condition = influxql.Reduce(condition, &influxql.NowValuer{Now: time.Now().UTC()})
+	}
+	// the measurements are matched by "rp.measurement", a measurement only deletes the rows of its retention policy
+	rpNames := make(map[string][]string)
+	for key, m := range mis {
+		if err = checkDeleteCondition(m, condition); err != nil {
+			return err
+		}
+		rp := strings.TrimSuffix(key, "."+m.Name)
+		rpNames[rp] = append(rpNames[rp], m.Name)
+	}
+
+	for rp, names := range rpNames {
+		err = e.MetaExecutor.EachDBNodes(database, func(nodeID uint64, pts []uint32) error {
+			return e.NetStorage.DeleteSeries(nodeID, database, rp, pts, names, condition)
+		})
+		if err != nil {
+			e.StmtExecLogger.Error("failed to delete series", zap.String("db", database), zap.String("rp", rp), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// checkDeleteCondition checks that the condition only references the tags and time
+func checkDeleteCondition(m *meta2.MeasurementInfo, condition influxql.Expr) error {
+	if condition == nil || m.Schema == nil {
+		return nil
+	}
+	var err error
+	influxql.WalkFunc(condition, func(n influxql.Node) {
+		ref, ok := n.(*influxql.VarRef)
+		if !ok || err != nil || ref.Val == "time" {
+			return
+		}
+		if typ, ok := m.Schema.GetTyp(ref.Val); ok && typ != influx.Field_Type_Tag {
+			err = errors.New("fields not supported in WHERE clause during deletion")
+		}
+	})
+	return err
+}
+
 func (e *StatementExecutor) executeDropRetentionPolicyStatement(stmt *influxql.DropRetentionPolicyStatement) error {
 	e.StmtExecLogger.Info("start delete rp ", zap.String("db", stmt.Database), zap.String("rp", stmt.Name))
 	dbi, _ := e.MetaClient.Database(stmt.Database)
@@ -896,6 +1021,64 @@ func (e *StatementExecutor) executeDropRetentionPolicyStatement(stmt *influxql.D
 	return nil
 }
 
+func (e *StatementExecutor) executeDropShardStatement(stmt *influxql.DropShardStatement) error {
+	e.StmtExecLogger.Info("start drop shard", zap.Uint64("shard", stmt.ID))
+	err := e.eachShardOwner(stmt.ID, func(nodeID uint64, db, rp string, pts []uint32) error {
+		return e.NetStorage.DropShard(nodeID, db, rp, pts, stmt.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// remove the shard from the meta data once the data of all owners is dropped,
+	// otherwise writes and queries are still routed to the dropped shard
+	if err = e.MetaClient.DropShard(stmt.ID); err != nil {
+		e.StmtExecLogger.Error("drop shard from meta error", zap.Uint64("shard", stmt.ID), zap.Error(err))
+		return err
+	}
+	e.StmtExecLogger.Info("suc drop shard", zap.Uint64("shard", stmt.ID))
+	return nil
+}
+
+func (e *StatementExecutor) executeAlterShardStatement(stmt *influxql.AlterShardStatement) error {
+	e.StmtExecLogger.Info("start shard maintenance", zap.Uint64("shard", stmt.ID), zap.String("action", stmt.Action))
+	return e.eachShardOwner(stmt.ID, func(nodeID uint64, db, _ string, pts []uint32) error {
+		return e.NetStorage.ShardMaintenance(nodeID, db, pts, stmt.ID, stmt.Action)
+	})
+}
+
+// eachShardOwner calls fn for every node holding the shard, pts are the partitions of the node owning the shard
+func (e *StatementExecutor) eachShardOwner(shardID uint64, fn func(nodeID uint64, db, rp string, pts []uint32) error) error {
+	db, rp, sgi := e.MetaClient.ShardOwner(shardID)
+	if sgi == nil {
+		return errno.NewError(errno.ShardNotFound, shardID)
+	}
+
+	var owners []uint32
+	for i := range sgi.Shards {
+		if sgi.Shards[i].ID == shardID {
+			owners = sgi.Shards[i].Owners
+			break
+		}
+	}
+
+	return e.MetaExecutor.EachDBNodes(db, func(nodeID uint64, pts []uint32) error {
+		var ownerPts []uint32
+		for _, pt := range pts {
+			for _, owner := range owners {
+				if pt == owner {
+					ownerPts = append(ownerPts, pt)
+					break
+				}
+			}
+		}
+		if len(ownerPts) == 0 {
+			return nil
+		}
+		return fn(nodeID, db, rp, ownerPts)
+	})
+}
+
 func (e *StatementExecutor) executeDropSubscriptionStatement(q *influxql.DropSubscriptionStatement) error {
 	if !config.GetSubscriptionEnable() {
 		return errors.New("subscription is not enabled")
@@ -908,13 +1091,51 @@ func (e *StatementExecutor) executeDropUserStatement(q *influxql.DropUserStateme
 }
 
 func (e *StatementExecutor) executeExplainStatement(q *influxql.ExplainStatement, ctx *query.ExecutionContext) (models.Rows, error) {
-	return nil, meta2.ErrUnsupportCommand
+	stmt := q.Statement
+	stmt.OmitTime = true
+	subqueries, err := e.explainSemiJoinConditions(stmt, ctx)
+	if err != nil {
+		return models.Rows{}, err
+	}
+	e.refreshPlanStatistics(stmt, true)
+
+	plan, err := executor.ExplainSelect(ctx.Context, stmt, e.ShardMapper, e.GetOptions(ctx.ExecutionOptions, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	row := &models.Row{
+		Columns: []string{"EXPLAIN"},
+	}
+	appendExplainPlan(row, plan)
+	for _, sub := range subqueries {
+		e.refreshPlanStatistics(sub, true)
+		subPlan, err := executor.ExplainSelect(ctx.Context, sub, e.ShardMapper, e.GetOptions(ctx.ExecutionOptions, nil))
+		if err != nil {
+			return nil, err
+		}
+		row.Values = append(row.Values, []interface{}{"semi join subquery: " + sub.String()})
+		appendExplainPlan(row, subPlan)
+	}
+	return models.Rows{row}, nil
+}
+
+func appendExplainPlan(row *models.Row, plan string) {
+	for _, s := range strings.Split(strings.TrimRight(plan, "\n"), "\n") {
+		if s == "" {
+			continue
+		}
+		row.Values = append(row.Values, []interface{}{s})
+	}
 }
 
 func (e *StatementExecutor) executeExplainAnalyzeStatement(q *influxql.ExplainStatement, ectx *query.ExecutionContext) (models.Rows, error) {
 	stmt := q.Statement
 	trace, span := tracing.NewTrace("SELECT")
 	stmt.OmitTime = true
+	if matched, err := e.rewriteSemiJoinConditions(stmt, ectx); err != nil || !matched {
+		return models.Rows{}, err
+	}
 	ctx := tracing.NewContextWithTrace(ectx.Context, trace)
 	ctx = tracing.NewContextWithSpan(ctx, span)
 	span.AppendNameValue("statement", q.String())
@@ -1018,8 +1239,14 @@ func (e *StatementExecutor) retryExecuteSelectStatement(stmt *influxql.SelectSta
 	var err error
 
 	for i := 0; i < maxRetrySelectCount; i++ {
-		err = e.executeSelectStatement(stmt, ctx, seq)
-		if err == nil || !errno.IsRetryErrorForPtView(err) {
+		emitted := false
+		err = e.executeSelectStatement(stmt, ctx, seq, &emitted)
+		if err == nil {
+			break
+		}
+		// with the replication HA policy, the shards are mapped to the live replicas again if a store
+		// fails in the middle of the query, as long as no rows have been sent to the client
+		if !errno.IsRetryErrorForPtView(err) && (emitted || !config.IsReplication() || !coordinator.IsRetriedError(err)) {
 			break
 		}
 		time.Sleep(retrySelectInterval * (1 << i))
@@ -1058,11 +1285,21 @@ func (e *StatementExecutor) retryCreatePipelineExecutor(ctx context.Context, stm
 	}
 }
 
-func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatement, ctx *query.ExecutionContext, seq int) error {
+func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatement, ctx *query.ExecutionContext, seq int, emitted *bool) error {
 	start := time.Now()
 	proxy := newRowChanProxy()
 	// omit Time field for stmt
 	stmt.OmitTime = true
+	if matched, err := e.rewriteSemiJoinConditions(stmt, ctx); err != nil || !matched {
+		proxy.close()
+		if err != nil {
+			return err
+		}
+		return ctx.Send(&query.Result{
+			Series: make([]*models.Row, 0),
+		}, seq, nil)
+	}
+	e.refreshPlanStatistics(stmt, false)
 	pipelineExecutor, err := e.retryCreatePipelineExecutor(ctx, stmt, ctx.ExecutionOptions, proxy.rc)
 	if err == influxql.ErrDeclareEmptyCollection {
 		// skip empty collection err and return empty result set
@@ -1081,7 +1318,6 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	}
 
 	end := time.Now()
-	emitted := false
 	closed := false
 
 	ec := make(chan error, 2)
@@ -1090,9 +1326,12 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	var ctxWithWriter context.Context
 	go func() {
 		defer wg.Done()
-		ctxWithWriter = context.WithValue(context.Background(), executor.WRITER_CONTEXT, ctx.PointsWriter)
+		ctxWithWriter = context.WithValue(tracing.ContextWithOTelSpan(context.Background(), ctx), executor.WRITER_CONTEXT, ctx.PointsWriter)
 		var queryIndexState int32 = 0
 		ctxWithWriter = context.WithValue(ctxWithWriter, index.QueryIndexState, &queryIndexState)
+		if qStat, _ := ctx.Value(query.QueryDurationKey).(*statistics.SQLSlowQueryStatistics); qStat != nil {
+			ctxWithWriter = context.WithValue(ctxWithWriter, query.SQLQueryStatKey, qStat)
+		}
 		ec <- pipelineExecutor.ExecuteExecutor(ctxWithWriter)
 		close(ec)
 		proxy.close()
@@ -1108,10 +1347,7 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 
 	var rowsChan query.RowsChan
 	var ok bool
-	var init bool
-	var byteSizePerRow int
-	var byteSizePerSeries int
-	var totalRowSize int
+	memTracker := newResultMemoryTracker(e.MaxRowSizeLimit, ctx.Chunked)
 	for {
 		select {
 		case rowsChan, ok = <-proxy.rc:
@@ -1124,6 +1360,18 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 				Partial: rowsChan.Partial,
 			}
 
+			// Fail fast before the result is buffered if the query holds too much memory.
+			if err := memTracker.Add(result); err != nil {
+				e.StmtExecLogger.Warn("the queried data volume exceeds the maximum memory threshold.",
+					zap.Float64("QueryMemory(Gb):", float64(memTracker.Size())/config.GB),
+					zap.Float64("MemoryThreshold(GB):", float64(e.MaxRowSizeLimit)/config.GB),
+					zap.Bool("chunked", ctx.Chunked),
+					zap.String("stmt", stmt.String()))
+				pipelineExecutor.Abort()
+				go proxy.wait()
+				return err
+			}
+
 			// Send results or exit if closing.
 			if err := ctx.Send(result, seq, ctxWithWriter); err != nil {
 				var abort, crash bool
@@ -1137,30 +1385,7 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 				e.StmtExecLogger.Error("send result rows failed", zap.Error(err), zap.Bool("crash", crash), zap.Bool("abort", abort))
 				return err
 			}
-			emitted = true
-			if e.MaxRowSizeLimit > 0 {
-				if !init {
-					if len(result.Series) > 0 && len(result.Series[0].Values) > 0 {
-						byteSizePerSeries, byteSizePerRow = getPerSeriesAndRowSize(result.Series[0])
-						init = true
-					}
-				}
-				for i := range result.Series {
-					totalRowSize += byteSizePerSeries
-					totalRowSize += len(result.Series[i].Values) * byteSizePerRow
-				}
-				if totalRowSize > int(e.MaxRowSizeLimit) {
-					e.StmtExecLogger.Warn("the queried data volume exceeds the maximum memory threshold.",
-						zap.Float64("QueryMemory(Gb):", float64(totalRowSize)/config.GB),
-						zap.Float64("MemoryThreshold(GB):", float64(e.MaxRowSizeLimit)/config.GB),
-						zap.String("stmt", stmt.String()))
-					pipelineExecutor.Abort()
-					// Always emit at least one result.
-					return ctx.Send(&query.Result{
-						Series: make([]*models.Row, 0),
-					}, seq, ctxWithWriter)
-				}
-			}
+			*emitted = true
 		case <-ctx.Done():
 			var abort, crash bool
 			if err := ctx.Err(); sysconfig.GetInterruptQuery() && err != nil && strings.Contains(err.Error(), query.ErrQueryTimeoutLimitExceeded.Error()) {
@@ -1186,7 +1411,7 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	}
 
 	// Always emit at least one result.
-	if !emitted {
+	if !*emitted {
 		return ctx.Send(&query.Result{
 			Series: make([]*models.Row, 0),
 		}, seq, ctxWithWriter)
@@ -1641,10 +1866,88 @@ func (e *StatementExecutor) executeShowContinuousQueriesStatement(q *influxql.Sh
 }
 
 func (e *StatementExecutor) executeShowShardsStatement(stmt *influxql.ShowShardsStatement) (models.Rows, error) {
+	var rows models.Rows
 	if stmt.GetMstInfo() == nil {
-		return e.MetaClient.ShowShards("", "", ""), nil
+		rows = e.MetaClient.ShowShards("", "", "")
+	} else {
+		rows = e.MetaClient.ShowShards(stmt.GetDBName(), stmt.GetRPName(), stmt.GetMstName())
+	}
+	if e.ShowShardMaintenance {
+		appendShardMaintenance(rows, e.getShardMaintenance())
+	}
+	return rows, nil
+}
+
+// getShardMaintenance collects the latest maintenance task of each shard from all data nodes
+func (e *StatementExecutor) getShardMaintenance() map[uint64]*netstorage.ShardMaintenanceInfo {
+	if e.NetStorage == nil {
+		return nil
+	}
+
+	nodes, err := e.MetaClient.DataNodes()
+	if err != nil {
+		e.StmtExecLogger.Error("failed to get data nodes", zap.Error(err))
+		return nil
+	}
+
+	tasks := make(map[uint64]*netstorage.ShardMaintenanceInfo)
+	for _, node := range nodes {
+		infos, err := e.NetStorage.GetShardMaintenance(node.ID)
+		if err != nil {
+			e.StmtExecLogger.Warn("failed to get shard maintenance", zap.Uint64("node", node.ID), zap.Error(err))
+			continue
+		}
+		for _, info := range infos {
+			// a running task of any replica takes precedence
+			if prev, ok := tasks[info.ShardID]; !ok || prev.State != netstorage.ShardMaintenanceRunning {
+				tasks[info.ShardID] = info
+			}
+		}
+	}
+	return tasks
+}
+
+func appendShardMaintenance(rows models.Rows, tasks map[uint64]*netstorage.ShardMaintenanceInfo) {
+	for _, row := range rows {
+		row.Columns = append(row.Columns, "maintenance", "state", "progress")
+		for i := range row.Values {
+			id, _ := row.Values[i][0].(uint64)
+			info, ok := tasks[id]
+			if !ok {
+				row.Values[i] = append(row.Values[i], "", "", nil)
+				continue
+			}
+			state := info.State
+			if info.Err != "" {
+				state += ": " + info.Err
+			}
+			row.Values[i] = append(row.Values[i], info.Action, state, info.Progress)
+		}
 	}
-	return e.MetaClient.ShowShards(stmt.GetDBName(), stmt.GetRPName(), stmt.GetMstName()), nil
+}
+
+// executeShowScrubStatusStatement collects the result of the last scrub of each shard from all data nodes
+func (e *StatementExecutor) executeShowScrubStatusStatement() (models.Rows, error) {
+	nodes, err := e.MetaClient.DataNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	row := &models.Row{Name: "scrub", Columns: []string{"node_id", "db", "pt", "shard_id", "last_scrub", "files", "bytes", "corrupt", "repaired"}}
+	for _, node := range nodes {
+		infos, err := e.NetStorage.GetScrubStatus(node.ID)
+		if err != nil {
+			e.StmtExecLogger.Warn("failed to get scrub status", zap.Uint64("node", node.ID), zap.Error(err))
+			continue
+		}
+		sort.Slice(infos, func(i, j int) bool { return infos[i].ShardID < infos[j].ShardID })
+		for _, info := range infos {
+			row.Values = append(row.Values, []interface{}{node.ID, info.Database, info.PtID, info.ShardID,
+				time.Unix(0, info.LastScrub).UTC().Format(time.RFC3339), info.Files, info.Bytes,
+				strings.Join(info.Corrupt, "; "), strings.Join(info.Repaired, "; ")})
+		}
+	}
+	return models.Rows{row}, nil
 }
 
 func (e *StatementExecutor) executeShowShardGroupsStatement(stmt *influxql.ShowShardGroupsStatement) (models.Rows, error) {
@@ -2345,6 +2648,10 @@ func (e *StatementExecutor) NormalizeStatement(stmt influxql.Statement, defaultD
 			if node.Database == "" {
 				node.Database = defaultDatabase
 			}
+		case *influxql.AlterMeasurementTTLStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
 		case *influxql.CreateDownSampleStatement:
 			if node.DbName == "" {
 				node.DbName = defaultDatabase
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/influxdata/influxdb/models"
 	"github.com/openGemini/openGemini/lib/errno"
 	Logger "github.com/openGemini/openGemini/lib/logger"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
@@ -14,6 +15,7 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
@@ -397,3 +399,112 @@ func TestStatementExecutor_NormalizeStatement(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestStatementExecutor_executeDropSeriesStatement(t *testing.T) {
+	e := newMockStatementExecutor()
+	stmt := &influxql.DropSeriesStatement{
+		Sources:   influxql.Sources{&influxql.Measurement{Name: "mst"}},
+		Condition: influxql.MustParseExpr("host = 'a' AND time > 0"),
+	}
+	err := e.executeDropSeriesStatement(stmt, "db0")
+	assert.EqualError(t, err, "DROP SERIES doesn't support time in WHERE clause")
+}
+
+func TestCheckDeleteCondition(t *testing.T) {
+	schema := meta2.NewCleanSchema(2)
+	schema.SetTyp("host", influx.Field_Type_Tag)
+	schema.SetTyp("value", influx.Field_Type_Float)
+	m := &meta2.MeasurementInfo{Name: "mst_0000", Schema: &schema}
+
+	assert.NoError(t, checkDeleteCondition(m, nil))
+	assert.NoError(t, checkDeleteCondition(m, influxql.MustParseExpr("host = 'a' AND time > 0")))
+	assert.EqualError(t, checkDeleteCondition(m, influxql.MustParseExpr("host = 'a' OR value > 1")),
+		"fields not supported in WHERE clause during deletion")
+}
+
+func (m *MockMetaClient) ShardOwner(shardID uint64) (string, string, *meta2.ShardGroupInfo) {
+	return "", "", nil
+}
+
+func (m *MockMetaClient) ShowShards(db string, rp string, mst string) models.Rows {
+	return models.Rows{{Columns: []string{"id", "database"}, Values: [][]interface{}{{uint64(1), "db0"}}}}
+}
+
+func (s *mockNS) GetShardMaintenance(nodeID uint64) ([]*netstorage.ShardMaintenanceInfo, error) {
+	if nodeID == 1 {
+		return nil, errors.New("node unavailable")
+	}
+	return []*netstorage.ShardMaintenanceInfo{
+		{ShardID: 1, Action: influxql.ShardActionCompact, State: netstorage.ShardMaintenanceRunning, Progress: float64(nodeID) * 10},
+		{ShardID: 2, Action: influxql.ShardActionRebuildIndex, State: netstorage.ShardMaintenanceFailed, Err: "shard closed"},
+	}, nil
+}
+
+func TestStatementExecutor_ShowShardsMaintenance(t *testing.T) {
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	tasks := e.getShardMaintenance()
+	assert.Equal(t, 2, len(tasks))
+	assert.Equal(t, netstorage.ShardMaintenanceRunning, tasks[1].State)
+
+	rows := models.Rows{{
+		Columns: []string{"id", "database"},
+		Values:  [][]interface{}{{uint64(1), "db0"}, {uint64(2), "db0"}, {uint64(3), "db0"}},
+	}}
+	appendShardMaintenance(rows, tasks)
+	assert.Equal(t, []string{"id", "database", "maintenance", "state", "progress"}, rows[0].Columns)
+	assert.Equal(t, []interface{}{uint64(1), "db0", "compact", "running", tasks[1].Progress}, rows[0].Values[0])
+	assert.Equal(t, []interface{}{uint64(2), "db0", "rebuild_index", "failed: shard closed", float64(0)}, rows[0].Values[1])
+	assert.Equal(t, []interface{}{uint64(3), "db0", "", "", nil}, rows[0].Values[2])
+
+	// the maintenance columns are only queried from the data nodes when enabled
+	rows, err := e.executeShowShardsStatement(&influxql.ShowShardsStatement{})
+	assert.NoError(t, err)
+	for _, row := range rows {
+		assert.NotContains(t, row.Columns, "maintenance")
+	}
+}
+
+func TestStatementExecutor_ShardNotFound(t *testing.T) {
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	err := e.executeDropShardStatement(&influxql.DropShardStatement{ID: 1})
+	assert.True(t, errno.Equal(err, errno.ShardNotFound))
+	err = e.executeAlterShardStatement(&influxql.AlterShardStatement{ID: 1, Action: influxql.ShardActionFlush})
+	assert.True(t, errno.Equal(err, errno.ShardNotFound))
+}
+
+func (s *mockNS) GetScrubStatus(nodeID uint64) ([]*netstorage.ScrubInfo, error) {
+	if nodeID == 1 {
+		return nil, errors.New("node unavailable")
+	}
+	return []*netstorage.ScrubInfo{
+		{Database: "db0", PtID: 1, ShardID: 3, Files: 2, Bytes: 100, Corrupt: []string{"a.tssp: crc mismatch", "b.wal: unknown record type"}, Repaired: []string{"a.tssp"}},
+		{Database: "db0", PtID: 1, ShardID: 2, Files: 4, Bytes: 200},
+	}, nil
+}
+
+func TestStatementExecutor_ShowScrubStatus(t *testing.T) {
+	e := StatementExecutor{MetaClient: &MockMetaClient{}, NetStorage: &mockNS{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	rows, err := e.executeShowScrubStatusStatement()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, []string{"node_id", "db", "pt", "shard_id", "last_scrub", "files", "bytes", "corrupt", "repaired"}, rows[0].Columns)
+	assert.Equal(t, 2*(dataNodesNum-1), len(rows[0].Values))
+	assert.Equal(t, []interface{}{uint64(2), "db0", uint32(1), uint64(2), "1970-01-01T00:00:00Z", 4, int64(200), "", ""}, rows[0].Values[0])
+	assert.Equal(t, []interface{}{uint64(2), "db0", uint32(1), uint64(3), "1970-01-01T00:00:00Z", 2, int64(100),
+		"a.tssp: crc mismatch; b.wal: unknown record type", "a.tssp"}, rows[0].Values[1])
+}
+
+func TestReplicatedDatabase(t *testing.T) {
+	for q, db := range map[string]string{
+		"CREATE DATABASE db1":                     "db1",
+		"DROP RETENTION POLICY rp0 ON db1":        "db1",
+		"DROP MEASUREMENT cpu":                    "db0",
+		"DELETE FROM cpu WHERE time < 1":          "db0",
+		"SELECT * FROM cpu":                       "",
+		"CREATE USER u0 WITH PASSWORD 'Pwd@1234'": "",
+	} {
+		stmt, err := influxql.ParseStatement(q)
+		assert.NoError(t, err)
+		assert.Equal(t, db, replicatedDatabase(stmt, "db0"), q)
+	}
+}
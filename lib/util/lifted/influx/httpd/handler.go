@@ -23,6 +23,7 @@ import (
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/uuid"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/openGemini/openGemini/coordinator"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	compression "github.com/openGemini/openGemini/lib/compress"
 	config2 "github.com/openGemini/openGemini/lib/config"
@@ -38,6 +39,7 @@ import (
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/sysconfig"
 	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/auth"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
@@ -50,6 +52,8 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/prompb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
 )
@@ -174,12 +178,18 @@ type Handler struct {
 
 	PointsWriter interface {
 		RetryWritePointRows(database, retentionPolicy string, points []influx.Row) error
+		RetryWritePointRowsContext(ctx context.Context, database, retentionPolicy string, points []influx.Row) error
 	}
 
 	RecordWriter interface {
 		RetryWriteLogRecord(rec *record.BulkRecords) error
 	}
 
+	// QueryHistory records the slow queries
+	QueryHistory interface {
+		Record(stat *statistics.SQLSlowQueryStatistics)
+	}
+
 	SubscriberManager
 
 	Config           *config.Config
@@ -1079,16 +1089,28 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 	}
 
 	db := r.FormValue("db")
+	traceCtx, span := tracing.StartOTelSpan(tracing.ExtractHTTP(r.Context(), r.Header), "query", trace.SpanKindServer)
+	defer span.End()
+	if span.IsRecording() {
+		span.SetAttributes(attribute.String("db.name", db), attribute.String("db.statement", q.String()))
+	}
+
 	var qDuration *statistics.SQLSlowQueryStatistics
 	if !isInternalDatabase(db) {
 		qDuration = statistics.NewSqlSlowQueryStatistics(db)
+		if user != nil {
+			qDuration.SetUser(user.ID())
+		}
 		defer func() {
 			d := time.Now().Sub(start)
-			if d.Nanoseconds() > time.Second.Nanoseconds()*10 {
+			if slow := time.Duration(h.Config.SlowQueryTime); slow > 0 && d > slow {
 				qDuration.AddDuration("TotalDuration", d.Nanoseconds())
 				statistics.AppendSqlQueryDuration(qDuration)
 				h.Logger.Info("slow query", zap.Duration("duration", d), zap.String("db", qDuration.DB),
 					zap.String("query", qDuration.Query))
+				if h.QueryHistory != nil {
+					h.QueryHistory.Record(qDuration)
+				}
 			}
 		}()
 	}
@@ -1123,6 +1145,8 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 		ParallelQuery:      atomic.LoadInt32(&syscontrol.ParallelQueryInBatch) == 1,
 		Quiet:              true,
 		Authorizer:         h.getAuthorizer(user),
+		TraceContext:       traceCtx,
+		ReplicationSource:  r.Header.Get(coordinator.ReplicationSourceHeader) != "",
 	}
 
 	// Make sure if the client disconnects we signal the query to abort
@@ -1317,6 +1341,13 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 		return
 	}
 
+	traceCtx, span := tracing.StartOTelSpan(tracing.ExtractHTTP(r.Context(), r.Header), "write", trace.SpanKindServer,
+		attribute.String("db.name", database))
+	defer span.End()
+
+	// the writes shipped by the replication of the peer cluster are not replicated again
+	traceCtx = coordinator.WithReplicationSource(traceCtx, r.Header)
+
 	if h.Config.AuthEnabled {
 		if user == nil {
 			h.httpError(w, fmt.Sprintf("user is required to write to database %q", database), http.StatusForbidden)
@@ -1403,7 +1434,7 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 			if atomic.LoadInt32(&syscontrol.LogRowsRuleSwitch) == 1 {
 				h.logRowsIfNecessary(rows, uw.ReqBuf)
 			}
-			if err = h.PointsWriter.RetryWritePointRows(db, rp, rows); err != nil {
+			if err = h.PointsWriter.RetryWritePointRowsContext(traceCtx, db, rp, rows); err != nil {
 				ctx.ErrLock.Lock()
 				if ctx.CallbackErr == nil {
 					ctx.CallbackErr = err
@@ -1431,6 +1462,7 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 		numPtsInsert++
 	}
 	ctx.Wg.Wait()
+	tracing.SetOTelSpanError(span, ctx.CallbackErr)
 	if err := ctx.Error(); err != nil {
 		h.Logger.Error("write error:read body ", zap.Error(err), zap.String("db", database))
 		h.httpError(w, err.Error(), http.StatusBadRequest)
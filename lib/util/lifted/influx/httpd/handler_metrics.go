@@ -42,7 +42,7 @@ const (
 var (
 	openGeminiCollector *OpenGeminiCollector
 	metricMsts          = []string{"httpd", "performance", "io", "executor", "runtime",
-		"spdy", "filestat_level", "errno", "compact", "merge", "hotMode", "resultCache"}
+		"spdy", "filestat_level", "errno", "compact", "merge", "hotMode", "resultCache", "scrub", "coldRead"}
 )
 
 func init() {
@@ -285,6 +285,7 @@ func (*CreateContinuousQueryStatement) node()      {}
 func (*CreateDatabaseStatement) node()             {}
 func (*CreateMeasurementStatement) node()          {}
 func (*AlterShardKeyStatement) node()              {}
+func (*AlterMeasurementTTLStatement) node()        {}
 func (*CreateRetentionPolicyStatement) node()      {}
 func (*CreateSubscriptionStatement) node()         {}
 func (*CreateUserStatement) node()                 {}
@@ -297,6 +298,7 @@ func (*DropMeasurementStatement) node()            {}
 func (*DropRetentionPolicyStatement) node()        {}
 func (*DropSeriesStatement) node()                 {}
 func (*DropShardStatement) node()                  {}
+func (*AlterShardStatement) node()                 {}
 func (*DropSubscriptionStatement) node()           {}
 func (*DropUserStatement) node()                   {}
 func (*ExplainStatement) node()                    {}
@@ -321,6 +323,8 @@ func (*ShowSeriesStatement) node()                 {}
 func (*ShowSeriesCardinalityStatement) node()      {}
 func (*ShowShardGroupsStatement) node()            {}
 func (*ShowShardsStatement) node()                 {}
+func (*ShowScrubStatusStatement) node()            {}
+func (*ShowQueryHistoryStatement) node()           {}
 func (*ShowStatsStatement) node()                  {}
 func (*ShowSubscriptionsStatement) node()          {}
 func (*ShowDiagnosticsStatement) node()            {}
@@ -365,6 +369,7 @@ func (*Hint) node()                        {}
 func (Hints) node()                        {}
 func (*MatchExpr) node()                   {}
 func (*InCondition) node()                 {}
+func (*ExistsCondition) node()             {}
 func (*Unnest) node()                      {}
 func (*BinOp) node()                       {}
 
@@ -463,6 +468,7 @@ func (*CreateContinuousQueryStatement) stmt()      {}
 func (*CreateDatabaseStatement) stmt()             {}
 func (*CreateMeasurementStatement) stmt()          {}
 func (*AlterShardKeyStatement) stmt()              {}
+func (*AlterMeasurementTTLStatement) stmt()        {}
 func (*CreateRetentionPolicyStatement) stmt()      {}
 func (*CreateSubscriptionStatement) stmt()         {}
 func (*CreateUserStatement) stmt()                 {}
@@ -493,8 +499,11 @@ func (*ShowSeriesStatement) stmt()                 {}
 func (*ShowSeriesCardinalityStatement) stmt()      {}
 func (*ShowShardGroupsStatement) stmt()            {}
 func (*ShowShardsStatement) stmt()                 {}
+func (*ShowScrubStatusStatement) stmt()            {}
+func (*ShowQueryHistoryStatement) stmt()           {}
 func (*ShowStatsStatement) stmt()                  {}
 func (*DropShardStatement) stmt()                  {}
+func (*AlterShardStatement) stmt()                 {}
 func (*ShowSubscriptionsStatement) stmt()          {}
 func (*ShowDiagnosticsStatement) stmt()            {}
 func (*ShowTagKeyCardinalityStatement) stmt()      {}
@@ -520,6 +529,7 @@ type Expr interface {
 }
 
 func (*InCondition) expr()          {}
+func (*ExistsCondition) expr()      {}
 func (*BinaryExpr) expr()           {}
 func (*BooleanLiteral) expr()       {}
 func (*BoundParameter) expr()       {}
@@ -870,6 +880,7 @@ type CreateMeasurementStatement struct {
 	IndexOption         []*IndexOption
 	TimeClusterDuration time.Duration
 	CompactType         string
+	TTL                 time.Duration
 }
 
 type CreateMeasurementStatementOption struct {
@@ -884,6 +895,7 @@ type CreateMeasurementStatementOption struct {
 	Property            [][]string
 	TimeClusterDuration time.Duration
 	CompactType         string
+	TTL                 time.Duration
 }
 
 type IndexOption struct {
@@ -951,6 +963,11 @@ func (s *CreateMeasurementStatement) String() string {
 
 	}
 
+	if s.TTL > 0 {
+		_, _ = buf.WriteString(" TTL ")
+		_, _ = buf.WriteString(FormatDuration(s.TTL))
+	}
+
 	return buf.String()
 }
 
@@ -995,6 +1012,38 @@ func (s *AlterShardKeyStatement) RequiredPrivileges() (ExecutionPrivileges, erro
 	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
 }
 
+// AlterMeasurementTTLStatement represents a command to set the TTL of a measurement,
+// a zero TTL means following the retention policy.
+type AlterMeasurementTTLStatement struct {
+	Database        string
+	RetentionPolicy string
+	Name            string
+	TTL             time.Duration
+}
+
+func (s *AlterMeasurementTTLStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("ALTER MEASUREMENT ")
+	if s.Database != "" {
+		_, _ = buf.WriteString(QuoteIdent(s.Database))
+		_, _ = buf.WriteString(".")
+	}
+
+	if s.RetentionPolicy != "" {
+		_, _ = buf.WriteString(QuoteIdent(s.RetentionPolicy))
+		_, _ = buf.WriteString(".")
+	}
+
+	_, _ = buf.WriteString(QuoteIdent(s.Name))
+	_, _ = buf.WriteString(" WITH TTL ")
+	_, _ = buf.WriteString(FormatDuration(s.TTL))
+	return buf.String()
+}
+
+func (s *AlterMeasurementTTLStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
 // DropDatabaseStatement represents a command to drop a database.
 type DropDatabaseStatement struct {
 	// Name of the database to be dropped.
@@ -3572,6 +3621,44 @@ func (s *DropShardStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
 	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
 }
 
+// Maintenance actions supported by AlterShardStatement.
+const (
+	ShardActionCompact      = "compact"
+	ShardActionFlush        = "flush"
+	ShardActionRebuildIndex = "rebuild_index"
+)
+
+// AlterShardStatement represents a command for running a maintenance
+// action on a shard on its owning node.
+type AlterShardStatement struct {
+	// ID of the shard to be maintained.
+	ID uint64
+
+	// Action is one of ShardActionCompact, ShardActionFlush and ShardActionRebuildIndex.
+	Action string
+}
+
+// String returns a string representation of the alter shard statement.
+func (s *AlterShardStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("ALTER SHARD ")
+	buf.WriteString(strconv.FormatUint(s.ID, 10))
+	switch s.Action {
+	case ShardActionRebuildIndex:
+		buf.WriteString(" REBUILD INDEX")
+	default:
+		buf.WriteString(" ")
+		buf.WriteString(strings.ToUpper(s.Action))
+	}
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a
+// AlterShardStatement.
+func (s *AlterShardStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
 // ShowSeriesCardinalityStatement represents a command for listing series cardinality.
 type ShowSeriesCardinalityStatement struct {
 	// Database to query. If blank, use the default database.
@@ -4097,6 +4184,56 @@ func (s *ShowShardsStatement) GetRPName() string {
 	return s.mstInfo.RetentionPolicy
 }
 
+// ShowScrubStatusStatement represents a command for displaying the result
+// of the last background scrub of every shard in the cluster.
+type ShowScrubStatusStatement struct{}
+
+// String returns a string representation.
+func (s *ShowScrubStatusStatement) String() string { return "SHOW SCRUB STATUS" }
+
+// RequiredPrivileges returns the privileges required to execute the statement.
+func (s *ShowScrubStatusStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
+// ShowQueryHistoryStatement represents a command for browsing the slow queries
+// recorded in the query history, the latest queries first.
+type ShowQueryHistoryStatement struct {
+	// An expression evaluated on the recorded queries.
+	Condition Expr
+
+	// Maximum number of queries to be returned.
+	// Unlimited if zero.
+	Limit int
+
+	// Returns queries starting at an offset from the first query.
+	Offset int
+}
+
+// String returns a string representation.
+func (s *ShowQueryHistoryStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("SHOW QUERY HISTORY")
+	if s.Condition != nil {
+		_, _ = buf.WriteString(" WHERE ")
+		_, _ = buf.WriteString(s.Condition.String())
+	}
+	if s.Limit > 0 {
+		_, _ = buf.WriteString(" LIMIT ")
+		_, _ = buf.WriteString(strconv.Itoa(s.Limit))
+	}
+	if s.Offset > 0 {
+		_, _ = buf.WriteString(" OFFSET ")
+		_, _ = buf.WriteString(strconv.Itoa(s.Offset))
+	}
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privileges required to execute the statement.
+func (s *ShowQueryHistoryStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
 // ShowDiagnosticsStatement represents a command for show node diagnostics.
 type ShowDiagnosticsStatement struct {
 	// Module
@@ -5135,9 +5272,13 @@ func (j *PromSubCall) String() string {
 type InCondition struct {
 	Stmt   *SelectStatement
 	Column Expr
+	NotIn  bool
 }
 
 func (j *InCondition) String() string {
+	if j.NotIn {
+		return fmt.Sprintf("not in condition,select statement: %s, column name: %s", j.Stmt.String(), j.Column.String())
+	}
 	return fmt.Sprintf("in condition,select statement: %s, column name: %s", j.Stmt.String(), j.Column.String())
 }
 
@@ -5147,6 +5288,25 @@ func (j *InCondition) GetName() string {
 
 func (j *InCondition) RewriteNameSpace(alias, mst string) {}
 
+// ExistsCondition represents an uncorrelated [NOT] EXISTS (SELECT ...) predicate.
+type ExistsCondition struct {
+	Stmt      *SelectStatement
+	NotExists bool
+}
+
+func (j *ExistsCondition) String() string {
+	if j.NotExists {
+		return fmt.Sprintf("not exists condition,select statement: %s", j.Stmt.String())
+	}
+	return fmt.Sprintf("exists condition,select statement: %s", j.Stmt.String())
+}
+
+func (j *ExistsCondition) GetName() string {
+	return ""
+}
+
+func (j *ExistsCondition) RewriteNameSpace(alias, mst string) {}
+
 // VarRef represents a reference to a variable.
 type VarRef struct {
 	Val   string
@@ -5634,7 +5794,9 @@ func CloneExpr(expr Expr) Expr {
 	case *Wildcard:
 		return &Wildcard{Type: expr.Type}
 	case *InCondition:
-		return &InCondition{Stmt: expr.Stmt.Clone(), Column: CloneExpr(expr.Column)}
+		return &InCondition{Stmt: expr.Stmt.Clone(), Column: CloneExpr(expr.Column), NotIn: expr.NotIn}
+	case *ExistsCondition:
+		return &ExistsCondition{Stmt: expr.Stmt.Clone(), NotExists: expr.NotExists}
 	}
 	panic("unreachable")
 }
@@ -7817,7 +7979,7 @@ func conditionExpr(cond Expr, valuer Valuer) (Expr, TimeRange, error) {
 		return reduce(&ParenExpr{Expr: expr}, nil), timeRange, nil
 	case *BooleanLiteral:
 		return cond, TimeRange{}, nil
-	case *InCondition:
+	case *InCondition, *ExistsCondition:
 		return reduce(cond, nil), TimeRange{}, nil
 	default:
 		return nil, TimeRange{}, fmt.Errorf("invalid condition expression: %s", cond)
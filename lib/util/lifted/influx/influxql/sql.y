@@ -105,7 +105,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
                 TO IN NOT EXISTS REVOKE FILL DELETE WITH ENGINETYPE COLUMNSTORE TSSTORE ALL ANY PASSWORD NAME REPLICANUM ALTER USER USERS
                 DATABASES DATABASE MEASUREMENTS RETENTION POLICIES POLICY DURATION DEFAULT SHARD INDEX GRANT HOT WARM TYPE SET FOR GRANTS
                 REPLICATION SERIES DROP CASE WHEN THEN ELSE BEGIN END TRUE FALSE TAG ATTRIBUTE FIELD KEYS VALUES KEY EXPLAIN ANALYZE EXACT CARDINALITY SHARDKEY
-                PRIMARYKEY SORTKEY PROPERTY COMPACT
+                PRIMARYKEY SORTKEY PROPERTY COMPACT TTL
                 CONTINUOUS DIAGNOSTICS QUERIES QUERIE SHARDS STATS SUBSCRIPTIONS SUBSCRIPTION GROUPS INDEXTYPE INDEXLIST SEGMENT KILL
                 EVERY RESAMPLE
                 DOWNSAMPLE DOWNSAMPLES SAMPLEINTERVAL TIMEINTERVAL STREAM DELAY STREAMS
@@ -139,12 +139,12 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
                                     TAG_VALUES_WITH  EXPLAIN_STATEMENT SHOW_TAG_KEY_CARDINALITY_STATEMENT SHOW_TAG_VALUES_CARDINALITY_STATEMENT
                                     SHOW_FIELD_KEY_CARDINALITY_STATEMENT CREATE_MEASUREMENT_STATEMENT DROP_SHARD_STATEMENT SET_PASSWORD_USER_STATEMENT
                                     SHOW_GRANTS_FOR_USER_STATEMENT SHOW_MEASUREMENT_CARDINALITY_STATEMENT SHOW_SERIES_CARDINALITY_STATEMENT SHOW_SHARDS_STATEMENT
-                                    ALTER_SHARD_KEY_STATEMENT SHOW_SHARD_GROUPS_STATEMENT DROP_MEASUREMENT_STATEMENT
+                                    ALTER_SHARD_KEY_STATEMENT ALTER_MEASUREMENT_TTL_STATEMENT SHOW_SHARD_GROUPS_STATEMENT DROP_MEASUREMENT_STATEMENT ALTER_SHARD_STATEMENT
                                     CREATE_CONTINUOUS_QUERY_STATEMENT SHOW_CONTINUOUS_QUERIES_STATEMENT DROP_CONTINUOUS_QUERY_STATEMENT
                                     CREATE_DOWNSAMPLE_STATEMENT DOWNSAMPLE_INTERVALS DROP_DOWNSAMPLE_STATEMENT SHOW_DOWNSAMPLE_STATEMENT
                                     CREATE_STREAM_STATEMENT SHOW_STREAM_STATEMENT DROP_STREAM_STATEMENT COLUMN_LISTS SHOW_MEASUREMENT_KEYS_STATEMENT
                                     SHOW_QUERIES_STATEMENT KILL_QUERY_STATEMENT SHOW_CONFIGS_STATEMENT SET_CONFIG_STATEMENT SHOW_CLUSTER_STATEMENT
-                                    CREATE_SUBSCRIPTION_STATEMENT SHOW_SUBSCRIPTION_STATEMENT DROP_SUBSCRIPTION_STATEMENT
+                                    SHOW_SCRUB_STATUS_STATEMENT SHOW_QUERY_HISTORY_STATEMENT CREATE_SUBSCRIPTION_STATEMENT SHOW_SUBSCRIPTION_STATEMENT DROP_SUBSCRIPTION_STATEMENT
 %type <fields>                      COLUMN_CLAUSES IDENTS
 %type <field>                       COLUMN_CLAUSE
 %type <stmts>                       ALL_QUERIES ALL_QUERY
@@ -169,6 +169,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %type <indexType>                   INDEX_TYPE INDEX_TYPES CMOPTION_INDEXTYPE_TS CMOPTION_INDEXTYPE_CS
 %type <cqsp>                        SAMPLE_POLICY
 %type <tdurs>                       DURATIONVALS
+%type <tdur>                        CMOPTION_TTL
 %type <cqsp>                        SAMPLE_POLICY
 %type <int64>                       INTEGERPARA CMOPTION_SHARDNUM
 %type <bool>                        ALLOW_TAG_ARRAY
@@ -348,6 +349,14 @@ STATEMENT:
     {
         $$ = $1
     }
+    |ALTER_MEASUREMENT_TTL_STATEMENT
+    {
+        $$ = $1
+    }
+    |ALTER_SHARD_STATEMENT
+    {
+        $$ = $1
+    }
     |SHOW_SHARD_GROUPS_STATEMENT
     {
         $$ = $1
@@ -428,6 +437,14 @@ STATEMENT:
     {
     	$$ = $1
     }
+    |SHOW_SCRUB_STATUS_STATEMENT
+    {
+    	$$ = $1
+    }
+    |SHOW_QUERY_HISTORY_STATEMENT
+    {
+    	$$ = $1
+    }
 
 SELECT_STATEMENT:
     SELECT COLUMN_CLAUSES INTO_CLAUSE FROM_CLAUSE WHERE_CLAUSE GROUP_BY_CLAUSE EXCEPT_CLAUSE FILL_CLAUSE ORDER_CLAUSES OPTION_CLAUSES TIME_ZONE
@@ -1073,19 +1090,29 @@ CONDITION:
     }
     |EXISTS LPAREN SELECT_STATEMENT RPAREN
     {
-    	$$ = &BinaryExpr{}
+    	$$ = &ExistsCondition{Stmt:$3.(*SelectStatement)}
     }
     |IDENT NOT IN LPAREN SELECT_STATEMENT RPAREN
     {
-    	$$ = &BinaryExpr{}
+    	$$ = &InCondition{Stmt:$5.(*SelectStatement), Column: &VarRef{Val: $1}, NotIn: true}
     }
     |IDENT NOT IN LPAREN IDENTS RPAREN
     {
-    	$$ = &BinaryExpr{}
+        ident := &VarRef{Val:$1}
+    	var expr,e Expr
+    	for i := range $5{
+    	    expr = &BinaryExpr{LHS:ident, Op:Token(NEQ), RHS:&StringLiteral{Val:$5[i].Expr.(*VarRef).Val}}
+    	    if e == nil{
+    	        e = expr
+    	    }else{
+    	        e = &BinaryExpr{LHS:e, Op:Token(AND), RHS:expr}
+    	    }
+    	}
+    	$$ = e
     }
     |NOT EXISTS LPAREN SELECT_STATEMENT RPAREN
     {
-    	$$ = &BinaryExpr{}
+    	$$ = &ExistsCondition{Stmt:$4.(*SelectStatement), NotExists: true}
     }
     |MATCH LPAREN STRING_TYPE COMMA STRING_TYPE RPAREN
     {
@@ -2491,6 +2518,7 @@ CREATE_MEASUREMENT_STATEMENT:
         stmt.NumOfShards = $5.NumOfShards
         stmt.Type = $5.Type
         stmt.EngineType = $5.EngineType
+        stmt.TTL = $5.TTL
 
         $$ = stmt
     }
@@ -2581,6 +2609,7 @@ CREATE_MEASUREMENT_STATEMENT:
         stmt.SortKey = $5.SortKey
         stmt.Property = $5.Property
         stmt.CompactType = $5.CompactType
+        stmt.TTL = $5.TTL
         $$ = stmt
     }
 
@@ -2591,7 +2620,7 @@ CMOPTIONS_TS:
         option.EngineType = "tsstore"
         $$ = option
     }
-    | WITH CMOPTION_ENGINETYPE_TS CMOPTION_INDEXTYPE_TS CMOPTION_SHARDKEY CMOPTION_SHARDNUM TYPE_CLAUSE
+    | WITH CMOPTION_ENGINETYPE_TS CMOPTION_INDEXTYPE_TS CMOPTION_SHARDKEY CMOPTION_SHARDNUM TYPE_CLAUSE CMOPTION_TTL
     {
         option := &CreateMeasurementStatementOption{}
         if $3 != nil {
@@ -2604,11 +2633,12 @@ CMOPTIONS_TS:
         option.NumOfShards = $5
         option.Type = $6
         option.EngineType = $2
+        option.TTL = $7
         $$ = option
     }
 
 CMOPTIONS_CS:
-    WITH CMOPTION_ENGINETYPE_CS CMOPTION_INDEXTYPE_CS CMOPTION_SHARDKEY CMOPTION_SHARDNUM TYPE_CLAUSE CMOPTION_PRIMARYKEY CMOPTION_SORTKEY CMOPTION_PROPERTIES COMPACTION_TYPE_CLAUSE
+    WITH CMOPTION_ENGINETYPE_CS CMOPTION_INDEXTYPE_CS CMOPTION_SHARDKEY CMOPTION_SHARDNUM TYPE_CLAUSE CMOPTION_PRIMARYKEY CMOPTION_SORTKEY CMOPTION_PROPERTIES COMPACTION_TYPE_CLAUSE CMOPTION_TTL
     {
         option := &CreateMeasurementStatementOption{}
         if $3 != nil {
@@ -2637,6 +2667,7 @@ CMOPTIONS_CS:
             option.Property = $9
         }
         option.CompactType = $10
+        option.TTL = $11
         $$ = option
     }
 
@@ -2793,6 +2824,15 @@ COMPACTION_TYPE_CLAUSE:
         $$ = compactionType
     }
 
+CMOPTION_TTL:
+    {
+        $$ = 0
+    }
+    | TTL DURATIONVAL
+    {
+        $$ = $2
+    }
+
 COLUMN_LISTS:
     LPAREN FIELD_OPTIONS
     {
@@ -3008,6 +3048,37 @@ DROP_SHARD_STATEMENT:
         $$ = stmt
     }
 
+ALTER_SHARD_STATEMENT:
+    ALTER SHARD INTEGER COMPACT
+    {
+        stmt := &AlterShardStatement{}
+        stmt.ID = uint64($3)
+        stmt.Action = ShardActionCompact
+        $$ = stmt
+    }
+    |ALTER SHARD INTEGER IDENT
+    {
+        if strings.ToLower($4) != ShardActionFlush {
+            yylex.Error("expect COMPACT, FLUSH or REBUILD INDEX for ALTER SHARD")
+            return 1
+        }
+        stmt := &AlterShardStatement{}
+        stmt.ID = uint64($3)
+        stmt.Action = ShardActionFlush
+        $$ = stmt
+    }
+    |ALTER SHARD INTEGER IDENT INDEX
+    {
+        if strings.ToLower($4) != "rebuild" {
+            yylex.Error("expect COMPACT, FLUSH or REBUILD INDEX for ALTER SHARD")
+            return 1
+        }
+        stmt := &AlterShardStatement{}
+        stmt.ID = uint64($3)
+        stmt.Action = ShardActionRebuildIndex
+        $$ = stmt
+    }
+
 SET_PASSWORD_USER_STATEMENT:
     SET PASSWORD FOR IDENT EQ STRING
     {
@@ -3160,6 +3231,17 @@ ALTER_SHARD_KEY_STATEMENT:
         $$ = stmt
     }
 
+ALTER_MEASUREMENT_TTL_STATEMENT:
+    ALTER MEASUREMENT TABLE_CASE WITH TTL DURATIONVAL
+    {
+        stmt := &AlterMeasurementTTLStatement{}
+        stmt.Database = $3.Database
+        stmt.Name = $3.Name
+        stmt.RetentionPolicy = $3.RetentionPolicy
+        stmt.TTL = $6
+        $$ = stmt
+    }
+
 
 
 
@@ -3558,4 +3640,28 @@ SHOW_CLUSTER_STATEMENT:
 	 $$ = stmt
       }
 
+SHOW_SCRUB_STATUS_STATEMENT:
+    SHOW IDENT IDENT
+    {
+        if strings.ToLower($2) != "scrub" || strings.ToLower($3) != "status" {
+            yylex.Error("expect SHOW SCRUB STATUS")
+            return 1
+        }
+        $$ = &ShowScrubStatusStatement{}
+    }
+
+SHOW_QUERY_HISTORY_STATEMENT:
+    SHOW QUERY IDENT WHERE_CLAUSE LIMIT_OFFSET_OPTION
+    {
+        if strings.ToLower($3) != "history" {
+            yylex.Error("expect SHOW QUERY HISTORY")
+            return 1
+        }
+        stmt := &ShowQueryHistoryStatement{}
+        stmt.Condition = $4
+        stmt.Limit = $5[0]
+        stmt.Offset = $5[1]
+        $$ = stmt
+    }
+
 %%
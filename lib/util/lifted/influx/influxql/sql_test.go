@@ -477,3 +477,207 @@ func BenchmarkPreviousParser(b *testing.B) {
 		}
 	}
 }
+
+func TestSemiJoinConditionParser(t *testing.T) {
+	stmt := parseSemiJoinSelect(t, "SELECT value FROM cpu WHERE host NOT IN (SELECT host FROM mem)")
+	in, ok := stmt.Condition.(*influxql.InCondition)
+	if !ok || !in.NotIn || in.Column.(*influxql.VarRef).Val != "host" {
+		t.Fatalf("unexpected condition %#v", stmt.Condition)
+	}
+
+	stmt = parseSemiJoinSelect(t, "SELECT value FROM cpu WHERE host IN (SELECT host FROM mem)")
+	if in, ok = stmt.Condition.(*influxql.InCondition); !ok || in.NotIn {
+		t.Fatalf("unexpected condition %#v", stmt.Condition)
+	}
+
+	stmt = parseSemiJoinSelect(t, "SELECT value FROM cpu WHERE NOT EXISTS (SELECT host FROM mem)")
+	exists, ok := stmt.Condition.(*influxql.ExistsCondition)
+	if !ok || !exists.NotExists || exists.Stmt == nil {
+		t.Fatalf("unexpected condition %#v", stmt.Condition)
+	}
+
+	stmt = parseSemiJoinSelect(t, "SELECT value FROM cpu WHERE host NOT IN (a, b)")
+	if got := stmt.Condition.String(); got != "host != 'a' AND host != 'b'" {
+		t.Fatalf("unexpected condition %s", got)
+	}
+}
+
+func parseSemiJoinSelect(t *testing.T, sql string) *influxql.SelectStatement {
+	YyParser := &influxql.YyParser{
+		Query: influxql.Query{},
+	}
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+	YyParser.ParseTokens()
+	q, err := YyParser.GetQuery()
+	if err != nil {
+		t.Fatalf("%s with sql: %s", err, sql)
+	}
+	return q.Statements[0].(*influxql.SelectStatement)
+}
+
+func TestAlterShardParser(t *testing.T) {
+	for sql, action := range map[string]string{
+		"ALTER SHARD 3 COMPACT":       influxql.ShardActionCompact,
+		"alter shard 3 flush":         influxql.ShardActionFlush,
+		"ALTER SHARD 3 REBUILD INDEX": influxql.ShardActionRebuildIndex,
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err, sql)
+		}
+		stmt, ok := q.Statements[0].(*influxql.AlterShardStatement)
+		if !ok || stmt.ID != 3 || stmt.Action != action {
+			t.Fatalf("unexpected statement %#v with sql: %s", q.Statements[0], sql)
+		}
+		if got := strings.ToUpper(sql); stmt.String() != got {
+			t.Fatalf("unexpected string %s, expect %s", stmt.String(), got)
+		}
+	}
+
+	for _, sql := range []string{"ALTER SHARD 3 DROP", "ALTER SHARD 3 FLUSH INDEX"} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		if _, err := YyParser.GetQuery(); err == nil {
+			t.Fatalf("expect error with sql: %s", sql)
+		}
+	}
+}
+
+func TestShowScrubStatusParser(t *testing.T) {
+	for _, sql := range []string{"SHOW SCRUB STATUS", "show scrub status"} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err, sql)
+		}
+		stmt, ok := q.Statements[0].(*influxql.ShowScrubStatusStatement)
+		if !ok {
+			t.Fatalf("unexpected statement %#v with sql: %s", q.Statements[0], sql)
+		}
+		if stmt.String() != "SHOW SCRUB STATUS" {
+			t.Fatalf("unexpected string %s", stmt.String())
+		}
+	}
+
+	for _, sql := range []string{"SHOW SCRUB", "SHOW SCRUB STATE", "SHOW FOO STATUS"} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		if _, err := YyParser.GetQuery(); err == nil {
+			t.Fatalf("expect error with sql: %s", sql)
+		}
+	}
+}
+
+func TestShowQueryHistoryParser(t *testing.T) {
+	for sql, exp := range map[string]string{
+		"SHOW QUERY HISTORY":          "SHOW QUERY HISTORY",
+		"show query history limit 10": "SHOW QUERY HISTORY LIMIT 10",
+		`SHOW QUERY HISTORY WHERE "database" = 'db0' LIMIT 5 OFFSET 10`: `SHOW QUERY HISTORY WHERE "database" = 'db0' LIMIT 5 OFFSET 10`,
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err, sql)
+		}
+		stmt, ok := q.Statements[0].(*influxql.ShowQueryHistoryStatement)
+		if !ok {
+			t.Fatalf("unexpected statement %#v with sql: %s", q.Statements[0], sql)
+		}
+		if stmt.String() != exp {
+			t.Fatalf("unexpected string %s", stmt.String())
+		}
+	}
+
+	for _, sql := range []string{"SHOW QUERY", "SHOW QUERY HISTORIES", "SHOW QUERY HISTORY LIMIT"} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		if _, err := YyParser.GetQuery(); err == nil {
+			t.Fatalf("expect error with sql: %s", sql)
+		}
+	}
+}
+
+func TestMeasurementTTLParser(t *testing.T) {
+	for sql, exp := range map[string]string{
+		"ALTER MEASUREMENT mst WITH TTL 7d":                                           "ALTER MEASUREMENT mst WITH TTL 1w",
+		"alter measurement db0.rp0.mst with ttl 0s":                                   "ALTER MEASUREMENT db0.rp0.mst WITH TTL 0s",
+		"CREATE MEASUREMENT mst WITH ENGINETYPE = TSSTORE TTL 2h":                     "",
+		"CREATE MEASUREMENT mst (a INT64 FIELD) WITH ENGINETYPE = COLUMNSTORE TTL 1d": "",
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err, sql)
+		}
+		switch stmt := q.Statements[0].(type) {
+		case *influxql.AlterMeasurementTTLStatement:
+			if stmt.String() != exp {
+				t.Fatalf("unexpected string %s, exp: %s", stmt.String(), exp)
+			}
+		case *influxql.CreateMeasurementStatement:
+			if stmt.TTL == 0 || !strings.Contains(stmt.String(), " TTL ") {
+				t.Fatalf("unexpected ttl %s with sql: %s", stmt.TTL, sql)
+			}
+		default:
+			t.Fatalf("unexpected statement %#v with sql: %s", q.Statements[0], sql)
+		}
+	}
+
+	for _, sql := range []string{"ALTER MEASUREMENT mst WITH TTL", "ALTER MEASUREMENT mst WITH TTL 1", "ALTER MEASUREMENT mst TTL 1d"} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		if _, err := YyParser.GetQuery(); err == nil {
+			t.Fatalf("expect error with sql: %s", sql)
+		}
+	}
+}
+
+func TestTTLIdentifier(t *testing.T) {
+	for sql, exp := range map[string]string{
+		"SELECT ttl FROM mst WHERE ttl > 1":         "SELECT ttl FROM mst WHERE ttl > 1",
+		"SELECT max(ttl) FROM ttl GROUP BY ttl":     "SELECT max(ttl) FROM ttl GROUP BY ttl",
+		"SELECT ttl FROM mst WHERE time > now()-1d": "SELECT ttl FROM mst WHERE time > now() - 1d",
+	} {
+		YyParser := &influxql.YyParser{
+			Query: influxql.Query{},
+		}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(sql))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err, sql)
+		}
+		if q.Statements[0].String() != exp {
+			t.Fatalf("unexpected string %s, exp: %s", q.Statements[0].String(), exp)
+		}
+	}
+}
@@ -338,6 +338,7 @@ var tokens = [...]string{
 	REPLICAS:       "REPLICAS",
 	DETAIL:         "DETAIL",
 	COMPACT:        "COMPACT",
+	TTL:            "TTL",
 	AUTO:           "AUTO",
 	EXCEPT:         "EXCEPT",
 }
@@ -354,6 +355,8 @@ func init() {
 	}
 	/*	keywords["true"] = TRUE
 		keywords["false"] = FALSE*/
+	// TTL is a keyword only if it is followed by a duration, see YyParser.Lex
+	delete(keywords, "ttl")
 }
 
 // String returns the string representation of the token.
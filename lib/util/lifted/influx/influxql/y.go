@@ -188,81 +188,82 @@ const PRIMARYKEY = 57424
 const SORTKEY = 57425
 const PROPERTY = 57426
 const COMPACT = 57427
-const CONTINUOUS = 57428
-const DIAGNOSTICS = 57429
-const QUERIES = 57430
-const QUERIE = 57431
-const SHARDS = 57432
-const STATS = 57433
-const SUBSCRIPTIONS = 57434
-const SUBSCRIPTION = 57435
-const GROUPS = 57436
-const INDEXTYPE = 57437
-const INDEXLIST = 57438
-const SEGMENT = 57439
-const KILL = 57440
-const EVERY = 57441
-const RESAMPLE = 57442
-const DOWNSAMPLE = 57443
-const DOWNSAMPLES = 57444
-const SAMPLEINTERVAL = 57445
-const TIMEINTERVAL = 57446
-const STREAM = 57447
-const DELAY = 57448
-const STREAMS = 57449
-const QUERY = 57450
-const PARTITION = 57451
-const TOKEN = 57452
-const TOKENIZERS = 57453
-const MATCH = 57454
-const LIKE = 57455
-const MATCHPHRASE = 57456
-const CONFIG = 57457
-const CONFIGS = 57458
-const CLUSTER = 57459
-const IPINRANGE = 57460
-const REPLICAS = 57461
-const DETAIL = 57462
-const DESTINATIONS = 57463
-const SCHEMA = 57464
-const INDEXES = 57465
-const AUTO = 57466
-const EXCEPT = 57467
-const DESC = 57468
-const ASC = 57469
-const COMMA = 57470
-const SEMICOLON = 57471
-const LPAREN = 57472
-const RPAREN = 57473
-const REGEX = 57474
-const EQ = 57475
-const NEQ = 57476
-const LT = 57477
-const LTE = 57478
-const GT = 57479
-const GTE = 57480
-const DOT = 57481
-const DOUBLECOLON = 57482
-const NEQREGEX = 57483
-const EQREGEX = 57484
-const IDENT = 57485
-const INTEGER = 57486
-const DURATIONVAL = 57487
-const STRING = 57488
-const NUMBER = 57489
-const HINT = 57490
-const BOUNDPARAM = 57491
-const AND = 57492
-const OR = 57493
-const ADD = 57494
-const SUB = 57495
-const BITWISE_OR = 57496
-const BITWISE_XOR = 57497
-const MUL = 57498
-const DIV = 57499
-const MOD = 57500
-const BITWISE_AND = 57501
-const UMINUS = 57502
+const TTL = 57428
+const CONTINUOUS = 57429
+const DIAGNOSTICS = 57430
+const QUERIES = 57431
+const QUERIE = 57432
+const SHARDS = 57433
+const STATS = 57434
+const SUBSCRIPTIONS = 57435
+const SUBSCRIPTION = 57436
+const GROUPS = 57437
+const INDEXTYPE = 57438
+const INDEXLIST = 57439
+const SEGMENT = 57440
+const KILL = 57441
+const EVERY = 57442
+const RESAMPLE = 57443
+const DOWNSAMPLE = 57444
+const DOWNSAMPLES = 57445
+const SAMPLEINTERVAL = 57446
+const TIMEINTERVAL = 57447
+const STREAM = 57448
+const DELAY = 57449
+const STREAMS = 57450
+const QUERY = 57451
+const PARTITION = 57452
+const TOKEN = 57453
+const TOKENIZERS = 57454
+const MATCH = 57455
+const LIKE = 57456
+const MATCHPHRASE = 57457
+const CONFIG = 57458
+const CONFIGS = 57459
+const CLUSTER = 57460
+const IPINRANGE = 57461
+const REPLICAS = 57462
+const DETAIL = 57463
+const DESTINATIONS = 57464
+const SCHEMA = 57465
+const INDEXES = 57466
+const AUTO = 57467
+const EXCEPT = 57468
+const DESC = 57469
+const ASC = 57470
+const COMMA = 57471
+const SEMICOLON = 57472
+const LPAREN = 57473
+const RPAREN = 57474
+const REGEX = 57475
+const EQ = 57476
+const NEQ = 57477
+const LT = 57478
+const LTE = 57479
+const GT = 57480
+const GTE = 57481
+const DOT = 57482
+const DOUBLECOLON = 57483
+const NEQREGEX = 57484
+const EQREGEX = 57485
+const IDENT = 57486
+const INTEGER = 57487
+const DURATIONVAL = 57488
+const STRING = 57489
+const NUMBER = 57490
+const HINT = 57491
+const BOUNDPARAM = 57492
+const AND = 57493
+const OR = 57494
+const ADD = 57495
+const SUB = 57496
+const BITWISE_OR = 57497
+const BITWISE_XOR = 57498
+const MUL = 57499
+const DIV = 57500
+const MOD = 57501
+const BITWISE_AND = 57502
+const UMINUS = 57503
 
 var yyToknames = [...]string{
 	"$end",
@@ -350,6 +351,7 @@ var yyToknames = [...]string{
 	"SORTKEY",
 	"PROPERTY",
 	"COMPACT",
+	"TTL",
 	"CONTINUOUS",
 	"DIAGNOSTICS",
 	"QUERIES",
@@ -433,321 +435,329 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line sql.y:3561
+//line sql.y:3667
 
 //line yacctab:1
 var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 71,
-	4, 93,
-	-2, 140,
-	-1, 483,
-	113, 158,
-	133, 158,
-	134, 158,
-	135, 158,
-	136, 158,
-	137, 158,
-	138, 158,
-	141, 158,
-	142, 158,
-	-2, 146,
+	-1, 75,
+	4, 97,
+	-2, 144,
+	-1, 498,
+	114, 162,
+	134, 162,
+	135, 162,
+	136, 162,
+	137, 162,
+	138, 162,
+	139, 162,
+	142, 162,
+	143, 162,
+	-2, 150,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 1207
+const yyLast = 1260
 
 var yyAct = [...]int16{
-	510, 525, 964, 905, 802, 937, 436, 819, 928, 716,
-	830, 402, 738, 770, 720, 268, 524, 730, 864, 4,
-	669, 570, 506, 653, 744, 649, 240, 800, 71, 571,
-	393, 519, 434, 508, 210, 330, 251, 455, 327, 236,
-	2, 238, 155, 745, 746, 696, 175, 747, 736, 234,
-	285, 917, 75, 748, 883, 245, 244, 162, 163, 167,
-	168, 695, 884, 589, 516, 357, 358, 357, 358, 626,
-	140, 164, 165, 169, 166, 162, 163, 167, 168, 164,
-	165, 169, 166, 162, 163, 167, 168, 150, 582, 89,
-	483, 650, 81, 630, 631, 400, 651, 938, 85, 86,
-	511, 158, 239, 218, 89, 217, 974, 901, 218, 357,
-	358, 209, 935, 512, 89, 208, 919, 217, 211, 87,
-	218, 460, 89, 909, 903, 459, 216, 219, 211, 170,
-	874, 174, 873, 593, 817, 217, 211, 230, 218, 232,
-	161, 246, 156, 247, 904, 580, 816, 248, 164, 165,
-	169, 166, 162, 163, 167, 168, 797, 275, 751, 242,
-	276, 89, 701, 700, 207, 699, 698, 217, 628, 263,
-	218, 629, 243, 83, 80, 84, 82, 566, 88, 805,
-	357, 358, 78, 89, 59, 290, 254, 291, 272, 899,
-	209, 563, 564, 886, 208, 252, 756, 211, 805, 222,
-	286, 271, 755, 324, 578, 296, 59, 270, 569, 567,
-	233, 447, 361, 362, 266, 277, 278, 279, 280, 281,
-	282, 283, 284, 294, 295, 520, 521, 225, 551, 147,
-	178, 252, 550, 523, 522, 683, 672, 424, 579, 81,
-	322, 423, 212, 315, 340, 85, 86, 314, 145, 804,
-	968, 298, 906, 831, 180, 303, 900, 289, 772, 341,
-	731, 212, 572, 655, 212, 828, 391, 794, 808, 360,
-	793, 785, 741, 638, 740, 726, 377, 212, 685, 356,
-	355, 684, 164, 165, 169, 166, 162, 163, 167, 168,
-	643, 642, 343, 625, 623, 359, 369, 370, 371, 372,
-	373, 374, 622, 620, 376, 375, 76, 618, 89, 176,
-	604, 603, 602, 597, 595, 407, 581, 171, 212, 77,
-	83, 80, 84, 82, 568, 88, 173, 172, 426, 78,
-	636, 553, 74, 81, 517, 501, 458, 148, 392, 85,
-	86, 731, 500, 468, 670, 671, 398, 497, 184, 496,
-	473, 474, 674, 673, 89, 476, 146, 470, 405, 390,
-	389, 388, 385, 384, 383, 433, 488, 489, 490, 380,
-	378, 461, 348, 406, 347, 346, 410, 412, 344, 415,
-	339, 338, 337, 486, 481, 482, 332, 325, 323, 319,
-	300, 292, 431, 265, 226, 224, 220, 206, 204, 160,
-	76, 475, 89, 477, 605, 491, 591, 601, 505, 531,
-	252, 252, 552, 77, 83, 80, 84, 82, 530, 88,
-	472, 252, 171, 78, 537, 600, 535, 541, 555, 514,
-	464, 173, 172, 462, 422, 345, 299, 554, 336, 465,
-	970, 305, 306, 81, 308, 309, 860, 562, 316, 85,
-	86, 859, 321, 518, 709, 504, 212, 458, 503, 590,
-	432, 835, 587, 975, 834, 588, 953, 515, 565, 940,
-	70, 212, 479, 212, 939, 934, 918, 892, 876, 533,
-	534, 832, 536, 577, 827, 540, 868, 599, 826, 825,
-	823, 822, 549, 596, 586, 592, 732, 594, 728, 558,
-	560, 561, 727, 714, 610, 627, 612, 613, 480, 466,
-	492, 214, 89, 609, 513, 513, 619, 397, 633, 617,
-	871, 967, 913, 77, 83, 80, 84, 82, 607, 88,
-	882, 639, 657, 78, 774, 715, 632, 661, 637, 663,
-	634, 611, 487, 484, 367, 359, 366, 365, 662, 659,
-	660, 363, 666, 652, 335, 354, 686, 739, 667, 408,
-	352, 656, 413, 70, 694, 682, 419, 969, 421, 954,
-	930, 697, 879, 428, 690, 429, 692, 693, 846, 824,
-	212, 758, 212, 759, 760, 182, 635, 139, 616, 615,
-	641, 614, 606, 159, 394, 818, 331, 179, 212, 328,
-	448, 719, 151, 658, 227, 213, 723, 798, 153, 718,
-	960, 877, 713, 813, 869, 733, 734, 735, 680, 681,
-	868, 708, 706, 199, 231, 200, 711, 688, 689, 697,
-	691, 729, 865, 963, 215, 958, 950, 182, 933, 331,
-	801, 494, 644, 645, 329, 317, 318, 427, 312, 313,
-	743, 737, 182, 754, 812, 742, 724, 420, 196, 197,
-	418, 762, 763, 353, 764, 320, 304, 59, 848, 749,
-	753, 779, 778, 544, 761, 547, 765, 678, 351, 122,
-	152, 799, 556, 767, 784, 773, 766, 329, 193, 768,
-	194, 668, 789, 665, 791, 792, 782, 783, 181, 780,
-	189, 190, 191, 543, 787, 788, 307, 790, 710, 310,
-	311, 212, 273, 807, 274, 121, 221, 752, 119, 449,
-	120, 820, 187, 750, 185, 186, 212, 795, 331, 811,
-	3, 910, 188, 640, 399, 806, 293, 149, 861, 178,
-	769, 911, 264, 267, 815, 443, 446, 195, 444, 445,
-	781, 739, 821, 796, 829, 513, 717, 703, 786, 576,
-	123, 575, 574, 841, 573, 253, 223, 126, 205, 183,
-	144, 837, 302, 833, 451, 124, 836, 585, 252, 125,
-	912, 845, 840, 839, 853, 854, 842, 141, 847, 856,
-	857, 142, 858, 775, 776, 59, 364, 852, 814, 849,
-	850, 154, 855, 721, 722, 60, 61, 141, 867, 810,
-	809, 143, 777, 297, 676, 66, 141, 63, 141, 704,
-	677, 664, 598, 866, 875, 870, 542, 64, 454, 404,
-	379, 872, 333, 878, 546, 507, 255, 843, 485, 844,
-	65, 381, 880, 539, 68, 417, 881, 890, 885, 62,
-	256, 851, 621, 257, 897, 888, 889, 898, 382, 396,
-	891, 498, 495, 478, 67, 261, 863, 896, 259, 893,
-	862, 647, 648, 907, 838, 757, 403, 902, 528, 820,
-	820, 395, 260, 908, 269, 69, 914, 915, 526, 527,
-	922, 923, 916, 409, 411, 141, 414, 416, 927, 921,
-	142, 142, 920, 141, 425, 608, 203, 725, 929, 430,
-	925, 926, 403, 887, 59, 157, 157, 239, 142, 529,
-	894, 895, 936, 942, 182, 493, 387, 944, 945, 386,
-	471, 941, 469, 467, 947, 463, 929, 951, 946, 952,
-	943, 450, 350, 349, 342, 955, 59, 301, 262, 258,
-	229, 228, 100, 202, 959, 201, 60, 61, 401, 966,
-	961, 624, 502, 499, 924, 141, 66, 198, 63, 192,
-	966, 973, 972, 971, 584, 81, 583, 453, 64, 114,
-	452, 85, 86, 457, 456, 712, 707, 705, 803, 95,
-	90, 65, 91, 92, 956, 68, 532, 957, 102, 965,
-	62, 538, 948, 931, 949, 932, 99, 545, 93, 548,
-	962, 97, 771, 435, 646, 67, 557, 559, 96, 81,
-	98, 509, 654, 288, 368, 85, 86, 177, 113, 110,
-	111, 112, 117, 103, 79, 106, 69, 101, 250, 107,
-	249, 81, 76, 287, 89, 241, 235, 85, 86, 104,
-	237, 1, 73, 55, 105, 77, 83, 80, 84, 82,
-	54, 88, 53, 108, 109, 78, 58, 57, 74, 115,
-	116, 56, 52, 51, 50, 334, 49, 48, 47, 46,
-	45, 44, 43, 42, 41, 40, 76, 39, 89, 38,
-	118, 37, 36, 35, 34, 94, 33, 32, 31, 77,
-	83, 80, 84, 82, 72, 88, 132, 30, 76, 78,
-	89, 29, 74, 28, 27, 26, 25, 24, 21, 20,
-	22, 77, 83, 80, 84, 82, 19, 88, 23, 18,
-	17, 78, 675, 16, 74, 679, 137, 439, 440, 14,
-	15, 13, 130, 12, 687, 127, 702, 129, 437, 441,
-	443, 446, 131, 444, 445, 7, 11, 10, 9, 438,
-	8, 326, 128, 6, 5, 0, 0, 0, 0, 0,
+	527, 542, 983, 946, 921, 819, 955, 733, 944, 277,
+	847, 451, 755, 541, 836, 747, 4, 737, 880, 589,
+	669, 523, 761, 79, 786, 248, 817, 590, 405, 580,
+	525, 449, 536, 470, 340, 337, 259, 414, 685, 218,
+	75, 244, 2, 181, 753, 899, 498, 242, 161, 294,
+	933, 246, 712, 900, 85, 168, 169, 173, 174, 711,
+	89, 90, 646, 367, 368, 367, 368, 145, 601, 412,
+	170, 171, 175, 172, 168, 169, 173, 174, 170, 171,
+	175, 172, 168, 169, 173, 174, 581, 93, 156, 367,
+	368, 582, 63, 85, 247, 956, 93, 608, 528, 89,
+	90, 226, 93, 217, 176, 186, 180, 216, 762, 763,
+	219, 529, 764, 612, 93, 917, 219, 164, 765, 91,
+	965, 475, 80, 296, 93, 474, 225, 994, 219, 226,
+	225, 224, 227, 226, 953, 81, 87, 84, 88, 86,
+	935, 92, 238, 162, 240, 82, 284, 85, 78, 285,
+	915, 93, 925, 89, 90, 367, 368, 890, 217, 889,
+	834, 80, 216, 93, 822, 219, 919, 833, 688, 814,
+	225, 260, 272, 226, 81, 87, 84, 88, 86, 215,
+	92, 768, 718, 717, 82, 716, 920, 78, 715, 639,
+	585, 902, 286, 287, 288, 289, 290, 291, 292, 293,
+	822, 230, 263, 190, 578, 579, 281, 773, 260, 333,
+	305, 279, 241, 280, 772, 80, 63, 93, 295, 599,
+	597, 167, 299, 588, 300, 586, 303, 304, 81, 87,
+	84, 88, 86, 566, 92, 821, 85, 565, 82, 462,
+	275, 78, 89, 90, 537, 538, 436, 220, 389, 262,
+	435, 324, 540, 539, 307, 323, 331, 233, 312, 988,
+	650, 651, 184, 922, 350, 153, 220, 848, 916, 788,
+	220, 825, 151, 351, 369, 403, 370, 686, 687, 335,
+	748, 371, 372, 220, 591, 690, 689, 658, 671, 366,
+	365, 845, 810, 308, 809, 298, 801, 353, 314, 315,
+	758, 317, 318, 757, 80, 325, 93, 390, 743, 330,
+	701, 700, 663, 598, 662, 645, 643, 81, 87, 84,
+	88, 86, 76, 92, 220, 642, 410, 82, 640, 637,
+	78, 623, 622, 621, 616, 225, 648, 445, 226, 649,
+	614, 600, 182, 587, 404, 568, 473, 534, 518, 517,
+	419, 514, 748, 483, 513, 990, 533, 491, 485, 417,
+	488, 489, 402, 438, 170, 171, 175, 172, 168, 169,
+	173, 174, 448, 401, 154, 400, 503, 504, 505, 418,
+	397, 152, 422, 424, 396, 427, 476, 177, 395, 392,
+	501, 260, 260, 496, 497, 388, 179, 178, 443, 358,
+	93, 357, 260, 356, 354, 349, 348, 347, 342, 490,
+	334, 492, 332, 328, 522, 506, 309, 301, 274, 420,
+	234, 546, 425, 232, 228, 214, 431, 212, 433, 211,
+	210, 656, 550, 440, 620, 441, 535, 166, 531, 699,
+	570, 170, 171, 175, 172, 168, 169, 173, 174, 177,
+	624, 479, 619, 577, 610, 567, 545, 487, 179, 178,
+	480, 477, 552, 434, 355, 556, 220, 346, 876, 875,
+	726, 521, 473, 520, 609, 569, 532, 583, 444, 995,
+	972, 220, 584, 220, 852, 958, 957, 851, 548, 549,
+	606, 551, 952, 607, 555, 934, 74, 596, 494, 618,
+	908, 564, 892, 884, 849, 605, 844, 843, 573, 575,
+	576, 842, 840, 839, 749, 611, 629, 613, 745, 632,
+	615, 744, 647, 731, 631, 495, 530, 530, 481, 409,
+	638, 369, 222, 636, 986, 929, 559, 887, 562, 898,
+	628, 626, 659, 790, 387, 571, 732, 673, 657, 654,
+	630, 652, 677, 502, 679, 499, 377, 376, 675, 676,
+	375, 653, 373, 144, 379, 380, 381, 382, 383, 384,
+	683, 702, 386, 385, 698, 345, 364, 672, 74, 710,
+	756, 85, 362, 706, 989, 708, 709, 89, 90, 678,
+	973, 948, 714, 682, 895, 220, 862, 220, 841, 775,
+	776, 406, 774, 655, 661, 635, 634, 633, 625, 165,
+	835, 185, 188, 220, 338, 674, 463, 235, 221, 157,
+	736, 341, 159, 735, 979, 740, 893, 815, 730, 830,
+	696, 697, 885, 884, 750, 751, 752, 725, 723, 704,
+	705, 205, 707, 881, 239, 947, 206, 746, 982, 80,
+	728, 93, 977, 714, 341, 951, 223, 969, 818, 664,
+	665, 439, 81, 87, 84, 88, 86, 760, 92, 754,
+	339, 829, 82, 741, 759, 188, 510, 778, 779, 432,
+	780, 511, 430, 137, 777, 363, 766, 188, 770, 326,
+	327, 321, 322, 202, 203, 329, 783, 229, 158, 771,
+	800, 361, 816, 339, 313, 864, 798, 799, 805, 795,
+	807, 808, 789, 142, 803, 804, 63, 806, 794, 135,
+	782, 781, 132, 784, 134, 276, 187, 694, 684, 136,
+	220, 824, 681, 796, 195, 196, 197, 558, 837, 133,
+	316, 3, 812, 811, 199, 220, 200, 319, 320, 727,
+	282, 823, 283, 785, 311, 464, 769, 193, 767, 191,
+	192, 341, 926, 797, 828, 138, 260, 194, 838, 660,
+	411, 802, 143, 832, 877, 530, 302, 184, 927, 857,
+	139, 140, 273, 127, 141, 854, 155, 201, 850, 756,
+	458, 461, 853, 459, 460, 856, 509, 846, 813, 734,
+	869, 870, 150, 720, 595, 872, 873, 868, 874, 791,
+	792, 594, 871, 593, 592, 863, 160, 261, 231, 126,
+	858, 213, 124, 861, 125, 466, 883, 189, 738, 739,
+	928, 827, 826, 865, 866, 146, 604, 147, 882, 891,
+	146, 146, 886, 148, 408, 888, 831, 146, 149, 793,
+	859, 721, 860, 894, 693, 680, 617, 896, 557, 306,
+	897, 469, 692, 906, 867, 128, 416, 561, 554, 391,
+	913, 343, 131, 914, 429, 524, 374, 912, 421, 423,
+	129, 426, 428, 500, 130, 641, 515, 907, 264, 437,
+	923, 901, 512, 918, 442, 837, 837, 904, 905, 924,
+	393, 493, 265, 909, 879, 266, 938, 939, 932, 930,
+	931, 878, 855, 270, 943, 936, 268, 394, 667, 668,
+	941, 942, 446, 447, 415, 713, 903, 543, 146, 945,
+	269, 407, 278, 910, 911, 146, 954, 415, 627, 960,
+	147, 937, 163, 962, 963, 147, 147, 959, 209, 63,
+	961, 742, 399, 966, 964, 398, 970, 945, 971, 188,
+	508, 104, 486, 974, 484, 482, 478, 465, 360, 359,
+	352, 310, 271, 267, 978, 237, 236, 940, 985, 208,
+	980, 207, 163, 544, 547, 987, 413, 603, 120, 553,
+	985, 993, 992, 991, 644, 560, 519, 563, 99, 94,
+	516, 95, 96, 146, 572, 574, 204, 106, 602, 253,
+	252, 468, 63, 198, 467, 103, 472, 97, 471, 729,
+	724, 722, 64, 65, 820, 975, 976, 100, 984, 102,
+	967, 949, 70, 968, 67, 950, 981, 119, 116, 117,
+	118, 123, 101, 107, 68, 110, 85, 105, 787, 111,
+	450, 666, 89, 90, 526, 670, 297, 69, 378, 108,
+	183, 72, 83, 258, 109, 115, 66, 257, 249, 243,
+	85, 245, 1, 112, 113, 77, 89, 90, 57, 121,
+	122, 71, 56, 55, 62, 61, 60, 59, 58, 54,
+	53, 52, 344, 51, 50, 49, 254, 48, 255, 47,
+	114, 46, 256, 73, 45, 98, 44, 43, 40, 42,
+	41, 39, 38, 37, 250, 36, 93, 35, 34, 33,
+	32, 31, 30, 691, 29, 28, 695, 251, 87, 84,
+	88, 86, 27, 92, 26, 703, 63, 82, 507, 25,
+	93, 24, 21, 20, 22, 19, 64, 65, 23, 18,
+	17, 81, 87, 84, 88, 86, 70, 92, 67, 16,
+	14, 82, 15, 13, 12, 719, 7, 11, 68, 10,
+	9, 8, 336, 6, 5, 454, 455, 0, 0, 0,
+	0, 69, 0, 0, 0, 72, 452, 456, 458, 461,
+	66, 459, 460, 0, 0, 0, 0, 453, 0, 0,
+	0, 0, 0, 0, 0, 71, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 457, 0,
+	0, 0, 0, 0, 0, 0, 0, 73, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	442, 0, 0, 0, 0, 0, 0, 133, 0, 0,
-	0, 0, 0, 0, 138, 0, 0, 0, 0, 0,
-	0, 0, 134, 135, 0, 0, 136,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
 }
 
 var yyPact = [...]int16{
-	938, -1000, 434, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1004, -1000, 448, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 956,
-	947, 674, 1101, 891, 765, 213, 194, 659, 565, 500,
-	938, 909, 978, 465, 259, 130, 270, 292, 270, -1000,
-	-1000, 166, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	477, 578, 722, 645, 661, -1000, 626, 965, 614, 689,
-	579, 963, 529, 537, 948, 946, -1000, -1000, -1000, 897,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 255,
-	720, 254, 51, 497, 504, -26, -26, 253, 891, 718,
-	252, 83, 251, 496, 944, 943, -26, 532, -26, 892,
-	-1000, -28, 29, 717, 51, 829, 942, 861, 941, 906,
-	-1000, 684, 250, 70, -1000, 961, 873, -28, 910, 978,
-	641, 14, 270, 270, 270, 270, 270, 270, 270, 270,
-	-81, 912, 114, 248, -1000, 670, 675, 675, 29, -1000,
-	782, 917, 247, 940, 891, 586, 917, 917, 631, 917,
-	630, 569, 104, 917, 566, 246, 585, 917, 51, -1000,
-	-1000, 245, -26, 244, 568, 243, 801, 424, 299, 239,
-	-1000, -1000, -1000, 238, 237, 978, 910, -1000, -1000, 937,
-	-1000, 892, -1000, 235, -1000, -1000, 296, 232, 231, 229,
-	-1000, 936, 935, -1000, -1000, 550, 535, -1000, -1000, 787,
-	-85, -1000, 29, 187, 421, 769, 417, 416, 414, -1000,
-	-1000, 163, -73, 227, 799, 226, 834, 221, 220, 219,
-	922, 218, 217, -1000, 216, -26, -1000, 892, 469, 869,
-	-1000, 961, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -99,
-	-99, -99, -1000, -1000, -99, -1000, 386, -1000, -1000, -1000,
-	-1000, -1000, -1000, 270, 668, -1000, 30, 953, 863, 798,
-	-1000, 215, 892, 863, 917, 891, 891, 917, 891, 814,
-	580, 917, 577, 917, 295, 98, 899, 567, 917, -1000,
-	917, 891, -1000, -1000, -1000, 327, 525, -1000, 1099, 67,
-	481, 647, 934, 737, 797, -26, -18, 294, 928, 300,
-	378, 926, -26, -1000, 925, 214, 923, 281, -1000, -26,
-	-26, -28, 212, -28, 840, 341, 377, 29, 29, -81,
-	-41, 413, 813, 906, 412, -26, -26, -26, 380, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 918, 560,
-	838, 206, 204, -1000, 837, 959, 199, 192, -1000, 958,
-	325, 322, 873, 806, -43, -43, 892, -1000, -4, 191,
-	270, 92, 874, 866, 914, -1000, 863, 874, 891, 892,
-	873, 892, 863, 812, 892, 863, 795, 627, 917, 803,
-	917, 891, 89, 273, 188, 863, 874, 917, 891, 891,
-	892, 873, 48, -1000, -1000, 1099, -1000, 32, 65, 181,
-	64, -1000, 119, 715, 713, 712, 710, 657, 60, 95,
-	173, -58, -1000, -1000, 745, -1000, -26, 334, -8, 267,
-	-10, -1000, -10, 171, 978, 170, 791, 906, 286, 169,
-	-1000, 168, 167, -1000, 265, -1000, 464, -1000, -28, 895,
-	-1000, -1000, -1000, -1000, 176, 411, 375, 906, 463, 461,
-	460, -1000, 29, 164, 119, 160, 828, -1000, 159, 151,
-	957, -1000, 150, -77, 24, 469, 863, 410, -1000, 458,
-	190, 408, 133, -1000, -1000, 873, -1000, 665, -73, 892,
-	148, 147, 222, 222, -1000, 855, -53, -53, 120, 92,
-	874, -1000, 892, 873, 873, 874, 863, 874, 790, 617,
-	863, 874, 615, 211, 783, 789, 601, 891, 892, 873,
-	96, 138, 135, -1000, 874, -1000, 891, 892, 873, 892,
-	873, 873, 874, -89, -105, -1000, -1000, -1000, -1000, -1000,
-	443, -1000, -1000, 21, 20, 18, 17, -1000, -1000, -1000,
-	-1000, 708, 788, 527, 526, 321, -1000, -1000, -1000, -1000,
-	635, -10, -1000, -1000, -1000, 512, 372, 405, 707, 503,
-	-26, 768, -1000, -1000, -1000, -26, -28, 900, 132, 371,
-	367, 198, -1000, 365, -26, -26, -26, -83, 1099, 501,
-	-1000, 131, -1000, -1000, 129, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 806, 874, -100, -43, 652, 13, 646, 469,
-	-1000, 863, -1000, -1000, -1000, -1000, -1000, 58, 52, 860,
-	-1000, -1000, -1000, -1000, 453, 457, -1000, -1000, 873, 874,
-	874, -1000, 874, -1000, 600, 211, 874, -1000, 211, 892,
-	115, 115, 404, 222, 222, 781, 596, 595, 211, 892,
-	873, 873, 874, 128, -1000, -1000, -1000, 892, 873, 873,
-	874, 873, 874, 874, -1000, 127, 124, 119, -1000, -1000,
-	-1000, -1000, 703, 11, 572, 559, 106, 559, 125, 776,
-	-1000, -1000, 662, 555, 767, 978, -1000, 1, -11, 474,
-	-26, -1000, -1000, -1000, -1000, 29, -1000, -1000, -1000, 360,
-	359, 451, -1000, 358, 357, 353, -1000, -1000, -1000, 122,
-	-1000, -1000, 863, 110, 350, -1000, -1000, -1000, -100, -1000,
-	-1000, 333, -1000, 806, 874, 857, -1000, -53, 120, -1000,
-	-1000, 874, -1000, -1000, -1000, 211, 892, -1000, 892, 863,
-	-1000, 450, -1000, -1000, 115, -1000, -1000, 592, 211, 211,
-	892, 873, 874, 874, -1000, -1000, 873, 874, 874, -1000,
-	874, -1000, -1000, 318, 313, -1000, -1000, 678, 849, 845,
-	542, 119, -1000, 106, 524, 518, 542, -1000, 390, -1000,
-	-1000, 906, -13, -15, 707, 347, 508, -1000, 768, -1000,
-	444, -85, -1000, -1000, 117, -1000, -1000, -1000, -1000, 874,
-	-1000, 400, -1000, -1000, -1000, -91, 863, -1000, 49, -1000,
-	-1000, -1000, 892, 863, 863, 874, 115, 346, 211, 892,
-	892, 873, 874, -1000, -1000, 874, -1000, -1000, -1000, 45,
-	113, -37, -1000, -1000, 695, 0, 443, -1000, 109, 109,
-	695, -22, 663, 683, -1000, -1000, 749, 392, -26, -26,
-	-1000, 110, -95, 345, -29, 874, -1000, 863, 874, 874,
-	-1000, -1000, -1000, 892, 873, 873, 874, -1000, -1000, -1000,
-	-1000, 694, -1000, -1000, -1000, -1000, 442, -1000, 556, 344,
-	-1000, -33, 707, -48, -1000, -1000, -1000, 343, -1000, 338,
-	110, 874, -1000, -1000, 873, 874, 874, -1000, -1000, 694,
-	109, 553, -1000, 109, 106, -1000, -1000, 335, 441, -1000,
-	-1000, -1000, -1000, 874, -1000, -1000, -1000, -1000, 551, -1000,
-	109, -1000, -1000, 506, -48, -1000, 548, -1000, -26, -1000,
-	391, -1000, -1000, 107, -1000, 439, 307, -48, -1000, -26,
-	-38, 332, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 173, 956, 778, 678, 931, 797, 237,
+	230, 708, 582, 513, 1004, 936, 30, 480, 296, 211,
+	518, 318, 518, -1000, -1000, 198, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 490, 605, 780, 680, 696, -1000,
+	660, 1009, 670, 729, 614, 1002, 546, 557, 974, 972,
+	-1000, -1000, -1000, 939, 286, 285, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 283, 773, 281, 18, 509, 525,
+	-14, -14, 280, 931, 770, 279, 112, 276, 508, 969,
+	968, -14, 551, -14, 937, -1000, -37, 983, 769, 104,
+	18, 881, 966, 909, 965, 941, -1000, 724, 274, 95,
+	-1000, 999, 921, -37, 976, 30, 679, 2, 518, 518,
+	518, 518, 518, 518, 518, 518, -83, -9, 151, 273,
+	-1000, 710, 713, 713, 983, -1000, 828, 952, 272, 964,
+	931, 624, 952, 952, 665, 952, 668, 612, 111, 952,
+	610, 269, 615, 952, 18, -1000, -1000, 268, -14, 266,
+	-1000, 937, 583, 264, 840, 444, 327, 263, -1000, -1000,
+	-1000, 262, 261, 30, 976, -1000, -1000, 963, -1000, 937,
+	-1000, 260, -1000, -1000, 324, 259, 257, 255, -1000, 962,
+	961, -1000, -1000, 572, 556, -1000, -1000, 1128, -62, -1000,
+	983, 256, 431, 849, 429, 426, 425, -1000, -1000, 430,
+	-75, 251, 163, 838, 245, 893, 244, 240, 236, 948,
+	231, 229, -1000, 218, -14, -1000, 937, 475, 919, -1000,
+	999, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -102, -102,
+	-102, -1000, -1000, -102, -1000, 397, -1000, -1000, -1000, -1000,
+	-1000, -1000, 518, 704, -1000, 4, 981, 911, 835, -1000,
+	215, 937, 911, 952, 931, 931, 952, 931, 843, 602,
+	952, 599, 952, 323, 106, 924, 581, 952, -1000, 952,
+	931, -1000, -1000, -1000, 344, 908, 550, -1000, 1137, 94,
+	496, 683, 960, 788, 830, -14, -19, 321, 959, 320,
+	396, 958, -14, -1000, 957, 214, 955, 317, -1000, -14,
+	-14, -37, 213, -37, 878, 366, 393, 983, 983, -83,
+	-86, 424, 858, 941, 422, -14, -14, -14, 1007, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 953, -1000,
+	744, 595, 868, 210, 207, -1000, 862, 996, 205, 204,
+	-1000, 992, 339, 337, 921, 846, -46, -46, 937, -1000,
+	288, 203, 518, 110, 908, 915, 978, -1000, 911, 908,
+	931, 937, 921, 937, 911, 837, 937, 911, 827, 661,
+	952, 836, 952, 931, 93, 315, 201, 911, 908, 952,
+	931, 931, 937, 921, 60, -1000, -59, -59, -1000, -1000,
+	1137, -1000, 44, 80, 199, 78, -1000, 140, 765, 764,
+	762, 755, 690, 75, 169, 197, -79, -1000, -1000, 804,
+	-1000, -14, 361, 26, 314, -31, -1000, -31, 196, 30,
+	190, 825, 941, 312, 189, -1000, 188, 187, -1000, 310,
+	-1000, 479, -1000, -37, 928, -1000, -1000, -1000, -1000, 84,
+	419, 392, 941, 478, 477, 476, -1000, 983, 185, -1000,
+	140, 43, 184, 861, -1000, 181, 172, 990, -1000, 171,
+	-85, 191, 475, 911, 418, -1000, 474, 290, 417, 146,
+	-1000, -1000, 921, -1000, 701, -75, 937, 170, 168, 267,
+	267, -1000, 902, 144, 110, 908, -1000, 937, 921, 921,
+	908, 911, 908, 824, 656, 911, 908, 652, 143, 831,
+	823, 651, 931, 937, 921, 299, 167, 166, -1000, 908,
+	-1000, 931, 937, 921, 937, 921, 921, 908, -92, -99,
+	910, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 463,
+	-1000, -1000, 42, 39, 37, 36, -1000, -1000, -1000, -1000,
+	754, 820, 542, 541, 336, -1000, -1000, -1000, -1000, 676,
+	-31, -1000, -1000, -1000, 527, 391, 415, 750, 516, -14,
+	793, -1000, -1000, -1000, -14, -37, 944, 164, 389, 386,
+	208, -1000, 382, -14, -14, -14, -88, 1137, 524, -1000,
+	-1000, 159, -1000, -1000, 156, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 846, 908, -36, -46, 687, 35, 685, 475,
+	-1000, 911, -1000, -1000, -1000, -1000, -1000, 69, 62, -1000,
+	473, 472, -1000, -1000, 921, 908, 908, -1000, 908, -1000,
+	645, 143, 908, -1000, 143, 937, 125, 125, 412, 267,
+	267, 818, 642, 633, 143, 937, 921, 921, 908, 152,
+	-1000, -1000, -1000, 937, 921, 921, 908, 921, 908, 908,
+	-1000, 150, 148, -59, 140, -1000, -1000, -1000, -1000, 748,
+	23, 592, 577, 91, 577, 127, 798, -1000, -1000, 697,
+	571, 815, 30, -1000, 21, 14, 488, -14, -1000, -1000,
+	-1000, -1000, 983, -1000, -1000, -1000, 381, 380, 469, -1000,
+	379, 375, 374, -1000, -1000, -1000, 147, -1000, -1000, 911,
+	123, 372, -1000, -1000, -1000, -36, -1000, -1000, 355, -1000,
+	846, 908, 895, -1000, 144, -1000, -1000, 908, -1000, -1000,
+	-1000, 143, 937, -1000, 937, 911, -1000, 467, -1000, -1000,
+	125, -1000, -1000, 629, 143, 143, 937, 921, 908, 908,
+	-1000, -1000, 921, 908, 908, -1000, 908, -1000, -1000, 335,
+	334, -1000, -1000, -1000, 714, 890, 883, 552, 140, -1000,
+	91, 536, 535, 552, -1000, 406, -1000, -1000, 941, 13,
+	11, 750, 370, 522, -1000, 793, -1000, 465, -62, -1000,
+	-1000, 136, -1000, -1000, -1000, -1000, 908, -1000, 408, -1000,
+	-1000, -1000, -101, 911, -1000, 46, -1000, -1000, 937, 911,
+	911, 908, 125, 368, 143, 937, 937, 921, 908, -1000,
+	-1000, 908, -1000, -1000, -1000, 5, 124, -30, -1000, -1000,
+	733, 41, 463, -1000, 119, 119, 733, 6, 694, 720,
+	-1000, -1000, 799, 404, -14, -14, -1000, 123, -97, 363,
+	-6, 908, -1000, 911, 908, 908, -1000, -1000, -1000, 937,
+	921, 921, 908, -1000, -1000, -1000, -1000, 739, 559, -1000,
+	-1000, -1000, 462, -1000, 573, 360, -1000, -12, 750, -51,
+	-1000, -1000, -1000, 354, -1000, 353, 123, 908, -1000, -1000,
+	921, 908, 908, -1000, -1000, 739, -1000, -26, 119, 574,
+	-1000, 119, 91, -1000, -1000, 348, 461, -1000, -1000, -1000,
+	-1000, 908, -1000, -1000, -1000, -1000, -1000, 568, -1000, 119,
+	-1000, -1000, 519, -51, -1000, 563, -1000, -14, -1000, 403,
+	-1000, 559, 115, -1000, 455, 221, -51, -1000, -1000, -14,
+	-18, 347, -1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 730, 1164, 1163, 1161, 1160, 19, 1158, 1157, 1156,
-	1155, 1146, 1143, 1141, 1140, 1139, 1133, 1130, 1129, 1128,
-	1126, 1120, 1119, 1118, 1117, 1116, 1115, 20, 1114, 1113,
-	1111, 1107, 1098, 1097, 1096, 1094, 1093, 1092, 1091, 1089,
-	1087, 1085, 1084, 1083, 1082, 1081, 9, 1080, 1079, 1078,
-	1077, 1076, 1075, 1074, 1073, 1072, 1071, 1067, 1066, 1062,
-	1060, 1053, 28, 17, 1052, 1051, 40, 587, 49, 39,
-	42, 1050, 34, 1046, 41, 31, 70, 1045, 1040, 26,
-	1038, 1034, 52, 36, 13, 1027, 46, 1024, 1023, 23,
-	11, 1022, 15, 30, 33, 1021, 16, 1, 1014, 22,
-	24, 8, 6, 1013, 32, 119, 1012, 254, 12, 29,
-	0, 1011, 14, 1010, 21, 27, 3, 1005, 1004, 7,
-	1003, 1002, 2, 999, 997, 994, 10, 988, 4, 987,
-	986, 985, 5, 25, 18, 35, 984, 983, 37, 38,
-	980, 977, 976, 974,
+	0, 741, 1174, 1173, 1172, 1171, 16, 1170, 1169, 1167,
+	1166, 1165, 1164, 1163, 1162, 1160, 1159, 1150, 1149, 1148,
+	1145, 1144, 1143, 1142, 1141, 1139, 1134, 38, 1132, 1125,
+	1124, 1122, 1121, 1120, 1119, 1118, 1117, 1115, 1113, 1112,
+	1111, 1110, 1109, 1108, 1107, 1106, 1104, 1101, 7, 1099,
+	1097, 1095, 1094, 1093, 1092, 1091, 1090, 1089, 1088, 1087,
+	1086, 1085, 1084, 1083, 1082, 1078, 40, 15, 1075, 1072,
+	42, 563, 47, 41, 48, 1071, 39, 1069, 51, 32,
+	67, 1068, 1067, 25, 1063, 1062, 23, 36, 24, 1060,
+	43, 1058, 1056, 20, 37, 1055, 9, 28, 30, 1054,
+	13, 1, 1051, 21, 22, 8, 11, 1050, 31, 119,
+	1048, 105, 12, 27, 0, 1042, 17, 1036, 19, 26,
+	4, 1035, 1033, 14, 1031, 1030, 2, 1028, 1026, 1025,
+	10, 1024, 5, 1021, 1020, 1019, 6, 3, 29, 18,
+	34, 1018, 1016, 33, 35, 1014, 1011, 1008, 987,
 }
 
 var yyR1 = [...]uint8{
-	0, 65, 66, 66, 66, 1, 1, 1, 1, 1,
+	0, 69, 70, 70, 70, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	6, 6, 6, 62, 62, 64, 64, 64, 64, 64,
-	64, 86, 86, 85, 63, 63, 82, 82, 82, 82,
-	82, 82, 82, 82, 82, 82, 82, 82, 82, 82,
-	82, 82, 70, 70, 67, 68, 68, 68, 68, 68,
-	68, 68, 71, 69, 69, 69, 73, 74, 74, 74,
-	74, 74, 72, 72, 72, 92, 92, 93, 93, 94,
-	94, 110, 110, 95, 95, 95, 95, 95, 95, 95,
-	95, 126, 126, 99, 99, 100, 100, 100, 100, 76,
-	76, 78, 78, 77, 77, 79, 79, 79, 79, 79,
-	79, 79, 79, 79, 79, 79, 80, 83, 83, 87,
-	87, 87, 87, 87, 87, 87, 87, 87, 105, 81,
-	81, 81, 81, 81, 81, 81, 81, 81, 81, 88,
-	88, 88, 90, 90, 89, 89, 91, 91, 91, 96,
-	133, 133, 97, 97, 97, 97, 98, 98, 98, 98,
-	2, 2, 3, 3, 139, 139, 139, 139, 139, 135,
-	135, 4, 104, 104, 103, 103, 103, 103, 103, 103,
-	103, 7, 7, 8, 8, 75, 75, 75, 75, 9,
-	9, 10, 10, 5, 5, 5, 11, 11, 101, 101,
-	102, 102, 102, 102, 12, 12, 12, 12, 13, 15,
-	14, 14, 16, 16, 17, 18, 20, 20, 20, 22,
-	22, 21, 21, 21, 23, 23, 19, 24, 24, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 53, 53,
-	53, 53, 53, 107, 107, 25, 25, 26, 26, 26,
-	26, 27, 27, 27, 27, 27, 84, 84, 106, 28,
-	28, 29, 29, 29, 29, 30, 30, 30, 30, 31,
-	31, 31, 31, 32, 32, 140, 140, 141, 129, 129,
-	130, 130, 130, 115, 115, 134, 134, 134, 142, 142,
-	143, 120, 120, 121, 121, 125, 125, 113, 113, 52,
-	52, 138, 138, 136, 136, 137, 137, 137, 127, 127,
-	128, 128, 116, 116, 108, 108, 117, 118, 122, 122,
-	124, 123, 123, 123, 114, 114, 109, 33, 34, 35,
-	36, 36, 36, 36, 37, 37, 37, 37, 38, 38,
-	39, 39, 40, 41, 41, 42, 131, 131, 131, 131,
-	43, 44, 45, 45, 45, 47, 47, 47, 47, 48,
-	48, 46, 132, 132, 49, 49, 50, 50, 51, 54,
-	55, 119, 119, 112, 112, 59, 59, 60, 61, 61,
-	61, 61, 56, 57, 57, 57, 57, 57, 58, 58,
-	58, 58, 58,
+	1, 1, 1, 1, 6, 6, 6, 66, 66, 68,
+	68, 68, 68, 68, 68, 90, 90, 89, 67, 67,
+	86, 86, 86, 86, 86, 86, 86, 86, 86, 86,
+	86, 86, 86, 86, 86, 86, 74, 74, 71, 72,
+	72, 72, 72, 72, 72, 72, 75, 73, 73, 73,
+	77, 78, 78, 78, 78, 78, 76, 76, 76, 96,
+	96, 97, 97, 98, 98, 114, 114, 99, 99, 99,
+	99, 99, 99, 99, 99, 130, 130, 103, 103, 104,
+	104, 104, 104, 80, 80, 82, 82, 81, 81, 83,
+	83, 83, 83, 83, 83, 83, 83, 83, 83, 83,
+	84, 87, 87, 91, 91, 91, 91, 91, 91, 91,
+	91, 91, 109, 85, 85, 85, 85, 85, 85, 85,
+	85, 85, 85, 92, 92, 92, 94, 94, 93, 93,
+	95, 95, 95, 100, 138, 138, 101, 101, 101, 101,
+	102, 102, 102, 102, 2, 2, 3, 3, 144, 144,
+	144, 144, 144, 140, 140, 4, 108, 108, 107, 107,
+	107, 107, 107, 107, 107, 7, 7, 8, 8, 79,
+	79, 79, 79, 9, 9, 10, 10, 5, 5, 5,
+	11, 11, 105, 105, 106, 106, 106, 106, 12, 12,
+	12, 12, 13, 15, 14, 14, 16, 16, 17, 18,
+	20, 20, 20, 22, 22, 21, 21, 21, 23, 23,
+	19, 24, 24, 115, 115, 115, 115, 115, 115, 115,
+	115, 115, 55, 55, 55, 55, 55, 111, 111, 25,
+	25, 26, 26, 26, 26, 27, 27, 27, 27, 27,
+	88, 88, 110, 28, 28, 29, 29, 29, 29, 30,
+	30, 30, 30, 31, 31, 31, 31, 32, 32, 145,
+	145, 146, 133, 133, 134, 134, 134, 119, 119, 139,
+	139, 139, 147, 147, 148, 124, 124, 125, 125, 129,
+	129, 117, 117, 137, 137, 54, 54, 143, 143, 141,
+	141, 142, 142, 142, 131, 131, 132, 132, 120, 120,
+	112, 112, 121, 122, 126, 126, 128, 127, 127, 127,
+	118, 118, 113, 33, 43, 43, 43, 34, 35, 36,
+	36, 36, 36, 37, 37, 37, 37, 38, 38, 39,
+	39, 40, 41, 42, 42, 44, 135, 135, 135, 135,
+	45, 46, 47, 47, 47, 49, 49, 49, 49, 50,
+	50, 48, 136, 136, 51, 51, 52, 52, 53, 56,
+	57, 123, 123, 116, 116, 63, 63, 64, 65, 65,
+	65, 65, 58, 59, 59, 59, 59, 59, 60, 60,
+	60, 60, 60, 61, 62,
 }
 
 var yyR2 = [...]int8{
@@ -757,145 +767,148 @@ var yyR2 = [...]int8{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	11, 12, 9, 1, 3, 1, 3, 3, 1, 3,
-	3, 1, 2, 4, 1, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 4, 3, 2, 1, 1,
-	5, 6, 2, 0, 2, 1, 3, 1, 3, 3,
-	5, 1, 6, 3, 5, 3, 1, 5, 4, 4,
-	3, 1, 1, 1, 1, 3, 0, 2, 0, 1,
-	3, 1, 1, 1, 3, 4, 6, 7, 1, 3,
-	1, 4, 0, 4, 0, 1, 1, 1, 2, 2,
-	0, 1, 3, 1, 3, 1, 3, 5, 5, 4,
-	6, 6, 5, 6, 6, 6, 3, 1, 3, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 1, 1, 1, 1, 1, 3, 1, 1,
-	1, 1, 3, 0, 1, 3, 1, 2, 2, 2,
-	1, 1, 4, 2, 2, 0, 4, 2, 2, 0,
-	2, 3, 5, 4, 2, 1, 3, 3, 0, 3,
-	3, 2, 1, 2, 1, 2, 2, 2, 2, 1,
-	2, 9, 6, 7, 4, 2, 2, 2, 2, 5,
-	3, 7, 8, 6, 9, 9, 5, 4, 1, 2,
-	3, 3, 3, 3, 7, 6, 8, 7, 2, 3,
-	4, 3, 3, 2, 7, 6, 6, 7, 6, 5,
-	4, 6, 7, 6, 5, 4, 3, 8, 7, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 4, 8,
-	7, 7, 6, 2, 0, 7, 6, 11, 10, 12,
-	11, 2, 2, 4, 2, 2, 1, 3, 1, 3,
-	2, 10, 9, 9, 8, 13, 12, 12, 11, 10,
-	9, 9, 8, 5, 5, 0, 6, 10, 0, 2,
-	0, 2, 6, 0, 2, 0, 2, 2, 0, 3,
-	3, 0, 1, 0, 1, 0, 1, 0, 2, 2,
-	0, 2, 1, 2, 2, 2, 3, 2, 3, 3,
-	2, 0, 1, 3, 2, 0, 2, 2, 3, 1,
-	2, 3, 3, 0, 1, 3, 1, 3, 6, 4,
-	9, 8, 8, 7, 9, 8, 8, 7, 2, 4,
-	7, 3, 3, 3, 5, 10, 3, 3, 5, 0,
+	1, 1, 1, 1, 11, 12, 9, 1, 3, 1,
+	3, 3, 1, 3, 3, 1, 2, 4, 1, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
+	3, 2, 1, 1, 5, 6, 2, 0, 2, 1,
+	3, 1, 3, 3, 5, 1, 6, 3, 5, 3,
+	1, 5, 4, 4, 3, 1, 1, 1, 1, 3,
+	0, 2, 0, 1, 3, 1, 1, 1, 3, 4,
+	6, 7, 1, 3, 1, 4, 0, 4, 0, 1,
+	1, 1, 2, 2, 0, 1, 3, 1, 3, 1,
+	3, 5, 5, 4, 6, 6, 5, 6, 6, 6,
+	3, 1, 3, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 3, 1, 1, 1, 1, 1,
+	1, 3, 1, 1, 1, 1, 3, 0, 1, 3,
+	1, 2, 2, 2, 1, 1, 4, 2, 2, 0,
+	4, 2, 2, 0, 2, 3, 5, 4, 2, 1,
+	3, 3, 0, 3, 3, 2, 1, 2, 1, 2,
+	2, 2, 2, 1, 2, 9, 6, 7, 4, 2,
+	2, 2, 2, 5, 3, 7, 8, 6, 9, 9,
+	5, 4, 1, 2, 3, 3, 3, 3, 7, 6,
+	8, 7, 2, 3, 4, 3, 3, 2, 7, 6,
+	6, 7, 6, 5, 4, 6, 7, 6, 5, 4,
+	3, 8, 7, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 4, 8, 7, 7, 6, 2, 0, 7,
+	6, 11, 10, 12, 11, 2, 2, 4, 2, 2,
+	1, 3, 1, 3, 2, 10, 9, 9, 8, 13,
+	12, 12, 11, 10, 9, 9, 8, 5, 5, 0,
+	7, 11, 0, 2, 0, 2, 6, 0, 2, 0,
+	2, 2, 0, 3, 3, 0, 1, 0, 1, 0,
+	1, 0, 2, 0, 2, 2, 0, 2, 1, 2,
+	2, 2, 3, 2, 3, 3, 2, 0, 1, 3,
+	2, 0, 2, 2, 3, 1, 2, 3, 3, 0,
+	1, 3, 1, 3, 4, 4, 5, 6, 4, 9,
+	8, 8, 7, 9, 8, 8, 7, 2, 4, 7,
+	3, 6, 3, 3, 5, 10, 3, 3, 5, 0,
 	3, 6, 9, 11, 7, 4, 6, 2, 4, 2,
 	4, 10, 1, 3, 8, 6, 2, 4, 3, 2,
 	3, 1, 3, 1, 1, 10, 8, 2, 3, 5,
 	7, 5, 2, 6, 6, 6, 6, 6, 2, 6,
-	6, 10, 10,
+	6, 10, 10, 3, 5,
 }
 
 var yyChk = [...]int16{
-	-1000, -65, -66, -1, -6, -2, -3, -10, -5, -7,
+	-1000, -69, -70, -1, -6, -2, -3, -10, -5, -7,
 	-8, -9, -12, -13, -15, -14, -16, -17, -18, -20,
 	-22, -23, -21, -19, -24, -25, -26, -28, -29, -30,
 	-31, -32, -33, -34, -35, -36, -37, -38, -39, -40,
-	-41, -42, -43, -44, -45, -47, -48, -49, -50, -51,
-	-53, -54, -55, -59, -60, -61, -56, -57, -58, 8,
-	18, 19, 62, 30, 40, 53, 28, 77, 57, 98,
-	129, -62, 148, -64, 156, -82, 130, 143, 153, -81,
-	145, 63, 147, 144, 146, 69, 70, -105, 149, 132,
-	43, 45, 46, 61, 148, 42, 71, -111, 73, 59,
-	5, 90, 51, 86, 102, 107, 88, 92, 116, 117,
-	82, 83, 84, 81, 32, 122, 123, 85, 143, 44,
-	46, 41, 5, 86, 101, 105, 93, 44, 61, 46,
-	41, 51, 5, 86, 101, 102, 105, 35, 93, -67,
-	-76, 4, 9, 46, 5, 35, 143, 35, 143, 78,
-	-6, 37, 115, 108, -1, -70, -76, 6, -62, 128,
-	140, 10, 156, 157, 152, 153, 155, 158, 159, 154,
-	-82, 130, 140, 139, -82, -86, 143, -85, 64, 120,
-	-107, 120, 7, 47, -107, 79, 80, 61, 71, 74,
-	75, 76, 4, 74, 76, 58, 79, 80, 4, 94,
-	88, 7, 7, 9, 143, 48, 143, -74, 143, 139,
-	-72, 146, -105, 108, 7, 130, -110, 143, 146, -110,
-	143, -67, -76, 48, 143, 144, 143, 108, 7, 7,
-	-110, 92, -110, -76, -68, -73, -69, -71, -74, 130,
-	-79, -77, 130, 143, 27, 26, 112, 114, 118, -78,
-	-80, -83, -82, 48, -74, 7, 21, 24, 7, 7,
-	21, 4, 7, -6, 58, 143, 144, -67, -92, 11,
-	-68, -70, -62, 71, 73, 143, 146, -82, -82, -82,
-	-82, -82, -82, -82, -82, 131, -62, 131, -88, 143,
-	71, 73, 143, 66, -86, -86, -79, 31, -76, -107,
-	143, 7, -67, -76, 80, -107, -107, 75, -107, -107,
-	79, 80, 79, 80, 143, 139, -107, 79, 80, 143,
-	80, -107, -74, 143, -110, 143, -4, -139, 31, 119,
-	-135, 71, 143, 31, -52, 130, 139, 143, 143, 143,
-	-62, -70, 7, -76, 143, 139, 143, 143, 143, 7,
-	7, 128, 10, 128, 20, -66, -69, 150, 151, -82,
-	-79, 25, 26, 130, 27, 130, 130, 130, -87, 133,
-	134, 135, 136, 137, 138, 142, 141, 113, 143, 31,
-	143, 7, 24, 143, 143, 143, 7, 4, 143, 143,
-	143, -110, -76, -93, 125, 12, -67, 131, -82, 66,
-	65, 5, -90, 13, 31, 143, -76, -90, -107, -67,
-	-76, -67, -76, -107, -67, -76, -67, 31, 80, -107,
-	80, -107, 139, 143, 139, -67, -90, 80, -107, -107,
-	-67, -76, 133, -139, -104, -103, -102, 49, 60, 38,
-	39, 50, 81, 51, 54, 55, 52, 144, 119, 72,
-	7, 37, -140, -141, 31, -138, -136, -137, -110, 143,
-	139, -72, 139, 7, 130, 139, 131, 7, -110, 7,
-	143, 7, 139, -110, -110, -68, 143, -68, 23, 131,
-	131, -79, -79, 131, 130, 25, -6, 130, -110, -110,
-	-110, -83, 130, 7, 81, 24, 143, 143, 24, 4,
-	143, 143, 4, 133, 133, -92, -99, 29, -94, -95,
-	-110, 143, 156, -105, -94, -76, 68, 143, -82, -75,
-	133, 134, 142, 141, -96, -97, 14, 15, 12, 5,
-	-90, -97, -67, -76, -76, -92, -76, -90, -67, 31,
-	-76, -90, 31, 76, -107, -67, 31, -107, -67, -76,
-	143, 139, 139, 143, -90, -97, -107, -67, -76, -67,
-	-76, -76, -92, 143, 144, -104, 145, 144, 143, 144,
-	-114, -109, 143, 49, 49, 49, 49, -135, 144, 143,
-	50, 143, 146, -142, -143, 32, -138, 128, 131, 71,
-	-110, 139, -72, 143, -72, 143, -62, 143, 31, -6,
-	139, 121, 143, 143, 143, 139, 128, -68, 10, -62,
-	-6, 130, 131, -6, 128, 128, 128, -79, 143, -114,
-	143, 24, 143, 143, 4, 143, 146, -110, 144, 147,
-	69, 70, -93, -90, 130, 128, 140, 130, 140, -92,
-	68, -76, 143, 143, -105, -105, -98, 16, 17, -133,
-	144, 149, -133, -89, -91, 143, -75, -97, -76, -92,
-	-92, -97, -90, -97, 31, 76, -90, -96, 76, -27,
-	133, 134, 25, 142, 141, -67, 31, 31, 76, -67,
-	-76, -76, -92, 139, 143, 143, -97, -67, -76, -76,
-	-92, -76, -92, -92, -97, 150, 150, 128, 145, 145,
-	145, 145, -11, 49, 31, -129, 95, -130, 95, 133,
-	73, -72, -131, 100, 131, 130, -46, 49, 106, -110,
-	-112, 35, 36, -110, -68, 7, 143, 131, 131, -6,
-	-63, 143, 131, -110, -110, -110, 131, -104, -108, 56,
-	143, 143, -99, -96, -100, 143, 144, 147, 153, -94,
-	71, 145, 71, -93, -90, 144, 144, 15, 128, 126,
-	127, -92, -97, -97, -97, 76, -27, -96, -27, -76,
-	-84, -106, 143, -84, 130, -105, -105, 31, 76, 76,
-	-27, -76, -92, -92, -97, 143, -76, -92, -92, -97,
-	-92, -97, -97, 143, 143, -109, 50, 145, 35, 109,
-	-115, 81, -128, -127, 143, 73, -115, -128, 143, 34,
-	33, 67, 99, 58, 31, -62, 145, 145, 121, -119,
-	-110, -79, 131, 131, 128, 131, 131, 131, 143, -90,
-	-126, 143, 131, -100, 131, 128, -99, -96, 17, -133,
-	-89, -97, -27, -76, -76, -90, 128, -84, 76, -27,
-	-27, -76, -92, -97, -97, -92, -97, -97, -97, 133,
-	133, 60, 21, 21, -134, 90, -114, -128, 96, 96,
-	-134, 130, -6, 145, 145, -46, 131, 103, -112, 128,
-	-63, -96, 130, 145, 153, -90, 144, -76, -90, -90,
-	-97, -84, 131, -27, -76, -76, -92, -97, -97, 144,
-	143, 144, -108, 124, 144, -116, 143, -116, -108, 145,
-	68, 58, 31, 130, -119, -119, -126, 146, 131, 145,
-	-96, -90, -97, -97, -76, -92, -92, -97, -101, -102,
-	128, -120, -117, 82, 131, 145, -46, -132, 145, 131,
-	131, -126, -97, -92, -97, -97, -101, -116, -121, -118,
-	83, -116, -128, 131, 128, -97, -125, -124, 84, -116,
-	104, -132, -113, 85, -122, -123, -110, 130, 143, 128,
-	133, -132, -122, -110, 144, 131,
+	-43, -41, -42, -44, -45, -46, -47, -49, -50, -51,
+	-52, -53, -55, -56, -57, -63, -64, -65, -58, -59,
+	-60, -61, -62, 8, 18, 19, 62, 30, 40, 53,
+	28, 77, 57, 99, 130, -66, 149, -68, 157, -86,
+	131, 144, 154, -85, 146, 63, 148, 145, 147, 69,
+	70, -109, 150, 133, 43, 45, 46, 61, 149, 42,
+	71, -115, 73, 59, 5, 91, 51, 87, 103, 108,
+	89, 93, 117, 118, 144, 109, 82, 83, 84, 81,
+	32, 123, 124, 85, 44, 46, 41, 5, 87, 102,
+	106, 94, 44, 61, 46, 41, 51, 5, 87, 102,
+	103, 106, 35, 94, -71, -80, 4, 9, 46, 51,
+	5, 35, 144, 35, 144, 78, -6, 37, 116, 109,
+	-1, -74, -80, 6, -66, 129, 141, 10, 157, 158,
+	153, 154, 156, 159, 160, 155, -86, 131, 141, 140,
+	-86, -90, 144, -89, 64, 121, -111, 121, 7, 47,
+	-111, 79, 80, 61, 71, 74, 75, 76, 4, 74,
+	76, 58, 79, 80, 4, 95, 89, 7, 7, 9,
+	144, 144, 144, 48, 144, -78, 144, 140, -76, 147,
+	-109, 109, 7, 131, -114, 144, 147, -114, 144, -71,
+	-80, 48, 144, 145, 144, 109, 7, 7, -114, 93,
+	-114, -80, -72, -77, -73, -75, -78, 131, -83, -81,
+	131, 144, 27, 26, 113, 115, 119, -82, -84, -87,
+	-86, 48, 145, -78, 7, 21, 24, 7, 7, 21,
+	4, 7, -6, 58, 144, 145, -71, -96, 11, -72,
+	-74, -66, 71, 73, 144, 147, -86, -86, -86, -86,
+	-86, -86, -86, -86, 132, -66, 132, -92, 144, 71,
+	73, 144, 66, -90, -90, -83, 31, -80, -111, 144,
+	7, -71, -80, 80, -111, -111, 75, -111, -111, 79,
+	80, 79, 80, 144, 140, -111, 79, 80, 144, 80,
+	-111, -78, 144, -114, 144, -80, -4, -144, 31, 120,
+	-140, 71, 144, 31, -54, 131, 140, 144, 144, 144,
+	-66, -74, 7, -80, 144, 140, 144, 144, 144, 7,
+	7, 129, 10, 129, 20, -70, -73, 151, 152, -86,
+	-83, 25, 26, 131, 27, 131, 131, 131, -91, 134,
+	135, 136, 137, 138, 139, 143, 142, 114, 144, 85,
+	144, 31, 144, 7, 24, 144, 144, 144, 7, 4,
+	144, 144, 144, -114, -80, -97, 126, 12, -71, 132,
+	-86, 66, 65, 5, -94, 13, 31, 144, -80, -94,
+	-111, -71, -80, -71, -80, -111, -71, -80, -71, 31,
+	80, -111, 80, -111, 140, 144, 140, -71, -94, 80,
+	-111, -111, -71, -80, 134, -101, 14, 15, -144, -108,
+	-107, -106, 49, 60, 38, 39, 50, 81, 51, 54,
+	55, 52, 145, 120, 72, 7, 37, -145, -146, 31,
+	-143, -141, -142, -114, 144, 140, -76, 140, 7, 131,
+	140, 132, 7, -114, 7, 144, 7, 140, -114, -114,
+	-72, 144, -72, 23, 132, 132, -83, -83, 132, 131,
+	25, -6, 131, -114, -114, -114, -87, 131, 7, 52,
+	81, 86, 24, 144, 144, 24, 4, 144, 144, 4,
+	134, 134, -96, -103, 29, -98, -99, -114, 144, 157,
+	-109, -98, -80, 68, 144, -86, -79, 134, 135, 143,
+	142, -100, -101, 12, 5, -94, -101, -71, -80, -80,
+	-96, -80, -94, -71, 31, -80, -94, 31, 76, -111,
+	-71, 31, -111, -71, -80, 144, 140, 140, 144, -94,
+	-101, -111, -71, -80, -71, -80, -80, -96, 144, 145,
+	-138, 145, 150, -138, -108, 146, 145, 144, 145, -118,
+	-113, 144, 49, 49, 49, 49, -140, 145, 144, 50,
+	144, 147, -147, -148, 32, -143, 129, 132, 71, -114,
+	140, -76, 144, -76, 144, -66, 144, 31, -6, 140,
+	122, 144, 144, 144, 140, 129, -72, 10, -66, -6,
+	131, 132, -6, 129, 129, 129, -83, 144, -118, 146,
+	144, 24, 144, 144, 4, 144, 147, -114, 145, 148,
+	69, 70, -97, -94, 131, 129, 141, 131, 141, -96,
+	68, -80, 144, 144, -109, -109, -102, 16, 17, -93,
+	-95, 144, -79, -101, -80, -96, -96, -101, -94, -101,
+	31, 76, -94, -100, 76, -27, 134, 135, 25, 143,
+	142, -71, 31, 31, 76, -71, -80, -80, -96, 140,
+	144, 144, -101, -71, -80, -80, -96, -80, -96, -96,
+	-101, 151, 151, 15, 129, 146, 146, 146, 146, -11,
+	49, 31, -133, 96, -134, 96, 134, 73, -76, -135,
+	101, 132, 131, -48, 49, 107, -114, -116, 35, 36,
+	-114, -72, 7, 144, 132, 132, -6, -67, 144, 132,
+	-114, -114, -114, 132, -108, -112, 56, 144, 144, -103,
+	-100, -104, 144, 145, 148, 154, -98, 71, 146, 71,
+	-97, -94, 145, 145, 129, 127, 128, -96, -101, -101,
+	-101, 76, -27, -100, -27, -80, -88, -110, 144, -88,
+	131, -109, -109, 31, 76, 76, -27, -80, -96, -96,
+	-101, 144, -80, -96, -96, -101, -96, -101, -101, 144,
+	144, -138, -113, 50, 146, 35, 110, -119, 81, -132,
+	-131, 144, 73, -119, -132, 144, 34, 33, 67, 100,
+	58, 31, -66, 146, 146, 122, -123, -114, -83, 132,
+	132, 129, 132, 132, 132, 144, -94, -130, 144, 132,
+	-104, 132, 129, -103, -100, 17, -93, -101, -27, -80,
+	-80, -94, 129, -88, 76, -27, -27, -80, -96, -101,
+	-101, -96, -101, -101, -101, 134, 134, 60, 21, 21,
+	-139, 91, -118, -132, 97, 97, -139, 131, -6, 146,
+	146, -48, 132, 104, -116, 129, -67, -100, 131, 146,
+	154, -94, 145, -80, -94, -94, -101, -88, 132, -27,
+	-80, -80, -96, -101, -101, 145, 144, 145, -112, 125,
+	145, -120, 144, -120, -112, 146, 68, 58, 31, 131,
+	-123, -123, -130, 147, 132, 146, -100, -94, -101, -101,
+	-80, -96, -96, -101, -105, -106, -137, 86, 129, -124,
+	-121, 82, 132, 146, -48, -136, 146, 132, 132, -130,
+	-101, -96, -101, -101, -105, 146, -120, -125, -122, 83,
+	-120, -132, 132, 129, -101, -129, -128, 84, -120, 105,
+	-136, -117, 85, -126, -127, -114, 131, -137, 144, 129,
+	134, -136, -126, -114, 145, 132,
 }
 
 var yyDef = [...]int16{
@@ -904,99 +917,101 @@ var yyDef = [...]int16{
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
 	31, 32, 33, 34, 35, 36, 37, 38, 39, 40,
 	41, 42, 43, 44, 45, 46, 47, 48, 49, 50,
-	51, 52, 53, 54, 55, 56, 57, 58, 59, 0,
-	0, 0, 0, 140, 0, 0, 0, 0, 0, 0,
-	3, -2, 0, 63, 65, 68, 0, 169, 0, 88,
-	89, 0, 171, 172, 173, 174, 175, 176, 178, 168,
-	200, 284, 0, 284, 0, 248, 0, 0, 0, 0,
-	0, 378, 0, 0, 399, 406, 409, 417, 422, 428,
-	269, 270, 271, 272, 273, 274, 275, 276, 277, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 140, 0,
-	0, 0, 0, 0, 0, 397, 0, 0, 0, 140,
-	253, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	300, 0, 0, 0, 4, 0, 116, 0, 93, 0,
+	51, 52, 53, 54, 55, 56, 57, 58, 59, 60,
+	61, 62, 63, 0, 0, 0, 0, 144, 0, 0,
+	0, 0, 0, 0, 3, -2, 0, 67, 69, 72,
+	0, 173, 0, 92, 93, 0, 175, 176, 177, 178,
+	179, 180, 182, 172, 204, 288, 0, 288, 0, 252,
+	0, 0, 0, 0, 0, 387, 0, 0, 409, 416,
+	419, 427, 432, 438, 281, 0, 273, 274, 275, 276,
+	277, 278, 279, 280, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 144, 0, 0, 0, 0, 0, 0,
+	407, 0, 0, 0, 144, 257, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 304, 0, 0, 0,
+	4, 0, 120, 0, 97, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 87, 0, 0, 71, 0, 201,
-	140, 284, 0, 230, 140, 0, 284, 284, 0, 284,
-	284, 0, 0, 284, 0, 0, 0, 284, 0, 382,
-	390, 0, 0, 0, 208, 0, 0, 340, 112, 0,
-	111, 113, 114, 0, 0, 0, 93, 121, 122, 0,
-	249, 140, 251, 0, 266, 367, 383, 0, 0, 0,
-	408, 418, 0, 252, 94, 95, 97, 101, 106, 0,
-	139, 145, 0, 169, 0, 0, 0, 0, 0, 143,
-	141, 0, 157, 0, 381, 0, 0, 0, 0, 0,
-	0, 0, 0, 299, 0, 0, 410, 140, 118, 0,
-	92, 0, 64, 66, 67, 69, 70, 76, 77, 78,
-	79, 80, 81, 82, 83, 84, 0, 86, 170, 179,
-	180, 181, 177, 0, 0, 72, 0, 0, 183, 224,
-	283, 0, 140, 183, 284, 140, 140, 284, 140, 0,
-	0, 284, 0, 284, 278, 0, 183, 0, 284, 369,
-	284, 140, 379, 400, 407, 0, 208, 203, 0, 0,
-	205, 0, 0, 0, 315, 0, 0, 0, 0, 0,
-	0, 0, 0, 250, 0, 0, 0, 395, 398, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 157,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	160, 161, 162, 163, 164, 165, 166, 167, 0, 0,
-	0, 0, 0, 260, 0, 0, 0, 0, 265, 0,
-	0, 0, 116, 134, 0, 0, 140, 85, 0, 0,
-	0, 0, 195, 0, 0, 229, 183, 195, 140, 140,
-	116, 140, 183, 0, 140, 183, 0, 0, 284, 0,
-	284, 140, 0, 0, 0, 183, 195, 284, 140, 140,
-	140, 116, 0, 202, 211, 212, 214, 0, 0, 0,
-	0, 219, 0, 0, 0, 0, 0, 204, 0, 0,
-	0, 0, 313, 314, 328, 339, 342, 0, 0, 112,
-	0, 110, 0, 0, 0, 0, 0, 0, 0, 0,
-	384, 0, 0, 419, 421, 96, 99, 98, 0, 103,
-	105, 142, 144, -2, 0, 0, 0, 0, 0, 0,
-	0, 156, 0, 0, 0, 0, 0, 259, 0, 0,
-	0, 264, 0, 0, 0, 118, 183, 0, 117, 119,
-	123, 121, 128, 130, 115, 116, 90, 0, 73, 140,
-	0, 0, 0, 0, 222, 199, 0, 0, 0, 0,
-	195, 245, 140, 116, 116, 195, 183, 195, 0, 0,
-	183, 195, 0, 0, 0, 0, 0, 140, 140, 116,
-	0, 0, 0, 282, 195, 286, 140, 140, 116, 140,
-	116, 116, 195, 429, 430, 213, 215, 216, 217, 218,
-	220, 364, 366, 0, 0, 0, 0, 206, 207, 209,
-	210, 0, 233, 318, 320, 0, 341, 343, 344, 345,
-	347, 0, 109, 112, 108, 389, 0, 0, 0, 405,
-	0, 0, 255, 391, 396, 0, 0, 0, 0, 0,
-	0, 0, 149, 0, 0, 0, 0, 0, 0, 355,
-	256, 0, 258, 261, 0, 263, 368, 423, 424, 425,
-	426, 427, 134, 195, 0, 0, 0, 0, 0, 118,
-	91, 183, 225, 226, 227, 228, 189, 0, 0, 193,
-	190, 191, 194, 182, 184, 186, 223, 244, 116, 195,
-	195, 377, 195, 247, 0, 0, 195, 268, 0, 140,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
-	116, 116, 195, 0, 280, 281, 285, 140, 116, 116,
-	195, 116, 195, 195, 373, 0, 0, 0, 240, 241,
-	242, 243, 231, 0, 0, 323, 351, 323, 351, 0,
-	346, 107, 0, 0, 0, 0, 394, 0, 0, 0,
-	0, 413, 414, 420, 100, 0, 104, 147, 148, 0,
-	0, 74, 152, 0, 0, 0, 158, 254, 380, 0,
-	257, 262, 183, 132, 0, 135, 136, 137, 0, 120,
-	124, 0, 129, 134, 195, 197, 198, 0, 0, 187,
-	188, 195, 375, 376, 246, 0, 140, 267, 140, 183,
-	291, 296, 298, 292, 0, 294, 295, 0, 0, 0,
-	140, 116, 195, 195, 304, 279, 116, 195, 195, 312,
-	195, 371, 372, 0, 0, 365, 232, 0, 0, 0,
-	325, 0, 319, 351, 0, 0, 325, 321, 0, 329,
-	330, 0, 0, 0, 0, 0, 0, 404, 0, 416,
-	411, 102, 150, 151, 0, 153, 154, 155, 354, 195,
-	62, 0, 133, 138, 125, 0, 183, 221, 0, 192,
-	185, 374, 140, 183, 183, 195, 0, 0, 0, 140,
-	140, 116, 195, 302, 303, 195, 310, 311, 370, 0,
-	0, 0, 234, 235, 355, 0, 324, 350, 0, 0,
-	355, 0, 0, 386, 387, 392, 0, 0, 0, 0,
-	75, 132, 0, 0, 0, 195, 196, 183, 195, 195,
-	288, 297, 293, 140, 116, 116, 195, 301, 309, 432,
-	431, 237, 316, 326, 327, 348, 352, 349, 331, 0,
-	385, 0, 0, 0, 415, 412, 60, 0, 126, 0,
-	132, 195, 290, 287, 116, 195, 195, 308, 236, 238,
-	0, 333, 332, 0, 351, 388, 393, 0, 402, 131,
-	127, 61, 289, 195, 306, 307, 239, 353, 335, 334,
-	0, 356, 322, 0, 0, 305, 337, 336, 363, 357,
-	0, 403, 317, 0, 360, 359, 0, 0, 338, 363,
-	0, 0, 358, 361, 362, 401,
+	91, 0, 0, 75, 0, 205, 144, 288, 0, 234,
+	144, 0, 288, 288, 0, 288, 288, 0, 0, 288,
+	0, 0, 0, 288, 0, 392, 400, 0, 0, 0,
+	443, 144, 212, 0, 0, 346, 116, 0, 115, 117,
+	118, 0, 0, 0, 97, 125, 126, 0, 253, 144,
+	255, 0, 270, 373, 393, 0, 0, 0, 418, 428,
+	0, 256, 98, 99, 101, 105, 110, 0, 143, 149,
+	0, 173, 0, 0, 0, 0, 0, 147, 145, 0,
+	161, 0, 0, 390, 0, 0, 0, 0, 0, 0,
+	0, 0, 303, 0, 0, 420, 144, 122, 0, 96,
+	0, 68, 70, 71, 73, 74, 80, 81, 82, 83,
+	84, 85, 86, 87, 88, 0, 90, 174, 183, 184,
+	185, 181, 0, 0, 76, 0, 0, 187, 228, 287,
+	0, 144, 187, 288, 144, 144, 288, 144, 0, 0,
+	288, 0, 288, 282, 0, 187, 0, 288, 378, 288,
+	144, 388, 410, 417, 0, 199, 212, 207, 0, 0,
+	209, 0, 0, 0, 319, 0, 0, 0, 0, 0,
+	0, 0, 0, 254, 0, 0, 0, 405, 408, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	164, 165, 166, 167, 168, 169, 170, 171, 0, 374,
+	375, 0, 0, 0, 0, 264, 0, 0, 0, 0,
+	269, 0, 0, 0, 120, 138, 0, 0, 144, 89,
+	0, 0, 0, 0, 199, 0, 0, 233, 187, 199,
+	144, 144, 120, 144, 187, 0, 144, 187, 0, 0,
+	288, 0, 288, 144, 0, 0, 0, 187, 199, 288,
+	144, 144, 144, 120, 0, 444, 0, 0, 206, 215,
+	216, 218, 0, 0, 0, 0, 223, 0, 0, 0,
+	0, 0, 208, 0, 0, 0, 0, 317, 318, 332,
+	345, 348, 0, 0, 116, 0, 114, 0, 0, 0,
+	0, 0, 0, 0, 0, 394, 0, 0, 429, 431,
+	100, 103, 102, 0, 107, 109, 146, 148, -2, 0,
+	0, 0, 0, 0, 0, 0, 160, 0, 0, 376,
+	0, 0, 0, 0, 263, 0, 0, 0, 268, 0,
+	0, 0, 122, 187, 0, 121, 123, 127, 125, 132,
+	134, 119, 120, 94, 0, 77, 144, 0, 0, 0,
+	0, 226, 203, 0, 0, 199, 249, 144, 120, 120,
+	199, 187, 199, 0, 0, 187, 199, 0, 0, 0,
+	0, 0, 144, 144, 120, 0, 0, 0, 286, 199,
+	290, 144, 144, 120, 144, 120, 120, 199, 439, 440,
+	197, 194, 195, 198, 217, 219, 220, 221, 222, 224,
+	370, 372, 0, 0, 0, 0, 210, 211, 213, 214,
+	0, 237, 322, 324, 0, 347, 349, 350, 351, 353,
+	0, 113, 116, 112, 399, 0, 0, 0, 415, 0,
+	0, 259, 401, 406, 0, 0, 0, 0, 0, 0,
+	0, 153, 0, 0, 0, 0, 0, 0, 361, 391,
+	260, 0, 262, 265, 0, 267, 377, 433, 434, 435,
+	436, 437, 138, 199, 0, 0, 0, 0, 0, 122,
+	95, 187, 229, 230, 231, 232, 193, 0, 0, 186,
+	188, 190, 227, 248, 120, 199, 199, 386, 199, 251,
+	0, 0, 199, 272, 0, 144, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 144, 120, 120, 199, 0,
+	284, 285, 289, 144, 120, 120, 199, 120, 199, 199,
+	382, 0, 0, 0, 0, 244, 245, 246, 247, 235,
+	0, 0, 327, 357, 327, 357, 0, 352, 111, 0,
+	0, 0, 0, 404, 0, 0, 0, 0, 423, 424,
+	430, 104, 0, 108, 151, 152, 0, 0, 78, 156,
+	0, 0, 0, 162, 258, 389, 0, 261, 266, 187,
+	136, 0, 139, 140, 141, 0, 124, 128, 0, 133,
+	138, 199, 201, 202, 0, 191, 192, 199, 384, 385,
+	250, 0, 144, 271, 144, 187, 295, 300, 302, 296,
+	0, 298, 299, 0, 0, 0, 144, 120, 199, 199,
+	308, 283, 120, 199, 199, 316, 199, 380, 381, 0,
+	0, 196, 371, 236, 0, 0, 0, 329, 0, 323,
+	357, 0, 0, 329, 325, 0, 333, 334, 0, 0,
+	0, 0, 0, 0, 414, 0, 426, 421, 106, 154,
+	155, 0, 157, 158, 159, 360, 199, 66, 0, 137,
+	142, 129, 0, 187, 225, 0, 189, 383, 144, 187,
+	187, 199, 0, 0, 0, 144, 144, 120, 199, 306,
+	307, 199, 314, 315, 379, 0, 0, 0, 238, 239,
+	361, 0, 328, 356, 0, 0, 361, 0, 0, 396,
+	397, 402, 0, 0, 0, 0, 79, 136, 0, 0,
+	0, 199, 200, 187, 199, 199, 292, 301, 297, 144,
+	120, 120, 199, 305, 313, 442, 441, 241, 343, 330,
+	331, 354, 358, 355, 335, 0, 395, 0, 0, 0,
+	425, 422, 64, 0, 130, 0, 136, 199, 294, 291,
+	120, 199, 199, 312, 240, 242, 320, 0, 0, 337,
+	336, 0, 357, 398, 403, 0, 412, 135, 131, 65,
+	293, 199, 310, 311, 243, 344, 359, 339, 338, 0,
+	362, 326, 0, 0, 309, 341, 340, 369, 363, 0,
+	413, 343, 0, 366, 365, 0, 0, 321, 342, 369,
+	0, 0, 364, 367, 368, 411,
 }
 
 var yyTok1 = [...]int8{
@@ -1019,7 +1034,7 @@ var yyTok2 = [...]uint8{
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
 	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
-	152, 153, 154, 155, 156, 157, 158, 159, 160,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
 }
 
 var yyTok3 = [...]int8{
@@ -1365,19 +1380,19 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:186
+//line sql.y:187
 		{
 			setParseTree(yylex, yyDollar[1].stmts)
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:192
+//line sql.y:193
 		{
 			yyVAL.stmts = []Statement{yyDollar[1].stmt}
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:196
+//line sql.y:197
 		{
 			if len(yyDollar[1].stmts) >= 1 {
 				yyVAL.stmts = yyDollar[1].stmts
@@ -1387,343 +1402,367 @@ yydefault:
 		}
 	case 4:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:204
+//line sql.y:205
 		{
 			yyVAL.stmts = append(yyDollar[1].stmts, yyDollar[3].stmt)
 		}
 	case 5:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:212
+//line sql.y:213
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:216
+//line sql.y:217
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:220
+//line sql.y:221
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:224
+//line sql.y:225
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 9:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:228
+//line sql.y:229
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:232
+//line sql.y:233
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:236
+//line sql.y:237
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:240
+//line sql.y:241
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:244
+//line sql.y:245
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:248
+//line sql.y:249
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:252
+//line sql.y:253
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:256
+//line sql.y:257
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:260
+//line sql.y:261
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:264
+//line sql.y:265
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:268
+//line sql.y:269
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:272
+//line sql.y:273
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:276
+//line sql.y:277
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:280
+//line sql.y:281
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:284
+//line sql.y:285
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:288
+//line sql.y:289
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:292
+//line sql.y:293
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:296
+//line sql.y:297
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:300
+//line sql.y:301
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:304
+//line sql.y:305
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:308
+//line sql.y:309
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:312
+//line sql.y:313
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:316
+//line sql.y:317
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:320
+//line sql.y:321
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:324
+//line sql.y:325
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:328
+//line sql.y:329
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:332
+//line sql.y:333
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:336
+//line sql.y:337
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:340
+//line sql.y:341
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:344
+//line sql.y:345
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:348
+//line sql.y:349
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:352
+//line sql.y:353
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:356
+//line sql.y:357
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:360
+//line sql.y:361
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:364
+//line sql.y:365
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:368
+//line sql.y:369
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:372
+//line sql.y:373
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:376
+//line sql.y:377
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:380
+//line sql.y:381
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:384
+//line sql.y:385
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:388
+//line sql.y:389
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:392
+//line sql.y:393
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:396
+//line sql.y:397
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:400
+//line sql.y:401
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:404
+//line sql.y:405
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:408
+//line sql.y:409
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:412
+//line sql.y:413
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:416
+//line sql.y:417
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:420
+//line sql.y:421
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:424
+//line sql.y:425
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:428
+//line sql.y:429
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 60:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:433
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:437
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:441
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 63:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:445
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 64:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:434
+//line sql.y:451
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1764,9 +1803,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 61:
+	case 65:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:475
+//line sql.y:492
 		{
 			stmt := &SelectStatement{}
 			stmt.Hints = yyDollar[2].hints
@@ -1808,9 +1847,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 62:
+	case 66:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:517
+//line sql.y:534
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1838,147 +1877,147 @@ yydefault:
 			stmt.Location = yyDollar[9].location
 			yyVAL.stmt = stmt
 		}
-	case 63:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:548
+//line sql.y:565
 		{
 			yyVAL.fields = []*Field{yyDollar[1].field}
 		}
-	case 64:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:552
+//line sql.y:569
 		{
 			yyVAL.fields = append([]*Field{yyDollar[1].field}, yyDollar[3].fields...)
 		}
-	case 65:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:558
+//line sql.y:575
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 66:
+	case 70:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:562
+//line sql.y:579
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: TAG}}
 		}
-	case 67:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:566
+//line sql.y:583
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: FIELD}}
 		}
-	case 68:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:570
+//line sql.y:587
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr}
 		}
-	case 69:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:574
+//line sql.y:591
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 70:
+	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:578
+//line sql.y:595
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 71:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:584
+//line sql.y:601
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 72:
+	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:588
+//line sql.y:605
 		{
 			c := yyDollar[1].expr.(*CaseWhenExpr)
 			c.Conditions = append(c.Conditions, yyDollar[2].expr.(*CaseWhenExpr).Conditions...)
 			c.Assigners = append(c.Assigners, yyDollar[2].expr.(*CaseWhenExpr).Assigners...)
 			yyVAL.expr = c
 		}
-	case 73:
+	case 77:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:597
+//line sql.y:614
 		{
 			c := &CaseWhenExpr{}
 			c.Conditions = []Expr{yyDollar[2].expr}
 			c.Assigners = []Expr{yyDollar[4].expr}
 			yyVAL.expr = c
 		}
-	case 74:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:606
+//line sql.y:623
 		{
 			yyVAL.fields = []*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}
 		}
-	case 75:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:610
+//line sql.y:627
 		{
 			yyVAL.fields = append([]*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}, yyDollar[3].fields...)
 		}
-	case 76:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:616
+//line sql.y:633
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MUL), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 77:
+	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:620
+//line sql.y:637
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(DIV), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 78:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:624
+//line sql.y:641
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(ADD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 79:
+	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:628
+//line sql.y:645
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(SUB), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 80:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:632
+//line sql.y:649
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_XOR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 81:
+	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:636
+//line sql.y:653
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MOD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 82:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:640
+//line sql.y:657
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_AND), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 83:
+	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:644
+//line sql.y:661
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_OR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 84:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:648
+//line sql.y:665
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 85:
+	case 89:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:652
+//line sql.y:669
 		{
 			if strings.ToLower(yyDollar[1].str) == "cast" {
 				if len(yyDollar[3].fields) != 1 {
@@ -2009,16 +2048,16 @@ yydefault:
 				yyVAL.expr = cols
 			}
 		}
-	case 86:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:683
+//line sql.y:700
 		{
 			cols := &Call{Name: strings.ToLower(yyDollar[1].str)}
 			yyVAL.expr = cols
 		}
-	case 87:
+	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:688
+//line sql.y:705
 		{
 			switch s := yyDollar[2].expr.(type) {
 			case *NumberLiteral:
@@ -2032,98 +2071,98 @@ yydefault:
 			}
 
 		}
-	case 88:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:702
+//line sql.y:719
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 89:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:706
+//line sql.y:723
 		{
 			yyVAL.expr = &DurationLiteral{Val: yyDollar[1].tdur}
 		}
-	case 90:
+	case 94:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:710
+//line sql.y:727
 		{
 			c := yyDollar[2].expr.(*CaseWhenExpr)
 			c.Assigners = append(c.Assigners, yyDollar[4].expr)
 			yyVAL.expr = c
 		}
-	case 91:
+	case 95:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:716
+//line sql.y:733
 		{
 			yyVAL.expr = &VarRef{}
 		}
-	case 92:
+	case 96:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:722
+//line sql.y:739
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 93:
+	case 97:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:726
+//line sql.y:743
 		{
 			yyVAL.sources = nil
 		}
-	case 94:
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:732
+//line sql.y:749
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 95:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:738
+//line sql.y:755
 		{
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 96:
+	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:742
+//line sql.y:759
 		{
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[3].sources...)
 		}
-	case 97:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:746
+//line sql.y:763
 		{
 			yyVAL.sources = yyDollar[1].sources
 
 		}
-	case 98:
+	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:751
+//line sql.y:768
 		{
 			yyVAL.sources = append(yyDollar[1].sources, yyDollar[3].sources...)
 		}
-	case 99:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:755
+//line sql.y:772
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 100:
+	case 104:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:760
+//line sql.y:777
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[5].sources...)
 		}
-	case 101:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:765
+//line sql.y:782
 		{
 			yyVAL.sources = []Source{yyDollar[1].source}
 		}
-	case 102:
+	case 106:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:771
+//line sql.y:788
 		{
 			join := &Join{}
 			if len(yyDollar[1].sources) != 1 || len(yyDollar[4].sources) != 1 {
@@ -2134,9 +2173,9 @@ yydefault:
 			join.Condition = yyDollar[6].expr
 			yyVAL.source = join
 		}
-	case 103:
+	case 107:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:784
+//line sql.y:801
 		{
 			all_subquerys := []Source{}
 			for _, temp_stmt := range yyDollar[2].stmts {
@@ -2149,9 +2188,9 @@ yydefault:
 			}
 			yyVAL.sources = all_subquerys
 		}
-	case 104:
+	case 108:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:797
+//line sql.y:814
 		{
 			if len(yyDollar[2].stmts) != 1 {
 				yylex.Error("expexted SelectStatement length")
@@ -2168,72 +2207,72 @@ yydefault:
 			all_subquerys = append(all_subquerys, build_SubQuery)
 			yyVAL.sources = all_subquerys
 		}
-	case 105:
+	case 109:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:814
+//line sql.y:831
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 106:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:820
+//line sql.y:837
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 107:
+	case 111:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:826
+//line sql.y:843
 		{
 			mst := yyDollar[5].ment
 			mst.Database = yyDollar[1].str
 			mst.RetentionPolicy = yyDollar[3].str
 			yyVAL.ment = mst
 		}
-	case 108:
+	case 112:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:833
+//line sql.y:850
 		{
 			mst := yyDollar[4].ment
 			mst.RetentionPolicy = yyDollar[2].str
 			yyVAL.ment = mst
 		}
-	case 109:
+	case 113:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:839
+//line sql.y:856
 		{
 			mst := yyDollar[4].ment
 			mst.Database = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 110:
+	case 114:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:845
+//line sql.y:862
 		{
 			mst := yyDollar[3].ment
 			mst.RetentionPolicy = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 111:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:851
+//line sql.y:868
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 112:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:857
+//line sql.y:874
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 113:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:861
+//line sql.y:878
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 114:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:865
+//line sql.y:882
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2242,69 +2281,69 @@ yydefault:
 
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 115:
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:876
+//line sql.y:893
 		{
 			yyVAL.dimens = yyDollar[3].dimens
 		}
-	case 116:
+	case 120:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:880
+//line sql.y:897
 		{
 			yyVAL.dimens = nil
 		}
-	case 117:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:886
+//line sql.y:903
 		{
 			yyVAL.dimens = yyDollar[2].dimens
 		}
-	case 118:
+	case 122:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:890
+//line sql.y:907
 		{
 			yyVAL.dimens = nil
 		}
-	case 119:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:896
+//line sql.y:913
 		{
 			yyVAL.dimens = []*Dimension{yyDollar[1].dimen}
 		}
-	case 120:
+	case 124:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:900
+//line sql.y:917
 		{
 			yyVAL.dimens = append([]*Dimension{yyDollar[1].dimen}, yyDollar[3].dimens...)
 		}
-	case 121:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:906
+//line sql.y:923
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 122:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:910
+//line sql.y:927
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 123:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:916
+//line sql.y:933
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 124:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:920
+//line sql.y:937
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 125:
+	case 129:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:924
+//line sql.y:941
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2312,9 +2351,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}}}}
 		}
-	case 126:
+	case 130:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:932
+//line sql.y:949
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2322,9 +2361,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: yyDollar[5].tdur}}}}
 		}
-	case 127:
+	case 131:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:940
+//line sql.y:957
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2332,21 +2371,21 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: time.Duration(-yyDollar[6].tdur)}}}}
 		}
-	case 128:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:948
+//line sql.y:965
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 129:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:952
+//line sql.y:969
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 130:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:956
+//line sql.y:973
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2354,9 +2393,9 @@ yydefault:
 			}
 			yyVAL.dimen = &Dimension{Expr: &RegexLiteral{Val: re}}
 		}
-	case 131:
+	case 135:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:967
+//line sql.y:984
 		{
 			if strings.ToLower(yyDollar[1].str) != "tz" {
 				yylex.Error("Expect tz")
@@ -2367,45 +2406,45 @@ yydefault:
 			}
 			yyVAL.location = loc
 		}
-	case 132:
+	case 136:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:978
+//line sql.y:995
 		{
 			yyVAL.location = nil
 		}
-	case 133:
+	case 137:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:984
+//line sql.y:1001
 		{
 			yyVAL.inter = yyDollar[3].inter
 		}
-	case 134:
+	case 138:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:988
+//line sql.y:1005
 		{
 			yyVAL.inter = "null"
 		}
-	case 135:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:994
+//line sql.y:1011
 		{
 			yyVAL.inter = yyDollar[1].str
 		}
-	case 136:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:998
+//line sql.y:1015
 		{
 			yyVAL.inter = yyDollar[1].int64
 		}
-	case 137:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1002
+//line sql.y:1019
 		{
 			yyVAL.inter = yyDollar[1].float64
 		}
-	case 138:
+	case 142:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1006
+//line sql.y:1023
 		{
 			switch s := yyDollar[2].inter.(type) {
 			case int64:
@@ -2416,57 +2455,57 @@ yydefault:
 				yyVAL.inter = yyDollar[2].inter
 			}
 		}
-	case 139:
+	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1019
+//line sql.y:1036
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 140:
+	case 144:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1023
+//line sql.y:1040
 		{
 			yyVAL.expr = nil
 		}
-	case 141:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1029
+//line sql.y:1046
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 142:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1033
+//line sql.y:1050
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 143:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1039
+//line sql.y:1056
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 144:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1043
+//line sql.y:1060
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 145:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1049
+//line sql.y:1066
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 146:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1053
+//line sql.y:1070
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 147:
+	case 151:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1057
+//line sql.y:1074
 		{
 			ident := &VarRef{Val: yyDollar[1].str}
 			var expr, e Expr
@@ -2480,39 +2519,49 @@ yydefault:
 			}
 			yyVAL.expr = e
 		}
-	case 148:
+	case 152:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1071
+//line sql.y:1088
 		{
 			yyVAL.expr = &InCondition{Stmt: yyDollar[4].stmt.(*SelectStatement), Column: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 149:
+	case 153:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1075
+//line sql.y:1092
 		{
-			yyVAL.expr = &BinaryExpr{}
+			yyVAL.expr = &ExistsCondition{Stmt: yyDollar[3].stmt.(*SelectStatement)}
 		}
-	case 150:
+	case 154:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1079
+//line sql.y:1096
 		{
-			yyVAL.expr = &BinaryExpr{}
+			yyVAL.expr = &InCondition{Stmt: yyDollar[5].stmt.(*SelectStatement), Column: &VarRef{Val: yyDollar[1].str}, NotIn: true}
 		}
-	case 151:
+	case 155:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1083
+//line sql.y:1100
 		{
-			yyVAL.expr = &BinaryExpr{}
+			ident := &VarRef{Val: yyDollar[1].str}
+			var expr, e Expr
+			for i := range yyDollar[5].fields {
+				expr = &BinaryExpr{LHS: ident, Op: Token(NEQ), RHS: &StringLiteral{Val: yyDollar[5].fields[i].Expr.(*VarRef).Val}}
+				if e == nil {
+					e = expr
+				} else {
+					e = &BinaryExpr{LHS: e, Op: Token(AND), RHS: expr}
+				}
+			}
+			yyVAL.expr = e
 		}
-	case 152:
+	case 156:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1087
+//line sql.y:1114
 		{
-			yyVAL.expr = &BinaryExpr{}
+			yyVAL.expr = &ExistsCondition{Stmt: yyDollar[4].stmt.(*SelectStatement), NotExists: true}
 		}
-	case 153:
+	case 157:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1091
+//line sql.y:1118
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2520,9 +2569,9 @@ yydefault:
 				Op:  MATCH,
 			}
 		}
-	case 154:
+	case 158:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1099
+//line sql.y:1126
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2530,9 +2579,9 @@ yydefault:
 				Op:  MATCHPHRASE,
 			}
 		}
-	case 155:
+	case 159:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1107
+//line sql.y:1134
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2540,9 +2589,9 @@ yydefault:
 				Op:  IPINRANGE,
 			}
 		}
-	case 156:
+	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1117
+//line sql.y:1144
 		{
 			if yyDollar[2].int == NEQREGEX {
 				switch yyDollar[3].expr.(type) {
@@ -2553,123 +2602,123 @@ yydefault:
 			}
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 157:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1130
+//line sql.y:1157
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 158:
+	case 162:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1134
+//line sql.y:1161
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 159:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1140
+//line sql.y:1167
 		{
 			yyVAL.int = EQ
 		}
-	case 160:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1144
+//line sql.y:1171
 		{
 			yyVAL.int = NEQ
 		}
-	case 161:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1148
+//line sql.y:1175
 		{
 			yyVAL.int = LT
 		}
-	case 162:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1152
+//line sql.y:1179
 		{
 			yyVAL.int = LTE
 		}
-	case 163:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1156
+//line sql.y:1183
 		{
 			yyVAL.int = GT
 		}
-	case 164:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1160
+//line sql.y:1187
 		{
 			yyVAL.int = GTE
 		}
-	case 165:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1164
+//line sql.y:1191
 		{
 			yyVAL.int = EQREGEX
 		}
-	case 166:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1168
+//line sql.y:1195
 		{
 			yyVAL.int = NEQREGEX
 		}
-	case 167:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1172
+//line sql.y:1199
 		{
 			yyVAL.int = LIKE
 		}
-	case 168:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1178
+//line sql.y:1205
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 169:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1184
+//line sql.y:1211
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str}
 		}
-	case 170:
+	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1188
+//line sql.y:1215
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str, Type: yyDollar[3].dataType}
 		}
-	case 171:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1192
+//line sql.y:1219
 		{
 			yyVAL.expr = &NumberLiteral{Val: yyDollar[1].float64}
 		}
-	case 172:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1196
+//line sql.y:1223
 		{
 			yyVAL.expr = &IntegerLiteral{Val: yyDollar[1].int64}
 		}
-	case 173:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1200
+//line sql.y:1227
 		{
 			yyVAL.expr = &StringLiteral{Val: yyDollar[1].str}
 		}
-	case 174:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1204
+//line sql.y:1231
 		{
 			yyVAL.expr = &BooleanLiteral{Val: true}
 		}
-	case 175:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1208
+//line sql.y:1235
 		{
 			yyVAL.expr = &BooleanLiteral{Val: false}
 		}
-	case 176:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1212
+//line sql.y:1239
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2677,21 +2726,21 @@ yydefault:
 			}
 			yyVAL.expr = &RegexLiteral{Val: re}
 		}
-	case 177:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1220
+//line sql.y:1247
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str + "." + yyDollar[3].str, Type: Tag}
 		}
-	case 178:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1224
+//line sql.y:1251
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 179:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1230
+//line sql.y:1257
 		{
 			switch strings.ToLower(yyDollar[1].str) {
 			case "float":
@@ -2712,75 +2761,75 @@ yydefault:
 				yylex.Error("wrong field dataType")
 			}
 		}
-	case 180:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1251
+//line sql.y:1278
 		{
 			yyVAL.dataType = Tag
 		}
-	case 181:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1255
+//line sql.y:1282
 		{
 			yyVAL.dataType = AnyField
 		}
-	case 182:
+	case 186:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1261
+//line sql.y:1288
 		{
 			yyVAL.sortfs = yyDollar[3].sortfs
 		}
-	case 183:
+	case 187:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1265
+//line sql.y:1292
 		{
 			yyVAL.sortfs = nil
 		}
-	case 184:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1271
+//line sql.y:1298
 		{
 			yyVAL.sortfs = []*SortField{yyDollar[1].sortf}
 		}
-	case 185:
+	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1275
+//line sql.y:1302
 		{
 			yyVAL.sortfs = append([]*SortField{yyDollar[1].sortf}, yyDollar[3].sortfs...)
 		}
-	case 186:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1281
+//line sql.y:1308
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 187:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1285
+//line sql.y:1312
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: false}
 		}
-	case 188:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1289
+//line sql.y:1316
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 189:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1295
+//line sql.y:1322
 		{
 			yyVAL.intSlice = append(yyDollar[1].intSlice, yyDollar[2].intSlice...)
 		}
-	case 190:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1301
+//line sql.y:1328
 		{
 			yyVAL.int64 = yyDollar[1].int64
 		}
-	case 191:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1306
+//line sql.y:1333
 		{
 			if n, ok := yyDollar[1].expr.(*IntegerLiteral); ok {
 				yyVAL.int64 = n.Val
@@ -2788,69 +2837,69 @@ yydefault:
 				yylex.Error("unsupported type, expect integer type")
 			}
 		}
-	case 192:
+	case 196:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1316
+//line sql.y:1343
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 193:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1320
+//line sql.y:1347
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 194:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1324
+//line sql.y:1351
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 195:
+	case 199:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1328
+//line sql.y:1355
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 196:
+	case 200:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1334
+//line sql.y:1361
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 197:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1338
+//line sql.y:1365
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 198:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1342
+//line sql.y:1369
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 199:
+	case 203:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1346
+//line sql.y:1373
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 200:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1352
+//line sql.y:1379
 		{
 			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: false}
 		}
-	case 201:
+	case 205:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1356
+//line sql.y:1383
 		{
 			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: true}
 		}
-	case 202:
+	case 206:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1362
+//line sql.y:1389
 		{
 			sms := yyDollar[4].stmt
 
@@ -2858,9 +2907,9 @@ yydefault:
 			sms.(*CreateDatabaseStatement).DatabaseAttr = yyDollar[5].databasePolicy
 			yyVAL.stmt = sms
 		}
-	case 203:
+	case 207:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1370
+//line sql.y:1397
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = false
@@ -2868,54 +2917,54 @@ yydefault:
 			stmt.DatabaseAttr = yyDollar[4].databasePolicy
 			yyVAL.stmt = stmt
 		}
-	case 204:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1380
+//line sql.y:1407
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: false}
 		}
-	case 205:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1385
+//line sql.y:1412
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: yyDollar[1].bool}
 		}
-	case 206:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1390
+//line sql.y:1417
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: yyDollar[3].bool}
 		}
-	case 207:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1395
+//line sql.y:1422
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[3].int64), EnableTagArray: yyDollar[1].bool}
 		}
-	case 208:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1399
+//line sql.y:1426
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: false}
 		}
-	case 209:
+	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1405
+//line sql.y:1432
 		{
 			if strings.ToLower(yyDollar[3].str) != "array" {
 				yylex.Error("unsupport type")
 			}
 			yyVAL.bool = true
 		}
-	case 210:
+	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1412
+//line sql.y:1439
 		{
 			yyVAL.bool = false
 		}
-	case 211:
+	case 215:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1419
+//line sql.y:1446
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = true
@@ -2954,15 +3003,15 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 212:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1462
+//line sql.y:1489
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 213:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1466
+//line sql.y:1493
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -3035,56 +3084,56 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 214:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1541
+//line sql.y:1568
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 215:
+	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1545
+//line sql.y:1572
 		{
 			duration := yyDollar[2].tdur
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyDuration: &duration}
 		}
-	case 216:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1550
+//line sql.y:1577
 		{
 			replicaN := int(yyDollar[2].int64)
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, Replication: &replicaN}
 		}
-	case 217:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1555
+//line sql.y:1582
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyName: yyDollar[2].str}
 		}
-	case 218:
+	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1559
+//line sql.y:1586
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, ReplicaNum: uint32(yyDollar[2].int64)}
 		}
-	case 219:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1563
+//line sql.y:1590
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: true}
 		}
-	case 220:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1567
+//line sql.y:1594
 		{
 			if len(yyDollar[2].strSlice) == 0 {
 				yylex.Error("ShardKey should not be nil")
 			}
 			yyVAL.durations = &Durations{ShardKey: yyDollar[2].strSlice, ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: false}
 		}
-	case 221:
+	case 225:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1578
+//line sql.y:1605
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3095,9 +3144,9 @@ yydefault:
 			sms.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 222:
+	case 226:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1589
+//line sql.y:1616
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3107,38 +3156,38 @@ yydefault:
 			sms.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 223:
+	case 227:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1601
+//line sql.y:1628
 		{
 			sms := &ShowMeasurementsDetailStatement{}
 			sms.Database = yyDollar[4].str
 			sms.Source = yyDollar[7].ment
 			yyVAL.stmt = sms
 		}
-	case 224:
+	case 228:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1608
+//line sql.y:1635
 		{
 			sms := &ShowMeasurementsDetailStatement{}
 			sms.Database = yyDollar[4].str
 			yyVAL.stmt = sms
 		}
-	case 225:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1617
+//line sql.y:1644
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 226:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1621
+//line sql.y:1648
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 227:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1625
+//line sql.y:1652
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3146,9 +3195,9 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 228:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1633
+//line sql.y:1660
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3156,32 +3205,32 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 229:
+	case 233:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1645
+//line sql.y:1672
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{
 				Database: yyDollar[5].str,
 			}
 		}
-	case 230:
+	case 234:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1651
+//line sql.y:1678
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{}
 		}
-	case 231:
+	case 235:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1658
+//line sql.y:1685
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 232:
+	case 236:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1665
+//line sql.y:1692
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
@@ -3189,18 +3238,18 @@ yydefault:
 			stmt.Default = true
 			yyVAL.stmt = stmt
 		}
-	case 233:
+	case 237:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1675
+//line sql.y:1702
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 234:
+	case 238:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1682
+//line sql.y:1709
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3208,9 +3257,9 @@ yydefault:
 			stmt.Admin = true
 			yyVAL.stmt = stmt
 		}
-	case 235:
+	case 239:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1690
+//line sql.y:1717
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3218,9 +3267,9 @@ yydefault:
 			stmt.Rwuser = true
 			yyVAL.stmt = stmt
 		}
-	case 236:
+	case 240:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1701
+//line sql.y:1728
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
@@ -3252,24 +3301,24 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 237:
+	case 241:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1733
+//line sql.y:1760
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
 			stmt.Replication = int(yyDollar[4].int64)
 			yyVAL.stmt = stmt
 		}
-	case 238:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1743
+//line sql.y:1770
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 239:
+	case 243:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1747
+//line sql.y:1774
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -3304,33 +3353,33 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 240:
+	case 244:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1785
+//line sql.y:1812
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: yyDollar[3].tdur, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 241:
+	case 245:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1789
+//line sql.y:1816
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: yyDollar[3].tdur, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 242:
+	case 246:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1793
+//line sql.y:1820
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: yyDollar[3].tdur, IndexGroupDuration: -1}
 		}
-	case 243:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1797
+//line sql.y:1824
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: yyDollar[3].tdur}
 		}
-	case 244:
+	case 248:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1805
+//line sql.y:1832
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3341,9 +3390,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 245:
+	case 249:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1816
+//line sql.y:1843
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3353,9 +3402,9 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 246:
+	case 250:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1826
+//line sql.y:1853
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Hints = yyDollar[2].hints
@@ -3367,9 +3416,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 247:
+	case 251:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1838
+//line sql.y:1865
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Hints = yyDollar[2].hints
@@ -3380,57 +3429,57 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 248:
+	case 252:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1851
+//line sql.y:1878
 		{
 			yyVAL.stmt = &ShowUsersStatement{}
 		}
-	case 249:
+	case 253:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1857
+//line sql.y:1884
 		{
 			stmt := &DropDatabaseStatement{}
 			stmt.Name = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 250:
+	case 254:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1865
+//line sql.y:1892
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Sources = yyDollar[3].sources
 			stmt.Condition = yyDollar[4].expr
 			yyVAL.stmt = stmt
 		}
-	case 251:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1872
+//line sql.y:1899
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 252:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1880
+//line sql.y:1907
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Sources = yyDollar[2].sources
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 253:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1887
+//line sql.y:1914
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Condition = yyDollar[2].expr
 			yyVAL.stmt = stmt
 		}
-	case 254:
+	case 258:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1896
+//line sql.y:1923
 		{
 			stmt := &AlterRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
@@ -3464,18 +3513,18 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 255:
+	case 259:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1934
+//line sql.y:1961
 		{
 			stmt := &DropRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 256:
+	case 260:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1943
+//line sql.y:1970
 		{
 			stmt := &GrantStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3483,9 +3532,9 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 257:
+	case 261:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1951
+//line sql.y:1978
 		{
 			stmt := &GrantStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3493,9 +3542,9 @@ yydefault:
 			stmt.User = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 258:
+	case 262:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1959
+//line sql.y:1986
 		{
 			stmt := &GrantStatement{}
 			switch strings.ToLower(yyDollar[2].str) {
@@ -3510,21 +3559,21 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 259:
+	case 263:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1976
+//line sql.y:2003
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[5].str}
 		}
-	case 260:
+	case 264:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1980
+//line sql.y:2007
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[4].str}
 		}
-	case 261:
+	case 265:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1986
+//line sql.y:2013
 		{
 			stmt := &RevokeStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3532,9 +3581,9 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 262:
+	case 266:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1994
+//line sql.y:2021
 		{
 			stmt := &RevokeStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3542,9 +3591,9 @@ yydefault:
 			stmt.User = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 263:
+	case 267:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2002
+//line sql.y:2029
 		{
 			stmt := &RevokeStatement{}
 			switch strings.ToLower(yyDollar[2].str) {
@@ -3559,27 +3608,27 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 264:
+	case 268:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2019
+//line sql.y:2046
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[5].str}
 		}
-	case 265:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2023
+//line sql.y:2050
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[4].str}
 		}
-	case 266:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2029
+//line sql.y:2056
 		{
 			yyVAL.stmt = &DropUserStatement{Name: yyDollar[3].str}
 		}
-	case 267:
+	case 271:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2035
+//line sql.y:2062
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3593,9 +3642,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 268:
+	case 272:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2049
+//line sql.y:2076
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3607,72 +3656,72 @@ yydefault:
 			stmt.SOffset = yyDollar[7].intSlice[3]
 			yyVAL.stmt = stmt
 		}
-	case 269:
+	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2063
+//line sql.y:2090
 		{
 			yyVAL.str = "PRIMARYKEY"
 		}
-	case 270:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2067
+//line sql.y:2094
 		{
 			yyVAL.str = "SORTKEY"
 		}
-	case 271:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2071
+//line sql.y:2098
 		{
 			yyVAL.str = "PROPERTY"
 		}
-	case 272:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2075
+//line sql.y:2102
 		{
 			yyVAL.str = "SHARDKEY"
 		}
-	case 273:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2079
+//line sql.y:2106
 		{
 			yyVAL.str = "ENGINETYPE"
 		}
-	case 274:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2083
+//line sql.y:2110
 		{
 			yyVAL.str = "SCHEMA"
 		}
-	case 275:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2087
+//line sql.y:2114
 		{
 			yyVAL.str = "INDEXES"
 		}
-	case 276:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2091
+//line sql.y:2118
 		{
 			yyVAL.str = "COMPACT"
 		}
-	case 277:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2095
+//line sql.y:2122
 		{
 			yylex.Error("SHOW command error, only support PRIMARYKEY, SORTKEY, SHARDKEY, ENGINETYPE, INDEXES, SCHEMA, COMPACT")
 		}
-	case 278:
+	case 282:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2101
+//line sql.y:2128
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 279:
+	case 283:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2108
+//line sql.y:2135
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3681,9 +3730,9 @@ yydefault:
 			stmt.Measurement = yyDollar[8].str
 			yyVAL.stmt = stmt
 		}
-	case 280:
+	case 284:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2117
+//line sql.y:2144
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3691,9 +3740,9 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 281:
+	case 285:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2125
+//line sql.y:2152
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3701,30 +3750,30 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 282:
+	case 286:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2133
+//line sql.y:2160
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 283:
+	case 287:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2142
+//line sql.y:2169
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 284:
+	case 288:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2146
+//line sql.y:2173
 		{
 			yyVAL.str = ""
 		}
-	case 285:
+	case 289:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2152
+//line sql.y:2179
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3734,9 +3783,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 286:
+	case 290:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2162
+//line sql.y:2189
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3745,9 +3794,9 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 287:
+	case 291:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2174
+//line sql.y:2201
 		{
 			stmt := yyDollar[8].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3760,9 +3809,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 288:
+	case 292:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2187
+//line sql.y:2214
 		{
 			stmt := yyDollar[7].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3773,9 +3822,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 289:
+	case 293:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2198
+//line sql.y:2225
 		{
 			stmt := yyDollar[9].stmt.(*ShowTagValuesStatement)
 			stmt.Hints = yyDollar[2].hints
@@ -3788,9 +3837,9 @@ yydefault:
 			stmt.Offset = yyDollar[12].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 290:
+	case 294:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2211
+//line sql.y:2238
 		{
 			stmt := yyDollar[8].stmt.(*ShowTagValuesStatement)
 			stmt.Hints = yyDollar[2].hints
@@ -3802,36 +3851,36 @@ yydefault:
 			stmt.Offset = yyDollar[11].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 291:
+	case 295:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2225
+//line sql.y:2252
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 292:
+	case 296:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2232
+//line sql.y:2259
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 293:
+	case 297:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2239
+//line sql.y:2266
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = IN
 			stmt.TagKeyExpr = yyDollar[3].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 294:
+	case 298:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2246
+//line sql.y:2273
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQREGEX
@@ -3842,9 +3891,9 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 295:
+	case 299:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2257
+//line sql.y:2284
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQREGEX
@@ -3855,47 +3904,47 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 296:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2271
+//line sql.y:2298
 		{
 			temp := []string{yyDollar[1].str}
 			yyVAL.expr = &ListLiteral{Vals: temp}
 		}
-	case 297:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2276
+//line sql.y:2303
 		{
 			yyDollar[3].expr.(*ListLiteral).Vals = append(yyDollar[3].expr.(*ListLiteral).Vals, yyDollar[1].str)
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 298:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2283
+//line sql.y:2310
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 299:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2291
+//line sql.y:2318
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[3].stmt.(*SelectStatement)
 			stmt.Analyze = true
 			yyVAL.stmt = stmt
 		}
-	case 300:
+	case 304:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2298
+//line sql.y:2325
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[2].stmt.(*SelectStatement)
 			stmt.Analyze = false
 			yyVAL.stmt = stmt
 		}
-	case 301:
+	case 305:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2308
+//line sql.y:2335
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3907,9 +3956,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 302:
+	case 306:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2320
+//line sql.y:2347
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3920,9 +3969,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 303:
+	case 307:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2331
+//line sql.y:2358
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3934,9 +3983,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 304:
+	case 308:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2343
+//line sql.y:2370
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3947,9 +3996,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 305:
+	case 309:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line sql.y:2359
+//line sql.y:2386
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3966,9 +4015,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 306:
+	case 310:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2376
+//line sql.y:2403
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3983,9 +4032,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 307:
+	case 311:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2391
+//line sql.y:2418
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4002,9 +4051,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 308:
+	case 312:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2408
+//line sql.y:2435
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4019,9 +4068,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 309:
+	case 313:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2426
+//line sql.y:2453
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -4033,9 +4082,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 310:
+	case 314:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2438
+//line sql.y:2465
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -4046,9 +4095,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 311:
+	case 315:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2449
+//line sql.y:2476
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4060,9 +4109,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 312:
+	case 316:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2461
+//line sql.y:2488
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4073,9 +4122,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 313:
+	case 317:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2475
+//line sql.y:2502
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4095,12 +4144,13 @@ yydefault:
 			stmt.NumOfShards = yyDollar[5].cmOption.NumOfShards
 			stmt.Type = yyDollar[5].cmOption.Type
 			stmt.EngineType = yyDollar[5].cmOption.EngineType
+			stmt.TTL = yyDollar[5].cmOption.TTL
 
 			yyVAL.stmt = stmt
 		}
-	case 314:
+	case 318:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2498
+//line sql.y:2526
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4187,20 +4237,21 @@ yydefault:
 			stmt.SortKey = yyDollar[5].cmOption.SortKey
 			stmt.Property = yyDollar[5].cmOption.Property
 			stmt.CompactType = yyDollar[5].cmOption.CompactType
+			stmt.TTL = yyDollar[5].cmOption.TTL
 			yyVAL.stmt = stmt
 		}
-	case 315:
+	case 319:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2588
+//line sql.y:2617
 		{
 			option := &CreateMeasurementStatementOption{}
 			option.Type = "hash"
 			option.EngineType = "tsstore"
 			yyVAL.cmOption = option
 		}
-	case 316:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2595
+	case 320:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2624
 		{
 			option := &CreateMeasurementStatementOption{}
 			if yyDollar[3].indexType != nil {
@@ -4213,11 +4264,12 @@ yydefault:
 			option.NumOfShards = yyDollar[5].int64
 			option.Type = yyDollar[6].str
 			option.EngineType = yyDollar[2].str
+			option.TTL = yyDollar[7].tdur
 			yyVAL.cmOption = option
 		}
-	case 317:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2612
+	case 321:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:2642
 		{
 			option := &CreateMeasurementStatementOption{}
 			if yyDollar[3].indexType != nil {
@@ -4246,17 +4298,18 @@ yydefault:
 				option.Property = yyDollar[9].strSlices
 			}
 			option.CompactType = yyDollar[10].str
+			option.TTL = yyDollar[11].tdur
 			yyVAL.cmOption = option
 		}
-	case 318:
+	case 322:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2644
+//line sql.y:2675
 		{
 			yyVAL.indexType = nil
 		}
-	case 319:
+	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2648
+//line sql.y:2679
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["text"] = struct{}{}
@@ -4272,15 +4325,15 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 320:
+	case 324:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2665
+//line sql.y:2696
 		{
 			yyVAL.indexType = nil
 		}
-	case 321:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2669
+//line sql.y:2700
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["bloomfilter"] = struct{}{}
@@ -4298,9 +4351,9 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 322:
+	case 326:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2687
+//line sql.y:2718
 		{
 			indexType := strings.ToLower(yyDollar[2].str)
 			if indexType != "timecluster" {
@@ -4329,104 +4382,104 @@ yydefault:
 				yyVAL.indexType = indextype
 			}
 		}
-	case 323:
+	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2717
+//line sql.y:2748
 		{
 			yyVAL.strSlice = nil
 		}
-	case 324:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2721
+//line sql.y:2752
 		{
 			shardKey := yyDollar[2].strSlice
 			sort.Strings(shardKey)
 			yyVAL.strSlice = shardKey
 		}
-	case 325:
+	case 329:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2728
+//line sql.y:2759
 		{
 			yyVAL.int64 = 0
 		}
-	case 326:
+	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2732
+//line sql.y:2763
 		{
 			yyVAL.int64 = -1
 		}
-	case 327:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2736
+//line sql.y:2767
 		{
 			if yyDollar[2].int64 == 0 {
 				yylex.Error("syntax error: NUM OF SHARDS SHOULD LARGER THAN 0")
 			}
 			yyVAL.int64 = yyDollar[2].int64
 		}
-	case 328:
+	case 332:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2744
+//line sql.y:2775
 		{
 			yyVAL.str = "tsstore" // default engine type
 		}
-	case 329:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2748
+//line sql.y:2779
 		{
 			yyVAL.str = "tsstore"
 		}
-	case 330:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2754
+//line sql.y:2785
 		{
 			yyVAL.str = "columnstore"
 		}
-	case 331:
+	case 335:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2759
+//line sql.y:2790
 		{
 			yyVAL.strSlice = nil
 		}
-	case 332:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2762
+//line sql.y:2793
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 333:
+	case 337:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2767
+//line sql.y:2798
 		{
 			yyVAL.strSlice = nil
 		}
-	case 334:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2770
+//line sql.y:2801
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 335:
+	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2775
+//line sql.y:2806
 		{
 			yyVAL.strSlices = nil
 		}
-	case 336:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2778
+//line sql.y:2809
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 337:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2783
+//line sql.y:2814
 		{
 			yyVAL.str = "row"
 		}
-	case 338:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2787
+//line sql.y:2818
 		{
 			compactionType := strings.ToLower(yyDollar[2].str)
 			if compactionType != "row" && compactionType != "block" {
@@ -4435,9 +4488,21 @@ yydefault:
 			}
 			yyVAL.str = compactionType
 		}
-	case 339:
+	case 343:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2828
+		{
+			yyVAL.tdur = 0
+		}
+	case 344:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2798
+//line sql.y:2832
+		{
+			yyVAL.tdur = yyDollar[2].tdur
+		}
+	case 345:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2838
 		{
 			stmt := &CreateMeasurementStatement{
 				Tags:   make(map[string]int32),
@@ -4466,40 +4531,40 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 340:
+	case 346:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2827
+//line sql.y:2867
 		{
 			yyVAL.stmt = nil
 		}
-	case 341:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2833
+//line sql.y:2873
 		{
 			fields := []*fieldList{yyDollar[1].fieldOption}
 			yyVAL.fieldOptions = append(fields, yyDollar[2].fieldOptions...)
 		}
-	case 342:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2839
+//line sql.y:2879
 		{
 			yyVAL.fieldOptions = []*fieldList{yyDollar[1].fieldOption}
 		}
-	case 343:
+	case 349:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2845
+//line sql.y:2885
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 344:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2850
+//line sql.y:2890
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 345:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2856
+//line sql.y:2896
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4507,9 +4572,9 @@ yydefault:
 				tagOrField: "tag",
 			}
 		}
-	case 346:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2865
+//line sql.y:2905
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4517,9 +4582,9 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 347:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2874
+//line sql.y:2914
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4527,27 +4592,27 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 348:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2884
+//line sql.y:2924
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{yyDollar[1].str},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 349:
+	case 355:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2892
+//line sql.y:2932
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{"field"},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 350:
+	case 356:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2901
+//line sql.y:2941
 		{
 			indextype := yyDollar[1].indexType
 			if yyDollar[2].indexType != nil {
@@ -4556,28 +4621,28 @@ yydefault:
 			}
 			yyVAL.indexType = indextype
 		}
-	case 351:
+	case 357:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2910
+//line sql.y:2950
 		{
 			yyVAL.indexType = nil
 		}
-	case 352:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2916
+//line sql.y:2956
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 353:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2920
+//line sql.y:2960
 		{
 
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 354:
+	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2927
+//line sql.y:2967
 		{
 			shardType := strings.ToLower(yyDollar[2].str)
 			if shardType != "hash" && shardType != "range" {
@@ -4586,27 +4651,27 @@ yydefault:
 			}
 			yyVAL.str = shardType
 		}
-	case 355:
+	case 361:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2936
+//line sql.y:2976
 		{
 			yyVAL.str = "hash"
 		}
-	case 356:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2942
+//line sql.y:2982
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 357:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2948
+//line sql.y:2988
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 358:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2954
+//line sql.y:2994
 		{
 			m := yyDollar[1].strSlices
 			if yyDollar[3].strSlices != nil {
@@ -4615,82 +4680,117 @@ yydefault:
 			}
 			yyVAL.strSlices = m
 		}
-	case 359:
+	case 365:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2964
+//line sql.y:3004
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 360:
+	case 366:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2970
+//line sql.y:3010
 		{
 			yyVAL.strSlices = yyDollar[2].strSlices
 		}
-	case 361:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2976
+//line sql.y:3016
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {yyDollar[3].str}}
 		}
-	case 362:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2980
+//line sql.y:3020
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {fmt.Sprintf("%d", yyDollar[3].int64)}}
 		}
-	case 363:
+	case 369:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2984
+//line sql.y:3024
 		{
 			yyVAL.strSlices = nil
 		}
-	case 364:
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2990
+//line sql.y:3030
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 365:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2994
+//line sql.y:3034
 		{
 			yyVAL.strSlice = append(yyDollar[1].strSlice, yyDollar[3].str)
 		}
-	case 366:
+	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2999
+//line sql.y:3039
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 367:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3005
+//line sql.y:3045
 		{
 			stmt := &DropShardStatement{}
 			stmt.ID = uint64(yyDollar[3].int64)
 			yyVAL.stmt = stmt
 		}
-	case 368:
+	case 374:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3053
+		{
+			stmt := &AlterShardStatement{}
+			stmt.ID = uint64(yyDollar[3].int64)
+			stmt.Action = ShardActionCompact
+			yyVAL.stmt = stmt
+		}
+	case 375:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3060
+		{
+			if strings.ToLower(yyDollar[4].str) != ShardActionFlush {
+				yylex.Error("expect COMPACT, FLUSH or REBUILD INDEX for ALTER SHARD")
+				return 1
+			}
+			stmt := &AlterShardStatement{}
+			stmt.ID = uint64(yyDollar[3].int64)
+			stmt.Action = ShardActionFlush
+			yyVAL.stmt = stmt
+		}
+	case 376:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3071
+		{
+			if strings.ToLower(yyDollar[4].str) != "rebuild" {
+				yylex.Error("expect COMPACT, FLUSH or REBUILD INDEX for ALTER SHARD")
+				return 1
+			}
+			stmt := &AlterShardStatement{}
+			stmt.ID = uint64(yyDollar[3].int64)
+			stmt.Action = ShardActionRebuildIndex
+			yyVAL.stmt = stmt
+		}
+	case 377:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3013
+//line sql.y:3084
 		{
 			stmt := &SetPasswordUserStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 369:
+	case 378:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3024
+//line sql.y:3095
 		{
 			stmt := &ShowGrantsForUserStatement{}
 			stmt.Name = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 370:
+	case 379:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3032
+//line sql.y:3103
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4702,9 +4802,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 371:
+	case 380:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3044
+//line sql.y:3115
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4715,9 +4815,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 372:
+	case 381:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3055
+//line sql.y:3126
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4729,9 +4829,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 373:
+	case 382:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3067
+//line sql.y:3138
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4742,9 +4842,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 374:
+	case 383:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3081
+//line sql.y:3152
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4756,9 +4856,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 375:
+	case 384:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3093
+//line sql.y:3164
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4769,9 +4869,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 376:
+	case 385:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3104
+//line sql.y:3175
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4783,9 +4883,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 377:
+	case 386:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3116
+//line sql.y:3187
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4796,23 +4896,23 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 378:
+	case 387:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3130
+//line sql.y:3201
 		{
 			stmt := &ShowShardsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 379:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3135
+//line sql.y:3206
 		{
 			stmt := &ShowShardsStatement{mstInfo: yyDollar[4].ment}
 			yyVAL.stmt = stmt
 		}
-	case 380:
+	case 389:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3143
+//line sql.y:3214
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4823,9 +4923,9 @@ yydefault:
 			stmt.Type = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 381:
+	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3154
+//line sql.y:3225
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4834,34 +4934,45 @@ yydefault:
 			stmt.Type = "hash"
 			yyVAL.stmt = stmt
 		}
-	case 382:
+	case 391:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3236
+		{
+			stmt := &AlterMeasurementTTLStatement{}
+			stmt.Database = yyDollar[3].ment.Database
+			stmt.Name = yyDollar[3].ment.Name
+			stmt.RetentionPolicy = yyDollar[3].ment.RetentionPolicy
+			stmt.TTL = yyDollar[6].tdur
+			yyVAL.stmt = stmt
+		}
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3168
+//line sql.y:3250
 		{
 			stmt := &ShowShardGroupsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 383:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3175
+//line sql.y:3257
 		{
 			stmt := &DropMeasurementStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.RpName = ""
 			yyVAL.stmt = stmt
 		}
-	case 384:
+	case 394:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3182
+//line sql.y:3264
 		{
 			stmt := &DropMeasurementStatement{}
 			stmt.Name = yyDollar[5].str
 			stmt.RpName = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 385:
+	case 395:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3192
+//line sql.y:3274
 		{
 			stmt := &CreateContinuousQueryStatement{
 				Name:     yyDollar[4].str,
@@ -4874,64 +4985,64 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 386:
+	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3207
+//line sql.y:3289
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 			}
 		}
-	case 387:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3213
+//line sql.y:3295
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleFor: yyDollar[3].tdur,
 			}
 		}
-	case 388:
+	case 398:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3219
+//line sql.y:3301
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 				ResampleFor:   yyDollar[5].tdur,
 			}
 		}
-	case 389:
+	case 399:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3226
+//line sql.y:3308
 		{
 			yyVAL.cqsp = nil
 		}
-	case 390:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3232
+//line sql.y:3314
 		{
 			yyVAL.stmt = &ShowContinuousQueriesStatement{}
 		}
-	case 391:
+	case 401:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3238
+//line sql.y:3320
 		{
 			yyVAL.stmt = &DropContinuousQueryStatement{
 				Name:     yyDollar[4].str,
 				Database: yyDollar[6].str,
 			}
 		}
-	case 392:
+	case 402:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3246
+//line sql.y:3328
 		{
 			stmt := yyDollar[9].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[4].str
 			stmt.Ops = yyDollar[6].fields
 			yyVAL.stmt = stmt
 		}
-	case 393:
+	case 403:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:3253
+//line sql.y:3335
 		{
 			stmt := yyDollar[11].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[6].str
@@ -4939,65 +5050,65 @@ yydefault:
 			stmt.Ops = yyDollar[8].fields
 			yyVAL.stmt = stmt
 		}
-	case 394:
+	case 404:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3261
+//line sql.y:3343
 		{
 			stmt := yyDollar[7].stmt.(*CreateDownSampleStatement)
 			stmt.Ops = yyDollar[4].fields
 			yyVAL.stmt = stmt
 		}
-	case 395:
+	case 405:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3269
+//line sql.y:3351
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				RpName: yyDollar[4].str,
 			}
 		}
-	case 396:
+	case 406:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3275
+//line sql.y:3357
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName: yyDollar[4].str,
 				RpName: yyDollar[6].str,
 			}
 		}
-	case 397:
+	case 407:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3282
+//line sql.y:3364
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DropAll: true,
 			}
 		}
-	case 398:
+	case 408:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3288
+//line sql.y:3370
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName:  yyDollar[4].str,
 				DropAll: true,
 			}
 		}
-	case 399:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3297
+//line sql.y:3379
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{}
 		}
-	case 400:
+	case 410:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3301
+//line sql.y:3383
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{
 				DbName: yyDollar[4].str,
 			}
 		}
-	case 401:
+	case 411:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3309
+//line sql.y:3391
 		{
 			yyVAL.stmt = &CreateDownSampleStatement{
 				Duration:       yyDollar[2].tdur,
@@ -5005,21 +5116,21 @@ yydefault:
 				TimeInterval:   yyDollar[9].tdurs,
 			}
 		}
-	case 402:
+	case 412:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3319
+//line sql.y:3401
 		{
 			yyVAL.tdurs = []time.Duration{yyDollar[1].tdur}
 		}
-	case 403:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3323
+//line sql.y:3405
 		{
 			yyVAL.tdurs = append([]time.Duration{yyDollar[1].tdur}, yyDollar[3].tdurs...)
 		}
-	case 404:
+	case 414:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3330
+//line sql.y:3412
 		{
 			stmt := &CreateStreamStatement{
 				Name:  yyDollar[3].str,
@@ -5041,9 +5152,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 405:
+	case 415:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3352
+//line sql.y:3434
 		{
 			stmt := &CreateStreamStatement{
 				Name:  yyDollar[3].str,
@@ -5064,112 +5175,112 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 406:
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3375
+//line sql.y:3457
 		{
 			yyVAL.stmt = &ShowStreamsStatement{}
 		}
-	case 407:
+	case 417:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3379
+//line sql.y:3461
 		{
 			yyVAL.stmt = &ShowStreamsStatement{Database: yyDollar[4].str}
 		}
-	case 408:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3385
+//line sql.y:3467
 		{
 			yyVAL.stmt = &DropStreamsStatement{Name: yyDollar[3].str}
 		}
-	case 409:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3390
+//line sql.y:3472
 		{
 			yyVAL.stmt = &ShowQueriesStatement{}
 		}
-	case 410:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3395
+//line sql.y:3477
 		{
 			yyVAL.stmt = &KillQueryStatement{QueryID: uint64(yyDollar[3].int64)}
 		}
-	case 411:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3401
+//line sql.y:3483
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 412:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3405
+//line sql.y:3487
 		{
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 413:
+	case 423:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3411
+//line sql.y:3493
 		{
 			yyVAL.str = "ALL"
 		}
-	case 414:
+	case 424:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3415
+//line sql.y:3497
 		{
 			yyVAL.str = "ANY"
 		}
-	case 415:
+	case 425:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3421
+//line sql.y:3503
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str, Destinations: yyDollar[10].strSlice, Mode: yyDollar[9].str}
 		}
-	case 416:
+	case 426:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3425
+//line sql.y:3507
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: "", Destinations: yyDollar[8].strSlice, Mode: yyDollar[7].str}
 		}
-	case 417:
+	case 427:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3431
+//line sql.y:3513
 		{
 			yyVAL.stmt = &ShowSubscriptionsStatement{}
 		}
-	case 418:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3437
+//line sql.y:3519
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: "", RetentionPolicy: ""}
 		}
-	case 419:
+	case 429:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3441
+//line sql.y:3523
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 420:
+	case 430:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3445
+//line sql.y:3527
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str}
 		}
-	case 421:
+	case 431:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3449
+//line sql.y:3531
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 422:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3455
+//line sql.y:3537
 		{
 			stmt := &ShowConfigsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 423:
+	case 433:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3462
+//line sql.y:3544
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5177,9 +5288,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 424:
+	case 434:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3470
+//line sql.y:3552
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5187,9 +5298,9 @@ yydefault:
 			stmt.Value = yyDollar[6].int64
 			yyVAL.stmt = stmt
 		}
-	case 425:
+	case 435:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3478
+//line sql.y:3560
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5197,9 +5308,9 @@ yydefault:
 			stmt.Value = yyDollar[6].float64
 			yyVAL.stmt = stmt
 		}
-	case 426:
+	case 436:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3486
+//line sql.y:3568
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5207,9 +5318,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 427:
+	case 437:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3494
+//line sql.y:3576
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5217,17 +5328,17 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 428:
+	case 438:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3504
+//line sql.y:3586
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
 			yyVAL.stmt = stmt
 		}
-	case 429:
+	case 439:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3510
+//line sql.y:3592
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
@@ -5238,9 +5349,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 430:
+	case 440:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3521
+//line sql.y:3603
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5250,9 +5361,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 431:
+	case 441:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3531
+//line sql.y:3613
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5267,9 +5378,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 432:
+	case 442:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3546
+//line sql.y:3628
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodetype" {
@@ -5284,6 +5395,30 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
+	case 443:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3645
+		{
+			if strings.ToLower(yyDollar[2].str) != "scrub" || strings.ToLower(yyDollar[3].str) != "status" {
+				yylex.Error("expect SHOW SCRUB STATUS")
+				return 1
+			}
+			yyVAL.stmt = &ShowScrubStatusStatement{}
+		}
+	case 444:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3655
+		{
+			if strings.ToLower(yyDollar[3].str) != "history" {
+				yylex.Error("expect SHOW QUERY HISTORY")
+				return 1
+			}
+			stmt := &ShowQueryHistoryStatement{}
+			stmt.Condition = yyDollar[4].expr
+			stmt.Limit = yyDollar[5].intSlice[0]
+			stmt.Offset = yyDollar[5].intSlice[1]
+			yyVAL.stmt = stmt
+		}
 	}
 	goto yystack /* stack new state and value */
 }
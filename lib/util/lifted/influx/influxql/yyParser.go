@@ -26,6 +26,14 @@ type YyParser struct {
 	Scanner *Scanner
 	error   YyParserError
 	Params  map[string]interface{}
+
+	// tokens scanned ahead to find the contextual keywords
+	peeked []scannedToken
+}
+
+type scannedToken struct {
+	typ Token
+	val string
 }
 
 type YyParserError string
@@ -44,6 +52,30 @@ func (p *YyParser) ParseTokens() {
 
 func (p *YyParser) SetScanner(s *Scanner) {
 	p.Scanner = s
+	p.peeked = p.peeked[:0]
+}
+
+func (p *YyParser) scan() (Token, string) {
+	if len(p.peeked) > 0 {
+		t := p.peeked[0]
+		p.peeked = p.peeked[1:]
+		return t.typ, t.val
+	}
+	typ, _, val := p.Scanner.Scan()
+	return typ, val
+}
+
+// nextIs returns true if the next token except the whitespaces is typ
+func (p *YyParser) nextIs(typ Token) bool {
+	for i := 0; ; i++ {
+		if i == len(p.peeked) {
+			t, _, val := p.Scanner.Scan()
+			p.peeked = append(p.peeked, scannedToken{typ: t, val: val})
+		}
+		if p.peeked[i].typ != WS {
+			return p.peeked[i].typ == typ
+		}
+	}
 }
 func (p *YyParser) GetQuery() (*Query, error) {
 	if len(p.error) > 0 {
@@ -57,7 +89,7 @@ func (p *YyParser) Lex(lval *yySymType) int {
 	var val string
 
 	for {
-		typ, _, val = p.Scanner.Scan()
+		typ, val = p.scan()
 		switch typ {
 		case ILLEGAL:
 			p.Error("unexpected " + string(val) + ", it's ILLEGAL")
@@ -150,6 +182,10 @@ func (p *YyParser) Lex(lval *yySymType) int {
 			break
 		}
 	}
+	// TTL is not reserved, it is still an identifier if it is not followed by a duration
+	if typ == IDENT && strings.EqualFold(val, "ttl") && p.nextIs(DURATIONVAL) {
+		typ = TTL
+	}
 	lval.str = val
 	return int(typ)
 }
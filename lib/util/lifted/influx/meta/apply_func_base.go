@@ -273,8 +273,12 @@ func ApplyCreateMeasurement(data *Data, cmd *proto2.Command) error {
 	if !ok {
 		panic(fmt.Errorf("%s is not a CreateMeasurementCommand", ext))
 	}
-	return data.CreateMeasurement(v.GetDBName(), v.GetRpName(), v.GetName(), v.GetSki(), v.GetInitNumOfShards(), v.GetIR(), config.EngineType(v.GetEngineType()),
+	err := data.CreateMeasurement(v.GetDBName(), v.GetRpName(), v.GetName(), v.GetSki(), v.GetInitNumOfShards(), v.GetIR(), config.EngineType(v.GetEngineType()),
 		v.GetColStoreInfo(), v.GetSchemaInfo(), v.GetOptions())
+	if err != nil || v.GetTTL() == 0 {
+		return err
+	}
+	return data.AlterMeasurementTTL(v.GetDBName(), v.GetRpName(), v.GetName(), time.Duration(v.GetTTL()))
 }
 
 func ApplyReSharding(data *Data, cmd *proto2.Command) error {
@@ -312,6 +316,15 @@ func ApplyAlterShardKey(data *Data, cmd *proto2.Command) error {
 	return data.AlterShardKey(v.GetDBName(), v.GetRpName(), v.GetName(), v.GetSki())
 }
 
+func ApplyAlterMeasurementTTL(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_AlterMeasurementTTLCmd_Command)
+	v, ok := ext.(*proto2.AlterMeasurementTTLCmd)
+	if !ok {
+		panic(fmt.Errorf("%s is not a AlterMeasurementTTLCmd", ext))
+	}
+	return data.AlterMeasurementTTL(v.GetDBName(), v.GetRpName(), v.GetName(), time.Duration(v.GetTTL()))
+}
+
 func ApplyPruneGroups(data *Data, cmd *proto2.Command) error {
 	ext, _ := proto.GetExtension(cmd, proto2.E_PruneGroupsCommand_Command)
 	v, ok := ext.(*proto2.PruneGroupsCommand)
@@ -194,6 +194,7 @@ type Data struct {
 	Streams       map[string]*StreamInfo
 	Users         []UserInfo
 	MigrateEvents map[string]*MigrateEventInfo
+	Decommissions map[uint64]*DecommissionInfo // key is the id of the decommissioned node
 
 	// Query ID range segment allocated by all sql nodes
 	QueryIDInit map[SQLHost]uint64 // {"127.0.0.1:8086": 0, "127.0.0.2:8086": 10w, "127.0.0.3:8086": 20w}, span is QueryIDSpan
@@ -1010,6 +1011,25 @@ func (data *Data) AlterShardKey(database string, rpName string, mst string, shar
 	return nil
 }
 
+// AlterMeasurementTTL sets the TTL of the measurement, the data older than the TTL is expired
+// even if the retention policy keeps it. A zero TTL means following the retention policy.
+func (data *Data) AlterMeasurementTTL(database string, rpName string, mst string, ttl time.Duration) error {
+	if ttl != 0 && ttl < MinRetentionPolicyDuration {
+		return ErrMeasurementTTLTooLow
+	}
+	rp, err := data.RetentionPolicy(database, rpName)
+	if err != nil {
+		return err
+	}
+
+	msti := rp.Measurement(mst)
+	if msti == nil || msti.MarkDeleted {
+		return ErrMeasurementNotFound
+	}
+	msti.TTL = ttl
+	return nil
+}
+
 func (data *Data) GetNodeIndex(nodeId uint64) (uint64, error) {
 	for i, value := range data.DataNodes {
 		if value.ID == nodeId {
@@ -2125,6 +2145,7 @@ const (
 	AssignType EventType = iota
 	OffloadType
 	MoveType
+	DecommissionMoveType
 )
 
 type MoveState int
@@ -2168,6 +2189,9 @@ func getEventStr(eventType EventType, currState, preState int) (string, string,
 	case MoveType:
 		eventTypeStr = "move_event"
 		currStateStr, preStateStr = MoveState(currState).String(), MoveState(preState).String()
+	case DecommissionMoveType:
+		eventTypeStr = "decommission_move_event"
+		currStateStr, preStateStr = MoveState(currState).String(), MoveState(preState).String()
 	}
 	return eventTypeStr, currStateStr, preStateStr
 }
@@ -2962,6 +2986,7 @@ func (data *Data) Clone() *Data {
 	other.Users = data.CloneUsers()
 	other.PtView = data.CloneDBPtView()
 	other.MigrateEvents = data.CloneMigrateEvents()
+	other.Decommissions = data.CloneDecommissions()
 
 	other.QueryIDInit = data.CloneQueryIDInit()
 
@@ -2977,6 +3002,10 @@ func (data *Data) Marshal() *proto2.Data {
 		pb.MigrateEvents[i] = data.MigrateEvents[eventStr].marshal()
 		i++
 	}
+	pb.Decommissions = make([]*proto2.DecommissionInfo, 0, len(data.Decommissions))
+	for _, info := range data.Decommissions {
+		pb.Decommissions = append(pb.Decommissions, info.Marshal())
+	}
 	return pb
 }
 
@@ -3159,6 +3188,13 @@ func (data *Data) Unmarshal(pb *proto2.Data) {
 		mei.unmarshal(me)
 		data.MigrateEvents[mei.eventId] = mei
 	}
+
+	data.Decommissions = make(map[uint64]*DecommissionInfo, len(pb.GetDecommissions()))
+	for _, x := range pb.GetDecommissions() {
+		info := &DecommissionInfo{}
+		info.Unmarshal(x)
+		data.Decommissions[info.NodeID] = info
+	}
 	// Exhaustively determine if there is an admin GetUser. The marshalled cache
 	// value may not be correct.
 	data.AdminUserExists = data.HasAdminUser()
@@ -108,6 +108,67 @@ func Test_Data_CreateMeasurement(t *testing.T) {
 	}
 }
 
+func Test_Data_AlterMeasurementTTL(t *testing.T) {
+	data := initData()
+
+	dbName := "foo"
+	rpName := "bar"
+	err := data.CreateDatabase(dbName, &RetentionPolicyInfo{
+		Name:     rpName,
+		ReplicaN: 1,
+		Duration: 24 * time.Hour,
+	}, nil, false, 1, nil)
+	require.NoError(t, err)
+
+	mstName := "cpu"
+	err = data.CreateMeasurement(dbName, rpName, mstName,
+		&proto2.ShardKeyInfo{ShardKey: []string{"hostName"}, Type: proto.String(influxql.HASH)}, 0, nil, 0, nil, nil, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, ErrMeasurementTTLTooLow, data.AlterMeasurementTTL(dbName, rpName, mstName, time.Minute))
+	require.Equal(t, ErrMeasurementNotFound, data.AlterMeasurementTTL(dbName, rpName, "mem", time.Hour))
+	require.NoError(t, data.AlterMeasurementTTL(dbName, rpName, mstName, 2*time.Hour))
+
+	// the ttl survives the snapshot of meta data
+	buf, err := data.MarshalBinary()
+	require.NoError(t, err)
+	other := &Data{}
+	require.NoError(t, other.UnmarshalBinary(buf))
+	mst, err := other.Measurement(dbName, rpName, mstName)
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Hour, mst.TTL)
+
+	require.NoError(t, data.AlterMeasurementTTL(dbName, rpName, mstName, 0))
+	mst, err = data.Measurement(dbName, rpName, mstName)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), mst.TTL)
+}
+
+func Test_ApplyCreateMeasurementWithTTL(t *testing.T) {
+	data := initData()
+	require.NoError(t, data.CreateDatabase("foo", &RetentionPolicyInfo{
+		Name:     "bar",
+		ReplicaN: 1,
+		Duration: 24 * time.Hour,
+	}, nil, false, 1, nil))
+
+	cmd := &proto2.CreateMeasurementCommand{
+		DBName: proto.String("foo"),
+		RpName: proto.String("bar"),
+		Name:   proto.String("cpu"),
+		Ski:    &proto2.ShardKeyInfo{ShardKey: []string{"hostName"}, Type: proto.String(influxql.HASH)},
+		TTL:    proto.Int64(int64(2 * time.Hour)),
+	}
+	typ := proto2.Command_CreateMeasurementCommand
+	command := &proto2.Command{Type: &typ}
+	require.NoError(t, proto.SetExtension(command, proto2.E_CreateMeasurementCommand_Command, cmd))
+	require.NoError(t, ApplyCreateMeasurement(data, command))
+
+	mst, err := data.Measurement("foo", "bar", "cpu")
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Hour, mst.TTL)
+}
+
 func Test_Data_AlterShardKey(t *testing.T) {
 	data := initData()
 
@@ -1235,6 +1296,22 @@ func TestData_Marshal_Unmarshal(t *testing.T) {
 	assert2.Equal(t, dataUnMarshal.MigrateEvents[mei.eventId].currState, mei.currState)
 }
 
+func TestData_UpdateDecommission(t *testing.T) {
+	data := &Data{}
+	info := &DecommissionInfo{NodeID: 1, State: "paused", Paused: true, StartTime: 10,
+		Pts: []DecommissionPtInfo{{Db: "db0", Pt: 0, Target: 2, State: "copying"}, {Db: "db0", Pt: 2, State: "pending"}}}
+	data.UpdateDecommission(info.Marshal())
+	assert2.Equal(t, info, data.Decommissions[1])
+
+	dataClone := data.Clone()
+	dataClone.Decommissions[1].Pts[0].State = "moved"
+	assert2.Equal(t, "copying", data.Decommissions[1].Pts[0].State)
+
+	dataUnMarshal := &Data{}
+	dataUnMarshal.Unmarshal(data.Marshal())
+	assert2.Equal(t, info, dataUnMarshal.Decommissions[1])
+}
+
 func TestData_CreateMigrateEvent(t *testing.T) {
 	data := &Data{}
 	dbBriefInfo := &DatabaseBriefInfo{Name: "db0", EnableTagArray: false}
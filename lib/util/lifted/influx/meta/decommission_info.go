@@ -0,0 +1,114 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+)
+
+// DecommissionPtInfo is the persisted progress of the move of a db pt of a decommissioned node
+type DecommissionPtInfo struct {
+	Db     string
+	Pt     uint32
+	Target uint64
+	State  string
+	Error  string
+}
+
+// DecommissionInfo is the persisted state of the decommission of a node, the new leader resumes
+// the decommission from it
+type DecommissionInfo struct {
+	NodeID    uint64
+	State     string
+	Paused    bool
+	Cancelled bool
+	StartTime int64
+	EndTime   int64
+	Error     string
+	Pts       []DecommissionPtInfo
+}
+
+func (di *DecommissionInfo) Clone() *DecommissionInfo {
+	other := *di
+	other.Pts = make([]DecommissionPtInfo, len(di.Pts))
+	copy(other.Pts, di.Pts)
+	return &other
+}
+
+func (di *DecommissionInfo) Marshal() *proto2.DecommissionInfo {
+	pb := &proto2.DecommissionInfo{
+		NodeID:    proto.Uint64(di.NodeID),
+		State:     proto.String(di.State),
+		Paused:    proto.Bool(di.Paused),
+		Cancelled: proto.Bool(di.Cancelled),
+		StartTime: proto.Int64(di.StartTime),
+		EndTime:   proto.Int64(di.EndTime),
+		Error:     proto.String(di.Error),
+		Pts:       make([]*proto2.DecommissionPtInfo, len(di.Pts)),
+	}
+	for i := range di.Pts {
+		pt := &di.Pts[i]
+		pb.Pts[i] = &proto2.DecommissionPtInfo{
+			Db:     proto.String(pt.Db),
+			Pt:     proto.Uint32(pt.Pt),
+			Target: proto.Uint64(pt.Target),
+			State:  proto.String(pt.State),
+			Error:  proto.String(pt.Error),
+		}
+	}
+	return pb
+}
+
+func (di *DecommissionInfo) Unmarshal(pb *proto2.DecommissionInfo) {
+	di.NodeID = pb.GetNodeID()
+	di.State = pb.GetState()
+	di.Paused = pb.GetPaused()
+	di.Cancelled = pb.GetCancelled()
+	di.StartTime = pb.GetStartTime()
+	di.EndTime = pb.GetEndTime()
+	di.Error = pb.GetError()
+	di.Pts = make([]DecommissionPtInfo, len(pb.GetPts()))
+	for i, pt := range pb.GetPts() {
+		di.Pts[i] = DecommissionPtInfo{
+			Db:     pt.GetDb(),
+			Pt:     pt.GetPt(),
+			Target: pt.GetTarget(),
+			State:  pt.GetState(),
+			Error:  pt.GetError(),
+		}
+	}
+}
+
+// UpdateDecommission replaces the decommission state of the node
+func (data *Data) UpdateDecommission(pb *proto2.DecommissionInfo) {
+	if data.Decommissions == nil {
+		data.Decommissions = make(map[uint64]*DecommissionInfo)
+	}
+	info := &DecommissionInfo{}
+	info.Unmarshal(pb)
+	data.Decommissions[info.NodeID] = info
+}
+
+func (data *Data) CloneDecommissions() map[uint64]*DecommissionInfo {
+	if data.Decommissions == nil {
+		return nil
+	}
+	decommissions := make(map[uint64]*DecommissionInfo, len(data.Decommissions))
+	for nodeID, info := range data.Decommissions {
+		decommissions[nodeID] = info.Clone()
+	}
+	return decommissions
+}
@@ -123,6 +123,9 @@ var (
 	// ErrIncompatibleShardGroupDurations is returned when creating or updating a
 	// retention policy that has a warm duration not equal n * shard duration
 	ErrIncompatibleShardGroupDurations = errors.New("retention policy hot duration/warm duration/index duration should be equal n * shard duration and n>=1")
+
+	// ErrMeasurementTTLTooLow is returned when setting a measurement TTL lower than the allowed minimum.
+	ErrMeasurementTTLTooLow = fmt.Errorf("measurement ttl must be 0 or at least %s", MinRetentionPolicyDuration)
 )
 
 var (
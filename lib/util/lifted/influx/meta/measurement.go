@@ -250,7 +250,8 @@ type MeasurementInfo struct {
 	ObsOptions      *obs.ObsOptions // assign DatabaseInfo's ObsOptions to it when obatining MeasurementInfo
 	tagKeysTotal    int
 	ID              uint64
-	SchemaLock      sync.RWMutex //ts-meta not use
+	TTL             time.Duration // data older than TTL is expired, 0 means following the retention policy
+	SchemaLock      sync.RWMutex  //ts-meta not use
 }
 
 func NewMeasurementInfo(nameWithVer string, name string, engineType config.EngineType, id uint64) *MeasurementInfo {
@@ -329,6 +330,9 @@ func (msti *MeasurementInfo) marshal() *proto2.MeasurementInfo {
 		EngineType:  proto.Uint32(uint32(msti.EngineType)),
 		ID:          proto.Uint64(msti.ID),
 	}
+	if msti.TTL > 0 {
+		pb.TTL = proto.Int64(int64(msti.TTL))
+	}
 
 	if msti.ShardKeys != nil {
 		pb.ShardKeys = make([]*proto2.ShardKeyInfo, len(msti.ShardKeys))
@@ -374,6 +378,7 @@ func (msti *MeasurementInfo) unmarshal(pb *proto2.MeasurementInfo) {
 	msti.MarkDeleted = pb.GetMarkDeleted()
 	msti.EngineType = config.EngineType(pb.GetEngineType())
 	msti.ID = pb.GetID()
+	msti.TTL = time.Duration(pb.GetTTL())
 	if pb.GetShardKeys() != nil {
 		msti.ShardKeys = make([]ShardKeyInfo, len(pb.GetShardKeys()))
 		for i := range pb.GetShardKeys() {
@@ -435,6 +440,7 @@ func (msti *MeasurementInfo) clone() *MeasurementInfo {
 	other.MarkDeleted = msti.MarkDeleted
 	other.EngineType = msti.EngineType
 	other.tagKeysTotal = msti.tagKeysTotal
+	other.TTL = msti.TTL
 
 	other.Schema = msti.CloneSchema()
 	other.ShardIdexes = msti.CloneShardIdexes()
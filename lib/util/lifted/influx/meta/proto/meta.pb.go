@@ -100,6 +100,8 @@ const (
 	Command_UpdateMetaNodeStatusCommand           Command_Type = 102
 	Command_ShowClusterCommand                    Command_Type = 103
 	Command_IndexDurationCommand                  Command_Type = 104
+	Command_AlterMeasurementTTLCmd                Command_Type = 105
+	Command_UpdateDecommissionCommand             Command_Type = 106
 )
 
 var Command_Type_name = map[int32]string{
@@ -180,6 +182,8 @@ var Command_Type_name = map[int32]string{
 	102: "UpdateMetaNodeStatusCommand",
 	103: "ShowClusterCommand",
 	104: "IndexDurationCommand",
+	105: "AlterMeasurementTTLCmd",
+	106: "UpdateDecommissionCommand",
 }
 
 var Command_Type_value = map[string]int32{
@@ -260,6 +264,8 @@ var Command_Type_value = map[string]int32{
 	"UpdateMetaNodeStatusCommand":           102,
 	"ShowClusterCommand":                    103,
 	"IndexDurationCommand":                  104,
+	"AlterMeasurementTTLCmd":                105,
+	"UpdateDecommissionCommand":             106,
 }
 
 func (x Command_Type) Enum() *Command_Type {
@@ -318,6 +324,7 @@ type Data struct {
 	IsSQLiteEnabled      *bool                    `protobuf:"varint,32,opt,name=IsSQLiteEnabled" json:"IsSQLiteEnabled,omitempty"`
 	SqlNodes             []*DataNode              `protobuf:"bytes,33,rep,name=SqlNodes" json:"SqlNodes,omitempty"`
 	MaxMstID             *uint64                  `protobuf:"varint,34,opt,name=MaxMstID" json:"MaxMstID,omitempty"`
+	Decommissions        []*DecommissionInfo      `protobuf:"bytes,35,rep,name=Decommissions" json:"Decommissions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -571,6 +578,13 @@ func (m *Data) GetMaxMstID() uint64 {
 	return 0
 }
 
+func (m *Data) GetDecommissions() []*DecommissionInfo {
+	if m != nil {
+		return m.Decommissions
+	}
+	return nil
+}
+
 type Replications struct {
 	Groups               []*ReplicaGroup `protobuf:"bytes,1,rep,name=Groups" json:"Groups,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -1221,6 +1235,7 @@ type MeasurementInfo struct {
 	ID                   *uint64               `protobuf:"varint,11,opt,name=ID" json:"ID,omitempty"`
 	Options              *Options              `protobuf:"bytes,21,opt,name=Options" json:"Options,omitempty"`
 	SchemaUseForClean    map[string]*SchemaVal `protobuf:"bytes,22,rep,name=SchemaUseForClean" json:"SchemaUseForClean,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TTL                  *int64                `protobuf:"varint,23,opt,name=TTL" json:"TTL,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1341,6 +1356,13 @@ func (m *MeasurementInfo) GetSchemaUseForClean() map[string]*SchemaVal {
 	return nil
 }
 
+func (m *MeasurementInfo) GetTTL() int64 {
+	if m != nil && m.TTL != nil {
+		return *m.TTL
+	}
+	return 0
+}
+
 type SchemaVal struct {
 	Typ                  *int32   `protobuf:"varint,1,req,name=Typ" json:"Typ,omitempty"`
 	EndTime              *int32   `protobuf:"varint,2,opt,name=EndTime" json:"EndTime,omitempty"`
@@ -4520,6 +4542,7 @@ type CreateMeasurementCommand struct {
 	SchemaInfo           []*FieldSchema `protobuf:"bytes,8,rep,name=SchemaInfo" json:"SchemaInfo,omitempty"`
 	Options              *Options       `protobuf:"bytes,9,opt,name=Options" json:"Options,omitempty"`
 	InitNumOfShards      *int32         `protobuf:"varint,10,opt,name=InitNumOfShards" json:"InitNumOfShards,omitempty"`
+	TTL                  *int64         `protobuf:"varint,11,opt,name=TTL" json:"TTL,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -4619,6 +4642,13 @@ func (m *CreateMeasurementCommand) GetInitNumOfShards() int32 {
 	return 0
 }
 
+func (m *CreateMeasurementCommand) GetTTL() int64 {
+	if m != nil && m.TTL != nil {
+		return *m.TTL
+	}
+	return 0
+}
+
 var E_CreateMeasurementCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
 	ExtensionType: (*CreateMeasurementCommand)(nil),
@@ -7197,6 +7227,170 @@ func (m *MigrateEventInfo) GetAliveConnId() uint64 {
 	return 0
 }
 
+type DecommissionPtInfo struct {
+	Db                   *string  `protobuf:"bytes,1,req,name=Db" json:"Db,omitempty"`
+	Pt                   *uint32  `protobuf:"varint,2,req,name=Pt" json:"Pt,omitempty"`
+	Target               *uint64  `protobuf:"varint,3,opt,name=Target" json:"Target,omitempty"`
+	State                *string  `protobuf:"bytes,4,req,name=State" json:"State,omitempty"`
+	Error                *string  `protobuf:"bytes,5,opt,name=Error" json:"Error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecommissionPtInfo) Reset()         { *m = DecommissionPtInfo{} }
+func (m *DecommissionPtInfo) String() string { return proto.CompactTextString(m) }
+func (*DecommissionPtInfo) ProtoMessage()    {}
+func (*DecommissionPtInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{106}
+}
+func (m *DecommissionPtInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecommissionPtInfo.Unmarshal(m, b)
+}
+func (m *DecommissionPtInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecommissionPtInfo.Marshal(b, m, deterministic)
+}
+func (m *DecommissionPtInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionPtInfo.Merge(m, src)
+}
+func (m *DecommissionPtInfo) XXX_Size() int {
+	return xxx_messageInfo_DecommissionPtInfo.Size(m)
+}
+func (m *DecommissionPtInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionPtInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecommissionPtInfo proto.InternalMessageInfo
+
+func (m *DecommissionPtInfo) GetDb() string {
+	if m != nil && m.Db != nil {
+		return *m.Db
+	}
+	return ""
+}
+
+func (m *DecommissionPtInfo) GetPt() uint32 {
+	if m != nil && m.Pt != nil {
+		return *m.Pt
+	}
+	return 0
+}
+
+func (m *DecommissionPtInfo) GetTarget() uint64 {
+	if m != nil && m.Target != nil {
+		return *m.Target
+	}
+	return 0
+}
+
+func (m *DecommissionPtInfo) GetState() string {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return ""
+}
+
+func (m *DecommissionPtInfo) GetError() string {
+	if m != nil && m.Error != nil {
+		return *m.Error
+	}
+	return ""
+}
+
+type DecommissionInfo struct {
+	NodeID               *uint64               `protobuf:"varint,1,req,name=NodeID" json:"NodeID,omitempty"`
+	State                *string               `protobuf:"bytes,2,req,name=State" json:"State,omitempty"`
+	Paused               *bool                 `protobuf:"varint,3,opt,name=Paused" json:"Paused,omitempty"`
+	Cancelled            *bool                 `protobuf:"varint,4,opt,name=Cancelled" json:"Cancelled,omitempty"`
+	StartTime            *int64                `protobuf:"varint,5,opt,name=StartTime" json:"StartTime,omitempty"`
+	EndTime              *int64                `protobuf:"varint,6,opt,name=EndTime" json:"EndTime,omitempty"`
+	Error                *string               `protobuf:"bytes,7,opt,name=Error" json:"Error,omitempty"`
+	Pts                  []*DecommissionPtInfo `protobuf:"bytes,8,rep,name=Pts" json:"Pts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DecommissionInfo) Reset()         { *m = DecommissionInfo{} }
+func (m *DecommissionInfo) String() string { return proto.CompactTextString(m) }
+func (*DecommissionInfo) ProtoMessage()    {}
+func (*DecommissionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{107}
+}
+func (m *DecommissionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecommissionInfo.Unmarshal(m, b)
+}
+func (m *DecommissionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecommissionInfo.Marshal(b, m, deterministic)
+}
+func (m *DecommissionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionInfo.Merge(m, src)
+}
+func (m *DecommissionInfo) XXX_Size() int {
+	return xxx_messageInfo_DecommissionInfo.Size(m)
+}
+func (m *DecommissionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecommissionInfo proto.InternalMessageInfo
+
+func (m *DecommissionInfo) GetNodeID() uint64 {
+	if m != nil && m.NodeID != nil {
+		return *m.NodeID
+	}
+	return 0
+}
+
+func (m *DecommissionInfo) GetState() string {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return ""
+}
+
+func (m *DecommissionInfo) GetPaused() bool {
+	if m != nil && m.Paused != nil {
+		return *m.Paused
+	}
+	return false
+}
+
+func (m *DecommissionInfo) GetCancelled() bool {
+	if m != nil && m.Cancelled != nil {
+		return *m.Cancelled
+	}
+	return false
+}
+
+func (m *DecommissionInfo) GetStartTime() int64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
+	}
+	return 0
+}
+
+func (m *DecommissionInfo) GetEndTime() int64 {
+	if m != nil && m.EndTime != nil {
+		return *m.EndTime
+	}
+	return 0
+}
+
+func (m *DecommissionInfo) GetError() string {
+	if m != nil && m.Error != nil {
+		return *m.Error
+	}
+	return ""
+}
+
+func (m *DecommissionInfo) GetPts() []*DecommissionPtInfo {
+	if m != nil {
+		return m.Pts
+	}
+	return nil
+}
+
 type CreateEventCommand struct {
 	EventInfo            *MigrateEventInfo `protobuf:"bytes,1,req,name=eventInfo" json:"eventInfo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
@@ -7208,7 +7402,7 @@ func (m *CreateEventCommand) Reset()         { *m = CreateEventCommand{} }
 func (m *CreateEventCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateEventCommand) ProtoMessage()    {}
 func (*CreateEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{106}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{108}
 }
 func (m *CreateEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateEventCommand.Unmarshal(m, b)
@@ -7255,7 +7449,7 @@ func (m *UpdateEventCommand) Reset()         { *m = UpdateEventCommand{} }
 func (m *UpdateEventCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateEventCommand) ProtoMessage()    {}
 func (*UpdateEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{107}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{109}
 }
 func (m *UpdateEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateEventCommand.Unmarshal(m, b)
@@ -7305,7 +7499,7 @@ func (m *UpdatePtInfoCommand) Reset()         { *m = UpdatePtInfoCommand{} }
 func (m *UpdatePtInfoCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdatePtInfoCommand) ProtoMessage()    {}
 func (*UpdatePtInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{108}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{110}
 }
 func (m *UpdatePtInfoCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdatePtInfoCommand.Unmarshal(m, b)
@@ -7373,7 +7567,7 @@ func (m *RemoveEventCommand) Reset()         { *m = RemoveEventCommand{} }
 func (m *RemoveEventCommand) String() string { return proto.CompactTextString(m) }
 func (*RemoveEventCommand) ProtoMessage()    {}
 func (*RemoveEventCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{109}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{111}
 }
 func (m *RemoveEventCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveEventCommand.Unmarshal(m, b)
@@ -7422,7 +7616,7 @@ func (m *CreateDownSamplePolicyCommand) Reset()         { *m = CreateDownSampleP
 func (m *CreateDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDownSamplePolicyCommand) ProtoMessage()    {}
 func (*CreateDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{110}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{112}
 }
 func (m *CreateDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDownSamplePolicyCommand.Unmarshal(m, b)
@@ -7485,7 +7679,7 @@ func (m *DropDownSamplePolicyCommand) Reset()         { *m = DropDownSamplePolic
 func (m *DropDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*DropDownSamplePolicyCommand) ProtoMessage()    {}
 func (*DropDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{111}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{113}
 }
 func (m *DropDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropDownSamplePolicyCommand.Unmarshal(m, b)
@@ -7545,7 +7739,7 @@ func (m *GetDownSamplePolicyCommand) Reset()         { *m = GetDownSamplePolicyC
 func (m *GetDownSamplePolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*GetDownSamplePolicyCommand) ProtoMessage()    {}
 func (*GetDownSamplePolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{112}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{114}
 }
 func (m *GetDownSamplePolicyCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDownSamplePolicyCommand.Unmarshal(m, b)
@@ -7586,7 +7780,7 @@ func (m *CreateDbPtViewCommand) Reset()         { *m = CreateDbPtViewCommand{} }
 func (m *CreateDbPtViewCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateDbPtViewCommand) ProtoMessage()    {}
 func (*CreateDbPtViewCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{113}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{115}
 }
 func (m *CreateDbPtViewCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDbPtViewCommand.Unmarshal(m, b)
@@ -7642,7 +7836,7 @@ func (m *GetMeasurementInfoWithinSameRpCommand) Reset()         { *m = GetMeasur
 func (m *GetMeasurementInfoWithinSameRpCommand) String() string { return proto.CompactTextString(m) }
 func (*GetMeasurementInfoWithinSameRpCommand) ProtoMessage()    {}
 func (*GetMeasurementInfoWithinSameRpCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{114}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{116}
 }
 func (m *GetMeasurementInfoWithinSameRpCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMeasurementInfoWithinSameRpCommand.Unmarshal(m, b)
@@ -7703,7 +7897,7 @@ func (m *UpdateShardDownSampleInfoCommand) Reset()         { *m = UpdateShardDow
 func (m *UpdateShardDownSampleInfoCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateShardDownSampleInfoCommand) ProtoMessage()    {}
 func (*UpdateShardDownSampleInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{115}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{117}
 }
 func (m *UpdateShardDownSampleInfoCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateShardDownSampleInfoCommand.Unmarshal(m, b)
@@ -7750,7 +7944,7 @@ func (m *MarkTakeoverCommand) Reset()         { *m = MarkTakeoverCommand{} }
 func (m *MarkTakeoverCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkTakeoverCommand) ProtoMessage()    {}
 func (*MarkTakeoverCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{116}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{118}
 }
 func (m *MarkTakeoverCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkTakeoverCommand.Unmarshal(m, b)
@@ -7797,7 +7991,7 @@ func (m *MarkBalancerCommand) Reset()         { *m = MarkBalancerCommand{} }
 func (m *MarkBalancerCommand) String() string { return proto.CompactTextString(m) }
 func (*MarkBalancerCommand) ProtoMessage()    {}
 func (*MarkBalancerCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{117}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{119}
 }
 func (m *MarkBalancerCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarkBalancerCommand.Unmarshal(m, b)
@@ -7844,7 +8038,7 @@ func (m *CreateStreamCommand) Reset()         { *m = CreateStreamCommand{} }
 func (m *CreateStreamCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateStreamCommand) ProtoMessage()    {}
 func (*CreateStreamCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{118}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{120}
 }
 func (m *CreateStreamCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateStreamCommand.Unmarshal(m, b)
@@ -7891,7 +8085,7 @@ func (m *DropStreamCommand) Reset()         { *m = DropStreamCommand{} }
 func (m *DropStreamCommand) String() string { return proto.CompactTextString(m) }
 func (*DropStreamCommand) ProtoMessage()    {}
 func (*DropStreamCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{119}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{121}
 }
 func (m *DropStreamCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropStreamCommand.Unmarshal(m, b)
@@ -7940,7 +8134,7 @@ func (m *GetMeasurementInfoStoreCommand) Reset()         { *m = GetMeasurementIn
 func (m *GetMeasurementInfoStoreCommand) String() string { return proto.CompactTextString(m) }
 func (*GetMeasurementInfoStoreCommand) ProtoMessage()    {}
 func (*GetMeasurementInfoStoreCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{120}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{122}
 }
 func (m *GetMeasurementInfoStoreCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMeasurementInfoStoreCommand.Unmarshal(m, b)
@@ -8001,7 +8195,7 @@ func (m *VerifyDataNodeCommand) Reset()         { *m = VerifyDataNodeCommand{} }
 func (m *VerifyDataNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*VerifyDataNodeCommand) ProtoMessage()    {}
 func (*VerifyDataNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{121}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{123}
 }
 func (m *VerifyDataNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyDataNodeCommand.Unmarshal(m, b)
@@ -8047,7 +8241,7 @@ func (m *ExpandGroupsCommand) Reset()         { *m = ExpandGroupsCommand{} }
 func (m *ExpandGroupsCommand) String() string { return proto.CompactTextString(m) }
 func (*ExpandGroupsCommand) ProtoMessage()    {}
 func (*ExpandGroupsCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{122}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{124}
 }
 func (m *ExpandGroupsCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExpandGroupsCommand.Unmarshal(m, b)
@@ -8088,7 +8282,7 @@ func (m *UpdatePtVersionCommand) Reset()         { *m = UpdatePtVersionCommand{}
 func (m *UpdatePtVersionCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdatePtVersionCommand) ProtoMessage()    {}
 func (*UpdatePtVersionCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{123}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{125}
 }
 func (m *UpdatePtVersionCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdatePtVersionCommand.Unmarshal(m, b)
@@ -8143,7 +8337,7 @@ func (m *GetMeasurementsInfoCommand) Reset()         { *m = GetMeasurementsInfoC
 func (m *GetMeasurementsInfoCommand) String() string { return proto.CompactTextString(m) }
 func (*GetMeasurementsInfoCommand) ProtoMessage()    {}
 func (*GetMeasurementsInfoCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{124}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{126}
 }
 func (m *GetMeasurementsInfoCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMeasurementsInfoCommand.Unmarshal(m, b)
@@ -8199,7 +8393,7 @@ func (m *DatabaseBriefInfo) Reset()         { *m = DatabaseBriefInfo{} }
 func (m *DatabaseBriefInfo) String() string { return proto.CompactTextString(m) }
 func (*DatabaseBriefInfo) ProtoMessage()    {}
 func (*DatabaseBriefInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{125}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{127}
 }
 func (m *DatabaseBriefInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DatabaseBriefInfo.Unmarshal(m, b)
@@ -8251,7 +8445,7 @@ func (m *MeasurementsInfo) Reset()         { *m = MeasurementsInfo{} }
 func (m *MeasurementsInfo) String() string { return proto.CompactTextString(m) }
 func (*MeasurementsInfo) ProtoMessage()    {}
 func (*MeasurementsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{126}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{128}
 }
 func (m *MeasurementsInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MeasurementsInfo.Unmarshal(m, b)
@@ -8289,7 +8483,7 @@ func (m *RegisterQueryIDOffsetCommand) Reset()         { *m = RegisterQueryIDOff
 func (m *RegisterQueryIDOffsetCommand) String() string { return proto.CompactTextString(m) }
 func (*RegisterQueryIDOffsetCommand) ProtoMessage()    {}
 func (*RegisterQueryIDOffsetCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{127}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{129}
 }
 func (m *RegisterQueryIDOffsetCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterQueryIDOffsetCommand.Unmarshal(m, b)
@@ -8338,7 +8532,7 @@ func (m *CreateContinuousQueryCommand) Reset()         { *m = CreateContinuousQu
 func (m *CreateContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateContinuousQueryCommand) ProtoMessage()    {}
 func (*CreateContinuousQueryCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{128}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{130}
 }
 func (m *CreateContinuousQueryCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateContinuousQueryCommand.Unmarshal(m, b)
@@ -8399,7 +8593,7 @@ func (m *Sql2MetaHeartbeatCommand) Reset()         { *m = Sql2MetaHeartbeatComma
 func (m *Sql2MetaHeartbeatCommand) String() string { return proto.CompactTextString(m) }
 func (*Sql2MetaHeartbeatCommand) ProtoMessage()    {}
 func (*Sql2MetaHeartbeatCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{129}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{131}
 }
 func (m *Sql2MetaHeartbeatCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Sql2MetaHeartbeatCommand.Unmarshal(m, b)
@@ -8446,7 +8640,7 @@ func (m *ContinuousQueryReportCommand) Reset()         { *m = ContinuousQueryRep
 func (m *ContinuousQueryReportCommand) String() string { return proto.CompactTextString(m) }
 func (*ContinuousQueryReportCommand) ProtoMessage()    {}
 func (*ContinuousQueryReportCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{130}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{132}
 }
 func (m *ContinuousQueryReportCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContinuousQueryReportCommand.Unmarshal(m, b)
@@ -8494,7 +8688,7 @@ func (m *CQState) Reset()         { *m = CQState{} }
 func (m *CQState) String() string { return proto.CompactTextString(m) }
 func (*CQState) ProtoMessage()    {}
 func (*CQState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{131}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{133}
 }
 func (m *CQState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CQState.Unmarshal(m, b)
@@ -8539,7 +8733,7 @@ func (m *GetContinuousQueryLeaseCommand) Reset()         { *m = GetContinuousQue
 func (m *GetContinuousQueryLeaseCommand) String() string { return proto.CompactTextString(m) }
 func (*GetContinuousQueryLeaseCommand) ProtoMessage()    {}
 func (*GetContinuousQueryLeaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{132}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{134}
 }
 func (m *GetContinuousQueryLeaseCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetContinuousQueryLeaseCommand.Unmarshal(m, b)
@@ -8587,7 +8781,7 @@ func (m *DropContinuousQueryCommand) Reset()         { *m = DropContinuousQueryC
 func (m *DropContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
 func (*DropContinuousQueryCommand) ProtoMessage()    {}
 func (*DropContinuousQueryCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{133}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{135}
 }
 func (m *DropContinuousQueryCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DropContinuousQueryCommand.Unmarshal(m, b)
@@ -8640,7 +8834,7 @@ func (m *NotifyCQLeaseChangedCommand) Reset()         { *m = NotifyCQLeaseChange
 func (m *NotifyCQLeaseChangedCommand) String() string { return proto.CompactTextString(m) }
 func (*NotifyCQLeaseChangedCommand) ProtoMessage()    {}
 func (*NotifyCQLeaseChangedCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{134}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{136}
 }
 func (m *NotifyCQLeaseChangedCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyCQLeaseChangedCommand.Unmarshal(m, b)
@@ -8681,7 +8875,7 @@ func (m *SetNodeSegregateStatusCommand) Reset()         { *m = SetNodeSegregateS
 func (m *SetNodeSegregateStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*SetNodeSegregateStatusCommand) ProtoMessage()    {}
 func (*SetNodeSegregateStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{135}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{137}
 }
 func (m *SetNodeSegregateStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetNodeSegregateStatusCommand.Unmarshal(m, b)
@@ -8735,7 +8929,7 @@ func (m *RemoveNodeCommand) Reset()         { *m = RemoveNodeCommand{} }
 func (m *RemoveNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*RemoveNodeCommand) ProtoMessage()    {}
 func (*RemoveNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{136}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{138}
 }
 func (m *RemoveNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveNodeCommand.Unmarshal(m, b)
@@ -8785,7 +8979,7 @@ func (m *UpdateReplicationCommand) Reset()         { *m = UpdateReplicationComma
 func (m *UpdateReplicationCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateReplicationCommand) ProtoMessage()    {}
 func (*UpdateReplicationCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{137}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{139}
 }
 func (m *UpdateReplicationCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateReplicationCommand.Unmarshal(m, b)
@@ -8858,7 +9052,7 @@ func (m *ObsOptions) Reset()         { *m = ObsOptions{} }
 func (m *ObsOptions) String() string { return proto.CompactTextString(m) }
 func (*ObsOptions) ProtoMessage()    {}
 func (*ObsOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{138}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{140}
 }
 func (m *ObsOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObsOptions.Unmarshal(m, b)
@@ -8938,7 +9132,7 @@ func (m *Options) Reset()         { *m = Options{} }
 func (m *Options) String() string { return proto.CompactTextString(m) }
 func (*Options) ProtoMessage()    {}
 func (*Options) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{139}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{141}
 }
 func (m *Options) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Options.Unmarshal(m, b)
@@ -9028,7 +9222,7 @@ func (m *UpdateMeasurementCommand) Reset()         { *m = UpdateMeasurementComma
 func (m *UpdateMeasurementCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateMeasurementCommand) ProtoMessage()    {}
 func (*UpdateMeasurementCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{140}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{142}
 }
 func (m *UpdateMeasurementCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateMeasurementCommand.Unmarshal(m, b)
@@ -9100,7 +9294,7 @@ func (m *DataOps) Reset()         { *m = DataOps{} }
 func (m *DataOps) String() string { return proto.CompactTextString(m) }
 func (*DataOps) ProtoMessage()    {}
 func (*DataOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{141}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{143}
 }
 func (m *DataOps) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataOps.Unmarshal(m, b)
@@ -9167,7 +9361,7 @@ func (m *CreateSqlNodeCommand) Reset()         { *m = CreateSqlNodeCommand{} }
 func (m *CreateSqlNodeCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateSqlNodeCommand) ProtoMessage()    {}
 func (*CreateSqlNodeCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{142}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{144}
 }
 func (m *CreateSqlNodeCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSqlNodeCommand.Unmarshal(m, b)
@@ -9224,7 +9418,7 @@ func (m *UpdateSqlNodeStatusCommand) Reset()         { *m = UpdateSqlNodeStatusC
 func (m *UpdateSqlNodeStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateSqlNodeStatusCommand) ProtoMessage()    {}
 func (*UpdateSqlNodeStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{143}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{145}
 }
 func (m *UpdateSqlNodeStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateSqlNodeStatusCommand.Unmarshal(m, b)
@@ -9294,7 +9488,7 @@ func (m *UpdateNodeTmpIndexCommand) Reset()         { *m = UpdateNodeTmpIndexCom
 func (m *UpdateNodeTmpIndexCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateNodeTmpIndexCommand) ProtoMessage()    {}
 func (*UpdateNodeTmpIndexCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{144}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{146}
 }
 func (m *UpdateNodeTmpIndexCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNodeTmpIndexCommand.Unmarshal(m, b)
@@ -9366,7 +9560,7 @@ func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{145}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{147}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FileInfo.Unmarshal(m, b)
@@ -9481,7 +9675,7 @@ func (m *InsertFilesCommand) Reset()         { *m = InsertFilesCommand{} }
 func (m *InsertFilesCommand) String() string { return proto.CompactTextString(m) }
 func (*InsertFilesCommand) ProtoMessage()    {}
 func (*InsertFilesCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{146}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{148}
 }
 func (m *InsertFilesCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InsertFilesCommand.Unmarshal(m, b)
@@ -9531,7 +9725,7 @@ func (m *UpdateMetaNodeStatusCommand) Reset()         { *m = UpdateMetaNodeStatu
 func (m *UpdateMetaNodeStatusCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateMetaNodeStatusCommand) ProtoMessage()    {}
 func (*UpdateMetaNodeStatusCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{147}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{149}
 }
 func (m *UpdateMetaNodeStatusCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateMetaNodeStatusCommand.Unmarshal(m, b)
@@ -9600,7 +9794,7 @@ func (m *ShowClusterCommand) Reset()         { *m = ShowClusterCommand{} }
 func (m *ShowClusterCommand) String() string { return proto.CompactTextString(m) }
 func (*ShowClusterCommand) ProtoMessage()    {}
 func (*ShowClusterCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{148}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{150}
 }
 func (m *ShowClusterCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShowClusterCommand.Unmarshal(m, b)
@@ -9658,7 +9852,7 @@ func (m *NodeRow) Reset()         { *m = NodeRow{} }
 func (m *NodeRow) String() string { return proto.CompactTextString(m) }
 func (*NodeRow) ProtoMessage()    {}
 func (*NodeRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{149}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{151}
 }
 func (m *NodeRow) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeRow.Unmarshal(m, b)
@@ -9731,7 +9925,7 @@ func (m *EventRow) Reset()         { *m = EventRow{} }
 func (m *EventRow) String() string { return proto.CompactTextString(m) }
 func (*EventRow) ProtoMessage()    {}
 func (*EventRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{150}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{152}
 }
 func (m *EventRow) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EventRow.Unmarshal(m, b)
@@ -9819,7 +10013,7 @@ func (m *ShowClusterInfo) Reset()         { *m = ShowClusterInfo{} }
 func (m *ShowClusterInfo) String() string { return proto.CompactTextString(m) }
 func (*ShowClusterInfo) ProtoMessage()    {}
 func (*ShowClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{151}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{153}
 }
 func (m *ShowClusterInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShowClusterInfo.Unmarshal(m, b)
@@ -9866,7 +10060,7 @@ func (m *IndexDurationCommand) Reset()         { *m = IndexDurationCommand{} }
 func (m *IndexDurationCommand) String() string { return proto.CompactTextString(m) }
 func (*IndexDurationCommand) ProtoMessage()    {}
 func (*IndexDurationCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b5ea8fe65782bcc, []int{152}
+	return fileDescriptor_3b5ea8fe65782bcc, []int{154}
 }
 func (m *IndexDurationCommand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IndexDurationCommand.Unmarshal(m, b)
@@ -9916,6 +10110,124 @@ var E_IndexDurationCommand_Command = &proto.ExtensionDesc{
 	Filename:      "meta.proto",
 }
 
+type AlterMeasurementTTLCmd struct {
+	DBName               *string  `protobuf:"bytes,1,req,name=DBName" json:"DBName,omitempty"`
+	RpName               *string  `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
+	Name                 *string  `protobuf:"bytes,3,req,name=Name" json:"Name,omitempty"`
+	TTL                  *int64   `protobuf:"varint,4,req,name=TTL" json:"TTL,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterMeasurementTTLCmd) Reset()         { *m = AlterMeasurementTTLCmd{} }
+func (m *AlterMeasurementTTLCmd) String() string { return proto.CompactTextString(m) }
+func (*AlterMeasurementTTLCmd) ProtoMessage()    {}
+func (*AlterMeasurementTTLCmd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{155}
+}
+func (m *AlterMeasurementTTLCmd) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterMeasurementTTLCmd.Unmarshal(m, b)
+}
+func (m *AlterMeasurementTTLCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterMeasurementTTLCmd.Marshal(b, m, deterministic)
+}
+func (m *AlterMeasurementTTLCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterMeasurementTTLCmd.Merge(m, src)
+}
+func (m *AlterMeasurementTTLCmd) XXX_Size() int {
+	return xxx_messageInfo_AlterMeasurementTTLCmd.Size(m)
+}
+func (m *AlterMeasurementTTLCmd) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterMeasurementTTLCmd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterMeasurementTTLCmd proto.InternalMessageInfo
+
+func (m *AlterMeasurementTTLCmd) GetDBName() string {
+	if m != nil && m.DBName != nil {
+		return *m.DBName
+	}
+	return ""
+}
+
+func (m *AlterMeasurementTTLCmd) GetRpName() string {
+	if m != nil && m.RpName != nil {
+		return *m.RpName
+	}
+	return ""
+}
+
+func (m *AlterMeasurementTTLCmd) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *AlterMeasurementTTLCmd) GetTTL() int64 {
+	if m != nil && m.TTL != nil {
+		return *m.TTL
+	}
+	return 0
+}
+
+var E_AlterMeasurementTTLCmd_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*AlterMeasurementTTLCmd)(nil),
+	Field:         202,
+	Name:          "proto.AlterMeasurementTTLCmd.command",
+	Tag:           "bytes,202,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type UpdateDecommissionCommand struct {
+	Info                 *DecommissionInfo `protobuf:"bytes,1,req,name=Info" json:"Info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateDecommissionCommand) Reset()         { *m = UpdateDecommissionCommand{} }
+func (m *UpdateDecommissionCommand) String() string { return proto.CompactTextString(m) }
+func (*UpdateDecommissionCommand) ProtoMessage()    {}
+func (*UpdateDecommissionCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{156}
+}
+func (m *UpdateDecommissionCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDecommissionCommand.Unmarshal(m, b)
+}
+func (m *UpdateDecommissionCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDecommissionCommand.Marshal(b, m, deterministic)
+}
+func (m *UpdateDecommissionCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDecommissionCommand.Merge(m, src)
+}
+func (m *UpdateDecommissionCommand) XXX_Size() int {
+	return xxx_messageInfo_UpdateDecommissionCommand.Size(m)
+}
+func (m *UpdateDecommissionCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDecommissionCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDecommissionCommand proto.InternalMessageInfo
+
+func (m *UpdateDecommissionCommand) GetInfo() *DecommissionInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+var E_UpdateDecommissionCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*UpdateDecommissionCommand)(nil),
+	Field:         203,
+	Name:          "proto.UpdateDecommissionCommand.command",
+	Tag:           "bytes,203,opt,name=command",
+	Filename:      "meta.proto",
+}
+
 func init() {
 	proto.RegisterEnum("proto.Command_Type", Command_Type_name, Command_Type_value)
 	proto.RegisterType((*Data)(nil), "proto.Data")
@@ -10071,6 +10383,8 @@ func init() {
 	proto.RegisterType((*DbPt)(nil), "proto.DbPt")
 	proto.RegisterMapType((map[uint64]*ShardDurationInfo)(nil), "proto.DbPt.ShardsEntry")
 	proto.RegisterType((*MigrateEventInfo)(nil), "proto.MigrateEventInfo")
+	proto.RegisterType((*DecommissionPtInfo)(nil), "proto.DecommissionPtInfo")
+	proto.RegisterType((*DecommissionInfo)(nil), "proto.DecommissionInfo")
 	proto.RegisterExtension(E_CreateEventCommand_Command)
 	proto.RegisterType((*CreateEventCommand)(nil), "proto.CreateEventCommand")
 	proto.RegisterExtension(E_UpdateEventCommand_Command)
@@ -10155,477 +10469,494 @@ func init() {
 	proto.RegisterType((*ShowClusterInfo)(nil), "proto.ShowClusterInfo")
 	proto.RegisterExtension(E_IndexDurationCommand_Command)
 	proto.RegisterType((*IndexDurationCommand)(nil), "proto.IndexDurationCommand")
+	proto.RegisterExtension(E_AlterMeasurementTTLCmd_Command)
+	proto.RegisterType((*AlterMeasurementTTLCmd)(nil), "proto.AlterMeasurementTTLCmd")
+	proto.RegisterExtension(E_UpdateDecommissionCommand_Command)
+	proto.RegisterType((*UpdateDecommissionCommand)(nil), "proto.UpdateDecommissionCommand")
 }
 
 func init() { proto.RegisterFile("meta.proto", fileDescriptor_3b5ea8fe65782bcc) }
 
 var fileDescriptor_3b5ea8fe65782bcc = []byte{
-	// 7467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3d, 0x5b, 0x8c, 0x64, 0xc7,
-	0x55, 0xba, 0xfd, 0x98, 0xe9, 0xa9, 0xd9, 0x9e, 0x9d, 0xad, 0x7d, 0xb8, 0x3d, 0x5e, 0xaf, 0x67,
-	0x6f, 0xd6, 0xf6, 0xc6, 0x8e, 0xd7, 0xf1, 0x28, 0xb1, 0x1d, 0x27, 0x71, 0x32, 0x33, 0xbd, 0x8f,
-	0x8e, 0x77, 0x76, 0xda, 0xb7, 0xc7, 0xbb, 0x10, 0x87, 0xe0, 0x3b, 0xd3, 0xb5, 0x33, 0x37, 0xd3,
-	0xd3, 0xdd, 0xbe, 0xf7, 0xce, 0xee, 0x8e, 0x15, 0x14, 0x27, 0x91, 0x82, 0x02, 0x42, 0x08, 0x21,
-	0xf2, 0x12, 0x04, 0x12, 0x92, 0x00, 0x81, 0x00, 0x09, 0x09, 0x79, 0xe0, 0x04, 0xf2, 0x82, 0x80,
-	0x10, 0x3f, 0x08, 0xc4, 0x17, 0x82, 0x2f, 0x24, 0x04, 0x08, 0x7e, 0x78, 0x08, 0x90, 0xd0, 0x39,
-	0xf5, 0xbe, 0xb7, 0xee, 0x9d, 0xdd, 0x4d, 0x36, 0xe2, 0xab, 0x6f, 0x9d, 0x7a, 0x9d, 0x73, 0xea,
-	0xd4, 0xa9, 0x53, 0x75, 0x4e, 0x55, 0x13, 0xb2, 0xc3, 0xd2, 0xf0, 0xcc, 0x38, 0x1e, 0xa5, 0x23,
-	0x5a, 0xc7, 0x1f, 0xff, 0x3f, 0xa7, 0x49, 0xad, 0x1d, 0xa6, 0x21, 0xa5, 0xa4, 0xb6, 0xc6, 0xe2,
-	0x9d, 0x96, 0x37, 0x5f, 0x39, 0x5d, 0x0b, 0xf0, 0x9b, 0x1e, 0x21, 0xf5, 0xce, 0xb0, 0xcf, 0x6e,
-	0xb4, 0x2a, 0x08, 0xe4, 0x09, 0x7a, 0x9c, 0x4c, 0x2d, 0x0f, 0x76, 0x93, 0x94, 0xc5, 0x9d, 0x76,
-	0xab, 0x8a, 0x39, 0x1a, 0x40, 0xef, 0x27, 0xf5, 0x4b, 0xa3, 0x3e, 0x4b, 0x5a, 0xb5, 0xf9, 0xea,
-	0xe9, 0xe9, 0x85, 0x83, 0xbc, 0xbb, 0x33, 0x00, 0xeb, 0x0c, 0xaf, 0x8e, 0x02, 0x9e, 0x4b, 0x1f,
-	0x23, 0x53, 0xd0, 0xed, 0x7a, 0x98, 0xb0, 0xa4, 0x55, 0xc7, 0xa2, 0x87, 0x45, 0x51, 0x09, 0xc7,
-	0xe2, 0xba, 0x14, 0xb4, 0xfc, 0x5c, 0xc2, 0xe2, 0xa4, 0x35, 0x61, 0xb5, 0x0c, 0x30, 0xde, 0x32,
-	0xe6, 0x02, 0x7a, 0x2b, 0xe1, 0x0d, 0xec, 0xaf, 0xdd, 0x9a, 0xe4, 0xe8, 0x29, 0x00, 0x3d, 0x4d,
-	0x0e, 0xae, 0x84, 0x37, 0x7a, 0x5b, 0x61, 0xdc, 0x3f, 0x1f, 0x8f, 0x76, 0xc7, 0x9d, 0x76, 0xab,
-	0x81, 0x65, 0xb2, 0x60, 0x7a, 0x82, 0x10, 0x09, 0xea, 0xb4, 0x5b, 0x53, 0x58, 0xc8, 0x80, 0xd0,
-	0x47, 0x38, 0x05, 0x9c, 0x58, 0x62, 0xa1, 0x24, 0xe1, 0x81, 0x2e, 0x01, 0xc5, 0x57, 0x98, 0x2c,
-	0x3e, 0xed, 0xe6, 0x8d, 0x2e, 0x41, 0x7d, 0x72, 0x40, 0xf0, 0xb4, 0x9b, 0x5e, 0xda, 0xdd, 0x69,
-	0xcd, 0xcc, 0x57, 0x4e, 0x37, 0x03, 0x0b, 0x46, 0x1f, 0x25, 0x13, 0xdd, 0xf4, 0x72, 0xc4, 0xae,
-	0xb7, 0x0e, 0x62, 0x7b, 0x77, 0x19, 0xdd, 0x9f, 0xe1, 0x39, 0x67, 0x87, 0x69, 0xbc, 0x17, 0x88,
-	0x62, 0xd0, 0x28, 0xd6, 0xec, 0xb2, 0x18, 0x7a, 0x69, 0xcd, 0xce, 0x7b, 0xd0, 0xa8, 0x09, 0x13,
-	0x0c, 0xc2, 0x91, 0x96, 0x0c, 0x3a, 0xa4, 0x18, 0x64, 0x82, 0x05, 0x83, 0x10, 0xd4, 0x69, 0xb7,
-	0xa8, 0x62, 0x90, 0x80, 0x40, 0x6f, 0x2b, 0xe1, 0x8d, 0xb3, 0xd7, 0xd8, 0x30, 0x5d, 0x1d, 0x77,
-	0xfa, 0xad, 0xc3, 0xf3, 0xde, 0xe9, 0x5a, 0x60, 0xc1, 0xa0, 0xb7, 0xb5, 0x70, 0x9b, 0xad, 0x5e,
-	0x63, 0xf1, 0xd9, 0x61, 0xb8, 0x3e, 0x60, 0xfd, 0xd6, 0x91, 0x79, 0xef, 0x74, 0x23, 0xc8, 0x82,
-	0xe9, 0x9b, 0x49, 0x73, 0x25, 0xda, 0x8c, 0xc3, 0x94, 0x61, 0xed, 0xa4, 0x75, 0xd4, 0xa2, 0xd9,
-	0xcc, 0x43, 0x5e, 0xda, 0xa5, 0xa1, 0xa3, 0xa5, 0x70, 0x10, 0x0e, 0x37, 0x74, 0x47, 0xc7, 0x78,
-	0x47, 0x19, 0xb0, 0x60, 0x40, 0x7b, 0x74, 0x7d, 0xd8, 0x0b, 0x77, 0xc6, 0x03, 0x90, 0xa2, 0xbb,
-	0x10, 0xf3, 0x2c, 0x98, 0x3e, 0x4c, 0x26, 0x7b, 0x69, 0xcc, 0xc2, 0x9d, 0xa4, 0xd5, 0x42, 0x64,
-	0x0e, 0x09, 0x64, 0x38, 0x14, 0xd1, 0x90, 0x25, 0xe8, 0x3c, 0x99, 0x06, 0xe1, 0xe1, 0x39, 0xed,
-	0xd6, 0xdd, 0xd8, 0xa4, 0x09, 0x12, 0x82, 0xbb, 0x3c, 0x1a, 0x0e, 0x3b, 0xfd, 0xd6, 0x1c, 0xe6,
-	0x6b, 0x00, 0x7d, 0x9a, 0x4c, 0x3f, 0xbb, 0xcb, 0xe2, 0xbd, 0x4e, 0xbb, 0x33, 0x8c, 0xd2, 0xd6,
-	0x3d, 0xd8, 0xe1, 0x71, 0x73, 0xc4, 0x8d, 0x6c, 0x3e, 0xec, 0x66, 0x05, 0xda, 0x26, 0xcd, 0x80,
-	0x8d, 0x07, 0xd1, 0x46, 0x88, 0xe3, 0x97, 0xb4, 0x8e, 0x63, 0x0b, 0x27, 0xcc, 0x16, 0xac, 0x02,
-	0xbc, 0x0d, 0xbb, 0x12, 0x7d, 0x0d, 0x39, 0x04, 0x28, 0xef, 0xae, 0x27, 0x1b, 0x71, 0x34, 0x4e,
-	0xa3, 0xd1, 0xb0, 0xd3, 0x6e, 0xdd, 0x8b, 0xb8, 0xe6, 0x33, 0xe8, 0x29, 0xd2, 0x04, 0x02, 0x9e,
-	0x5d, 0xde, 0x0a, 0x87, 0x9b, 0xc0, 0xc8, 0x13, 0x58, 0xd2, 0x06, 0x02, 0x67, 0x2e, 0xed, 0xee,
-	0xac, 0x5e, 0xc5, 0x89, 0x95, 0xb4, 0xee, 0x9b, 0xf7, 0x4e, 0xd7, 0x03, 0x13, 0x04, 0x43, 0xd2,
-	0x49, 0x7a, 0xcf, 0x5e, 0x8c, 0x52, 0x26, 0x07, 0x6f, 0x9e, 0x0f, 0x5e, 0x06, 0x4c, 0x1f, 0x26,
-	0x8d, 0xde, 0x8b, 0x03, 0x3e, 0xc9, 0x4e, 0xba, 0xe7, 0xa4, 0x2a, 0x40, 0xe7, 0x48, 0x63, 0x25,
-	0xbc, 0xb1, 0x92, 0xa4, 0x9d, 0x76, 0xcb, 0x47, 0xcc, 0x54, 0x7a, 0xee, 0x6d, 0x64, 0xda, 0x98,
-	0x41, 0x74, 0x96, 0x54, 0xb7, 0xd9, 0x5e, 0xcb, 0x9b, 0xf7, 0x4e, 0x4f, 0x05, 0xf0, 0x09, 0xda,
-	0xe8, 0x5a, 0x38, 0xd8, 0x65, 0xad, 0xca, 0xbc, 0x67, 0x76, 0xb3, 0xd4, 0xe5, 0xf2, 0xc7, 0x73,
-	0x9f, 0xaa, 0x3c, 0xe9, 0xcd, 0x3d, 0x4d, 0x66, 0xb3, 0x63, 0xe3, 0x68, 0xf0, 0x88, 0xd9, 0x60,
-	0xcd, 0xac, 0xff, 0x1c, 0xa1, 0xf9, 0x91, 0x71, 0xb4, 0xf0, 0x6a, 0x1b, 0x25, 0xa9, 0x4f, 0x45,
-	0x5d, 0x18, 0x93, 0xc4, 0x68, 0xd6, 0x7f, 0x23, 0x39, 0x60, 0x66, 0xd1, 0x87, 0xc9, 0x84, 0x10,
-	0x0d, 0xcf, 0xd2, 0xc7, 0x66, 0xdf, 0x81, 0x28, 0xe2, 0x7f, 0xd0, 0x53, 0xb5, 0x11, 0x42, 0x67,
-	0x48, 0xa5, 0xd3, 0xc6, 0xd5, 0xa3, 0x19, 0x54, 0x3a, 0x6d, 0xce, 0x5c, 0xb1, 0x48, 0x54, 0x10,
-	0xaa, 0xd2, 0xf4, 0x24, 0xa9, 0x77, 0x19, 0x68, 0xf2, 0x2a, 0x76, 0x34, 0x2d, 0x3a, 0x02, 0x58,
-	0xc0, 0x73, 0xe8, 0x31, 0x32, 0xd1, 0x4b, 0xc3, 0x74, 0x17, 0xd6, 0x11, 0xa8, 0x2c, 0x52, 0x6a,
-	0x99, 0xaa, 0xeb, 0x65, 0xca, 0x7f, 0x88, 0xd4, 0xa0, 0x52, 0x0e, 0x05, 0x4a, 0x6a, 0xc1, 0x68,
-	0xc0, 0x44, 0xf7, 0xf8, 0xed, 0x9f, 0x24, 0x93, 0xdd, 0x74, 0xf5, 0xfa, 0x90, 0xc5, 0xd0, 0x85,
-	0x58, 0x25, 0xf8, 0x9a, 0x27, 0x52, 0xfe, 0xcb, 0x1e, 0xe8, 0x55, 0x18, 0x44, 0x7a, 0x8a, 0xd4,
-	0xb1, 0x2c, 0x96, 0x98, 0x5e, 0x98, 0x91, 0x88, 0xf2, 0x16, 0x82, 0xba, 0x6a, 0x48, 0xe0, 0x5a,
-	0xc9, 0xe2, 0xda, 0x4d, 0x3b, 0x7d, 0x5c, 0x23, 0x9b, 0x01, 0x7e, 0xc3, 0xa8, 0x5d, 0x66, 0x71,
-	0xab, 0x86, 0x63, 0x0c, 0x9f, 0x88, 0xe5, 0xf9, 0x4e, 0xbb, 0x55, 0x47, 0x65, 0x8c, 0xdf, 0xfe,
-	0x23, 0xa4, 0x21, 0x05, 0x89, 0x9e, 0x24, 0xb5, 0xf6, 0x7a, 0x37, 0x15, 0x83, 0xd2, 0x54, 0x28,
-	0xa0, 0x94, 0x61, 0x96, 0xff, 0x2f, 0x1e, 0x69, 0xc8, 0x45, 0xc4, 0xe0, 0x42, 0x4d, 0x72, 0xe1,
-	0xc2, 0x28, 0x49, 0x11, 0xb7, 0xa9, 0x00, 0xbf, 0x69, 0x8b, 0x4c, 0x06, 0xdd, 0xe5, 0xc5, 0x7e,
-	0x3f, 0xc6, 0x6e, 0xa7, 0x02, 0x99, 0x84, 0x9c, 0xb5, 0xe5, 0x2e, 0x56, 0xa8, 0xf2, 0x1c, 0x91,
-	0xcc, 0x8c, 0x48, 0x55, 0x51, 0x79, 0x84, 0xd4, 0x2f, 0xae, 0x45, 0x3b, 0xac, 0x35, 0xc1, 0x8d,
-	0x04, 0x4c, 0xc0, 0xe2, 0x70, 0x7e, 0x94, 0x24, 0xd1, 0x18, 0x3b, 0x99, 0xc4, 0xbe, 0x0d, 0x08,
-	0x4c, 0xe9, 0x1e, 0xdb, 0x8c, 0xd9, 0x66, 0x98, 0x32, 0xd1, 0x6c, 0x83, 0x6b, 0xd9, 0x0c, 0x58,
-	0x8d, 0x22, 0x41, 0x74, 0xf8, 0x28, 0xee, 0x92, 0x86, 0x9c, 0xcf, 0xf4, 0x3e, 0x52, 0xb9, 0x14,
-	0x89, 0x01, 0xca, 0xad, 0xa8, 0x95, 0x4b, 0x11, 0x20, 0x8e, 0x3a, 0xb4, 0x2d, 0x66, 0x96, 0x48,
-	0x81, 0xde, 0x59, 0x1c, 0x44, 0xd7, 0x98, 0xc8, 0xac, 0x72, 0x8d, 0x6c, 0x80, 0x80, 0x95, 0x8b,
-	0x2f, 0xe1, 0x58, 0x4d, 0x05, 0x95, 0xc5, 0x97, 0xfc, 0x2f, 0x54, 0xc9, 0x01, 0xd3, 0x3a, 0x01,
-	0xdc, 0x2e, 0x85, 0x3b, 0x0c, 0x7b, 0x9f, 0x0a, 0xf0, 0x9b, 0x3e, 0x4e, 0x8e, 0xb5, 0xd9, 0xd5,
-	0x70, 0x77, 0x90, 0x06, 0x2c, 0x65, 0x43, 0x98, 0x5b, 0xdd, 0xd1, 0x20, 0xda, 0xd8, 0x13, 0x23,
-	0x50, 0x90, 0x4b, 0x2f, 0x90, 0x43, 0x36, 0x28, 0x62, 0x72, 0x82, 0xcc, 0xa9, 0x99, 0x68, 0x55,
-	0x41, 0x0a, 0xf3, 0x95, 0xa0, 0xa5, 0xe5, 0xd1, 0x30, 0x8d, 0x86, 0xbb, 0xa3, 0xdd, 0x04, 0x34,
-	0x4f, 0xa4, 0xcc, 0x31, 0xd9, 0x92, 0x9d, 0x2f, 0x5a, 0xca, 0x55, 0xe2, 0x8b, 0x56, 0xbc, 0xdd,
-	0x66, 0x03, 0x96, 0xb2, 0x3e, 0xca, 0x4a, 0x23, 0x30, 0x41, 0xf4, 0x51, 0xd2, 0x40, 0x25, 0xfd,
-	0x0c, 0xdb, 0x6b, 0x4d, 0x58, 0x6a, 0x47, 0x82, 0xb1, 0x6d, 0x55, 0x88, 0x3e, 0x40, 0x66, 0xb8,
-	0xb2, 0x5e, 0x0b, 0x37, 0x17, 0xe3, 0x38, 0xdc, 0x6b, 0x4d, 0x62, 0xab, 0x19, 0x28, 0xe8, 0x0f,
-	0xa1, 0x5f, 0x2e, 0xa1, 0x64, 0x54, 0x03, 0x95, 0x86, 0x85, 0x77, 0x15, 0xd7, 0x18, 0xb0, 0x02,
-	0x3c, 0x63, 0xe1, 0x5d, 0x5d, 0x4f, 0x44, 0x46, 0x20, 0x4b, 0xf8, 0x5f, 0xf2, 0xc8, 0xe1, 0x0c,
-	0xe3, 0x7a, 0x63, 0xb6, 0x61, 0x8c, 0x9d, 0xa7, 0xc6, 0x6e, 0x8e, 0x34, 0xda, 0xbb, 0x31, 0xea,
-	0x43, 0x14, 0x96, 0x6a, 0xa0, 0xd2, 0xf4, 0x0c, 0xa1, 0xda, 0x3e, 0x54, 0xa5, 0xaa, 0x58, 0xca,
-	0x91, 0x63, 0x11, 0x50, 0xc3, 0xb9, 0xad, 0x09, 0xf0, 0xc9, 0x81, 0x2b, 0x61, 0xbc, 0xa3, 0x5a,
-	0xa9, 0x63, 0x2b, 0x16, 0xcc, 0xff, 0x87, 0x09, 0x72, 0x70, 0x85, 0x85, 0xc9, 0x6e, 0xcc, 0x76,
-	0x84, 0x51, 0xe3, 0x94, 0xb7, 0xc7, 0xc8, 0x94, 0x64, 0x2e, 0x28, 0xa0, 0x6a, 0xd1, 0x10, 0xe8,
-	0x52, 0xf4, 0x29, 0x32, 0xd1, 0xdb, 0xd8, 0x62, 0x3b, 0xa1, 0x90, 0x2f, 0x5f, 0x1a, 0x51, 0x76,
-	0x77, 0x67, 0x78, 0x21, 0x61, 0x43, 0xf2, 0x44, 0x56, 0x24, 0x6a, 0x79, 0x91, 0x78, 0x8a, 0x34,
-	0x23, 0x30, 0x01, 0x03, 0x36, 0xd0, 0xd4, 0x4d, 0x2f, 0x1c, 0x11, 0x9d, 0x74, 0xcc, 0xbc, 0xc0,
-	0x2e, 0x0a, 0x6a, 0xe3, 0xec, 0x70, 0x33, 0x1a, 0xb2, 0xb5, 0xbd, 0x31, 0x43, 0x81, 0x6a, 0x06,
-	0x06, 0x84, 0x3e, 0x41, 0x0e, 0x2c, 0x8f, 0x06, 0xbd, 0x74, 0x14, 0xe3, 0x04, 0x44, 0xd9, 0xd1,
-	0xf4, 0x9a, 0x59, 0x81, 0x55, 0x90, 0x3e, 0x46, 0x88, 0x16, 0x0e, 0x14, 0x28, 0xa7, 0xd4, 0x18,
-	0x85, 0xe8, 0x39, 0x42, 0xb8, 0xad, 0xdf, 0xbf, 0xc1, 0x92, 0xd6, 0x14, 0x72, 0xea, 0x81, 0x22,
-	0x4e, 0xa9, 0x82, 0x9c, 0x5b, 0x46, 0x4d, 0xb4, 0x5e, 0x86, 0x51, 0x6a, 0xda, 0x38, 0x04, 0x6d,
-	0x9c, 0x2c, 0x58, 0xa8, 0xee, 0x69, 0x54, 0x44, 0x15, 0xdc, 0xac, 0x64, 0xe4, 0x5c, 0x2e, 0x40,
-	0x59, 0x21, 0xa7, 0xcf, 0x93, 0x43, 0x7c, 0x7c, 0x9e, 0x4b, 0xd8, 0xb9, 0x51, 0xbc, 0x3c, 0x60,
-	0xe1, 0xb0, 0x75, 0x0c, 0x51, 0x7e, 0xa4, 0x74, 0x70, 0x8d, 0xf2, 0x1c, 0xf3, 0x7c, 0x3b, 0x73,
-	0x6f, 0x20, 0xd3, 0x86, 0x24, 0xec, 0x67, 0xba, 0xd4, 0x4d, 0xd3, 0xe5, 0x19, 0x72, 0x30, 0xc3,
-	0x1a, 0xb3, 0x7a, 0x8d, 0x57, 0xf7, 0x6d, 0xbb, 0xe5, 0x80, 0x14, 0x14, 0xa8, 0x63, 0x36, 0x76,
-	0x99, 0x1c, 0x73, 0x23, 0xed, 0x40, 0xe9, 0x01, 0xbb, 0xcd, 0x59, 0x39, 0x23, 0xb0, 0xfe, 0xe5,
-	0x70, 0x60, 0x1a, 0x42, 0x4f, 0x90, 0x29, 0x05, 0x87, 0xa6, 0xd6, 0xf6, 0xc6, 0x38, 0xc3, 0xea,
-	0x01, 0x7c, 0xc2, 0x92, 0x78, 0x76, 0xd8, 0xc7, 0x25, 0x8e, 0xd3, 0x27, 0x93, 0xfe, 0xbf, 0xd7,
-	0x73, 0xaa, 0xa5, 0x70, 0x9a, 0xda, 0xaa, 0xa5, 0x72, 0x53, 0xaa, 0xa5, 0x72, 0x53, 0xaa, 0xa5,
-	0x62, 0xa9, 0x96, 0xa7, 0xc8, 0x01, 0x63, 0xa4, 0xe5, 0xde, 0xfa, 0x98, 0x5b, 0x08, 0x02, 0xab,
-	0x2c, 0x5d, 0x21, 0xd3, 0x2b, 0x49, 0x7a, 0x99, 0xc5, 0x09, 0xca, 0xdc, 0x0c, 0x56, 0x7d, 0xb8,
-	0x78, 0xf1, 0x39, 0x63, 0x94, 0x16, 0x5b, 0x0e, 0x03, 0x42, 0x9f, 0x20, 0xd3, 0x1a, 0x79, 0xb9,
-	0x6d, 0x3f, 0x6a, 0xea, 0x26, 0xbe, 0x95, 0x04, 0x44, 0xcc, 0x92, 0xb0, 0xd7, 0x33, 0x77, 0x12,
-	0x49, 0x6b, 0xd2, 0xda, 0xeb, 0x59, 0xbb, 0x0c, 0xdc, 0xeb, 0x59, 0xa5, 0xb3, 0x2a, 0xaa, 0x91,
-	0x57, 0x51, 0xf3, 0x64, 0xfa, 0xc2, 0x28, 0x55, 0x9c, 0x9e, 0x42, 0x4e, 0x9b, 0xa0, 0x9c, 0x86,
-	0x26, 0x58, 0xc4, 0x82, 0xc1, 0xb0, 0xe9, 0x0d, 0xb1, 0x2a, 0x39, 0xcd, 0x87, 0x2d, 0x9f, 0x03,
-	0xfc, 0xd0, 0xd0, 0xa4, 0x75, 0xc0, 0xe2, 0x87, 0xb1, 0xb5, 0x46, 0x7e, 0x18, 0x25, 0xe9, 0x2a,
-	0x39, 0xa2, 0x37, 0x9e, 0x9a, 0xfd, 0xad, 0x26, 0xca, 0xf6, 0x3d, 0x72, 0xeb, 0xe1, 0x28, 0x12,
-	0x38, 0x2b, 0xc2, 0x8e, 0x24, 0x3b, 0x74, 0xfb, 0x4d, 0xeb, 0xa6, 0x39, 0x63, 0x42, 0x72, 0xd8,
-	0x61, 0x41, 0x38, 0xe5, 0xfe, 0x08, 0xa9, 0x63, 0x01, 0x61, 0xfd, 0xf0, 0x04, 0x0c, 0xc0, 0xc5,
-	0x30, 0x49, 0x83, 0xdd, 0x21, 0xce, 0x2b, 0xbe, 0x8a, 0x9a, 0x20, 0xff, 0x7f, 0x3c, 0x32, 0x63,
-	0xcb, 0x48, 0xce, 0xb2, 0x3d, 0x4e, 0xa6, 0x7a, 0x69, 0x18, 0xa7, 0x62, 0x6a, 0x02, 0xdb, 0x35,
-	0xc0, 0x9c, 0xb6, 0x7c, 0x26, 0xc9, 0x24, 0xd4, 0x13, 0x82, 0xb0, 0x98, 0x0a, 0x63, 0x56, 0x03,
-	0xe8, 0x69, 0x32, 0x21, 0xb4, 0x34, 0x9f, 0x3a, 0xb3, 0xa6, 0xc0, 0x22, 0x4f, 0x45, 0x3e, 0x10,
-	0xb1, 0x16, 0xef, 0x0e, 0x37, 0x42, 0xde, 0xd2, 0x04, 0x27, 0xc2, 0x00, 0x65, 0x96, 0xb3, 0xc9,
-	0xdc, 0x72, 0xd6, 0x22, 0x93, 0xd7, 0xf8, 0x20, 0xb4, 0x0e, 0x60, 0xa6, 0x4c, 0xfa, 0x1f, 0xae,
-	0x88, 0x65, 0xdd, 0x49, 0xf9, 0x09, 0xd2, 0xc0, 0xad, 0x47, 0xa7, 0xcd, 0x97, 0xfc, 0xe6, 0x52,
-	0xa5, 0xe5, 0x05, 0x0a, 0x06, 0x63, 0xb9, 0x12, 0x71, 0x0d, 0x32, 0x15, 0xc0, 0x27, 0x42, 0xc2,
-	0x1b, 0x48, 0x2d, 0x40, 0xc2, 0x1b, 0xb8, 0x93, 0x8a, 0x58, 0xac, 0x76, 0x52, 0x11, 0x43, 0xeb,
-	0x5f, 0x9e, 0xe7, 0x70, 0x6b, 0x5e, 0x26, 0x61, 0x11, 0xd3, 0x92, 0x74, 0x91, 0x5d, 0x63, 0x03,
-	0x34, 0xea, 0xab, 0x41, 0x16, 0x0c, 0x33, 0xc7, 0x3a, 0x3c, 0xe1, 0x66, 0xbd, 0x05, 0xe3, 0x0a,
-	0x2c, 0xec, 0xaf, 0x0e, 0x07, 0x7b, 0xad, 0x29, 0x9c, 0x9e, 0x2a, 0xcd, 0x8f, 0x95, 0xe4, 0x54,
-	0xc5, 0x95, 0xb2, 0x11, 0x18, 0x10, 0x3f, 0x20, 0x07, 0x4c, 0xbb, 0x06, 0xda, 0x52, 0x16, 0x28,
-	0xec, 0x91, 0xa6, 0x0c, 0x63, 0x13, 0x68, 0x04, 0xce, 0x57, 0xb8, 0x8d, 0x87, 0x3c, 0xa7, 0xa4,
-	0xd6, 0xdb, 0x54, 0xf6, 0x3e, 0x7e, 0xfb, 0x77, 0x93, 0x3a, 0x5f, 0xab, 0x67, 0x49, 0xb5, 0xd3,
-	0xbf, 0x81, 0xed, 0xd4, 0x03, 0xf8, 0xf4, 0xdf, 0x49, 0x66, 0xb3, 0xfa, 0xc6, 0x29, 0xe7, 0x94,
-	0xd4, 0x56, 0x46, 0x7d, 0x26, 0xb7, 0x59, 0xf0, 0x8d, 0xac, 0x60, 0x49, 0x1a, 0x0d, 0xf9, 0x0e,
-	0x1b, 0xad, 0xad, 0xa9, 0xc0, 0x82, 0xf9, 0xa7, 0x84, 0x95, 0x51, 0xbe, 0x27, 0xfd, 0x90, 0x47,
-	0x1a, 0xf2, 0xa0, 0xb3, 0xa8, 0xfb, 0x0b, 0x61, 0xb2, 0xa5, 0x76, 0x79, 0x61, 0xb2, 0x05, 0x53,
-	0x6f, 0xb1, 0xbf, 0x23, 0xe4, 0xa0, 0x11, 0xf0, 0x04, 0x74, 0x11, 0x5c, 0x87, 0xb6, 0x84, 0xed,
-	0x26, 0x52, 0xf4, 0x75, 0x84, 0x74, 0xe3, 0xe8, 0x5a, 0x34, 0x60, 0x9b, 0xea, 0x48, 0xf6, 0x88,
-	0x71, 0xc6, 0xaa, 0x32, 0x03, 0xa3, 0x9c, 0xdf, 0x21, 0x4d, 0x2b, 0x13, 0xd7, 0x39, 0xb1, 0x45,
-	0x12, 0x08, 0xaa, 0x34, 0x4c, 0x3c, 0x55, 0x10, 0x31, 0xad, 0x07, 0x1a, 0xe0, 0xbf, 0xe2, 0x91,
-	0xa6, 0x65, 0x1c, 0xc2, 0x68, 0x04, 0x51, 0x5f, 0xec, 0xe8, 0xe1, 0x13, 0x20, 0xab, 0x51, 0x9f,
-	0xcb, 0x7c, 0x00, 0x9f, 0xd0, 0x26, 0x56, 0x42, 0x8e, 0x70, 0x06, 0x6b, 0x00, 0x7d, 0x2d, 0x21,
-	0x98, 0xb8, 0x18, 0x25, 0xa9, 0xdc, 0x03, 0xcd, 0x9a, 0x1a, 0x17, 0x32, 0x02, 0xa3, 0x0c, 0x58,
-	0x98, 0x98, 0x92, 0x86, 0x97, 0x7d, 0x36, 0x6d, 0x66, 0x05, 0x56, 0x41, 0xff, 0xa4, 0x40, 0x04,
-	0x9a, 0xc1, 0x93, 0x73, 0xf8, 0x10, 0x12, 0xc9, 0x13, 0x7e, 0x9f, 0xb4, 0x82, 0xb1, 0xb9, 0xe2,
-	0x9e, 0x8b, 0xd8, 0xa0, 0x9f, 0xe0, 0xa0, 0x5e, 0x20, 0xb3, 0x99, 0xc5, 0x59, 0x9e, 0xc3, 0x1c,
-	0xcf, 0xaf, 0xdd, 0xba, 0x5e, 0x90, 0xab, 0xe5, 0x8f, 0xc8, 0x51, 0x67, 0x51, 0x98, 0xdd, 0x2b,
-	0x49, 0x6a, 0x88, 0x8e, 0x4c, 0xd2, 0x37, 0x11, 0x02, 0x73, 0x83, 0x97, 0x15, 0x9b, 0x08, 0x47,
-	0xb7, 0xba, 0x4c, 0x60, 0x94, 0xf7, 0x97, 0xad, 0x0e, 0x75, 0x06, 0x88, 0x9a, 0x68, 0x92, 0xb3,
-	0x41, 0xa4, 0x8c, 0x69, 0x09, 0x1a, 0x04, 0xbf, 0xfd, 0x6f, 0x56, 0x08, 0xd1, 0xe7, 0xa6, 0x4e,
-	0x19, 0xe7, 0x5a, 0xb0, 0xa2, 0xb4, 0xe0, 0xeb, 0xc8, 0x44, 0x2f, 0xde, 0x58, 0xc1, 0xa3, 0x8a,
-	0x8a, 0x81, 0x31, 0x6f, 0x26, 0x6b, 0xea, 0x88, 0xb2, 0x50, 0xab, 0xcd, 0x12, 0xa8, 0x55, 0xbb,
-	0x99, 0x5a, 0xbc, 0x2c, 0x88, 0x75, 0x67, 0x98, 0xb2, 0xf8, 0x5a, 0x38, 0x40, 0x8d, 0x59, 0x0d,
-	0x54, 0x1a, 0x06, 0xbb, 0xcd, 0x06, 0xe1, 0x1e, 0xea, 0xcc, 0x6a, 0xc0, 0x13, 0x40, 0x41, 0x3b,
-	0xda, 0xe1, 0xb6, 0xcb, 0x54, 0x80, 0xdf, 0xf4, 0x41, 0x52, 0x5f, 0x0e, 0x07, 0x03, 0xd8, 0x80,
-	0xe4, 0xcf, 0x8b, 0x21, 0x27, 0xe0, 0xf9, 0x50, 0x79, 0x79, 0x34, 0xec, 0xa3, 0x72, 0x9c, 0x0a,
-	0xf0, 0x1b, 0x96, 0x9b, 0x4e, 0xd2, 0x63, 0x03, 0xb6, 0x91, 0x2e, 0x0e, 0x06, 0x42, 0x33, 0x9a,
-	0x20, 0xff, 0x71, 0x32, 0xad, 0x59, 0x88, 0xbd, 0x99, 0x72, 0xe4, 0x38, 0x9d, 0xe6, 0xf9, 0xfe,
-	0x8b, 0xe4, 0xa8, 0x93, 0xfa, 0x42, 0x43, 0x56, 0x4e, 0xf0, 0x4a, 0x66, 0x82, 0x9f, 0x26, 0x07,
-	0xb3, 0x87, 0x1e, 0x7c, 0x0d, 0xca, 0x82, 0xfd, 0x8b, 0x72, 0xb4, 0x81, 0x5e, 0x24, 0x37, 0x1c,
-	0x0c, 0x64, 0x3f, 0x08, 0x3b, 0x42, 0xea, 0x28, 0x2e, 0xd2, 0x70, 0xc0, 0x04, 0xea, 0xb4, 0x41,
-	0x14, 0x26, 0xa2, 0x5d, 0x9e, 0xf0, 0xff, 0xd1, 0xb3, 0xf7, 0x85, 0xb0, 0x88, 0x74, 0xe3, 0x68,
-	0x27, 0x8c, 0xf7, 0xf4, 0xb2, 0x60, 0x40, 0x60, 0x2a, 0xf4, 0x46, 0x71, 0x0a, 0x99, 0x15, 0xcc,
-	0x94, 0x49, 0xe0, 0x72, 0x37, 0x1e, 0x8d, 0x59, 0x9c, 0x62, 0x55, 0xae, 0x51, 0x4c, 0x10, 0x3d,
-	0x45, 0x9a, 0x32, 0x79, 0x19, 0xcd, 0xa3, 0x1a, 0x96, 0xb1, 0x81, 0xf4, 0xb5, 0xe4, 0x30, 0x18,
-	0x1b, 0xc2, 0x61, 0x93, 0xd9, 0xe9, 0xbb, 0xb2, 0xe8, 0x03, 0x64, 0x66, 0x79, 0xb4, 0x33, 0x0e,
-	0x37, 0x20, 0xa5, 0xf6, 0xbf, 0xf5, 0x20, 0x03, 0xf5, 0xaf, 0x0b, 0x33, 0x92, 0x2b, 0x1e, 0x98,
-	0x64, 0x6b, 0xa3, 0x6d, 0x36, 0x4c, 0x84, 0xe9, 0x26, 0x52, 0xc0, 0x02, 0xfc, 0x8a, 0x5e, 0x62,
-	0x71, 0x22, 0x56, 0x40, 0x03, 0x52, 0x84, 0x60, 0xb5, 0x10, 0x41, 0xff, 0x49, 0x5b, 0x35, 0xd2,
-	0xd3, 0xb6, 0x7c, 0xd1, 0xbc, 0x8e, 0x94, 0x02, 0xf6, 0x77, 0x87, 0xc8, 0xe4, 0xf2, 0x68, 0x67,
-	0x27, 0x1c, 0xf6, 0xe9, 0x83, 0xa4, 0x96, 0x02, 0x71, 0x30, 0xd6, 0x33, 0xc6, 0xd6, 0x1d, 0x73,
-	0xcf, 0x00, 0x85, 0x01, 0x16, 0xf0, 0x3f, 0x71, 0x88, 0xab, 0x09, 0x7a, 0x37, 0x39, 0xba, 0x1c,
-	0xb3, 0x30, 0x65, 0x52, 0xce, 0x44, 0xe1, 0xd9, 0x2a, 0xbd, 0x8b, 0x1c, 0x6e, 0xc7, 0xa3, 0x71,
-	0x36, 0xa3, 0x46, 0xe7, 0xc9, 0x71, 0x5e, 0x27, 0x23, 0x78, 0xb2, 0x44, 0x9d, 0x9e, 0x20, 0x73,
-	0x50, 0xb5, 0x20, 0x7f, 0x82, 0x9e, 0x22, 0xf3, 0x3d, 0x96, 0xba, 0x0f, 0xeb, 0x64, 0xa9, 0x49,
-	0xe8, 0xe7, 0xb9, 0x71, 0xbf, 0xb8, 0x9f, 0x06, 0xbd, 0x87, 0xdc, 0xc5, 0x31, 0xd1, 0xd6, 0xac,
-	0xcc, 0x9c, 0x82, 0x4c, 0x6e, 0xd6, 0xe4, 0x33, 0x09, 0x3d, 0x4a, 0x0e, 0xf1, 0x9a, 0xb0, 0xc2,
-	0x4a, 0x70, 0x93, 0x1e, 0x26, 0x07, 0x01, 0x71, 0x13, 0x38, 0x03, 0x65, 0x39, 0x1e, 0x26, 0xf8,
-	0x20, 0xf0, 0xa7, 0xc7, 0x52, 0xb5, 0xc6, 0xca, 0x8c, 0x59, 0x4a, 0xc9, 0x0c, 0x50, 0x17, 0xa6,
-	0xa1, 0x84, 0x1d, 0xa2, 0xc7, 0x49, 0xab, 0xc7, 0x52, 0xb4, 0x12, 0x72, 0x35, 0x28, 0xbd, 0x97,
-	0xdc, 0x2d, 0xe8, 0x30, 0xcc, 0x21, 0x99, 0x7d, 0x14, 0x29, 0x89, 0x47, 0x63, 0x57, 0xe6, 0x31,
-	0x3d, 0x82, 0xd2, 0xc1, 0x29, 0xb3, 0x5a, 0xf6, 0xe0, 0x9a, 0x59, 0x77, 0x43, 0x16, 0xa7, 0x29,
-	0x9b, 0x35, 0x07, 0x59, 0x9c, 0x6f, 0xd9, 0x06, 0xef, 0xd1, 0x59, 0xd9, 0x5a, 0xc7, 0xe9, 0x31,
-	0x42, 0x7b, 0x2c, 0xcd, 0x56, 0xb9, 0x97, 0x1e, 0x21, 0xb3, 0x88, 0x3b, 0x8c, 0x81, 0x84, 0x9e,
-	0x00, 0x82, 0xd1, 0xec, 0x14, 0xb2, 0xc5, 0x1b, 0x95, 0xd9, 0xf7, 0x01, 0xc1, 0x1c, 0x3b, 0x6d,
-	0xbe, 0xc9, 0xcc, 0x57, 0x81, 0xf0, 0x40, 0xdd, 0x8c, 0x50, 0xd8, 0x4d, 0x3c, 0x08, 0x0c, 0x97,
-	0x6c, 0x51, 0x7a, 0x57, 0xe6, 0x3e, 0x06, 0x58, 0x2d, 0x0e, 0x52, 0x16, 0x4b, 0x6b, 0x76, 0x79,
-	0xa7, 0x3f, 0xbb, 0x00, 0x03, 0x1d, 0xf0, 0x2e, 0xa3, 0xe1, 0xa6, 0x2c, 0xfc, 0x3a, 0x18, 0x68,
-	0x81, 0x0d, 0x9e, 0x64, 0xc8, 0x8c, 0xd7, 0x43, 0x46, 0xc0, 0xc6, 0xa3, 0x38, 0xe5, 0x9b, 0x16,
-	0x99, 0xf1, 0x38, 0x30, 0xa3, 0x1b, 0xef, 0x0e, 0x19, 0xdf, 0x63, 0x4a, 0xf8, 0x1b, 0x40, 0xa2,
-	0x01, 0x75, 0x03, 0x25, 0x1b, 0xed, 0xa7, 0xe8, 0x1c, 0x39, 0x06, 0xec, 0x72, 0x20, 0xfd, 0x46,
-	0x40, 0x1a, 0x54, 0x47, 0x10, 0x0e, 0xb5, 0xec, 0xbc, 0x89, 0xb6, 0xc8, 0x11, 0xec, 0x5e, 0xaa,
-	0x12, 0x99, 0xf3, 0x66, 0x3d, 0x01, 0xf4, 0x7e, 0x57, 0x66, 0x3e, 0x0d, 0x53, 0xd4, 0x60, 0x31,
-	0xa8, 0x12, 0xd8, 0xa5, 0xc8, 0xfc, 0xb7, 0xe8, 0x21, 0x80, 0xe1, 0xe4, 0xee, 0x02, 0x99, 0xf9,
-	0x56, 0xa0, 0x8f, 0x33, 0x17, 0x3d, 0xc0, 0x12, 0xbe, 0x08, 0x70, 0x5e, 0xc9, 0x82, 0x2f, 0x69,
-	0x0e, 0x72, 0xd7, 0x8a, 0xcc, 0x58, 0x86, 0x0a, 0x01, 0xdb, 0x19, 0x5d, 0xb3, 0x2b, 0xb4, 0xe9,
-	0x49, 0x72, 0xaf, 0x90, 0xdc, 0xcc, 0x16, 0x5b, 0x16, 0x39, 0x4b, 0xef, 0x23, 0xf7, 0xa0, 0x7a,
-	0x2a, 0x28, 0x70, 0x0e, 0x28, 0x3c, 0xcf, 0xd2, 0xa2, 0xfc, 0xf3, 0xc6, 0xec, 0x58, 0xe7, 0xee,
-	0x48, 0x99, 0x75, 0x81, 0xbe, 0x9a, 0xdc, 0x7f, 0x1e, 0x84, 0xd9, 0x5a, 0xb1, 0xaf, 0x44, 0xe9,
-	0x56, 0x04, 0x6d, 0xb1, 0x40, 0xf1, 0xb1, 0x03, 0xd2, 0x68, 0xf0, 0xd1, 0xd8, 0x89, 0x19, 0x74,
-	0xbe, 0x0d, 0x18, 0x00, 0x03, 0xbf, 0x16, 0x6e, 0xb3, 0xd1, 0x35, 0xcd, 0xe6, 0x67, 0x64, 0x86,
-	0x74, 0x94, 0xcb, 0x8c, 0x8b, 0x90, 0x21, 0x54, 0x02, 0x5f, 0xca, 0x45, 0xc6, 0x0a, 0x08, 0x29,
-	0x4e, 0x28, 0x0b, 0x7c, 0x89, 0xfa, 0xe4, 0x44, 0x1e, 0x65, 0x5c, 0xb4, 0x65, 0x99, 0x55, 0xa0,
-	0xf8, 0x32, 0x8b, 0xa3, 0xab, 0x7b, 0xd9, 0xe9, 0xdb, 0x85, 0xee, 0xce, 0xde, 0x18, 0x87, 0xc3,
-	0xbe, 0x2d, 0xb2, 0xcf, 0x82, 0x40, 0xca, 0xa1, 0x13, 0x67, 0x1a, 0x32, 0x2f, 0x80, 0xf6, 0x80,
-	0xc3, 0x4b, 0x4b, 0x71, 0xc4, 0xae, 0x9a, 0x04, 0xf7, 0x04, 0xf3, 0x4d, 0x7b, 0xdc, 0xcc, 0x5f,
-	0x83, 0x99, 0x10, 0xb0, 0xcd, 0x08, 0xd6, 0x40, 0xe1, 0xbf, 0x5d, 0xbd, 0x7a, 0x35, 0x61, 0x4a,
-	0x04, 0x9e, 0xd3, 0xab, 0x4c, 0xe6, 0x34, 0x44, 0x96, 0xb8, 0x8c, 0x3a, 0xf5, 0xc5, 0xc1, 0x02,
-	0xe8, 0x9c, 0x0b, 0x2c, 0x8c, 0xd3, 0x75, 0x16, 0xaa, 0xfa, 0x57, 0xb0, 0xbe, 0x5d, 0x93, 0xcf,
-	0x55, 0x59, 0xe2, 0x47, 0x04, 0xcb, 0x32, 0x85, 0x2e, 0x32, 0x63, 0xad, 0xfb, 0x51, 0xb9, 0x92,
-	0x15, 0xe0, 0xf0, 0x76, 0x90, 0xc2, 0x4b, 0xa3, 0x34, 0xba, 0xba, 0xb7, 0xfc, 0x2c, 0xaf, 0x89,
-	0x9e, 0x77, 0xa5, 0xe9, 0x9e, 0x07, 0x49, 0xee, 0xb1, 0x14, 0x27, 0x91, 0xed, 0x7c, 0x93, 0x45,
-	0xde, 0xc1, 0xd5, 0x0e, 0x4c, 0x02, 0x73, 0x48, 0x7e, 0x0c, 0xc8, 0x93, 0xcb, 0x9f, 0xf2, 0x24,
-	0xcb, 0xdc, 0x77, 0x82, 0x06, 0xd5, 0xf3, 0x73, 0x6d, 0x67, 0x8c, 0x73, 0x5c, 0x66, 0xff, 0x38,
-	0x68, 0x05, 0x21, 0x3e, 0xdc, 0x23, 0x2f, 0x73, 0x5e, 0x30, 0x26, 0x3e, 0xcf, 0xb1, 0xb1, 0x09,
-	0x61, 0x4a, 0x76, 0x86, 0x09, 0x8b, 0xd3, 0x73, 0xd1, 0x80, 0x29, 0xf8, 0xba, 0x46, 0xc7, 0xa1,
-	0x9b, 0x18, 0xf0, 0x41, 0xe6, 0x72, 0xd1, 0xb2, 0x9b, 0xbd, 0x8a, 0xeb, 0xc3, 0xd6, 0xe8, 0xba,
-	0xb0, 0x7b, 0x24, 0x7c, 0x13, 0x10, 0x45, 0xd4, 0xb3, 0xea, 0x6b, 0xeb, 0xa1, 0x46, 0xa3, 0x3f,
-	0xfb, 0xf2, 0xcb, 0x2f, 0xbf, 0x5c, 0xf1, 0xff, 0xaa, 0x52, 0x60, 0xa5, 0x38, 0x8d, 0xe8, 0x76,
-	0xde, 0x50, 0xe6, 0x07, 0xd5, 0x65, 0xae, 0xbe, 0x6c, 0x15, 0x30, 0xf1, 0xe4, 0xb9, 0xef, 0xee,
-	0x0e, 0x5a, 0x6e, 0xcd, 0xc0, 0x80, 0xd0, 0xfb, 0x49, 0xb5, 0xb7, 0x1d, 0xe1, 0x3e, 0xbf, 0xc0,
-	0x29, 0x04, 0xf9, 0x0e, 0x97, 0x5c, 0xdd, 0xe9, 0x92, 0xbb, 0x15, 0xb7, 0xdb, 0xc2, 0x39, 0x32,
-	0xb9, 0x21, 0x18, 0x30, 0x63, 0xdb, 0x78, 0xad, 0x4d, 0xac, 0x2c, 0xf7, 0x5d, 0x4e, 0xa6, 0x05,
-	0xb2, 0xb2, 0x3f, 0x72, 0x5a, 0x78, 0x2e, 0xa6, 0x2e, 0xb4, 0x8b, 0xbb, 0xdc, 0xb2, 0x98, 0xeb,
-	0x68, 0x50, 0x77, 0xf8, 0xcf, 0x5e, 0xb9, 0xe9, 0x58, 0x7a, 0xc2, 0xe1, 0x1c, 0xd7, 0xca, 0xad,
-	0x8e, 0x2b, 0x1e, 0x50, 0x72, 0xbb, 0xb3, 0x2b, 0x0e, 0x6f, 0x34, 0x60, 0x61, 0xa5, 0x98, 0xcc,
-	0x08, 0xc9, 0x7c, 0x95, 0xc5, 0x59, 0x37, 0x15, 0x9a, 0xde, 0x8f, 0x7a, 0x65, 0x86, 0x70, 0x29,
-	0xb5, 0x72, 0x10, 0x2a, 0xc6, 0x20, 0x3c, 0x53, 0x8c, 0xdd, 0xbb, 0x10, 0xbb, 0x93, 0xc6, 0x20,
-	0xec, 0x87, 0xdb, 0xa7, 0xbd, 0xfd, 0x8d, 0xf0, 0x5b, 0xc6, 0xf0, 0xd9, 0x62, 0x0c, 0xb7, 0x11,
-	0xc3, 0x07, 0xe5, 0x4c, 0xd9, 0xa7, 0x67, 0x8d, 0xe7, 0x97, 0xab, 0xe5, 0xdb, 0x80, 0x5b, 0xc5,
-	0x11, 0xf6, 0xa7, 0x97, 0xd8, 0x75, 0x71, 0xa6, 0x85, 0x61, 0x18, 0x22, 0x69, 0xf9, 0x91, 0x6a,
-	0x19, 0x17, 0xb5, 0xe9, 0x17, 0xaa, 0x67, 0x5c, 0xce, 0x6e, 0x1f, 0xd3, 0x44, 0xa1, 0xfb, 0x1a,
-	0x9d, 0x28, 0xdb, 0x4c, 0x30, 0x00, 0x0f, 0x7b, 0xd1, 0x89, 0xa2, 0x40, 0x79, 0x27, 0x8a, 0xb7,
-	0xbf, 0x13, 0xc5, 0xbb, 0x69, 0x27, 0x8a, 0xe7, 0x76, 0xa2, 0x94, 0x49, 0xff, 0xc0, 0x92, 0xfe,
-	0xb2, 0xf1, 0xd0, 0x23, 0xf7, 0x33, 0x95, 0xc2, 0xed, 0x59, 0xe9, 0xa0, 0x1d, 0x23, 0x13, 0x56,
-	0x54, 0xc7, 0x84, 0x9e, 0xba, 0x60, 0xff, 0x26, 0x69, 0xb8, 0x33, 0x16, 0x7e, 0x07, 0x0d, 0x40,
-	0x8f, 0x05, 0x74, 0x83, 0x07, 0xef, 0x35, 0x1e, 0x9b, 0xaa, 0x00, 0x19, 0x6f, 0x41, 0xdd, 0xe5,
-	0x2d, 0x10, 0xe6, 0x0d, 0xf2, 0xa7, 0x19, 0xc8, 0xe4, 0xc2, 0x85, 0x62, 0xa6, 0xec, 0x20, 0x53,
-	0x4e, 0x58, 0x2a, 0x21, 0x47, 0xaa, 0xe6, 0xc7, 0x7f, 0x79, 0x85, 0x3b, 0xd2, 0xdb, 0xe2, 0x87,
-	0x2f, 0x4e, 0xeb, 0x65, 0x2c, 0x29, 0x8f, 0x17, 0xb6, 0x60, 0xb6, 0x3f, 0x86, 0x4b, 0xa4, 0xe1,
-	0x8f, 0x39, 0x41, 0x08, 0x4f, 0x28, 0x1f, 0x4a, 0x3d, 0x30, 0x20, 0x65, 0xb4, 0x0f, 0x2d, 0xda,
-	0x0b, 0xc8, 0xd2, 0xb4, 0x7f, 0xce, 0x73, 0x6c, 0xb8, 0xef, 0xcc, 0x69, 0xfb, 0xc2, 0x52, 0x31,
-	0xd6, 0x2f, 0x22, 0xd6, 0x2d, 0x6b, 0xc4, 0x0c, 0x84, 0x34, 0xbe, 0x9b, 0xb9, 0x83, 0x00, 0xe7,
-	0xb2, 0xf8, 0xd6, 0xe2, 0xae, 0x62, 0xec, 0xea, 0x98, 0xa1, 0x91, 0x9d, 0x1d, 0xbd, 0xc7, 0x71,
-	0xb8, 0x70, 0xb3, 0x7c, 0x29, 0xa3, 0x34, 0xb1, 0x28, 0xcd, 0x75, 0xa1, 0x11, 0xf8, 0xbc, 0xe7,
-	0x3c, 0xc7, 0x00, 0x89, 0x84, 0xf2, 0x43, 0x8d, 0x87, 0x4a, 0x97, 0x9e, 0x53, 0x5a, 0x8e, 0x88,
-	0x6a, 0xc6, 0x11, 0x51, 0x66, 0x47, 0xa4, 0x96, 0x1d, 0xe1, 0x40, 0x49, 0xe3, 0x1c, 0x67, 0x4f,
-	0x58, 0xe8, 0x7d, 0x3c, 0xd4, 0x5e, 0xc4, 0xaa, 0x4d, 0x1b, 0x81, 0xa9, 0x01, 0x66, 0x2c, 0xbc,
-	0xa5, 0xb8, 0xe3, 0x5d, 0xec, 0xf8, 0xa8, 0xb1, 0x32, 0xe9, 0x86, 0x75, 0x9f, 0x1f, 0xf6, 0x8a,
-	0x8f, 0x70, 0x4a, 0x99, 0xa5, 0x84, 0xb7, 0x62, 0x08, 0xef, 0x42, 0xa7, 0x18, 0x9f, 0x6b, 0x88,
-	0xcf, 0x7d, 0x1a, 0x1f, 0x67, 0x9f, 0x96, 0x5e, 0x29, 0x3e, 0x3e, 0xba, 0x73, 0xe7, 0xcc, 0xca,
-	0x2d, 0x57, 0x2b, 0x71, 0xcb, 0xd5, 0xf3, 0x6e, 0xb9, 0x85, 0xb7, 0x15, 0x93, 0xbe, 0x87, 0xa4,
-	0xcf, 0xdb, 0x1a, 0x35, 0x4f, 0x94, 0xa6, 0xfd, 0xeb, 0x5e, 0xe1, 0xd9, 0xd8, 0x9d, 0xa3, 0xbc,
-	0x4c, 0x2f, 0xbe, 0x64, 0xeb, 0x45, 0x37, 0x6a, 0x1a, 0xff, 0x6f, 0x7b, 0x05, 0xc7, 0x77, 0x80,
-	0xe9, 0x85, 0xb5, 0xb5, 0x2e, 0xc6, 0x78, 0x0a, 0x91, 0x92, 0x69, 0x33, 0xc6, 0x94, 0x33, 0x3f,
-	0x13, 0x63, 0x8a, 0x39, 0x9c, 0x3c, 0x99, 0xc4, 0x58, 0x4f, 0x40, 0x90, 0xaf, 0x12, 0xf8, 0x5d,
-	0xb6, 0x91, 0x78, 0xb7, 0x63, 0x23, 0x91, 0x41, 0x51, 0x53, 0xf1, 0x55, 0xaf, 0xe0, 0xa4, 0x71,
-	0x3f, 0x2a, 0x4a, 0x70, 0xcd, 0xc4, 0xa5, 0x8a, 0x80, 0xd1, 0x69, 0x19, 0x30, 0x5a, 0x86, 0xfb,
-	0x4f, 0x14, 0x6c, 0x82, 0x9c, 0xb8, 0x5f, 0x21, 0x4d, 0x99, 0x87, 0x87, 0x50, 0x2a, 0xa8, 0x17,
-	0xd0, 0x3d, 0x20, 0x82, 0x7a, 0x8f, 0x93, 0x29, 0xcc, 0x34, 0x5c, 0x6b, 0x1a, 0xa0, 0xc3, 0x74,
-	0xab, 0x46, 0x98, 0xae, 0x3f, 0x2a, 0x38, 0x47, 0xcd, 0x46, 0x1c, 0x94, 0x51, 0xf2, 0x1e, 0x8b,
-	0x12, 0x67, 0x73, 0x9a, 0x92, 0x71, 0xc1, 0xe9, 0x6c, 0xae, 0xc3, 0xf3, 0xc5, 0x1d, 0xbe, 0xec,
-	0x39, 0x7a, 0x2c, 0xe4, 0xdd, 0x39, 0x30, 0x8a, 0x93, 0xf1, 0x68, 0x98, 0xe0, 0xf8, 0xac, 0x3e,
-	0x83, 0x9d, 0x34, 0x82, 0xca, 0xea, 0x33, 0xc0, 0x94, 0xb3, 0x71, 0x3c, 0x8a, 0x85, 0x7b, 0x84,
-	0x27, 0xf4, 0xb5, 0x27, 0x1e, 0x22, 0xc0, 0x13, 0xfe, 0x37, 0x3c, 0xd7, 0xe9, 0xf1, 0x0f, 0x65,
-	0x0a, 0x94, 0x2c, 0x48, 0xef, 0xe5, 0xbc, 0xb8, 0x5b, 0x2b, 0xe2, 0x42, 0xd6, 0x5f, 0xcd, 0x9f,
-	0x72, 0xe7, 0xb8, 0x5e, 0xb2, 0x58, 0xbf, 0x8f, 0xf7, 0x74, 0x97, 0xa9, 0x35, 0x8c, 0xa6, 0x74,
-	0x3f, 0xef, 0x2e, 0x39, 0x37, 0x77, 0x1a, 0x28, 0x25, 0x5b, 0xc6, 0xf7, 0x7b, 0x96, 0xb2, 0x2d,
-	0x6c, 0x57, 0xf7, 0xfe, 0x3d, 0xaf, 0xf0, 0x5c, 0x1e, 0xbd, 0x7e, 0x3c, 0x1a, 0x11, 0xfb, 0xaf,
-	0x06, 0x32, 0x09, 0x39, 0x3c, 0x78, 0xa6, 0x2f, 0x66, 0x8e, 0x4c, 0x82, 0x01, 0xd7, 0x5e, 0x17,
-	0x1b, 0x31, 0x34, 0x6c, 0x79, 0x0a, 0x0d, 0xbb, 0x31, 0xc2, 0xf9, 0xd0, 0x8a, 0x54, 0xd9, 0x9a,
-	0xf9, 0x93, 0x9e, 0xa5, 0x77, 0x0b, 0xb0, 0xd4, 0xa4, 0x7c, 0xc6, 0xdb, 0xdf, 0x8b, 0x70, 0xcb,
-	0xbb, 0xdf, 0xa0, 0x18, 0xbf, 0x9f, 0xf6, 0xac, 0xed, 0xef, 0x7e, 0x5d, 0x6b, 0x44, 0xff, 0xa6,
-	0x5a, 0xec, 0xc8, 0x40, 0x06, 0x2e, 0x19, 0x63, 0x2e, 0x52, 0x06, 0x03, 0x2b, 0x26, 0x03, 0x15,
-	0xd2, 0x55, 0x63, 0x45, 0xbc, 0xc9, 0x83, 0xac, 0x53, 0xa4, 0xd2, 0x09, 0x4a, 0xc3, 0x8d, 0x2b,
-	0x9d, 0xe0, 0xce, 0xc5, 0x18, 0x2f, 0x10, 0xc2, 0xbd, 0x2f, 0x58, 0xad, 0x61, 0x39, 0x45, 0xd1,
-	0x7b, 0xcd, 0x73, 0x03, 0xa3, 0x94, 0x19, 0xe2, 0x3b, 0x55, 0x1e, 0xe2, 0x7b, 0xd3, 0x61, 0xc4,
-	0x65, 0xb6, 0xcb, 0x2f, 0x78, 0x96, 0xdd, 0x56, 0x34, 0x68, 0x7a, 0x68, 0xbf, 0xe9, 0xe5, 0xbd,
-	0x50, 0x3f, 0xc4, 0x21, 0x2d, 0x53, 0x48, 0x1f, 0xb2, 0x15, 0x52, 0x16, 0x4b, 0x4d, 0xc3, 0x9f,
-	0x29, 0x95, 0xd0, 0x5e, 0xef, 0xa6, 0xd6, 0xa1, 0x2f, 0x7a, 0xcf, 0xc3, 0x64, 0x5b, 0x07, 0x5c,
-	0xf1, 0x94, 0x0a, 0xc4, 0xea, 0x8b, 0x78, 0x13, 0x91, 0x02, 0x85, 0xd9, 0x5e, 0x12, 0x84, 0x54,
-	0xda, 0x4b, 0x90, 0xee, 0xae, 0x89, 0x20, 0xdc, 0x4a, 0x77, 0x4d, 0xaf, 0x28, 0x75, 0x63, 0x45,
-	0x29, 0x53, 0x0a, 0x1f, 0x76, 0x29, 0x85, 0x1c, 0x9e, 0x9a, 0x98, 0x7f, 0xf5, 0x1c, 0x0e, 0xc0,
-	0xfd, 0xb6, 0xe6, 0xce, 0x51, 0xb9, 0xc9, 0xad, 0x79, 0x6f, 0x3c, 0x88, 0x78, 0x88, 0xa5, 0x08,
-	0x95, 0x54, 0x00, 0x3a, 0x2f, 0x02, 0x7c, 0x97, 0x46, 0xbb, 0xc3, 0xbe, 0xb4, 0xa3, 0x4d, 0xd0,
-	0xc2, 0x72, 0x31, 0xe1, 0x1f, 0xf1, 0xac, 0xdd, 0x5f, 0x8e, 0x26, 0x4d, 0xf2, 0x3f, 0x79, 0x4e,
-	0xe7, 0xe6, 0x6d, 0x11, 0x3d, 0x4f, 0xa6, 0x0d, 0x71, 0x17, 0x03, 0x69, 0x82, 0xe8, 0x93, 0xa4,
-	0x89, 0x93, 0x75, 0x6d, 0xc4, 0x67, 0x87, 0x88, 0x1a, 0x73, 0x4d, 0x64, 0xbb, 0xe0, 0xc2, 0xd9,
-	0x62, 0x62, 0x3f, 0xea, 0x59, 0x1b, 0x47, 0x07, 0x35, 0x9a, 0xdc, 0x0d, 0x32, 0x6d, 0x74, 0x02,
-	0x43, 0x80, 0x49, 0x63, 0xbe, 0x69, 0x80, 0xca, 0x55, 0x46, 0x5f, 0x3d, 0xd0, 0x00, 0x3b, 0x06,
-	0xd6, 0x0a, 0x5d, 0xbf, 0x22, 0xa2, 0xd5, 0x9c, 0xe1, 0xa5, 0x73, 0xd9, 0xf0, 0x52, 0x23, 0xb4,
-	0xd4, 0x0e, 0xcf, 0xac, 0xe6, 0xc2, 0x33, 0xbf, 0xe5, 0x91, 0x19, 0x3b, 0x96, 0xf9, 0x87, 0x14,
-	0xb7, 0xfb, 0x90, 0x88, 0x5d, 0x65, 0xd9, 0xc0, 0x5d, 0x45, 0x67, 0x20, 0x0b, 0xec, 0xb7, 0x04,
-	0xf8, 0xef, 0xf5, 0x84, 0x64, 0x8b, 0x3b, 0x68, 0xca, 0x70, 0x90, 0x64, 0xc8, 0xa4, 0x3a, 0xd1,
-	0xeb, 0x45, 0x2f, 0x31, 0xa1, 0x2a, 0x34, 0x00, 0x27, 0x08, 0xde, 0xa4, 0x5a, 0x1e, 0xed, 0x0a,
-	0x69, 0xab, 0x07, 0x26, 0x08, 0x63, 0xf2, 0xc2, 0x1b, 0xc6, 0xf4, 0x92, 0x49, 0xff, 0x79, 0xd2,
-	0x0c, 0xc6, 0x26, 0x12, 0x5a, 0xa4, 0x3d, 0x4b, 0xa4, 0x17, 0x44, 0x04, 0x29, 0x14, 0x4b, 0x84,
-	0xbb, 0x81, 0x9a, 0x0a, 0x95, 0xd7, 0x0f, 0x8c, 0x52, 0xfe, 0x0b, 0x84, 0xb4, 0x97, 0xa4, 0x8e,
-	0x11, 0x4a, 0xcd, 0x53, 0x4a, 0x8d, 0x5f, 0x5c, 0x94, 0xf7, 0x36, 0xf1, 0x9b, 0x9e, 0x21, 0x93,
-	0xc1, 0x98, 0x77, 0x51, 0xb5, 0x62, 0x43, 0x2d, 0x24, 0x03, 0x59, 0xc8, 0xff, 0x79, 0x8f, 0xdc,
-	0x65, 0x06, 0x1e, 0x5c, 0x1c, 0x85, 0xca, 0xea, 0xe4, 0xd7, 0x1b, 0xd7, 0xa0, 0x60, 0x26, 0x36,
-	0x4d, 0x23, 0x15, 0xa8, 0x22, 0x65, 0xda, 0xf3, 0x63, 0xb6, 0xf6, 0x2c, 0xe8, 0x50, 0xcf, 0xad,
-	0xef, 0x7a, 0xee, 0x50, 0x7a, 0xfa, 0x5a, 0x19, 0x99, 0xe7, 0x59, 0xf7, 0xe4, 0x74, 0xd9, 0xd5,
-	0x31, 0x8b, 0xc3, 0x74, 0x14, 0x27, 0x32, 0x44, 0xef, 0x3c, 0xa1, 0x99, 0x96, 0x22, 0x26, 0x63,
-	0x27, 0xef, 0x2a, 0x08, 0xc9, 0x0f, 0x1c, 0x55, 0xac, 0x13, 0xfd, 0x6a, 0xe6, 0x66, 0x88, 0x5e,
-	0x9e, 0xf8, 0x8d, 0x51, 0x91, 0xf2, 0xdf, 0x4d, 0x66, 0xb3, 0x6d, 0xd3, 0x07, 0xc8, 0x8c, 0x74,
-	0xeb, 0x8b, 0x40, 0x45, 0x6e, 0xe4, 0x66, 0xa0, 0xa0, 0xf7, 0x41, 0xc0, 0x54, 0x29, 0x3e, 0x03,
-	0x2d, 0x18, 0x88, 0xf5, 0x95, 0x30, 0x65, 0x31, 0x4c, 0x6c, 0x79, 0x8c, 0xad, 0x00, 0x7e, 0x87,
-	0x1c, 0x76, 0x30, 0x06, 0x90, 0x5d, 0xdc, 0xdc, 0x5c, 0x1d, 0xab, 0x70, 0x4f, 0x9e, 0x92, 0x7a,
-	0xda, 0xd8, 0x97, 0xaa, 0xb4, 0xff, 0x1e, 0x72, 0xdc, 0x35, 0x1e, 0x57, 0xa2, 0x74, 0xab, 0xbd,
-	0x1e, 0x8c, 0xe9, 0xa3, 0xa4, 0x86, 0xd6, 0x14, 0x3f, 0x33, 0x2b, 0xbd, 0xea, 0x80, 0x05, 0x0d,
-	0x7b, 0xbd, 0x52, 0x60, 0xaf, 0x57, 0xcd, 0xd9, 0xe3, 0x3f, 0x4f, 0x4e, 0xe4, 0xc7, 0xc4, 0x42,
-	0xe1, 0x0d, 0x76, 0x98, 0xdb, 0xab, 0x4a, 0x70, 0x90, 0x75, 0x64, 0xdc, 0xdb, 0x1a, 0x99, 0xcb,
-	0x84, 0x5c, 0x70, 0xcd, 0xcf, 0xe3, 0x33, 0x1f, 0xb7, 0x1b, 0x9e, 0x37, 0xe7, 0xac, 0xab, 0x86,
-	0x6c, 0x75, 0x44, 0xee, 0x2e, 0x2c, 0x43, 0x5f, 0x43, 0xea, 0x9d, 0x3e, 0x2c, 0x6d, 0x9c, 0x63,
-	0xc7, 0xac, 0xdb, 0x0b, 0x90, 0x11, 0x5d, 0x8d, 0x58, 0x1c, 0xf0, 0x42, 0xf4, 0x14, 0x69, 0x1a,
-	0xf1, 0xfb, 0xd7, 0xa4, 0x30, 0xd8, 0x40, 0xff, 0xa7, 0x3c, 0x57, 0xac, 0x10, 0x68, 0x51, 0x6d,
-	0x2c, 0x88, 0x5d, 0xb5, 0x01, 0x51, 0xf1, 0xba, 0xe2, 0x3a, 0x5b, 0xd9, 0x36, 0xf6, 0x97, 0xec,
-	0x6d, 0x6c, 0xbe, 0x33, 0x3d, 0x85, 0xbf, 0xe3, 0x95, 0x07, 0x28, 0xdd, 0x96, 0x9b, 0x62, 0x5f,
-	0xb3, 0x60, 0xe1, 0x52, 0x31, 0xf2, 0x1f, 0xf7, 0x2c, 0xc7, 0x53, 0x19, 0x72, 0x9a, 0x8c, 0xaf,
-	0x78, 0x45, 0x51, 0x54, 0x77, 0x88, 0x80, 0x92, 0xf3, 0xc0, 0x5f, 0xe6, 0x04, 0xdc, 0x6b, 0x6c,
-	0xed, 0xcb, 0xf6, 0x04, 0xff, 0xeb, 0x91, 0xa6, 0x08, 0x9f, 0x88, 0x79, 0x9c, 0xf0, 0x71, 0xfe,
-	0x16, 0x0a, 0x3f, 0x35, 0xe1, 0x2b, 0xa4, 0x06, 0x18, 0x97, 0x1a, 0x4c, 0x5b, 0xba, 0x0d, 0xb6,
-	0x72, 0x37, 0xed, 0xf4, 0xf9, 0x82, 0xd2, 0x0c, 0x78, 0x82, 0x3e, 0x4e, 0xa6, 0xa4, 0xfa, 0x93,
-	0x11, 0xfb, 0x2d, 0x6b, 0x66, 0x88, 0x4c, 0xf1, 0x3c, 0x8c, 0x2c, 0xaa, 0x0f, 0xb8, 0xea, 0xe6,
-	0x3d, 0xf4, 0xa7, 0xc8, 0xb4, 0x11, 0xfb, 0x23, 0xee, 0xa0, 0xb5, 0x32, 0x2f, 0xcd, 0xa8, 0xfc,
-	0xc0, 0x2c, 0x0c, 0x78, 0x6f, 0xf0, 0xd7, 0x38, 0x26, 0xb9, 0xf2, 0xe5, 0x29, 0xff, 0x53, 0x5e,
-	0x3e, 0xc8, 0xed, 0xb6, 0x06, 0xcd, 0x30, 0x2b, 0xaa, 0x96, 0x59, 0x51, 0xb6, 0xed, 0xf9, 0x15,
-	0x7b, 0xdb, 0x93, 0x45, 0x44, 0x0f, 0xd3, 0xc7, 0x3d, 0x77, 0xd4, 0x9d, 0x3e, 0xdf, 0xf2, 0xcc,
-	0x67, 0x7d, 0x66, 0x49, 0xb5, 0x9b, 0x4a, 0x7b, 0x0f, 0x3e, 0x01, 0xed, 0x21, 0xdf, 0x03, 0xf1,
-	0x83, 0x30, 0x91, 0x2a, 0x3b, 0x0b, 0xfc, 0x84, 0x67, 0x5d, 0x49, 0x73, 0x75, 0x6f, 0x9e, 0x05,
-	0x52, 0x99, 0xd7, 0x66, 0xfc, 0xf8, 0x79, 0x14, 0x03, 0x23, 0xd7, 0x22, 0x16, 0xaf, 0xc9, 0x18,
-	0xe1, 0x5a, 0xa0, 0xd2, 0x7c, 0xe9, 0x32, 0x82, 0x95, 0xd5, 0xd2, 0x65, 0x84, 0x51, 0x97, 0x2c,
-	0xa7, 0xfe, 0x7f, 0x57, 0xd4, 0x7d, 0x54, 0xa9, 0x09, 0x4b, 0x6c, 0xbb, 0xec, 0x06, 0xa9, 0xe2,
-	0xd8, 0x20, 0xc9, 0x83, 0xa3, 0xf6, 0xba, 0x98, 0x73, 0x32, 0xa9, 0x72, 0xba, 0xa9, 0xd8, 0x1e,
-	0xca, 0xa4, 0x21, 0x0e, 0xf5, 0xac, 0xef, 0x98, 0x3b, 0x83, 0xb9, 0x51, 0x8a, 0x96, 0xbe, 0x02,
-	0xb8, 0x6f, 0x60, 0x79, 0x77, 0xe8, 0x06, 0x96, 0x61, 0x1d, 0x93, 0xdc, 0x01, 0x89, 0x65, 0xbf,
-	0x73, 0x4f, 0xbd, 0xdb, 0x7e, 0x3f, 0x80, 0x79, 0x6a, 0xcf, 0xf1, 0x97, 0x1e, 0x39, 0xc8, 0x8d,
-	0x71, 0x8b, 0xfb, 0xf2, 0xc6, 0x99, 0x67, 0xdf, 0x38, 0xf3, 0x45, 0xb4, 0x79, 0x86, 0xfb, 0xd6,
-	0x13, 0x44, 0x3f, 0x68, 0xee, 0x2b, 0xaa, 0x26, 0x4a, 0xa8, 0x9a, 0xb4, 0xa9, 0x3a, 0x4f, 0x9a,
-	0x6a, 0x0e, 0x4a, 0x65, 0xa8, 0x1b, 0xf2, 0x4a, 0xb6, 0x37, 0x15, 0x6b, 0x7b, 0xe3, 0xbf, 0x5f,
-	0xb2, 0xc7, 0x98, 0x0c, 0xdf, 0x1f, 0x7b, 0x16, 0x78, 0x30, 0x02, 0xa2, 0x26, 0xee, 0xc8, 0x1c,
-	0xc9, 0xaa, 0x0d, 0xae, 0x46, 0x55, 0x12, 0xf6, 0x6f, 0x87, 0x72, 0x7a, 0xd6, 0xb4, 0x2a, 0xbc,
-	0xfd, 0xad, 0x8a, 0x37, 0x93, 0x03, 0x66, 0x6d, 0xb1, 0x27, 0x91, 0x8b, 0x7b, 0x7e, 0xce, 0x07,
-	0x56, 0x71, 0xfa, 0xd6, 0xdc, 0xc3, 0x07, 0x62, 0xcb, 0x51, 0x74, 0x8b, 0x39, 0x5b, 0x1c, 0x89,
-	0xb0, 0x22, 0xf6, 0xca, 0x88, 0xc8, 0x88, 0xe4, 0xff, 0x1b, 0x22, 0xfe, 0xd6, 0x13, 0x21, 0x3b,
-	0xb6, 0x78, 0x59, 0x83, 0xea, 0xdd, 0xd4, 0xa0, 0xd2, 0xc7, 0x09, 0xe1, 0x1b, 0x78, 0xf5, 0x9a,
-	0x5b, 0x86, 0x7c, 0x83, 0x0c, 0xa3, 0x24, 0x7d, 0x9a, 0x34, 0x2d, 0x59, 0x10, 0x42, 0x54, 0xbc,
-	0x1e, 0xdb, 0xc5, 0x6d, 0x8d, 0xc6, 0xdf, 0x51, 0xd1, 0x00, 0x7f, 0x87, 0x1c, 0xb5, 0x8a, 0x2b,
-	0x37, 0x4d, 0xb9, 0x39, 0x61, 0x19, 0x08, 0x95, 0x9b, 0x36, 0x10, 0xa0, 0x3b, 0x4b, 0x26, 0xbe,
-	0xff, 0xee, 0x72, 0x22, 0x66, 0x76, 0xf7, 0x8a, 0x57, 0x18, 0xda, 0x7e, 0xbb, 0x91, 0x34, 0xd6,
-	0x84, 0xaf, 0xe6, 0x27, 0x7c, 0xd9, 0x4e, 0xf9, 0x93, 0x9e, 0x23, 0x18, 0x26, 0x87, 0x99, 0xe5,
-	0x47, 0x29, 0x09, 0xbe, 0x2f, 0x59, 0x35, 0xe5, 0xbd, 0xe2, 0x8a, 0x71, 0xaf, 0xf8, 0x56, 0x9d,
-	0x28, 0x17, 0x8b, 0xe9, 0xf8, 0x55, 0xcf, 0x8a, 0x22, 0x2c, 0x46, 0xd1, 0x8a, 0x93, 0x59, 0xc6,
-	0xa3, 0xc5, 0x70, 0x10, 0xa5, 0x7b, 0xb7, 0x3d, 0x89, 0xe6, 0xc9, 0xb4, 0xd1, 0x8c, 0xa0, 0xcf,
-	0x04, 0xf9, 0xef, 0x22, 0x73, 0xa6, 0xdd, 0x9c, 0xe9, 0xd3, 0xe5, 0xea, 0x7f, 0x32, 0xdb, 0xa6,
-	0xa9, 0x21, 0x32, 0x0d, 0xd8, 0x7d, 0xbd, 0x40, 0x0e, 0x1b, 0x49, 0x25, 0xcb, 0x4f, 0xd8, 0x7b,
-	0xca, 0x93, 0x79, 0x65, 0x93, 0x6d, 0x95, 0x97, 0x07, 0xf3, 0xef, 0x6c, 0x2c, 0x1d, 0xa1, 0xf0,
-	0x09, 0x4a, 0xb4, 0xe8, 0x7a, 0x45, 0xee, 0x48, 0xcf, 0x7e, 0x02, 0xab, 0x6e, 0x3d, 0x0e, 0x95,
-	0x9a, 0x5e, 0xe7, 0x34, 0xff, 0x38, 0x54, 0x2d, 0xfb, 0x38, 0x54, 0x99, 0x18, 0x7f, 0xca, 0x75,
-	0x5c, 0x9e, 0xc3, 0x4f, 0x8f, 0xfd, 0x7f, 0x78, 0xfc, 0xf9, 0x2c, 0x3c, 0xe3, 0x5a, 0x57, 0x67,
-	0x5c, 0xeb, 0xf4, 0x5e, 0x52, 0xe9, 0xa6, 0x42, 0x15, 0x66, 0x1e, 0xd5, 0xaa, 0x74, 0x53, 0xfa,
-	0xa8, 0x7a, 0x05, 0xa0, 0x6a, 0x9f, 0xe8, 0xac, 0x77, 0x53, 0xae, 0x66, 0x12, 0xf9, 0x2e, 0x0e,
-	0x7f, 0x0c, 0x20, 0xb3, 0xd1, 0xa8, 0x59, 0x87, 0xdb, 0xe5, 0x1b, 0x8d, 0xb9, 0x9e, 0x38, 0x6d,
-	0x2c, 0x7c, 0x21, 0xe5, 0x8c, 0xfd, 0x9a, 0x49, 0xb1, 0xba, 0x33, 0xde, 0x68, 0xf8, 0x74, 0x85,
-	0xcc, 0x66, 0x5f, 0x45, 0x84, 0x69, 0xcb, 0x30, 0xd1, 0x17, 0xb7, 0x05, 0x65, 0x12, 0x94, 0x20,
-	0x33, 0xa2, 0x07, 0xbc, 0xd3, 0xf5, 0x40, 0x03, 0x40, 0x76, 0x47, 0x63, 0xb5, 0x11, 0xc0, 0x6f,
-	0x7a, 0x2f, 0xa9, 0x8e, 0x53, 0xe9, 0xc1, 0x99, 0x36, 0xf8, 0x13, 0x00, 0x1c, 0x1a, 0xdc, 0xd8,
-	0x8d, 0x63, 0x18, 0x17, 0x1e, 0xcc, 0x58, 0x0f, 0x34, 0x00, 0x34, 0xe0, 0x38, 0x66, 0x3c, 0x93,
-	0x5f, 0x73, 0x54, 0x69, 0xa0, 0x3f, 0x89, 0x37, 0xc4, 0xa6, 0x0b, 0x3e, 0xa1, 0xfb, 0x3e, 0x4b,
-	0x52, 0x61, 0xc9, 0xe2, 0x37, 0x3d, 0x45, 0x9a, 0x1b, 0x5b, 0x6c, 0x63, 0x7b, 0x79, 0x34, 0xbc,
-	0x3a, 0x88, 0x36, 0x52, 0x61, 0xc6, 0xda, 0x40, 0x98, 0xb4, 0xa1, 0x7a, 0xd1, 0xab, 0x8f, 0xc6,
-	0x6c, 0x2d, 0x30, 0x41, 0xfe, 0xcf, 0x79, 0xae, 0x8b, 0x42, 0xf4, 0xf5, 0x82, 0x1f, 0xc6, 0xe9,
-	0x53, 0xe1, 0x5b, 0x93, 0xba, 0x64, 0xd9, 0x19, 0xc7, 0xa7, 0xed, 0x33, 0x8e, 0x7c, 0x9f, 0x5a,
-	0x6a, 0x01, 0xa7, 0xfc, 0x25, 0xa5, 0x3b, 0x80, 0xd3, 0x67, 0x6c, 0x9c, 0xf2, 0x7d, 0x5a, 0x9e,
-	0x40, 0xd7, 0x05, 0xa9, 0x5b, 0x9d, 0x58, 0xc7, 0xc9, 0x14, 0x1a, 0x18, 0xf8, 0x00, 0x29, 0x17,
-	0x27, 0x0d, 0xb0, 0x1e, 0x99, 0xf3, 0xf4, 0x53, 0x7a, 0x65, 0xae, 0x95, 0x5f, 0x73, 0xb9, 0x56,
-	0x2c, 0x14, 0x35, 0x0d, 0xa9, 0xeb, 0x2a, 0x97, 0x3d, 0x29, 0x2a, 0xc6, 0xa4, 0x28, 0xe3, 0xdc,
-	0xaf, 0xdb, 0x9c, 0xcb, 0x37, 0xab, 0x7b, 0xfd, 0x37, 0x6f, 0x9f, 0x9b, 0x62, 0x85, 0x0f, 0xbc,
-	0xdc, 0xc4, 0xa9, 0xa7, 0xfb, 0x38, 0xbb, 0x2c, 0x84, 0x8c, 0x92, 0xda, 0xd0, 0xf0, 0xc6, 0xc2,
-	0xf7, 0xc2, 0x6a, 0x31, 0xa1, 0xbf, 0xc1, 0x09, 0x3d, 0x65, 0x47, 0x2a, 0xb9, 0x09, 0xd1, 0x34,
-	0x7f, 0xcd, 0x2b, 0xbd, 0xfa, 0xb6, 0x9f, 0x05, 0x14, 0x5b, 0xbe, 0x3b, 0x9e, 0x82, 0x71, 0xea,
-	0xc7, 0xa3, 0xf1, 0xe2, 0x60, 0x20, 0xfc, 0x4e, 0x32, 0x59, 0x16, 0x14, 0xfe, 0x59, 0x8e, 0xbe,
-	0x6f, 0x5e, 0xfd, 0xd8, 0x0f, 0xf9, 0x77, 0x95, 0xdd, 0xca, 0x2b, 0x33, 0x4e, 0x7e, 0xd3, 0x36,
-	0x4e, 0x8a, 0x1b, 0xd1, 0x7d, 0x7d, 0xc4, 0x2b, 0xb8, 0xe2, 0x67, 0x18, 0x4d, 0x9e, 0x65, 0x34,
-	0x9d, 0x20, 0x24, 0xd6, 0xb7, 0x7e, 0xf8, 0xdb, 0x3c, 0x06, 0xa4, 0x2c, 0x72, 0xea, 0xb7, 0x3c,
-	0x57, 0xd4, 0x99, 0xdd, 0xaf, 0x46, 0xed, 0x2f, 0xbc, 0x9b, 0xbc, 0x62, 0x58, 0x88, 0x6a, 0x91,
-	0x17, 0x56, 0x58, 0xdc, 0xb0, 0xb4, 0xf0, 0x05, 0xb6, 0x1a, 0x68, 0xc0, 0xc2, 0x95, 0x62, 0x02,
-	0x3e, 0xc7, 0x09, 0x78, 0x8d, 0x66, 0xf0, 0xfe, 0xd8, 0x69, 0x82, 0x3e, 0xe5, 0xed, 0x7f, 0x11,
-	0xf2, 0xd6, 0x0e, 0xd0, 0xcb, 0xc2, 0x69, 0x7e, 0xdb, 0x0e, 0xa7, 0xd9, 0xaf, 0x63, 0x53, 0x4b,
-	0xb9, 0x2e, 0x62, 0x02, 0x33, 0x19, 0x5e, 0xc8, 0x12, 0x47, 0xed, 0x22, 0x55, 0xa6, 0x1b, 0x7f,
-	0xc7, 0xd6, 0x8d, 0x8e, 0x56, 0x73, 0xbd, 0x66, 0x6e, 0x79, 0xde, 0x4e, 0xaf, 0xbf, 0x9b, 0xef,
-	0x35, 0xd3, 0xaa, 0xee, 0xf5, 0x67, 0x3d, 0xe7, 0x1d, 0x52, 0xfa, 0x98, 0xf9, 0x1a, 0x88, 0x18,
-	0x0a, 0xc7, 0x03, 0x16, 0x46, 0xa1, 0x32, 0x8c, 0x3e, 0x6f, 0x63, 0xe4, 0xe8, 0x50, 0x63, 0x34,
-	0x70, 0xdc, 0x5d, 0x75, 0x86, 0xad, 0x95, 0xc4, 0x36, 0x7c, 0xc1, 0x8e, 0x6d, 0xc8, 0xb5, 0xa7,
-	0x7b, 0x7b, 0xc5, 0xdb, 0xef, 0x4e, 0xec, 0x2d, 0x4f, 0x2e, 0xe3, 0x99, 0x97, 0xaa, 0xf5, 0xcc,
-	0xcb, 0x42, 0xb7, 0x18, 0xe3, 0xdf, 0xe3, 0x18, 0xdf, 0x5f, 0x38, 0xb1, 0x4c, 0x94, 0x34, 0xfa,
-	0x37, 0x0a, 0x6e, 0xeb, 0x16, 0x3d, 0x64, 0x54, 0xa6, 0x9c, 0xbe, 0x68, 0x2b, 0x27, 0x67, 0xbb,
-	0xba, 0xe7, 0x77, 0x38, 0x2f, 0x03, 0x97, 0x09, 0xc1, 0x97, 0x6c, 0x21, 0x70, 0xd4, 0xd6, 0xad,
-	0xbf, 0xcf, 0x2b, 0xba, 0x52, 0x9c, 0xb3, 0x77, 0x66, 0x94, 0xbd, 0xd3, 0x04, 0x03, 0xa7, 0xcc,
-	0xcf, 0xf2, 0xfb, 0xb6, 0x9f, 0xc5, 0xdd, 0x81, 0x46, 0xe2, 0x63, 0x5e, 0xd9, 0x05, 0xe5, 0x5b,
-	0x95, 0x8b, 0xb2, 0x75, 0xeb, 0xcb, 0xb9, 0x75, 0xab, 0xa0, 0x53, 0x8d, 0xdc, 0x36, 0x39, 0x94,
-	0xdb, 0xd5, 0x38, 0xb7, 0xb8, 0xf9, 0xdb, 0xa5, 0xfc, 0x8e, 0x81, 0xe3, 0xc1, 0x57, 0xb1, 0x88,
-	0x25, 0x22, 0x58, 0x45, 0xa5, 0xfd, 0xcb, 0xd6, 0xe3, 0x48, 0xfc, 0x35, 0xa3, 0xa5, 0x3c, 0x4c,
-	0x6c, 0x7a, 0x8b, 0x4e, 0xd8, 0x72, 0xe5, 0x61, 0x98, 0x4b, 0xaf, 0x78, 0x5b, 0x71, 0xd6, 0xe2,
-	0xf1, 0xe4, 0x32, 0x4f, 0xe0, 0x57, 0x6c, 0x4f, 0x60, 0x59, 0xd3, 0x9a, 0x93, 0x5f, 0xf4, 0xca,
-	0x6f, 0x91, 0xdf, 0xf2, 0xe5, 0x41, 0xf5, 0xe4, 0x5e, 0xd5, 0x78, 0x72, 0xaf, 0x0c, 0xed, 0xaf,
-	0x7a, 0x8e, 0x7b, 0xa3, 0x6e, 0x64, 0x34, 0xda, 0x2f, 0x15, 0xdf, 0x6c, 0x77, 0xb2, 0xad, 0x24,
-	0x2a, 0xf1, 0x6b, 0x76, 0x54, 0x62, 0x51, 0xb3, 0xd6, 0xcc, 0x28, 0xbd, 0x38, 0x4f, 0x1f, 0x22,
-	0x8d, 0xe5, 0x67, 0x71, 0x37, 0x29, 0x4f, 0x42, 0x54, 0x9f, 0x1c, 0x1c, 0xa8, 0xfc, 0x32, 0xc6,
-	0xfc, 0x41, 0x86, 0x31, 0x25, 0x5d, 0x6a, 0xe4, 0xde, 0x42, 0x26, 0x45, 0xdb, 0xce, 0xf9, 0x90,
-	0x79, 0xfa, 0x90, 0x3b, 0x01, 0xac, 0xa7, 0x0f, 0x3f, 0xe0, 0xed, 0x77, 0xe9, 0xdf, 0xc9, 0xe0,
-	0x12, 0xed, 0xfe, 0x4a, 0x4e, 0xbb, 0x97, 0x34, 0x6e, 0x2b, 0xa0, 0xe2, 0x97, 0x05, 0x6e, 0xf5,
-	0xee, 0x4a, 0x99, 0x02, 0xfa, 0xba, 0x97, 0xbb, 0x1b, 0xbc, 0x9f, 0xfc, 0x0d, 0x4a, 0x5f, 0x35,
-	0x28, 0xdb, 0x12, 0x7c, 0xc3, 0xde, 0x12, 0x94, 0xb4, 0xa2, 0x7b, 0xfb, 0xa4, 0xb7, 0xcf, 0x1b,
-	0x09, 0xa0, 0x76, 0x13, 0xbe, 0x75, 0x05, 0x81, 0xab, 0x05, 0x22, 0x05, 0xcb, 0x31, 0xf7, 0x9b,
-	0xf2, 0xd3, 0xe3, 0x5a, 0x20, 0x93, 0x65, 0x9b, 0xae, 0x3f, 0xb4, 0x37, 0x5d, 0xa5, 0x3d, 0x9b,
-	0x57, 0xce, 0xf2, 0x8f, 0x34, 0x98, 0xfd, 0x7b, 0x76, 0xff, 0x25, 0x06, 0xcc, 0x1f, 0x65, 0x83,
-	0x33, 0x33, 0xad, 0xea, 0x3e, 0xff, 0xde, 0x2b, 0x7e, 0x02, 0x02, 0xa4, 0xa1, 0x9f, 0xd1, 0x5c,
-	0x32, 0x2d, 0xb6, 0x31, 0xfc, 0xe4, 0xba, 0x2f, 0xd6, 0x4f, 0x03, 0x02, 0x75, 0x77, 0xf8, 0x1f,
-	0x06, 0xf4, 0xc5, 0xd3, 0x06, 0x2a, 0xad, 0xff, 0x40, 0xa0, 0x56, 0xf4, 0x07, 0x02, 0x65, 0xea,
-	0xe6, 0x9b, 0xb6, 0xba, 0x29, 0xc2, 0xde, 0xf2, 0xa4, 0x9b, 0x0f, 0x43, 0xa3, 0x0b, 0x8f, 0xff,
-	0x0d, 0x85, 0xc7, 0xf7, 0xa1, 0xf2, 0xef, 0x27, 0x4e, 0x10, 0xb2, 0xb4, 0xbb, 0xb1, 0xcd, 0x52,
-	0xa1, 0x93, 0xf1, 0xcd, 0x2d, 0x0d, 0xc1, 0xfb, 0x41, 0xdb, 0xe2, 0x46, 0x77, 0x65, 0x71, 0x1b,
-	0xd2, 0xbd, 0x6d, 0xf9, 0xc0, 0x7c, 0x6f, 0x1b, 0x68, 0x3e, 0x3b, 0xec, 0x8f, 0x47, 0xd1, 0x30,
-	0x15, 0x01, 0xc4, 0x2a, 0x0d, 0x79, 0x4b, 0x61, 0xc2, 0xba, 0x61, 0xba, 0x85, 0x27, 0x66, 0x53,
-	0x81, 0x4a, 0xfb, 0x1f, 0xad, 0x10, 0x33, 0x4e, 0x7c, 0x19, 0xdf, 0xa7, 0xef, 0xb1, 0x61, 0x12,
-	0xa5, 0xd1, 0x35, 0x26, 0xb0, 0xcc, 0x82, 0x01, 0xdb, 0xc5, 0xf1, 0x98, 0x0d, 0xfb, 0xa0, 0x6c,
-	0x11, 0xdb, 0x46, 0x60, 0x40, 0x60, 0xe5, 0xbe, 0x12, 0x47, 0x29, 0x5b, 0xdb, 0x8a, 0x59, 0xb2,
-	0x35, 0x1a, 0xf4, 0xc5, 0xba, 0x9c, 0x81, 0xd2, 0x53, 0xa4, 0x19, 0xb0, 0xb0, 0xaf, 0x8b, 0xd5,
-	0xb0, 0x98, 0x0d, 0xc4, 0x17, 0xff, 0xd3, 0x51, 0x1c, 0x6e, 0xb2, 0xe5, 0x70, 0x1c, 0x6e, 0x44,
-	0xe9, 0x9e, 0x38, 0x15, 0xcc, 0x82, 0x55, 0xd0, 0xf1, 0xf2, 0x56, 0x18, 0x0b, 0x52, 0x35, 0x00,
-	0x1f, 0x68, 0x4e, 0xa5, 0xef, 0x1b, 0x3e, 0xf1, 0xce, 0x75, 0xb8, 0x99, 0x60, 0x11, 0x71, 0x1d,
-	0x4b, 0x03, 0xfc, 0x6f, 0x79, 0xc5, 0x8f, 0x82, 0xb8, 0x8c, 0xb9, 0x60, 0x2c, 0x14, 0x57, 0x25,
-	0x18, 0xe3, 0xb3, 0xa9, 0x49, 0xaa, 0x1e, 0x52, 0x4d, 0x52, 0x33, 0x60, 0xbf, 0x66, 0xfd, 0x29,
-	0x44, 0xee, 0x05, 0x8c, 0x12, 0x09, 0xfc, 0x96, 0x4b, 0x02, 0xcb, 0x42, 0x6e, 0x7e, 0xd1, 0x23,
-	0x93, 0xa0, 0x47, 0x57, 0xc7, 0x18, 0xad, 0xb9, 0x3a, 0x16, 0x21, 0x76, 0x95, 0xd5, 0x31, 0x08,
-	0xc6, 0x90, 0x5d, 0x97, 0xae, 0x3d, 0x7c, 0x11, 0x40, 0xa6, 0xf3, 0xff, 0xc0, 0xc2, 0x9f, 0x77,
-	0xcb, 0xfc, 0x03, 0xcb, 0x09, 0x42, 0xce, 0xb3, 0x74, 0x75, 0xcc, 0x8f, 0x63, 0xf9, 0xe8, 0x19,
-	0x10, 0x75, 0x71, 0xb5, 0x6e, 0x1f, 0xf5, 0xaa, 0x8b, 0xab, 0xb0, 0x50, 0x38, 0x9f, 0x72, 0x29,
-	0xbd, 0x1d, 0x65, 0x7b, 0x01, 0xc4, 0x64, 0x31, 0xbc, 0x00, 0x25, 0x61, 0x26, 0xdf, 0xb6, 0xc3,
-	0x4c, 0x5c, 0x5d, 0x3b, 0x3d, 0x59, 0x8e, 0xd7, 0x64, 0x7e, 0xc0, 0xae, 0x8c, 0x2c, 0x11, 0x25,
-	0x6b, 0xde, 0x77, 0x9c, 0x9e, 0x2c, 0x07, 0x8a, 0x9a, 0x94, 0xcf, 0x7a, 0x25, 0x2f, 0xea, 0xa8,
-	0x1b, 0x89, 0xfc, 0xed, 0x72, 0x7e, 0x23, 0xd1, 0xfd, 0x17, 0x5e, 0xfa, 0x76, 0x43, 0xd5, 0xbc,
-	0xdd, 0x50, 0x76, 0x13, 0xeb, 0xbb, 0xf6, 0x4d, 0xac, 0x42, 0x2c, 0x34, 0xb2, 0x7f, 0x5e, 0x21,
-	0x8d, 0x73, 0x11, 0x3f, 0xe3, 0x00, 0x41, 0x48, 0xd8, 0x8b, 0xbb, 0x6c, 0xb8, 0xc1, 0x84, 0x63,
-	0x43, 0xa5, 0x01, 0xc7, 0x01, 0xc6, 0xb3, 0x88, 0x77, 0xa6, 0x31, 0x01, 0xd0, 0x1d, 0x16, 0x6f,
-	0x32, 0xa1, 0xfc, 0x79, 0x02, 0x8f, 0x23, 0x6e, 0xa4, 0x6c, 0x98, 0xca, 0x03, 0x62, 0x9e, 0xc2,
-	0xd2, 0xf8, 0x47, 0x3e, 0x75, 0x7e, 0x67, 0x0f, 0x13, 0xa0, 0xa9, 0x13, 0xe1, 0xa5, 0x9c, 0x40,
-	0xb8, 0x4c, 0x82, 0xce, 0xe8, 0xab, 0x58, 0x72, 0xae, 0x4b, 0x34, 0x00, 0x7d, 0x17, 0x28, 0x53,
-	0x90, 0xcb, 0xff, 0x7d, 0x42, 0x03, 0xa0, 0xd5, 0x9d, 0x88, 0x5b, 0x6f, 0xfc, 0xd1, 0x0b, 0x99,
-	0xc4, 0x1c, 0x11, 0xcd, 0x4d, 0x44, 0x0e, 0x4f, 0xe2, 0xee, 0x66, 0x74, 0x9d, 0x87, 0x81, 0xf3,
-	0x90, 0x19, 0x95, 0x86, 0x49, 0x7a, 0x35, 0x1a, 0xb0, 0x5e, 0xf4, 0x12, 0x5b, 0xda, 0x03, 0x8b,
-	0x95, 0xc7, 0xcd, 0xd8, 0x40, 0xff, 0x83, 0x9e, 0xeb, 0xd1, 0x23, 0xfa, 0x08, 0x99, 0x92, 0x4c,
-	0x96, 0xa6, 0xee, 0x41, 0x75, 0x55, 0x61, 0x20, 0x9c, 0x98, 0xaa, 0x44, 0xd9, 0x89, 0xf6, 0x1f,
-	0xdb, 0x27, 0xda, 0xf9, 0xbe, 0xac, 0x1b, 0x35, 0x65, 0x4f, 0x29, 0xdd, 0xe1, 0x39, 0x55, 0x62,
-	0xda, 0xfd, 0x89, 0x6d, 0xda, 0x95, 0xe0, 0xa8, 0x89, 0xf9, 0x80, 0xe7, 0x7a, 0xf6, 0x09, 0xd5,
-	0x2a, 0x88, 0xb7, 0x8c, 0x43, 0x9b, 0x0a, 0x54, 0x3a, 0xfb, 0x12, 0x6d, 0x19, 0x57, 0xbf, 0x97,
-	0xb9, 0xa0, 0x99, 0xeb, 0xc8, 0x3a, 0x0b, 0x9b, 0xc4, 0xbf, 0xa8, 0x1a, 0x5d, 0x07, 0x09, 0x4c,
-	0xd5, 0x3b, 0x22, 0x22, 0x88, 0x48, 0x01, 0x0c, 0x5b, 0x53, 0x6c, 0xf1, 0x85, 0xad, 0x39, 0x47,
-	0x1a, 0x5b, 0x23, 0xeb, 0xec, 0x47, 0xa5, 0x55, 0x34, 0x5f, 0x5b, 0x3c, 0x3c, 0x22, 0x52, 0x16,
-	0x9d, 0x75, 0x9b, 0x4e, 0xff, 0xaf, 0x3d, 0xd2, 0x40, 0x9f, 0x06, 0xa0, 0x24, 0x7d, 0x80, 0xe2,
-	0x1f, 0x02, 0xd1, 0x07, 0x98, 0xf1, 0x1a, 0x62, 0xc8, 0x9a, 0xf6, 0x1a, 0xce, 0x90, 0x4a, 0x5f,
-	0x46, 0x66, 0x55, 0xfa, 0xeb, 0xd0, 0xc2, 0x38, 0xed, 0xf4, 0x45, 0x44, 0x16, 0x7e, 0x43, 0x0b,
-	0x49, 0xbc, 0x21, 0xb4, 0x11, 0x0f, 0xdd, 0xd4, 0x00, 0x9c, 0xa6, 0x49, 0x2a, 0x72, 0xf9, 0x93,
-	0xe4, 0x1a, 0x60, 0xbb, 0x18, 0xf9, 0x7f, 0x0c, 0x15, 0xb8, 0x18, 0x1b, 0x9c, 0x30, 0x99, 0xf6,
-	0x5f, 0x20, 0x07, 0x8d, 0x91, 0x90, 0xff, 0xf5, 0x34, 0xc4, 0xff, 0x0d, 0xb3, 0xf7, 0x8b, 0x62,
-	0x40, 0x02, 0x9e, 0x49, 0x1f, 0x24, 0x13, 0x8c, 0xff, 0xff, 0x5c, 0xc5, 0x9a, 0x6b, 0x92, 0x4b,
-	0x81, 0xc8, 0xc6, 0xe8, 0x4b, 0xd7, 0xa3, 0x61, 0x77, 0x32, 0xfa, 0xf2, 0x4f, 0xed, 0x65, 0xd1,
-	0xd5, 0xbd, 0x92, 0xb6, 0x25, 0xf2, 0xf6, 0xc6, 0x99, 0x33, 0x8f, 0x62, 0xc9, 0xff, 0x0b, 0x00,
-	0x00, 0xff, 0xff, 0x79, 0x44, 0xc0, 0x66, 0x19, 0x72, 0x00, 0x00,
+	// 7680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x6b, 0x90, 0x1d, 0xc7,
+	0x55, 0x70, 0xcd, 0x7d, 0xec, 0xa3, 0x57, 0x77, 0xb5, 0x6a, 0x3d, 0x7c, 0xb5, 0x96, 0xe5, 0xd5,
+	0x58, 0xb6, 0x15, 0x3b, 0x96, 0xe3, 0xad, 0xc4, 0x76, 0x9c, 0xc4, 0x89, 0x76, 0xaf, 0x1e, 0x37,
+	0xd6, 0x6a, 0xaf, 0xe7, 0xae, 0xa5, 0xef, 0x8b, 0xf3, 0xe5, 0xf3, 0xec, 0xde, 0xd6, 0xee, 0x78,
+	0xef, 0xcb, 0x33, 0xb3, 0x92, 0xd6, 0x95, 0xaf, 0xe2, 0x24, 0x55, 0xf9, 0x2a, 0x50, 0x14, 0x45,
+	0x51, 0x79, 0x02, 0x01, 0x42, 0x12, 0x20, 0x10, 0x20, 0x21, 0x21, 0x0f, 0x9c, 0x40, 0x5e, 0x10,
+	0x02, 0xc5, 0x1f, 0x0a, 0x7e, 0x51, 0x54, 0xf1, 0x37, 0x05, 0x14, 0xf9, 0x03, 0x95, 0x02, 0xaa,
+	0xa8, 0x73, 0xfa, 0x3d, 0xd3, 0x33, 0x2b, 0x29, 0x51, 0x8a, 0x5f, 0x77, 0xfa, 0xf4, 0xeb, 0x9c,
+	0xee, 0xd3, 0xa7, 0x4f, 0x9f, 0x73, 0xba, 0x2f, 0x21, 0x03, 0x96, 0x86, 0xa7, 0xc7, 0xf1, 0x28,
+	0x1d, 0xd1, 0x3a, 0xfe, 0xf8, 0x5f, 0xde, 0x47, 0x6a, 0xad, 0x30, 0x0d, 0x29, 0x25, 0xb5, 0x35,
+	0x16, 0x0f, 0x9a, 0xde, 0x42, 0xe5, 0x54, 0x2d, 0xc0, 0x6f, 0x7a, 0x88, 0xd4, 0xdb, 0xc3, 0x1e,
+	0xbb, 0xd1, 0xac, 0x20, 0x90, 0x27, 0xe8, 0x31, 0x32, 0xbd, 0xdc, 0xdf, 0x49, 0x52, 0x16, 0xb7,
+	0x5b, 0xcd, 0x2a, 0xe6, 0x68, 0x00, 0xbd, 0x9f, 0xd4, 0x2f, 0x8d, 0x7a, 0x2c, 0x69, 0xd6, 0x16,
+	0xaa, 0xa7, 0x66, 0x16, 0xf7, 0xf3, 0xee, 0x4e, 0x03, 0xac, 0x3d, 0xbc, 0x3a, 0x0a, 0x78, 0x2e,
+	0x7d, 0x8c, 0x4c, 0x43, 0xb7, 0xeb, 0x61, 0xc2, 0x92, 0x66, 0x1d, 0x8b, 0x1e, 0x14, 0x45, 0x25,
+	0x1c, 0x8b, 0xeb, 0x52, 0xd0, 0xf2, 0x73, 0x09, 0x8b, 0x93, 0xe6, 0x84, 0xd5, 0x32, 0xc0, 0x78,
+	0xcb, 0x98, 0x0b, 0xe8, 0xad, 0x84, 0x37, 0xb0, 0xbf, 0x56, 0x73, 0x92, 0xa3, 0xa7, 0x00, 0xf4,
+	0x14, 0xd9, 0xbf, 0x12, 0xde, 0xe8, 0x6e, 0x85, 0x71, 0xef, 0x7c, 0x3c, 0xda, 0x19, 0xb7, 0x5b,
+	0xcd, 0x29, 0x2c, 0x93, 0x05, 0xd3, 0xe3, 0x84, 0x48, 0x50, 0xbb, 0xd5, 0x9c, 0xc6, 0x42, 0x06,
+	0x84, 0x3e, 0xc2, 0x29, 0xe0, 0xc4, 0x12, 0x0b, 0x25, 0x09, 0x0f, 0x74, 0x09, 0x28, 0xbe, 0xc2,
+	0x64, 0xf1, 0x19, 0xf7, 0xd8, 0xe8, 0x12, 0xd4, 0x27, 0xfb, 0xc4, 0x98, 0x76, 0xd2, 0x4b, 0x3b,
+	0x83, 0xe6, 0xec, 0x42, 0xe5, 0x54, 0x23, 0xb0, 0x60, 0xf4, 0x51, 0x32, 0xd1, 0x49, 0x2f, 0x47,
+	0xec, 0x7a, 0x73, 0x3f, 0xb6, 0x77, 0x97, 0xd1, 0xfd, 0x69, 0x9e, 0x73, 0x76, 0x98, 0xc6, 0xbb,
+	0x81, 0x28, 0x06, 0x8d, 0x62, 0xcd, 0x0e, 0x8b, 0xa1, 0x97, 0xe6, 0xdc, 0x82, 0x07, 0x8d, 0x9a,
+	0x30, 0x31, 0x40, 0x38, 0xd3, 0x72, 0x80, 0x0e, 0xa8, 0x01, 0x32, 0xc1, 0x62, 0x80, 0x10, 0xd4,
+	0x6e, 0x35, 0xa9, 0x1a, 0x20, 0x01, 0x81, 0xde, 0x56, 0xc2, 0x1b, 0x67, 0xaf, 0xb1, 0x61, 0xba,
+	0x3a, 0x6e, 0xf7, 0x9a, 0x07, 0x17, 0xbc, 0x53, 0xb5, 0xc0, 0x82, 0x41, 0x6f, 0x6b, 0xe1, 0x36,
+	0x5b, 0xbd, 0xc6, 0xe2, 0xb3, 0xc3, 0x70, 0xbd, 0xcf, 0x7a, 0xcd, 0x43, 0x0b, 0xde, 0xa9, 0xa9,
+	0x20, 0x0b, 0xa6, 0x6f, 0x21, 0x8d, 0x95, 0x68, 0x33, 0x0e, 0x53, 0x86, 0xb5, 0x93, 0xe6, 0x61,
+	0x8b, 0x66, 0x33, 0x0f, 0xc7, 0xd2, 0x2e, 0x0d, 0x1d, 0x2d, 0x85, 0xfd, 0x70, 0xb8, 0xa1, 0x3b,
+	0x3a, 0xc2, 0x3b, 0xca, 0x80, 0xc5, 0x00, 0xb4, 0x46, 0xd7, 0x87, 0xdd, 0x70, 0x30, 0xee, 0x03,
+	0x17, 0xdd, 0x85, 0x98, 0x67, 0xc1, 0xf4, 0x61, 0x32, 0xd9, 0x4d, 0x63, 0x16, 0x0e, 0x92, 0x66,
+	0x13, 0x91, 0x39, 0x20, 0x90, 0xe1, 0x50, 0x44, 0x43, 0x96, 0xa0, 0x0b, 0x64, 0x06, 0x98, 0x87,
+	0xe7, 0xb4, 0x9a, 0x47, 0xb1, 0x49, 0x13, 0x24, 0x18, 0x77, 0x79, 0x34, 0x1c, 0xb6, 0x7b, 0xcd,
+	0x79, 0xcc, 0xd7, 0x00, 0xfa, 0x34, 0x99, 0x79, 0x76, 0x87, 0xc5, 0xbb, 0xed, 0x56, 0x7b, 0x18,
+	0xa5, 0xcd, 0xbb, 0xb1, 0xc3, 0x63, 0xe6, 0x8c, 0x1b, 0xd9, 0x7c, 0xda, 0xcd, 0x0a, 0xb4, 0x45,
+	0x1a, 0x01, 0x1b, 0xf7, 0xa3, 0x8d, 0x10, 0xe7, 0x2f, 0x69, 0x1e, 0xc3, 0x16, 0x8e, 0x9b, 0x2d,
+	0x58, 0x05, 0x78, 0x1b, 0x76, 0x25, 0xfa, 0x5a, 0x72, 0x00, 0x50, 0xde, 0x59, 0x4f, 0x36, 0xe2,
+	0x68, 0x9c, 0x46, 0xa3, 0x61, 0xbb, 0xd5, 0xbc, 0x07, 0x71, 0xcd, 0x67, 0xd0, 0x93, 0xa4, 0x01,
+	0x04, 0x3c, 0xbb, 0xbc, 0x15, 0x0e, 0x37, 0x61, 0x20, 0x8f, 0x63, 0x49, 0x1b, 0x08, 0x23, 0x73,
+	0x69, 0x67, 0xb0, 0x7a, 0x15, 0x17, 0x56, 0xd2, 0xbc, 0x77, 0xc1, 0x3b, 0x55, 0x0f, 0x4c, 0x10,
+	0x4c, 0x49, 0x3b, 0xe9, 0x3e, 0x7b, 0x31, 0x4a, 0x99, 0x9c, 0xbc, 0x05, 0x3e, 0x79, 0x19, 0x30,
+	0x7d, 0x98, 0x4c, 0x75, 0x5f, 0xea, 0xf3, 0x45, 0x76, 0xc2, 0xbd, 0x26, 0x55, 0x01, 0x3a, 0x4f,
+	0xa6, 0x56, 0xc2, 0x1b, 0x2b, 0x49, 0xda, 0x6e, 0x35, 0x7d, 0xc4, 0x4c, 0xa5, 0x81, 0xdd, 0x5a,
+	0x6c, 0x63, 0x34, 0x18, 0x44, 0x49, 0x12, 0x8d, 0x86, 0x49, 0xf3, 0x3e, 0x7b, 0x89, 0x19, 0x79,
+	0x9c, 0xdd, 0xac, 0xd2, 0xf3, 0x6f, 0x27, 0x33, 0xc6, 0x02, 0xa4, 0x73, 0xa4, 0xba, 0xcd, 0x76,
+	0x9b, 0xde, 0x82, 0x77, 0x6a, 0x3a, 0x80, 0x4f, 0x10, 0x66, 0xd7, 0xc2, 0xfe, 0x0e, 0x6b, 0x56,
+	0x16, 0x3c, 0x13, 0xcb, 0xa5, 0x0e, 0x67, 0x5f, 0x9e, 0xfb, 0x54, 0xe5, 0x49, 0x6f, 0xfe, 0x69,
+	0x32, 0x97, 0x9d, 0x5a, 0x47, 0x83, 0x87, 0xcc, 0x06, 0x6b, 0x66, 0xfd, 0xe7, 0x08, 0xcd, 0x4f,
+	0xac, 0xa3, 0x85, 0xd7, 0xd8, 0x28, 0x49, 0x71, 0x2c, 0xea, 0xc2, 0x94, 0x26, 0x46, 0xb3, 0xfe,
+	0x9b, 0xc8, 0x3e, 0x33, 0x8b, 0x3e, 0x4c, 0x26, 0x04, 0x67, 0x79, 0x96, 0x38, 0x37, 0xfb, 0x0e,
+	0x44, 0x11, 0xff, 0x83, 0x9e, 0xaa, 0x8d, 0x10, 0x3a, 0x4b, 0x2a, 0xed, 0x16, 0x6e, 0x3e, 0x8d,
+	0xa0, 0xd2, 0x6e, 0xf1, 0xb9, 0x11, 0x7b, 0x4c, 0x05, 0xa1, 0x2a, 0x4d, 0x4f, 0x90, 0x7a, 0x87,
+	0xc1, 0x46, 0x50, 0xc5, 0x8e, 0x66, 0x44, 0x47, 0x00, 0x0b, 0x78, 0x0e, 0x3d, 0x42, 0x26, 0xba,
+	0x69, 0x98, 0xee, 0xc0, 0x36, 0x04, 0x95, 0x45, 0x4a, 0xed, 0x72, 0x75, 0xbd, 0xcb, 0xf9, 0x0f,
+	0x91, 0x1a, 0x54, 0xca, 0xa1, 0x40, 0x49, 0x2d, 0x18, 0xf5, 0x99, 0xe8, 0x1e, 0xbf, 0xfd, 0x13,
+	0x64, 0xb2, 0x93, 0xae, 0x5e, 0x1f, 0xb2, 0x18, 0xba, 0x10, 0x9b, 0x0c, 0xdf, 0x32, 0x45, 0xca,
+	0x7f, 0xc5, 0x03, 0xb1, 0x0c, 0x93, 0x48, 0x4f, 0x92, 0x3a, 0x96, 0xc5, 0x12, 0x33, 0x8b, 0xb3,
+	0x12, 0x51, 0xde, 0x42, 0x50, 0x57, 0x0d, 0x09, 0x5c, 0x2b, 0x59, 0x5c, 0x3b, 0x69, 0xbb, 0x87,
+	0x5b, 0x6c, 0x23, 0xc0, 0x6f, 0x98, 0xb5, 0xcb, 0x2c, 0x6e, 0xd6, 0x70, 0x8e, 0xe1, 0x13, 0xb1,
+	0x3c, 0xdf, 0x6e, 0x35, 0xeb, 0x28, 0xcb, 0xf1, 0xdb, 0x7f, 0x84, 0x4c, 0x49, 0x46, 0xa2, 0x27,
+	0x48, 0xad, 0xb5, 0xde, 0x49, 0xc5, 0xa4, 0x34, 0x14, 0x0a, 0xc8, 0x65, 0x98, 0xe5, 0xff, 0x8b,
+	0x47, 0xa6, 0xe4, 0x1e, 0x64, 0x8c, 0x42, 0x4d, 0x8e, 0xc2, 0x85, 0x51, 0x92, 0x22, 0x6e, 0xd3,
+	0x01, 0x7e, 0xd3, 0x26, 0x99, 0x0c, 0x3a, 0xcb, 0x67, 0x7a, 0xbd, 0x18, 0xbb, 0x9d, 0x0e, 0x64,
+	0x12, 0x72, 0xd6, 0x96, 0x3b, 0x58, 0xa1, 0xca, 0x73, 0x44, 0x32, 0x33, 0x23, 0x55, 0x45, 0xe5,
+	0x21, 0x52, 0xbf, 0xb8, 0x16, 0x0d, 0x58, 0x73, 0x82, 0xeb, 0x18, 0x98, 0x80, 0xbd, 0xe5, 0xfc,
+	0x28, 0x49, 0xa2, 0x31, 0x76, 0x32, 0x89, 0x7d, 0x1b, 0x10, 0x90, 0x08, 0x5d, 0xb6, 0x19, 0xb3,
+	0xcd, 0x30, 0x65, 0xa2, 0xd9, 0x29, 0x2e, 0xa4, 0x33, 0x60, 0x35, 0x8b, 0x04, 0xd1, 0xe1, 0xb3,
+	0xb8, 0x43, 0xa6, 0xa4, 0x38, 0xa0, 0xf7, 0x92, 0xca, 0xa5, 0x48, 0x4c, 0x50, 0x6e, 0x43, 0xae,
+	0x5c, 0x8a, 0x00, 0x71, 0x14, 0xc1, 0x2d, 0xb1, 0xb2, 0x44, 0x0a, 0xc4, 0xd6, 0x99, 0x7e, 0x74,
+	0x8d, 0x89, 0xcc, 0x2a, 0x17, 0xe8, 0x06, 0x08, 0x86, 0xf2, 0xcc, 0xcb, 0x38, 0x57, 0xd3, 0x41,
+	0xe5, 0xcc, 0xcb, 0xfe, 0x17, 0xaa, 0x64, 0x9f, 0xa9, 0xdc, 0x00, 0x6e, 0x97, 0xc2, 0x01, 0xc3,
+	0xde, 0xa7, 0x03, 0xfc, 0xa6, 0x8f, 0x93, 0x23, 0x2d, 0x76, 0x35, 0xdc, 0xe9, 0xa7, 0x01, 0x4b,
+	0xd9, 0x10, 0xd6, 0x56, 0x67, 0xd4, 0x8f, 0x36, 0x76, 0xc5, 0x0c, 0x14, 0xe4, 0xd2, 0x0b, 0xe4,
+	0x80, 0x0d, 0x8a, 0x98, 0x5c, 0x20, 0xf3, 0x6a, 0x25, 0x5a, 0x55, 0x90, 0xc2, 0x7c, 0x25, 0x68,
+	0x69, 0x79, 0x34, 0x4c, 0xa3, 0xe1, 0xce, 0x68, 0x27, 0x01, 0xc9, 0x13, 0x29, 0x6d, 0x4e, 0xb6,
+	0x64, 0xe7, 0x8b, 0x96, 0x72, 0x95, 0xf8, 0x9e, 0x17, 0x6f, 0xb7, 0x58, 0x9f, 0xa5, 0xac, 0x87,
+	0xbc, 0x32, 0x15, 0x98, 0x20, 0xfa, 0x28, 0x99, 0x42, 0x19, 0xff, 0x0c, 0xdb, 0x6d, 0x4e, 0x58,
+	0x62, 0x47, 0x82, 0xb1, 0x6d, 0x55, 0x88, 0x3e, 0x40, 0x66, 0xb9, 0xac, 0x5f, 0x0b, 0x37, 0xcf,
+	0xc4, 0x71, 0xb8, 0xdb, 0x9c, 0xc4, 0x56, 0x33, 0x50, 0x90, 0x1f, 0x42, 0xbe, 0x5c, 0x42, 0xce,
+	0xa8, 0x06, 0x2a, 0x0d, 0xfb, 0xf6, 0x2a, 0x6e, 0x51, 0xa0, 0x44, 0x78, 0xc6, 0xbe, 0xbd, 0xba,
+	0x9e, 0x88, 0x8c, 0x40, 0x96, 0xf0, 0xbf, 0xe4, 0x91, 0x83, 0x99, 0x81, 0xeb, 0x8e, 0xd9, 0x86,
+	0x31, 0x77, 0x9e, 0x9a, 0xbb, 0x79, 0x32, 0xd5, 0xda, 0x89, 0x51, 0x1e, 0x22, 0xb3, 0x54, 0x03,
+	0x95, 0xa6, 0xa7, 0x09, 0xd5, 0xea, 0xa5, 0x2a, 0x55, 0xc5, 0x52, 0x8e, 0x1c, 0x8b, 0x80, 0x1a,
+	0xae, 0x6d, 0x4d, 0x80, 0x4f, 0xf6, 0x5d, 0x09, 0xe3, 0x81, 0x6a, 0xa5, 0x8e, 0xad, 0x58, 0x30,
+	0xff, 0x47, 0x13, 0x64, 0xff, 0x0a, 0x0b, 0x93, 0x9d, 0x98, 0x0d, 0x84, 0x4e, 0xe4, 0xe4, 0xb7,
+	0xc7, 0xc8, 0xb4, 0x1c, 0x5c, 0x10, 0x40, 0xd5, 0xa2, 0x29, 0xd0, 0xa5, 0xe8, 0x53, 0x64, 0xa2,
+	0xbb, 0xb1, 0xc5, 0x06, 0xa1, 0xe0, 0x2f, 0x5f, 0xea, 0x60, 0x76, 0x77, 0xa7, 0x79, 0x21, 0xa1,
+	0x82, 0xf2, 0x44, 0x96, 0x25, 0x6a, 0x79, 0x96, 0x78, 0x8a, 0x34, 0x22, 0xd0, 0x20, 0x03, 0xd6,
+	0xd7, 0xd4, 0xcd, 0x2c, 0x1e, 0x12, 0x9d, 0xb4, 0xcd, 0xbc, 0xc0, 0x2e, 0x0a, 0x62, 0xe3, 0xec,
+	0x70, 0x33, 0x1a, 0xb2, 0xb5, 0xdd, 0x31, 0x43, 0x86, 0x6a, 0x04, 0x06, 0x84, 0x3e, 0x41, 0xf6,
+	0x2d, 0x8f, 0xfa, 0xdd, 0x74, 0x14, 0xe3, 0x02, 0x44, 0xde, 0xd1, 0xf4, 0x9a, 0x59, 0x81, 0x55,
+	0x90, 0x3e, 0x46, 0x88, 0x66, 0x0e, 0x64, 0x28, 0x27, 0xd7, 0x18, 0x85, 0xe8, 0x39, 0x42, 0xf8,
+	0x51, 0xa1, 0x77, 0x83, 0x25, 0xcd, 0x69, 0x1c, 0xa9, 0x07, 0x8a, 0x46, 0x4a, 0x15, 0xe4, 0xa3,
+	0x65, 0xd4, 0x44, 0xe5, 0x67, 0x18, 0xa5, 0xa6, 0x8a, 0x44, 0x50, 0x45, 0xca, 0x82, 0x85, 0xe8,
+	0x9e, 0x41, 0x41, 0x54, 0xc1, 0xb3, 0x4e, 0x86, 0xcf, 0xe5, 0x06, 0x94, 0x65, 0x72, 0xfa, 0x3c,
+	0x39, 0xc0, 0xe7, 0xe7, 0xb9, 0x84, 0x9d, 0x1b, 0xc5, 0xcb, 0x7d, 0x16, 0x0e, 0x9b, 0x47, 0x10,
+	0xe5, 0x47, 0x4a, 0x27, 0xd7, 0x28, 0xcf, 0x31, 0xcf, 0xb7, 0x03, 0x7b, 0xd6, 0xda, 0xda, 0x45,
+	0x54, 0xa2, 0xab, 0x01, 0x7c, 0xce, 0xbf, 0x91, 0xcc, 0x18, 0xbc, 0xb1, 0x97, 0x32, 0x53, 0x37,
+	0x95, 0x99, 0x67, 0xc8, 0xfe, 0xcc, 0x60, 0x99, 0xd5, 0x6b, 0xbc, 0xba, 0x6f, 0x6b, 0x32, 0xfb,
+	0x24, 0xeb, 0x40, 0x1d, 0xb3, 0xb1, 0xcb, 0xe4, 0x88, 0x9b, 0x0c, 0x07, 0x4a, 0x0f, 0xd8, 0x6d,
+	0xce, 0xc9, 0x35, 0x82, 0xf5, 0x2f, 0x87, 0x7d, 0x53, 0x35, 0x7a, 0x82, 0x4c, 0x2b, 0x38, 0x92,
+	0xbf, 0x3b, 0xc6, 0x35, 0x57, 0x0f, 0xe0, 0x13, 0x36, 0xc9, 0xb3, 0xc3, 0x1e, 0x6e, 0x7a, 0x9c,
+	0x3e, 0x99, 0xf4, 0xff, 0xad, 0x9e, 0x13, 0x36, 0x85, 0x0b, 0xd7, 0x16, 0x36, 0x95, 0x9b, 0x12,
+	0x36, 0x95, 0x9b, 0x12, 0x36, 0x15, 0x4b, 0xd8, 0x3c, 0x45, 0xf6, 0x19, 0x73, 0x2f, 0x0f, 0xeb,
+	0x47, 0xdc, 0x6c, 0x11, 0x58, 0x65, 0xe9, 0x0a, 0x99, 0x59, 0x49, 0xd2, 0xcb, 0x2c, 0xe6, 0x3a,
+	0xf4, 0x2c, 0x56, 0x7d, 0xb8, 0x78, 0x3b, 0x3a, 0x6d, 0x94, 0x16, 0x67, 0x18, 0x03, 0x42, 0x9f,
+	0x20, 0x33, 0x1a, 0x79, 0x69, 0x07, 0x38, 0x6c, 0x4a, 0x2b, 0x7e, 0x36, 0x05, 0x44, 0xcc, 0x92,
+	0xa0, 0xcd, 0x9b, 0x47, 0x93, 0xa4, 0x39, 0x69, 0x69, 0xf3, 0xd6, 0xb1, 0x05, 0xb5, 0x79, 0xab,
+	0x74, 0x56, 0x68, 0x4d, 0xe5, 0x85, 0xd6, 0x02, 0x99, 0xb9, 0x30, 0x4a, 0xd5, 0x48, 0x4f, 0xe3,
+	0x48, 0x9b, 0xa0, 0x9c, 0xcc, 0x26, 0x58, 0xc4, 0x82, 0xc1, 0xb4, 0xe9, 0x13, 0xb6, 0x2a, 0x39,
+	0xc3, 0xa7, 0x2d, 0x9f, 0x03, 0xe3, 0xa1, 0xa1, 0x49, 0x73, 0x9f, 0x35, 0x1e, 0xc6, 0x59, 0x1d,
+	0xc7, 0xc3, 0x28, 0x49, 0x57, 0xc9, 0x21, 0x7d, 0x92, 0xd5, 0xc3, 0xdf, 0x6c, 0x20, 0x6f, 0xdf,
+	0x2d, 0x0f, 0x23, 0x8e, 0x22, 0x81, 0xb3, 0x22, 0x9c, 0x51, 0xb2, 0x53, 0xb7, 0xd7, 0xb2, 0x6e,
+	0x98, 0x2b, 0x26, 0x24, 0x07, 0x1d, 0x3a, 0x85, 0x93, 0xef, 0x0f, 0x91, 0x3a, 0x16, 0x10, 0xfa,
+	0x10, 0x4f, 0xc0, 0x04, 0x5c, 0x0c, 0x93, 0x34, 0xd8, 0x19, 0xe2, 0xba, 0xe2, 0xfb, 0xaa, 0x09,
+	0xf2, 0xff, 0xd3, 0x23, 0xb3, 0x36, 0x8f, 0xe4, 0x74, 0xdd, 0x63, 0x64, 0xba, 0x9b, 0x86, 0x71,
+	0x2a, 0x96, 0x26, 0x0c, 0xbb, 0x06, 0x98, 0xcb, 0x96, 0xaf, 0x24, 0x99, 0x84, 0x7a, 0x82, 0x11,
+	0xce, 0xa4, 0x42, 0xbd, 0xd5, 0x00, 0x7a, 0x8a, 0x4c, 0x08, 0xb9, 0xcd, 0x97, 0xce, 0x9c, 0xc9,
+	0xb0, 0x38, 0xa6, 0x22, 0x1f, 0x88, 0x58, 0x8b, 0x77, 0x86, 0x1b, 0x21, 0x6f, 0x69, 0x82, 0x13,
+	0x61, 0x80, 0x32, 0x1b, 0xdc, 0x64, 0x6e, 0x83, 0x6b, 0x92, 0xc9, 0x6b, 0x7c, 0x12, 0x9a, 0xfb,
+	0x30, 0x53, 0x26, 0xfd, 0x8f, 0x54, 0xc4, 0x46, 0xef, 0xa4, 0xfc, 0x38, 0x99, 0xc2, 0xc3, 0x48,
+	0xbb, 0xc5, 0x95, 0x80, 0xc6, 0x52, 0xa5, 0xe9, 0x05, 0x0a, 0x06, 0x73, 0xb9, 0x12, 0x71, 0x09,
+	0x32, 0x1d, 0xc0, 0x27, 0x42, 0xc2, 0x1b, 0x48, 0x2d, 0x40, 0xc2, 0x1b, 0x78, 0xb6, 0x8a, 0x58,
+	0xac, 0xce, 0x56, 0x11, 0xc3, 0xf3, 0x80, 0x34, 0x10, 0x71, 0xfd, 0x5e, 0x26, 0x61, 0x5b, 0xd3,
+	0x9c, 0x74, 0x91, 0x5d, 0x63, 0x7d, 0x54, 0xf3, 0xab, 0x41, 0x16, 0x0c, 0x2b, 0xc7, 0xb2, 0xc6,
+	0x70, 0x45, 0xdf, 0x82, 0x71, 0x01, 0x16, 0xf6, 0x56, 0x87, 0xfd, 0xdd, 0xe6, 0x34, 0x2e, 0x4f,
+	0x95, 0xe6, 0x76, 0x2a, 0xb9, 0x54, 0x71, 0xef, 0x9c, 0x0a, 0x0c, 0x88, 0x1f, 0x90, 0x7d, 0xa6,
+	0xa6, 0x03, 0x6d, 0x29, 0x9d, 0x14, 0x4e, 0x4d, 0xd3, 0x86, 0xfa, 0x09, 0x34, 0xc2, 0xc8, 0x57,
+	0xb8, 0xd6, 0x87, 0x63, 0x4e, 0x49, 0xad, 0xbb, 0xa9, 0x4e, 0x00, 0xf8, 0xed, 0x1f, 0x25, 0x75,
+	0xbe, 0x7b, 0xcf, 0x91, 0x6a, 0xbb, 0x77, 0x03, 0xdb, 0xa9, 0x07, 0xf0, 0xe9, 0xbf, 0x8b, 0xcc,
+	0x65, 0xe5, 0x8d, 0x93, 0xcf, 0x29, 0xa9, 0xad, 0x8c, 0x7a, 0x4c, 0x1e, 0xbc, 0xe0, 0x1b, 0x87,
+	0x82, 0x25, 0x69, 0x34, 0xe4, 0x67, 0x6e, 0xd4, 0xbf, 0xa6, 0x03, 0x0b, 0xe6, 0x9f, 0x14, 0x7a,
+	0x47, 0xf9, 0x29, 0xf5, 0xc3, 0x1e, 0x99, 0x92, 0x96, 0xd3, 0xa2, 0xee, 0x2f, 0x84, 0xc9, 0x96,
+	0x3a, 0xf7, 0x85, 0xc9, 0x16, 0x2c, 0xbd, 0x33, 0xbd, 0x81, 0xe0, 0x83, 0xa9, 0x80, 0x27, 0xa0,
+	0x8b, 0xe0, 0x3a, 0xb4, 0x25, 0xb4, 0x39, 0x91, 0xa2, 0xaf, 0x27, 0xa4, 0x13, 0x47, 0xd7, 0xa2,
+	0x3e, 0xdb, 0x54, 0x36, 0xde, 0x43, 0x86, 0xd1, 0x56, 0x65, 0x06, 0x46, 0x39, 0xbf, 0x4d, 0x1a,
+	0x56, 0x26, 0xee, 0x73, 0xe2, 0xd0, 0x24, 0x10, 0x54, 0x69, 0x58, 0x78, 0xaa, 0x20, 0x62, 0x5a,
+	0x0f, 0x34, 0xc0, 0x7f, 0xd5, 0x23, 0x0d, 0x4b, 0x5d, 0x84, 0xd9, 0x08, 0xa2, 0x9e, 0x38, 0xe3,
+	0xc3, 0x27, 0x40, 0x56, 0xa3, 0x1e, 0xe7, 0xf9, 0x00, 0x3e, 0xa1, 0x4d, 0xac, 0x84, 0x23, 0xc2,
+	0x07, 0x58, 0x03, 0xe8, 0xeb, 0x08, 0xc1, 0xc4, 0xc5, 0x28, 0x49, 0xe5, 0xa9, 0x68, 0xce, 0x94,
+	0xb8, 0x90, 0x11, 0x18, 0x65, 0x40, 0xe7, 0xc4, 0x94, 0x54, 0xc5, 0x6c, 0x63, 0xb7, 0x99, 0x15,
+	0x58, 0x05, 0xfd, 0x13, 0x02, 0x11, 0x68, 0x06, 0x4d, 0xf1, 0xf0, 0x21, 0x38, 0x92, 0x27, 0xfc,
+	0x1e, 0x69, 0x06, 0x63, 0x73, 0xc7, 0x3d, 0x17, 0xb1, 0x7e, 0x2f, 0xc1, 0x49, 0xbd, 0x40, 0xe6,
+	0x32, 0x9b, 0xb3, 0xb4, 0xcc, 0x1c, 0xcb, 0xef, 0xdd, 0xba, 0x5e, 0x90, 0xab, 0xe5, 0x8f, 0xc8,
+	0x61, 0x67, 0x51, 0x58, 0xdd, 0x2b, 0x49, 0x6a, 0xb0, 0x8e, 0x4c, 0xd2, 0x37, 0x13, 0x02, 0x6b,
+	0x83, 0x97, 0x15, 0xc7, 0x0a, 0x47, 0xb7, 0xba, 0x4c, 0x60, 0x94, 0xf7, 0x97, 0xad, 0x0e, 0x75,
+	0x06, 0xb0, 0x9a, 0x68, 0x92, 0x0f, 0x83, 0x48, 0x19, 0xcb, 0x12, 0x24, 0x08, 0x7e, 0xfb, 0xdf,
+	0xac, 0x10, 0xa2, 0x0d, 0xb1, 0x4e, 0x1e, 0xe7, 0x52, 0xb0, 0xa2, 0xa4, 0xe0, 0xeb, 0xc9, 0x44,
+	0x37, 0xde, 0x58, 0x41, 0xe3, 0x45, 0xc5, 0xc0, 0x98, 0x37, 0x93, 0x55, 0x75, 0x44, 0x59, 0xa8,
+	0xd5, 0x62, 0x09, 0xd4, 0xaa, 0xdd, 0x4c, 0x2d, 0x5e, 0x16, 0xd8, 0xba, 0x3d, 0x4c, 0x59, 0x7c,
+	0x2d, 0xec, 0xa3, 0xc4, 0xac, 0x06, 0x2a, 0x0d, 0x93, 0xdd, 0x62, 0xfd, 0x70, 0x17, 0x65, 0x66,
+	0x35, 0xe0, 0x09, 0xa0, 0xa0, 0x15, 0x0d, 0xb8, 0xee, 0x32, 0x1d, 0xe0, 0x37, 0x7d, 0x90, 0xd4,
+	0x97, 0xc3, 0x7e, 0x1f, 0x8e, 0x24, 0x79, 0x03, 0x34, 0xe4, 0x04, 0x3c, 0x1f, 0x2a, 0x2f, 0x8f,
+	0x86, 0x3d, 0x14, 0x8e, 0xd3, 0x01, 0x7e, 0xc3, 0x76, 0xd3, 0x4e, 0xba, 0xac, 0xcf, 0x36, 0xd2,
+	0x33, 0xfd, 0xbe, 0x90, 0x8c, 0x26, 0xc8, 0x7f, 0x9c, 0xcc, 0xe8, 0x21, 0xc4, 0xde, 0x4c, 0x3e,
+	0x72, 0x98, 0xbb, 0x79, 0xbe, 0xff, 0x12, 0x39, 0xec, 0xa4, 0xbe, 0x50, 0x91, 0x95, 0x0b, 0xbc,
+	0x92, 0x59, 0xe0, 0xa7, 0xc8, 0xfe, 0xac, 0x19, 0x84, 0xef, 0x41, 0x59, 0xb0, 0x7f, 0x51, 0xce,
+	0x36, 0xd0, 0x8b, 0xe4, 0x86, 0xfd, 0xbe, 0xec, 0x07, 0x61, 0x87, 0x48, 0x1d, 0xd9, 0x45, 0x2a,
+	0x0e, 0x98, 0x40, 0x99, 0xd6, 0x8f, 0xc2, 0x44, 0xb4, 0xcb, 0x13, 0xfe, 0x0f, 0x3c, 0xfb, 0xa4,
+	0x08, 0x9b, 0x48, 0x27, 0x8e, 0x06, 0x61, 0xbc, 0xab, 0xb7, 0x05, 0x03, 0x02, 0x4b, 0xa1, 0x3b,
+	0x8a, 0x53, 0xc8, 0xac, 0x60, 0xa6, 0x4c, 0xc2, 0x28, 0x77, 0xe2, 0xd1, 0x98, 0xc5, 0x29, 0x56,
+	0xe5, 0x12, 0xc5, 0x04, 0xd1, 0x93, 0xa4, 0x21, 0x93, 0x97, 0x51, 0x3d, 0xaa, 0x61, 0x19, 0x1b,
+	0x48, 0x5f, 0x47, 0x0e, 0x82, 0xb2, 0x21, 0x3c, 0x40, 0x99, 0xb3, 0xbf, 0x2b, 0x8b, 0x3e, 0x40,
+	0x66, 0x97, 0x47, 0x83, 0x71, 0xb8, 0x01, 0x29, 0x75, 0x22, 0xae, 0x07, 0x19, 0xa8, 0x7f, 0x5d,
+	0xa8, 0x91, 0x5c, 0xf0, 0xc0, 0x22, 0x5b, 0x1b, 0x6d, 0xb3, 0x61, 0x22, 0x54, 0x37, 0x91, 0x82,
+	0x21, 0xc0, 0xaf, 0xe8, 0x65, 0x16, 0x27, 0x62, 0x07, 0x34, 0x20, 0x45, 0x08, 0x56, 0x0b, 0x11,
+	0xf4, 0x9f, 0xb4, 0x45, 0x23, 0x3d, 0x65, 0xf3, 0x17, 0xcd, 0xcb, 0x48, 0xc9, 0x60, 0xbf, 0x44,
+	0xc9, 0xe4, 0xf2, 0x68, 0x30, 0x08, 0x87, 0x3d, 0xfa, 0x20, 0xa9, 0xa5, 0x40, 0x1c, 0xcc, 0xf5,
+	0xac, 0x71, 0x98, 0xc7, 0xdc, 0xd3, 0x40, 0x61, 0x80, 0x05, 0xfc, 0xbf, 0x3f, 0xc0, 0xc5, 0x04,
+	0x3d, 0x4a, 0x0e, 0x2f, 0xc7, 0x2c, 0x4c, 0x99, 0xe4, 0x33, 0x51, 0x78, 0xae, 0x4a, 0xef, 0x22,
+	0x07, 0x5b, 0xf1, 0x68, 0x9c, 0xcd, 0xa8, 0xd1, 0x05, 0x72, 0x8c, 0xd7, 0xc9, 0x30, 0x9e, 0x2c,
+	0x51, 0xa7, 0xc7, 0xc9, 0x3c, 0x54, 0x2d, 0xc8, 0x9f, 0xa0, 0x27, 0xc9, 0x42, 0x97, 0xa5, 0x6e,
+	0xf3, 0x9d, 0x2c, 0x35, 0x09, 0xfd, 0x3c, 0x37, 0xee, 0x15, 0xf7, 0x33, 0x45, 0xef, 0x26, 0x77,
+	0x71, 0x4c, 0xb4, 0x36, 0x2b, 0x33, 0xa7, 0x21, 0x93, 0xab, 0x35, 0xf9, 0x4c, 0x42, 0x0f, 0x93,
+	0x03, 0xbc, 0x26, 0xec, 0xb0, 0x12, 0xdc, 0xa0, 0x07, 0xc9, 0x7e, 0x40, 0xdc, 0x04, 0xce, 0x42,
+	0x59, 0x8e, 0x87, 0x09, 0xde, 0x0f, 0xe3, 0xd3, 0x65, 0xa9, 0xda, 0x63, 0x65, 0xc6, 0x1c, 0xa5,
+	0x64, 0x16, 0xa8, 0x0b, 0xd3, 0x50, 0xc2, 0x0e, 0xd0, 0x63, 0xa4, 0xd9, 0x65, 0x29, 0x6a, 0x09,
+	0xb9, 0x1a, 0x94, 0xde, 0x43, 0x8e, 0x0a, 0x3a, 0x0c, 0x75, 0x48, 0x66, 0x1f, 0x46, 0x4a, 0xe2,
+	0xd1, 0xd8, 0x95, 0x79, 0x44, 0xcf, 0xa0, 0xf4, 0x98, 0xca, 0xac, 0xa6, 0x3d, 0xb9, 0x66, 0xd6,
+	0x51, 0xc8, 0xe2, 0x34, 0x65, 0xb3, 0xe6, 0x21, 0x8b, 0x8f, 0x5b, 0xb6, 0xc1, 0xbb, 0x75, 0x56,
+	0xb6, 0xd6, 0x31, 0x7a, 0x84, 0xd0, 0x2e, 0x4b, 0xb3, 0x55, 0xee, 0xa1, 0x87, 0xc8, 0x1c, 0xe2,
+	0x0e, 0x73, 0x20, 0xa1, 0xc7, 0x81, 0x60, 0x54, 0x3b, 0x05, 0x6f, 0xf1, 0x46, 0x65, 0xf6, 0xbd,
+	0x40, 0x30, 0xc7, 0x4e, 0xab, 0x6f, 0x32, 0xf3, 0x3e, 0x60, 0x1e, 0xa8, 0x9b, 0x61, 0x0a, 0xbb,
+	0x89, 0x07, 0x61, 0xc0, 0xe5, 0xb0, 0x28, 0xb9, 0x2b, 0x73, 0x1f, 0x03, 0xac, 0xce, 0xf4, 0x53,
+	0x16, 0x4b, 0x6d, 0x76, 0x79, 0xd0, 0x9b, 0x5b, 0x84, 0x89, 0x0e, 0x78, 0x97, 0xd1, 0x70, 0x53,
+	0x16, 0x7e, 0x3d, 0x4c, 0xb4, 0xc0, 0x06, 0x2d, 0x19, 0x32, 0xe3, 0x0d, 0x90, 0x11, 0xb0, 0xf1,
+	0x28, 0x4e, 0xf9, 0xa1, 0x45, 0x66, 0x3c, 0x0e, 0x83, 0xd1, 0x89, 0x77, 0x86, 0x8c, 0x9f, 0x31,
+	0x25, 0xfc, 0x8d, 0xc0, 0xd1, 0x80, 0xba, 0x81, 0x92, 0x8d, 0xf6, 0x53, 0x74, 0x9e, 0x1c, 0x81,
+	0xe1, 0x72, 0x20, 0xfd, 0x26, 0x40, 0x1a, 0x44, 0x47, 0x10, 0x0e, 0x35, 0xef, 0xbc, 0x99, 0x36,
+	0xc9, 0x21, 0xec, 0x5e, 0x8a, 0x12, 0x99, 0xf3, 0x16, 0xbd, 0x00, 0xf4, 0x79, 0x57, 0x66, 0x3e,
+	0x0d, 0x4b, 0xd4, 0x18, 0x62, 0x10, 0x25, 0x70, 0x4a, 0x91, 0xf9, 0x6f, 0xd5, 0x53, 0x00, 0xd3,
+	0xc9, 0x1d, 0x08, 0x32, 0xf3, 0x6d, 0x40, 0x1f, 0x1f, 0x5c, 0x74, 0x29, 0x4b, 0xf8, 0x19, 0x80,
+	0xf3, 0x4a, 0x16, 0x7c, 0x49, 0x8f, 0x20, 0x77, 0xb6, 0xc8, 0x8c, 0x65, 0xa8, 0x10, 0xb0, 0xc1,
+	0xe8, 0x9a, 0x5d, 0xa1, 0x45, 0x4f, 0x90, 0x7b, 0x04, 0xe7, 0x66, 0x8e, 0xd8, 0xb2, 0xc8, 0x59,
+	0x7a, 0x2f, 0xb9, 0x1b, 0xc5, 0x53, 0x41, 0x81, 0x73, 0x40, 0xe1, 0x79, 0x96, 0x16, 0xe5, 0x9f,
+	0x37, 0x56, 0xc7, 0x3a, 0x77, 0x50, 0xca, 0xac, 0x0b, 0xf4, 0x35, 0xe4, 0xfe, 0xf3, 0xc0, 0xcc,
+	0xd6, 0x8e, 0x7d, 0x25, 0x4a, 0xb7, 0x22, 0x68, 0x8b, 0x05, 0x6a, 0x1c, 0xdb, 0xc0, 0x8d, 0xc6,
+	0x38, 0x1a, 0x27, 0x31, 0x83, 0xce, 0xb7, 0xc3, 0x00, 0xc0, 0xc4, 0xaf, 0x85, 0xdb, 0x6c, 0x74,
+	0x4d, 0x0f, 0xf3, 0x33, 0x32, 0x43, 0x7a, 0xde, 0x65, 0xc6, 0x45, 0xc8, 0x10, 0x22, 0x81, 0x6f,
+	0xe5, 0x22, 0x63, 0x05, 0x98, 0x14, 0x17, 0x94, 0x05, 0xbe, 0x44, 0x7d, 0x72, 0x3c, 0x8f, 0x32,
+	0x6e, 0xda, 0xb2, 0xcc, 0x2a, 0x50, 0x7c, 0x99, 0xc5, 0xd1, 0xd5, 0xdd, 0xec, 0xf2, 0xed, 0x40,
+	0x77, 0x67, 0x6f, 0x8c, 0xc3, 0x61, 0xcf, 0x66, 0xd9, 0x67, 0x81, 0x21, 0xe5, 0xd4, 0x09, 0x9b,
+	0x86, 0xcc, 0x0b, 0xa0, 0x3d, 0x18, 0xe1, 0xa5, 0xa5, 0x38, 0x62, 0x57, 0x4d, 0x82, 0xbb, 0x62,
+	0xf0, 0x4d, 0x7d, 0xdc, 0xcc, 0x5f, 0x83, 0x95, 0x10, 0xb0, 0xcd, 0x08, 0xf6, 0x40, 0xe1, 0xd1,
+	0x5d, 0xbd, 0x7a, 0x35, 0x61, 0x8a, 0x05, 0x9e, 0xd3, 0xbb, 0x4c, 0xc6, 0x1a, 0x22, 0x4b, 0x5c,
+	0x46, 0x99, 0xfa, 0x52, 0x7f, 0x11, 0x64, 0xce, 0x05, 0x16, 0xc6, 0xe9, 0x3a, 0x0b, 0x55, 0xfd,
+	0x2b, 0x58, 0xdf, 0xae, 0xc9, 0xd7, 0xaa, 0x2c, 0xf1, 0xbf, 0xc4, 0x90, 0x65, 0x0a, 0x5d, 0x64,
+	0xc6, 0x5e, 0xf7, 0xbf, 0xe5, 0x4e, 0x56, 0x80, 0xc3, 0x3b, 0x80, 0x0b, 0x2f, 0x8d, 0xd2, 0xe8,
+	0xea, 0xee, 0xf2, 0xb3, 0xbc, 0x26, 0xba, 0xf2, 0x95, 0xa4, 0x7b, 0x1e, 0x38, 0xb9, 0xcb, 0x52,
+	0x5c, 0x44, 0xb6, 0x3b, 0x4e, 0x16, 0x79, 0x27, 0x17, 0x3b, 0xb0, 0x08, 0xcc, 0x29, 0xf9, 0x3f,
+	0x40, 0x9e, 0xdc, 0xfe, 0x94, 0x6f, 0x59, 0xe6, 0xbe, 0x0b, 0x24, 0xa8, 0x5e, 0x9f, 0x6b, 0x83,
+	0x31, 0xae, 0x71, 0x99, 0xfd, 0x7f, 0x41, 0x2a, 0x08, 0xf6, 0xe1, 0x2e, 0x7e, 0x99, 0xf3, 0x82,
+	0xb1, 0xf0, 0x79, 0x8e, 0x8d, 0x4d, 0x08, 0x4b, 0xb2, 0x3d, 0x4c, 0x58, 0x9c, 0x9e, 0x8b, 0xfa,
+	0x4c, 0xc1, 0xd7, 0x35, 0x3a, 0x0e, 0xd9, 0xc4, 0x60, 0x1c, 0x64, 0x2e, 0x67, 0x2d, 0xbb, 0xd9,
+	0xab, 0xb8, 0x3f, 0x6c, 0x8d, 0xae, 0x0b, 0xbd, 0x47, 0xc2, 0x37, 0x01, 0x51, 0x44, 0x3d, 0x2b,
+	0xbe, 0xb6, 0x80, 0xf3, 0x50, 0x46, 0x9b, 0xe7, 0x9f, 0xb5, 0x8b, 0x20, 0xa9, 0x23, 0x4d, 0xbd,
+	0x19, 0x6b, 0x20, 0xab, 0xbe, 0xf8, 0xd0, 0xd4, 0x54, 0x6f, 0xee, 0x95, 0x57, 0x5e, 0x79, 0xa5,
+	0xe2, 0xff, 0x6d, 0xa5, 0x40, 0xc1, 0x71, 0xea, 0xdf, 0xad, 0xbc, 0x8e, 0xcd, 0x6d, 0xdc, 0x65,
+	0x7e, 0xc3, 0x6c, 0x15, 0xd0, 0x0e, 0xa5, 0xc9, 0x78, 0x67, 0x80, 0x4a, 0x5f, 0x23, 0x30, 0x20,
+	0xf4, 0x7e, 0x52, 0xed, 0x6e, 0x47, 0x68, 0x22, 0x28, 0xf0, 0x30, 0x41, 0xbe, 0xc3, 0xbf, 0x57,
+	0x77, 0xfa, 0xf7, 0x6e, 0xc5, 0x87, 0xb7, 0x78, 0x8e, 0x4c, 0x6e, 0x88, 0x01, 0x98, 0xb5, 0xd5,
+	0xc3, 0xe6, 0x26, 0x56, 0x96, 0x47, 0x36, 0xe7, 0xa0, 0x05, 0xb2, 0xb2, 0x3f, 0x72, 0x2a, 0x87,
+	0xae, 0x41, 0x5d, 0x6c, 0x15, 0x77, 0xb9, 0x65, 0x0d, 0xae, 0xa3, 0x41, 0xdd, 0xe1, 0x3f, 0x7b,
+	0xe5, 0x5a, 0x67, 0xa9, 0x71, 0xc4, 0x39, 0xaf, 0x95, 0x5b, 0x9d, 0x57, 0xb4, 0x6d, 0x72, 0x95,
+	0xb5, 0x23, 0xec, 0x3e, 0x1a, 0xb0, 0xb8, 0x52, 0x4c, 0x66, 0x84, 0x64, 0xde, 0x67, 0x8d, 0xac,
+	0x9b, 0x0a, 0x4d, 0xef, 0xc7, 0xbc, 0x32, 0x1d, 0xba, 0x94, 0x5a, 0x39, 0x09, 0x15, 0x63, 0x12,
+	0x9e, 0x29, 0xc6, 0xee, 0x45, 0xc4, 0xee, 0x84, 0x31, 0x09, 0x7b, 0xe1, 0xf6, 0x69, 0x6f, 0x6f,
+	0xfd, 0xfd, 0x96, 0x31, 0x7c, 0xb6, 0x18, 0xc3, 0x6d, 0xc4, 0xf0, 0x41, 0xb9, 0x52, 0xf6, 0xe8,
+	0x59, 0xe3, 0xf9, 0xe5, 0x6a, 0xf9, 0x09, 0xe2, 0x56, 0x71, 0x84, 0xa3, 0xed, 0x25, 0x76, 0x5d,
+	0x98, 0xc3, 0x30, 0xa6, 0x43, 0x24, 0x2d, 0x17, 0x54, 0x2d, 0xe3, 0xef, 0x36, 0x5d, 0x4a, 0xf5,
+	0x8c, 0xff, 0xda, 0xed, 0x9e, 0x9a, 0x28, 0xf4, 0x85, 0xa3, 0xff, 0x65, 0x9b, 0x89, 0x01, 0x40,
+	0x3b, 0x31, 0xfa, 0x5f, 0x14, 0x28, 0xef, 0x7f, 0xf1, 0xf6, 0xf6, 0xbf, 0x78, 0x37, 0xed, 0x7f,
+	0xf1, 0xdc, 0xfe, 0x97, 0x32, 0xee, 0xef, 0x5b, 0xdc, 0x5f, 0x36, 0x1f, 0x7a, 0xe6, 0x7e, 0xae,
+	0x52, 0x78, 0xb2, 0x2b, 0x9d, 0xb4, 0x23, 0x64, 0xc2, 0x0a, 0x11, 0x99, 0xd0, 0x4b, 0x17, 0x54,
+	0xe7, 0x24, 0x0d, 0x07, 0x63, 0xe1, 0xb2, 0xd0, 0x00, 0x74, 0x76, 0x40, 0x37, 0x68, 0xb3, 0xaf,
+	0xf1, 0x38, 0x59, 0x05, 0xc8, 0x38, 0x1a, 0xea, 0x2e, 0x47, 0x83, 0xd0, 0x8c, 0x70, 0x7c, 0x1a,
+	0x81, 0x4c, 0x2e, 0x5e, 0x28, 0x1e, 0x94, 0x01, 0x0e, 0xca, 0x71, 0x4b, 0x24, 0xe4, 0x48, 0xd5,
+	0xe3, 0xf1, 0x23, 0xaf, 0xf0, 0x30, 0x7b, 0x5b, 0xe3, 0xe1, 0x0b, 0x43, 0xbf, 0x8c, 0x6b, 0xe5,
+	0xb1, 0xcb, 0x16, 0xcc, 0x76, 0xe5, 0x70, 0x8e, 0x34, 0x5c, 0x39, 0xc7, 0x09, 0xe1, 0x09, 0xe5,
+	0x7e, 0xa9, 0x07, 0x06, 0xa4, 0x8c, 0xf6, 0xa1, 0x45, 0x7b, 0x01, 0x59, 0x9a, 0xf6, 0xcf, 0x79,
+	0x8e, 0xb3, 0xfa, 0x9d, 0x31, 0xd4, 0x2f, 0x2e, 0x15, 0x63, 0xfd, 0x12, 0x62, 0xdd, 0xb4, 0x66,
+	0xcc, 0x40, 0x48, 0xe3, 0xbb, 0x99, 0xb3, 0x21, 0x38, 0xb7, 0xc5, 0xb7, 0x15, 0x77, 0x15, 0x63,
+	0x57, 0x47, 0x0c, 0x89, 0xec, 0xec, 0xe8, 0x3d, 0x0e, 0xbb, 0xc4, 0xcd, 0x8e, 0x4b, 0x19, 0xa5,
+	0x89, 0x45, 0x69, 0xae, 0x0b, 0x8d, 0xc0, 0xe7, 0x3d, 0xa7, 0x09, 0x04, 0x38, 0x12, 0xca, 0x0f,
+	0x35, 0x1e, 0x2a, 0x5d, 0x6a, 0xe2, 0xb4, 0x7c, 0x18, 0xd5, 0x8c, 0x0f, 0xa3, 0x4c, 0x8f, 0x48,
+	0x2d, 0x3d, 0xc2, 0x81, 0x92, 0xc6, 0x39, 0xce, 0x1a, 0x67, 0xe8, 0xbd, 0x3c, 0xec, 0x5f, 0x04,
+	0xbe, 0xcd, 0x18, 0x41, 0xb2, 0x01, 0x66, 0x2c, 0xbe, 0xb5, 0xb8, 0xe3, 0x1d, 0xec, 0xf8, 0xb0,
+	0xb1, 0x33, 0xe9, 0x86, 0x75, 0x9f, 0x1f, 0xf1, 0x8a, 0xad, 0x3f, 0xa5, 0x83, 0xa5, 0x98, 0xb7,
+	0x62, 0x30, 0xef, 0x62, 0xbb, 0x18, 0x9f, 0x6b, 0x88, 0xcf, 0xbd, 0x1a, 0x1f, 0x67, 0x9f, 0x96,
+	0x5c, 0x29, 0xb6, 0x3c, 0xdd, 0x39, 0x13, 0xb5, 0xf2, 0xe8, 0xd5, 0x4a, 0x3c, 0x7a, 0xf5, 0xbc,
+	0x47, 0x6f, 0xf1, 0xed, 0xc5, 0xa4, 0xef, 0x22, 0xe9, 0x0b, 0xb6, 0x44, 0xcd, 0x13, 0xa5, 0x69,
+	0xff, 0xba, 0x57, 0x68, 0x56, 0xbb, 0x73, 0x94, 0x97, 0xc9, 0xc5, 0x97, 0x6d, 0xb9, 0xe8, 0x46,
+	0x4d, 0xe3, 0xff, 0x6d, 0xaf, 0xc0, 0xf2, 0x07, 0x98, 0x5e, 0x58, 0x5b, 0xeb, 0x60, 0xc0, 0xa8,
+	0x60, 0x29, 0x99, 0x36, 0x03, 0x56, 0xf9, 0xe0, 0x67, 0x02, 0x56, 0x31, 0x87, 0x93, 0x27, 0x93,
+	0x18, 0x38, 0x0a, 0x08, 0xf2, 0x5d, 0x02, 0xbf, 0xcb, 0x0e, 0x12, 0xef, 0x76, 0x1c, 0x24, 0x32,
+	0x28, 0x6a, 0x2a, 0xbe, 0xea, 0x15, 0x18, 0x29, 0xf7, 0xa2, 0xa2, 0x04, 0xd7, 0x4c, 0x90, 0xab,
+	0x88, 0x3e, 0x9d, 0x91, 0xd1, 0xa7, 0x65, 0xb8, 0xff, 0xbf, 0x82, 0x43, 0x90, 0x13, 0xf7, 0x2b,
+	0xa4, 0x21, 0xf3, 0xd0, 0x7e, 0xa5, 0x22, 0x84, 0x01, 0xdd, 0x7d, 0x22, 0x42, 0xf8, 0x18, 0x99,
+	0xc6, 0x4c, 0xc3, 0x2b, 0xa7, 0x01, 0x3a, 0xe6, 0xb7, 0x6a, 0xc4, 0xfc, 0xfa, 0xa3, 0x02, 0x13,
+	0x6c, 0x36, 0x58, 0xa1, 0x8c, 0x92, 0xf7, 0x58, 0x94, 0x38, 0x9b, 0xd3, 0x94, 0x8c, 0x0b, 0x0c,
+	0xbb, 0xb9, 0x0e, 0xcf, 0x17, 0x77, 0xf8, 0x8a, 0xe7, 0xe8, 0xb1, 0x70, 0xec, 0xce, 0x81, 0x52,
+	0x9c, 0x8c, 0x47, 0xc3, 0x04, 0xe7, 0x67, 0xf5, 0x19, 0xec, 0x64, 0x2a, 0xa8, 0xac, 0x3e, 0x03,
+	0x83, 0x72, 0x36, 0x8e, 0x47, 0xb1, 0xf0, 0xac, 0xf0, 0x84, 0xbe, 0x82, 0xc5, 0xa3, 0x0b, 0x78,
+	0xc2, 0xff, 0x86, 0xe7, 0x32, 0x3c, 0xff, 0x54, 0x96, 0x40, 0xc9, 0x86, 0xf4, 0x5e, 0x3e, 0x16,
+	0x47, 0xb5, 0x20, 0x2e, 0x1c, 0xfa, 0xab, 0x79, 0x03, 0x79, 0x6e, 0xd4, 0x4b, 0x36, 0xeb, 0xf7,
+	0xf1, 0x9e, 0xee, 0x32, 0xa5, 0x86, 0xd1, 0x94, 0xee, 0xe7, 0xdd, 0x25, 0x26, 0x77, 0xa7, 0x82,
+	0x52, 0x72, 0x64, 0x7c, 0xbf, 0x67, 0x09, 0xdb, 0xc2, 0x76, 0x75, 0xef, 0xdf, 0xf3, 0x0a, 0x4d,
+	0xfa, 0xe8, 0x30, 0xe4, 0x81, 0x8c, 0xd8, 0x7f, 0x35, 0x90, 0x49, 0xc8, 0xe1, 0x71, 0x37, 0x3d,
+	0xb1, 0x72, 0x64, 0x12, 0x14, 0xb8, 0xd6, 0xba, 0x38, 0x88, 0xa1, 0x62, 0xcb, 0x53, 0xa8, 0xd8,
+	0x8d, 0x11, 0xce, 0xa7, 0x56, 0xa4, 0xca, 0xf6, 0xcc, 0xff, 0xef, 0x59, 0x72, 0xb7, 0x00, 0x4b,
+	0x4d, 0xca, 0x67, 0xbc, 0xbd, 0x1d, 0x10, 0xb7, 0x7c, 0xfa, 0x0d, 0x8a, 0xf1, 0xfb, 0x59, 0xcf,
+	0x3a, 0xfe, 0xee, 0xd5, 0xb5, 0x46, 0xf4, 0x07, 0xd5, 0x62, 0x1f, 0x08, 0x0e, 0xe0, 0x92, 0x31,
+	0xe7, 0x22, 0x65, 0x0c, 0x60, 0xc5, 0x1c, 0x40, 0x85, 0x74, 0xd5, 0xd8, 0x11, 0x6f, 0xd2, 0x90,
+	0x75, 0x92, 0x54, 0xda, 0x41, 0x69, 0xec, 0x72, 0xa5, 0x1d, 0xdc, 0xb9, 0x80, 0xe5, 0x45, 0x42,
+	0xb8, 0xe3, 0x06, 0xab, 0x4d, 0x59, 0xfe, 0x54, 0x74, 0x7c, 0xf3, 0xdc, 0xc0, 0x28, 0x65, 0xc6,
+	0x0b, 0x4f, 0x97, 0xc7, 0x0b, 0xdf, 0x7c, 0x4c, 0xb2, 0x08, 0xfe, 0x9d, 0x51, 0xc1, 0xbf, 0x65,
+	0xda, 0xcc, 0x87, 0x3c, 0x4b, 0x93, 0x2b, 0x9a, 0x46, 0x3d, 0xd9, 0xdf, 0xf4, 0xf2, 0x2e, 0xad,
+	0x9f, 0xe2, 0x24, 0x97, 0x89, 0xa8, 0x0f, 0xdb, 0x22, 0x2a, 0x8b, 0xa5, 0xa6, 0xe1, 0xfb, 0x4a,
+	0x48, 0xb4, 0xd6, 0x3b, 0xa9, 0x65, 0x41, 0x46, 0x57, 0x7c, 0x98, 0x6c, 0xeb, 0xe8, 0x2d, 0x9e,
+	0x52, 0x51, 0x5d, 0x3d, 0x11, 0xbc, 0x22, 0x52, 0x20, 0x42, 0x5b, 0x4b, 0x82, 0x90, 0x4a, 0x6b,
+	0x09, 0xd2, 0x9d, 0x35, 0x11, 0xd1, 0x5b, 0xe9, 0xac, 0xe9, 0x3d, 0xa6, 0x6e, 0xec, 0x31, 0x65,
+	0x62, 0xe2, 0x23, 0x2e, 0x31, 0x91, 0xc3, 0x53, 0x13, 0xf3, 0xaf, 0x9e, 0xc3, 0x9b, 0xb8, 0xd7,
+	0x61, 0xdd, 0x39, 0x2b, 0x37, 0x79, 0x58, 0xef, 0x8e, 0xfb, 0x11, 0x8f, 0xd7, 0x14, 0x71, 0x97,
+	0x0a, 0x40, 0x17, 0x44, 0xb4, 0xf0, 0xd2, 0x68, 0x67, 0xd8, 0x93, 0x9a, 0xb5, 0x09, 0x5a, 0x5c,
+	0x2e, 0x26, 0xfc, 0xa3, 0x9e, 0x75, 0x1e, 0xcc, 0xd1, 0xa4, 0x49, 0xfe, 0x27, 0xcf, 0xe9, 0x29,
+	0xbd, 0x2d, 0xa2, 0x17, 0xc8, 0x8c, 0xc1, 0xee, 0x62, 0x22, 0x4d, 0x10, 0x7d, 0x92, 0x34, 0x70,
+	0xf9, 0xae, 0x8d, 0xf8, 0xea, 0x10, 0x21, 0x68, 0xae, 0xa5, 0x6d, 0x17, 0x5c, 0x3c, 0x5b, 0x4c,
+	0xec, 0xc7, 0x3c, 0xeb, 0x28, 0xe9, 0xa0, 0x46, 0x93, 0xbb, 0x41, 0x66, 0x8c, 0x4e, 0x60, 0x0a,
+	0x30, 0x69, 0xac, 0x37, 0x0d, 0x50, 0xb9, 0x4a, 0x0d, 0xac, 0x07, 0x1a, 0x60, 0x07, 0xd4, 0x5a,
+	0x71, 0xf0, 0x57, 0x44, 0xe8, 0x9b, 0x33, 0x56, 0x75, 0x3e, 0x1b, 0xab, 0x6a, 0xc4, 0xa9, 0xda,
+	0xb1, 0x9e, 0xd5, 0x5c, 0xac, 0xe7, 0xb7, 0x3c, 0x32, 0x6b, 0x07, 0x46, 0xff, 0x94, 0x82, 0x80,
+	0x1f, 0x12, 0x81, 0xb0, 0x2c, 0x1b, 0x05, 0xac, 0xe8, 0x0c, 0x64, 0x81, 0xbd, 0x36, 0x05, 0xff,
+	0xbd, 0x9e, 0xe0, 0x6c, 0x71, 0xc5, 0x4d, 0xa9, 0x12, 0x92, 0x0c, 0x99, 0x54, 0x36, 0xbe, 0x6e,
+	0xf4, 0x32, 0x13, 0xa2, 0x42, 0x03, 0x70, 0x81, 0xe0, 0x45, 0xad, 0xe5, 0xd1, 0x8e, 0xe0, 0xb6,
+	0x7a, 0x60, 0x82, 0x30, 0xc0, 0x2f, 0xbc, 0x61, 0x2c, 0x2f, 0x99, 0xf4, 0x9f, 0x27, 0x8d, 0x60,
+	0x6c, 0x22, 0xa1, 0x59, 0xda, 0xb3, 0x58, 0x7a, 0x51, 0x84, 0xa3, 0x42, 0xb1, 0x44, 0x38, 0x20,
+	0xa8, 0x29, 0x50, 0x79, 0xfd, 0xc0, 0x28, 0xe5, 0xbf, 0x40, 0x48, 0x6b, 0x49, 0xca, 0x18, 0x21,
+	0xd4, 0x3c, 0x25, 0xd4, 0xf8, 0xbd, 0x48, 0x79, 0x2d, 0x14, 0xbf, 0xe9, 0x69, 0x32, 0x19, 0x8c,
+	0x79, 0x17, 0x55, 0x2b, 0xd0, 0xd4, 0x42, 0x32, 0x90, 0x85, 0xfc, 0x5f, 0xf4, 0xc8, 0x5d, 0x66,
+	0x14, 0xc3, 0xc5, 0x51, 0xa8, 0xf4, 0x50, 0x7e, 0x7b, 0x72, 0x0d, 0x0a, 0x66, 0x02, 0xdd, 0x34,
+	0x52, 0x81, 0x2a, 0x52, 0x26, 0x3d, 0x3f, 0x6e, 0x4b, 0xcf, 0x82, 0x0e, 0xf5, 0xda, 0xfa, 0xae,
+	0xe7, 0x8e, 0xcb, 0xa7, 0xaf, 0x93, 0x61, 0x7e, 0x9e, 0x75, 0x0d, 0x4f, 0x97, 0x5d, 0x1d, 0xb3,
+	0x38, 0x4c, 0x47, 0x71, 0x22, 0xe3, 0xfd, 0xce, 0x13, 0x9a, 0x69, 0x29, 0x62, 0x32, 0x10, 0xf3,
+	0xae, 0x82, 0xf8, 0xfe, 0xc0, 0x51, 0xc5, 0xb2, 0xf1, 0x57, 0x33, 0xd7, 0x4c, 0xf4, 0xf6, 0xc4,
+	0x2f, 0xa4, 0x8a, 0x94, 0xff, 0x6e, 0x32, 0x97, 0x6d, 0x9b, 0x3e, 0x40, 0x66, 0x65, 0x8c, 0x80,
+	0x88, 0x7a, 0xe4, 0x6a, 0x6f, 0x06, 0x0a, 0x72, 0x1f, 0x18, 0x4c, 0x95, 0xe2, 0x2b, 0xd0, 0x82,
+	0x01, 0x5b, 0x5f, 0x09, 0x53, 0x16, 0xc3, 0xc2, 0x96, 0x86, 0x6d, 0x05, 0xf0, 0xdb, 0xe4, 0xa0,
+	0x63, 0x60, 0x00, 0xd9, 0x33, 0x9b, 0x9b, 0xab, 0x63, 0x15, 0x3b, 0xca, 0x53, 0x52, 0x4e, 0x1b,
+	0x27, 0x55, 0x95, 0xf6, 0xdf, 0x43, 0x8e, 0xb9, 0xe6, 0xe3, 0x4a, 0x94, 0x6e, 0xb5, 0xd6, 0x83,
+	0x31, 0x7d, 0x94, 0xd4, 0x50, 0xbf, 0xe2, 0x56, 0xb4, 0xd2, 0x7b, 0x13, 0x58, 0xd0, 0xd0, 0xe0,
+	0x2b, 0x05, 0x1a, 0x7c, 0xd5, 0x5c, 0x3d, 0xfe, 0xf3, 0xe4, 0x78, 0x7e, 0x4e, 0x2c, 0x14, 0xde,
+	0x68, 0xc7, 0xcc, 0xdd, 0x57, 0x82, 0x83, 0xac, 0x23, 0x83, 0xe8, 0xd6, 0xc8, 0x7c, 0x26, 0x7e,
+	0x83, 0x4b, 0x7e, 0x1e, 0xec, 0xf9, 0xb8, 0xdd, 0xf0, 0x82, 0xb9, 0x66, 0x5d, 0x35, 0x64, 0xab,
+	0x23, 0x72, 0xb4, 0xb0, 0x0c, 0x7d, 0x2d, 0xa9, 0xb7, 0x7b, 0xb0, 0xb5, 0xf1, 0x11, 0x3b, 0x62,
+	0x5d, 0x85, 0x80, 0x8c, 0xe8, 0x6a, 0xc4, 0xe2, 0x80, 0x17, 0xa2, 0x27, 0x49, 0xc3, 0xb8, 0x0c,
+	0x70, 0x4d, 0x32, 0x83, 0x0d, 0xf4, 0x7f, 0xc6, 0x73, 0x05, 0x1e, 0x81, 0x14, 0xd5, 0xca, 0x82,
+	0x38, 0x67, 0x1b, 0x10, 0x15, 0xfc, 0x2b, 0x6e, 0xcb, 0x95, 0x1d, 0x6c, 0x7f, 0xc5, 0x3e, 0xd8,
+	0xe6, 0x3b, 0xd3, 0x4b, 0xf8, 0x3b, 0x5e, 0x79, 0xb4, 0xd3, 0x6d, 0x39, 0x2e, 0xf6, 0x54, 0x0b,
+	0x16, 0x2f, 0x15, 0x23, 0xff, 0x09, 0xcf, 0x72, 0x45, 0x95, 0x21, 0xa7, 0xc9, 0xf8, 0x8a, 0x57,
+	0x14, 0x92, 0x75, 0x87, 0x08, 0x28, 0xb1, 0x10, 0xfe, 0x2a, 0x27, 0xe0, 0x1e, 0xe3, 0xb0, 0x5f,
+	0x76, 0x26, 0xf8, 0x2f, 0x8f, 0x34, 0x44, 0x2c, 0x46, 0xcc, 0x83, 0x8e, 0x8f, 0xf1, 0x97, 0x5a,
+	0xb8, 0x1d, 0x85, 0xef, 0x90, 0x1a, 0x60, 0xdc, 0x90, 0x30, 0x75, 0xe9, 0x16, 0xe8, 0xca, 0x9d,
+	0xb4, 0xdd, 0xe3, 0x1b, 0x4a, 0x23, 0xe0, 0x09, 0xfa, 0x38, 0x99, 0x96, 0xe2, 0x4f, 0x86, 0xff,
+	0x37, 0xad, 0x95, 0x21, 0x32, 0xc5, 0xe3, 0x35, 0xb2, 0xa8, 0x36, 0x79, 0xd5, 0xcd, 0x6b, 0xee,
+	0x4f, 0x91, 0x19, 0x23, 0x90, 0x48, 0x5c, 0x68, 0x6b, 0x66, 0xde, 0xc1, 0x51, 0xf9, 0x81, 0x59,
+	0x18, 0xf0, 0xde, 0xe0, 0x6f, 0x85, 0x4c, 0x72, 0xe1, 0xcb, 0x53, 0xfe, 0xa7, 0xbc, 0x7c, 0xc4,
+	0xdc, 0x6d, 0x4d, 0x9a, 0xa1, 0x56, 0x54, 0x2d, 0xb5, 0xa2, 0xec, 0xd8, 0xf3, 0x6b, 0xf6, 0xb1,
+	0x27, 0x8b, 0x88, 0x9e, 0xa6, 0x4f, 0x78, 0xee, 0x10, 0x3e, 0x6d, 0xf1, 0xf2, 0xcc, 0x47, 0x87,
+	0xe6, 0x48, 0xb5, 0x93, 0x4a, 0x7d, 0x0f, 0x3e, 0x01, 0xed, 0x21, 0x3f, 0x03, 0x71, 0xd3, 0x98,
+	0x48, 0x95, 0x59, 0x07, 0x7f, 0xdd, 0xb3, 0xee, 0xb7, 0xb9, 0xba, 0x37, 0xad, 0x83, 0x54, 0xe6,
+	0xb5, 0x18, 0x37, 0x48, 0x8f, 0x62, 0x18, 0xc8, 0xb5, 0x88, 0xc5, 0x6b, 0x32, 0xe0, 0xb8, 0x16,
+	0xa8, 0x34, 0xdf, 0xba, 0x8c, 0xc8, 0x67, 0xb5, 0x75, 0x19, 0x31, 0xd9, 0x25, 0xdb, 0xa9, 0xff,
+	0x1f, 0x15, 0x75, 0xb9, 0x55, 0x4a, 0xc2, 0x12, 0xdd, 0x2e, 0x7b, 0x40, 0xaa, 0x38, 0x0e, 0x48,
+	0xd2, 0x94, 0xd4, 0x5a, 0x17, 0x6b, 0x4e, 0x26, 0x55, 0x4e, 0x27, 0x15, 0xc7, 0x43, 0x99, 0x34,
+	0xd8, 0xa1, 0x9e, 0xf5, 0x26, 0x73, 0xf7, 0x30, 0x57, 0x4a, 0x51, 0xd3, 0x57, 0x00, 0xf7, 0x75,
+	0x2e, 0xef, 0x0e, 0x5d, 0xe7, 0x32, 0xb4, 0x63, 0x92, 0x33, 0x99, 0x58, 0xfa, 0x3b, 0xb7, 0x3b,
+	0xb8, 0xf5, 0xf7, 0x7d, 0x98, 0xa7, 0xce, 0x1c, 0x7f, 0xe3, 0x91, 0xfd, 0x5c, 0x19, 0xb7, 0x46,
+	0x5f, 0x5e, 0x5f, 0xf3, 0xec, 0xeb, 0x6b, 0xbe, 0x08, 0x5d, 0xcf, 0x8c, 0xbe, 0xf5, 0x40, 0xd2,
+	0x4f, 0x7a, 0xf4, 0x15, 0x55, 0x13, 0x25, 0x54, 0x4d, 0xda, 0x54, 0x9d, 0x27, 0x0d, 0xb5, 0x06,
+	0xa5, 0x30, 0xd4, 0x0d, 0x79, 0x25, 0xc7, 0x9b, 0x8a, 0x75, 0xbc, 0xf1, 0xdf, 0x2f, 0x87, 0xc7,
+	0x58, 0x0c, 0x3f, 0xde, 0xf0, 0x2c, 0xf2, 0xf0, 0x04, 0x44, 0x4d, 0x5c, 0xb8, 0x39, 0x94, 0x15,
+	0x1b, 0x5c, 0x8c, 0xaa, 0x24, 0x9c, 0xdf, 0x0e, 0xe4, 0xe4, 0xac, 0xa9, 0x55, 0x78, 0x7b, 0x6b,
+	0x15, 0x6f, 0x21, 0xfb, 0xcc, 0xda, 0xe2, 0x4c, 0x22, 0x37, 0xf7, 0xfc, 0x9a, 0x0f, 0xac, 0xe2,
+	0xf4, 0x6d, 0xb9, 0x77, 0x15, 0xc4, 0x91, 0xa3, 0xe8, 0x4a, 0x74, 0xb6, 0x38, 0x12, 0x61, 0x85,
+	0xff, 0x95, 0x11, 0x91, 0x61, 0xc9, 0xff, 0x31, 0x44, 0xfc, 0x83, 0x27, 0x82, 0x78, 0x6c, 0xf6,
+	0xb2, 0x26, 0xd5, 0xbb, 0xa9, 0x49, 0xa5, 0x8f, 0x13, 0xc2, 0x0f, 0xf0, 0xea, 0xad, 0xb9, 0x0c,
+	0xf9, 0x06, 0x19, 0x46, 0x49, 0xfa, 0x34, 0x69, 0x58, 0xbc, 0x20, 0x98, 0xa8, 0x78, 0x3f, 0xb6,
+	0x8b, 0xdb, 0x12, 0x8d, 0x3f, 0xd3, 0xa2, 0x01, 0xfe, 0x80, 0x1c, 0xb6, 0x8a, 0x2b, 0xc7, 0x4d,
+	0xb9, 0x3a, 0x61, 0x29, 0x08, 0x95, 0x9b, 0x56, 0x10, 0xa0, 0x3b, 0x8b, 0x27, 0x7e, 0xfc, 0xee,
+	0x72, 0x2c, 0x66, 0x76, 0xf7, 0xaa, 0x57, 0x18, 0x27, 0x7f, 0xbb, 0xb1, 0x35, 0xd6, 0x82, 0xaf,
+	0xe6, 0x17, 0x7c, 0xd9, 0x49, 0xf9, 0x93, 0x9e, 0x23, 0x3c, 0x26, 0x87, 0x99, 0xe5, 0x59, 0x29,
+	0x89, 0xe4, 0x2f, 0xd9, 0x35, 0xe5, 0x25, 0xe5, 0x8a, 0x71, 0x49, 0xf9, 0x56, 0xdd, 0x2a, 0x17,
+	0x8b, 0xe9, 0xf8, 0x0d, 0xcf, 0x8a, 0x2b, 0x2c, 0x46, 0xd1, 0x8a, 0x9c, 0x59, 0x46, 0xd3, 0x62,
+	0xd8, 0x8f, 0xd2, 0xdd, 0xdb, 0x5e, 0x44, 0x0b, 0x64, 0xc6, 0x68, 0x46, 0xd0, 0x67, 0x82, 0xfc,
+	0x17, 0xc9, 0xbc, 0xa9, 0x37, 0x67, 0xfa, 0x74, 0x39, 0xff, 0x9f, 0xcc, 0xb6, 0x69, 0x4a, 0x88,
+	0x4c, 0x03, 0x76, 0x5f, 0x2f, 0x90, 0x83, 0x46, 0x52, 0xf1, 0xf2, 0x13, 0xf6, 0x99, 0xf2, 0x44,
+	0x5e, 0xd8, 0x64, 0x5b, 0xe5, 0xe5, 0x41, 0xfd, 0x3b, 0x1b, 0x4b, 0xd7, 0x28, 0x7c, 0x82, 0x10,
+	0x2d, 0xba, 0xab, 0x91, 0x33, 0xe9, 0xd9, 0x2f, 0x6c, 0xd5, 0xad, 0xb7, 0xa7, 0x52, 0xd3, 0x0f,
+	0x9d, 0xe6, 0xdf, 0x9e, 0xaa, 0x65, 0xdf, 0x9e, 0x2a, 0x63, 0xe3, 0x4f, 0xb9, 0xcc, 0xe5, 0x39,
+	0xfc, 0xf4, 0xdc, 0xff, 0xbb, 0xc7, 0x5f, 0xe7, 0x42, 0x1b, 0xd7, 0xba, 0xb2, 0x71, 0xad, 0xd3,
+	0x7b, 0x48, 0xa5, 0x93, 0x0a, 0x51, 0x98, 0x79, 0xb3, 0xab, 0xd2, 0x49, 0xe9, 0xa3, 0xea, 0x49,
+	0x81, 0xaa, 0x6d, 0xd1, 0x59, 0xef, 0xa4, 0x5c, 0xcc, 0x24, 0xf2, 0xd9, 0x1d, 0xee, 0x86, 0xc9,
+	0x1c, 0x34, 0x6a, 0x96, 0x71, 0xbb, 0xfc, 0xa0, 0x31, 0xdf, 0x15, 0xd6, 0xc6, 0xc2, 0xe7, 0x56,
+	0x4e, 0xdb, 0x4f, 0xa3, 0x14, 0x8b, 0x3b, 0xe3, 0xc1, 0x87, 0x4f, 0x57, 0xc8, 0x5c, 0xf6, 0xcd,
+	0x46, 0x58, 0xb6, 0x0c, 0x13, 0x3d, 0x71, 0xf5, 0x50, 0x26, 0x41, 0x08, 0x32, 0x23, 0x9e, 0xc0,
+	0x3b, 0x55, 0x0f, 0x34, 0x00, 0x78, 0x77, 0x34, 0x56, 0x07, 0x01, 0xfc, 0xa6, 0xf7, 0x90, 0xea,
+	0x38, 0x95, 0x1e, 0x9c, 0x19, 0x63, 0x7c, 0x02, 0x80, 0x43, 0x83, 0x1b, 0x3b, 0x71, 0x0c, 0xf3,
+	0xc2, 0xc3, 0x1b, 0xeb, 0x81, 0x06, 0x80, 0x04, 0x1c, 0xc7, 0x8c, 0x67, 0xf2, 0x3b, 0x93, 0x2a,
+	0x0d, 0xf4, 0x27, 0xf1, 0x86, 0x38, 0x74, 0xc1, 0x27, 0x74, 0xdf, 0x63, 0x49, 0x2a, 0x34, 0x59,
+	0xfc, 0xa6, 0x27, 0x49, 0x63, 0x63, 0x8b, 0x6d, 0x6c, 0x2f, 0x8f, 0x86, 0x57, 0xfb, 0xd1, 0x46,
+	0x2a, 0xd4, 0x58, 0x1b, 0x08, 0x8b, 0x36, 0x54, 0x0f, 0x86, 0xf5, 0x50, 0x99, 0xad, 0x05, 0x26,
+	0xc8, 0xbf, 0x41, 0xa8, 0x19, 0xed, 0x2f, 0x1e, 0x75, 0xcb, 0xb2, 0xcb, 0xac, 0x62, 0x97, 0x06,
+	0xf2, 0x07, 0x1a, 0xe6, 0xe2, 0x4d, 0x96, 0xca, 0xb3, 0x11, 0x4f, 0x01, 0xc3, 0x73, 0xa2, 0x38,
+	0x57, 0xf3, 0x84, 0xdb, 0x2b, 0xe4, 0xff, 0xd0, 0x23, 0x73, 0xd9, 0x67, 0x0e, 0x8b, 0x9e, 0x13,
+	0xd0, 0x0d, 0x57, 0xcc, 0x86, 0x61, 0xb3, 0x08, 0x77, 0x12, 0xd6, 0x13, 0x96, 0x78, 0x91, 0xc2,
+	0x17, 0x64, 0xc3, 0xe1, 0x06, 0xeb, 0xf7, 0xd5, 0x13, 0x50, 0x1a, 0x60, 0x6b, 0xa8, 0xf5, 0x12,
+	0x55, 0x77, 0xc2, 0x52, 0x75, 0x35, 0x19, 0x93, 0x66, 0x00, 0xc5, 0xc3, 0xfc, 0xe0, 0xc8, 0x3d,
+	0xa0, 0x47, 0x1d, 0xcf, 0x37, 0x8a, 0x65, 0x05, 0xa5, 0xfc, 0x5f, 0xf0, 0x5c, 0x77, 0xbc, 0xe8,
+	0x1b, 0x04, 0xf7, 0x19, 0xb6, 0xbe, 0xc2, 0x77, 0x47, 0x75, 0xc9, 0x32, 0x8b, 0xd2, 0xa7, 0x6d,
+	0x8b, 0x52, 0xbe, 0x4f, 0x2d, 0x23, 0x00, 0xa7, 0xfc, 0xfd, 0xb2, 0x3b, 0x80, 0xd3, 0x67, 0x6c,
+	0x9c, 0xf2, 0x7d, 0x5a, 0x7e, 0x57, 0xd7, 0xdd, 0xb6, 0x5b, 0x15, 0x63, 0xc7, 0xc8, 0x34, 0xaa,
+	0x73, 0xf8, 0x18, 0x2d, 0xe7, 0x54, 0x0d, 0xb0, 0x5e, 0x0c, 0xf4, 0xf4, 0xbb, 0x88, 0x65, 0x8e,
+	0xac, 0xdf, 0x74, 0x39, 0xb2, 0x2c, 0x14, 0x35, 0x0d, 0xa9, 0xeb, 0x16, 0x9e, 0x2d, 0x82, 0x2a,
+	0x86, 0x08, 0x2a, 0x1b, 0xb9, 0xdf, 0xb2, 0x47, 0x2e, 0xdf, 0xac, 0xee, 0xf5, 0x87, 0xde, 0x1e,
+	0x97, 0xfc, 0x0a, 0xdf, 0xe6, 0xb9, 0x09, 0x1b, 0xb3, 0xdb, 0x79, 0x50, 0x16, 0xc2, 0x47, 0x49,
+	0x6d, 0x68, 0xf8, 0xbe, 0xe1, 0x7b, 0x71, 0xb5, 0x98, 0xd0, 0xdf, 0xe6, 0x84, 0x9e, 0xb4, 0x23,
+	0xc5, 0xdc, 0x84, 0x68, 0x9a, 0xbf, 0xe6, 0x95, 0xde, 0x5a, 0xdc, 0x4b, 0xdf, 0x8c, 0x2d, 0x4f,
+	0x29, 0x4f, 0xc1, 0x3c, 0xf5, 0xe2, 0xd1, 0xf8, 0x4c, 0xbf, 0x2f, 0x64, 0x8b, 0x4c, 0x96, 0x05,
+	0xe5, 0x7f, 0x96, 0xa3, 0xef, 0x9b, 0x57, 0x6f, 0xf6, 0x42, 0xfe, 0xc5, 0xb2, 0x0b, 0x95, 0x65,
+	0xaa, 0xe0, 0xef, 0xd8, 0xaa, 0x60, 0x71, 0x23, 0xba, 0xaf, 0x8f, 0x7a, 0x05, 0xb7, 0x33, 0x0d,
+	0x15, 0xd5, 0xb3, 0x54, 0xd4, 0xe3, 0x84, 0xc4, 0xfa, 0xd6, 0x15, 0x7f, 0x56, 0xc9, 0x80, 0x94,
+	0x45, 0xae, 0xfd, 0xae, 0xe7, 0x8a, 0xfa, 0xb3, 0xfb, 0xd5, 0xa8, 0xfd, 0xb5, 0x77, 0x93, 0xb7,
+	0x43, 0x0b, 0x51, 0x2d, 0xf2, 0x79, 0x8b, 0xf3, 0x0d, 0x6c, 0xe4, 0x5c, 0x9d, 0xa9, 0x06, 0x1a,
+	0xb0, 0x78, 0xa5, 0x98, 0x80, 0xcf, 0x71, 0x02, 0x5e, 0xab, 0x07, 0x78, 0x6f, 0xec, 0x34, 0x41,
+	0x9f, 0xf2, 0xf6, 0xbe, 0xc3, 0x7a, 0x6b, 0xee, 0x8a, 0xb2, 0x70, 0xa6, 0xdf, 0xb3, 0xc3, 0x99,
+	0xf6, 0xea, 0xd8, 0x94, 0x52, 0xae, 0x3b, 0xb4, 0x30, 0x98, 0x0c, 0x2f, 0xc4, 0x09, 0xc7, 0x86,
+	0x48, 0x95, 0xc9, 0xc6, 0xdf, 0xb7, 0x65, 0xa3, 0xa3, 0xd5, 0x5c, 0xaf, 0x99, 0x0b, 0xba, 0xb7,
+	0xd3, 0xeb, 0x1f, 0xe4, 0x7b, 0xcd, 0xb4, 0xaa, 0x7b, 0xfd, 0x79, 0xcf, 0x79, 0xfd, 0x97, 0x3e,
+	0x66, 0x3e, 0xe4, 0x22, 0xa6, 0xc2, 0xf1, 0xf6, 0x88, 0x51, 0xa8, 0x0c, 0xa3, 0xcf, 0xdb, 0x18,
+	0x39, 0x3a, 0xd4, 0x18, 0xf5, 0x1d, 0xd7, 0x8e, 0x9d, 0x61, 0x83, 0x25, 0x91, 0x24, 0x5f, 0xb0,
+	0x23, 0x49, 0x72, 0xed, 0xe9, 0xde, 0x5e, 0xf5, 0xf6, 0xba, 0xce, 0x7c, 0xcb, 0x8b, 0xcb, 0x78,
+	0xa1, 0xa7, 0x6a, 0xbd, 0xd0, 0xb3, 0xd8, 0x29, 0xc6, 0xf8, 0x0f, 0x39, 0xc6, 0xf7, 0x17, 0x2e,
+	0x2c, 0x13, 0x25, 0x8d, 0xfe, 0x8d, 0x82, 0x8b, 0xd6, 0x45, 0x4a, 0x63, 0x99, 0x70, 0xfa, 0xa2,
+	0x2d, 0x9c, 0x9c, 0xed, 0xea, 0x9e, 0xdf, 0xe9, 0xbc, 0xc7, 0x5d, 0xc6, 0x04, 0x5f, 0xb2, 0x99,
+	0xc0, 0x51, 0x5b, 0xb7, 0xfe, 0x3e, 0xaf, 0xe8, 0x36, 0xf8, 0x5e, 0x7a, 0x78, 0x99, 0x57, 0xeb,
+	0x8f, 0x6c, 0xaf, 0x96, 0xbb, 0x03, 0x8d, 0xc4, 0xc7, 0xbd, 0xb2, 0xbb, 0xe5, 0xb7, 0xca, 0x17,
+	0x65, 0xfb, 0xd6, 0x97, 0x73, 0xfb, 0x56, 0x41, 0xa7, 0x1a, 0xb9, 0x6d, 0x72, 0x20, 0x77, 0x86,
+	0x74, 0x1a, 0x14, 0xf2, 0xb7, 0x7b, 0xf9, 0x1d, 0x0f, 0xc7, 0xeb, 0xbd, 0x62, 0x13, 0x4b, 0x44,
+	0x68, 0x90, 0x4a, 0xfb, 0x97, 0xad, 0x77, 0xad, 0xf8, 0x43, 0x54, 0x4b, 0x79, 0x98, 0x30, 0x31,
+	0x14, 0xd9, 0x33, 0x73, 0xe5, 0x61, 0x9a, 0x4b, 0x6f, 0xe7, 0x5b, 0x71, 0xee, 0xe2, 0x25, 0xec,
+	0x32, 0xbf, 0xeb, 0x57, 0x6c, 0xbf, 0x6b, 0x59, 0xd3, 0x7a, 0x24, 0xbf, 0xe8, 0x95, 0x3f, 0x00,
+	0x70, 0xcb, 0x97, 0x37, 0xd5, 0x6b, 0x89, 0x55, 0xe3, 0xb5, 0xc4, 0x32, 0xb4, 0xbf, 0xea, 0x39,
+	0xee, 0xed, 0xba, 0x91, 0xd1, 0x68, 0xbf, 0x5c, 0xfc, 0x28, 0x81, 0x73, 0xd8, 0x4a, 0x62, 0x40,
+	0xbf, 0x66, 0xc7, 0x80, 0x16, 0x35, 0x6b, 0xad, 0x8c, 0xd2, 0x37, 0x0f, 0xe8, 0x43, 0x64, 0x6a,
+	0xf9, 0x59, 0x3c, 0x90, 0x4a, 0xbb, 0x93, 0xea, 0x93, 0x83, 0x03, 0x95, 0x5f, 0x36, 0x30, 0x7f,
+	0x9c, 0x19, 0x98, 0x92, 0x2e, 0x35, 0x72, 0x6f, 0x25, 0x93, 0xa2, 0x6d, 0xe7, 0x7a, 0xc8, 0xbc,
+	0x5a, 0xc9, 0x5d, 0x2e, 0xd6, 0xab, 0x95, 0x1f, 0xf0, 0xf6, 0x7a, 0xaf, 0xc1, 0x39, 0xc0, 0x25,
+	0xd2, 0xfd, 0xd5, 0x9c, 0x74, 0x2f, 0x69, 0xdc, 0x16, 0x40, 0xc5, 0x8f, 0x42, 0xdc, 0xea, 0xdd,
+	0xa1, 0x32, 0x01, 0xf4, 0x75, 0x2f, 0x77, 0x37, 0x7b, 0x2f, 0xfe, 0xeb, 0x97, 0x3e, 0x48, 0x51,
+	0x76, 0x24, 0xf8, 0x86, 0x7d, 0x24, 0x28, 0x69, 0x45, 0xf7, 0xf6, 0x49, 0x6f, 0x8f, 0xe7, 0x2d,
+	0x40, 0xec, 0x26, 0xfc, 0xe8, 0x0a, 0x0c, 0x57, 0x0b, 0x44, 0x0a, 0xb6, 0x63, 0xee, 0xa5, 0xe6,
+	0xb6, 0xfa, 0x5a, 0x20, 0x93, 0x65, 0x87, 0xae, 0x3f, 0xb1, 0x0f, 0x5d, 0xa5, 0x3d, 0x9b, 0x57,
+	0xfe, 0xf2, 0xef, 0x6b, 0x98, 0xfd, 0x7b, 0x76, 0xff, 0x25, 0x0a, 0xcc, 0x9f, 0x66, 0x43, 0x61,
+	0x33, 0xad, 0xea, 0x3e, 0xff, 0xd1, 0x2b, 0x7e, 0xbd, 0x03, 0xb8, 0xa1, 0x97, 0x91, 0x5c, 0x32,
+	0x2d, 0x8e, 0x31, 0xdc, 0x4f, 0xd0, 0x13, 0xfb, 0xa7, 0x01, 0x81, 0xba, 0x03, 0xfe, 0xef, 0x0f,
+	0x3d, 0xf1, 0xb4, 0x84, 0x4a, 0xeb, 0x7f, 0x83, 0xa8, 0x15, 0xfd, 0x1b, 0x44, 0x99, 0xb8, 0xf9,
+	0xa6, 0x2d, 0x6e, 0x8a, 0xb0, 0xb7, 0xe2, 0x16, 0xcc, 0x57, 0xbe, 0xd1, 0x1c, 0xc5, 0xff, 0x92,
+	0xc4, 0xe3, 0xe7, 0x50, 0xf9, 0x57, 0x24, 0xc7, 0x09, 0x59, 0xda, 0xd9, 0xd8, 0x66, 0xa9, 0x90,
+	0xc9, 0xf8, 0x5c, 0x9a, 0x86, 0xe0, 0xfd, 0xac, 0x6d, 0x71, 0xa3, 0xbe, 0x72, 0x66, 0x1b, 0xd2,
+	0xdd, 0x6d, 0xf9, 0x6f, 0x01, 0xdd, 0x6d, 0xa0, 0xf9, 0xec, 0xb0, 0x37, 0x1e, 0x45, 0xc3, 0x54,
+	0x18, 0xe6, 0x54, 0x1a, 0xf2, 0x96, 0xc2, 0x84, 0x75, 0xc2, 0x74, 0x0b, 0xad, 0x60, 0xd3, 0x81,
+	0x4a, 0xfb, 0x1f, 0xab, 0x10, 0x33, 0x4e, 0x7f, 0x19, 0xff, 0x6c, 0xa0, 0xcb, 0x86, 0x49, 0x94,
+	0x46, 0xd7, 0x98, 0xc0, 0x32, 0x0b, 0x06, 0x6c, 0xcf, 0x8c, 0xc7, 0x6c, 0xd8, 0x03, 0x61, 0x8b,
+	0xd8, 0x4e, 0x05, 0x06, 0x04, 0x76, 0xee, 0x2b, 0x71, 0x94, 0xb2, 0xb5, 0xad, 0x98, 0x25, 0x5b,
+	0xa3, 0x7e, 0x4f, 0xec, 0xcb, 0x19, 0x28, 0x3d, 0x49, 0x1a, 0x01, 0x0b, 0x7b, 0xba, 0x58, 0x0d,
+	0x8b, 0xd9, 0x40, 0xfc, 0xfb, 0x86, 0x74, 0x14, 0x87, 0x9b, 0x6c, 0x39, 0x1c, 0x87, 0x1b, 0x51,
+	0xba, 0x2b, 0x6c, 0xb0, 0x59, 0xb0, 0x0a, 0xf1, 0x5e, 0xde, 0x0a, 0x63, 0x41, 0xaa, 0x06, 0xe0,
+	0xed, 0x82, 0x54, 0x46, 0x1a, 0xc0, 0x27, 0xde, 0x79, 0x0f, 0x37, 0x13, 0x2c, 0x22, 0xae, 0xc3,
+	0x69, 0x80, 0xff, 0x2d, 0xaf, 0xf8, 0x3d, 0x17, 0x97, 0x32, 0x17, 0x8c, 0x85, 0xe0, 0xaa, 0x04,
+	0x63, 0x7c, 0xf1, 0x36, 0x49, 0xd5, 0x1b, 0xb8, 0x49, 0x6a, 0x5e, 0x98, 0xa8, 0x59, 0xff, 0xf0,
+	0x91, 0x7b, 0x81, 0xa4, 0x84, 0x03, 0xbf, 0xe5, 0xe2, 0xc0, 0xb2, 0x00, 0xa7, 0x5f, 0xf6, 0xc8,
+	0x24, 0xc8, 0xd1, 0xd5, 0x31, 0xc6, 0xc6, 0xae, 0x8e, 0x45, 0x40, 0x63, 0x65, 0x75, 0x0c, 0x8c,
+	0x31, 0x64, 0xd7, 0xa5, 0x23, 0x15, 0x5f, 0x64, 0x90, 0xe9, 0xfc, 0xbf, 0xf1, 0xf0, 0x97, 0xf9,
+	0x32, 0xff, 0xc6, 0x73, 0x9c, 0x90, 0xf3, 0x2c, 0x5d, 0x1d, 0x4b, 0x3b, 0x31, 0x5e, 0x71, 0xd7,
+	0x10, 0x75, 0x71, 0xb8, 0x6e, 0x1b, 0xd6, 0xd5, 0xc5, 0x61, 0xd8, 0x28, 0x9c, 0xaf, 0xf0, 0x94,
+	0xde, 0x4e, 0xb3, 0x7d, 0x2e, 0x62, 0xb1, 0x18, 0x3e, 0x97, 0x92, 0xa0, 0x9e, 0x6f, 0xdb, 0x41,
+	0x3d, 0xae, 0xae, 0x9d, 0x7e, 0x43, 0xc7, 0x43, 0x40, 0x3f, 0x61, 0xc7, 0x51, 0x96, 0x88, 0x92,
+	0x3d, 0xef, 0x3b, 0x4e, 0xbf, 0xa1, 0x03, 0x45, 0x4d, 0xca, 0x67, 0xbd, 0x92, 0xc7, 0x90, 0xd4,
+	0x8d, 0x50, 0xfe, 0xec, 0x3c, 0xbf, 0x11, 0xea, 0xfe, 0x3b, 0x37, 0x7d, 0x97, 0xa4, 0x6a, 0xde,
+	0x25, 0x29, 0xbb, 0x09, 0xf7, 0x5d, 0xfb, 0x26, 0x5c, 0x21, 0x16, 0x1a, 0xd9, 0xbf, 0xaa, 0x90,
+	0xa9, 0x73, 0x11, 0xb7, 0x71, 0x00, 0x23, 0x24, 0xec, 0xa5, 0x1d, 0x36, 0xdc, 0x60, 0xc2, 0x8d,
+	0xa4, 0xd2, 0x80, 0x63, 0x1f, 0xa3, 0x87, 0xc4, 0x13, 0xe1, 0x98, 0x00, 0xe8, 0x80, 0xc5, 0x9b,
+	0x4c, 0x08, 0x7f, 0x9e, 0x40, 0x73, 0xc4, 0x8d, 0x94, 0x0d, 0x53, 0x69, 0x20, 0xe6, 0x29, 0x2c,
+	0x8d, 0x7f, 0xea, 0x54, 0xe7, 0x77, 0x26, 0x31, 0x01, 0x92, 0x3a, 0x11, 0x3e, 0xe1, 0x09, 0x84,
+	0xcb, 0x24, 0xc8, 0x8c, 0x9e, 0x8a, 0xdc, 0xe7, 0xb2, 0x44, 0x03, 0xd0, 0x53, 0x84, 0x3c, 0x05,
+	0xb9, 0xfc, 0xaf, 0x44, 0x34, 0x00, 0x5a, 0x1d, 0x44, 0x5c, 0x7b, 0xe3, 0x8f, 0x8e, 0xc8, 0x24,
+	0xe6, 0x88, 0xd8, 0x79, 0x22, 0x72, 0x78, 0x12, 0x4f, 0x37, 0xa3, 0xeb, 0x3c, 0xe8, 0x9e, 0x07,
+	0x28, 0xa9, 0x34, 0x2c, 0xd2, 0xab, 0x51, 0x9f, 0x75, 0xa3, 0x97, 0xd9, 0xd2, 0x2e, 0x68, 0xac,
+	0x3c, 0x4a, 0xc9, 0x06, 0xfa, 0x1f, 0xf4, 0x5c, 0xef, 0x55, 0xd1, 0x47, 0xc8, 0xb4, 0x1c, 0x64,
+	0xa9, 0xea, 0xee, 0x57, 0x17, 0x43, 0xfa, 0xc2, 0x65, 0xac, 0x4a, 0x94, 0x59, 0xb4, 0xff, 0xcc,
+	0xb6, 0x68, 0xe7, 0xfb, 0xb2, 0xee, 0x2f, 0x95, 0xbd, 0x82, 0x75, 0x87, 0xd7, 0x54, 0x89, 0x6a,
+	0xf7, 0xe7, 0xb6, 0x6a, 0x57, 0x82, 0xa3, 0x26, 0xe6, 0x03, 0x9e, 0xeb, 0xc5, 0x2e, 0x14, 0xab,
+	0xc0, 0xde, 0x32, 0xea, 0x6f, 0x3a, 0x50, 0xe9, 0xec, 0x23, 0xc2, 0x65, 0xa3, 0xfa, 0xbd, 0xcc,
+	0x05, 0xd9, 0x5c, 0x47, 0x96, 0x2d, 0x6c, 0x12, 0xff, 0xae, 0x6c, 0x74, 0x1d, 0x38, 0x30, 0x55,
+	0xef, 0xb8, 0x88, 0x90, 0x2d, 0x05, 0x30, 0x74, 0x4d, 0x71, 0xc4, 0x17, 0xba, 0xe6, 0x3c, 0x99,
+	0xda, 0x1a, 0x59, 0xb6, 0x1f, 0x95, 0x56, 0xb1, 0x93, 0x2d, 0xf1, 0xf0, 0x8b, 0x48, 0x59, 0x74,
+	0xd6, 0x6d, 0x3a, 0xfd, 0xbf, 0xf3, 0xc8, 0x14, 0xfa, 0x34, 0x00, 0x25, 0xe9, 0x71, 0x15, 0xff,
+	0x16, 0x89, 0x1e, 0xd7, 0x8c, 0x8f, 0x16, 0x03, 0x04, 0xb5, 0x8f, 0x76, 0x96, 0x54, 0x7a, 0x32,
+	0x0e, 0xae, 0xd2, 0x5b, 0x87, 0x16, 0xc6, 0x69, 0xbb, 0x27, 0xe2, 0xdf, 0xf0, 0x1b, 0x5a, 0x48,
+	0xe2, 0x0d, 0x21, 0x8d, 0x78, 0xa0, 0xac, 0x06, 0xe0, 0x32, 0x4d, 0x52, 0x91, 0xcb, 0x5f, 0x93,
+	0xd7, 0x00, 0xdb, 0xa1, 0xcb, 0xff, 0x30, 0xaa, 0xc0, 0xa1, 0x3b, 0xc5, 0x09, 0x93, 0x69, 0xff,
+	0x05, 0xb2, 0xdf, 0x98, 0x09, 0xf9, 0xc7, 0x5d, 0x43, 0xfc, 0x0f, 0x39, 0xfb, 0xbc, 0x28, 0x26,
+	0x24, 0xe0, 0x99, 0xf4, 0x41, 0x32, 0xc1, 0xf8, 0x7f, 0x11, 0x56, 0xac, 0xb5, 0x26, 0x47, 0x29,
+	0x10, 0xd9, 0x18, 0xeb, 0xea, 0x7a, 0xef, 0xed, 0x4e, 0xc6, 0xba, 0xfe, 0x85, 0xbd, 0x2d, 0xba,
+	0xba, 0xb7, 0xc3, 0xbd, 0xdd, 0xcf, 0xce, 0xfd, 0x44, 0x6e, 0x53, 0x8a, 0x4b, 0xa0, 0xfc, 0x0a,
+	0x11, 0x5e, 0x02, 0x2d, 0x31, 0x8c, 0x7d, 0xdf, 0x36, 0x8c, 0xb9, 0xd1, 0xd2, 0xa8, 0x7f, 0xc8,
+	0x2b, 0x79, 0x15, 0x8f, 0x3e, 0x6c, 0x5d, 0xd0, 0x28, 0xfc, 0xf7, 0x3e, 0x2c, 0x54, 0xb6, 0xe3,
+	0xfd, 0xa5, 0x6b, 0xc7, 0x73, 0x74, 0xa8, 0xf0, 0x5a, 0x22, 0xef, 0x98, 0x3a, 0x7d, 0xfa, 0x51,
+	0x2c, 0xfe, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf8, 0xbc, 0xd3, 0xb4, 0x78, 0x75, 0x00, 0x00,
 }
@@ -15,6 +15,7 @@
 package meta
 
 import (
+	"github.com/openGemini/openGemini/lib/errno"
 	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
 	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
 	"go.uber.org/zap"
@@ -104,6 +105,12 @@ func getDataNodeByPtId(data *Data, db string, ptId uint32) *DataNode {
 // choose rgId for each pt owned to newNode when create a new datanode, or called by createDBPtView
 func NodeHardChooseRG(data *Data, db string, newNode *DataNode, replicasN int) {
 	rgStart := getFirstUnFullRGLoc(data.ReplicaGroups[db], replicasN)
+	if data.availabilityZoneNum() > 1 {
+		// spread the replicas across the availability zones, a pt joins a replica group with a replica
+		// in the same zone only if the replica groups are enough for all the pts of the database
+		joinRpGroup(data, db, newNode, replicasN, rgStart, newNode.Az, joinForAzHard, joinForRGNumLimit(replicasN))
+		return
+	}
 	joinRpGroup(data, db, newNode, replicasN, rgStart, "", joinForNodeHard)
 }
 
@@ -145,26 +152,33 @@ func notContainsRG(prePtRGIds []uint32, rgId uint32) bool {
 	return true
 }
 
-func joinRpGroup(data *Data, db string, newNode *DataNode, replicasN int, rgStart int, currentNodeAz string, joinFunc JoinForRgFn) {
+// joinRpGroup joins each pt owned by newNode to a replica group, the replica groups accepted by
+// the first joinFunc are preferred to the ones accepted by the next joinFunc
+func joinRpGroup(data *Data, db string, newNode *DataNode, replicasN int, rgStart int, currentNodeAz string, joinFuncs ...JoinForRgFn) {
 	var prePtRGIds []uint32
 	initFirstPtRg := false
 	for ptLoc, pt := range data.PtView[db] {
 		if pt.Owner.NodeID == newNode.ID {
 			choosed := false
-			for i := rgStart; i < len(data.ReplicaGroups[db]); i++ {
-				rgGroup := data.ReplicaGroups[db][i]
-				if joinFunc(data, db, rgGroup, currentNodeAz) {
-					if !initFirstPtRg || notContainsRG(prePtRGIds, data.ReplicaGroups[db][i].ID) {
-						data.PtView[db][ptLoc].RGID = data.ReplicaGroups[db][i].ID
-						data.ReplicaGroups[db][i].addPeer(pt.PtId, replicasN)
-						data.ReplicaGroups[db][i].nextUnFull(replicasN, db)
-						choosed = true
-						prePtRGIds = append(prePtRGIds, data.ReplicaGroups[db][i].ID)
-						initFirstPtRg = true
-						DataLogger.Info("NodeHardChooseRG addPeer", zap.String("db", db), zap.Uint32("ptId", pt.PtId), zap.Uint32("RGId", data.ReplicaGroups[db][i].ID))
-						break
+			for _, joinFunc := range joinFuncs {
+				for i := rgStart; i < len(data.ReplicaGroups[db]); i++ {
+					rgGroup := data.ReplicaGroups[db][i]
+					if joinFunc(data, db, rgGroup, currentNodeAz) {
+						if !initFirstPtRg || notContainsRG(prePtRGIds, data.ReplicaGroups[db][i].ID) {
+							data.PtView[db][ptLoc].RGID = data.ReplicaGroups[db][i].ID
+							data.ReplicaGroups[db][i].addPeer(pt.PtId, replicasN)
+							data.ReplicaGroups[db][i].nextUnFull(replicasN, db)
+							choosed = true
+							prePtRGIds = append(prePtRGIds, data.ReplicaGroups[db][i].ID)
+							initFirstPtRg = true
+							DataLogger.Info("NodeHardChooseRG addPeer", zap.String("db", db), zap.Uint32("ptId", pt.PtId), zap.Uint32("RGId", data.ReplicaGroups[db][i].ID))
+							break
+						}
 					}
 				}
+				if choosed {
+					break
+				}
 			}
 			if !choosed {
 				// to create a new rg as owner Rg of this pt, first add pt is masterPt
@@ -190,6 +204,66 @@ func joinForNodeHard(data *Data, db string, rg ReplicaGroup, currentAz string) b
 	return rg.Status == UnFull
 }
 
+// joinForRGNumLimit joins an unfull replica group once the replica groups are enough for all the pts of the database
+func joinForRGNumLimit(replicasN int) JoinForRgFn {
+	return func(data *Data, db string, rg ReplicaGroup, currentAz string) bool {
+		return rg.Status == UnFull && len(data.ReplicaGroups[db]) >= (len(data.PtView[db])+replicasN-1)/replicasN
+	}
+}
+
+func (data *Data) availabilityZoneNum() int {
+	azs := make(map[string]struct{})
+	for i := range data.DataNodes {
+		azs[data.DataNodes[i].Az] = struct{}{}
+	}
+	return len(azs)
+}
+
+// CheckRGPlacement checks whether the db pt can be placed on the node. The replicas of a replica group
+// must be on different nodes, and in different availability zones if rep-dis-policy is AzHard
+func (data *Data) CheckRGPlacement(db string, ptId uint32, nodeId uint64) error {
+	if data.Databases[db] == nil || data.DBReplicaN(db) <= 1 || int(ptId) >= len(data.PtView[db]) {
+		return nil
+	}
+	rgId := data.PtView[db][ptId].RGID
+	if int(rgId) >= len(data.ReplicaGroups[db]) {
+		return nil
+	}
+	node := data.DataNode(nodeId)
+	if node == nil {
+		return errno.NewError(errno.DataNodeNotFound, nodeId, "")
+	}
+	rg := &data.ReplicaGroups[db][rgId]
+	members := []uint32{rg.MasterPtID}
+	for _, peer := range rg.Peers {
+		members = append(members, peer.ID)
+	}
+	for _, member := range members {
+		if member == ptId || int(member) >= len(data.PtView[db]) {
+			continue
+		}
+		owner := data.DataNode(data.PtView[db][member].Owner.NodeID)
+		if owner == nil {
+			continue
+		}
+		if owner.ID == nodeId {
+			return errno.NewError(errno.ReplicaOnSameNode, db, ptId, nodeId, member, rgId)
+		}
+		if repDisPolicy == AzHard && owner.Az == node.Az {
+			return errno.NewError(errno.ReplicaInSameAz, db, ptId, nodeId, node.Az, member, rgId)
+		}
+	}
+	return nil
+}
+
+// CheckDataNodeAz checks the availability zone of a data node joining the cluster, it is required if rep-dis-policy is AzHard
+func CheckDataNodeAz(host, az string) error {
+	if repDisPolicy == AzHard && az == "" {
+		return errno.NewError(errno.AvailabilityZoneRequired, host)
+	}
+	return nil
+}
+
 type Role uint8
 
 const (
@@ -1408,3 +1408,59 @@ func Test_CreateDBReplication_fix(t *testing.T) {
 		assert1.Equal(t, uint32(i), d.ReplicaGroups["testDB"][i].MasterPtID)
 	}
 }
+
+func newAzTestData() *Data {
+	d := &Data{
+		Databases: map[string]*DatabaseInfo{
+			"testDB": {Name: "testDB", ReplicaN: 3},
+		},
+		PtNumPerNode: 1,
+		PtView:       map[string]DBPtInfos{"testDB": {}},
+	}
+	azs := []string{"az1", "az1", "az2", "az2", "az3", "az3"}
+	for i, az := range azs {
+		d.DataNodes = append(d.DataNodes, DataNode{NodeInfo: NodeInfo{ID: uint64(i + 1)}, Az: az})
+		d.PtView["testDB"] = append(d.PtView["testDB"], PtInfo{PtId: uint32(i), Owner: PtOwner{NodeID: uint64(i + 1)}})
+	}
+	return d
+}
+
+// repN=3, nodeN=6 in 3 availability zones, ptPerNode=1
+func Test_NodeHardChooseRG_SpreadAz(t *testing.T) {
+	DataLogger = logger.GetLogger().With(zap.String("service", "data"))
+	d := newAzTestData()
+	require.NoError(t, CreateDBRGFns[NodeHard](d, "testDB", 3))
+	require.Equal(t, 2, len(d.ReplicaGroups["testDB"]))
+	for i, rg := range d.ReplicaGroups["testDB"] {
+		require.Equal(t, SubHealth, rg.Status)
+		require.Equal(t, 3, len(getAzSet(d, "testDB", rg)))
+		require.Equal(t, uint32(i), rg.MasterPtID)
+		require.Equal(t, []Peer{{ID: uint32(i + 2), PtRole: Slave}, {ID: uint32(i + 4), PtRole: Slave}}, rg.Peers)
+	}
+}
+
+func Test_CheckRGPlacement(t *testing.T) {
+	DataLogger = logger.GetLogger().With(zap.String("service", "data"))
+	defer SetRepDisPolicy(uint8(NodeHard))
+	d := newAzTestData()
+	require.NoError(t, CreateDBRGFns[NodeHard](d, "testDB", 3))
+
+	// pt 0 of replica group 0 moves to the node of pt 2
+	err := d.CheckRGPlacement("testDB", 0, 3)
+	require.EqualError(t, err, "pt testDB$0 can not be placed on node 3 which holds pt 2 of the same replica group 0")
+	// node 4 holds pt 3 of the other replica group, az2 holds pt 2 of the same replica group
+	require.NoError(t, d.CheckRGPlacement("testDB", 0, 4))
+	SetRepDisPolicy(uint8(AzHard))
+	err = d.CheckRGPlacement("testDB", 0, 4)
+	require.EqualError(t, err, `pt testDB$0 can not be placed on node 4, availability zone "az2" holds pt 2 of the same replica group 0`)
+	require.NoError(t, d.CheckRGPlacement("testDB", 0, 2))
+
+	// the pts without replicas are placed anywhere
+	d.Databases["testDB"].ReplicaN = 1
+	require.NoError(t, d.CheckRGPlacement("testDB", 0, 3))
+
+	require.EqualError(t, CheckDataNodeAz("127.0.0.1:8400", ""), "data node 127.0.0.1:8400 has no availability-zone, which is required by rep-dis-policy = 1")
+	require.NoError(t, CheckDataNodeAz("127.0.0.1:8400", "az1"))
+	SetRepDisPolicy(uint8(NodeHard))
+	require.NoError(t, CheckDataNodeAz("127.0.0.1:8400", ""))
+}
@@ -589,3 +589,12 @@ type ExpiredIndexInfos struct {
 	IndexGroupID uint64
 	IndexIDs     []uint64
 }
+
+// ExpiredMeasurementInfos is a measurement with TTL, the data of it earlier than ExpireTime is expired
+type ExpiredMeasurementInfos struct {
+	Database   string
+	Policy     string
+	Name       string // measurement name with version
+	EngineType config.EngineType
+	ExpireTime int64
+}
@@ -21,6 +21,27 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 )
 
+// Methods supported by time_weighted_avg to weight the points of a window.
+const (
+	TimeWeightLinear = "linear" // trapezoidal weighting between adjacent points
+	TimeWeightLOCF   = "locf"   // last observation carried forward until the next point
+)
+
+// Partial calls time_weighted_avg is split into so that the stores can compute it, the
+// weighted area and the weighted duration of each window are summed up by the upper levels.
+const (
+	TimeWeightedArea     = "time_weighted_area"
+	TimeWeightedDuration = "time_weighted_duration"
+)
+
+// Methods supported by interpolate to fill the empty GROUP BY time buckets.
+const (
+	InterpolateLinear = "linear" // straight line between the neighbouring buckets
+	InterpolateStep   = "step"   // the previous bucket carried forward
+	InterpolateLOCF   = "locf"   // alias of step
+	InterpolateSpline = "spline" // cubic Hermite spline through the neighbouring buckets
+)
+
 var (
 	_ = RegistryAggregateFunction("mean", &MeanFunc{
 		BaseInfo: BaseInfo{FuncType: AGG_NORMAL},
@@ -174,6 +195,18 @@ var (
 			sortedMergeCall: true,
 		},
 	})
+	_ = RegistryAggregateFunction("time_weighted_avg", &TimeWeightedAvgFunc{
+		BaseInfo: BaseInfo{FuncType: AGG_NORMAL},
+		BaseAgg: BaseAgg{
+			canPushDown: true,
+		},
+	})
+	_ = RegistryAggregateFunction("interpolate", &InterpolateFunc{
+		BaseInfo: BaseInfo{FuncType: AGG_NORMAL},
+		BaseAgg: BaseAgg{
+			canPushDown: true,
+		},
+	})
 	_ = RegistryAggregateFunction("mode", &ModeFunc{
 		BaseInfo: BaseInfo{FuncType: AGG_SLICE},
 		BaseAgg: BaseAgg{
@@ -1095,6 +1128,96 @@ func (f *IntegralFunc) CallTypeFunc(name string, args []influxql.DataType) (infl
 	return influxql.Float, nil
 }
 
+type TimeWeightedAvgFunc struct {
+	BaseInfo
+	BaseAgg
+}
+
+// CompileFunc checks time_weighted_avg(field[, 'linear'|'locf'[, maxgap]]).
+func (f *TimeWeightedAvgFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	args, name := expr.Args, expr.Name
+	if min, max, got := 1, 3, len(args); got > max || got < min {
+		return fmt.Errorf("invalid number of arguments for %s, expected at least %d but no more than %d, got %d", name, min, max, got)
+	}
+
+	if len(args) > 1 {
+		method, ok := args[1].(*influxql.StringLiteral)
+		if !ok {
+			return fmt.Errorf("second argument for %s must be a string, got %T", name, args[1])
+		}
+		switch method.Val {
+		case TimeWeightLinear, TimeWeightLOCF:
+		default:
+			return fmt.Errorf("invalid method for %s, expected '%s' or '%s', got '%s'", name, TimeWeightLinear, TimeWeightLOCF, method.Val)
+		}
+	}
+
+	if len(args) > 2 {
+		switch arg2 := args[2].(type) {
+		case *influxql.DurationLiteral:
+			if arg2.Val <= 0 {
+				return fmt.Errorf("duration argument must be positive, got %s", influxql.FormatDuration(arg2.Val))
+			}
+		default:
+			return fmt.Errorf("third argument for %s must be a duration", name)
+		}
+	}
+	c.global.OnlySelectors = false
+
+	// Must be a variable reference, wildcard, or regexp.
+	return c.compileSymbol(name, args[0])
+}
+
+func (f *TimeWeightedAvgFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
+	return influxql.Float, nil
+}
+
+type InterpolateFunc struct {
+	BaseInfo
+	BaseAgg
+}
+
+// CompileFunc checks interpolate(field, 'linear'|'step'|'locf'|'spline'[, maxgap]).
+func (f *InterpolateFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	args, name := expr.Args, expr.Name
+	if min, max, got := 2, 3, len(args); got > max || got < min {
+		return fmt.Errorf("invalid number of arguments for %s, expected at least %d but no more than %d, got %d", name, min, max, got)
+	}
+
+	method, ok := args[1].(*influxql.StringLiteral)
+	if !ok {
+		return fmt.Errorf("second argument for %s must be a string, got %T", name, args[1])
+	}
+	switch method.Val {
+	case InterpolateLinear, InterpolateStep, InterpolateLOCF, InterpolateSpline:
+	default:
+		return fmt.Errorf("invalid method for %s, expected '%s', '%s', '%s' or '%s', got '%s'", name,
+			InterpolateLinear, InterpolateStep, InterpolateLOCF, InterpolateSpline, method.Val)
+	}
+
+	if len(args) > 2 {
+		switch arg2 := args[2].(type) {
+		case *influxql.DurationLiteral:
+			if arg2.Val <= 0 {
+				return fmt.Errorf("duration argument must be positive, got %s", influxql.FormatDuration(arg2.Val))
+			}
+		default:
+			return fmt.Errorf("third argument for %s must be a duration", name)
+		}
+	}
+	if c.global.Interval.IsZero() {
+		return fmt.Errorf("%s aggregate requires a GROUP BY interval", name)
+	}
+	c.global.OnlySelectors = false
+
+	// Must be a variable reference, wildcard, or regexp.
+	return c.compileSymbol(name, args[0])
+}
+
+func (f *InterpolateFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
+	return influxql.Float, nil
+}
+
 type ModeFunc struct {
 	BaseInfo
 	BaseAgg
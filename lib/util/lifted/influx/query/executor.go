@@ -9,6 +9,7 @@ Huawei Cloud Computing Technologies Co., Ltd.
 */
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -66,6 +67,9 @@ const (
 	QueryIDKey
 
 	IndexScanDagStartTimeKey
+
+	// SQLQueryStatKey carries the *statistics.SQLSlowQueryStatistics of the query into the pipeline of the ts-sql
+	SQLQueryStatKey
 )
 
 var batchQueryConcurrenceLimiter limiter.Fixed
@@ -217,6 +221,12 @@ type ExecutionOptions struct {
 
 	// IterID indicates the number of iteration in incremental query, starting from 0.
 	IterID int32
+
+	// TraceContext carries the span of the request exported by OpenTelemetry.
+	TraceContext context.Context
+
+	// ReplicationSource indicates the query is shipped by the replication of the peer cluster.
+	ReplicationSource bool
 }
 
 func NewExecutionOptions(db, rp string, nodeID uint64, chunkSize, innerChunkSize int, chunked, readOnly, quiet, parallelQuery bool) *ExecutionOptions {
@@ -87,3 +87,55 @@ func Test_TimerangePushDown(t *testing.T) {
 		}
 	}
 }
+
+func Test_CompileTimeWeightedAvg(t *testing.T) {
+	cases := []testRes{
+		{"SELECT time_weighted_avg(value) FROM cpu WHERE time>=1 AND time<100 GROUP BY time(10ns)", true},
+		{"SELECT time_weighted_avg(value, 'locf') FROM cpu WHERE time>=1 AND time<100 GROUP BY time(10ns)", true},
+		{"SELECT time_weighted_avg(value, 'linear', 5m) FROM cpu WHERE time>=1 AND time<100", true},
+		{"SELECT time_weighted_avg(value, 'spline') FROM cpu WHERE time>=1 AND time<100", false},
+		{"SELECT time_weighted_avg(value, 1) FROM cpu WHERE time>=1 AND time<100", false},
+		{"SELECT time_weighted_avg(value, 'locf', 0s) FROM cpu WHERE time>=1 AND time<100", false},
+		{"SELECT time_weighted_avg(value, 'locf', 5m, 1) FROM cpu WHERE time>=1 AND time<100", false},
+	}
+
+	for i := 0; i < len(cases); i++ {
+		q, err := influxql.ParseQuery(cases[i].query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		statement := q.Statements[0].(*influxql.SelectStatement)
+		_, err = query.Compile(statement, query.CompileOptions{})
+		isOK := (err == nil)
+		if isOK != cases[i].isOK {
+			t.Fatalf("statement-%d compile check failed. exepect: %+v == %+v, err:%+v", i, cases[i].isOK, isOK, err)
+		}
+	}
+}
+
+func Test_CompileInterpolate(t *testing.T) {
+	cases := []testRes{
+		{"SELECT interpolate(value, 'linear') FROM cpu WHERE time>=1 AND time<100 GROUP BY time(10ns)", true},
+		{"SELECT interpolate(value, 'step', 30ns) FROM cpu WHERE time>=1 AND time<100 GROUP BY time(10ns)", true},
+		{"SELECT interpolate(value, 'locf') FROM cpu WHERE time>=1 AND time<100 GROUP BY time(10ns)", true},
+		{"SELECT interpolate(value, 'spline', 5m) FROM cpu WHERE time>=1 AND time<100 GROUP BY time(10ns)", true},
+		{"SELECT interpolate(value, 'linear') FROM cpu WHERE time>=1 AND time<100", false},
+		{"SELECT interpolate(value) FROM cpu WHERE time>=1 AND time<100 GROUP BY time(10ns)", false},
+		{"SELECT interpolate(value, 'cubic') FROM cpu WHERE time>=1 AND time<100 GROUP BY time(10ns)", false},
+		{"SELECT interpolate(value, 'linear', 0s) FROM cpu WHERE time>=1 AND time<100 GROUP BY time(10ns)", false},
+		{"SELECT interpolate(value, 'linear', 1) FROM cpu WHERE time>=1 AND time<100 GROUP BY time(10ns)", false},
+	}
+
+	for i := 0; i < len(cases); i++ {
+		q, err := influxql.ParseQuery(cases[i].query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		statement := q.Statements[0].(*influxql.SelectStatement)
+		_, err = query.Compile(statement, query.CompileOptions{})
+		isOK := (err == nil)
+		if isOK != cases[i].isOK {
+			t.Fatalf("statement-%d compile check failed. exepect: %+v == %+v, err:%+v", i, cases[i].isOK, isOK, err)
+		}
+	}
+}
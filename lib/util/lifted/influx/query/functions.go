@@ -86,7 +86,7 @@ func (m FunctionTypeMapper) CallType(name string, args []influxql.DataType) (inf
 	case "percentile", "percentile_ogsketch", "percentile_approx", "histogram", "distinct", "top", "bottom",
 		"difference", "non_negative_difference", "mode", "spread", "sample", "cumulative_sum":
 		return args[0], nil
-	case "ogsketch_percentile":
+	case "ogsketch_percentile", TimeWeightedArea, TimeWeightedDuration:
 		return influxql.Float, nil
 	case "ogsketch_insert", "ogsketch_merge":
 		return influxql.FloatTuple, nil
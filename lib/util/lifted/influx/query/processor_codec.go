@@ -81,6 +81,7 @@ func encodeProcessorOptions(opt *ProcessorOptions) *internal.ProcessorOptions {
 		IterID:                opt.IterID,
 		PromQuery:             opt.PromQuery,
 		PromRemoteRead:        opt.PromRemoteRead,
+		AggPushUp:             opt.AggPushUp,
 		Without:               opt.Without,
 		Step:                  int64(opt.Step),
 		Range:                 int64(opt.Range),
@@ -160,6 +161,7 @@ func decodeProcessorOptions(pb *internal.ProcessorOptions) (*ProcessorOptions, e
 		IterID:                pb.IterID,
 		PromQuery:             pb.GetPromQuery(),
 		PromRemoteRead:        pb.GetPromRemoteRead(),
+		AggPushUp:             pb.GetAggPushUp(),
 		Without:               pb.GetWithout(),
 		Step:                  time.Duration(pb.Step),
 		Range:                 time.Duration(pb.Range),
@@ -258,6 +258,10 @@ type ProcessorOptions struct {
 	// PromRemoteRead indicates whether the query is a prom remote read.
 	PromRemoteRead bool
 
+	// AggPushUp indicates that the aggregates are evaluated by the sql node only, because evaluating
+	// them on the stores first does not reduce the rows sent to the sql node.
+	AggPushUp bool
+
 	// Step is query resolution step width in duration format or float number of seconds for Prom.
 	Step time.Duration
 
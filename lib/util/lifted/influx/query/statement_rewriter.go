@@ -12,6 +12,7 @@ import (
 	"errors"
 	"regexp"
 
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 )
 
@@ -38,11 +39,29 @@ func RewriteStatement(stmt influxql.Statement) (influxql.Statement, error) {
 		return rewriteShowTagValuesStatement(stmt)
 	case *influxql.ShowTagValuesCardinalityStatement:
 		return rewriteShowTagValuesCardinalityStatement(stmt)
+	case *influxql.ShowQueryHistoryStatement:
+		return rewriteShowQueryHistoryStatement(stmt)
 	default:
 		return stmt, nil
 	}
 }
 
+// rewriteShowQueryHistoryStatement selects the slow queries recorded in the query history, the latest queries first.
+func rewriteShowQueryHistoryStatement(stmt *influxql.ShowQueryHistoryStatement) (influxql.Statement, error) {
+	return &influxql.SelectStatement{
+		Fields: []*influxql.Field{{Expr: &influxql.Wildcard{}}},
+		Sources: influxql.Sources{&influxql.Measurement{
+			Database: statistics.QueryHistoryDatabase,
+			Name:     statistics.QueryHistoryName,
+		}},
+		Condition:  stmt.Condition,
+		SortFields: influxql.SortFields{{Name: "time", Ascending: false}},
+		Limit:      stmt.Limit,
+		Offset:     stmt.Offset,
+		IsRawQuery: true,
+	}, nil
+}
+
 func rewriteShowFieldKeysStatement(stmt *influxql.ShowFieldKeysStatement) (influxql.Statement, error) {
 	return stmt, nil
 }
@@ -0,0 +1,18 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRewriteShowQueryHistoryStatement(t *testing.T) {
+	stmt, err := RewriteStatement(&influxql.ShowQueryHistoryStatement{
+		Condition: &influxql.BinaryExpr{Op: influxql.EQ, LHS: &influxql.VarRef{Val: "user"}, RHS: &influxql.StringLiteral{Val: "u0"}},
+		Limit:     10,
+		Offset:    5,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `SELECT * FROM _internal..query_history WHERE "user" = 'u0' ORDER BY time DESC LIMIT 10 OFFSET 5`, stmt.String())
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/pingcap/failpoint"
 	"go.uber.org/zap"
@@ -247,7 +248,7 @@ func (t *TaskManager) AttachQuery(q *influxql.Query, opt ExecutionOptions, inter
 			})
 		}
 	}
-	qCtx := context.Background()
+	qCtx := tracing.ContextWithOTelSpan(context.Background(), opt.TraceContext)
 	qCtx = context.WithValue(qCtx, QueryIDKey, qids)
 	qCtx = context.WithValue(qCtx, QueryDurationKey, qStat)
 	ctx := &ExecutionContext{
@@ -92,7 +92,7 @@ func MustOpenReaderAt(path string) *ReaderAt {
 	}
 	var r ReaderAt
 	r.f = f
-	if enableMmap {
+	if enableMmap && !fileops.IsEncryptedFile(f) {
 		fi, err := f.Stat()
 		if err != nil {
 			MustClose(f)
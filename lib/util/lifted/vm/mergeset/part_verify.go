@@ -0,0 +1,74 @@
+package mergeset
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// PartCorruption describes a file part of the table which cannot be read.
+type PartCorruption struct {
+	Path string
+	Err  error
+}
+
+func (pc *PartCorruption) String() string {
+	return fmt.Sprintf("part %s: %v", pc.Path, pc.Err)
+}
+
+// VerifyParts reads all the blocks of the file parts of tb and checks they match the part headers
+// and the items are sorted.
+//
+// The parts are referenced while they are verified, so they cannot be removed by the background merges.
+// onBlock is called with the size of every block read and may be used for throttling.
+func (tb *Table) VerifyParts(onBlock func(size int)) (parts int, corruptions []PartCorruption) {
+	pws := tb.getParts(nil)
+	defer tb.putParts(pws)
+
+	for _, pw := range pws {
+		if pw.mp != nil || pw.p == nil {
+			continue
+		}
+		parts++
+		if err := verifyFilePart(pw.p.path, onBlock); err != nil {
+			corruptions = append(corruptions, PartCorruption{Path: pw.p.path, Err: err})
+		}
+	}
+	return parts, corruptions
+}
+
+func verifyFilePart(path string, onBlock func(size int)) (err error) {
+	bsr := getBlockStreamReader()
+	defer func() {
+		putBlockStreamReader(bsr)
+		if e := recover(); e != nil {
+			err = fmt.Errorf("cannot read the part: %v", e)
+		}
+	}()
+
+	if err = bsr.InitFromFilePart(path); err != nil {
+		return err
+	}
+
+	var lastItem []byte
+	for bsr.Next() {
+		b := &bsr.Block
+		for i := range b.items {
+			item := b.items[i].Bytes(b.data)
+			if lastItem != nil && bytes.Compare(lastItem, item) > 0 {
+				return fmt.Errorf("items are not sorted in block %d: %X > %X", bsr.blocksRead, lastItem, item)
+			}
+			lastItem = append(lastItem[:0], item...)
+		}
+		if onBlock != nil {
+			onBlock(int(bsr.bh.itemsBlockSize + bsr.bh.lensBlockSize))
+		}
+	}
+	if err = bsr.Error(); err != nil {
+		return err
+	}
+	if bsr.blocksRead != bsr.ph.blocksCount || bsr.itemsRead != bsr.ph.itemsCount {
+		return fmt.Errorf("read %d blocks and %d items, but the part header has %d blocks and %d items",
+			bsr.blocksRead, bsr.itemsRead, bsr.ph.blocksCount, bsr.ph.itemsCount)
+	}
+	return nil
+}
@@ -363,3 +363,36 @@ func TestRenameOldFile(t *testing.T) {
 	}
 	assert.Equal(t, true, exist)
 }
+
+func TestTableVerifyParts(t *testing.T) {
+	path := t.TempDir()
+	lockPath := ""
+	tb, err := OpenTable(path, nil, nil, &lockPath)
+	assert.NoError(t, err)
+	testAddItemsSerial(tb, 1e4)
+	tb.MustClose()
+
+	tb, err = OpenTable(path, nil, nil, &lockPath)
+	assert.NoError(t, err)
+	size := 0
+	parts, corruptions := tb.VerifyParts(func(n int) { size += n })
+	tb.MustClose()
+	assert.True(t, parts > 0)
+	assert.True(t, size > 0)
+	assert.Empty(t, corruptions)
+
+	// truncate the items of a part
+	items, err := filepath.Glob(filepath.Join(path, "*", "items.bin"))
+	assert.NoError(t, err)
+	assert.True(t, len(items) > 0)
+	st, err := os.Stat(items[0])
+	assert.NoError(t, err)
+	assert.NoError(t, os.Truncate(items[0], st.Size()/2))
+
+	tb, err = OpenTable(path, nil, nil, &lockPath)
+	assert.NoError(t, err)
+	defer tb.MustClose()
+	_, corruptions = tb.VerifyParts(nil)
+	assert.Equal(t, 1, len(corruptions))
+	assert.Equal(t, filepath.Dir(items[0]), corruptions[0].Path)
+}
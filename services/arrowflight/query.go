@@ -0,0 +1,325 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrowflight
+
+import (
+	json2 "encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/apache/arrow/go/v13/arrow/ipc"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultQueryChunkSize      = 10000
+	MaxQueryChunkSize          = DefaultQueryChunkSize * 50
+	DefaultQueryInnerChunkSize = 1024
+)
+
+type QueryExecutor interface {
+	ExecuteQuery(query *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query.Result
+}
+
+// QueryTicket is the ticket of DoGet, a SELECT statement to execute on the database.
+type QueryTicket struct {
+	DataBase        string `json:"db"`
+	RetentionPolicy string `json:"rp"`
+	Query           string `json:"q"`
+	ChunkSize       int    `json:"chunk_size"`
+}
+
+// SeriesMetaData is the app metadata of each record sent by DoGet, the series the rows of the record belong to.
+// Partial is true if the next record goes on with the rows of the same series.
+type SeriesMetaData struct {
+	Name    string            `json:"name"`
+	Tags    map[string]string `json:"tags,omitempty"`
+	Partial bool              `json:"partial,omitempty"`
+}
+
+// queryServer streams the results of a query as arrow records. The results are produced by a chunked query,
+// so the sql node only holds the chunk being sent: the stream blocks once the flow control window of the
+// client is full, which stops the query executor from reading the data of the stores in turn.
+type queryServer struct {
+	QueryExecutor
+	auth   *authServer
+	mem    memory.Allocator
+	logger *logger.Logger
+}
+
+func NewQueryServer(logger *logger.Logger, auth *authServer) *queryServer {
+	return &queryServer{
+		auth:   auth,
+		mem:    memory.NewGoAllocator(),
+		logger: logger,
+	}
+}
+
+func (s *queryServer) SetExecutor(executor QueryExecutor) {
+	s.QueryExecutor = executor
+}
+
+func (s *queryServer) DoGet(tkt *flight.Ticket, server flight.FlightService_DoGetServer) error {
+	if s.QueryExecutor == nil {
+		return status.Error(codes.Unimplemented, "arrow flight query is not enabled")
+	}
+	ticket := &QueryTicket{}
+	if err := json2.Unmarshal(tkt.GetTicket(), ticket); err != nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid ticket: %s", err))
+	}
+	q, err := parseQueryTicket(ticket)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	authorizer, err := s.auth.authorizeQuery(server.Context(), ticket.DataBase, q)
+	if err != nil {
+		return err
+	}
+
+	atomic.AddInt64(&statistics.HandlerStat.QueryRequests, 1)
+	atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, 1)
+	defer func(start time.Time) {
+		atomic.AddInt64(&statistics.HandlerStat.ActiveQueryRequests, -1)
+		atomic.AddInt64(&statistics.HandlerStat.QueryRequestDuration, time.Since(start).Nanoseconds())
+	}(time.Now())
+
+	chunkSize := ticket.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultQueryChunkSize
+	}
+	closing := make(chan struct{})
+	results := s.ExecuteQuery(q, query.ExecutionOptions{
+		Database:        ticket.DataBase,
+		RetentionPolicy: ticket.RetentionPolicy,
+		ChunkSize:       chunkSize,
+		Chunked:         true,
+		ReadOnly:        true,
+		InnerChunkSize:  DefaultQueryInnerChunkSize,
+		Quiet:           true,
+		Authorizer:      authorizer,
+		AbortCh:         closing,
+	}, closing, nil)
+	defer func() {
+		// abort the query if the stream ends before all the results are sent
+		close(closing)
+		for range results {
+		}
+	}()
+
+	writer := newSeriesRecordWriter(server, s.mem)
+	defer writer.Close()
+	for r := range results {
+		if r == nil {
+			continue
+		}
+		if r.Err != nil {
+			s.logger.Error("arrow flight DoGet query failed", zap.String("query", ticket.Query), zap.Error(r.Err))
+			return status.Error(codes.Internal, r.Err.Error())
+		}
+		if err = writer.Write(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseQueryTicket(ticket *QueryTicket) (*influxql.Query, error) {
+	if ticket.DataBase == "" {
+		return nil, fmt.Errorf("database is required")
+	}
+	if ticket.ChunkSize > MaxQueryChunkSize {
+		return nil, fmt.Errorf("chunk_size %d larger than max chunk_size %d", ticket.ChunkSize, MaxQueryChunkSize)
+	}
+
+	p := influxql.NewParser(strings.NewReader(ticket.Query))
+	defer p.Release()
+	yyParser := influxql.NewYyParser(p.GetScanner(), p.GetPara())
+	yyParser.ParseTokens()
+	q, err := yyParser.GetQuery()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing query: %s", err)
+	}
+	if len(q.Statements) != 1 {
+		return nil, fmt.Errorf("only one statement is supported, got %d", len(q.Statements))
+	}
+	if _, ok := q.Statements[0].(*influxql.SelectStatement); !ok {
+		return nil, fmt.Errorf("only SELECT statement is supported")
+	}
+	return q, nil
+}
+
+// seriesRecordWriter converts the rows of each series of the results into an arrow record. All the
+// records share the schema of the first series with rows, which is sent before the first record.
+type seriesRecordWriter struct {
+	stream  flight.DataStreamWriter
+	mem     memory.Allocator
+	schema  *arrow.Schema
+	columns []string
+	writer  *flight.Writer
+}
+
+func newSeriesRecordWriter(stream flight.DataStreamWriter, mem memory.Allocator) *seriesRecordWriter {
+	return &seriesRecordWriter{stream: stream, mem: mem}
+}
+
+func (w *seriesRecordWriter) Write(r *query.Result) error {
+	for _, row := range r.Series {
+		if len(row.Values) == 0 {
+			continue
+		}
+		if w.writer == nil {
+			w.columns = row.Columns
+			w.schema = buildSeriesSchema(row)
+			w.writer = flight.NewRecordWriter(w.stream, ipc.WithSchema(w.schema), ipc.WithAllocator(w.mem))
+		}
+		if err := w.writeSeries(row, row.Partial); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *seriesRecordWriter) writeSeries(row *models.Row, partial bool) error {
+	if !equalColumns(w.columns, row.Columns) {
+		return status.Error(codes.Internal, fmt.Sprintf("columns of series %s differ from the first series", row.Name))
+	}
+	builder := array.NewRecordBuilder(w.mem, w.schema)
+	defer builder.Release()
+	for _, values := range row.Values {
+		for i := range w.columns {
+			if err := appendValue(builder.Field(i), values[i]); err != nil {
+				return status.Error(codes.Internal, fmt.Sprintf("column %s: %s", w.columns[i], err))
+			}
+		}
+	}
+	rec := builder.NewRecord()
+	defer rec.Release()
+
+	appMeta, err := json2.Marshal(&SeriesMetaData{Name: row.Name, Tags: row.Tags, Partial: partial})
+	if err != nil {
+		return err
+	}
+	return w.writer.WriteWithAppMetadata(rec, appMeta)
+}
+
+func (w *seriesRecordWriter) Close() {
+	if w.writer != nil {
+		_ = w.writer.Close()
+	}
+}
+
+// buildSeriesSchema derives the type of each column from its first non-nil value in the series.
+// The columns without any value are float columns, the most common type of the fields.
+func buildSeriesSchema(row *models.Row) *arrow.Schema {
+	fields := make([]arrow.Field, len(row.Columns))
+	for i, name := range row.Columns {
+		fields[i] = arrow.Field{Name: name, Type: arrow.PrimitiveTypes.Float64, Nullable: true}
+		for _, values := range row.Values {
+			if dataType := arrowType(values[i]); dataType != nil {
+				fields[i].Type = dataType
+				break
+			}
+		}
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+func arrowType(v interface{}) arrow.DataType {
+	switch v.(type) {
+	case time.Time:
+		return arrow.FixedWidthTypes.Timestamp_ns
+	case float64:
+		return arrow.PrimitiveTypes.Float64
+	case int64:
+		return arrow.PrimitiveTypes.Int64
+	case uint64:
+		return arrow.PrimitiveTypes.Uint64
+	case string:
+		return arrow.BinaryTypes.String
+	case bool:
+		return arrow.FixedWidthTypes.Boolean
+	default:
+		return nil
+	}
+}
+
+func appendValue(b array.Builder, v interface{}) error {
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+	ok := false
+	switch builder := b.(type) {
+	case *array.TimestampBuilder:
+		var t time.Time
+		if t, ok = v.(time.Time); ok {
+			builder.Append(arrow.Timestamp(t.UnixNano()))
+		}
+	case *array.Float64Builder:
+		var f float64
+		if f, ok = v.(float64); ok {
+			builder.Append(f)
+		}
+	case *array.Int64Builder:
+		var i int64
+		if i, ok = v.(int64); ok {
+			builder.Append(i)
+		}
+	case *array.Uint64Builder:
+		var u uint64
+		if u, ok = v.(uint64); ok {
+			builder.Append(u)
+		}
+	case *array.StringBuilder:
+		var s string
+		if s, ok = v.(string); ok {
+			builder.Append(s)
+		}
+	case *array.BooleanBuilder:
+		var bl bool
+		if bl, ok = v.(bool); ok {
+			builder.Append(bl)
+		}
+	}
+	if !ok {
+		return fmt.Errorf("value %v of type %T does not match the column type %s", v, v, b.Type())
+	}
+	return nil
+}
+
+func equalColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
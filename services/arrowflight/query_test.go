@@ -0,0 +1,202 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrowflight_test
+
+import (
+	"context"
+	json2 "encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/services/arrowflight"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type MockQueryExecutor struct {
+	results []*query.Result
+	opt     query.ExecutionOptions
+	aborted chan struct{}
+}
+
+func (e *MockQueryExecutor) ExecuteQuery(_ *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, _ *statistics.SQLSlowQueryStatistics) <-chan *query.Result {
+	e.opt = opt
+	e.aborted = make(chan struct{})
+	results := make(chan *query.Result)
+	go func() {
+		defer close(results)
+		for _, r := range e.results {
+			select {
+			case results <- r:
+			case <-closing:
+				close(e.aborted)
+				return
+			}
+		}
+	}()
+	return results
+}
+
+func newMockQueryResults() []*query.Result {
+	t0 := time.Unix(0, 1629129600000000000)
+	columns := []string{"time", "value", "host"}
+	return []*query.Result{
+		{Series: models.Rows{
+			{Name: "cpu", Tags: map[string]string{"region": "east"}, Columns: columns, Partial: true,
+				Values: [][]interface{}{{t0, 1.5, "h1"}, {t0.Add(time.Second), nil, "h1"}}},
+		}, Partial: true},
+		{Series: models.Rows{
+			{Name: "cpu", Tags: map[string]string{"region": "east"}, Columns: columns,
+				Values: [][]interface{}{{t0.Add(2 * time.Second), 2.5, "h2"}}},
+			{Name: "cpu", Tags: map[string]string{"region": "west"}, Columns: columns,
+				Values: [][]interface{}{{t0, 3.5, nil}}},
+		}},
+	}
+}
+
+func startQueryService(t *testing.T, executor arrowflight.QueryExecutor) (*arrowflight.Service, flight.Client) {
+	c := config.Config{
+		FlightAddress: "127.0.0.1:8089",
+		MaxBodySize:   1024 * 1024,
+	}
+	service, err := arrowflight.NewService(c)
+	require.NoError(t, err)
+	service.MetaClient = NewMockFlightMetaClient()
+	service.RecordWriter = &MockRecordWriter{}
+	service.QueryExecutor = executor
+	require.NoError(t, service.Open())
+
+	client, err := flight.NewFlightClient(service.GetServer().Addr().String(), &clientAuth{}, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	return service, client
+}
+
+func doGetTicket(t *testing.T, ticket *arrowflight.QueryTicket) *flight.Ticket {
+	buf, err := json2.Marshal(ticket)
+	require.NoError(t, err)
+	return &flight.Ticket{Ticket: buf}
+}
+
+func TestArrowFlightDoGet(t *testing.T) {
+	executor := &MockQueryExecutor{results: newMockQueryResults()}
+	service, client := startQueryService(t, executor)
+	defer func() {
+		assert.NoError(t, client.Close())
+		assert.NoError(t, service.Close())
+	}()
+
+	stream, err := client.DoGet(context.Background(), doGetTicket(t, &arrowflight.QueryTicket{DataBase: "db0", Query: "SELECT value, host FROM cpu GROUP BY region", ChunkSize: 2}))
+	require.NoError(t, err)
+	reader, err := flight.NewRecordReader(stream)
+	require.NoError(t, err)
+	defer reader.Release()
+
+	assert.Equal(t, arrow.FixedWidthTypes.Timestamp_ns, reader.Schema().Field(0).Type)
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, reader.Schema().Field(1).Type)
+	assert.Equal(t, arrow.BinaryTypes.String, reader.Schema().Field(2).Type)
+
+	var metas []arrowflight.SeriesMetaData
+	var values []float64
+	var nulls int
+	for reader.Next() {
+		meta := arrowflight.SeriesMetaData{}
+		require.NoError(t, json2.Unmarshal(reader.LatestAppMetadata(), &meta))
+		metas = append(metas, meta)
+
+		col := reader.Record().Column(1).(*array.Float64)
+		for i := 0; i < col.Len(); i++ {
+			if col.IsNull(i) {
+				nulls++
+				continue
+			}
+			values = append(values, col.Value(i))
+		}
+	}
+	require.NoError(t, reader.Err())
+
+	assert.Equal(t, []arrowflight.SeriesMetaData{
+		{Name: "cpu", Tags: map[string]string{"region": "east"}, Partial: true},
+		{Name: "cpu", Tags: map[string]string{"region": "east"}},
+		{Name: "cpu", Tags: map[string]string{"region": "west"}},
+	}, metas)
+	assert.Equal(t, []float64{1.5, 2.5, 3.5}, values)
+	assert.Equal(t, 1, nulls)
+
+	// the query is chunked, so the sql node only holds the chunk being sent
+	assert.True(t, executor.opt.Chunked)
+	assert.Equal(t, 2, executor.opt.ChunkSize)
+	assert.Equal(t, "db0", executor.opt.Database)
+}
+
+func TestArrowFlightDoGetError(t *testing.T) {
+	executor := &MockQueryExecutor{results: []*query.Result{{Err: errors.New("query failed")}, newMockQueryResults()[0]}}
+	service, client := startQueryService(t, executor)
+	defer func() {
+		assert.NoError(t, client.Close())
+		assert.NoError(t, service.Close())
+	}()
+
+	recvErr := func(ticket *arrowflight.QueryTicket) error {
+		stream, err := client.DoGet(context.Background(), doGetTicket(t, ticket))
+		require.NoError(t, err)
+		for {
+			if _, err = stream.Recv(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the query is aborted once the stream fails
+	err := recvErr(&arrowflight.QueryTicket{DataBase: "db0", Query: "SELECT value FROM cpu"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	select {
+	case <-executor.aborted:
+	case <-time.After(10 * time.Second):
+		t.Fatal("query is not aborted")
+	}
+
+	err = recvErr(&arrowflight.QueryTicket{Query: "SELECT value FROM cpu"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = recvErr(&arrowflight.QueryTicket{DataBase: "db0", Query: "SELECT value FROM cpu; SELECT value FROM mem"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = recvErr(&arrowflight.QueryTicket{DataBase: "db0", Query: "SHOW MEASUREMENTS"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	stream, err := client.DoGet(context.Background(), &flight.Ticket{Ticket: []byte("{")})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// a query without any row sends nothing
+	executor.results = nil
+	err = recvErr(&arrowflight.QueryTicket{DataBase: "db0", Query: "SELECT value FROM cpu"})
+	assert.Equal(t, io.EOF, err)
+}
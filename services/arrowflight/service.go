@@ -15,6 +15,7 @@
 package arrowflight
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	json2 "encoding/json"
@@ -37,10 +38,13 @@ import (
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	influxql2 "github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -48,6 +52,9 @@ const (
 	WriteAuthSuccess      string = "ArrowFlightWriteSuccessfully"
 	WriteAuthTokenSalty   int64  = 1e9
 	WriteAuthTokenTimeOut        = 24 * time.Hour
+
+	// AuthTokenHeader is the grpc metadata key the client sends the auth token with.
+	AuthTokenHeader = "auth-token-bin"
 )
 
 type RecordWriter interface {
@@ -70,6 +77,7 @@ type FlightMetaClient interface {
 type Service struct {
 	server           flight.Server
 	writer           *writeServer
+	query            *queryServer
 	authHandler      *authServer
 	Config           *config.Config
 	Logger           *logger.Logger
@@ -81,12 +89,25 @@ type Service struct {
 	RecordWriter interface {
 		RetryWriteRecord(database, retentionPolicy, measurement string, rec arrow.Record) error
 	}
+
+	QueryExecutor QueryExecutor
+}
+
+// flightServer serves the writes of DoPut and the queries of DoGet.
+type flightServer struct {
+	*writeServer
+	query *queryServer
+}
+
+func (s *flightServer) DoGet(tkt *flight.Ticket, server flight.FlightService_DoGetServer) error {
+	return s.query.DoGet(tkt, server)
 }
 
 func NewService(c config.Config) (*Service, error) {
 	sLogger := logger.NewLogger(errno.ModuleHTTP)
 	writer := NewWriteServer(sLogger)
 	authHandler := NewAuthServer(c.FlightAuthEnabled)
+	reader := NewQueryServer(sLogger, authHandler)
 	var maxRecvMsgSize int
 	if c.MaxBodySize <= 0 {
 		maxRecvMsgSize = config.DefaultMaxBodySize
@@ -96,7 +117,7 @@ func NewService(c config.Config) (*Service, error) {
 
 	server := flight.NewServerWithMiddleware(nil, grpc.MaxRecvMsgSize(maxRecvMsgSize))
 	writer.SetAuthHandler(authHandler)
-	server.RegisterFlightService(writer)
+	server.RegisterFlightService(&flightServer{writeServer: writer, query: reader})
 	if err := server.Init(c.FlightAddress); err != nil {
 		sLogger.Error("arrow flight service start failed", zap.Error(err))
 		return nil, err
@@ -105,6 +126,7 @@ func NewService(c config.Config) (*Service, error) {
 	return &Service{
 		server:      server,
 		writer:      writer,
+		query:       reader,
 		authHandler: authHandler,
 		err:         make(chan error),
 		Logger:      sLogger,
@@ -121,6 +143,9 @@ func (s *Service) Open() error {
 	}()
 	s.authHandler.SetMetaClient(s.MetaClient)
 	s.writer.SetWriter(s.RecordWriter)
+	if s.QueryExecutor != nil {
+		s.query.SetExecutor(s.QueryExecutor)
+	}
 	return nil
 }
 
@@ -242,6 +267,37 @@ func (a *authServer) IsValid(authHashID string) (interface{}, error) {
 	return WriteAuthSuccess, nil
 }
 
+// authorizeQuery returns the authorizer of the query of the user the auth token of ctx is issued to.
+func (a *authServer) authorizeQuery(ctx context.Context, database string, q *influxql2.Query) (query.FineAuthorizer, error) {
+	if !a.authEnabled {
+		return query.OpenAuthorizer, nil
+	}
+	var authHashID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(AuthTokenHeader); len(values) > 0 {
+			authHashID = values[0]
+		}
+	}
+	a.mu.RLock()
+	token, ok := a.token[authHashID]
+	a.mu.RUnlock()
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "invalid auth token")
+	}
+
+	u, err := a.client.User(token.Username)
+	if err != nil || u == nil {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("user %s not found", token.Username))
+	}
+	if u.AuthorizeUnrestricted() {
+		return query.OpenAuthorizer, nil
+	}
+	if err = u.AuthorizeQuery(database, q); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return u, nil
+}
+
 func (a *authServer) Close() {
 	a.token = nil
 	a.client = nil
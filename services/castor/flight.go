@@ -0,0 +1,160 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package castor
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/apache/arrow/go/v13/arrow/ipc"
+	"github.com/openGemini/openGemini/lib/errno"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxFlightRetry is the number of other workers tried after a worker failed
+const maxFlightRetry = 1
+
+// exchange sends the record to the worker in a DoExchange stream, the algorithm is carried by the descriptor
+func (w *worker) exchange(ctx context.Context, algo string, rec arrow.Record) ([]arrow.Record, error) {
+	atomic.AddInt64(&w.inflight, 1)
+	defer atomic.AddInt64(&w.inflight, -1)
+
+	stream, err := w.client.DoExchange(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wr := flight.NewRecordWriter(stream, ipc.WithSchema(rec.Schema()))
+	wr.SetFlightDescriptor(&flight.FlightDescriptor{Type: flight.DescriptorCMD, Cmd: []byte(algo)})
+	if err := wr.Write(rec); err != nil {
+		_ = wr.Close()
+		return nil, err
+	}
+	if err := wr.Close(); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	rdr, err := flight.NewRecordReader(stream)
+	if err != nil {
+		return nil, err
+	}
+	defer rdr.Release()
+	var results []arrow.Record
+	for rdr.Next() {
+		res := rdr.Record()
+		res.Retain()
+		results = append(results, res)
+	}
+	if err := rdr.Err(); err != nil {
+		releaseRecords(results)
+		return nil, err
+	}
+	return results, nil
+}
+
+// isWorkerFailure returns true if the error is caused by the worker instead of the data or the query
+func isWorkerFailure(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted, codes.Unknown, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// processFlight sends every record to the least loaded healthy worker serving its algorithm, another worker is tried
+// if the worker fails
+func (s *Service) processFlight(ctx context.Context, recs []arrow.Record) ([]arrow.Record, error) {
+	results := make([][]arrow.Record, len(recs))
+	errs := make([]error, len(recs))
+	limit := make(chan struct{}, s.Config.ConnPoolSize)
+	var wg sync.WaitGroup
+	for i := range recs {
+		wg.Add(1)
+		limit <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-limit
+				wg.Done()
+			}()
+			results[i], errs[i] = s.processRecord(ctx, recs[i])
+		}(i)
+	}
+	wg.Wait()
+
+	var responses []arrow.Record
+	for _, res := range results {
+		responses = append(responses, res...)
+	}
+	for _, err := range errs {
+		if err != nil {
+			releaseRecords(responses)
+			return nil, err
+		}
+	}
+	return responses, nil
+}
+
+func (s *Service) processRecord(ctx context.Context, rec arrow.Record) ([]arrow.Record, error) {
+	algo, err := GetMetaValueFromRecord(rec, string(Algorithm))
+	if err != nil {
+		return nil, err
+	}
+	tried := make(map[*worker]struct{}, maxFlightRetry+1)
+	for {
+		wk, err := s.registry.pick(algo, tried)
+		if err != nil {
+			return nil, err
+		}
+		tried[wk] = struct{}{}
+		results, exErr := wk.exchange(ctx, algo, rec)
+		if exErr == nil {
+			for _, res := range results {
+				if err := checkRecordType(res); err != nil {
+					releaseRecords(results)
+					return nil, err
+				}
+			}
+			return results, nil
+		}
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, errno.NewError(errno.ResponseTimeout)
+		} else if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		failure := isWorkerFailure(ctx, exErr)
+		if failure {
+			s.registry.markUnhealthy(wk, exErr)
+		}
+		if !failure || len(tried) > maxFlightRetry {
+			return nil, errno.NewThirdParty(exErr, errno.ModuleCastor)
+		}
+	}
+}
+
+func releaseRecords(recs []arrow.Record) {
+	for _, r := range recs {
+		r.Release()
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package castor
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/stretchr/testify/require"
+)
+
+func newMockFlightWorker(t *testing.T, algorithms ...string) *MockFlightWorker {
+	w, err := NewMockFlightWorker("127.0.0.1:0", algorithms...)
+	require.NoError(t, err)
+	t.Cleanup(w.Stop)
+	return w
+}
+
+func newFlightService(t *testing.T, workers ...config.CastorWorker) *Service {
+	c := config.NewCastor()
+	c.Enabled = true
+	c.Protocol = config.CastorProtocolFlight
+	c.ResultWaitTimeout = 1
+	c.Workers = workers
+	require.NoError(t, c.Validate())
+
+	srv := NewService(c)
+	require.NoError(t, srv.Open())
+	t.Cleanup(func() {
+		require.NoError(t, srv.Close())
+	})
+	return srv
+}
+
+func buildDataRecord(algo string, values ...float64) arrow.Record {
+	meta := arrow.NewMetadata(
+		[]string{string(Algorithm), string(TaskID), string(MessageType)},
+		[]string{algo, "task", string(DATA)},
+	)
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "f", Type: arrow.PrimitiveTypes.Float64},
+		{Name: string(DataTime), Type: arrow.PrimitiveTypes.Int64},
+	}, &meta)
+	b := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	defer b.Release()
+	for i, v := range values {
+		b.Field(0).(*array.Float64Builder).Append(v)
+		b.Field(1).(*array.Int64Builder).Append(int64(i))
+	}
+	return b.NewRecord()
+}
+
+func processValues(t *testing.T, srv *Service, ctx context.Context, recs ...arrow.Record) ([]float64, error) {
+	resps, err := srv.Process(ctx, "task", recs)
+	if err != nil {
+		return nil, err
+	}
+	var values []float64
+	for _, resp := range resps {
+		values = append(values, resp.Column(0).(*array.Float64).Float64Values()...)
+		num, err := GetMetaValueFromRecord(resp, string(AnomalyNum))
+		require.Nil(t, err)
+		require.NotEmpty(t, num)
+		resp.Release()
+	}
+	sort.Float64s(values)
+	return values, nil
+}
+
+func Test_Flight_Process(t *testing.T) {
+	w := newMockFlightWorker(t, "mad")
+	srv := newFlightService(t, config.CastorWorker{Addr: w.Addr()})
+	require.Equal(t, []string{w.Addr()}, srv.HealthyWorkers())
+
+	values, err := processValues(t, srv, context.Background(), buildDataRecord("mad", 1, 2), buildDataRecord("mad", 3))
+	require.NoError(t, err)
+	require.Equal(t, []float64{1, 2, 3}, values)
+	require.Equal(t, int64(2), w.Requests())
+}
+
+func Test_Flight_LeastLoaded(t *testing.T) {
+	busy := newMockFlightWorker(t, "mad")
+	idle := newMockFlightWorker(t, "mad")
+	busy.SetLoad(10)
+	srv := newFlightService(t, config.CastorWorker{Addr: busy.Addr()}, config.CastorWorker{Addr: idle.Addr()})
+
+	_, err := processValues(t, srv, context.Background(), buildDataRecord("mad", 1), buildDataRecord("mad", 2))
+	require.NoError(t, err)
+	require.Equal(t, int64(0), busy.Requests())
+	require.Equal(t, int64(2), idle.Requests())
+
+	// the load is reported by the next health check
+	busy.SetLoad(0)
+	idle.SetLoad(10)
+	srv.CheckWorkers()
+	_, err = processValues(t, srv, context.Background(), buildDataRecord("mad", 1))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), busy.Requests())
+}
+
+func Test_Flight_AlgorithmRouting(t *testing.T) {
+	w1 := newMockFlightWorker(t, "mad", "stl")
+	w2 := newMockFlightWorker(t, "mad", "stl")
+	// w1 only serves mad though it reports stl
+	srv := newFlightService(t, config.CastorWorker{Addr: w1.Addr(), Algorithms: []string{"mad"}},
+		config.CastorWorker{Addr: w2.Addr()})
+	w2.SetLoad(10)
+	srv.CheckWorkers()
+
+	_, err := processValues(t, srv, context.Background(), buildDataRecord("stl", 1))
+	require.NoError(t, err)
+	require.Equal(t, int64(0), w1.Requests())
+	require.Equal(t, int64(1), w2.Requests())
+
+	_, err = processValues(t, srv, context.Background(), buildDataRecord("ewma", 1))
+	require.True(t, errno.Equal(err, errno.NoAvailableWorker), err)
+}
+
+func Test_Flight_Failover(t *testing.T) {
+	dead := newMockFlightWorker(t, "mad")
+	alive := newMockFlightWorker(t, "mad")
+	alive.SetLoad(10)
+	srv := newFlightService(t, config.CastorWorker{Addr: dead.Addr()}, config.CastorWorker{Addr: alive.Addr()})
+	require.Len(t, srv.HealthyWorkers(), 2)
+
+	// the worker dies between two health checks
+	dead.Stop()
+	values, err := processValues(t, srv, context.Background(), buildDataRecord("mad", 1))
+	require.NoError(t, err)
+	require.Equal(t, []float64{1}, values)
+	require.Equal(t, int64(1), alive.Requests())
+	require.Equal(t, []string{alive.Addr()}, srv.HealthyWorkers())
+
+	srv.CheckWorkers()
+	require.Equal(t, []string{alive.Addr()}, srv.HealthyWorkers())
+
+	alive.Stop()
+	srv.CheckWorkers()
+	require.Empty(t, srv.HealthyWorkers())
+	_, err = processValues(t, srv, context.Background(), buildDataRecord("mad", 1))
+	require.True(t, errno.Equal(err, errno.NoAvailableWorker), err)
+}
+
+func Test_Flight_Cancel(t *testing.T) {
+	w := newMockFlightWorker(t, "mad")
+	w.SetDelay(time.Minute)
+	srv := newFlightService(t, config.CastorWorker{Addr: w.Addr()})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	start := time.Now()
+	_, err := processValues(t, srv, ctx, buildDataRecord("mad", 1))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	// result-wait-timeout
+	_, err = processValues(t, srv, context.Background(), buildDataRecord("mad", 1))
+	require.True(t, errno.Equal(err, errno.ResponseTimeout), err)
+	// a slow worker is still healthy
+	require.Equal(t, []string{w.Addr()}, srv.HealthyWorkers())
+}
+
+func Test_Flight_RegisterWorker(t *testing.T) {
+	w1 := newMockFlightWorker(t, "mad")
+	srv := newFlightService(t, config.CastorWorker{Addr: w1.Addr()})
+	require.Equal(t, []string{w1.Addr()}, srv.HealthyWorkers())
+
+	w2 := newMockFlightWorker(t, "mad")
+	require.NoError(t, srv.RegisterWorker(w2.Addr()))
+	require.Error(t, srv.RegisterWorker(w2.Addr()))
+	// used after the first health check
+	require.Equal(t, []string{w1.Addr()}, srv.HealthyWorkers())
+	srv.CheckWorkers()
+	require.ElementsMatch(t, []string{w1.Addr(), w2.Addr()}, srv.HealthyWorkers())
+
+	require.True(t, srv.DeregisterWorker(w1.Addr()))
+	require.False(t, srv.DeregisterWorker(w1.Addr()))
+	require.Equal(t, []string{w2.Addr()}, srv.HealthyWorkers())
+
+	ipc := NewService(config.Castor{})
+	require.True(t, errno.Equal(ipc.RegisterWorker(w1.Addr()), errno.InvalidCastorProtocol))
+}
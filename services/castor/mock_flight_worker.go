@@ -0,0 +1,175 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package castor
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/apache/arrow/go/v13/arrow/ipc"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockFlightWorker is an arrow flight worker for the tests, the anomaly level of every point is its first field value
+type MockFlightWorker struct {
+	flight.BaseFlightServer
+
+	server     flight.Server
+	algorithms []string
+	load       int64
+	delay      int64
+	requests   int64
+	stopOnce   sync.Once
+}
+
+// NewMockFlightWorker starts a worker serving the algorithms on addr, use "127.0.0.1:0" for a random port
+func NewMockFlightWorker(addr string, algorithms ...string) (*MockFlightWorker, error) {
+	w := &MockFlightWorker{algorithms: algorithms}
+	w.server = flight.NewServerWithMiddleware(nil)
+	if err := w.server.Init(addr); err != nil {
+		return nil, err
+	}
+	w.server.RegisterFlightService(w)
+	go func() {
+		_ = w.server.Serve()
+	}()
+	return w, nil
+}
+
+// Addr returns the listening address of the worker
+func (w *MockFlightWorker) Addr() string {
+	return w.server.Addr().String()
+}
+
+// SetLoad sets the load reported by the health check
+func (w *MockFlightWorker) SetLoad(load int64) {
+	atomic.StoreInt64(&w.load, load)
+}
+
+// SetDelay sets the processing time of every record
+func (w *MockFlightWorker) SetDelay(d time.Duration) {
+	atomic.StoreInt64(&w.delay, int64(d))
+}
+
+// Requests returns the number of the records received
+func (w *MockFlightWorker) Requests() int64 {
+	return atomic.LoadInt64(&w.requests)
+}
+
+// Stop kills the worker, the running requests are canceled
+func (w *MockFlightWorker) Stop() {
+	w.stopOnce.Do(func() {
+		w.server.Shutdown()
+	})
+}
+
+func (w *MockFlightWorker) DoAction(action *flight.Action, stream flight.FlightService_DoActionServer) error {
+	if action.Type != HealthCheckAction {
+		return status.Errorf(codes.Unimplemented, "unknown action %s", action.Type)
+	}
+	body, err := json.Marshal(WorkerStatus{Load: atomic.LoadInt64(&w.load), Algorithms: w.algorithms})
+	if err != nil {
+		return err
+	}
+	return stream.Send(&flight.Result{Body: body})
+}
+
+func (w *MockFlightWorker) DoExchange(stream flight.FlightService_DoExchangeServer) error {
+	rdr, err := flight.NewRecordReader(stream)
+	if err != nil {
+		return err
+	}
+	defer rdr.Release()
+
+	var wr *flight.Writer
+	defer func() {
+		if wr != nil {
+			_ = wr.Close()
+		}
+	}()
+	for rdr.Next() {
+		atomic.AddInt64(&w.requests, 1)
+		if !w.serves(string(rdr.LatestFlightDescriptor().GetCmd())) {
+			return status.Errorf(codes.InvalidArgument, "unknown algorithm %s", rdr.LatestFlightDescriptor().GetCmd())
+		}
+		select {
+		case <-time.After(time.Duration(atomic.LoadInt64(&w.delay))):
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+
+		res := mockAnomalyLevel(rdr.Record())
+		if wr == nil {
+			wr = flight.NewRecordWriter(stream, ipc.WithSchema(res.Schema()))
+		}
+		err := wr.Write(res)
+		res.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return rdr.Err()
+}
+
+func (w *MockFlightWorker) serves(algo string) bool {
+	for _, a := range w.algorithms {
+		if a == algo {
+			return true
+		}
+	}
+	return false
+}
+
+// mockAnomalyLevel returns the first field as the anomaly level with the metadata of the input
+func mockAnomalyLevel(rec arrow.Record) arrow.Record {
+	md := rec.Schema().Metadata()
+	keys := append(append([]string{}, md.Keys()...), string(AnomalyNum))
+	vals := append(append([]string{}, md.Values()...), strconv.FormatInt(rec.NumRows(), 10))
+	meta := arrow.NewMetadata(keys, vals)
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: string(AnomalyLevel), Type: arrow.PrimitiveTypes.Float64},
+		{Name: string(DataTime), Type: arrow.PrimitiveTypes.Int64},
+	}, &meta)
+
+	b := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	defer b.Release()
+	levels := b.Field(0).(*array.Float64Builder)
+	times := b.Field(1).(*array.Int64Builder)
+	timeCol := rec.Column(int(rec.NumCols()) - 1)
+	for i := 0; i < int(rec.NumRows()); i++ {
+		switch col := rec.Column(0).(type) {
+		case *array.Float64:
+			levels.Append(col.Value(i))
+		case *array.Int64:
+			levels.Append(float64(col.Value(i)))
+		default:
+			levels.Append(0)
+		}
+		if t, ok := timeCol.(*array.Int64); ok {
+			times.Append(t.Value(i))
+		} else {
+			times.Append(0)
+		}
+	}
+	return b.NewRecord()
+}
@@ -0,0 +1,262 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package castor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// HealthCheckAction is the flight action answered by the workers with their WorkerStatus
+const HealthCheckAction = "healthcheck"
+
+// WorkerStatus is the body of the result of the health check action
+type WorkerStatus struct {
+	// number of the requests the worker is processing, from all the nodes
+	Load int64 `json:"load"`
+	// algorithms the worker is able to run
+	Algorithms []string `json:"algorithms"`
+}
+
+type worker struct {
+	addr   string
+	client flight.Client
+	// algorithms routed to the worker by the configuration, the reported ones are used if it is empty
+	configured map[string]struct{}
+
+	mu         sync.RWMutex
+	healthy    bool
+	reported   map[string]struct{}
+	load       int64
+	inflight   int64
+	lastFailed error
+}
+
+func newWorker(w config.CastorWorker) (*worker, error) {
+	cli, err := flight.NewClientWithMiddleware(w.Addr, nil, nil, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	wk := &worker{addr: w.Addr, client: cli, configured: make(map[string]struct{}, len(w.Algorithms))}
+	for _, algo := range w.Algorithms {
+		wk.configured[algo] = struct{}{}
+	}
+	return wk, nil
+}
+
+func (w *worker) serves(algo string) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if !w.healthy {
+		return false
+	}
+	algorithms := w.configured
+	if len(algorithms) == 0 {
+		algorithms = w.reported
+	}
+	_, ok := algorithms[algo]
+	return ok
+}
+
+// score is the load of the worker, the requests in flight from this node are counted before the next health check
+func (w *worker) score() int64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.load + atomic.LoadInt64(&w.inflight)
+}
+
+func (w *worker) isHealthy() bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.healthy
+}
+
+// setStatus returns true if the worker becomes healthy
+func (w *worker) setStatus(status *WorkerStatus) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	recovered := !w.healthy
+	w.healthy = true
+	w.load = status.Load
+	w.reported = make(map[string]struct{}, len(status.Algorithms))
+	for _, algo := range status.Algorithms {
+		w.reported[algo] = struct{}{}
+	}
+	w.lastFailed = nil
+	return recovered
+}
+
+// setUnhealthy returns true if the worker was healthy
+func (w *worker) setUnhealthy(err error) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	down := w.healthy
+	w.healthy = false
+	w.lastFailed = err
+	return down
+}
+
+// registry keeps the flight workers, a worker is healthy after a successful health check and unhealthy after a failed
+// health check or request. The requests are routed to the least loaded healthy worker serving the algorithm
+type registry struct {
+	logger  *logger.Logger
+	timeout time.Duration
+
+	mu      sync.RWMutex
+	workers []*worker
+}
+
+func newRegistry(log *logger.Logger, timeout time.Duration) *registry {
+	return &registry{logger: log, timeout: timeout}
+}
+
+func (r *registry) register(w config.CastorWorker) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, wk := range r.workers {
+		if wk.addr == w.Addr {
+			return fmt.Errorf("castor worker %s already registered", w.Addr)
+		}
+	}
+	wk, err := newWorker(w)
+	if err != nil {
+		return err
+	}
+	r.workers = append(r.workers, wk)
+	return nil
+}
+
+func (r *registry) deregister(addr string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, wk := range r.workers {
+		if wk.addr != addr {
+			continue
+		}
+		r.workers = append(r.workers[:i], r.workers[i+1:]...)
+		if err := wk.client.Close(); err != nil {
+			r.logger.Warn("close castor worker failed", zap.String("addr", addr), zap.Error(err))
+		}
+		return true
+	}
+	return false
+}
+
+func (r *registry) list() []*worker {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]*worker{}, r.workers...)
+}
+
+// healthy returns the sorted addresses of the healthy workers
+func (r *registry) healthy() []string {
+	var addrs []string
+	for _, wk := range r.list() {
+		if wk.isHealthy() {
+			addrs = append(addrs, wk.addr)
+		}
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+// pick returns the least loaded healthy worker serving the algorithm, except the tried ones
+func (r *registry) pick(algo string, tried map[*worker]struct{}) (*worker, *errno.Error) {
+	var best *worker
+	var bestScore int64
+	for _, wk := range r.list() {
+		if _, ok := tried[wk]; ok || !wk.serves(algo) {
+			continue
+		}
+		if score := wk.score(); best == nil || score < bestScore {
+			best, bestScore = wk, score
+		}
+	}
+	if best == nil {
+		return nil, errno.NewError(errno.NoAvailableWorker, algo)
+	}
+	return best, nil
+}
+
+func (r *registry) checkAll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, wk := range r.list() {
+		wg.Add(1)
+		go func(wk *worker) {
+			defer wg.Done()
+			r.check(ctx, wk)
+		}(wk)
+	}
+	wg.Wait()
+}
+
+func (r *registry) check(ctx context.Context, wk *worker) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	status, err := healthCheck(ctx, wk.client)
+	if err != nil {
+		r.markUnhealthy(wk, err)
+		return
+	}
+	if wk.setStatus(status) {
+		r.logger.Info("castor worker is healthy", zap.String("addr", wk.addr), zap.Strings("algorithms", status.Algorithms))
+	}
+}
+
+func (r *registry) markUnhealthy(wk *worker, err error) {
+	if wk.setUnhealthy(err) {
+		r.logger.Warn("castor worker is unhealthy", zap.String("addr", wk.addr), zap.Error(err))
+	}
+}
+
+func (r *registry) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, wk := range r.workers {
+		if err := wk.client.Close(); err != nil {
+			r.logger.Warn("close castor worker failed", zap.String("addr", wk.addr), zap.Error(err))
+		}
+	}
+	r.workers = nil
+}
+
+func healthCheck(ctx context.Context, cli flight.Client) (*WorkerStatus, error) {
+	stream, err := cli.DoAction(ctx, &flight.Action{Type: HealthCheckAction})
+	if err != nil {
+		return nil, err
+	}
+	res, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	status := &WorkerStatus{}
+	if err := json.Unmarshal(res.Body, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
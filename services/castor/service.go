@@ -46,6 +46,9 @@ type Service struct {
 	resultChan      chan arrow.Record
 	responseChanMap sync.Map
 
+	// registry of the arrow flight workers, only used in flight protocol
+	registry *registry
+
 	alive bool
 }
 
@@ -61,6 +64,18 @@ func NewService(c config.Castor) *Service {
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
+	if c.IsFlight() {
+		s := &Service{Config: c, ctx: ctx, cancel: cancel}
+		s.Init("castor", 0, s.handle)
+		s.registry = newRegistry(s.Logger, time.Duration(c.HealthCheckTimeout))
+		for _, w := range c.GetWorkers() {
+			if err := s.registry.register(w); err != nil {
+				s.Logger.Error("register castor worker failed", zap.String("addr", w.Addr), zap.Error(err))
+			}
+		}
+		return s
+	}
+
 	s := &Service{
 		Config:          c,
 		ctx:             ctx,
@@ -94,6 +109,15 @@ func (s *Service) Open() error {
 	if err := s.Base.Open(); err != nil {
 		return err
 	}
+	if s.Config.IsFlight() {
+		// know the healthy workers before the first query
+		s.registry.checkAll(s.ctx)
+		s.wg.Add(1)
+		go s.checkWorkers()
+		s.alive = true
+		service = s
+		return nil
+	}
 	s.wg.Add(4)
 	go s.monitorConn()
 	go s.handleResult()
@@ -123,6 +147,23 @@ func (s *Service) monitorConn() {
 	}
 }
 
+func (s *Service) checkWorkers() {
+	ticker := time.NewTicker(time.Duration(s.Config.HealthCheckInterval))
+	defer func() {
+		s.wg.Done()
+		ticker.Stop()
+	}()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.registry.checkAll(s.ctx)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
 func (s *Service) recordFailure() {
 	defer s.wg.Done()
 	for {
@@ -298,6 +339,11 @@ func (s *Service) Close() error {
 	s.cancel()
 	s.wg.Wait()
 
+	if s.registry != nil {
+		s.registry.close()
+		return s.closeBase()
+	}
+
 	for _, p := range s.clientPool {
 		p.close()
 	}
@@ -319,6 +365,10 @@ func (s *Service) Close() error {
 	close(s.dataFailureChan)
 
 	s.responseChanMap = sync.Map{}
+	return s.closeBase()
+}
+
+func (s *Service) closeBase() error {
 	if err := s.Base.Close(); err != nil {
 		return err
 	}
@@ -343,3 +393,81 @@ func (s *Service) RegisterResultChan(id string, ch *respChan) {
 func (s *Service) DeregisterResultChan(id string) {
 	s.responseChanMap.Delete(id)
 }
+
+// Process sends the records of a task to the workers and waits for the responses until the result-wait-timeout, it
+// returns early if ctx is done. The responses must be released by the caller
+func (s *Service) Process(ctx context.Context, taskID string, recs []arrow.Record) ([]arrow.Record, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.Config.GetWaitTimeout())
+	defer cancel()
+	if s.registry != nil {
+		return s.processFlight(ctx, recs)
+	}
+	return s.processIPC(ctx, taskID, recs)
+}
+
+func (s *Service) processIPC(ctx context.Context, taskID string, recs []arrow.Record) ([]arrow.Record, error) {
+	respChan, err := NewRespChan(len(recs))
+	if err != nil {
+		return nil, err
+	}
+	s.RegisterResultChan(taskID, respChan)
+	defer func() {
+		s.DeregisterResultChan(taskID)
+		respChan.Close()
+	}()
+	for _, r := range recs {
+		s.HandleData(r)
+	}
+
+	responses := make([]arrow.Record, 0, len(recs))
+	for {
+		select {
+		case <-ctx.Done():
+			releaseRecords(responses)
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil, errno.NewError(errno.ResponseIncomplete, len(recs), len(responses))
+			}
+			return nil, ctx.Err()
+		case resp := <-respChan.C:
+			responses = append(responses, resp)
+			if len(responses) == len(recs) {
+				return responses, nil
+			}
+		case err := <-respChan.ErrCh:
+			releaseRecords(responses)
+			return nil, err
+		}
+	}
+}
+
+// RegisterWorker adds an arrow flight worker, it serves the algorithms reported by its health check if algorithms is
+// empty. The worker is used after its first successful health check
+func (s *Service) RegisterWorker(addr string, algorithms ...string) error {
+	if s.registry == nil {
+		return errno.NewError(errno.InvalidCastorProtocol, s.Config.Protocol)
+	}
+	return s.registry.register(config.CastorWorker{Addr: addr, Algorithms: algorithms})
+}
+
+// DeregisterWorker removes an arrow flight worker, the requests sent to it are not canceled
+func (s *Service) DeregisterWorker(addr string) bool {
+	if s.registry == nil {
+		return false
+	}
+	return s.registry.deregister(addr)
+}
+
+// HealthyWorkers returns the addresses of the healthy arrow flight workers
+func (s *Service) HealthyWorkers() []string {
+	if s.registry == nil {
+		return nil
+	}
+	return s.registry.healthy()
+}
+
+// CheckWorkers runs the health check of all the arrow flight workers immediately
+func (s *Service) CheckWorkers() {
+	if s.registry != nil {
+		s.registry.checkAll(s.ctx)
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryhistory
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/services"
+	"go.uber.org/zap"
+)
+
+type PointsWriter interface {
+	RetryWritePointRows(database, retentionPolicy string, rows []influx.Row) error
+}
+
+type MetaClient interface {
+	Database(name string) (*meta2.DatabaseInfo, error)
+	CreateDatabaseWithRetentionPolicy(name string, spec *meta2.RetentionPolicySpec, shardKey *meta2.ShardKeyInfo,
+		enableTagArray bool, replicaN uint32) (*meta2.DatabaseInfo, error)
+}
+
+// Record is a slow query of the query history
+type Record struct {
+	Time            int64  `json:"time"`
+	Database        string `json:"database"`
+	User            string `json:"user"`
+	Query           string `json:"query"`
+	TotalDuration   int64  `json:"totalDuration"`
+	PrepareDuration int64  `json:"prepareDuration"`
+	ScanSeries      int64  `json:"scanSeries"`
+	ScanRows        int64  `json:"scanRows"`
+	ScanBytes       int64  `json:"scanBytes"`
+	Plan            string `json:"plan"`
+}
+
+// Service records the slow queries of the ts-sql into the measurement query_history of the _internal database
+// and a rotating log file, SHOW QUERY HISTORY browses the measurement
+type Service struct {
+	Logger *logger.Logger
+
+	Config *config.QueryHistory
+
+	PointsWriter PointsWriter
+	MetaClient   MetaClient
+
+	base services.Base
+
+	host    string
+	records chan *Record
+	dropped int64
+
+	mu        sync.Mutex
+	dbCreated bool
+	log       io.WriteCloser
+}
+
+// NewService returns the query history service, the log file is created in the logging path of the ts-sql
+func NewService(c config.QueryHistory, logConf *config.Logger, host string) *Service {
+	s := &Service{
+		Logger:  logger.NewLogger(errno.ModuleHTTP).With(zap.String("service", "query_history")),
+		Config:  &c,
+		host:    host,
+		records: make(chan *Record, c.BufferSize),
+	}
+	if c.LogFile != "" && logConf != nil {
+		s.log = logConf.NewLumberjackLogger(c.LogFile)
+	}
+	s.base.Init("query_history", time.Duration(c.FlushInterval), s.flush)
+	return s
+}
+
+func (s *Service) Open() error {
+	s.Logger.Info("service open", zap.Duration("FlushInterval", time.Duration(s.Config.FlushInterval)),
+		zap.Bool("WriteMeasurement", s.Config.WriteMeasurement), zap.String("LogFile", s.Config.LogFile))
+	return s.base.Open()
+}
+
+func (s *Service) Close() error {
+	err := s.base.Close()
+	s.flush()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.log != nil {
+		if e := s.log.Close(); e != nil && err == nil {
+			err = e
+		}
+		s.log = nil
+	}
+	return err
+}
+
+// Record adds a slow query to the query history, the query is dropped if too many queries are waiting to be recorded
+func (s *Service) Record(stat *statistics.SQLSlowQueryStatistics) {
+	if stat == nil {
+		return
+	}
+	query := stat.Query
+	if len(query) > s.Config.MaxQueryLength {
+		query = query[:s.Config.MaxQueryLength]
+	}
+	rec := &Record{
+		Time:            time.Now().UnixNano(),
+		Database:        stat.DB,
+		User:            stat.User,
+		Query:           query,
+		TotalDuration:   atomic.LoadInt64(&stat.TotalDuration),
+		PrepareDuration: atomic.LoadInt64(&stat.PrepareDuration),
+		ScanSeries:      atomic.LoadInt64(&stat.ScanSeries),
+		ScanRows:        atomic.LoadInt64(&stat.ScanRows),
+		ScanBytes:       atomic.LoadInt64(&stat.ScanBytes),
+		Plan:            stat.Plan(),
+	}
+	select {
+	case s.records <- rec:
+	default:
+		atomic.AddInt64(&s.dropped, 1)
+	}
+}
+
+// Dropped returns the number of the slow queries dropped because the buffer is full
+func (s *Service) Dropped() int64 {
+	return atomic.LoadInt64(&s.dropped)
+}
+
+func (s *Service) flush() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var records []*Record
+loop:
+	for len(records) < cap(s.records) {
+		select {
+		case rec := <-s.records:
+			records = append(records, rec)
+		default:
+			break loop
+		}
+	}
+	if len(records) == 0 {
+		return
+	}
+
+	s.writeLog(records)
+	if s.Config.WriteMeasurement && s.PointsWriter != nil {
+		if err := s.writeMeasurement(records); err != nil {
+			s.Logger.Error("failed to write the query history", zap.Int("queries", len(records)), zap.Error(err))
+		}
+	}
+}
+
+func (s *Service) writeLog(records []*Record) {
+	if s.log == nil {
+		return
+	}
+	var buf []byte
+	for _, rec := range records {
+		b, err := json.Marshal(rec)
+		if err != nil {
+			continue
+		}
+		buf = append(buf, b...)
+		buf = append(buf, '\n')
+	}
+	if _, err := s.log.Write(buf); err != nil {
+		s.Logger.Error("failed to write the query history log", zap.Error(err))
+	}
+}
+
+func (s *Service) writeMeasurement(records []*Record) error {
+	if err := s.createDatabase(); err != nil {
+		return err
+	}
+	rows := make([]influx.Row, 0, len(records))
+	for _, rec := range records {
+		rows = append(rows, s.buildRow(rec))
+	}
+	return s.PointsWriter.RetryWritePointRows(statistics.QueryHistoryDatabase, "", rows)
+}
+
+// createDatabase creates the _internal database with the retention of the query history if it does not exist
+func (s *Service) createDatabase() error {
+	if s.dbCreated || s.MetaClient == nil {
+		return nil
+	}
+	db, err := s.MetaClient.Database(statistics.QueryHistoryDatabase)
+	if err != nil && !errno.Equal(err, errno.DatabaseNotFound) {
+		return err
+	}
+	if db == nil {
+		retention := time.Duration(s.Config.Retention)
+		spec := &meta2.RetentionPolicySpec{Duration: &retention}
+		if _, err = s.MetaClient.CreateDatabaseWithRetentionPolicy(statistics.QueryHistoryDatabase, spec,
+			&meta2.ShardKeyInfo{}, false, 1); err != nil {
+			return err
+		}
+	}
+	s.dbCreated = true
+	return nil
+}
+
+func (s *Service) buildRow(rec *Record) influx.Row {
+	// the tags and the fields are sorted by the key, the empty tags are skipped
+	tags := make(influx.PointTags, 0, 3)
+	for _, tag := range []influx.Tag{
+		{Key: statistics.StatSlowQueryDatabase, Value: rec.Database},
+		{Key: "host", Value: s.host},
+		{Key: statistics.StatSlowQueryUser, Value: rec.User},
+	} {
+		if tag.Value != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return influx.Row{
+		Name: statistics.QueryHistoryName,
+		Tags: tags,
+		Fields: influx.Fields{
+			{Key: statistics.StatPlan, StrValue: rec.Plan, Type: influx.Field_Type_String},
+			{Key: statistics.StatPrepareDuration, NumValue: float64(rec.PrepareDuration), Type: influx.Field_Type_Int},
+			{Key: statistics.StatQuery, StrValue: rec.Query, Type: influx.Field_Type_String},
+			{Key: statistics.StatScanBytes, NumValue: float64(rec.ScanBytes), Type: influx.Field_Type_Int},
+			{Key: statistics.StatScanRows, NumValue: float64(rec.ScanRows), Type: influx.Field_Type_Int},
+			{Key: statistics.StatScanSeries, NumValue: float64(rec.ScanSeries), Type: influx.Field_Type_Int},
+			{Key: statistics.StatTotalDuration, NumValue: float64(rec.TotalDuration), Type: influx.Field_Type_Int},
+		},
+		Timestamp: rec.Time,
+	}
+}
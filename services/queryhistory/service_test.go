@@ -0,0 +1,152 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queryhistory
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+type mockWriter struct {
+	mu   sync.Mutex
+	db   string
+	rows []influx.Row
+}
+
+func (w *mockWriter) RetryWritePointRows(database, _ string, rows []influx.Row) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.db = database
+	w.rows = append(w.rows, rows...)
+	return nil
+}
+
+type mockMetaClient struct {
+	created  int
+	duration time.Duration
+}
+
+func (c *mockMetaClient) Database(string) (*meta2.DatabaseInfo, error) {
+	if c.created > 0 {
+		return &meta2.DatabaseInfo{}, nil
+	}
+	return nil, errno.NewError(errno.DatabaseNotFound)
+}
+
+func (c *mockMetaClient) CreateDatabaseWithRetentionPolicy(_ string, spec *meta2.RetentionPolicySpec, _ *meta2.ShardKeyInfo,
+	_ bool, _ uint32) (*meta2.DatabaseInfo, error) {
+	c.created++
+	c.duration = *spec.Duration
+	return &meta2.DatabaseInfo{}, nil
+}
+
+func newSlowQuery(query string) *statistics.SQLSlowQueryStatistics {
+	stat := statistics.NewSqlSlowQueryStatistics("db0")
+	stat.SetQuery(query)
+	stat.SetUser("u0")
+	stat.AddDuration("TotalDuration", int64(11*time.Second))
+	stat.AddScan(2, 100, 4096)
+	stat.AddPlan("LogicalProject(LogicalExchange(LogicalReader))")
+	return stat
+}
+
+func TestService_Record(t *testing.T) {
+	logConf := config.NewLogger(config.AppSql)
+	logConf.Path = t.TempDir()
+	c := config.NewQueryHistory()
+	c.FlushInterval = toml.Duration(time.Hour)
+	c.MaxQueryLength = 12
+
+	w := &mockWriter{}
+	m := &mockMetaClient{}
+	s := NewService(c, &logConf, "127.0.0.1:8086")
+	s.PointsWriter = w
+	s.MetaClient = m
+	require.NoError(t, s.Open())
+
+	s.Record(nil)
+	s.Record(newSlowQuery("SELECT * FROM mst0"))
+	s.Record(newSlowQuery("SELECT * FROM mst1"))
+	// the queries are flushed by the close
+	require.NoError(t, s.Close())
+
+	require.Equal(t, 1, m.created)
+	require.Equal(t, time.Duration(c.Retention), m.duration)
+	require.Equal(t, "_internal", w.db)
+	require.Equal(t, 2, len(w.rows))
+	row := w.rows[0]
+	require.Equal(t, "query_history", row.Name)
+	require.Equal(t, influx.PointTags{{Key: "database", Value: "db0"}, {Key: "host", Value: "127.0.0.1:8086"},
+		{Key: "user", Value: "u0"}}, row.Tags)
+	fields := map[string]influx.Field{}
+	for _, f := range row.Fields {
+		fields[f.Key] = f
+	}
+	require.Equal(t, "SELECT * FRO", fields["query"].StrValue)
+	require.Equal(t, "LogicalProject(LogicalExchange(LogicalReader))", fields["plan"].StrValue)
+	require.Equal(t, float64(11*time.Second), fields["totalDuration"].NumValue)
+	require.Equal(t, float64(2), fields["scanSeries"].NumValue)
+	require.Equal(t, float64(100), fields["scanRows"].NumValue)
+	require.Equal(t, float64(4096), fields["scanBytes"].NumValue)
+
+	buf, err := os.ReadFile(filepath.Join(logConf.Path, c.LogFile+".log"))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+	require.Equal(t, 2, len(lines))
+	rec := &Record{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), rec))
+	require.Equal(t, "db0", rec.Database)
+	require.Equal(t, "u0", rec.User)
+	require.Equal(t, int64(4096), rec.ScanBytes)
+}
+
+func TestService_Dropped(t *testing.T) {
+	c := config.NewQueryHistory()
+	c.BufferSize = 1
+	c.LogFile = ""
+	c.FlushInterval = toml.Duration(time.Hour)
+
+	w := &mockWriter{}
+	s := NewService(c, nil, "")
+	s.PointsWriter = w
+	s.Record(newSlowQuery("SELECT * FROM mst0"))
+	s.Record(newSlowQuery("SELECT * FROM mst1"))
+	require.Equal(t, int64(1), s.Dropped())
+
+	// the _internal database is not created without the meta client, the empty tags are skipped
+	s.flush()
+	require.Equal(t, 1, len(w.rows))
+	require.Equal(t, influx.PointTags{{Key: "database", Value: "db0"}, {Key: "user", Value: "u0"}}, w.rows[0].Tags)
+
+	// the measurement is not written if it is disabled
+	s.Config.WriteMeasurement = false
+	s.Record(newSlowQuery("SELECT * FROM mst2"))
+	s.flush()
+	require.Equal(t, 1, len(w.rows))
+	require.NoError(t, s.Close())
+}
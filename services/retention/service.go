@@ -90,6 +90,7 @@ type Service struct {
 		DelayDeleteShardGroup(database, policy string, id uint64, deletedAt time.Time, deleteType int32) error
 		GetExpiredShards() ([]meta.ExpiredShardInfos, []meta.ExpiredShardInfos)
 		GetExpiredIndexes() []meta.ExpiredIndexInfos
+		GetExpiredMeasurements() []meta.ExpiredMeasurementInfos
 	}
 
 	Engine interface {
@@ -101,6 +102,7 @@ type Service struct {
 		ExpiredCacheIndexes() []*meta.IndexIdentifier
 		DeleteShard(db string, ptId uint32, shardID uint64) error
 		ClearIndexCache(db string, ptId uint32, indexID uint64) error
+		ExpireMeasurements(infos []meta.ExpiredMeasurementInfos) error
 	}
 
 	pendingShard PendingInfo
@@ -302,6 +304,14 @@ func (s *Service) handleLocalStorage(logger *zap.Logger, nilShardMap *map[uint64
 		}
 	}
 
+	// expire the data of the measurements with TTL
+	if expiredMsts := s.MetaClient.GetExpiredMeasurements(); len(expiredMsts) > 0 {
+		if err := s.Engine.ExpireMeasurements(expiredMsts); err != nil {
+			logger.Error("Failed to expire measurements", zap.Error(err))
+			retryNeeded = true
+		}
+	}
+
 	return retryNeeded
 }
 
@@ -48,6 +48,7 @@ type MockEngine struct {
 	ExpiredCacheIndexesFn     func() []*meta.IndexIdentifier
 	DeleteShardFn             func(db string, ptId uint32, shardID uint64) error
 	ClearIndexCacheFn         func(db string, ptId uint32, indexID uint64) error
+	ExpireMeasurementsFn      func(infos []meta.ExpiredMeasurementInfos) error
 }
 
 func (me *MockEngine) DeleteIndex(db string, ptId uint32, indexID uint64) error {
@@ -82,15 +83,20 @@ func (me *MockEngine) ClearIndexCache(db string, ptId uint32, indexID uint64) er
 	return me.ClearIndexCacheFn(db, ptId, indexID)
 }
 
+func (me *MockEngine) ExpireMeasurements(infos []meta.ExpiredMeasurementInfos) error {
+	return me.ExpireMeasurementsFn(infos)
+}
+
 type MockMetaClient struct {
-	PruneGroupsCommandFn    func(shardGroup bool, id uint64) error
-	GetShardDurationInfoFn  func(index uint64) (*meta.ShardDurationResponse, error)
-	GetIndexDurationInfoFn  func(index uint64) (*meta.IndexDurationResponse, error)
-	DeleteShardGroupFn      func(database, policy string, id uint64, deleteType int32) error
-	DeleteIndexGroupFn      func(database, policy string, id uint64) error
-	DelayDeleteShardGroupFn func(database, policy string, id uint64, deletedAt time.Time, deleteType int32) error
-	GetExpiredShardsFn      func() ([]meta.ExpiredShardInfos, []meta.ExpiredShardInfos)
-	GetExpiredIndexesFn     func() []meta.ExpiredIndexInfos
+	PruneGroupsCommandFn     func(shardGroup bool, id uint64) error
+	GetShardDurationInfoFn   func(index uint64) (*meta.ShardDurationResponse, error)
+	GetIndexDurationInfoFn   func(index uint64) (*meta.IndexDurationResponse, error)
+	DeleteShardGroupFn       func(database, policy string, id uint64, deleteType int32) error
+	DeleteIndexGroupFn       func(database, policy string, id uint64) error
+	DelayDeleteShardGroupFn  func(database, policy string, id uint64, deletedAt time.Time, deleteType int32) error
+	GetExpiredShardsFn       func() ([]meta.ExpiredShardInfos, []meta.ExpiredShardInfos)
+	GetExpiredIndexesFn      func() []meta.ExpiredIndexInfos
+	GetExpiredMeasurementsFn func() []meta.ExpiredMeasurementInfos
 }
 
 func (mc *MockMetaClient) PruneGroupsCommand(shardGroup bool, id uint64) error {
@@ -125,6 +131,10 @@ func (mc *MockMetaClient) GetExpiredIndexes() []meta.ExpiredIndexInfos {
 	return mc.GetExpiredIndexesFn()
 }
 
+func (mc *MockMetaClient) GetExpiredMeasurements() []meta.ExpiredMeasurementInfos {
+	return mc.GetExpiredMeasurementsFn()
+}
+
 func TestTTL(t *testing.T) {
 	path := t.TempDir()
 	eng, err := engine.NewEngine(path, path, netstorage.NewEngineOptions(), &metaclient.LoadCtx{LoadCh: make(chan *metaclient.DBPTCtx, 100)})
@@ -191,6 +201,10 @@ func TestTTL(t *testing.T) {
 		return nil
 	}
 
+	mockClient.GetExpiredMeasurementsFn = func() []meta.ExpiredMeasurementInfos {
+		return []meta.ExpiredMeasurementInfos{{Database: db, Policy: rp, Name: "mst_0000", EngineType: config.TSSTORE, ExpireTime: time.Now().UnixNano()}}
+	}
+
 	s.MetaClient = mockClient
 	err = s.Open()
 	if err != nil {
@@ -352,6 +366,9 @@ func TestUpdateDurationInfo(t *testing.T) {
 	mockClient.DeleteShardGroupFn = func(database, policy string, id uint64, deleteType int32) error {
 		return nil
 	}
+	mockClient.GetExpiredMeasurementsFn = func() []meta.ExpiredMeasurementInfos {
+		return nil
+	}
 
 	mockEngine := &MockEngine{}
 	mockEngine.ExpiredShardsFn = func(nilShardMap *map[uint64]*meta.ShardDurationInfo) []*meta.ShardIdentifier {
@@ -416,3 +433,42 @@ func TestUpdateIndexDurationInfo(t *testing.T) {
 	err = s.updateIndexDurationInfo(&nilIndexMap)
 	assert.Equal(t, err.Error(), "err2")
 }
+
+func TestExpireMeasurements(t *testing.T) {
+	mockClient := &MockMetaClient{}
+	mockClient.GetExpiredMeasurementsFn = func() []meta.ExpiredMeasurementInfos {
+		return []meta.ExpiredMeasurementInfos{{Database: "db0", Policy: "rp0", Name: "mst_0000", EngineType: config.TSSTORE, ExpireTime: 1}}
+	}
+
+	var expired []meta.ExpiredMeasurementInfos
+	mockEngine := &MockEngine{}
+	mockEngine.ExpiredShardsFn = func(nilShardMap *map[uint64]*meta.ShardDurationInfo) []*meta.ShardIdentifier {
+		return nil
+	}
+	mockEngine.ExpiredIndexesFn = func(nilIndexMap *map[uint64]*meta.IndexDurationInfo) []*meta.IndexIdentifier {
+		return nil
+	}
+	mockEngine.ExpiredCacheIndexesFn = func() []*meta.IndexIdentifier {
+		return nil
+	}
+	mockEngine.ExpireMeasurementsFn = func(infos []meta.ExpiredMeasurementInfos) error {
+		expired = infos
+		return nil
+	}
+
+	s := NewService(time.Second)
+	s.MetaClient = mockClient
+	s.Engine = mockEngine
+
+	nilShardMap := make(map[uint64]*meta.ShardDurationInfo)
+	nilIndexMap := make(map[uint64]*meta.IndexDurationInfo)
+	logger, _ := log.NewOperation(s.Logger.GetZapLogger(), "retention policy deletion check", "retention_delete_check")
+	assert.False(t, s.handleLocalStorage(logger, &nilShardMap, &nilIndexMap))
+	assert.Equal(t, 1, len(expired))
+	assert.Equal(t, "mst_0000", expired[0].Name)
+
+	mockEngine.ExpireMeasurementsFn = func(infos []meta.ExpiredMeasurementInfos) error {
+		return fmt.Errorf("expire failed")
+	}
+	assert.True(t, s.handleLocalStorage(logger, &nilShardMap, &nilIndexMap))
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrub
+
+import (
+	"context"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/services"
+	"go.uber.org/zap"
+)
+
+type engine interface {
+	Scrub(opt *netstorage.ScrubOptions)
+}
+
+// Service periodically reads all the data of the ts-store at a limited throughput to find the silent corruptions
+type Service struct {
+	Logger *logger.Logger
+
+	Config *config.ScrubConfig
+
+	base services.Base
+
+	Engine engine
+
+	// Fetcher re-fetches the corrupt series from the peer replicas, nil disables the repair
+	Fetcher netstorage.ScrubFetcher
+
+	limiter fileops.Limiter
+	closed  chan struct{}
+	// ctx is cancelled on close, so that the throttled reads do not block the close
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func NewService(c config.ScrubConfig) *Service {
+	s := &Service{
+		Logger:  logger.NewLogger(errno.ModuleStorageEngine).With(zap.String("service", "scrub")),
+		Config:  &c,
+		limiter: fileops.NewLimiter(int(c.ReadThroughput), int(c.ReadThroughput)),
+	}
+	s.base.Init("scrub", time.Duration(c.RunInterval), s.handle)
+	return s
+}
+
+func (s *Service) Open() error {
+	s.Logger.Info("service open", zap.Duration("RunInterval", time.Duration(s.Config.RunInterval)),
+		zap.Int64("ReadThroughput", int64(s.Config.ReadThroughput)), zap.Bool("RepairFromPeer", s.Fetcher != nil))
+	s.closed = make(chan struct{})
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	return s.base.Open()
+}
+
+func (s *Service) Close() error {
+	if s.closed != nil {
+		close(s.closed)
+		s.cancel()
+	}
+	return s.base.Close()
+}
+
+func (s *Service) handle() {
+	s.Engine.Scrub(&netstorage.ScrubOptions{
+		Throttle: s.throttle,
+		Closed:   s.closed,
+		Fetcher:  s.Fetcher,
+	})
+}
+
+// throttle blocks until n bytes are allowed to be read, a scrub round is never faster than the read throughput
+func (s *Service) throttle(n int) {
+	burst := s.limiter.Burst()
+	for n > 0 {
+		m := n
+		if m > burst {
+			m = burst
+		}
+		if err := s.limiter.WaitN(s.ctx, m); err != nil {
+			return
+		}
+		n -= m
+	}
+}
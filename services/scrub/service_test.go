@@ -0,0 +1,80 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/stretchr/testify/require"
+)
+
+type mockEngine struct {
+	opts chan *netstorage.ScrubOptions
+}
+
+func (e *mockEngine) Scrub(opt *netstorage.ScrubOptions) {
+	opt.Throttle(2 << 10)
+	e.opts <- opt
+}
+
+func TestService(t *testing.T) {
+	c := config.NewScrubConfig()
+	c.RunInterval = toml.Duration(10 * time.Millisecond)
+	c.ReadThroughput = 1 << 10
+
+	e := &mockEngine{opts: make(chan *netstorage.ScrubOptions, 1)}
+	s := NewService(c)
+	s.Engine = e
+	require.NoError(t, s.Open())
+
+	// the limiter starts without tokens, so reading 2KB takes about 2s
+	start := time.Now()
+	opt := <-e.opts
+	require.True(t, time.Since(start) > time.Second)
+	require.Nil(t, opt.Fetcher)
+
+	require.NoError(t, s.Close())
+	select {
+	case <-opt.Closed:
+	default:
+		t.Fatal("the scrub round is not stopped")
+	}
+}
+
+func TestService_ThrottleStoppedByClose(t *testing.T) {
+	c := config.NewScrubConfig()
+	c.RunInterval = toml.Duration(time.Hour)
+	c.ReadThroughput = 1
+
+	s := NewService(c)
+	s.Engine = &mockEngine{opts: make(chan *netstorage.ScrubOptions, 1)}
+	require.NoError(t, s.Open())
+
+	done := make(chan struct{})
+	go func() {
+		s.throttle(1 << 20)
+		close(done)
+	}()
+	require.NoError(t, s.Close())
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the throttled read is not stopped by close")
+	}
+}
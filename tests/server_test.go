@@ -11700,6 +11700,80 @@ func TestServer_FullJoin(t *testing.T) {
 		})
 	}
 }
+func TestServer_Query_SemiJoin(t *testing.T) {
+	t.Parallel()
+	s := OpenServer(NewParseConfig(testCfgPath))
+	defer s.Close()
+
+	test := NewTest("db0", "rp0")
+	test.writes = Writes{
+		&Write{data: strings.Join([]string{
+			fmt.Sprintf("cpu,host=h1 value=1 %d", mustParseTime(time.RFC3339Nano, "2021-01-11T16:00:00Z").UnixNano()),
+			fmt.Sprintf("cpu,host=h2 value=2 %d", mustParseTime(time.RFC3339Nano, "2021-01-11T16:00:01Z").UnixNano()),
+			fmt.Sprintf("cpu,host=h3 value=3 %d", mustParseTime(time.RFC3339Nano, "2021-01-11T16:00:02Z").UnixNano()),
+			fmt.Sprintf("mem,host=h1 value=10 %d", mustParseTime(time.RFC3339Nano, "2021-01-11T16:00:00Z").UnixNano()),
+			fmt.Sprintf("mem,host=h2 value=1 %d", mustParseTime(time.RFC3339Nano, "2021-01-11T16:00:00Z").UnixNano()),
+		}, "\n")},
+	}
+
+	test.addQueries([]*Query{
+		{
+			name:    "in subquery on a selected tag",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select value from cpu where host in (select value, host from mem where value > 5)`,
+			exp:     `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","value"],"values":[["2021-01-11T16:00:00Z",1]]}]}]}`,
+		},
+		{
+			name:    "in subquery on a group by tag with another condition",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select value from cpu where host in (select max(value) from mem group by host) and value > 1`,
+			exp:     `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","value"],"values":[["2021-01-11T16:00:01Z",2]]}]}]}`,
+		},
+		{
+			name:    "not in subquery",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select value from cpu where host not in (select value, host from mem)`,
+			exp:     `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","value"],"values":[["2021-01-11T16:00:02Z",3]]}]}]}`,
+		},
+		{
+			name:    "in subquery without values",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select value from cpu where host in (select value, host from mem where value > 100)`,
+			exp:     `{"results":[{"statement_id":0}]}`,
+		},
+		{
+			name:    "exists subquery",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select value from cpu where exists (select value from mem where value > 5)`,
+			exp:     `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","value"],"values":[["2021-01-11T16:00:00Z",1],["2021-01-11T16:00:01Z",2],["2021-01-11T16:00:02Z",3]]}]}]}`,
+		},
+		{
+			name:    "not exists subquery",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select value from cpu where not exists (select value from mem where value > 5)`,
+			exp:     `{"results":[{"statement_id":0}]}`,
+		},
+	}...)
+
+	for i, query := range test.queries {
+		t.Run(query.name, func(t *testing.T) {
+			if i == 0 {
+				if err := test.init(s); err != nil {
+					t.Fatalf("test init failed: %s", err)
+				}
+			}
+			if query.skip {
+				t.Skipf("SKIP:: %s", query.name)
+			}
+			if err := query.Execute(s); err != nil {
+				t.Error(query.Error(err))
+			} else if !query.success() {
+				t.Error(query.failureMessage())
+			}
+		})
+	}
+}
+
 func TestServer_Write_Compatible(t *testing.T) {
 	t.Parallel()
 	s := OpenServer(NewParseConfig(testCfgPath))